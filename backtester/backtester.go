@@ -0,0 +1,365 @@
+package backtester
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// New returns a new backtest for the supplied config, strategy, fee provider
+// and historical data
+func New(cfg Config, s Strategy, fees FeeProvider, data []DataEvent) (*Backtest, error) {
+	if s == nil {
+		return nil, ErrStrategyIsNil
+	}
+	if fees == nil {
+		return nil, ErrFeeProviderIsNil
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	if cfg.InitialFunds <= 0 {
+		return nil, ErrInvalidFunds
+	}
+	if cfg.Pair.IsEmpty() {
+		return nil, order.ErrPairIsEmpty
+	}
+	if cfg.Spread <= 0 {
+		cfg.Spread = DefaultSpread
+	}
+	if cfg.Slippage <= 0 {
+		cfg.Slippage = DefaultSlippage
+	}
+	if cfg.OrderbookDepth <= 0 {
+		cfg.OrderbookDepth = DefaultOrderbookDepth
+	}
+
+	sortEvents(data)
+	return &Backtest{
+		Config:   cfg,
+		Strategy: s,
+		Fees:     fees,
+		Data:     data,
+		Portfolio: &Portfolio{
+			Pair:  cfg.Pair,
+			Funds: cfg.InitialFunds,
+		},
+	}, nil
+}
+
+// Run replays every data event through the strategy, simulating the fills of
+// the orders it submits, and returns the resulting report
+func (b *Backtest) Run() (*Report, error) {
+	for x := range b.Data {
+		ev := b.Data[x]
+		b.Portfolio.lastPrice = ev.LatestPrice()
+
+		b.processRestingOrders(ev)
+
+		orders, err := b.Strategy.OnData(ev, b.Portfolio)
+		if err != nil {
+			return nil, fmt.Errorf("strategy %s failed at %v: %s",
+				b.Strategy.Name(), ev.Timestamp(), err)
+		}
+
+		for y := range orders {
+			err = b.submit(ev, orders[y])
+			if err != nil {
+				b.rejected++
+				log.Debugf(log.Global, "Backtester: order rejected at %v: %s\n",
+					ev.Timestamp(), err)
+			}
+		}
+
+		b.equity = append(b.equity, equityPoint{
+			Time:  ev.Timestamp(),
+			Value: b.Portfolio.Value(),
+		})
+	}
+	return b.report(), nil
+}
+
+// submit validates a strategy order and either fills it against the synthetic
+// orderbook or rests it until the price crosses
+func (b *Backtest) submit(ev DataEvent, s *order.Submit) error {
+	if s == nil {
+		return order.ErrSubmissionIsNil
+	}
+	if s.Pair.IsEmpty() {
+		s.Pair = b.Config.Pair
+	}
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	if !s.Pair.Equal(b.Config.Pair) {
+		return fmt.Errorf("order pair %s does not match backtest pair %s",
+			s.Pair, b.Config.Pair)
+	}
+
+	b.Portfolio.nextOrderID++
+	id := strconv.FormatInt(b.Portfolio.nextOrderID, 10)
+
+	switch s.OrderType {
+	case order.Market:
+		return b.fillMarket(ev, id, s)
+	case order.Limit:
+		o := &RestingOrder{
+			ID:        id,
+			Submitted: ev.Timestamp(),
+			Submit:    *s,
+		}
+		if err := b.reserve(o); err != nil {
+			return err
+		}
+		b.Portfolio.OpenOrders = append(b.Portfolio.OpenOrders, o)
+		return nil
+	default:
+		return fmt.Errorf("order type %s is not supported by the backtester",
+			s.OrderType)
+	}
+}
+
+// processRestingOrders fills any limit orders whose price has been crossed by
+// the data event
+func (b *Backtest) processRestingOrders(ev DataEvent) {
+	low, high := ev.PriceRange()
+	var remaining []*RestingOrder
+	for x := range b.Portfolio.OpenOrders {
+		o := b.Portfolio.OpenOrders[x]
+		crossed := (isBuy(o.OrderSide) && low <= o.Price) ||
+			(!isBuy(o.OrderSide) && high >= o.Price)
+		if !crossed || !o.Submitted.Before(ev.Timestamp()) {
+			remaining = append(remaining, o)
+			continue
+		}
+		b.Portfolio.release(o)
+		err := b.fill(ev, o.ID, &o.Submit, o.Price, o.Amount, true)
+		if err != nil {
+			b.rejected++
+			log.Debugf(log.Global, "Backtester: resting order %s rejected at %v: %s\n",
+				o.ID, ev.Timestamp(), err)
+		}
+	}
+	b.Portfolio.OpenOrders = remaining
+}
+
+// reserve holds the funds, including the maker fee, of a limit buy order or
+// the holdings of a limit sell order so they cannot be used by other orders
+// while it rests
+func (b *Backtest) reserve(o *RestingOrder) error {
+	p := b.Portfolio
+	if !isBuy(o.OrderSide) {
+		if o.Amount > p.AvailableHoldings() {
+			return ErrInsufficientHoldings
+		}
+		o.Reserved = o.Amount
+		p.ReservedHoldings += o.Reserved
+		return nil
+	}
+
+	fee, err := b.Fees.GetFeeByType(&exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          o.Pair,
+		IsMaker:       true,
+		PurchasePrice: o.Price,
+		Amount:        o.Amount,
+	})
+	if err != nil {
+		return err
+	}
+	if o.Price*o.Amount+fee > p.AvailableFunds() {
+		return ErrInsufficientFunds
+	}
+	o.Reserved = o.Price*o.Amount + fee
+	p.ReservedFunds += o.Reserved
+	return nil
+}
+
+// fillMarket simulates a market order against a synthetic orderbook built
+// from the data event using the orderbook calculator
+func (b *Backtest) fillMarket(ev DataEvent, id string, s *order.Submit) error {
+	ob := b.orderbook(ev)
+	if isBuy(s.OrderSide) {
+		cost := quoteCost(ob.Asks, s.Amount)
+		if cost > b.Portfolio.AvailableFunds() {
+			return ErrInsufficientFunds
+		}
+		result := ob.SimulateOrder(cost, true)
+		price, amount := averagePrice(result)
+		if amount == 0 {
+			return ErrNoLiquidity
+		}
+		return b.fill(ev, id, s, price, amount, false)
+	}
+
+	if s.Amount > b.Portfolio.AvailableHoldings() {
+		return ErrInsufficientHoldings
+	}
+	result := ob.SimulateOrder(s.Amount, false)
+	price, amount := averagePrice(result)
+	if amount == 0 {
+		return ErrNoLiquidity
+	}
+	return b.fill(ev, id, s, price, amount, false)
+}
+
+// fill applies an executed order to the portfolio and records it in the ledger
+func (b *Backtest) fill(ev DataEvent, id string, s *order.Submit, price, amount float64, isMaker bool) error {
+	fee, err := b.Fees.GetFeeByType(&exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          s.Pair,
+		IsMaker:       isMaker,
+		PurchasePrice: price,
+		Amount:        amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	p := b.Portfolio
+	value := price * amount
+	var realised float64
+	if isBuy(s.OrderSide) {
+		if value+fee > p.AvailableFunds() {
+			return ErrInsufficientFunds
+		}
+		p.AverageCost = (p.AverageCost*p.Holdings + value) / (p.Holdings + amount)
+		p.Holdings += amount
+		p.Funds -= value + fee
+		realised = -fee
+	} else {
+		if amount > p.AvailableHoldings() {
+			return ErrInsufficientHoldings
+		}
+		realised = (price-p.AverageCost)*amount - fee
+		p.Holdings -= amount
+		p.Funds += value - fee
+		if p.Holdings == 0 {
+			p.AverageCost = 0
+		}
+	}
+	p.RealisedPNL += realised
+	p.FeesPaid += fee
+	p.Ledger = append(p.Ledger, LedgerEntry{
+		OrderID:     id,
+		Time:        ev.Timestamp(),
+		Side:        s.OrderSide,
+		Type:        s.OrderType,
+		Price:       price,
+		Amount:      amount,
+		Value:       value,
+		Fee:         fee,
+		RealisedPNL: realised,
+		Funds:       p.Funds,
+		Holdings:    p.Holdings,
+	})
+	return nil
+}
+
+// orderbook generates a synthetic orderbook around the data event price with
+// the event liquidity spread evenly across each level
+func (b *Backtest) orderbook(ev DataEvent) *orderbook.Base {
+	price := ev.LatestPrice()
+	depth := b.Config.OrderbookDepth
+	amount := ev.Liquidity() / float64(depth)
+	ob := &orderbook.Base{
+		Pair:         b.Config.Pair,
+		AssetType:    b.Config.AssetType,
+		ExchangeName: b.Fees.GetName(),
+		LastUpdated:  ev.Timestamp(),
+	}
+	for x := 0; x < depth; x++ {
+		offset := b.Config.Spread/2 + float64(x)*b.Config.Slippage
+		ob.Bids = append(ob.Bids, orderbook.Item{
+			Price:  price * (1 - offset),
+			Amount: amount,
+		})
+		ob.Asks = append(ob.Asks, orderbook.Item{
+			Price:  price * (1 + offset),
+			Amount: amount,
+		})
+	}
+	return ob
+}
+
+// quoteCost returns the quote currency required to buy the base amount by
+// walking the asks
+func quoteCost(asks []orderbook.Item, amount float64) float64 {
+	var cost float64
+	for x := range asks {
+		if amount <= asks[x].Amount {
+			return cost + amount*asks[x].Price
+		}
+		cost += asks[x].Amount * asks[x].Price
+		amount -= asks[x].Amount
+	}
+	return cost
+}
+
+// averagePrice returns the volume weighted price and base amount filled from
+// an orderbook simulation
+func averagePrice(r *orderbook.OrderSimulationResult) (price, amount float64) {
+	var value float64
+	for x := range r.Orders {
+		value += r.Orders[x].Price * r.Orders[x].Amount
+		amount += r.Orders[x].Amount
+	}
+	if amount == 0 {
+		return 0, 0
+	}
+	return value / amount, amount
+}
+
+func isBuy(s order.Side) bool {
+	return s == order.Buy || s == order.Bid
+}
+
+// Value returns the portfolio funds plus holdings valued at the latest price
+func (p *Portfolio) Value() float64 {
+	return p.Funds + p.Holdings*p.lastPrice
+}
+
+// AvailableFunds returns the funds which are not reserved by resting orders
+func (p *Portfolio) AvailableFunds() float64 {
+	return p.Funds - p.ReservedFunds
+}
+
+// AvailableHoldings returns the holdings which are not reserved by resting
+// orders
+func (p *Portfolio) AvailableHoldings() float64 {
+	return p.Holdings - p.ReservedHoldings
+}
+
+// CancelOrder removes a resting order and releases the funds or holdings it
+// reserved
+func (p *Portfolio) CancelOrder(id string) error {
+	for x := range p.OpenOrders {
+		if p.OpenOrders[x].ID != id {
+			continue
+		}
+		p.release(p.OpenOrders[x])
+		p.OpenOrders = append(p.OpenOrders[:x], p.OpenOrders[x+1:]...)
+		return nil
+	}
+	return fmt.Errorf("%v: %s", ErrOrderNotFound, id)
+}
+
+// release returns the funds or holdings reserved by a resting order
+func (p *Portfolio) release(o *RestingOrder) {
+	if isBuy(o.OrderSide) {
+		p.ReservedFunds = math.Max(p.ReservedFunds-o.Reserved, 0)
+	} else {
+		p.ReservedHoldings = math.Max(p.ReservedHoldings-o.Reserved, 0)
+	}
+	o.Reserved = 0
+}
+
+// LatestPrice returns the latest price seen by the portfolio
+func (p *Portfolio) LatestPrice() float64 {
+	return p.lastPrice
+}
@@ -0,0 +1,342 @@
+package backtester
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+type testFees struct {
+	rate float64
+}
+
+func (t *testFees) GetName() string {
+	return "test"
+}
+
+func (t *testFees) GetFeeByType(f *exchange.FeeBuilder) (float64, error) {
+	return f.PurchasePrice * f.Amount * t.rate, nil
+}
+
+type testStrategy struct {
+	orders map[int][]*order.Submit
+	events int
+}
+
+func (t *testStrategy) Name() string {
+	return "test"
+}
+
+func (t *testStrategy) OnData(d DataEvent, p *Portfolio) ([]*order.Submit, error) {
+	o := t.orders[t.events]
+	t.events++
+	return o, nil
+}
+
+func testCandles(closes ...float64) []DataEvent {
+	var candles []kline.Candle
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for x := range closes {
+		candles = append(candles, kline.Candle{
+			Time:   start.Add(time.Hour * time.Duration(x)),
+			Open:   closes[x],
+			High:   closes[x] * 1.01,
+			Low:    closes[x] * 0.99,
+			Close:  closes[x],
+			Volume: 1000,
+		})
+	}
+	return CandlesToEvents(candles)
+}
+
+func testConfig() Config {
+	return Config{
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		InitialFunds: 10000,
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	data := testCandles(100)
+	_, err := New(testConfig(), nil, &testFees{}, data)
+	if err != ErrStrategyIsNil {
+		t.Errorf("expected %v, received %v", ErrStrategyIsNil, err)
+	}
+	_, err = New(testConfig(), &BuyAndHold{}, nil, data)
+	if err != ErrFeeProviderIsNil {
+		t.Errorf("expected %v, received %v", ErrFeeProviderIsNil, err)
+	}
+	_, err = New(testConfig(), &BuyAndHold{}, &testFees{}, nil)
+	if err != ErrNoData {
+		t.Errorf("expected %v, received %v", ErrNoData, err)
+	}
+	cfg := testConfig()
+	cfg.InitialFunds = 0
+	_, err = New(cfg, &BuyAndHold{}, &testFees{}, data)
+	if err != ErrInvalidFunds {
+		t.Errorf("expected %v, received %v", ErrInvalidFunds, err)
+	}
+	b, err := New(testConfig(), &BuyAndHold{}, &testFees{}, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Config.Spread != DefaultSpread ||
+		b.Config.Slippage != DefaultSlippage ||
+		b.Config.OrderbookDepth != DefaultOrderbookDepth {
+		t.Error("unexpected defaults")
+	}
+}
+
+func TestMarketOrders(t *testing.T) {
+	t.Parallel()
+	s := &testStrategy{orders: map[int][]*order.Submit{
+		0: {{OrderType: order.Market, OrderSide: order.Buy, Amount: 10}},
+		2: {{OrderType: order.Market, OrderSide: order.Sell, Amount: 10}},
+	}}
+	b, err := New(testConfig(), s, &testFees{rate: 0.001}, testCandles(100, 110, 120))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := b.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.TotalTrades != 2 {
+		t.Fatalf("expected 2 trades, received %d", r.TotalTrades)
+	}
+	if r.Ledger[0].Amount != 10 || r.Ledger[1].Amount != 10 {
+		t.Error("unexpected fill amounts")
+	}
+	if r.Ledger[0].Price <= 100 || r.Ledger[1].Price >= 120 {
+		t.Error("expected fills to cross the spread")
+	}
+	if b.Portfolio.Holdings != 0 {
+		t.Errorf("expected no holdings, received %v", b.Portfolio.Holdings)
+	}
+	if r.RealisedPNL <= 0 || r.PNL <= 0 {
+		t.Errorf("expected profit, received realised %v total %v", r.RealisedPNL, r.PNL)
+	}
+	if r.FeesPaid <= 0 {
+		t.Error("expected fees to be paid")
+	}
+	if r.FinalValue != b.Portfolio.Funds {
+		t.Error("final value should equal funds when flat")
+	}
+}
+
+func TestLimitOrders(t *testing.T) {
+	t.Parallel()
+	s := &testStrategy{orders: map[int][]*order.Submit{
+		0: {{OrderType: order.Limit, OrderSide: order.Buy, Amount: 1, Price: 95}},
+	}}
+	b, err := New(testConfig(), s, &testFees{}, testCandles(100, 99, 95))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := b.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.TotalTrades != 1 {
+		t.Fatalf("expected 1 trade, received %d", r.TotalTrades)
+	}
+	if r.Ledger[0].Price != 95 {
+		t.Errorf("expected fill at limit price, received %v", r.Ledger[0].Price)
+	}
+	if !r.Ledger[0].Time.Equal(b.Data[2].Timestamp()) {
+		t.Error("limit order filled before the price crossed")
+	}
+	if len(b.Portfolio.OpenOrders) != 0 {
+		t.Error("expected no resting orders")
+	}
+}
+
+func TestLimitOrderReservations(t *testing.T) {
+	t.Parallel()
+	cfg := testConfig()
+	cfg.InitialFunds = 1000
+	b, err := New(cfg, &testStrategy{}, &testFees{rate: 0.001}, testCandles(100, 99, 95))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev := b.Data[0]
+	p := b.Portfolio
+
+	err = b.submit(ev, &order.Submit{OrderType: order.Limit, OrderSide: order.Buy, Amount: 6, Price: 95})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(p.ReservedFunds-570.57) > 1e-9 || math.Abs(p.AvailableFunds()-429.43) > 1e-9 {
+		t.Errorf("expected the order value and fee to be reserved, received %v", p.ReservedFunds)
+	}
+	err = b.submit(ev, &order.Submit{OrderType: order.Limit, OrderSide: order.Buy, Amount: 5, Price: 95})
+	if err != ErrInsufficientFunds {
+		t.Errorf("expected %v, received %v", ErrInsufficientFunds, err)
+	}
+	err = b.submit(ev, &order.Submit{OrderType: order.Market, OrderSide: order.Buy, Amount: 5})
+	if err != ErrInsufficientFunds {
+		t.Errorf("expected %v, received %v", ErrInsufficientFunds, err)
+	}
+
+	if err = p.CancelOrder(p.OpenOrders[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if p.ReservedFunds != 0 || len(p.OpenOrders) != 0 {
+		t.Errorf("expected the cancelled order to release its funds, reserved %v", p.ReservedFunds)
+	}
+	if err = p.CancelOrder("1"); err == nil {
+		t.Error("expected error cancelling an order which is not resting")
+	}
+
+	err = b.submit(ev, &order.Submit{OrderType: order.Limit, OrderSide: order.Buy, Amount: 5, Price: 95})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.processRestingOrders(b.Data[2])
+	if p.ReservedFunds != 0 || p.Holdings != 5 {
+		t.Errorf("expected the filled order to release its funds, reserved %v holdings %v",
+			p.ReservedFunds, p.Holdings)
+	}
+
+	err = b.submit(ev, &order.Submit{OrderType: order.Limit, OrderSide: order.Sell, Amount: 4, Price: 200})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ReservedHoldings != 4 || p.AvailableHoldings() != 1 {
+		t.Errorf("expected the sell amount to be reserved, received %v", p.ReservedHoldings)
+	}
+	err = b.submit(ev, &order.Submit{OrderType: order.Market, OrderSide: order.Sell, Amount: 2})
+	if err != ErrInsufficientHoldings {
+		t.Errorf("expected %v, received %v", ErrInsufficientHoldings, err)
+	}
+}
+
+func TestRejectedOrders(t *testing.T) {
+	t.Parallel()
+	s := &testStrategy{orders: map[int][]*order.Submit{
+		0: {
+			{OrderType: order.Market, OrderSide: order.Buy, Amount: 1000},
+			{OrderType: order.Market, OrderSide: order.Sell, Amount: 1},
+			{OrderType: order.Market, OrderSide: order.Buy},
+		},
+	}}
+	b, err := New(testConfig(), s, &testFees{}, testCandles(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := b.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.RejectedOrders != 3 {
+		t.Errorf("expected 3 rejected orders, received %d", r.RejectedOrders)
+	}
+	if r.TotalTrades != 0 {
+		t.Error("unexpected result")
+	}
+}
+
+func TestBuyAndHold(t *testing.T) {
+	t.Parallel()
+	b, err := New(testConfig(), &BuyAndHold{}, &testFees{}, testCandles(100, 50, 150))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := b.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.TotalTrades != 1 {
+		t.Fatalf("expected 1 trade, received %d", r.TotalTrades)
+	}
+	if r.MaxDrawdown < 49 || r.MaxDrawdown > 51 {
+		t.Errorf("expected drawdown of roughly 50%%, received %v", r.MaxDrawdown)
+	}
+	if r.UnrealisedPNL <= 0 {
+		t.Error("expected unrealised profit")
+	}
+}
+
+func TestSMACrossover(t *testing.T) {
+	t.Parallel()
+	var closes []float64
+	for x := 0; x < 40; x++ {
+		closes = append(closes, 100-float64(x))
+	}
+	for x := 0; x < 40; x++ {
+		closes = append(closes, 60+float64(x)*2)
+	}
+	for x := 0; x < 40; x++ {
+		closes = append(closes, 140-float64(x)*2)
+	}
+	b, err := New(testConfig(), &SMACrossover{Fast: 3, Slow: 10}, &testFees{}, testCandles(closes...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := b.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.TotalTrades != 2 {
+		t.Fatalf("expected 2 trades, received %d", r.TotalTrades)
+	}
+	if r.Ledger[0].Side != order.Buy || r.Ledger[1].Side != order.Sell {
+		t.Error("unexpected trade sides")
+	}
+	if r.RealisedPNL <= 0 {
+		t.Error("expected realised profit")
+	}
+}
+
+func TestMaxDrawdown(t *testing.T) {
+	t.Parallel()
+	equity := []equityPoint{{Value: 100}, {Value: 120}, {Value: 90}, {Value: 130}, {Value: 117}}
+	if d := maxDrawdown(equity); d != 25 {
+		t.Errorf("expected 25, received %v", d)
+	}
+}
+
+func TestSharpeRatio(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var equity []equityPoint
+	for x := 0; x < 10; x++ {
+		equity = append(equity, equityPoint{
+			Time:  start.Add(time.Hour * 24 * time.Duration(x)),
+			Value: 100,
+		})
+	}
+	if s := sharpeRatio(100, equity, 0); s != 0 {
+		t.Errorf("expected 0 for a flat equity curve, received %v", s)
+	}
+	for x := range equity {
+		equity[x].Value = 100 + float64(x)
+		if x%2 == 0 {
+			equity[x].Value += 0.5
+		}
+	}
+	if s := sharpeRatio(100, equity, 0); s <= 0 {
+		t.Errorf("expected positive ratio, received %v", s)
+	}
+}
+
+func TestGetStrategy(t *testing.T) {
+	t.Parallel()
+	if _, err := GetStrategy("BuyAndHold"); err != nil {
+		t.Error(err)
+	}
+	if _, err := GetStrategy("smacrossover"); err != nil {
+		t.Error(err)
+	}
+	if _, err := GetStrategy("meow"); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,172 @@
+package backtester
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// const values for the backtester package
+const (
+	// DefaultOrderbookDepth is the amount of price levels generated on each
+	// side of the synthetic orderbook used to simulate fills
+	DefaultOrderbookDepth = 10
+	// DefaultSpread is the default bid/ask spread applied around the data
+	// event price
+	DefaultSpread = 0.001
+	// DefaultSlippage is the default price increment between synthetic
+	// orderbook levels
+	DefaultSlippage = 0.0005
+
+	secondsPerYear = 365 * 24 * 60 * 60
+)
+
+// vars for the backtester package
+var (
+	ErrNoData               = errors.New("backtester has no data events to process")
+	ErrStrategyIsNil        = errors.New("backtester strategy is nil")
+	ErrFeeProviderIsNil     = errors.New("backtester fee provider is nil")
+	ErrInvalidFunds         = errors.New("backtester initial funds must be greater than zero")
+	ErrInsufficientFunds    = errors.New("insufficient funds to execute order")
+	ErrInsufficientHoldings = errors.New("insufficient holdings to execute order")
+	ErrNoLiquidity          = errors.New("order could not be filled due to insufficient liquidity")
+	ErrOrderNotFound        = errors.New("resting order not found")
+)
+
+// FeeProvider calculates the fee of a simulated fill. All IBotExchange
+// implementations satisfy this interface
+type FeeProvider interface {
+	GetName() string
+	GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error)
+}
+
+// DataEvent is a single point in time of historical market data which is
+// replayed through a strategy
+type DataEvent interface {
+	Timestamp() time.Time
+	LatestPrice() float64
+	PriceRange() (low, high float64)
+	Liquidity() float64
+}
+
+// Candle is a DataEvent wrapping a historic kline candle
+type Candle struct {
+	kline.Candle
+}
+
+// Trade is a DataEvent wrapping a historic exchange trade
+type Trade struct {
+	exchange.TradeHistory
+}
+
+// Strategy is implemented by trading logic evaluated by the backtester. On
+// each data event the strategy returns any orders it wishes to submit, using
+// the same order.Submit type as the live order manager
+type Strategy interface {
+	Name() string
+	OnData(d DataEvent, p *Portfolio) ([]*order.Submit, error)
+}
+
+// Config holds the settings for a single backtest run
+type Config struct {
+	Pair         currency.Pair
+	AssetType    asset.Item
+	InitialFunds float64
+	// Spread is the fractional bid/ask spread applied to the data event price
+	Spread float64
+	// Slippage is the fractional price increment between synthetic orderbook
+	// levels
+	Slippage float64
+	// OrderbookDepth is the amount of synthetic orderbook levels per side
+	OrderbookDepth int
+	// RiskFreeRate is the annualised rate used when calculating the Sharpe
+	// ratio
+	RiskFreeRate float64
+}
+
+// Backtest replays historical data through a strategy and records the results
+type Backtest struct {
+	Config    Config
+	Strategy  Strategy
+	Fees      FeeProvider
+	Data      []DataEvent
+	Portfolio *Portfolio
+	equity    []equityPoint
+	rejected  int
+}
+
+// Portfolio holds the simulated funds, holdings, resting orders and trade
+// ledger of a backtest. Funds and holdings include the amounts reserved by
+// resting orders, which cannot be used by other orders
+type Portfolio struct {
+	Pair             currency.Pair
+	Funds            float64
+	Holdings         float64
+	ReservedFunds    float64
+	ReservedHoldings float64
+	AverageCost      float64
+	RealisedPNL      float64
+	FeesPaid         float64
+	OpenOrders       []*RestingOrder
+	Ledger           []LedgerEntry
+	nextOrderID      int64
+	lastPrice        float64
+}
+
+// RestingOrder is a limit order waiting for the price to cross
+type RestingOrder struct {
+	ID        string
+	Submitted time.Time
+	// Reserved is the funds of a buy order, including its maker fee, or the
+	// holdings of a sell order held until it fills or is cancelled
+	Reserved float64
+	order.Submit
+}
+
+// LedgerEntry is a single simulated fill
+type LedgerEntry struct {
+	OrderID     string
+	Time        time.Time
+	Side        order.Side
+	Type        order.Type
+	Price       float64
+	Amount      float64
+	Value       float64
+	Fee         float64
+	RealisedPNL float64
+	Funds       float64
+	Holdings    float64
+}
+
+type equityPoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// Report holds the performance statistics of a completed backtest
+type Report struct {
+	Exchange       string
+	Strategy       string
+	Pair           currency.Pair
+	AssetType      asset.Item
+	Start          time.Time
+	End            time.Time
+	Events         int
+	InitialValue   float64
+	FinalValue     float64
+	PNL            float64
+	PNLPercentage  float64
+	RealisedPNL    float64
+	UnrealisedPNL  float64
+	FeesPaid       float64
+	MaxDrawdown    float64
+	SharpeRatio    float64
+	TotalTrades    int
+	RejectedOrders int
+	Ledger         []LedgerEntry
+}
@@ -0,0 +1,261 @@
+package backtester
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Timestamp returns the candle open time
+func (c *Candle) Timestamp() time.Time {
+	return c.Time
+}
+
+// LatestPrice returns the candle close price
+func (c *Candle) LatestPrice() float64 {
+	return c.Close
+}
+
+// PriceRange returns the low and high price of the candle
+func (c *Candle) PriceRange() (low, high float64) {
+	return c.Low, c.High
+}
+
+// Liquidity returns the candle volume
+func (c *Candle) Liquidity() float64 {
+	return c.Volume
+}
+
+// Timestamp returns the time the trade occurred
+func (t *Trade) Timestamp() time.Time {
+	return t.TradeHistory.Timestamp
+}
+
+// LatestPrice returns the trade price
+func (t *Trade) LatestPrice() float64 {
+	return t.Price
+}
+
+// PriceRange returns the trade price as both the low and high
+func (t *Trade) PriceRange() (low, high float64) {
+	return t.Price, t.Price
+}
+
+// Liquidity returns the trade amount
+func (t *Trade) Liquidity() float64 {
+	return t.Amount
+}
+
+// CandlesToEvents converts kline candles to time sorted data events
+func CandlesToEvents(candles []kline.Candle) []DataEvent {
+	events := make([]DataEvent, len(candles))
+	for x := range candles {
+		events[x] = &Candle{Candle: candles[x]}
+	}
+	sortEvents(events)
+	return events
+}
+
+// TradesToEvents converts exchange trades to time sorted data events
+func TradesToEvents(trades []exchange.TradeHistory) []DataEvent {
+	events := make([]DataEvent, len(trades))
+	for x := range trades {
+		events[x] = &Trade{TradeHistory: trades[x]}
+	}
+	sortEvents(events)
+	return events
+}
+
+func sortEvents(events []DataEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp().Before(events[j].Timestamp())
+	})
+}
+
+// LoadCandlesFromCSV reads candles from a CSV file with the columns
+// timestamp,open,high,low,close,volume where timestamp is in unix seconds
+func LoadCandlesFromCSV(path string) ([]kline.Candle, error) {
+	records, err := readCSV(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var candles []kline.Candle
+	for x := range records {
+		if len(records[x]) < 6 {
+			return nil, fmt.Errorf("%s line %d: expected 6 columns, received %d",
+				path, x+1, len(records[x]))
+		}
+		var v [6]float64
+		var parseErr error
+		for y := range v {
+			v[y], parseErr = strconv.ParseFloat(strings.TrimSpace(records[x][y]), 64)
+			if parseErr != nil {
+				break
+			}
+		}
+		if parseErr != nil {
+			if x == 0 {
+				// Skip header row
+				continue
+			}
+			return nil, fmt.Errorf("%s line %d: %s", path, x+1, parseErr)
+		}
+		candles = append(candles, kline.Candle{
+			Time:   time.Unix(int64(v[0]), 0),
+			Open:   v[1],
+			High:   v[2],
+			Low:    v[3],
+			Close:  v[4],
+			Volume: v[5],
+		})
+	}
+	return candles, nil
+}
+
+// LoadTradesFromCSV reads trades from a CSV file with the columns
+// timestamp,tid,price,amount,side where timestamp is in unix seconds
+func LoadTradesFromCSV(path, exchangeName string) ([]exchange.TradeHistory, error) {
+	records, err := readCSV(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var trades []exchange.TradeHistory
+	for x := range records {
+		if len(records[x]) < 5 {
+			return nil, fmt.Errorf("%s line %d: expected 5 columns, received %d",
+				path, x+1, len(records[x]))
+		}
+		ts, err := strconv.ParseInt(strings.TrimSpace(records[x][0]), 10, 64)
+		if err != nil {
+			if x == 0 {
+				// Skip header row
+				continue
+			}
+			return nil, fmt.Errorf("%s line %d: %s", path, x+1, err)
+		}
+		tid, err := strconv.ParseInt(strings.TrimSpace(records[x][1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %s", path, x+1, err)
+		}
+		price, err := strconv.ParseFloat(strings.TrimSpace(records[x][2]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %s", path, x+1, err)
+		}
+		amount, err := strconv.ParseFloat(strings.TrimSpace(records[x][3]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %s", path, x+1, err)
+		}
+		trades = append(trades, exchange.TradeHistory{
+			Timestamp: time.Unix(ts, 0),
+			TID:       tid,
+			Price:     price,
+			Amount:    amount,
+			Exchange:  exchangeName,
+			Type:      strings.ToUpper(strings.TrimSpace(records[x][4])),
+		})
+	}
+	return trades, nil
+}
+
+// LoadCandlesFromDatabase returns the candles stored for an exchange, pair,
+// asset and interval which open between the start and end date
+func LoadCandlesFromDatabase(exchangeName string, p currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) ([]kline.Candle, error) {
+	series, err := candle.Series(exchangeName,
+		p.Base.String(),
+		p.Quote.String(),
+		a.String(),
+		int64(interval.Duration().Seconds()),
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+	if len(series.Candles) == 0 {
+		return nil, fmt.Errorf("no %s %s %s %s candles stored between %v and %v",
+			exchangeName, p, a, interval, start, end)
+	}
+
+	candles := make([]kline.Candle, len(series.Candles))
+	for x := range series.Candles {
+		candles[x] = kline.Candle{
+			Time:   series.Candles[x].Timestamp,
+			Open:   series.Candles[x].Open,
+			High:   series.Candles[x].High,
+			Low:    series.Candles[x].Low,
+			Close:  series.Candles[x].Close,
+			Volume: series.Candles[x].Volume,
+		}
+	}
+	return candles, nil
+}
+
+// LoadTradesFromDatabase returns the trades stored for an exchange, pair and
+// asset which occurred between the start and end date
+func LoadTradesFromDatabase(exchangeName string, p currency.Pair, a asset.Item, start, end time.Time) ([]exchange.TradeHistory, error) {
+	stored, err := trade.GetInRange(exchangeName,
+		p.Base.String(),
+		p.Quote.String(),
+		a.String(),
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+	if len(stored) == 0 {
+		return nil, fmt.Errorf("no %s %s %s trades stored between %v and %v",
+			exchangeName, p, a, start, end)
+	}
+
+	trades := make([]exchange.TradeHistory, len(stored))
+	for x := range stored {
+		// trade IDs which are not numeric are left unset as they are not
+		// used by the backtest
+		tid, _ := strconv.ParseInt(stored[x].TID, 10, 64)
+		trades[x] = exchange.TradeHistory{
+			Timestamp: stored[x].Timestamp,
+			TID:       tid,
+			Price:     stored[x].Price,
+			Amount:    stored[x].Amount,
+			Exchange:  exchangeName,
+			Type:      stored[x].Side,
+		}
+	}
+	return trades, nil
+}
+
+func readCSV(path string) ([][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	var records [][]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
@@ -0,0 +1,120 @@
+package backtester
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
+)
+
+// report generates the performance statistics of the completed backtest
+func (b *Backtest) report() *Report {
+	r := &Report{
+		Exchange:       b.Fees.GetName(),
+		Strategy:       b.Strategy.Name(),
+		Pair:           b.Config.Pair,
+		AssetType:      b.Config.AssetType,
+		Events:         len(b.Data),
+		InitialValue:   b.Config.InitialFunds,
+		FinalValue:     b.Portfolio.Value(),
+		RealisedPNL:    b.Portfolio.RealisedPNL,
+		FeesPaid:       b.Portfolio.FeesPaid,
+		TotalTrades:    len(b.Portfolio.Ledger),
+		RejectedOrders: b.rejected,
+		Ledger:         b.Portfolio.Ledger,
+	}
+	if len(b.Data) > 0 {
+		r.Start = b.Data[0].Timestamp()
+		r.End = b.Data[len(b.Data)-1].Timestamp()
+	}
+	r.PNL = r.FinalValue - r.InitialValue
+	r.PNLPercentage = gctmath.CalculatePercentageGainOrLoss(r.FinalValue, r.InitialValue)
+	r.UnrealisedPNL = b.Portfolio.Holdings * (b.Portfolio.lastPrice - b.Portfolio.AverageCost)
+	r.MaxDrawdown = maxDrawdown(b.equity)
+	r.SharpeRatio = sharpeRatio(b.Config.InitialFunds, b.equity, b.Config.RiskFreeRate)
+	return r
+}
+
+// maxDrawdown returns the largest peak to trough decline of the equity curve
+// as a percentage
+func maxDrawdown(equity []equityPoint) float64 {
+	var peak, drawdown float64
+	for x := range equity {
+		if equity[x].Value > peak {
+			peak = equity[x].Value
+			continue
+		}
+		if peak == 0 {
+			continue
+		}
+		if d := (peak - equity[x].Value) / peak * 100; d > drawdown {
+			drawdown = d
+		}
+	}
+	return drawdown
+}
+
+// sharpeRatio returns the annualised Sharpe ratio of the per event returns of
+// the equity curve, scaled by the average interval between events
+func sharpeRatio(initial float64, equity []equityPoint, riskFreeRate float64) float64 {
+	if len(equity) < 2 {
+		return 0
+	}
+	interval := equity[len(equity)-1].Time.Sub(equity[0].Time) /
+		time.Duration(len(equity)-1)
+	if interval <= 0 {
+		return 0
+	}
+	periodsPerYear := secondsPerYear / interval.Seconds()
+	periodRiskFree := riskFreeRate / periodsPerYear
+
+	returns := make([]float64, len(equity))
+	previous := initial
+	var mean float64
+	for x := range equity {
+		if previous != 0 {
+			returns[x] = (equity[x].Value-previous)/previous - periodRiskFree
+		}
+		previous = equity[x].Value
+		mean += returns[x]
+	}
+	mean /= float64(len(returns))
+
+	var variance float64
+	for x := range returns {
+		variance += (returns[x] - mean) * (returns[x] - mean)
+	}
+	stdDev := math.Sqrt(variance / float64(len(returns)-1))
+	if stdDev == 0 {
+		return 0
+	}
+	return mean / stdDev * math.Sqrt(periodsPerYear)
+}
+
+// Print writes a human readable summary and trade ledger of the report
+func (r *Report) Print(w io.Writer) {
+	fmt.Fprintf(w, "Backtest results for %s %s %s using strategy %s\n",
+		r.Exchange, r.Pair, r.AssetType, r.Strategy)
+	fmt.Fprintf(w, "Period: %v to %v (%d events)\n", r.Start.UTC(), r.End.UTC(), r.Events)
+	fmt.Fprintf(w, "Initial value: %f\n", r.InitialValue)
+	fmt.Fprintf(w, "Final value: %f\n", r.FinalValue)
+	fmt.Fprintf(w, "PNL: %f (%.2f%%)\n", r.PNL, r.PNLPercentage)
+	fmt.Fprintf(w, "Realised PNL: %f\n", r.RealisedPNL)
+	fmt.Fprintf(w, "Unrealised PNL: %f\n", r.UnrealisedPNL)
+	fmt.Fprintf(w, "Fees paid: %f\n", r.FeesPaid)
+	fmt.Fprintf(w, "Max drawdown: %.2f%%\n", r.MaxDrawdown)
+	fmt.Fprintf(w, "Sharpe ratio: %.4f\n", r.SharpeRatio)
+	fmt.Fprintf(w, "Trades: %d Rejected orders: %d\n", r.TotalTrades, r.RejectedOrders)
+	if len(r.Ledger) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "\nLedger:")
+	for x := range r.Ledger {
+		l := &r.Ledger[x]
+		fmt.Fprintf(w, "%v %s %s %s price: %f amount: %f fee: %f realised PNL: %f funds: %f holdings: %f\n",
+			l.Time.UTC(), l.OrderID, l.Type, l.Side, l.Price, l.Amount, l.Fee,
+			l.RealisedPNL, l.Funds, l.Holdings)
+	}
+}
@@ -0,0 +1,111 @@
+package backtester
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// GetStrategy returns a built in strategy by name
+func GetStrategy(name string) (Strategy, error) {
+	switch strings.ToLower(name) {
+	case "buyandhold":
+		return &BuyAndHold{}, nil
+	case "smacrossover":
+		return &SMACrossover{Fast: 10, Slow: 30}, nil
+	default:
+		return nil, fmt.Errorf("strategy %s not found", name)
+	}
+}
+
+// BuyAndHold spends all available funds on the first data event and holds
+// the position until the backtest completes
+type BuyAndHold struct {
+	bought bool
+}
+
+// Name returns the strategy name
+func (b *BuyAndHold) Name() string {
+	return "BuyAndHold"
+}
+
+// OnData buys with all available funds on the first data event
+func (b *BuyAndHold) OnData(d DataEvent, p *Portfolio) ([]*order.Submit, error) {
+	if b.bought || d.LatestPrice() <= 0 {
+		return nil, nil
+	}
+	b.bought = true
+	// Leave headroom for the spread, slippage and fees of the fill
+	amount := p.AvailableFunds() / d.LatestPrice() * 0.99
+	return []*order.Submit{{
+		Pair:      p.Pair,
+		OrderType: order.Market,
+		OrderSide: order.Buy,
+		Amount:    amount,
+	}}, nil
+}
+
+// SMACrossover buys when the fast simple moving average crosses above the
+// slow simple moving average and sells its holdings when it crosses below
+type SMACrossover struct {
+	Fast   int
+	Slow   int
+	prices []float64
+	above  bool
+	primed bool
+}
+
+// Name returns the strategy name
+func (s *SMACrossover) Name() string {
+	return fmt.Sprintf("SMACrossover(%d,%d)", s.Fast, s.Slow)
+}
+
+// OnData evaluates the moving averages and returns an order on a crossover
+func (s *SMACrossover) OnData(d DataEvent, p *Portfolio) ([]*order.Submit, error) {
+	if s.Fast <= 0 || s.Slow <= s.Fast {
+		return nil, errors.New("fast period must be greater than zero and less than slow period")
+	}
+	s.prices = append(s.prices, d.LatestPrice())
+	if len(s.prices) > s.Slow {
+		s.prices = s.prices[1:]
+	}
+	if len(s.prices) < s.Slow {
+		return nil, nil
+	}
+
+	above := average(s.prices[s.Slow-s.Fast:]) > average(s.prices)
+	crossed := s.primed && above != s.above
+	s.above = above
+	s.primed = true
+	if !crossed {
+		return nil, nil
+	}
+
+	if above && p.AvailableFunds() > 0 {
+		return []*order.Submit{{
+			Pair:      p.Pair,
+			OrderType: order.Market,
+			OrderSide: order.Buy,
+			Amount:    p.AvailableFunds() / d.LatestPrice() * 0.99,
+		}}, nil
+	}
+	if !above && p.AvailableHoldings() > 0 {
+		return []*order.Submit{{
+			Pair:      p.Pair,
+			OrderType: order.Market,
+			OrderSide: order.Sell,
+			Amount:    p.AvailableHoldings(),
+		}}, nil
+	}
+	return nil, nil
+}
+
+func average(values []float64) float64 {
+	var total float64
+	for x := range values {
+		total += values[x]
+	}
+	return total / float64(len(values))
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/core"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+const dateFormat = "2006-01-02 15:04:05"
+
+var (
+	configFile   string
+	exchangeName string
+	pair         string
+	assetType    string
+	dataFile     string
+	dataType     string
+	useDatabase  bool
+	strategyName string
+	outputFile   string
+	interval     string
+	startDate    string
+	endDate      string
+	funds        float64
+	spread       float64
+	slippage     float64
+	riskFreeRate float64
+	depth        int
+)
+
+func main() {
+	fmt.Println("GoCryptoTrader backtester")
+	fmt.Println(core.Copyright)
+	fmt.Println()
+
+	flag.StringVar(&configFile, "config", config.DefaultFilePath(), "config file to load")
+	flag.StringVar(&exchangeName, "exchange", "Bitstamp", "exchange used to calculate fees and fetch historic data")
+	flag.StringVar(&pair, "pair", "BTC-USD", "currency pair to backtest")
+	flag.StringVar(&assetType, "asset", asset.Spot.String(), "asset type to backtest")
+	flag.StringVar(&dataFile, "datafile", "", "CSV file containing historic candles or trades, data is fetched from the exchange if empty")
+	flag.StringVar(&dataType, "datatype", "candle", "data type contained in the data file or database candle|trade")
+	flag.BoolVar(&useDatabase, "database", false, "load candles or trades between the start and end date from the database in the config file")
+	flag.StringVar(&strategyName, "strategy", "buyandhold", "strategy to backtest buyandhold|smacrossover")
+	flag.StringVar(&outputFile, "output", "", "optional file to write the JSON report to")
+	flag.StringVar(&interval, "interval", kline.OneHour.Short(), "candle interval to fetch from the exchange or database")
+	flag.StringVar(&startDate, "start", time.Now().AddDate(0, 0, -7).UTC().Format(dateFormat), "UTC start date of data to fetch from the exchange or database")
+	flag.StringVar(&endDate, "end", time.Now().UTC().Format(dateFormat), "UTC end date of data to fetch from the exchange or database")
+	flag.Float64Var(&funds, "funds", 10000, "initial quote currency funds")
+	flag.Float64Var(&spread, "spread", backtester.DefaultSpread, "fractional bid/ask spread applied to the data price")
+	flag.Float64Var(&slippage, "slippage", backtester.DefaultSlippage, "fractional price increment between synthetic orderbook levels")
+	flag.Float64Var(&riskFreeRate, "riskfreerate", 0, "annualised risk free rate used to calculate the Sharpe ratio")
+	flag.IntVar(&depth, "depth", backtester.DefaultOrderbookDepth, "synthetic orderbook levels per side")
+	flag.Parse()
+
+	err := run()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	a := asset.Item(strings.ToLower(assetType))
+	if !asset.IsValid(a) {
+		return fmt.Errorf("asset type %s is invalid", assetType)
+	}
+
+	strategy, err := backtester.GetStrategy(strategyName)
+	if err != nil {
+		return err
+	}
+
+	exch, err := loadExchange()
+	if err != nil {
+		return err
+	}
+
+	p := currency.NewPairDelimiter(pair, "-")
+	data, err := loadData(exch, p, a)
+	if err != nil {
+		return err
+	}
+
+	b, err := backtester.New(backtester.Config{
+		Pair:           p,
+		AssetType:      a,
+		InitialFunds:   funds,
+		Spread:         spread,
+		Slippage:       slippage,
+		OrderbookDepth: depth,
+		RiskFreeRate:   riskFreeRate,
+	}, strategy, exch, data)
+	if err != nil {
+		return err
+	}
+
+	report, err := b.Run()
+	if err != nil {
+		return err
+	}
+	report.Print(os.Stdout)
+
+	if outputFile == "" {
+		return nil
+	}
+	output, err := json.MarshalIndent(report, "", " ")
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(outputFile, output, 0644)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("\nReport written to %s\n", outputFile)
+	return nil
+}
+
+func loadExchange() (exchange.IBotExchange, error) {
+	engine.Bot = &engine.Engine{Config: &config.Cfg}
+	err := engine.Bot.Config.LoadConfig(configFile, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config. Err: %s", err)
+	}
+	engine.Bot.Settings = engine.Settings{
+		DisableExchangeAutoPairUpdates: true,
+	}
+
+	err = engine.LoadExchange(exchangeName, false, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load exchange %s. Err: %s", exchangeName, err)
+	}
+	exch := engine.GetExchangeByName(exchangeName)
+	if exch == nil {
+		return nil, fmt.Errorf("exchange %s not loaded", exchangeName)
+	}
+	return exch, nil
+}
+
+func loadData(exch exchange.IBotExchange, p currency.Pair, a asset.Item) ([]backtester.DataEvent, error) {
+	if useDatabase {
+		if dataFile != "" {
+			return nil, errors.New("a data file cannot be used with the database")
+		}
+		return loadDatabaseData(exch.GetName(), p, a)
+	}
+
+	switch strings.ToLower(dataType) {
+	case "candle":
+		if dataFile != "" {
+			candles, err := backtester.LoadCandlesFromCSV(dataFile)
+			if err != nil {
+				return nil, err
+			}
+			return backtester.CandlesToEvents(candles), nil
+		}
+		i, err := kline.ParseInterval(interval)
+		if err != nil {
+			return nil, err
+		}
+		start, end, err := dateRange()
+		if err != nil {
+			return nil, err
+		}
+		candles, err := engine.GetHistoricCandles(exch, p, a, start, end, i)
+		if err != nil {
+			return nil, err
+		}
+		return backtester.CandlesToEvents(candles.Candles), nil
+	case "trade":
+		var trades []exchange.TradeHistory
+		var err error
+		if dataFile == "" {
+			trades, err = exch.GetExchangeHistory(p, a)
+		} else {
+			trades, err = backtester.LoadTradesFromCSV(dataFile, exch.GetName())
+		}
+		if err != nil {
+			return nil, err
+		}
+		return backtester.TradesToEvents(trades), nil
+	default:
+		return nil, fmt.Errorf("data type %s is invalid", dataType)
+	}
+}
+
+// loadDatabaseData loads the candles or trades stored by the candle manager or
+// trade recorder between the start and end date
+func loadDatabaseData(exchName string, p currency.Pair, a asset.Item) ([]backtester.DataEvent, error) {
+	start, end, err := dateRange()
+	if err != nil {
+		return nil, err
+	}
+
+	err = engine.Bot.DatabaseManager.Start()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if errStop := engine.Bot.DatabaseManager.Stop(); errStop != nil {
+			fmt.Printf("failed to stop the database manager. Err: %s\n", errStop)
+		}
+	}()
+
+	switch strings.ToLower(dataType) {
+	case "candle":
+		var i kline.Interval
+		i, err = kline.ParseInterval(interval)
+		if err != nil {
+			return nil, err
+		}
+		var candles []kline.Candle
+		candles, err = backtester.LoadCandlesFromDatabase(exchName, p, a, i, start, end)
+		if err != nil {
+			return nil, err
+		}
+		return backtester.CandlesToEvents(candles), nil
+	case "trade":
+		var trades []exchange.TradeHistory
+		trades, err = backtester.LoadTradesFromDatabase(exchName, p, a, start, end)
+		if err != nil {
+			return nil, err
+		}
+		return backtester.TradesToEvents(trades), nil
+	default:
+		return nil, fmt.Errorf("data type %s is invalid", dataType)
+	}
+}
+
+func dateRange() (start, end time.Time, err error) {
+	start, err = time.Parse(dateFormat, startDate)
+	if err != nil {
+		return
+	}
+	end, err = time.Parse(dateFormat, endDate)
+	if err != nil {
+		return
+	}
+	if !end.After(start) {
+		err = errors.New("end date must be after the start date")
+	}
+	return
+}
@@ -1251,7 +1251,7 @@ func getOrder(c *cli.Context) error {
 var submitOrderCommand = cli.Command{
 	Name:      "submitorder",
 	Usage:     "submit order submits an exchange order",
-	ArgsUsage: "<exchange> <pair> <side> <type> <amount> <price> <client_id>",
+	ArgsUsage: "<exchange> <pair> <side> <type> <amount> <price> <client_id> <asset>",
 	Action:    submitOrder,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -1282,6 +1282,10 @@ var submitOrderCommand = cli.Command{
 			Name:  "client_id",
 			Usage: "the optional client order ID",
 		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the order, defaults to spot",
+		},
 	},
 }
 
@@ -1370,6 +1374,17 @@ func submitOrder(c *cli.Context) error {
 		clientID = c.Args().Get(6)
 	}
 
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(7)
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
 	conn, err := setupClient()
 	if err != nil {
 		return err
@@ -1390,6 +1405,7 @@ func submitOrder(c *cli.Context) error {
 		Amount:    amount,
 		Price:     price,
 		ClientId:  clientID,
+		AssetType: assetType,
 	})
 	if err != nil {
 		return err
@@ -1750,6 +1766,172 @@ func cancelOrder(c *cli.Context) error {
 	return nil
 }
 
+var modifyOrderCommand = cli.Command{
+	Name:      "modifyorder",
+	Usage:     "modifies the price and/or amount of an exchange order",
+	ArgsUsage: "<exchange> <order_id> <pair> <asset> <side> <type> <price> <amount>",
+	Action:    modifyOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to modify the order for",
+		},
+		cli.StringFlag{
+			Name:  "order_id",
+			Usage: "the order id",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair of the order",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side",
+		},
+		cli.StringFlag{
+			Name:  "type",
+			Usage: "the order type",
+		},
+		cli.Float64Flag{
+			Name:  "price",
+			Usage: "the new order price",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the new order amount",
+		},
+	},
+}
+
+func modifyOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "modifyorder")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var orderID string
+	if c.IsSet("order_id") {
+		orderID = c.String("order_id")
+	} else {
+		orderID = c.Args().Get(1)
+	}
+
+	if orderID == "" {
+		return errors.New("an order ID must be set")
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(3)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(4)
+	}
+
+	var orderType string
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(5)
+	}
+
+	var price float64
+	if c.IsSet("price") {
+		price = c.Float64("price")
+	} else if c.Args().Get(6) != "" {
+		var err error
+		price, err = strconv.ParseFloat(c.Args().Get(6), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(7) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(7), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if price <= 0 && amount <= 0 {
+		return errors.New("a new price or amount must be set")
+	}
+
+	// pair is optional, but if it's set, do a validity check
+	var p currency.Pair
+	if len(currencyPair) > 0 {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p = currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ModifyOrder(context.Background(), &gctrpc.ModifyOrderRequest{
+		Exchange: exchangeName,
+		OrderId:  orderID,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType: assetType,
+		Side:      orderSide,
+		OrderType: orderType,
+		Price:     price,
+		Amount:    amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var cancelAllOrdersCommand = cli.Command{
 	Name:      "cancelallorders",
 	Usage:     "cancels all orders (all or by exchange name)",
@@ -1796,6 +1978,54 @@ func cancelAllOrders(c *cli.Context) error {
 	return nil
 }
 
+var setRiskKillSwitchCommand = cli.Command{
+	Name:      "setriskkillswitch",
+	Usage:     "engages or releases the risk manager kill switch, engaging it rejects new orders and cancels all open orders",
+	ArgsUsage: "<engage>",
+	Action:    setRiskKillSwitch,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "engage",
+			Usage: "whether to engage or release the kill switch",
+		},
+	},
+}
+
+func setRiskKillSwitch(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "setriskkillswitch")
+		return nil
+	}
+
+	var engage bool
+	if c.IsSet("engage") {
+		engage = c.Bool("engage")
+	} else {
+		var err error
+		engage, err = strconv.ParseBool(c.Args().First())
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetRiskKillSwitch(context.Background(), &gctrpc.SetRiskKillSwitchRequest{
+		Engage: engage,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var getEventsCommand = cli.Command{
 	Name:   "getevents",
 	Usage:  "gets all events",
@@ -2958,6 +3188,73 @@ const timeFormat = "2006-01-02 15:04:05"
 var startTime, endTime, order string
 var limit int
 
+var getOrderUpdateStreamCommand = cli.Command{
+	Name:      "getorderupdatestream",
+	Usage:     "gets a stream of order updates tracked by the order manager (all or by exchange name)",
+	ArgsUsage: "<exchange>",
+	Action:    getOrderUpdateStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get order updates for",
+		},
+	},
+}
+
+func getOrderUpdateStream(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	// exchange name is an optional param
+	if exchangeName != "" {
+		if !validExchange(exchangeName) {
+			return errInvalidExchange
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderUpdateStream(context.Background(),
+		&gctrpc.GetOrderUpdateStreamRequest{
+			Exchange: exchangeName,
+		})
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Order update for %s order ID %s [Ours: %s]:\n",
+			resp.Order.Exchange,
+			resp.Order.Id,
+			resp.InternalOrderId)
+
+		fmt.Printf("STATUS: %s PREVIOUS: %s PRICE: %f AMOUNT: %f EXECUTED: %f REMAINING: %f FILLED SINCE LAST UPDATE: %f FEE: %f\n",
+			resp.Order.Status,
+			resp.PreviousStatus,
+			resp.Order.Price,
+			resp.Order.Amount,
+			resp.ExecutedAmount,
+			resp.RemainingAmount,
+			resp.ExecutedDelta,
+			resp.Fee)
+	}
+}
+
 var getAuditEventCommand = cli.Command{
 	Name:      "getauditevent",
 	Usage:     "gets audit events matching query parameters",
@@ -3172,17 +3469,131 @@ var gctScriptCommand = cli.Command{
 			},
 			Action: gctScriptAutoload,
 		},
+		{
+			Name:      "state",
+			Usage:     "list values stored by scripts",
+			ArgsUsage: "<name>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "name",
+					Usage:       "<script name> leave empty for all scripts",
+					Destination: &filename,
+				},
+			},
+			Action: gctScriptState,
+		},
+		{
+			Name:      "test",
+			Usage:     "test scripts against a fixture of exchange data",
+			ArgsUsage: "<fixture> <name> [name...]",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixture",
+					Usage: "<path> to a local JSON fixture of tickers, orderbooks and balances",
+				},
+				cli.StringFlag{
+					Name:        "path",
+					Usage:       "<script path> leave empty for the default script path",
+					Destination: &path,
+				},
+			},
+			Action: gctScriptTest,
+		},
 	},
 }
 
-func gctScriptAutoload(c *cli.Context) error {
+func gctScriptTest(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		_ = cli.ShowSubcommandHelp(c)
 		return nil
 	}
 
-	var command, script string
-	var status bool
+	fixturePath := c.String("fixture")
+	scripts := c.Args()
+	if !c.IsSet("fixture") && len(scripts) > 0 {
+		fixturePath = scripts[0]
+		scripts = scripts[1:]
+	}
+	if len(scripts) == 0 {
+		return errors.New("at least one script name must be specified")
+	}
+
+	var fixture []byte
+	if fixturePath != "" {
+		var err error
+		fixture, err = ioutil.ReadFile(fixturePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.GCTScriptTest(context.Background(),
+		&gctrpc.GCTScriptTestRequest{
+			Scripts: scripts,
+			Path:    path,
+			Fixture: fixture,
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+
+	var failed int
+	for x := range result.Results {
+		if !result.Results[x].Passed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d scripts failed", failed, len(result.Results))
+	}
+	return nil
+}
+
+func gctScriptState(c *cli.Context) error {
+	if !c.IsSet("name") {
+		if c.Args().Get(0) != "" {
+			filename = c.Args().Get(0)
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	executeCommand, err := client.GCTScriptState(context.Background(),
+		&gctrpc.GCTScriptStateRequest{
+			Script: filename,
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(executeCommand)
+	return nil
+}
+
+func gctScriptAutoload(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	var command, script string
+	var status bool
 	if !c.IsSet("command") {
 		if c.Args().Get(0) != "" {
 			command = c.Args().Get(0)
@@ -3495,11 +3906,11 @@ func gctScriptUpload(c *cli.Context) error {
 	return nil
 }
 
-var candleRangeSize, candleGranularity int64
+var candleInterval string
 var getHistoricCandlesCommand = cli.Command{
 	Name:      "gethistoriccandles",
-	Usage:     "gets historical candles for the specified granularity up to range size time from now.",
-	ArgsUsage: "<exchange> <pair> <rangesize> <granularity>",
+	Usage:     "gets historical candles for the specified interval between a start and end date",
+	ArgsUsage: "<exchange> <pair> <asset> <interval> <start> <end>",
 	Action:    getHistoricCandles,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -3510,17 +3921,28 @@ var getHistoricCandlesCommand = cli.Command{
 			Name:  "pair",
 			Usage: "the currency pair to get the candles for",
 		},
-		cli.Int64Flag{
-			Name:        "rangesize, r",
-			Usage:       "the amount of time to go back from now to fetch candles in the given granularity",
-			Value:       10,
-			Destination: &candleRangeSize,
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
 		},
-		cli.Int64Flag{
-			Name:        "granularity, g",
-			Usage:       "value is in seconds and can be one of the following {60, 300, 900, 3600, 21600, 86400}",
-			Value:       86400,
-			Destination: &candleGranularity,
+		cli.StringFlag{
+			Name:        "interval, i",
+			Usage:       "the candle interval, can be one of the following {1m, 5m, 15m, 1h, 4h, 1d, 1w}",
+			Value:       "1d",
+			Destination: &candleInterval,
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "the date to begin retrieving candles from",
+			Value:       time.Now().AddDate(0, 0, -30).Format(timeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "the date to end retrieving candles",
+			Value:       time.Now().Format(timeFormat),
+			Destination: &endTime,
 		},
 	},
 }
@@ -3552,24 +3974,43 @@ func getHistoricCandles(c *cli.Context) error {
 	}
 	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
 
-	if c.IsSet("rangesize") {
-		candleRangeSize = c.Int64("rangesize")
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
 	} else if c.Args().Get(2) != "" {
-		var err error
-		candleRangeSize, err = strconv.ParseInt(c.Args().Get(2), 10, 64)
-		if err != nil {
-			return err
-		}
+		assetType = c.Args().Get(2)
+	} else {
+		assetType = c.String("asset")
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
 	}
 
-	if c.IsSet("granularity") {
-		candleGranularity = c.Int64("granularity")
-	} else if c.Args().Get(3) != "" {
-		var err error
-		candleGranularity, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
-		if err != nil {
-			return err
-		}
+	if !c.IsSet("interval") && c.Args().Get(3) != "" {
+		candleInterval = c.Args().Get(3)
+	}
+
+	if !c.IsSet("start") && c.Args().Get(4) != "" {
+		startTime = c.Args().Get(4)
+	}
+
+	if !c.IsSet("end") && c.Args().Get(5) != "" {
+		endTime = c.Args().Get(5)
+	}
+
+	s, err := time.ParseInLocation(timeFormat, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.ParseInLocation(timeFormat, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
 	}
 
 	conn, err := setupClient()
@@ -3587,8 +4028,219 @@ func getHistoricCandles(c *cli.Context) error {
 				Base:      p.Base.String(),
 				Quote:     p.Quote.String(),
 			},
-			Rangesize:   candleRangeSize,
-			Granularity: candleGranularity,
+			AssetType: assetType,
+			Start:     s.UTC().Format(timeFormat),
+			End:       e.UTC().Format(timeFormat),
+			Interval:  candleInterval,
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var candleJobID string
+
+func candleSeriesFlags(action string) []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange, e",
+			Usage: "the exchange to " + action + " candles for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to " + action + " candles for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+		cli.StringFlag{
+			Name:        "interval, i",
+			Usage:       "the candle interval, can be one of the following {1m, 5m, 15m, 1h, 4h, 1d, 1w}",
+			Value:       "1d",
+			Destination: &candleInterval,
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "the date of the first candle",
+			Value:       time.Now().AddDate(0, 0, -30).Format(timeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end",
+			Usage:       "the date candles end",
+			Value:       time.Now().Format(timeFormat),
+			Destination: &endTime,
+		},
+	}
+}
+
+var candlesCommand = cli.Command{
+	Name:      "candles",
+	Usage:     "manages historic candles stored in the database",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "startjob",
+			Usage:     "downloads and stores any candles missing from the database between a start and end date",
+			ArgsUsage: "<exchange> <pair> <asset> <interval> <start> <end>",
+			Flags:     candleSeriesFlags("download"),
+			Action:    startCandleJob,
+		},
+		{
+			Name:      "status",
+			Usage:     "gets the status of a candle job, or all candle jobs if no id is supplied",
+			ArgsUsage: "<id>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "id",
+					Usage:       "the candle job id",
+					Destination: &candleJobID,
+				},
+			},
+			Action: getCandleJobs,
+		},
+		{
+			Name:      "coverage",
+			Usage:     "lists the date ranges of stored and missing candles",
+			ArgsUsage: "<exchange> <pair> <asset> <interval> <start> <end>",
+			Flags:     candleSeriesFlags("check"),
+			Action:    getCandleCoverage,
+		},
+	},
+}
+
+type candleSeriesArgs struct {
+	exchange  string
+	pair      currency.Pair
+	assetType string
+	start     time.Time
+	end       time.Time
+}
+
+func parseCandleSeriesArgs(c *cli.Context) (*candleSeriesArgs, error) {
+	var args candleSeriesArgs
+	if c.IsSet("exchange") {
+		args.exchange = c.String("exchange")
+	} else {
+		args.exchange = c.Args().First()
+	}
+	if !validExchange(args.exchange) {
+		return nil, errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return nil, errInvalidPair
+	}
+	args.pair = currency.NewPairDelimiter(currencyPair, pairDelimiter)
+
+	if c.IsSet("asset") {
+		args.assetType = c.String("asset")
+	} else if c.Args().Get(2) != "" {
+		args.assetType = c.Args().Get(2)
+	} else {
+		args.assetType = c.String("asset")
+	}
+	args.assetType = strings.ToLower(args.assetType)
+	if !validAsset(args.assetType) {
+		return nil, errInvalidAsset
+	}
+
+	if !c.IsSet("interval") && c.Args().Get(3) != "" {
+		candleInterval = c.Args().Get(3)
+	}
+
+	if !c.IsSet("start") && c.Args().Get(4) != "" {
+		startTime = c.Args().Get(4)
+	}
+
+	if !c.IsSet("end") && c.Args().Get(5) != "" {
+		endTime = c.Args().Get(5)
+	}
+
+	var err error
+	args.start, err = time.ParseInLocation(timeFormat, startTime, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	args.end, err = time.ParseInLocation(timeFormat, endTime, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if args.end.Before(args.start) {
+		return nil, errors.New("start cannot be after end")
+	}
+	return &args, nil
+}
+
+func startCandleJob(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	args, err := parseCandleSeriesArgs(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.StartCandleJob(context.Background(),
+		&gctrpc.StartCandleJobRequest{
+			Exchange: args.exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: args.pair.Delimiter,
+				Base:      args.pair.Base.String(),
+				Quote:     args.pair.Quote.String(),
+			},
+			AssetType: args.assetType,
+			Start:     args.start.UTC().Format(timeFormat),
+			End:       args.end.UTC().Format(timeFormat),
+			Interval:  candleInterval,
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getCandleJobs(c *cli.Context) error {
+	if !c.IsSet("id") && c.Args().First() != "" {
+		candleJobID = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetCandleJobs(context.Background(),
+		&gctrpc.GetCandleJobsRequest{
+			Id: candleJobID,
 		})
 
 	if err != nil {
@@ -3598,3 +4250,2018 @@ func getHistoricCandles(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+func getCandleCoverage(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	args, err := parseCandleSeriesArgs(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetCandleCoverage(context.Background(),
+		&gctrpc.GetCandleCoverageRequest{
+			Exchange: args.exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: args.pair.Delimiter,
+				Base:      args.pair.Base.String(),
+				Quote:     args.pair.Quote.String(),
+			},
+			AssetType: args.assetType,
+			Start:     args.start.UTC().Format(timeFormat),
+			End:       args.end.UTC().Format(timeFormat),
+			Interval:  candleInterval,
+		})
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var conditionalOrderCommand = cli.Command{
+	Name:      "conditionalorder",
+	Usage:     "manages stop, take profit, trailing stop, OCO and bracket orders held by the engine until triggered",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "add",
+			Usage:     "adds a stop, take profit or trailing stop order",
+			ArgsUsage: "<exchange> <pair> <asset> <side> <type> <trigger_price> <amount>",
+			Flags: append(conditionalOrderFlags(),
+				cli.StringFlag{
+					Name:  "type",
+					Usage: "the conditional order type: stop, take_profit or trailingstop",
+				},
+				cli.Float64Flag{
+					Name:  "trigger_price",
+					Usage: "the price which triggers the order",
+				},
+				cli.Float64Flag{
+					Name:  "limit_price",
+					Usage: "submits a limit order at this price once triggered instead of a market order",
+				},
+				cli.Float64Flag{
+					Name:  "trailing_distance",
+					Usage: "the price distance a trailing stop follows the market by",
+				},
+				cli.Float64Flag{
+					Name:  "trailing_percent",
+					Usage: "the percentage a trailing stop follows the market by",
+				},
+			),
+			Action: addConditionalOrder,
+		},
+		{
+			Name:      "addoco",
+			Usage:     "adds a take profit and stop order where triggering either cancels the other",
+			ArgsUsage: "<exchange> <pair> <asset> <side> <amount> <take_profit_price> <stop_loss_price>",
+			Flags: append(conditionalOrderFlags(),
+				cli.Float64Flag{
+					Name:  "take_profit_price",
+					Usage: "the take profit trigger price",
+				},
+				cli.Float64Flag{
+					Name:  "stop_loss_price",
+					Usage: "the stop loss trigger price",
+				},
+				cli.Float64Flag{
+					Name:  "take_profit_limit_price",
+					Usage: "submits the take profit as a limit order at this price",
+				},
+				cli.Float64Flag{
+					Name:  "stop_loss_limit_price",
+					Usage: "submits the stop loss as a limit order at this price",
+				},
+			),
+			Action: addOCOOrder,
+		},
+		{
+			Name:      "addbracket",
+			Usage:     "submits an entry order with take profit and stop loss orders which are watched once the entry fills",
+			ArgsUsage: "<exchange> <pair> <asset> <side> <entry_type> <entry_price> <amount> <take_profit_price> <stop_loss_price>",
+			Flags: append(conditionalOrderFlags(),
+				cli.StringFlag{
+					Name:  "entry_type",
+					Usage: "the entry order type: limit, market or stop",
+				},
+				cli.Float64Flag{
+					Name:  "entry_price",
+					Usage: "the limit price or stop trigger price of the entry order",
+				},
+				cli.Float64Flag{
+					Name:  "take_profit_price",
+					Usage: "the take profit trigger price",
+				},
+				cli.Float64Flag{
+					Name:  "stop_loss_price",
+					Usage: "the stop loss trigger price",
+				},
+			),
+			Action: addBracketOrder,
+		},
+		{
+			Name:      "get",
+			Usage:     "gets the conditional orders tracked by the engine",
+			ArgsUsage: "<exchange>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to get conditional orders for",
+				},
+			},
+			Action: getConditionalOrders,
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels a pending conditional order along with any bracket orders which depend on it",
+			ArgsUsage: "<id>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "id",
+					Usage: "the conditional order id",
+				},
+			},
+			Action: cancelConditionalOrder,
+		},
+	},
+}
+
+func conditionalOrderFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the order to",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type",
+			Value: "spot",
+		},
+		cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the order amount",
+		},
+	}
+}
+
+type conditionalOrderArgs struct {
+	exchange  string
+	pair      *gctrpc.CurrencyPair
+	assetType string
+	side      string
+}
+
+func parseConditionalOrderArgs(c *cli.Context) (*conditionalOrderArgs, error) {
+	var args conditionalOrderArgs
+	if c.IsSet("exchange") {
+		args.exchange = c.String("exchange")
+	} else {
+		args.exchange = c.Args().First()
+	}
+	if !validExchange(args.exchange) {
+		return nil, errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return nil, errInvalidPair
+	}
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	args.pair = &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}
+
+	if c.IsSet("asset") {
+		args.assetType = c.String("asset")
+	} else if c.Args().Get(2) != "" {
+		args.assetType = c.Args().Get(2)
+	} else {
+		args.assetType = c.String("asset")
+	}
+	args.assetType = strings.ToLower(args.assetType)
+	if !validAsset(args.assetType) {
+		return nil, errInvalidAsset
+	}
+
+	if c.IsSet("side") {
+		args.side = c.String("side")
+	} else {
+		args.side = c.Args().Get(3)
+	}
+	if args.side == "" {
+		return nil, errors.New("order side must be set")
+	}
+	return &args, nil
+}
+
+// conditionalFloat returns the value of a float flag, falling back to the
+// positional argument at the supplied index
+func conditionalFloat(c *cli.Context, name string, index int) (float64, error) {
+	if c.IsSet(name) || c.Args().Get(index) == "" {
+		return c.Float64(name), nil
+	}
+	v, err := strconv.ParseFloat(c.Args().Get(index), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return v, nil
+}
+
+func addConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	args, err := parseConditionalOrderArgs(c)
+	if err != nil {
+		return err
+	}
+
+	var orderType string
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(4)
+	}
+
+	triggerPrice, err := conditionalFloat(c, "trigger_price", 5)
+	if err != nil {
+		return err
+	}
+	amount, err := conditionalFloat(c, "amount", 6)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddConditionalOrder(context.Background(),
+		&gctrpc.AddConditionalOrderRequest{
+			Exchange:         args.exchange,
+			Pair:             args.pair,
+			AssetType:        args.assetType,
+			Side:             args.side,
+			OrderType:        orderType,
+			TriggerPrice:     triggerPrice,
+			LimitPrice:       c.Float64("limit_price"),
+			Amount:           amount,
+			TrailingDistance: c.Float64("trailing_distance"),
+			TrailingPercent:  c.Float64("trailing_percent"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func addOCOOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	args, err := parseConditionalOrderArgs(c)
+	if err != nil {
+		return err
+	}
+
+	amount, err := conditionalFloat(c, "amount", 4)
+	if err != nil {
+		return err
+	}
+	takeProfitPrice, err := conditionalFloat(c, "take_profit_price", 5)
+	if err != nil {
+		return err
+	}
+	stopLossPrice, err := conditionalFloat(c, "stop_loss_price", 6)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddOCOOrder(context.Background(),
+		&gctrpc.AddOCOOrderRequest{
+			First: &gctrpc.AddConditionalOrderRequest{
+				Exchange:     args.exchange,
+				Pair:         args.pair,
+				AssetType:    args.assetType,
+				Side:         args.side,
+				OrderType:    "TAKE_PROFIT",
+				TriggerPrice: takeProfitPrice,
+				LimitPrice:   c.Float64("take_profit_limit_price"),
+				Amount:       amount,
+			},
+			Second: &gctrpc.AddConditionalOrderRequest{
+				Exchange:     args.exchange,
+				Pair:         args.pair,
+				AssetType:    args.assetType,
+				Side:         args.side,
+				OrderType:    "STOP",
+				TriggerPrice: stopLossPrice,
+				LimitPrice:   c.Float64("stop_loss_limit_price"),
+				Amount:       amount,
+			},
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func addBracketOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	args, err := parseConditionalOrderArgs(c)
+	if err != nil {
+		return err
+	}
+
+	var entryType string
+	if c.IsSet("entry_type") {
+		entryType = c.String("entry_type")
+	} else {
+		entryType = c.Args().Get(4)
+	}
+
+	entryPrice, err := conditionalFloat(c, "entry_price", 5)
+	if err != nil {
+		return err
+	}
+	amount, err := conditionalFloat(c, "amount", 6)
+	if err != nil {
+		return err
+	}
+	takeProfitPrice, err := conditionalFloat(c, "take_profit_price", 7)
+	if err != nil {
+		return err
+	}
+	stopLossPrice, err := conditionalFloat(c, "stop_loss_price", 8)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddBracketOrder(context.Background(),
+		&gctrpc.AddBracketOrderRequest{
+			Exchange:        args.exchange,
+			Pair:            args.pair,
+			AssetType:       args.assetType,
+			Side:            args.side,
+			EntryType:       entryType,
+			EntryPrice:      entryPrice,
+			Amount:          amount,
+			TakeProfitPrice: takeProfitPrice,
+			StopLossPrice:   stopLossPrice,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getConditionalOrders(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetConditionalOrders(context.Background(),
+		&gctrpc.GetConditionalOrdersRequest{
+			Exchange: exchangeName,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func cancelConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	if id == "" {
+		return errors.New("a conditional order ID must be set")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelConditionalOrder(context.Background(),
+		&gctrpc.CancelConditionalOrderRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var executionCommand = cli.Command{
+	Name:      "execution",
+	Usage:     "works large orders with the TWAP, VWAP and iceberg execution algorithms",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "start",
+			Usage:     "starts working a parent order with an execution algorithm",
+			ArgsUsage: "<algorithm> <exchange> <pair> <asset> <side> <amount>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "algorithm",
+					Usage: "the execution algorithm: twap, vwap or iceberg",
+				},
+				cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to submit child orders to",
+				},
+				cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair",
+				},
+				cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type",
+					Value: "spot",
+				},
+				cli.StringFlag{
+					Name:  "side",
+					Usage: "the order side",
+				},
+				cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the total amount of the parent order",
+				},
+				cli.Float64Flag{
+					Name:  "limit_price",
+					Usage: "submits child orders as limit orders at this price, required for iceberg orders",
+				},
+				cli.StringFlag{
+					Name:  "start",
+					Usage: "the start of the TWAP or VWAP execution window, defaults to now",
+				},
+				cli.StringFlag{
+					Name:  "end",
+					Usage: "the end of the TWAP or VWAP execution window",
+				},
+				cli.Int64Flag{
+					Name:  "slices",
+					Usage: "the number of TWAP child orders",
+				},
+				cli.StringFlag{
+					Name:  "interval",
+					Usage: "the VWAP slice interval",
+					Value: "1h",
+				},
+				cli.Int64Flag{
+					Name:  "profile_days",
+					Usage: "the number of days of candles the VWAP volume profile is built from",
+				},
+				cli.Float64Flag{
+					Name:  "visible_amount",
+					Usage: "the amount of each iceberg child order",
+				},
+			},
+			Action: startExecution,
+		},
+		{
+			Name:      "pause",
+			Usage:     "stops an execution from submitting child orders",
+			ArgsUsage: "<id>",
+			Flags:     executionIDFlags(),
+			Action:    pauseExecution,
+		},
+		{
+			Name:      "resume",
+			Usage:     "restarts a paused execution",
+			ArgsUsage: "<id>",
+			Flags:     executionIDFlags(),
+			Action:    resumeExecution,
+		},
+		{
+			Name:      "cancel",
+			Usage:     "stops an execution and cancels its open child orders",
+			ArgsUsage: "<id>",
+			Flags:     executionIDFlags(),
+			Action:    cancelExecution,
+		},
+		{
+			Name:      "get",
+			Usage:     "gets an execution by id or all executions, filterable by exchange",
+			ArgsUsage: "<id>",
+			Flags: append(executionIDFlags(),
+				cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to get executions for",
+				},
+			),
+			Action: getExecutions,
+		},
+	},
+}
+
+func executionIDFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the execution id",
+		},
+	}
+}
+
+func startExecution(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	var algorithm string
+	if c.IsSet("algorithm") {
+		algorithm = c.String("algorithm")
+	} else {
+		algorithm = c.Args().First()
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(1)
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else if c.Args().Get(3) != "" {
+		assetType = c.Args().Get(3)
+	} else {
+		assetType = c.String("asset")
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(4)
+	}
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(5) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var start, end string
+	if c.IsSet("start") {
+		s, err := time.ParseInLocation(timeFormat, c.String("start"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for start: %v", err)
+		}
+		start = s.UTC().Format(timeFormat)
+	}
+	if c.IsSet("end") {
+		e, err := time.ParseInLocation(timeFormat, c.String("end"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for end: %v", err)
+		}
+		end = e.UTC().Format(timeFormat)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.StartExecution(context.Background(),
+		&gctrpc.StartExecutionRequest{
+			Algorithm: algorithm,
+			Exchange:  exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:     assetType,
+			Side:          orderSide,
+			Amount:        amount,
+			LimitPrice:    c.Float64("limit_price"),
+			Start:         start,
+			End:           end,
+			Slices:        c.Int64("slices"),
+			Interval:      c.String("interval"),
+			ProfileDays:   c.Int64("profile_days"),
+			VisibleAmount: c.Float64("visible_amount"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func executionID(c *cli.Context) (string, error) {
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return "", errors.New("an execution ID must be set")
+	}
+	return id, nil
+}
+
+func pauseExecution(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	id, err := executionID(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.PauseExecution(context.Background(),
+		&gctrpc.ExecutionIDRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func resumeExecution(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	id, err := executionID(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ResumeExecution(context.Background(),
+		&gctrpc.ExecutionIDRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func cancelExecution(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	id, err := executionID(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelExecution(context.Background(),
+		&gctrpc.ExecutionIDRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getExecutions(c *cli.Context) error {
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	exchangeName := c.String("exchange")
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExecutions(context.Background(),
+		&gctrpc.GetExecutionsRequest{
+			Exchange: exchangeName,
+			Id:       id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var arbitrageCommand = cli.Command{
+	Name:      "arbitrage",
+	Usage:     "gets cross exchange arbitrage opportunities found by the arbitrage manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "opportunities",
+			Usage:     "gets the open arbitrage opportunities (all or by currency pair)",
+			ArgsUsage: "<pair>",
+			Flags:     arbitragePairFlags(),
+			Action:    getArbitrageOpportunities,
+		},
+		{
+			Name:      "stream",
+			Usage:     "gets a stream of arbitrage opportunities as they open or change (all or by currency pair)",
+			ArgsUsage: "<pair>",
+			Flags:     arbitragePairFlags(),
+			Action:    getArbitrageStream,
+		},
+		{
+			Name:      "triangular",
+			Usage:     "gets the triangular arbitrage cycles between an exchange's currency pairs",
+			ArgsUsage: "<exchange> <min_return>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to scan",
+				},
+				cli.Float64Flag{
+					Name:  "min_return",
+					Usage: "the net return percentage after taker fees a cycle must exceed, defaults to the configured minimum",
+				},
+			},
+			Action: getTriangularArbitrage,
+		},
+	},
+}
+
+func arbitragePairFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get opportunities for",
+		},
+	}
+}
+
+// arbitragePair returns the optional currency pair filter
+func arbitragePair(c *cli.Context) (*gctrpc.CurrencyPair, error) {
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().First()
+	}
+
+	if pair == "" {
+		return nil, nil
+	}
+	if !validPair(pair) {
+		return nil, errInvalidPair
+	}
+	p := currency.NewPairDelimiter(pair, pairDelimiter)
+	return &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, nil
+}
+
+func getArbitrageOpportunities(c *cli.Context) error {
+	p, err := arbitragePair(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetArbitrageOpportunities(context.Background(),
+		&gctrpc.GetArbitrageOpportunitiesRequest{
+			Pair: p,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getArbitrageStream(c *cli.Context) error {
+	p, err := arbitragePair(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetArbitrageStream(context.Background(),
+		&gctrpc.GetArbitrageStreamRequest{
+			Pair: p,
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s-%s BUY %f on %s at %f SELL on %s at %f NET PROFIT: %f (%.4f%%) FEES: %f WITHDRAWAL FEES: %f\n",
+			resp.Pair.Base,
+			resp.Pair.Quote,
+			resp.Amount,
+			resp.BuyExchange,
+			resp.BuyPrice,
+			resp.SellExchange,
+			resp.SellPrice,
+			resp.NetProfit,
+			resp.NetProfitPercent,
+			resp.TradingFees,
+			resp.WithdrawalFees)
+	}
+}
+
+func getTriangularArbitrage(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	minReturn := c.Float64("min_return")
+	if !c.IsSet("min_return") && c.Args().Get(1) != "" {
+		var err error
+		minReturn, err = strconv.ParseFloat(c.Args().Get(1), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTriangularArbitrage(context.Background(),
+		&gctrpc.GetTriangularArbitrageRequest{
+			Exchange:         exchangeName,
+			MinReturnPercent: minReturn,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var routeCommand = cli.Command{
+	Name:      "route",
+	Usage:     "splits orders across exchanges by the best fee adjusted liquidity",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "order",
+			Usage:     "routes an order across the enabled exchanges which support the currency pair",
+			ArgsUsage: "<pair> <side> <type> <amount> <price>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair",
+				},
+				cli.StringFlag{
+					Name:  "side",
+					Usage: "the order side",
+				},
+				cli.StringFlag{
+					Name:  "type",
+					Usage: "the order type: market or limit",
+				},
+				cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the total amount of the order",
+				},
+				cli.Float64Flag{
+					Name:  "price",
+					Usage: "the limit price, required for limit orders",
+				},
+				cli.StringFlag{
+					Name:  "exchanges",
+					Usage: "comma separated exchanges to route to, defaults to every enabled exchange",
+				},
+				cli.BoolFlag{
+					Name:  "dry_run",
+					Usage: "returns the allocation without submitting any orders",
+				},
+			},
+			Action: routeOrder,
+		},
+		{
+			Name:      "get",
+			Usage:     "gets routed orders (all or by id) and the fill of their child orders",
+			ArgsUsage: "<id>",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "id",
+					Usage: "the routed order id",
+				},
+			},
+			Action: getRoutes,
+		},
+	},
+}
+
+func routeOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().First()
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(1)
+	}
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	var orderType string
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(2)
+	}
+	if orderType == "" {
+		return errors.New("order type must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	// price is optional for market orders
+	var price float64
+	if c.IsSet("price") {
+		price = c.Float64("price")
+	} else if c.Args().Get(4) != "" {
+		var err error
+		price, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var exchanges []string
+	if c.IsSet("exchanges") {
+		exchanges = strings.Split(c.String("exchanges"), ",")
+		for x := range exchanges {
+			if !validExchange(exchanges[x]) {
+				return errInvalidExchange
+			}
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.RouteOrder(context.Background(),
+		&gctrpc.RouteOrderRequest{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Side:      strings.ToUpper(orderSide),
+			OrderType: strings.ToUpper(orderType),
+			Amount:    amount,
+			Price:     price,
+			Exchanges: exchanges,
+			DryRun:    c.Bool("dry_run"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getRoutes(c *cli.Context) error {
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetRoutes(context.Background(),
+		&gctrpc.GetRoutesRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func aggregatedOrderbookFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		cli.BoolFlag{
+			Name:  "convert",
+			Usage: "includes pairs quoted in other fiat currencies converted into the pair's quote currency",
+		},
+		cli.Int64Flag{
+			Name:  "depth",
+			Usage: "the number of levels returned for each side",
+			Value: 50,
+		},
+	}
+}
+
+var getAggregatedOrderbookCommand = cli.Command{
+	Name:      "getaggregatedorderbook",
+	Usage:     "gets the orderbook of a currency pair merged across every enabled exchange",
+	ArgsUsage: "<pair>",
+	Action:    getAggregatedOrderbook,
+	Flags:     aggregatedOrderbookFlags(),
+}
+
+// aggregatedOrderbookRequest returns the aggregated orderbook request from the
+// command's flags and arguments
+func aggregatedOrderbookRequest(c *cli.Context) (*gctrpc.GetAggregatedOrderbookRequest, error) {
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().First()
+	}
+	if !validPair(pair) {
+		return nil, errInvalidPair
+	}
+	p := currency.NewPairDelimiter(pair, pairDelimiter)
+	return &gctrpc.GetAggregatedOrderbookRequest{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Convert: c.Bool("convert"),
+		Depth:   c.Int64("depth"),
+	}, nil
+}
+
+func getAggregatedOrderbook(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getaggregatedorderbook")
+		return nil
+	}
+
+	req, err := aggregatedOrderbookRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetAggregatedOrderbook(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getAggregatedOrderbookStreamCommand = cli.Command{
+	Name:      "getaggregatedorderbookstream",
+	Usage:     "gets a stream of the orderbook of a currency pair merged across every enabled exchange",
+	ArgsUsage: "<pair>",
+	Action:    getAggregatedOrderbookStream,
+	Flags:     aggregatedOrderbookFlags(),
+}
+
+func getAggregatedOrderbookStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getaggregatedorderbookstream")
+		return nil
+	}
+
+	req, err := aggregatedOrderbookRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetAggregatedOrderbookStream(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Aggregated orderbook stream for %s across %s:\n\n",
+			resp.Pair.String(), strings.Join(resp.Exchanges, ", "))
+		fmt.Println("\t\tBids\t\t\t\t\t\tAsks")
+		fmt.Println()
+
+		maxLen := len(resp.Bids)
+		if len(resp.Asks) > maxLen {
+			maxLen = len(resp.Asks)
+		}
+
+		for i := 0; i < maxLen; i++ {
+			var bid, ask string
+			if i < len(resp.Bids) {
+				bid = fmt.Sprintf("%f %s @ %f %s (%s)",
+					resp.Bids[i].Amount,
+					resp.Pair.Base,
+					resp.Bids[i].Price,
+					resp.Pair.Quote,
+					resp.Bids[i].Exchange)
+			}
+			if i < len(resp.Asks) {
+				ask = fmt.Sprintf("%f %s @ %f %s (%s)",
+					resp.Asks[i].Amount,
+					resp.Pair.Base,
+					resp.Asks[i].Price,
+					resp.Pair.Quote,
+					resp.Asks[i].Exchange)
+			}
+			fmt.Printf("%s\t\t%s\n", bid, ask)
+		}
+	}
+}
+
+var positionCommand = cli.Command{
+	Name:      "position",
+	Usage:     "manages futures and perpetual contract positions",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []cli.Command{
+		{
+			Name:      "get",
+			Usage:     "gets the open positions of an exchange's asset type",
+			ArgsUsage: "<exchange> <asset> <pair>",
+			Flags: append(positionFlags(),
+				cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair to filter positions by",
+				}),
+			Action: getPositions,
+		},
+		{
+			Name:      "setleverage",
+			Usage:     "sets the leverage of a currency pair's positions",
+			ArgsUsage: "<exchange> <asset> <pair> <leverage>",
+			Flags: append(positionPairFlags(),
+				cli.Float64Flag{
+					Name:  "leverage",
+					Usage: "the leverage to set",
+				}),
+			Action: setLeverage,
+		},
+		{
+			Name:      "setmarginmode",
+			Usage:     "sets the margin mode of a currency pair's positions",
+			ArgsUsage: "<exchange> <asset> <pair> <mode>",
+			Flags: append(positionPairFlags(),
+				cli.StringFlag{
+					Name:  "mode",
+					Usage: "the margin mode: cross or isolated",
+				}),
+			Action: setMarginMode,
+		},
+		{
+			Name:      "fundingrates",
+			Usage:     "gets the funding rate history of a perpetual contract",
+			ArgsUsage: "<exchange> <asset> <pair>",
+			Flags:     positionPairFlags(),
+			Action:    getFundingRates,
+		},
+		{
+			Name:      "close",
+			Usage:     "closes a position at market price",
+			ArgsUsage: "<exchange> <asset> <pair> <side>",
+			Flags: append(positionPairFlags(),
+				cli.StringFlag{
+					Name:  "side",
+					Usage: "the position side: long or short, only required when both sides are open",
+				}),
+			Action: closePosition,
+		},
+	},
+}
+
+func positionFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type, such as futures, perpetualswap or perpetualcontract",
+		},
+	}
+}
+
+func positionPairFlags() []cli.Flag {
+	return append(positionFlags(),
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		})
+}
+
+// positionRequest returns the exchange, asset type and currency pair of a
+// position subcommand, the pair is only validated when required
+func positionRequest(c *cli.Context, pairRequired bool) (string, string, *gctrpc.CurrencyPair, error) {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return "", "", nil, errInvalidExchange
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return "", "", nil, errInvalidAsset
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+	if currencyPair == "" && !pairRequired {
+		return exchangeName, assetType, nil, nil
+	}
+	if !validPair(currencyPair) {
+		return "", "", nil, errInvalidPair
+	}
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	return exchangeName, assetType, &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, nil
+}
+
+func getPositions(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	exchangeName, assetType, pair, err := positionRequest(c, false)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetPositions(context.Background(),
+		&gctrpc.GetPositionsRequest{
+			Exchange:  exchangeName,
+			AssetType: assetType,
+			Pair:      pair,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func setLeverage(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	exchangeName, assetType, pair, err := positionRequest(c, true)
+	if err != nil {
+		return err
+	}
+
+	var leverage float64
+	if c.IsSet("leverage") {
+		leverage = c.Float64("leverage")
+	} else if c.Args().Get(3) != "" {
+		leverage, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if leverage <= 0 {
+		return errors.New("leverage must be greater than zero")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetLeverage(context.Background(),
+		&gctrpc.SetLeverageRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+			Leverage:  leverage,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func setMarginMode(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	exchangeName, assetType, pair, err := positionRequest(c, true)
+	if err != nil {
+		return err
+	}
+
+	var mode string
+	if c.IsSet("mode") {
+		mode = c.String("mode")
+	} else {
+		mode = c.Args().Get(3)
+	}
+	if mode == "" {
+		return errors.New("margin mode must be set")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetMarginMode(context.Background(),
+		&gctrpc.SetMarginModeRequest{
+			Exchange:   exchangeName,
+			Pair:       pair,
+			AssetType:  assetType,
+			MarginMode: mode,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getFundingRates(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	exchangeName, assetType, pair, err := positionRequest(c, true)
+	if err != nil {
+		return err
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFundingRates(context.Background(),
+		&gctrpc.GetFundingRatesRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func closePosition(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowSubcommandHelp(c)
+		return nil
+	}
+
+	exchangeName, assetType, pair, err := positionRequest(c, true)
+	if err != nil {
+		return err
+	}
+
+	var side string
+	if c.IsSet("side") {
+		side = c.String("side")
+	} else {
+		side = c.Args().Get(3)
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ClosePosition(context.Background(),
+		&gctrpc.ClosePositionRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+			Side:      side,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getTradingRulesCommand = cli.Command{
+	Name:      "gettradingrules",
+	Usage:     "gets the price tick, amount step and order size limits of a currency pair",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getTradingRules,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the trading rules for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the trading rules for",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair, defaults to spot",
+		},
+	},
+}
+
+func getTradingRules(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(c, "gettradingrules")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTradingRules(context.Background(),
+		&gctrpc.GetTradingRulesRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var submitOrdersCommand = cli.Command{
+	Name:      "submitorders",
+	Usage:     "submits a batch of orders for a currency pair to an exchange together",
+	ArgsUsage: "<exchange> <pair> <type> <orders>",
+	Action:    submitOrders,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the orders for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "type",
+			Usage: "the order type of the orders (MARKET OR LIMIT)",
+		},
+		cli.StringFlag{
+			Name:  "orders",
+			Usage: "comma separated orders as side:price:amount, e.g. buy:9000:0.1,sell:11000:0.1",
+		},
+	},
+}
+
+func submitOrders(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(c, "submitorders")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var orderType string
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(2)
+	}
+	if orderType == "" {
+		return errors.New("order type must be set")
+	}
+
+	var orders string
+	if c.IsSet("orders") {
+		orders = c.String("orders")
+	} else {
+		orders = c.Args().Get(3)
+	}
+	if orders == "" {
+		return errors.New("at least one order must be set")
+	}
+
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	var requests []*gctrpc.SubmitOrderRequest
+	for _, o := range strings.Split(orders, ",") {
+		fields := strings.Split(o, ":")
+		if len(fields) != 3 {
+			return fmt.Errorf("order %q must be formatted as side:price:amount", o)
+		}
+		price, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return fmt.Errorf("order %q price: %v", o, err)
+		}
+		amount, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return fmt.Errorf("order %q amount: %v", o, err)
+		}
+		requests = append(requests, &gctrpc.SubmitOrderRequest{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Side:      strings.ToUpper(fields[0]),
+			OrderType: strings.ToUpper(orderType),
+			Price:     price,
+			Amount:    amount,
+		})
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitOrders(context.Background(),
+		&gctrpc.SubmitOrdersRequest{
+			Exchange: exchangeName,
+			Orders:   requests,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var cancelOrdersCommand = cli.Command{
+	Name:      "cancelorders",
+	Usage:     "cancels a batch of orders of a currency pair on an exchange together",
+	ArgsUsage: "<exchange> <pair> <asset> <order_ids>",
+	Action:    cancelOrders,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to cancel the orders for",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair of the orders",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the orders, defaults to spot",
+		},
+		cli.StringFlag{
+			Name:  "order_ids",
+			Usage: "comma separated order IDs",
+		},
+	},
+}
+
+func cancelOrders(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(c, "cancelorders")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType == "" {
+		assetType = "spot"
+	}
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderIDs string
+	if c.IsSet("order_ids") {
+		orderIDs = c.String("order_ids")
+	} else {
+		orderIDs = c.Args().Get(3)
+	}
+	if orderIDs == "" {
+		return errors.New("at least one order ID must be set")
+	}
+
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	var requests []*gctrpc.CancelOrderRequest
+	for _, id := range strings.Split(orderIDs, ",") {
+		requests = append(requests, &gctrpc.CancelOrderRequest{
+			Exchange: exchangeName,
+			OrderId:  id,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+		})
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelOrders(context.Background(),
+		&gctrpc.CancelOrdersRequest{
+			Exchange: exchangeName,
+			Orders:   requests,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
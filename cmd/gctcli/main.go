@@ -116,7 +116,17 @@ func main() {
 		simulateOrderCommand,
 		whaleBombCommand,
 		cancelOrderCommand,
+		modifyOrderCommand,
 		cancelAllOrdersCommand,
+		setRiskKillSwitchCommand,
+		conditionalOrderCommand,
+		executionCommand,
+		arbitrageCommand,
+		routeCommand,
+		positionCommand,
+		getTradingRulesCommand,
+		submitOrdersCommand,
+		cancelOrdersCommand,
 		getEventsCommand,
 		addEventCommand,
 		removeEventCommand,
@@ -130,11 +140,15 @@ func main() {
 		enableExchangePairCommand,
 		disableExchangePairCommand,
 		getOrderbookStreamCommand,
+		getAggregatedOrderbookCommand,
+		getAggregatedOrderbookStreamCommand,
 		getExchangeOrderbookStreamCommand,
 		getTickerStreamCommand,
 		getExchangeTickerStreamCommand,
+		getOrderUpdateStreamCommand,
 		getAuditEventCommand,
 		getHistoricCandlesCommand,
+		candlesCommand,
 		gctScriptCommand,
 	}
 
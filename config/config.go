@@ -1630,6 +1630,248 @@ func (c *Config) CheckRemoteControlConfig() {
 	}
 }
 
+// CheckRiskManagerConfig checks the risk manager limits, negative limits are
+// disabled and limits for unknown exchanges are removed
+func (c *Config) CheckRiskManagerConfig() {
+	if !c.RiskManager.Enabled {
+		return
+	}
+
+	var exchanges []ExchangeRiskConfig
+	for x := range c.RiskManager.Exchanges {
+		e := c.RiskManager.Exchanges[x]
+		if _, err := c.GetExchangeConfig(e.Name); err != nil {
+			log.Warnf(log.ConfigMgr, "Risk manager limits for exchange %s removed. Err: %s\n",
+				e.Name, err)
+			continue
+		}
+		checkRiskLimits(e.Name, &e.RiskLimits)
+		for y := range e.Pairs {
+			checkRiskLimits(e.Name+" "+e.Pairs[y].Pair.String(), &e.Pairs[y].RiskLimits)
+		}
+		exchanges = append(exchanges, e)
+	}
+
+	m.Lock()
+	c.RiskManager.Exchanges = exchanges
+	m.Unlock()
+}
+
+func checkRiskLimits(name string, l *RiskLimits) {
+	if l.MaxNotional < 0 {
+		log.Warnf(log.ConfigMgr, "Risk manager %s max notional cannot be negative, disabling.\n", name)
+		l.MaxNotional = 0
+	}
+	if l.MaxOpenOrders < 0 {
+		log.Warnf(log.ConfigMgr, "Risk manager %s max open orders cannot be negative, disabling.\n", name)
+		l.MaxOpenOrders = 0
+	}
+	if l.MaxNetPosition < 0 {
+		log.Warnf(log.ConfigMgr, "Risk manager %s max net position cannot be negative, disabling.\n", name)
+		l.MaxNetPosition = 0
+	}
+	if l.MaxDailyLoss < 0 {
+		log.Warnf(log.ConfigMgr, "Risk manager %s max daily loss cannot be negative, disabling.\n", name)
+		l.MaxDailyLoss = 0
+	}
+	if l.PriceBandPercent < 0 {
+		log.Warnf(log.ConfigMgr, "Risk manager %s price band cannot be negative, disabling.\n", name)
+		l.PriceBandPercent = 0
+	}
+}
+
+// CheckPaperTradingConfig checks the paper trading balances, balances for
+// unknown exchanges and negative or empty balances are removed
+func (c *Config) CheckPaperTradingConfig() {
+	if !c.PaperTrading.Enabled {
+		return
+	}
+
+	var exchanges []PaperTradingExchangeConfig
+	for x := range c.PaperTrading.Exchanges {
+		e := c.PaperTrading.Exchanges[x]
+		if _, err := c.GetExchangeConfig(e.Name); err != nil {
+			log.Warnf(log.ConfigMgr, "Paper trading balances for exchange %s removed. Err: %s\n",
+				e.Name, err)
+			continue
+		}
+		var balances []PaperTradingBalance
+		for y := range e.Balances {
+			if e.Balances[y].Currency.IsEmpty() || e.Balances[y].Amount < 0 {
+				log.Warnf(log.ConfigMgr, "Paper trading %s balance %s %v is invalid, removing.\n",
+					e.Name, e.Balances[y].Currency, e.Balances[y].Amount)
+				continue
+			}
+			balances = append(balances, e.Balances[y])
+		}
+		e.Balances = balances
+		exchanges = append(exchanges, e)
+	}
+
+	m.Lock()
+	c.PaperTrading.Exchanges = exchanges
+	m.Unlock()
+}
+
+// GetPaperTradingBalances returns the starting paper trading balances for an
+// exchange
+func (c *Config) GetPaperTradingBalances(exchName string) []PaperTradingBalance {
+	m.Lock()
+	defer m.Unlock()
+	for x := range c.PaperTrading.Exchanges {
+		if strings.EqualFold(c.PaperTrading.Exchanges[x].Name, exchName) {
+			return c.PaperTrading.Exchanges[x].Balances
+		}
+	}
+	return nil
+}
+
+// CheckArbitrageConfig checks the arbitrage settings, empty pairs, unknown
+// exchanges and negative thresholds are removed and arbitrage is disabled when
+// no pairs remain and triangular arbitrage is disabled. Auto execute is
+// disabled unless the risk manager is enabled
+func (c *Config) CheckArbitrageConfig() {
+	if !c.Arbitrage.Enabled {
+		return
+	}
+
+	var pairs []currency.Pair
+	for x := range c.Arbitrage.Pairs {
+		if c.Arbitrage.Pairs[x].IsEmpty() {
+			log.Warnln(log.ConfigMgr, "Arbitrage empty currency pair removed.")
+			continue
+		}
+		pairs = append(pairs, c.Arbitrage.Pairs[x])
+	}
+
+	exchanges := c.arbitrageExchanges(c.Arbitrage.Exchanges)
+	triangularExchanges := c.arbitrageExchanges(c.Arbitrage.Triangular.Exchanges)
+
+	m.Lock()
+	defer m.Unlock()
+	c.Arbitrage.Pairs = pairs
+	c.Arbitrage.Exchanges = exchanges
+	c.Arbitrage.Triangular.Exchanges = triangularExchanges
+	if len(pairs) == 0 && !c.Arbitrage.Triangular.Enabled {
+		log.Warnln(log.ConfigMgr, "Arbitrage has no currency pairs set, disabling.")
+		c.Arbitrage.Enabled = false
+		return
+	}
+	if c.Arbitrage.MinProfit < 0 {
+		log.Warnln(log.ConfigMgr, "Arbitrage min profit cannot be negative, disabling.")
+		c.Arbitrage.MinProfit = 0
+	}
+	if c.Arbitrage.MinProfitPercent < 0 {
+		log.Warnln(log.ConfigMgr, "Arbitrage min profit percent cannot be negative, disabling.")
+		c.Arbitrage.MinProfitPercent = 0
+	}
+	if c.Arbitrage.MaxAmount < 0 {
+		log.Warnln(log.ConfigMgr, "Arbitrage max amount cannot be negative, disabling.")
+		c.Arbitrage.MaxAmount = 0
+	}
+	if c.Arbitrage.AutoExecute && !c.RiskManager.Enabled {
+		log.Warnln(log.ConfigMgr, "Arbitrage auto execute requires the risk manager to be enabled, disabling.")
+		c.Arbitrage.AutoExecute = false
+	}
+	if c.Arbitrage.AutoExecute && c.Arbitrage.ExecutionCooldown <= 0 {
+		log.Warnf(log.ConfigMgr, "Arbitrage execution cooldown not set, defaulting to %v.\n",
+			defaultArbitrageExecutionCooldown)
+		c.Arbitrage.ExecutionCooldown = defaultArbitrageExecutionCooldown
+	}
+	if !c.Arbitrage.Triangular.Enabled {
+		return
+	}
+	if c.Arbitrage.Triangular.MinReturnPercent < 0 {
+		log.Warnln(log.ConfigMgr, "Triangular arbitrage min return percent cannot be negative, disabling.")
+		c.Arbitrage.Triangular.MinReturnPercent = 0
+	}
+	if c.Arbitrage.Triangular.CheckInterval <= 0 {
+		log.Warnf(log.ConfigMgr, "Triangular arbitrage check interval not set, defaulting to %v.\n",
+			defaultTriangularCheckInterval)
+		c.Arbitrage.Triangular.CheckInterval = defaultTriangularCheckInterval
+	}
+}
+
+// arbitrageExchanges returns the exchange names with unknown exchanges removed
+func (c *Config) arbitrageExchanges(names []string) []string {
+	var exchanges []string
+	for x := range names {
+		if _, err := c.GetExchangeConfig(names[x]); err != nil {
+			log.Warnf(log.ConfigMgr, "Arbitrage exchange %s removed. Err: %s\n",
+				names[x], err)
+			continue
+		}
+		exchanges = append(exchanges, names[x])
+	}
+	return exchanges
+}
+
+// CheckAutoLendConfig checks the auto-lend settings, currencies of unknown
+// exchanges are removed and invalid settings are reset to their defaults
+func (c *Config) CheckAutoLendConfig() {
+	if !c.AutoLend.Enabled {
+		return
+	}
+
+	var currencies []AutoLendCurrencyConfig
+	for x := range c.AutoLend.Currencies {
+		cfg := c.AutoLend.Currencies[x]
+		if cfg.Currency.IsEmpty() {
+			log.Warnf(log.ConfigMgr, "Auto-lend %s empty currency removed.\n",
+				cfg.Exchange)
+			continue
+		}
+		if _, err := c.GetExchangeConfig(cfg.Exchange); err != nil {
+			log.Warnf(log.ConfigMgr, "Auto-lend exchange %s removed. Err: %s\n",
+				cfg.Exchange, err)
+			continue
+		}
+		currencies = append(currencies, cfg)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	c.AutoLend.Currencies = currencies
+	if len(currencies) == 0 {
+		log.Warnln(log.ConfigMgr, "Auto-lend has no currencies set, disabling.")
+		c.AutoLend.Enabled = false
+		return
+	}
+	if c.AutoLend.CheckInterval <= 0 {
+		log.Warnf(log.ConfigMgr, "Auto-lend check interval not set, defaulting to %v.\n",
+			defaultAutoLendCheckInterval)
+		c.AutoLend.CheckInterval = defaultAutoLendCheckInterval
+	}
+	for x := range c.AutoLend.Currencies {
+		cfg := &c.AutoLend.Currencies[x]
+		if cfg.MinRate < 0 {
+			log.Warnf(log.ConfigMgr, "Auto-lend %s %s min rate cannot be negative, disabling.\n",
+				cfg.Exchange, cfg.Currency)
+			cfg.MinRate = 0
+		}
+		if cfg.BookDepth < 0 {
+			log.Warnf(log.ConfigMgr, "Auto-lend %s %s book depth cannot be negative, disabling.\n",
+				cfg.Exchange, cfg.Currency)
+			cfg.BookDepth = 0
+		}
+		if cfg.Period <= 0 {
+			log.Warnf(log.ConfigMgr, "Auto-lend %s %s period not set, defaulting to %d days.\n",
+				cfg.Exchange, cfg.Currency, defaultAutoLendPeriod)
+			cfg.Period = defaultAutoLendPeriod
+		}
+		if cfg.KeepBalance < 0 {
+			log.Warnf(log.ConfigMgr, "Auto-lend %s %s keep balance cannot be negative, disabling.\n",
+				cfg.Exchange, cfg.Currency)
+			cfg.KeepBalance = 0
+		}
+		if cfg.MinAmount < 0 {
+			log.Warnf(log.ConfigMgr, "Auto-lend %s %s min amount cannot be negative, disabling.\n",
+				cfg.Exchange, cfg.Currency)
+			cfg.MinAmount = 0
+		}
+	}
+}
+
 // CheckConfig checks all config settings
 func (c *Config) CheckConfig() error {
 	err := c.CheckLoggerConfig()
@@ -1656,6 +1898,10 @@ func (c *Config) CheckConfig() error {
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckRemoteControlConfig()
+	c.CheckRiskManagerConfig()
+	c.CheckPaperTradingConfig()
+	c.CheckArbitrageConfig()
+	c.CheckAutoLendConfig()
 
 	err = c.CheckCurrencyConfigValues()
 	if err != nil {
@@ -1699,6 +1945,8 @@ func (c *Config) UpdateConfig(configPath string, newCfg *Config, dryrun bool) er
 	c.Communications = newCfg.Communications
 	c.Webserver = newCfg.Webserver
 	c.Exchanges = newCfg.Exchanges
+	c.RiskManager = newCfg.RiskManager
+	c.PaperTrading = newCfg.PaperTrading
 
 	err = c.SaveConfig(configPath, dryrun)
 	if err != nil {
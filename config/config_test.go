@@ -1638,6 +1638,171 @@ func TestGetFilePath(t *testing.T) {
 	testBypass = true
 }
 
+func TestCheckRiskManagerConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.Exchanges = []ExchangeConfig{{Name: "Bitstamp"}}
+	c.RiskManager = RiskManagerConfig{
+		Enabled: true,
+		Exchanges: []ExchangeRiskConfig{
+			{
+				Name: "Bitstamp",
+				RiskLimits: RiskLimits{
+					MaxNotional:   -1,
+					MaxOpenOrders: 5,
+				},
+				Pairs: []PairRiskConfig{
+					{
+						Pair:       currency.NewPair(currency.BTC, currency.USD),
+						RiskLimits: RiskLimits{MaxNetPosition: -1},
+					},
+				},
+			},
+			{
+				Name: "meow",
+			},
+		},
+	}
+
+	c.CheckRiskManagerConfig()
+
+	if len(c.RiskManager.Exchanges) != 1 {
+		t.Fatalf("expected limits for unknown exchanges to be removed, received %d",
+			len(c.RiskManager.Exchanges))
+	}
+	e := c.RiskManager.Exchanges[0]
+	if e.MaxNotional != 0 || e.MaxOpenOrders != 5 || e.Pairs[0].MaxNetPosition != 0 {
+		t.Errorf("unexpected limits %+v", e)
+	}
+}
+
+func TestCheckPaperTradingConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.Exchanges = []ExchangeConfig{{Name: "Bitstamp"}}
+	c.PaperTrading = PaperTradingConfig{
+		Enabled: true,
+		Exchanges: []PaperTradingExchangeConfig{
+			{
+				Name: "Bitstamp",
+				Balances: []PaperTradingBalance{
+					{Currency: currency.USD, Amount: 10000},
+					{Currency: currency.BTC, Amount: -1},
+					{Amount: 1},
+				},
+			},
+			{
+				Name: "meow",
+			},
+		},
+	}
+
+	c.CheckPaperTradingConfig()
+
+	if len(c.PaperTrading.Exchanges) != 1 {
+		t.Fatalf("expected balances for unknown exchanges to be removed, received %d",
+			len(c.PaperTrading.Exchanges))
+	}
+	b := c.GetPaperTradingBalances("bitstamp")
+	if len(b) != 1 || b[0].Currency != currency.USD || b[0].Amount != 10000 {
+		t.Errorf("unexpected balances %+v", b)
+	}
+	if c.GetPaperTradingBalances("meow") != nil {
+		t.Error("expected no balances for an unknown exchange")
+	}
+}
+
+func TestCheckArbitrageConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.Exchanges = []ExchangeConfig{{Name: "Bitstamp"}}
+	c.Arbitrage = ArbitrageConfig{
+		Enabled:     true,
+		Pairs:       []currency.Pair{currency.NewPair(currency.BTC, currency.USD), {}},
+		Exchanges:   []string{"Bitstamp", "meow"},
+		MinProfit:   -1,
+		AutoExecute: true,
+	}
+
+	c.CheckArbitrageConfig()
+	if c.Arbitrage.AutoExecute {
+		t.Error("expected auto execute to be disabled without the risk manager")
+	}
+
+	c.RiskManager.Enabled = true
+	c.Arbitrage.AutoExecute = true
+	c.CheckArbitrageConfig()
+
+	if !c.Arbitrage.Enabled || len(c.Arbitrage.Pairs) != 1 || len(c.Arbitrage.Exchanges) != 1 {
+		t.Errorf("expected empty pairs and unknown exchanges to be removed, received %+v",
+			c.Arbitrage)
+	}
+	if c.Arbitrage.MinProfit != 0 || c.Arbitrage.ExecutionCooldown != defaultArbitrageExecutionCooldown {
+		t.Errorf("unexpected thresholds %+v", c.Arbitrage)
+	}
+
+	c.Arbitrage.Pairs = nil
+	c.Arbitrage.Triangular = TriangularArbitrageConfig{
+		Enabled:          true,
+		Exchanges:        []string{"meow"},
+		MinReturnPercent: -1,
+	}
+	c.CheckArbitrageConfig()
+	if !c.Arbitrage.Enabled || len(c.Arbitrage.Triangular.Exchanges) != 0 {
+		t.Errorf("expected triangular arbitrage to keep arbitrage enabled, received %+v",
+			c.Arbitrage)
+	}
+	if c.Arbitrage.Triangular.MinReturnPercent != 0 ||
+		c.Arbitrage.Triangular.CheckInterval != defaultTriangularCheckInterval {
+		t.Errorf("unexpected triangular settings %+v", c.Arbitrage.Triangular)
+	}
+
+	c.Arbitrage.Triangular.Enabled = false
+	c.CheckArbitrageConfig()
+	if c.Arbitrage.Enabled {
+		t.Error("expected arbitrage without pairs to be disabled")
+	}
+}
+
+func TestCheckAutoLendConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.Exchanges = []ExchangeConfig{{Name: "Bitfinex"}}
+	c.AutoLend = AutoLendConfig{
+		Enabled: true,
+		Currencies: []AutoLendCurrencyConfig{
+			{Exchange: "Bitfinex", Currency: currency.USD, MinRate: -1, KeepBalance: -1},
+			{Exchange: "Bitfinex"},
+			{Exchange: "meow", Currency: currency.BTC},
+		},
+	}
+
+	c.CheckAutoLendConfig()
+
+	if !c.AutoLend.Enabled || len(c.AutoLend.Currencies) != 1 {
+		t.Errorf("expected empty currencies and unknown exchanges to be removed, received %+v",
+			c.AutoLend)
+	}
+	if c.AutoLend.CheckInterval != defaultAutoLendCheckInterval {
+		t.Errorf("expected check interval %v, received %v",
+			defaultAutoLendCheckInterval, c.AutoLend.CheckInterval)
+	}
+	cfg := c.AutoLend.Currencies[0]
+	if cfg.MinRate != 0 || cfg.KeepBalance != 0 || cfg.Period != defaultAutoLendPeriod {
+		t.Errorf("unexpected currency settings %+v", cfg)
+	}
+
+	c.AutoLend.Currencies = []AutoLendCurrencyConfig{{Exchange: "meow", Currency: currency.BTC}}
+	c.CheckAutoLendConfig()
+	if c.AutoLend.Enabled {
+		t.Error("expected auto-lend without currencies to be disabled")
+	}
+}
+
 func TestCheckRemoteControlConfig(t *testing.T) {
 	t.Parallel()
 
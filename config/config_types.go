@@ -33,6 +33,10 @@ const (
 	maxAuthFailures                      = 3
 	defaultNTPAllowedDifference          = 50000000
 	defaultNTPAllowedNegativeDifference  = 50000000
+	defaultArbitrageExecutionCooldown    = time.Minute
+	defaultTriangularCheckInterval       = time.Second * 10
+	defaultAutoLendCheckInterval         = time.Minute * 10
+	defaultAutoLendPeriod                = 2
 	DefaultAPIKey                        = "Key"
 	DefaultAPISecret                     = "Secret"
 	DefaultAPIClientID                   = "ClientID"
@@ -89,6 +93,10 @@ type Config struct {
 	Profiler          Profiler                `json:"profiler"`
 	NTPClient         NTPClientConfig         `json:"ntpclient"`
 	GCTScript         gctscript.Config        `json:"gctscript"`
+	RiskManager       RiskManagerConfig       `json:"riskManager"`
+	PaperTrading      PaperTradingConfig      `json:"paperTrading"`
+	Arbitrage         ArbitrageConfig         `json:"arbitrage"`
+	AutoLend          AutoLendConfig          `json:"autoLend"`
 	Currency          CurrencyConfig          `json:"currencyConfig"`
 	Communications    CommunicationsConfig    `json:"communications"`
 	RemoteControl     RemoteControlConfig     `json:"remoteControl"`
@@ -169,6 +177,128 @@ type NTPClientConfig struct {
 	AllowedNegativeDifference *time.Duration `json:"allowedNegativeDifference"`
 }
 
+// RiskManagerConfig stores the pre-trade risk limits checked against every
+// order submitted through the order manager
+type RiskManagerConfig struct {
+	Enabled   bool                 `json:"enabled"`
+	Exchanges []ExchangeRiskConfig `json:"exchanges"`
+}
+
+// ExchangeRiskConfig stores the risk limits for an exchange, they apply to
+// each of its currency pairs unless overridden by a pair limit
+type ExchangeRiskConfig struct {
+	Name string `json:"name"`
+	RiskLimits
+	Pairs []PairRiskConfig `json:"pairs,omitempty"`
+}
+
+// PairRiskConfig stores the risk limits for a single currency pair
+type PairRiskConfig struct {
+	Pair currency.Pair `json:"pair"`
+	RiskLimits
+}
+
+// RiskLimits stores the limits checked before an order is submitted, a zero
+// value disables the check. Notional and loss limits are valued in the fiat
+// display currency, the net position limit in the base currency and the price
+// band as a percentage difference from the last ticker price
+type RiskLimits struct {
+	MaxNotional      float64 `json:"maxNotional,omitempty"`
+	MaxOpenOrders    int     `json:"maxOpenOrders,omitempty"`
+	MaxNetPosition   float64 `json:"maxNetPosition,omitempty"`
+	MaxDailyLoss     float64 `json:"maxDailyLoss,omitempty"`
+	PriceBandPercent float64 `json:"priceBandPercent,omitempty"`
+}
+
+// PaperTradingConfig stores the virtual balances used when orders are
+// simulated against the live orderbooks instead of being sent to exchanges
+type PaperTradingConfig struct {
+	Enabled   bool                         `json:"enabled"`
+	Exchanges []PaperTradingExchangeConfig `json:"exchanges"`
+}
+
+// PaperTradingExchangeConfig stores the starting balances of an exchange's
+// paper trading account
+type PaperTradingExchangeConfig struct {
+	Name     string                `json:"name"`
+	Balances []PaperTradingBalance `json:"balances"`
+}
+
+// PaperTradingBalance stores the starting balance of a single currency
+type PaperTradingBalance struct {
+	Currency currency.Code `json:"currency"`
+	Amount   float64       `json:"amount"`
+}
+
+// ArbitrageConfig stores the currency pairs watched for cross exchange
+// arbitrage and the thresholds an opportunity must meet. Profits are valued in
+// the quote currency of the pair
+type ArbitrageConfig struct {
+	Enabled bool            `json:"enabled"`
+	Pairs   []currency.Pair `json:"pairs"`
+	// Exchanges limits the exchanges watched, every enabled exchange with
+	// the pair enabled is watched when empty
+	Exchanges        []string `json:"exchanges,omitempty"`
+	MinProfit        float64  `json:"minProfit"`
+	MinProfitPercent float64  `json:"minProfitPercent"`
+	// IncludeWithdrawalFees deducts the fees of moving the bought currency to
+	// the selling exchange and the proceeds back when the exchange balances
+	// cannot cover the trade
+	IncludeWithdrawalFees bool `json:"includeWithdrawalFees"`
+	// AutoExecute submits both legs of an opportunity through the order
+	// manager, it requires the risk manager to be enabled so the orders are
+	// checked against its limits
+	AutoExecute       bool          `json:"autoExecute"`
+	MaxAmount         float64       `json:"maxAmount,omitempty"`
+	ExecutionCooldown time.Duration `json:"executionCooldown,omitempty"`
+	// Triangular scans exchanges for three leg cycles between their own
+	// currency pairs
+	Triangular TriangularArbitrageConfig `json:"triangular"`
+}
+
+// TriangularArbitrageConfig stores the exchanges scanned for triangular
+// arbitrage and the net return after taker fees a cycle must exceed
+type TriangularArbitrageConfig struct {
+	Enabled bool `json:"enabled"`
+	// Exchanges limits the exchanges scanned, every enabled exchange is
+	// scanned when empty
+	Exchanges        []string      `json:"exchanges,omitempty"`
+	MinReturnPercent float64       `json:"minReturnPercent"`
+	CheckInterval    time.Duration `json:"checkInterval"`
+}
+
+// AutoLendConfig stores the currencies whose idle balances are offered for
+// lending and how often open offers are checked and re-offered
+type AutoLendConfig struct {
+	Enabled       bool                     `json:"enabled"`
+	CheckInterval time.Duration            `json:"checkInterval"`
+	Currencies    []AutoLendCurrencyConfig `json:"currencies"`
+}
+
+// AutoLendCurrencyConfig stores the auto-lend settings of a currency on an
+// exchange. Rates are daily rates expressed as a fraction, 0.0002 is 0.02% a
+// day
+type AutoLendCurrencyConfig struct {
+	Exchange string        `json:"exchange"`
+	Currency currency.Code `json:"currency"`
+	// Account limits the balance offered to the exchange account, such as the
+	// funding wallet, every account is used when empty
+	Account string `json:"account,omitempty"`
+	// MinRate is the lowest rate an offer is placed at
+	MinRate float64 `json:"minRate"`
+	// BookDepth is the amount of cheaper offers in the lend book an offer is
+	// placed behind, the lowest offered rate is matched when zero
+	BookDepth float64 `json:"bookDepth"`
+	// Period is the number of days funds are offered for
+	Period    int  `json:"period"`
+	AutoRenew bool `json:"autoRenew"`
+	// KeepBalance is the amount of the balance which is never offered
+	KeepBalance float64 `json:"keepBalance,omitempty"`
+	// MinAmount is the smallest offer placed, idle balances below it are not
+	// offered
+	MinAmount float64 `json:"minAmount,omitempty"`
+}
+
 // GRPCConfig stores the gRPC settings
 type GRPCConfig struct {
 	Enabled                bool   `json:"enabled"`
@@ -0,0 +1,38 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS exchange_order
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    our_order_id varchar(36) NOT NULL,
+    exchange_name varchar(255) NOT NULL,
+    order_id varchar(255) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(30) NOT NULL,
+    side varchar(30) NOT NULL,
+    order_type varchar(30) NOT NULL,
+    status varchar(30) NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    order_date TIMESTAMP NOT NULL,
+    last_updated TIMESTAMP NOT NULL,
+    CONSTRAINT exchange_order_uniq UNIQUE (exchange_name, order_id)
+);
+CREATE TABLE IF NOT EXISTS exchange_order_event
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange_name varchar(255) NOT NULL,
+    order_id varchar(255) NOT NULL,
+    status varchar(30) NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMP NOT NULL
+);
+CREATE INDEX exchange_order_event_order_idx ON exchange_order_event (exchange_name, order_id);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE exchange_order_event;
+DROP TABLE exchange_order;
@@ -0,0 +1,38 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "exchange_order"
+(
+    id	                integer not null primary key,
+    our_order_id        text not null,
+    exchange_name       text not null,
+    order_id            text not null,
+    base                text not null,
+    quote               text not null,
+    asset               text not null,
+    side                text not null,
+    order_type          text not null,
+    status              text not null,
+    price               real not null,
+    amount              real not null,
+    executed_amount     real not null,
+    remaining_amount    real not null,
+    fee                 real not null,
+    order_date          timestamp not null,
+    last_updated        timestamp not null,
+    UNIQUE(exchange_name, order_id) ON CONFLICT REPLACE
+);
+CREATE TABLE IF NOT EXISTS "exchange_order_event"
+(
+    id	                integer not null primary key,
+    exchange_name       text not null,
+    order_id            text not null,
+    status              text not null,
+    executed_amount     real not null,
+    remaining_amount    real not null,
+    timestamp           timestamp not null
+);
+CREATE INDEX exchange_order_event_order_idx ON exchange_order_event (exchange_name, order_id);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE exchange_order_event;
+DROP TABLE exchange_order;
@@ -0,0 +1,31 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "conditional_order"
+(
+    id	                integer not null primary key,
+    conditional_id      text not null,
+    group_id            text not null,
+    parent_id           text not null,
+    exchange_name       text not null,
+    base                text not null,
+    quote               text not null,
+    asset               text not null,
+    side                text not null,
+    order_type          text not null,
+    trigger_price       real not null,
+    limit_price         real not null,
+    amount              real not null,
+    trailing_distance   real not null,
+    trailing_percent    real not null,
+    watermark_price     real not null,
+    status              text not null,
+    order_id            text not null,
+    error_message       text not null,
+    created_at          timestamp not null,
+    updated_at          timestamp not null,
+    UNIQUE(conditional_id) ON CONFLICT REPLACE
+);
+CREATE INDEX conditional_order_status_idx ON conditional_order (status);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE conditional_order;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS script_state
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    script_name varchar(255) NOT NULL,
+    state_key varchar(255) NOT NULL,
+    state_value text NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    CONSTRAINT script_state_uniq UNIQUE (script_name, state_key)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE script_state;
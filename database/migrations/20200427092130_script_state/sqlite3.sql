@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "script_state"
+(
+    id	                integer not null primary key,
+    script_name         text not null,
+    state_key           text not null,
+    state_value         text not null,
+    updated_at          timestamp not null,
+    UNIQUE(script_name, state_key) ON CONFLICT REPLACE
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE script_state;
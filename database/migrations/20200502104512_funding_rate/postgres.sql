@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS funding_rate
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange_name varchar(255) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(30) NOT NULL,
+    rate DOUBLE PRECISION NOT NULL,
+    predicted_rate DOUBLE PRECISION NOT NULL,
+    funding_time TIMESTAMP NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT funding_rate_uniq UNIQUE (exchange_name, base, quote, asset, timestamp)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE funding_rate;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS contract_stat
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange_name varchar(255) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(30) NOT NULL,
+    mark_price DOUBLE PRECISION NOT NULL,
+    index_price DOUBLE PRECISION NOT NULL,
+    open_interest DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    CONSTRAINT contract_stat_uniq UNIQUE (exchange_name, base, quote, asset, timestamp)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE contract_stat;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "contract_stat"
+(
+    id	            integer not null primary key,
+    exchange_name   text not null,
+    base            text not null,
+    quote           text not null,
+    asset           text not null,
+    mark_price      real not null,
+    index_price     real not null,
+    open_interest   real not null,
+    timestamp       timestamp not null,
+    UNIQUE(exchange_name, base, quote, asset, timestamp) ON CONFLICT IGNORE
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE contract_stat;
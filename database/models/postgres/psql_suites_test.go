@@ -7,5 +7,12 @@ import "testing"
 
 func TestUpsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpsert)
+	t.Run("Candles", testCandlesUpsert)
+	t.Run("ConditionalOrders", testConditionalOrdersUpsert)
+	t.Run("ContractStats", testContractStatsUpsert)
+	t.Run("ExchangeOrders", testExchangeOrdersUpsert)
+	t.Run("FundingRates", testFundingRatesUpsert)
 	t.Run("Scripts", testScriptsUpsert)
+	t.Run("ScriptStates", testScriptStatesUpsert)
+	t.Run("Trades", testTradesUpsert)
 }
@@ -13,77 +13,181 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("Candles", testCandles)
+	t.Run("ConditionalOrders", testConditionalOrders)
+	t.Run("ContractStats", testContractStats)
+	t.Run("ExchangeOrders", testExchangeOrders)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEvents)
+	t.Run("FundingRates", testFundingRates)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
+	t.Run("ScriptStates", testScriptStates)
+	t.Run("Trades", testTrades)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("Candles", testCandlesDelete)
+	t.Run("ConditionalOrders", testConditionalOrdersDelete)
+	t.Run("ContractStats", testContractStatsDelete)
+	t.Run("ExchangeOrders", testExchangeOrdersDelete)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsDelete)
+	t.Run("FundingRates", testFundingRatesDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
+	t.Run("ScriptStates", testScriptStatesDelete)
+	t.Run("Trades", testTradesDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("ConditionalOrders", testConditionalOrdersQueryDeleteAll)
+	t.Run("ContractStats", testContractStatsQueryDeleteAll)
+	t.Run("ExchangeOrders", testExchangeOrdersQueryDeleteAll)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsQueryDeleteAll)
+	t.Run("FundingRates", testFundingRatesQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
+	t.Run("ScriptStates", testScriptStatesQueryDeleteAll)
+	t.Run("Trades", testTradesQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("ConditionalOrders", testConditionalOrdersSliceDeleteAll)
+	t.Run("ContractStats", testContractStatsSliceDeleteAll)
+	t.Run("ExchangeOrders", testExchangeOrdersSliceDeleteAll)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsSliceDeleteAll)
+	t.Run("FundingRates", testFundingRatesSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
+	t.Run("ScriptStates", testScriptStatesSliceDeleteAll)
+	t.Run("Trades", testTradesSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("Candles", testCandlesExists)
+	t.Run("ConditionalOrders", testConditionalOrdersExists)
+	t.Run("ContractStats", testContractStatsExists)
+	t.Run("ExchangeOrders", testExchangeOrdersExists)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsExists)
+	t.Run("FundingRates", testFundingRatesExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
+	t.Run("ScriptStates", testScriptStatesExists)
+	t.Run("Trades", testTradesExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("Candles", testCandlesFind)
+	t.Run("ConditionalOrders", testConditionalOrdersFind)
+	t.Run("ContractStats", testContractStatsFind)
+	t.Run("ExchangeOrders", testExchangeOrdersFind)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsFind)
+	t.Run("FundingRates", testFundingRatesFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
+	t.Run("ScriptStates", testScriptStatesFind)
+	t.Run("Trades", testTradesFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("Candles", testCandlesBind)
+	t.Run("ConditionalOrders", testConditionalOrdersBind)
+	t.Run("ContractStats", testContractStatsBind)
+	t.Run("ExchangeOrders", testExchangeOrdersBind)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsBind)
+	t.Run("FundingRates", testFundingRatesBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
+	t.Run("ScriptStates", testScriptStatesBind)
+	t.Run("Trades", testTradesBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("Candles", testCandlesOne)
+	t.Run("ConditionalOrders", testConditionalOrdersOne)
+	t.Run("ContractStats", testContractStatsOne)
+	t.Run("ExchangeOrders", testExchangeOrdersOne)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsOne)
+	t.Run("FundingRates", testFundingRatesOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
+	t.Run("ScriptStates", testScriptStatesOne)
+	t.Run("Trades", testTradesOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("Candles", testCandlesAll)
+	t.Run("ConditionalOrders", testConditionalOrdersAll)
+	t.Run("ContractStats", testContractStatsAll)
+	t.Run("ExchangeOrders", testExchangeOrdersAll)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsAll)
+	t.Run("FundingRates", testFundingRatesAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
+	t.Run("ScriptStates", testScriptStatesAll)
+	t.Run("Trades", testTradesAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("Candles", testCandlesCount)
+	t.Run("ConditionalOrders", testConditionalOrdersCount)
+	t.Run("ContractStats", testContractStatsCount)
+	t.Run("ExchangeOrders", testExchangeOrdersCount)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsCount)
+	t.Run("FundingRates", testFundingRatesCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
+	t.Run("ScriptStates", testScriptStatesCount)
+	t.Run("Trades", testTradesCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("Candles", testCandlesHooks)
+	t.Run("ConditionalOrders", testConditionalOrdersHooks)
+	t.Run("ContractStats", testContractStatsHooks)
+	t.Run("ExchangeOrders", testExchangeOrdersHooks)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsHooks)
+	t.Run("FundingRates", testFundingRatesHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
+	t.Run("ScriptStates", testScriptStatesHooks)
+	t.Run("Trades", testTradesHooks)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("Candles", testCandlesInsert)
+	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("ConditionalOrders", testConditionalOrdersInsert)
+	t.Run("ConditionalOrders", testConditionalOrdersInsertWhitelist)
+	t.Run("ContractStats", testContractStatsInsert)
+	t.Run("ContractStats", testContractStatsInsertWhitelist)
+	t.Run("ExchangeOrders", testExchangeOrdersInsert)
+	t.Run("ExchangeOrders", testExchangeOrdersInsertWhitelist)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsInsert)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsInsertWhitelist)
+	t.Run("FundingRates", testFundingRatesInsert)
+	t.Run("FundingRates", testFundingRatesInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
 	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
+	t.Run("ScriptStates", testScriptStatesInsert)
+	t.Run("ScriptStates", testScriptStatesInsertWhitelist)
+	t.Run("Trades", testTradesInsert)
+	t.Run("Trades", testTradesInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
@@ -136,30 +240,70 @@ func TestToManyRemove(t *testing.T) {}
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("Candles", testCandlesReload)
+	t.Run("ConditionalOrders", testConditionalOrdersReload)
+	t.Run("ContractStats", testContractStatsReload)
+	t.Run("ExchangeOrders", testExchangeOrdersReload)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsReload)
+	t.Run("FundingRates", testFundingRatesReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
+	t.Run("ScriptStates", testScriptStatesReload)
+	t.Run("Trades", testTradesReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("Candles", testCandlesReloadAll)
+	t.Run("ConditionalOrders", testConditionalOrdersReloadAll)
+	t.Run("ContractStats", testContractStatsReloadAll)
+	t.Run("ExchangeOrders", testExchangeOrdersReloadAll)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsReloadAll)
+	t.Run("FundingRates", testFundingRatesReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
+	t.Run("ScriptStates", testScriptStatesReloadAll)
+	t.Run("Trades", testTradesReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("Candles", testCandlesSelect)
+	t.Run("ConditionalOrders", testConditionalOrdersSelect)
+	t.Run("ContractStats", testContractStatsSelect)
+	t.Run("ExchangeOrders", testExchangeOrdersSelect)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsSelect)
+	t.Run("FundingRates", testFundingRatesSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
+	t.Run("ScriptStates", testScriptStatesSelect)
+	t.Run("Trades", testTradesSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("Candles", testCandlesUpdate)
+	t.Run("ConditionalOrders", testConditionalOrdersUpdate)
+	t.Run("ContractStats", testContractStatsUpdate)
+	t.Run("ExchangeOrders", testExchangeOrdersUpdate)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsUpdate)
+	t.Run("FundingRates", testFundingRatesUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
+	t.Run("ScriptStates", testScriptStatesUpdate)
+	t.Run("Trades", testTradesUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("ConditionalOrders", testConditionalOrdersSliceUpdateAll)
+	t.Run("ContractStats", testContractStatsSliceUpdateAll)
+	t.Run("ExchangeOrders", testExchangeOrdersSliceUpdateAll)
+	t.Run("ExchangeOrderEvents", testExchangeOrderEventsSliceUpdateAll)
+	t.Run("FundingRates", testFundingRatesSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
+	t.Run("ScriptStates", testScriptStatesSliceUpdateAll)
+	t.Run("Trades", testTradesSliceUpdateAll)
 }
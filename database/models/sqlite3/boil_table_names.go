@@ -4,11 +4,27 @@
 package sqlite3
 
 var TableNames = struct {
-	AuditEvent      string
-	Script          string
-	ScriptExecution string
+	AuditEvent         string
+	Candle             string
+	ConditionalOrder   string
+	ContractStat       string
+	ExchangeOrder      string
+	ExchangeOrderEvent string
+	FundingRate        string
+	Script             string
+	ScriptExecution    string
+	ScriptState        string
+	Trade              string
 }{
-	AuditEvent:      "audit_event",
-	Script:          "script",
-	ScriptExecution: "script_execution",
+	AuditEvent:         "audit_event",
+	Candle:             "candle",
+	ConditionalOrder:   "conditional_order",
+	ContractStat:       "contract_stat",
+	ExchangeOrder:      "exchange_order",
+	ExchangeOrderEvent: "exchange_order_event",
+	FundingRate:        "funding_rate",
+	Script:             "script",
+	ScriptExecution:    "script_execution",
+	ScriptState:        "script_state",
+	Trade:              "trade",
 }
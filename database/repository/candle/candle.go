@@ -0,0 +1,234 @@
+package candle
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// sqliteTimeFormat is the format candle timestamps are stored in for sqlite,
+// it sorts lexically so range queries can be run against it
+const sqliteTimeFormat = time.RFC3339
+
+var conflictColumns = []string{"exchange_name", "base", "quote", "asset", "interval", "timestamp"}
+
+// Insert stores a series of candles, any candle already stored for the same
+// exchange, pair, asset, interval and timestamp is overwritten
+func Insert(in *Item) (uint64, error) {
+	if database.DB.SQL == nil {
+		return 0, errDBNotSet
+	}
+	if err := validate(in); err != nil {
+		return 0, err
+	}
+	if len(in.Candles) == 0 {
+		return 0, errNoCandleData
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	exchangeName := strings.ToLower(in.ExchangeName)
+	var count uint64
+	for x := range in.Candles {
+		if repository.GetSQLDialect() == database.DBSQLite3 {
+			var tempCandle = modelSQLite.Candle{
+				ExchangeName: exchangeName,
+				Base:         strings.ToUpper(in.Base),
+				Quote:        strings.ToUpper(in.Quote),
+				Asset:        strings.ToLower(in.Asset),
+				Interval:     in.Interval,
+				Timestamp:    in.Candles[x].Timestamp.UTC().Format(sqliteTimeFormat),
+				Open:         in.Candles[x].Open,
+				High:         in.Candles[x].High,
+				Low:          in.Candles[x].Low,
+				Close:        in.Candles[x].Close,
+				Volume:       in.Candles[x].Volume,
+			}
+			// the sqlite3 unique constraint replaces rows on conflict
+			err = tempCandle.Insert(ctx, tx, boil.Infer())
+		} else {
+			var tempCandle = modelPSQL.Candle{
+				ExchangeName: exchangeName,
+				Base:         strings.ToUpper(in.Base),
+				Quote:        strings.ToUpper(in.Quote),
+				Asset:        strings.ToLower(in.Asset),
+				Interval:     in.Interval,
+				Timestamp:    in.Candles[x].Timestamp.UTC(),
+				Open:         in.Candles[x].Open,
+				High:         in.Candles[x].High,
+				Low:          in.Candles[x].Low,
+				Close:        in.Candles[x].Close,
+				Volume:       in.Candles[x].Volume,
+			}
+			err = tempCandle.Upsert(ctx,
+				tx,
+				true,
+				conflictColumns,
+				boil.Whitelist("open", "high", "low", "close", "volume"),
+				boil.Infer())
+		}
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Candle insert failed: %v", err)
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Candle Transaction rollback failed: %v", errRB)
+			}
+			return 0, err
+		}
+		count++
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Candle Transaction commit failed: %v", err)
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Candle Transaction rollback failed: %v", errRB)
+		}
+		return 0, err
+	}
+	return count, nil
+}
+
+// Series returns the stored candles for an exchange, pair, asset and interval
+// which open between the start and end date, ordered by timestamp
+func Series(exchangeName, base, quote, asset string, interval int64, start, end time.Time) (Item, error) {
+	out := Item{
+		ExchangeName: exchangeName,
+		Base:         base,
+		Quote:        quote,
+		Asset:        asset,
+		Interval:     interval,
+	}
+	if database.DB.SQL == nil {
+		return out, errDBNotSet
+	}
+	if err := validate(&out); err != nil {
+		return out, err
+	}
+
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		retCandles, err := modelSQLite.Candles(seriesQuery(&out, start, end)...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return out, err
+		}
+		for x := range retCandles {
+			t, err := time.Parse(sqliteTimeFormat, retCandles[x].Timestamp)
+			if err != nil {
+				return out, err
+			}
+			out.Candles = append(out.Candles, Candle{
+				Timestamp: t.UTC(),
+				Open:      retCandles[x].Open,
+				High:      retCandles[x].High,
+				Low:       retCandles[x].Low,
+				Close:     retCandles[x].Close,
+				Volume:    retCandles[x].Volume,
+			})
+		}
+		return out, nil
+	}
+
+	retCandles, err := modelPSQL.Candles(seriesQuery(&out, start, end)...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return out, err
+	}
+	for x := range retCandles {
+		out.Candles = append(out.Candles, Candle{
+			Timestamp: retCandles[x].Timestamp.UTC(),
+			Open:      retCandles[x].Open,
+			High:      retCandles[x].High,
+			Low:       retCandles[x].Low,
+			Close:     retCandles[x].Close,
+			Volume:    retCandles[x].Volume,
+		})
+	}
+	return out, nil
+}
+
+// Timestamps returns the opening time of every stored candle for an
+// exchange, pair, asset and interval between the start and end date, ordered
+// by timestamp. This is used to work out which intervals are missing
+func Timestamps(exchangeName, base, quote, asset string, interval int64, start, end time.Time) ([]time.Time, error) {
+	in := Item{
+		ExchangeName: exchangeName,
+		Base:         base,
+		Quote:        quote,
+		Asset:        asset,
+		Interval:     interval,
+	}
+	if database.DB.SQL == nil {
+		return nil, errDBNotSet
+	}
+	if err := validate(&in); err != nil {
+		return nil, err
+	}
+
+	query := append(seriesQuery(&in, start, end), qm.Select("\"timestamp\""))
+	ctx := context.Background()
+	var timestamps []time.Time
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		retCandles, err := modelSQLite.Candles(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for x := range retCandles {
+			t, err := time.Parse(sqliteTimeFormat, retCandles[x].Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			timestamps = append(timestamps, t.UTC())
+		}
+		return timestamps, nil
+	}
+
+	retCandles, err := modelPSQL.Candles(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for x := range retCandles {
+		timestamps = append(timestamps, retCandles[x].Timestamp.UTC())
+	}
+	return timestamps, nil
+}
+
+func seriesQuery(in *Item, start, end time.Time) []qm.QueryMod {
+	query := []qm.QueryMod{
+		qm.Where("exchange_name = ?", strings.ToLower(in.ExchangeName)),
+		qm.And("base = ?", strings.ToUpper(in.Base)),
+		qm.And("quote = ?", strings.ToUpper(in.Quote)),
+		qm.And("asset = ?", strings.ToLower(in.Asset)),
+		qm.And("\"interval\" = ?", in.Interval),
+		qm.OrderBy("\"timestamp\""),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		return append(query,
+			qm.And("\"timestamp\" >= ?", start.UTC().Format(sqliteTimeFormat)),
+			qm.And("\"timestamp\" < ?", end.UTC().Format(sqliteTimeFormat)))
+	}
+	return append(query,
+		qm.And("\"timestamp\" >= ?", start.UTC()),
+		qm.And("\"timestamp\" < ?", end.UTC()))
+}
+
+func validate(in *Item) error {
+	if in.ExchangeName == "" || in.Base == "" || in.Quote == "" ||
+		in.Asset == "" || in.Interval <= 0 {
+		return errInvalidInput
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package candle
+
+import (
+	"errors"
+	"time"
+)
+
+// vars for the candle repository package
+var (
+	errInvalidInput = errors.New("exchange, base, quote, asset and interval must be set")
+	errNoCandleData = errors.New("no candle data provided")
+	errDBNotSet     = errors.New("database is nil")
+)
+
+// Item holds a series of candles for an exchange, currency pair, asset and
+// interval
+type Item struct {
+	ExchangeName string
+	Base         string
+	Quote        string
+	Asset        string
+	// Interval is the candle interval in seconds
+	Interval int64
+	Candles  []Candle
+}
+
+// Candle holds the OHLCV data for a single interval
+type Candle struct {
+	Timestamp time.Time
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+	Volume    float64
+}
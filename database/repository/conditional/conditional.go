@@ -0,0 +1,235 @@
+package conditional
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// sqliteTimeFormat is the format conditional order timestamps are stored in
+// for sqlite
+const sqliteTimeFormat = time.RFC3339Nano
+
+var conflictColumns = []string{"conditional_id"}
+
+// Upsert stores a conditional order, replacing any conditional order already
+// stored with the same ID
+func Upsert(in *Details) error {
+	if database.DB.SQL == nil {
+		return errDBNotSet
+	}
+	if in.ID == "" || in.ExchangeName == "" {
+		return errInvalidInput
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if in.UpdatedAt.IsZero() {
+		in.UpdatedAt = time.Now()
+	}
+	if in.CreatedAt.IsZero() {
+		in.CreatedAt = in.UpdatedAt
+	}
+
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		var tempOrder = modelSQLite.ConditionalOrder{
+			ConditionalID:    in.ID,
+			GroupID:          in.GroupID,
+			ParentID:         in.ParentID,
+			ExchangeName:     strings.ToLower(in.ExchangeName),
+			Base:             strings.ToUpper(in.Base),
+			Quote:            strings.ToUpper(in.Quote),
+			Asset:            strings.ToLower(in.Asset),
+			Side:             in.Side,
+			OrderType:        in.OrderType,
+			TriggerPrice:     in.TriggerPrice,
+			LimitPrice:       in.LimitPrice,
+			Amount:           in.Amount,
+			TrailingDistance: in.TrailingDistance,
+			TrailingPercent:  in.TrailingPercent,
+			WatermarkPrice:   in.WatermarkPrice,
+			Status:           in.Status,
+			OrderID:          in.OrderID,
+			ErrorMessage:     in.Error,
+			CreatedAt:        in.CreatedAt.UTC().Format(sqliteTimeFormat),
+			UpdatedAt:        in.UpdatedAt.UTC().Format(sqliteTimeFormat),
+		}
+		// the sqlite3 unique constraint replaces rows on conflict
+		err = tempOrder.Insert(ctx, tx, boil.Infer())
+	} else {
+		var tempOrder = modelPSQL.ConditionalOrder{
+			ConditionalID:    in.ID,
+			GroupID:          in.GroupID,
+			ParentID:         in.ParentID,
+			ExchangeName:     strings.ToLower(in.ExchangeName),
+			Base:             strings.ToUpper(in.Base),
+			Quote:            strings.ToUpper(in.Quote),
+			Asset:            strings.ToLower(in.Asset),
+			Side:             in.Side,
+			OrderType:        in.OrderType,
+			TriggerPrice:     in.TriggerPrice,
+			LimitPrice:       in.LimitPrice,
+			Amount:           in.Amount,
+			TrailingDistance: in.TrailingDistance,
+			TrailingPercent:  in.TrailingPercent,
+			WatermarkPrice:   in.WatermarkPrice,
+			Status:           in.Status,
+			OrderID:          in.OrderID,
+			ErrorMessage:     in.Error,
+			CreatedAt:        in.CreatedAt.UTC(),
+			UpdatedAt:        in.UpdatedAt.UTC(),
+		}
+		err = tempOrder.Upsert(ctx,
+			tx,
+			true,
+			conflictColumns,
+			boil.Whitelist("trigger_price", "limit_price", "amount",
+				"watermark_price", "status", "order_id", "error_message",
+				"updated_at"),
+			boil.Infer())
+	}
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Conditional order upsert failed: %v", err)
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Conditional order Transaction rollback failed: %v", errRB)
+		}
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Conditional order Transaction commit failed: %v", err)
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Conditional order Transaction rollback failed: %v", errRB)
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByStatus returns all stored conditional orders with one of the supplied
+// statuses ordered by creation time, this is used to restore conditional
+// orders which were still being watched when the bot last shut down
+func GetByStatus(statuses ...string) ([]Details, error) {
+	if database.DB.SQL == nil {
+		return nil, errDBNotSet
+	}
+
+	var query []qm.QueryMod
+	if len(statuses) > 0 {
+		values := make([]interface{}, len(statuses))
+		for x := range statuses {
+			values[x] = statuses[x]
+		}
+		query = append(query, qm.WhereIn("status IN ?", values...))
+	}
+	query = append(query, qm.OrderBy("created_at"))
+	return getConditionalOrders(query...)
+}
+
+// GetByID returns a stored conditional order by its ID
+func GetByID(id string) (*Details, error) {
+	if database.DB.SQL == nil {
+		return nil, errDBNotSet
+	}
+	if id == "" {
+		return nil, errInvalidInput
+	}
+	orders, err := getConditionalOrders(qm.Where("conditional_id = ?", id))
+	if err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	return &orders[0], nil
+}
+
+func getConditionalOrders(query ...qm.QueryMod) ([]Details, error) {
+	ctx := context.Background()
+	var out []Details
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		retOrders, err := modelSQLite.ConditionalOrders(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for x := range retOrders {
+			createdAt, err := time.Parse(sqliteTimeFormat, retOrders[x].CreatedAt)
+			if err != nil {
+				return nil, err
+			}
+			updatedAt, err := time.Parse(sqliteTimeFormat, retOrders[x].UpdatedAt)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, Details{
+				ID:               retOrders[x].ConditionalID,
+				GroupID:          retOrders[x].GroupID,
+				ParentID:         retOrders[x].ParentID,
+				ExchangeName:     retOrders[x].ExchangeName,
+				Base:             retOrders[x].Base,
+				Quote:            retOrders[x].Quote,
+				Asset:            retOrders[x].Asset,
+				Side:             retOrders[x].Side,
+				OrderType:        retOrders[x].OrderType,
+				TriggerPrice:     retOrders[x].TriggerPrice,
+				LimitPrice:       retOrders[x].LimitPrice,
+				Amount:           retOrders[x].Amount,
+				TrailingDistance: retOrders[x].TrailingDistance,
+				TrailingPercent:  retOrders[x].TrailingPercent,
+				WatermarkPrice:   retOrders[x].WatermarkPrice,
+				Status:           retOrders[x].Status,
+				OrderID:          retOrders[x].OrderID,
+				Error:            retOrders[x].ErrorMessage,
+				CreatedAt:        createdAt.UTC(),
+				UpdatedAt:        updatedAt.UTC(),
+			})
+		}
+		return out, nil
+	}
+
+	retOrders, err := modelPSQL.ConditionalOrders(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for x := range retOrders {
+		out = append(out, Details{
+			ID:               retOrders[x].ConditionalID,
+			GroupID:          retOrders[x].GroupID,
+			ParentID:         retOrders[x].ParentID,
+			ExchangeName:     retOrders[x].ExchangeName,
+			Base:             retOrders[x].Base,
+			Quote:            retOrders[x].Quote,
+			Asset:            retOrders[x].Asset,
+			Side:             retOrders[x].Side,
+			OrderType:        retOrders[x].OrderType,
+			TriggerPrice:     retOrders[x].TriggerPrice,
+			LimitPrice:       retOrders[x].LimitPrice,
+			Amount:           retOrders[x].Amount,
+			TrailingDistance: retOrders[x].TrailingDistance,
+			TrailingPercent:  retOrders[x].TrailingPercent,
+			WatermarkPrice:   retOrders[x].WatermarkPrice,
+			Status:           retOrders[x].Status,
+			OrderID:          retOrders[x].OrderID,
+			Error:            retOrders[x].ErrorMessage,
+			CreatedAt:        retOrders[x].CreatedAt.UTC(),
+			UpdatedAt:        retOrders[x].UpdatedAt.UTC(),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,38 @@
+package conditional
+
+import (
+	"errors"
+	"time"
+)
+
+// vars for the conditional order repository package
+var (
+	errInvalidInput = errors.New("conditional order id and exchange must be set")
+	errDBNotSet     = errors.New("database is nil")
+)
+
+// Details holds a conditional order managed by the engine. GroupID links
+// orders which are part of an OCO or bracket group and ParentID links the
+// take profit and stop loss legs of a bracket to their entry order
+type Details struct {
+	ID               string
+	GroupID          string
+	ParentID         string
+	ExchangeName     string
+	Base             string
+	Quote            string
+	Asset            string
+	Side             string
+	OrderType        string
+	TriggerPrice     float64
+	LimitPrice       float64
+	Amount           float64
+	TrailingDistance float64
+	TrailingPercent  float64
+	WatermarkPrice   float64
+	Status           string
+	OrderID          string
+	Error            string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,303 @@
+package derivatives
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// sqliteTimeFormat is the format timestamps are stored in for sqlite, it is
+// fixed width so range queries can be run against it lexically
+const sqliteTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+var conflictColumns = []string{"exchange_name", "base", "quote", "asset", "timestamp"}
+
+// InsertFundingRates stores a batch of funding rates in a single transaction,
+// any rate already stored for the same contract and timestamp is ignored
+func InsertFundingRates(rates ...FundingRate) (uint64, error) {
+	if database.DB.SQL == nil {
+		return 0, errDBNotSet
+	}
+	if len(rates) == 0 {
+		return 0, errNoData
+	}
+	for x := range rates {
+		if err := validate(rates[x].ExchangeName, rates[x].Base, rates[x].Quote,
+			rates[x].Asset, rates[x].Timestamp); err != nil {
+			return 0, err
+		}
+	}
+
+	return insert("Funding rate", len(rates), func(ctx context.Context, tx *sql.Tx, x int) error {
+		if repository.GetSQLDialect() == database.DBSQLite3 {
+			var tempRate = modelSQLite.FundingRate{
+				ExchangeName:  strings.ToLower(rates[x].ExchangeName),
+				Base:          strings.ToUpper(rates[x].Base),
+				Quote:         strings.ToUpper(rates[x].Quote),
+				Asset:         strings.ToLower(rates[x].Asset),
+				Rate:          rates[x].Rate,
+				PredictedRate: rates[x].PredictedRate,
+				FundingTime:   rates[x].FundingTime.UTC().Format(sqliteTimeFormat),
+				Timestamp:     rates[x].Timestamp.UTC().Format(sqliteTimeFormat),
+			}
+			// the sqlite3 unique constraint ignores rows on conflict
+			return tempRate.Insert(ctx, tx, boil.Infer())
+		}
+		var tempRate = modelPSQL.FundingRate{
+			ExchangeName:  strings.ToLower(rates[x].ExchangeName),
+			Base:          strings.ToUpper(rates[x].Base),
+			Quote:         strings.ToUpper(rates[x].Quote),
+			Asset:         strings.ToLower(rates[x].Asset),
+			Rate:          rates[x].Rate,
+			PredictedRate: rates[x].PredictedRate,
+			FundingTime:   rates[x].FundingTime.UTC(),
+			Timestamp:     rates[x].Timestamp.UTC(),
+		}
+		return tempRate.Upsert(ctx,
+			tx,
+			false,
+			conflictColumns,
+			boil.None(),
+			boil.Infer())
+	})
+}
+
+// InsertContractStats stores a batch of contract stats in a single
+// transaction, any stats already stored for the same contract and timestamp
+// are ignored
+func InsertContractStats(stats ...ContractStat) (uint64, error) {
+	if database.DB.SQL == nil {
+		return 0, errDBNotSet
+	}
+	if len(stats) == 0 {
+		return 0, errNoData
+	}
+	for x := range stats {
+		if err := validate(stats[x].ExchangeName, stats[x].Base, stats[x].Quote,
+			stats[x].Asset, stats[x].Timestamp); err != nil {
+			return 0, err
+		}
+	}
+
+	return insert("Contract stat", len(stats), func(ctx context.Context, tx *sql.Tx, x int) error {
+		if repository.GetSQLDialect() == database.DBSQLite3 {
+			var tempStat = modelSQLite.ContractStat{
+				ExchangeName: strings.ToLower(stats[x].ExchangeName),
+				Base:         strings.ToUpper(stats[x].Base),
+				Quote:        strings.ToUpper(stats[x].Quote),
+				Asset:        strings.ToLower(stats[x].Asset),
+				MarkPrice:    stats[x].MarkPrice,
+				IndexPrice:   stats[x].IndexPrice,
+				OpenInterest: stats[x].OpenInterest,
+				Timestamp:    stats[x].Timestamp.UTC().Format(sqliteTimeFormat),
+			}
+			return tempStat.Insert(ctx, tx, boil.Infer())
+		}
+		var tempStat = modelPSQL.ContractStat{
+			ExchangeName: strings.ToLower(stats[x].ExchangeName),
+			Base:         strings.ToUpper(stats[x].Base),
+			Quote:        strings.ToUpper(stats[x].Quote),
+			Asset:        strings.ToLower(stats[x].Asset),
+			MarkPrice:    stats[x].MarkPrice,
+			IndexPrice:   stats[x].IndexPrice,
+			OpenInterest: stats[x].OpenInterest,
+			Timestamp:    stats[x].Timestamp.UTC(),
+		}
+		return tempStat.Upsert(ctx,
+			tx,
+			false,
+			conflictColumns,
+			boil.None(),
+			boil.Infer())
+	})
+}
+
+// GetFundingRatesInRange returns the stored funding rates of a contract which
+// were collected between the start and end date, ordered by timestamp
+func GetFundingRatesInRange(exchangeName, base, quote, asset string, start, end time.Time) ([]FundingRate, error) {
+	query, err := rangeQuery(exchangeName, base, quote, asset, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	var out []FundingRate
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		retRates, err := modelSQLite.FundingRates(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for x := range retRates {
+			fundingTime, err := time.Parse(sqliteTimeFormat, retRates[x].FundingTime)
+			if err != nil {
+				return nil, err
+			}
+			t, err := time.Parse(sqliteTimeFormat, retRates[x].Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, FundingRate{
+				ExchangeName:  retRates[x].ExchangeName,
+				Base:          retRates[x].Base,
+				Quote:         retRates[x].Quote,
+				Asset:         retRates[x].Asset,
+				Rate:          retRates[x].Rate,
+				PredictedRate: retRates[x].PredictedRate,
+				FundingTime:   fundingTime.UTC(),
+				Timestamp:     t.UTC(),
+			})
+		}
+		return out, nil
+	}
+
+	retRates, err := modelPSQL.FundingRates(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for x := range retRates {
+		out = append(out, FundingRate{
+			ExchangeName:  retRates[x].ExchangeName,
+			Base:          retRates[x].Base,
+			Quote:         retRates[x].Quote,
+			Asset:         retRates[x].Asset,
+			Rate:          retRates[x].Rate,
+			PredictedRate: retRates[x].PredictedRate,
+			FundingTime:   retRates[x].FundingTime.UTC(),
+			Timestamp:     retRates[x].Timestamp.UTC(),
+		})
+	}
+	return out, nil
+}
+
+// GetContractStatsInRange returns the stored stats of a contract which were
+// collected between the start and end date, ordered by timestamp
+func GetContractStatsInRange(exchangeName, base, quote, asset string, start, end time.Time) ([]ContractStat, error) {
+	query, err := rangeQuery(exchangeName, base, quote, asset, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	var out []ContractStat
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		retStats, err := modelSQLite.ContractStats(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for x := range retStats {
+			t, err := time.Parse(sqliteTimeFormat, retStats[x].Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, ContractStat{
+				ExchangeName: retStats[x].ExchangeName,
+				Base:         retStats[x].Base,
+				Quote:        retStats[x].Quote,
+				Asset:        retStats[x].Asset,
+				MarkPrice:    retStats[x].MarkPrice,
+				IndexPrice:   retStats[x].IndexPrice,
+				OpenInterest: retStats[x].OpenInterest,
+				Timestamp:    t.UTC(),
+			})
+		}
+		return out, nil
+	}
+
+	retStats, err := modelPSQL.ContractStats(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for x := range retStats {
+		out = append(out, ContractStat{
+			ExchangeName: retStats[x].ExchangeName,
+			Base:         retStats[x].Base,
+			Quote:        retStats[x].Quote,
+			Asset:        retStats[x].Asset,
+			MarkPrice:    retStats[x].MarkPrice,
+			IndexPrice:   retStats[x].IndexPrice,
+			OpenInterest: retStats[x].OpenInterest,
+			Timestamp:    retStats[x].Timestamp.UTC(),
+		})
+	}
+	return out, nil
+}
+
+// insert runs the insert of each row in a single transaction, rolling back
+// every row if one fails
+func insert(name string, rows int, insertRow func(ctx context.Context, tx *sql.Tx, x int) error) (uint64, error) {
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	for x := 0; x < rows; x++ {
+		err = insertRow(ctx, tx, x)
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "%s insert failed: %v", name, err)
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "%s Transaction rollback failed: %v", name, errRB)
+			}
+			return 0, err
+		}
+		count++
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "%s Transaction commit failed: %v", name, err)
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "%s Transaction rollback failed: %v", name, errRB)
+		}
+		return 0, err
+	}
+	return count, nil
+}
+
+// rangeQuery returns the query mods selecting a contract's rows between the
+// start and end date, ordered by timestamp
+func rangeQuery(exchangeName, base, quote, asset string, start, end time.Time) ([]qm.QueryMod, error) {
+	if database.DB.SQL == nil {
+		return nil, errDBNotSet
+	}
+	if exchangeName == "" || base == "" || quote == "" || asset == "" {
+		return nil, errInvalidInput
+	}
+
+	query := []qm.QueryMod{
+		qm.Where("exchange_name = ?", strings.ToLower(exchangeName)),
+		qm.And("base = ?", strings.ToUpper(base)),
+		qm.And("quote = ?", strings.ToUpper(quote)),
+		qm.And("asset = ?", strings.ToLower(asset)),
+		qm.OrderBy("\"timestamp\""),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		return append(query,
+			qm.And("\"timestamp\" >= ?", start.UTC().Format(sqliteTimeFormat)),
+			qm.And("\"timestamp\" < ?", end.UTC().Format(sqliteTimeFormat))), nil
+	}
+	return append(query,
+		qm.And("\"timestamp\" >= ?", start.UTC()),
+		qm.And("\"timestamp\" < ?", end.UTC())), nil
+}
+
+func validate(exchangeName, base, quote, asset string, timestamp time.Time) error {
+	if exchangeName == "" || base == "" || quote == "" || asset == "" ||
+		timestamp.IsZero() {
+		return errInvalidInput
+	}
+	return nil
+}
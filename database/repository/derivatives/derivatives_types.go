@@ -0,0 +1,39 @@
+package derivatives
+
+import (
+	"errors"
+	"time"
+)
+
+// vars for the derivatives repository package
+var (
+	errInvalidInput = errors.New("exchange, base, quote, asset and timestamp must be set")
+	errNoData       = errors.New("no derivatives data provided")
+	errDBNotSet     = errors.New("database is nil")
+)
+
+// FundingRate holds the funding rate of a perpetual contract at the time it
+// was collected
+type FundingRate struct {
+	ExchangeName  string
+	Base          string
+	Quote         string
+	Asset         string
+	Rate          float64
+	PredictedRate float64
+	FundingTime   time.Time
+	Timestamp     time.Time
+}
+
+// ContractStat holds the pricing and open interest of a futures or perpetual
+// contract at the time it was collected
+type ContractStat struct {
+	ExchangeName string
+	Base         string
+	Quote        string
+	Asset        string
+	MarkPrice    float64
+	IndexPrice   float64
+	OpenInterest float64
+	Timestamp    time.Time
+}
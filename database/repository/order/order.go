@@ -0,0 +1,319 @@
+package order
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// sqliteTimeFormat is the format order timestamps are stored in for sqlite
+const sqliteTimeFormat = time.RFC3339Nano
+
+var conflictColumns = []string{"exchange_name", "order_id"}
+
+// Upsert stores an order, replacing any order already stored for the same
+// exchange and order ID. If the order status or executed amount differs from
+// the stored order an event is recorded for the transition
+func Upsert(in *Details) error {
+	if database.DB.SQL == nil {
+		return errDBNotSet
+	}
+	if in.ExchangeName == "" || in.OrderID == "" {
+		return errInvalidInput
+	}
+
+	previous, err := getOrder(in.ExchangeName, in.OrderID)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if in.LastUpdated.IsZero() {
+		in.LastUpdated = time.Now()
+	}
+	if in.OrderDate.IsZero() {
+		in.OrderDate = in.LastUpdated
+	}
+	if previous != nil && in.OurOrderID == "" {
+		in.OurOrderID = previous.OurOrderID
+	}
+
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		var tempOrder = modelSQLite.ExchangeOrder{
+			OurOrderID:      in.OurOrderID,
+			ExchangeName:    strings.ToLower(in.ExchangeName),
+			OrderID:         in.OrderID,
+			Base:            strings.ToUpper(in.Base),
+			Quote:           strings.ToUpper(in.Quote),
+			Asset:           strings.ToLower(in.Asset),
+			Side:            in.Side,
+			OrderType:       in.OrderType,
+			Status:          in.Status,
+			Price:           in.Price,
+			Amount:          in.Amount,
+			ExecutedAmount:  in.ExecutedAmount,
+			RemainingAmount: in.RemainingAmount,
+			Fee:             in.Fee,
+			OrderDate:       in.OrderDate.UTC().Format(sqliteTimeFormat),
+			LastUpdated:     in.LastUpdated.UTC().Format(sqliteTimeFormat),
+		}
+		// the sqlite3 unique constraint replaces rows on conflict
+		err = tempOrder.Insert(ctx, tx, boil.Infer())
+	} else {
+		var tempOrder = modelPSQL.ExchangeOrder{
+			OurOrderID:      in.OurOrderID,
+			ExchangeName:    strings.ToLower(in.ExchangeName),
+			OrderID:         in.OrderID,
+			Base:            strings.ToUpper(in.Base),
+			Quote:           strings.ToUpper(in.Quote),
+			Asset:           strings.ToLower(in.Asset),
+			Side:            in.Side,
+			OrderType:       in.OrderType,
+			Status:          in.Status,
+			Price:           in.Price,
+			Amount:          in.Amount,
+			ExecutedAmount:  in.ExecutedAmount,
+			RemainingAmount: in.RemainingAmount,
+			Fee:             in.Fee,
+			OrderDate:       in.OrderDate.UTC(),
+			LastUpdated:     in.LastUpdated.UTC(),
+		}
+		err = tempOrder.Upsert(ctx,
+			tx,
+			true,
+			conflictColumns,
+			boil.Whitelist("our_order_id", "status", "price", "amount",
+				"executed_amount", "remaining_amount", "fee", "last_updated"),
+			boil.Infer())
+	}
+	if err == nil && (previous == nil ||
+		previous.Status != in.Status ||
+		previous.ExecutedAmount != in.ExecutedAmount) {
+		err = insertEvent(ctx, tx, &Event{
+			ExchangeName:    in.ExchangeName,
+			OrderID:         in.OrderID,
+			Status:          in.Status,
+			ExecutedAmount:  in.ExecutedAmount,
+			RemainingAmount: in.RemainingAmount,
+			Timestamp:       in.LastUpdated,
+		})
+	}
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Order upsert failed: %v", err)
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Order Transaction rollback failed: %v", errRB)
+		}
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Order Transaction commit failed: %v", err)
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Order Transaction rollback failed: %v", errRB)
+		}
+		return err
+	}
+	return nil
+}
+
+func insertEvent(ctx context.Context, tx boil.ContextExecutor, in *Event) error {
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		var tempEvent = modelSQLite.ExchangeOrderEvent{
+			ExchangeName:    strings.ToLower(in.ExchangeName),
+			OrderID:         in.OrderID,
+			Status:          in.Status,
+			ExecutedAmount:  in.ExecutedAmount,
+			RemainingAmount: in.RemainingAmount,
+			Timestamp:       in.Timestamp.UTC().Format(sqliteTimeFormat),
+		}
+		return tempEvent.Insert(ctx, tx, boil.Infer())
+	}
+	var tempEvent = modelPSQL.ExchangeOrderEvent{
+		ExchangeName:    strings.ToLower(in.ExchangeName),
+		OrderID:         in.OrderID,
+		Status:          in.Status,
+		ExecutedAmount:  in.ExecutedAmount,
+		RemainingAmount: in.RemainingAmount,
+		Timestamp:       in.Timestamp.UTC(),
+	}
+	return tempEvent.Insert(ctx, tx, boil.Infer())
+}
+
+// GetByStatusExcluding returns all stored orders whose status is not one of
+// the supplied statuses, this is used to load orders which were still open
+// when the bot last shut down
+func GetByStatusExcluding(statuses ...string) ([]Details, error) {
+	if database.DB.SQL == nil {
+		return nil, errDBNotSet
+	}
+
+	var query []qm.QueryMod
+	if len(statuses) > 0 {
+		values := make([]interface{}, len(statuses))
+		for x := range statuses {
+			values[x] = statuses[x]
+		}
+		query = append(query, qm.WhereIn("status NOT IN ?", values...))
+	}
+	query = append(query, qm.OrderBy("order_date"))
+	return getOrders(query...)
+}
+
+// GetExecuted returns all stored orders which have been at least partly
+// filled, ordered by order date
+func GetExecuted() ([]Details, error) {
+	if database.DB.SQL == nil {
+		return nil, errDBNotSet
+	}
+	return getOrders(qm.Where("executed_amount > ?", 0), qm.OrderBy("order_date"))
+}
+
+// GetEvents returns the recorded status transitions and fills for an order,
+// ordered by timestamp
+func GetEvents(exchangeName, orderID string) ([]Event, error) {
+	if database.DB.SQL == nil {
+		return nil, errDBNotSet
+	}
+	if exchangeName == "" || orderID == "" {
+		return nil, errInvalidInput
+	}
+
+	query := []qm.QueryMod{
+		qm.Where("exchange_name = ?", strings.ToLower(exchangeName)),
+		qm.And("order_id = ?", orderID),
+		qm.OrderBy("id"),
+	}
+
+	ctx := context.Background()
+	var out []Event
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		retEvents, err := modelSQLite.ExchangeOrderEvents(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for x := range retEvents {
+			t, err := time.Parse(sqliteTimeFormat, retEvents[x].Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, Event{
+				ExchangeName:    retEvents[x].ExchangeName,
+				OrderID:         retEvents[x].OrderID,
+				Status:          retEvents[x].Status,
+				ExecutedAmount:  retEvents[x].ExecutedAmount,
+				RemainingAmount: retEvents[x].RemainingAmount,
+				Timestamp:       t.UTC(),
+			})
+		}
+		return out, nil
+	}
+
+	retEvents, err := modelPSQL.ExchangeOrderEvents(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for x := range retEvents {
+		out = append(out, Event{
+			ExchangeName:    retEvents[x].ExchangeName,
+			OrderID:         retEvents[x].OrderID,
+			Status:          retEvents[x].Status,
+			ExecutedAmount:  retEvents[x].ExecutedAmount,
+			RemainingAmount: retEvents[x].RemainingAmount,
+			Timestamp:       retEvents[x].Timestamp.UTC(),
+		})
+	}
+	return out, nil
+}
+
+func getOrder(exchangeName, orderID string) (*Details, error) {
+	orders, err := getOrders(
+		qm.Where("exchange_name = ?", strings.ToLower(exchangeName)),
+		qm.And("order_id = ?", orderID))
+	if err != nil || len(orders) == 0 {
+		return nil, err
+	}
+	return &orders[0], nil
+}
+
+func getOrders(query ...qm.QueryMod) ([]Details, error) {
+	ctx := context.Background()
+	var out []Details
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		retOrders, err := modelSQLite.ExchangeOrders(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for x := range retOrders {
+			orderDate, err := time.Parse(sqliteTimeFormat, retOrders[x].OrderDate)
+			if err != nil {
+				return nil, err
+			}
+			lastUpdated, err := time.Parse(sqliteTimeFormat, retOrders[x].LastUpdated)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, Details{
+				OurOrderID:      retOrders[x].OurOrderID,
+				ExchangeName:    retOrders[x].ExchangeName,
+				OrderID:         retOrders[x].OrderID,
+				Base:            retOrders[x].Base,
+				Quote:           retOrders[x].Quote,
+				Asset:           retOrders[x].Asset,
+				Side:            retOrders[x].Side,
+				OrderType:       retOrders[x].OrderType,
+				Status:          retOrders[x].Status,
+				Price:           retOrders[x].Price,
+				Amount:          retOrders[x].Amount,
+				ExecutedAmount:  retOrders[x].ExecutedAmount,
+				RemainingAmount: retOrders[x].RemainingAmount,
+				Fee:             retOrders[x].Fee,
+				OrderDate:       orderDate.UTC(),
+				LastUpdated:     lastUpdated.UTC(),
+			})
+		}
+		return out, nil
+	}
+
+	retOrders, err := modelPSQL.ExchangeOrders(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for x := range retOrders {
+		out = append(out, Details{
+			OurOrderID:      retOrders[x].OurOrderID,
+			ExchangeName:    retOrders[x].ExchangeName,
+			OrderID:         retOrders[x].OrderID,
+			Base:            retOrders[x].Base,
+			Quote:           retOrders[x].Quote,
+			Asset:           retOrders[x].Asset,
+			Side:            retOrders[x].Side,
+			OrderType:       retOrders[x].OrderType,
+			Status:          retOrders[x].Status,
+			Price:           retOrders[x].Price,
+			Amount:          retOrders[x].Amount,
+			ExecutedAmount:  retOrders[x].ExecutedAmount,
+			RemainingAmount: retOrders[x].RemainingAmount,
+			Fee:             retOrders[x].Fee,
+			OrderDate:       retOrders[x].OrderDate.UTC(),
+			LastUpdated:     retOrders[x].LastUpdated.UTC(),
+		})
+	}
+	return out, nil
+}
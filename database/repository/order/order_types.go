@@ -0,0 +1,43 @@
+package order
+
+import (
+	"errors"
+	"time"
+)
+
+// vars for the order repository package
+var (
+	errInvalidInput = errors.New("exchange and order id must be set")
+	errDBNotSet     = errors.New("database is nil")
+)
+
+// Details holds an order placed on an exchange along with the ID assigned to
+// it by the order manager
+type Details struct {
+	OurOrderID      string
+	ExchangeName    string
+	OrderID         string
+	Base            string
+	Quote           string
+	Asset           string
+	Side            string
+	OrderType       string
+	Status          string
+	Price           float64
+	Amount          float64
+	ExecutedAmount  float64
+	RemainingAmount float64
+	Fee             float64
+	OrderDate       time.Time
+	LastUpdated     time.Time
+}
+
+// Event holds a status transition or fill for an order
+type Event struct {
+	ExchangeName    string
+	OrderID         string
+	Status          string
+	ExecutedAmount  float64
+	RemainingAmount float64
+	Timestamp       time.Time
+}
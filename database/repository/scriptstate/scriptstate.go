@@ -0,0 +1,178 @@
+package scriptstate
+
+import (
+	"context"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// sqliteTimeFormat is the format script state timestamps are stored in for
+// sqlite
+const sqliteTimeFormat = time.RFC3339Nano
+
+var conflictColumns = []string{"script_name", "state_key"}
+
+// Upsert stores a script value, replacing any value already stored under the
+// same script name and key
+func Upsert(in *Details) error {
+	if database.DB.SQL == nil {
+		return errDBNotSet
+	}
+	if in.ScriptName == "" || in.Key == "" {
+		return errInvalidInput
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if in.UpdatedAt.IsZero() {
+		in.UpdatedAt = time.Now()
+	}
+
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		var tempState = modelSQLite.ScriptState{
+			ScriptName: in.ScriptName,
+			StateKey:   in.Key,
+			StateValue: in.Value,
+			UpdatedAt:  in.UpdatedAt.UTC().Format(sqliteTimeFormat),
+		}
+		// the sqlite3 unique constraint replaces rows on conflict
+		err = tempState.Insert(ctx, tx, boil.Infer())
+	} else {
+		var tempState = modelPSQL.ScriptState{
+			ScriptName: in.ScriptName,
+			StateKey:   in.Key,
+			StateValue: in.Value,
+			UpdatedAt:  in.UpdatedAt.UTC(),
+		}
+		err = tempState.Upsert(ctx,
+			tx,
+			true,
+			conflictColumns,
+			boil.Whitelist("state_value", "updated_at"),
+			boil.Infer())
+	}
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Script state upsert failed: %v", err)
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Script state Transaction rollback failed: %v", errRB)
+		}
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Script state Transaction commit failed: %v", err)
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Script state Transaction rollback failed: %v", errRB)
+		}
+		return err
+	}
+	return nil
+}
+
+// Get returns the value stored by a script under the key, nil is returned if
+// there is no value stored
+func Get(scriptName, key string) (*Details, error) {
+	if database.DB.SQL == nil {
+		return nil, errDBNotSet
+	}
+	if scriptName == "" || key == "" {
+		return nil, errInvalidInput
+	}
+
+	values, err := getScriptStates(qm.Where("script_name = ? AND state_key = ?", scriptName, key))
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return &values[0], nil
+}
+
+// GetByScript returns all values stored by a script ordered by key, all
+// values for every script are returned if the script name is empty
+func GetByScript(scriptName string) ([]Details, error) {
+	if database.DB.SQL == nil {
+		return nil, errDBNotSet
+	}
+
+	var query []qm.QueryMod
+	if scriptName != "" {
+		query = append(query, qm.Where("script_name = ?", scriptName))
+	}
+	query = append(query, qm.OrderBy("script_name, state_key"))
+	return getScriptStates(query...)
+}
+
+// Delete removes the value stored by a script under the key
+func Delete(scriptName, key string) error {
+	if database.DB.SQL == nil {
+		return errDBNotSet
+	}
+	if scriptName == "" || key == "" {
+		return errInvalidInput
+	}
+
+	ctx := context.Background()
+	query := qm.Where("script_name = ? AND state_key = ?", scriptName, key)
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		_, err = modelSQLite.ScriptStates(query).DeleteAll(ctx, database.DB.SQL)
+	} else {
+		_, err = modelPSQL.ScriptStates(query).DeleteAll(ctx, database.DB.SQL)
+	}
+	return err
+}
+
+func getScriptStates(query ...qm.QueryMod) ([]Details, error) {
+	ctx := context.Background()
+	var out []Details
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		retStates, err := modelSQLite.ScriptStates(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for x := range retStates {
+			updatedAt, err := time.Parse(sqliteTimeFormat, retStates[x].UpdatedAt)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, Details{
+				ScriptName: retStates[x].ScriptName,
+				Key:        retStates[x].StateKey,
+				Value:      retStates[x].StateValue,
+				UpdatedAt:  updatedAt.UTC(),
+			})
+		}
+		return out, nil
+	}
+
+	retStates, err := modelPSQL.ScriptStates(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for x := range retStates {
+		out = append(out, Details{
+			ScriptName: retStates[x].ScriptName,
+			Key:        retStates[x].StateKey,
+			Value:      retStates[x].StateValue,
+			UpdatedAt:  retStates[x].UpdatedAt.UTC(),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,20 @@
+package scriptstate
+
+import (
+	"errors"
+	"time"
+)
+
+// vars for the script state repository package
+var (
+	errInvalidInput = errors.New("script name and key must be set")
+	errDBNotSet     = errors.New("database is nil")
+)
+
+// Details holds a value stored by a script under a key
+type Details struct {
+	ScriptName string
+	Key        string
+	Value      string
+	UpdatedAt  time.Time
+}
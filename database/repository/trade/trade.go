@@ -0,0 +1,180 @@
+package trade
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// sqliteTimeFormat is the format trade timestamps are stored in for sqlite,
+// it is fixed width so range queries can be run against it lexically
+const sqliteTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+const (
+	insertColumns = "tid, exchange_name, base, quote, asset, price, amount, side, \"timestamp\""
+	// the sqlite3 unique constraint ignores rows on conflict
+	sqliteInsert = "INSERT INTO trade (" + insertColumns + ") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	psqlInsert   = "INSERT INTO trade (" + insertColumns + ") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) " +
+		"ON CONFLICT (exchange_name, base, quote, asset, tid) DO NOTHING"
+)
+
+// Insert stores a batch of trades in a single transaction, any trade already
+// stored for the same exchange, pair, asset and TID is ignored. The count of
+// trades actually stored is returned
+func Insert(trades ...Data) (uint64, error) {
+	if database.DB.SQL == nil {
+		return 0, errDBNotSet
+	}
+	if len(trades) == 0 {
+		return 0, errNoTradeData
+	}
+	for x := range trades {
+		if err := validate(&trades[x]); err != nil {
+			return 0, err
+		}
+	}
+
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	for x := range trades {
+		var affected int64
+		affected, err = insertTrade(ctx, tx, &trades[x])
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Trade insert failed: %v", err)
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Trade Transaction rollback failed: %v", errRB)
+			}
+			return 0, err
+		}
+		count += uint64(affected)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Trade Transaction commit failed: %v", err)
+		errRB := tx.Rollback()
+		if errRB != nil {
+			log.Errorf(log.DatabaseMgr, "Trade Transaction rollback failed: %v", errRB)
+		}
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetInRange returns the stored trades for an exchange, pair and asset which
+// occurred between the start and end date, ordered by timestamp
+func GetInRange(exchangeName, base, quote, asset string, start, end time.Time) ([]Data, error) {
+	if database.DB.SQL == nil {
+		return nil, errDBNotSet
+	}
+	if exchangeName == "" || base == "" || quote == "" || asset == "" {
+		return nil, errInvalidInput
+	}
+
+	query := []qm.QueryMod{
+		qm.Where("exchange_name = ?", strings.ToLower(exchangeName)),
+		qm.And("base = ?", strings.ToUpper(base)),
+		qm.And("quote = ?", strings.ToUpper(quote)),
+		qm.And("asset = ?", strings.ToLower(asset)),
+		qm.OrderBy("\"timestamp\""),
+	}
+
+	ctx := context.Background()
+	var out []Data
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		query = append(query,
+			qm.And("\"timestamp\" >= ?", start.UTC().Format(sqliteTimeFormat)),
+			qm.And("\"timestamp\" < ?", end.UTC().Format(sqliteTimeFormat)))
+		retTrades, err := modelSQLite.Trades(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for x := range retTrades {
+			t, err := time.Parse(sqliteTimeFormat, retTrades[x].Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, Data{
+				TID:          retTrades[x].Tid,
+				ExchangeName: retTrades[x].ExchangeName,
+				Base:         retTrades[x].Base,
+				Quote:        retTrades[x].Quote,
+				Asset:        retTrades[x].Asset,
+				Price:        retTrades[x].Price,
+				Amount:       retTrades[x].Amount,
+				Side:         retTrades[x].Side,
+				Timestamp:    t.UTC(),
+			})
+		}
+		return out, nil
+	}
+
+	query = append(query,
+		qm.And("\"timestamp\" >= ?", start.UTC()),
+		qm.And("\"timestamp\" < ?", end.UTC()))
+	retTrades, err := modelPSQL.Trades(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for x := range retTrades {
+		out = append(out, Data{
+			TID:          retTrades[x].Tid,
+			ExchangeName: retTrades[x].ExchangeName,
+			Base:         retTrades[x].Base,
+			Quote:        retTrades[x].Quote,
+			Asset:        retTrades[x].Asset,
+			Price:        retTrades[x].Price,
+			Amount:       retTrades[x].Amount,
+			Side:         retTrades[x].Side,
+			Timestamp:    retTrades[x].Timestamp.UTC(),
+		})
+	}
+	return out, nil
+}
+
+// insertTrade stores a trade, returning the number of rows stored which is
+// zero when the trade is already stored
+func insertTrade(ctx context.Context, tx *sql.Tx, t *Data) (int64, error) {
+	query := psqlInsert
+	var timestamp interface{} = t.Timestamp.UTC()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		query = sqliteInsert
+		timestamp = t.Timestamp.UTC().Format(sqliteTimeFormat)
+	}
+	result, err := tx.ExecContext(ctx, query,
+		t.TID,
+		strings.ToLower(t.ExchangeName),
+		strings.ToUpper(t.Base),
+		strings.ToUpper(t.Quote),
+		strings.ToLower(t.Asset),
+		t.Price,
+		t.Amount,
+		strings.ToUpper(t.Side),
+		timestamp)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func validate(in *Data) error {
+	if in.ExchangeName == "" || in.Base == "" || in.Quote == "" ||
+		in.Asset == "" || in.TID == "" {
+		return errInvalidInput
+	}
+	return nil
+}
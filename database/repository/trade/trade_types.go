@@ -0,0 +1,26 @@
+package trade
+
+import (
+	"errors"
+	"time"
+)
+
+// vars for the trade repository package
+var (
+	errInvalidInput = errors.New("exchange, base, quote, asset and tid must be set")
+	errNoTradeData  = errors.New("no trade data provided")
+	errDBNotSet     = errors.New("database is nil")
+)
+
+// Data holds a single public trade for an exchange, currency pair and asset
+type Data struct {
+	TID          string
+	ExchangeName string
+	Base         string
+	Quote        string
+	Asset        string
+	Price        float64
+	Amount       float64
+	Side         string
+	Timestamp    time.Time
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/goose"
+)
+
+var candleTestStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestCandle(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+		output interface{}
+	}{
+		{
+			"SQLite-Write",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			writeCandles,
+			closeDatabase,
+			nil,
+		},
+		{
+			"SQLite-Read",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			readCandles,
+			closeDatabase,
+			nil,
+		},
+		{
+			"Postgres-Write",
+			postgresTestDatabase,
+			writeCandles,
+			nil,
+			nil,
+		},
+		{
+			"Postgres-Read",
+			postgresTestDatabase,
+			readCandles,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func candleTestItem() *candle.Item {
+	item := &candle.Item{
+		ExchangeName: "Binance",
+		Base:         "BTC",
+		Quote:        "USDT",
+		Asset:        "spot",
+		Interval:     3600,
+	}
+	for x := 0; x < 10; x++ {
+		if x == 4 || x == 5 {
+			continue
+		}
+		item.Candles = append(item.Candles, candle.Candle{
+			Timestamp: candleTestStart.Add(time.Hour * time.Duration(x)),
+			Open:      float64(x),
+			High:      float64(x) + 2,
+			Low:       float64(x) - 1,
+			Close:     float64(x) + 1,
+			Volume:    100,
+		})
+	}
+	return item
+}
+
+func writeCandles(t *testing.T) {
+	t.Helper()
+
+	item := candleTestItem()
+	count, err := candle.Insert(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != uint64(len(item.Candles)) {
+		t.Errorf("expected %d candles inserted, received %d", len(item.Candles), count)
+	}
+
+	// inserting the same candles again updates rather than duplicates them
+	item.Candles[0].Close = 1337
+	_, err = candle.Insert(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = candle.Insert(&candle.Item{ExchangeName: "Binance"})
+	if err == nil {
+		t.Error("expected error for invalid candle item")
+	}
+}
+
+func readCandles(t *testing.T) {
+	t.Helper()
+
+	item := candleTestItem()
+	end := candleTestStart.Add(time.Hour * 10)
+	series, err := candle.Series("binance", "btc", "usdt", "SPOT", item.Interval, candleTestStart, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series.Candles) != len(item.Candles) {
+		t.Fatalf("expected %d candles, received %d", len(item.Candles), len(series.Candles))
+	}
+	if !series.Candles[0].Timestamp.Equal(candleTestStart) || series.Candles[0].Close != 1337 {
+		t.Errorf("unexpected candle %+v", series.Candles[0])
+	}
+
+	timestamps, err := candle.Timestamps("binance", "btc", "usdt", "spot", item.Interval, candleTestStart, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(timestamps) != len(item.Candles) {
+		t.Errorf("expected %d timestamps, received %d", len(item.Candles), len(timestamps))
+	}
+	for x := range timestamps {
+		if !timestamps[x].Equal(item.Candles[x].Timestamp) {
+			t.Errorf("expected %v, received %v", item.Candles[x].Timestamp, timestamps[x])
+		}
+	}
+}
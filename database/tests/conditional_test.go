@@ -0,0 +1,171 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/conditional"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestConditionalOrder(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+		output interface{}
+	}{
+		{
+			"SQLite-Write",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			writeConditionalOrders,
+			closeDatabase,
+			nil,
+		},
+		{
+			"SQLite-Read",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			readConditionalOrders,
+			closeDatabase,
+			nil,
+		},
+		{
+			"Postgres-Write",
+			postgresTestDatabase,
+			writeConditionalOrders,
+			nil,
+			nil,
+		},
+		{
+			"Postgres-Read",
+			postgresTestDatabase,
+			readConditionalOrders,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func conditionalTestData() *conditional.Details {
+	return &conditional.Details{
+		ID:           "0b5e3a3c-6b0b-4b57-8f33-7d1f0c3a3f10",
+		ExchangeName: "Bitstamp",
+		Base:         "BTC",
+		Quote:        "USD",
+		Asset:        "spot",
+		Side:         "SELL",
+		OrderType:    "STOP",
+		TriggerPrice: 900,
+		Amount:       1,
+		Status:       "PENDING",
+		CreatedAt:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func writeConditionalOrders(t *testing.T) {
+	t.Helper()
+
+	d := conditionalTestData()
+	err := conditional.Upsert(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.UpdatedAt = time.Time{}
+	d.WatermarkPrice = 1100
+	d.TriggerPrice = 1000
+	err = conditional.Upsert(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	triggered := conditionalTestData()
+	triggered.ID = "7f3ad1b5-3b8c-4c4f-9f5e-2f6f0e9c1d22"
+	triggered.Status = "TRIGGERED"
+	triggered.OrderID = "1337"
+	err = conditional.Upsert(triggered)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = conditional.Upsert(&conditional.Details{ExchangeName: "Bitstamp"})
+	if err == nil {
+		t.Error("expected error for invalid conditional order")
+	}
+}
+
+func readConditionalOrders(t *testing.T) {
+	t.Helper()
+
+	pending, err := conditional.GetByStatus("PENDING", "INACTIVE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 {
+		t.Fatalf("expected 1 pending conditional order, received %d", len(pending))
+	}
+	if pending[0].ID != conditionalTestData().ID || pending[0].TriggerPrice != 1000 ||
+		pending[0].WatermarkPrice != 1100 || pending[0].ExchangeName != "bitstamp" {
+		t.Errorf("unexpected conditional order %+v", pending[0])
+	}
+
+	d, err := conditional.GetByID("7f3ad1b5-3b8c-4c4f-9f5e-2f6f0e9c1d22")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d == nil || d.OrderID != "1337" || d.Status != "TRIGGERED" {
+		t.Errorf("unexpected conditional order %+v", d)
+	}
+
+	d, err = conditional.GetByID("missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d != nil {
+		t.Error("expected no conditional order")
+	}
+}
@@ -0,0 +1,197 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/derivatives"
+	"github.com/thrasher-corp/goose"
+)
+
+var derivativesTestStart = time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+
+func TestDerivatives(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+		output interface{}
+	}{
+		{
+			"SQLite-Write",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			writeDerivatives,
+			closeDatabase,
+			nil,
+		},
+		{
+			"SQLite-Read",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			readDerivatives,
+			closeDatabase,
+			nil,
+		},
+		{
+			"Postgres-Write",
+			postgresTestDatabase,
+			writeDerivatives,
+			nil,
+			nil,
+		},
+		{
+			"Postgres-Read",
+			postgresTestDatabase,
+			readDerivatives,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func derivativesTestData() ([]derivatives.FundingRate, []derivatives.ContractStat) {
+	var rates []derivatives.FundingRate
+	var stats []derivatives.ContractStat
+	for x := 0; x < 20; x++ {
+		ts := derivativesTestStart.Add(time.Minute * time.Duration(x))
+		rates = append(rates, derivatives.FundingRate{
+			ExchangeName:  "OKEX",
+			Base:          "BTC-USD",
+			Quote:         "SWAP",
+			Asset:         "perpetualswap",
+			Rate:          0.0001 * float64(x),
+			PredictedRate: 0.0002,
+			FundingTime:   derivativesTestStart.Add(time.Hour * 8),
+			Timestamp:     ts,
+		})
+		stats = append(stats, derivatives.ContractStat{
+			ExchangeName: "OKEX",
+			Base:         "BTC-USD",
+			Quote:        "SWAP",
+			Asset:        "perpetualswap",
+			MarkPrice:    float64(9000 + x),
+			IndexPrice:   float64(9001 + x),
+			OpenInterest: 1000,
+			Timestamp:    ts,
+		})
+	}
+	return rates, stats
+}
+
+func writeDerivatives(t *testing.T) {
+	t.Helper()
+
+	rates, stats := derivativesTestData()
+	count, err := derivatives.InsertFundingRates(rates...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != uint64(len(rates)) {
+		t.Errorf("expected %d funding rates inserted, received %d", len(rates), count)
+	}
+	count, err = derivatives.InsertContractStats(stats...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != uint64(len(stats)) {
+		t.Errorf("expected %d contract stats inserted, received %d", len(stats), count)
+	}
+
+	// rows which have already been stored are ignored
+	_, err = derivatives.InsertFundingRates(rates[:5]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = derivatives.InsertContractStats(stats[:5]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = derivatives.InsertFundingRates(derivatives.FundingRate{ExchangeName: "OKEX"})
+	if err == nil {
+		t.Error("expected error for invalid funding rate")
+	}
+	_, err = derivatives.InsertContractStats()
+	if err == nil {
+		t.Error("expected error for no contract stats")
+	}
+}
+
+func readDerivatives(t *testing.T) {
+	t.Helper()
+
+	rates, stats := derivativesTestData()
+	storedRates, err := derivatives.GetFundingRatesInRange("okex", "btc-usd", "swap", "PERPETUALSWAP",
+		derivativesTestStart, derivativesTestStart.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(storedRates) != len(rates) {
+		t.Fatalf("expected %d funding rates, received %d", len(rates), len(storedRates))
+	}
+	for x := range storedRates {
+		if storedRates[x].Rate != rates[x].Rate ||
+			!storedRates[x].FundingTime.Equal(rates[x].FundingTime) ||
+			!storedRates[x].Timestamp.Equal(rates[x].Timestamp) {
+			t.Errorf("unexpected funding rate %+v", storedRates[x])
+		}
+	}
+
+	storedStats, err := derivatives.GetContractStatsInRange("okex", "btc-usd", "swap", "perpetualswap",
+		derivativesTestStart, derivativesTestStart.Add(time.Minute*5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(storedStats) != 5 {
+		t.Fatalf("expected 5 contract stats, received %d", len(storedStats))
+	}
+	for x := range storedStats {
+		if storedStats[x].MarkPrice != stats[x].MarkPrice ||
+			!storedStats[x].Timestamp.Equal(stats[x].Timestamp) {
+			t.Errorf("unexpected contract stat %+v", storedStats[x])
+		}
+	}
+}
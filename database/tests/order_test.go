@@ -0,0 +1,185 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestOrder(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+		output interface{}
+	}{
+		{
+			"SQLite-Write",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			writeOrders,
+			closeDatabase,
+			nil,
+		},
+		{
+			"SQLite-Read",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			readOrders,
+			closeDatabase,
+			nil,
+		},
+		{
+			"Postgres-Write",
+			postgresTestDatabase,
+			writeOrders,
+			nil,
+			nil,
+		},
+		{
+			"Postgres-Read",
+			postgresTestDatabase,
+			readOrders,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func orderTestData() *order.Details {
+	return &order.Details{
+		OurOrderID:      "5b2ae7f4-d53d-4a6e-a2a1-9a4a7f6e63b8",
+		ExchangeName:    "Bitstamp",
+		OrderID:         "1337",
+		Base:            "BTC",
+		Quote:           "USD",
+		Asset:           "spot",
+		Side:            "BUY",
+		OrderType:       "LIMIT",
+		Status:          "NEW",
+		Price:           1000,
+		Amount:          2,
+		RemainingAmount: 2,
+		OrderDate:       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func writeOrders(t *testing.T) {
+	t.Helper()
+
+	d := orderTestData()
+	err := order.Upsert(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an update without a status change or fill does not record an event
+	d.LastUpdated = time.Time{}
+	err = order.Upsert(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.OurOrderID = ""
+	d.LastUpdated = time.Time{}
+	d.Status = "PARTIALLY_FILLED"
+	d.ExecutedAmount = 1
+	d.RemainingAmount = 1
+	err = order.Upsert(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filled := orderTestData()
+	filled.OrderID = "1338"
+	filled.Status = "FILLED"
+	err = order.Upsert(filled)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = order.Upsert(&order.Details{ExchangeName: "Bitstamp"})
+	if err == nil {
+		t.Error("expected error for invalid order")
+	}
+}
+
+func readOrders(t *testing.T) {
+	t.Helper()
+
+	open, err := order.GetByStatusExcluding("FILLED", "CANCELLED")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(open) != 1 {
+		t.Fatalf("expected 1 open order, received %d", len(open))
+	}
+	if open[0].OrderID != "1337" || open[0].Status != "PARTIALLY_FILLED" ||
+		open[0].OurOrderID != orderTestData().OurOrderID {
+		t.Errorf("unexpected order %+v", open[0])
+	}
+
+	events, err := order.GetEvents("bitstamp", "1337")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 order events, received %d", len(events))
+	}
+	if events[0].Status != "NEW" || events[1].Status != "PARTIALLY_FILLED" ||
+		events[1].ExecutedAmount != 1 {
+		t.Errorf("unexpected order events %+v", events)
+	}
+
+	executed, err := order.GetExecuted()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(executed) != 1 || executed[0].OrderID != "1337" || executed[0].ExecutedAmount != 1 {
+		t.Errorf("expected only the partially filled order, received %+v", executed)
+	}
+}
@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptstate"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestScriptState(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+		output interface{}
+	}{
+		{
+			"SQLite-Write",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			writeScriptStates,
+			closeDatabase,
+			nil,
+		},
+		{
+			"SQLite-Read",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			readScriptStates,
+			closeDatabase,
+			nil,
+		},
+		{
+			"Postgres-Write",
+			postgresTestDatabase,
+			writeScriptStates,
+			nil,
+			nil,
+		},
+		{
+			"Postgres-Read",
+			postgresTestDatabase,
+			readScriptStates,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func writeScriptStates(t *testing.T) {
+	t.Helper()
+
+	values := []scriptstate.Details{
+		{ScriptName: "timer.gct", Key: "position", Value: "1"},
+		{ScriptName: "timer.gct", Key: "signal", Value: `"buy"`},
+		{ScriptName: "timer.gct", Key: "position", Value: "2"},
+		{ScriptName: "once.gct", Key: "count", Value: "10"},
+		{ScriptName: "once.gct", Key: "removed", Value: "true"},
+	}
+	for x := range values {
+		err := scriptstate.Upsert(&values[x])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := scriptstate.Delete("once.gct", "removed")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = scriptstate.Upsert(&scriptstate.Details{ScriptName: "timer.gct"})
+	if err == nil {
+		t.Error("expected error for invalid script state")
+	}
+}
+
+func readScriptStates(t *testing.T) {
+	t.Helper()
+
+	d, err := scriptstate.Get("timer.gct", "position")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d == nil || d.Value != "2" {
+		t.Errorf("unexpected script state %+v", d)
+	}
+
+	d, err = scriptstate.Get("once.gct", "removed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d != nil {
+		t.Errorf("expected deleted script state, received %+v", d)
+	}
+
+	values, err := scriptstate.GetByScript("timer.gct")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || values[0].Key != "position" || values[1].Key != "signal" {
+		t.Errorf("unexpected script states %+v", values)
+	}
+
+	values, err = scriptstate.GetByScript("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 3 {
+		t.Errorf("expected 3 script states, received %d", len(values))
+	}
+}
@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/goose"
+)
+
+var tradeTestStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestTrade(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+		output interface{}
+	}{
+		{
+			"SQLite-Write",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			writeTrades,
+			closeDatabase,
+			nil,
+		},
+		{
+			"SQLite-Read",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+
+			readTrades,
+			closeDatabase,
+			nil,
+		},
+		{
+			"Postgres-Write",
+			postgresTestDatabase,
+			writeTrades,
+			nil,
+			nil,
+		},
+		{
+			"Postgres-Read",
+			postgresTestDatabase,
+			readTrades,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func tradeTestData() []trade.Data {
+	var trades []trade.Data
+	for x := 0; x < 20; x++ {
+		side := "BUY"
+		if x%2 == 1 {
+			side = "SELL"
+		}
+		trades = append(trades, trade.Data{
+			TID:          strconv.Itoa(x),
+			ExchangeName: "Binance",
+			Base:         "BTC",
+			Quote:        "USDT",
+			Asset:        "spot",
+			Price:        float64(100 + x),
+			Amount:       1,
+			Side:         side,
+			Timestamp:    tradeTestStart.Add(time.Minute * time.Duration(x)),
+		})
+	}
+	return trades
+}
+
+func writeTrades(t *testing.T) {
+	t.Helper()
+
+	trades := tradeTestData()
+	count, err := trade.Insert(trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != uint64(len(trades)) {
+		t.Errorf("expected %d trades inserted, received %d", len(trades), count)
+	}
+
+	// trades which have already been stored are ignored and not counted
+	count, err = trade.Insert(trades[:5]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("expected no trades inserted, received %d", count)
+	}
+
+	_, err = trade.Insert(trade.Data{ExchangeName: "Binance"})
+	if err == nil {
+		t.Error("expected error for invalid trade")
+	}
+	_, err = trade.Insert()
+	if err == nil {
+		t.Error("expected error for no trades")
+	}
+}
+
+func readTrades(t *testing.T) {
+	t.Helper()
+
+	trades := tradeTestData()
+	stored, err := trade.GetInRange("binance", "btc", "usdt", "SPOT",
+		tradeTestStart, tradeTestStart.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != len(trades) {
+		t.Fatalf("expected %d trades, received %d", len(trades), len(stored))
+	}
+	for x := range stored {
+		if stored[x].TID != trades[x].TID || !stored[x].Timestamp.Equal(trades[x].Timestamp) {
+			t.Errorf("unexpected trade %+v", stored[x])
+		}
+	}
+
+	stored, err = trade.GetInRange("binance", "btc", "usdt", "spot",
+		tradeTestStart, tradeTestStart.Add(time.Minute*5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 5 {
+		t.Errorf("expected 5 trades, received %d", len(stored))
+	}
+}
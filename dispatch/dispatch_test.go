@@ -242,12 +242,20 @@ func TestSubscribe(t *testing.T) {
 		pipes = append(pipes, newPipe)
 	}
 
+	if !mux.HasSubscribers(itemID) {
+		t.Error("expected the ID to have subscribers")
+	}
+
 	for i := range pipes {
 		err := pipes[i].Release()
 		if err != nil {
 			t.Error(err)
 		}
 	}
+
+	if mux.HasSubscribers(itemID) {
+		t.Error("expected the ID to have no subscribers once the pipes are released")
+	}
 }
 
 func TestPublish(t *testing.T) {
@@ -62,6 +62,14 @@ func (m *Mux) Publish(ids []uuid.UUID, data interface{}) error {
 	return nil
 }
 
+// HasSubscribers returns whether any pipes are subscribed to the ID
+func (m *Mux) HasSubscribers(id uuid.UUID) bool {
+	if m == nil {
+		return false
+	}
+	return m.d.hasSubscribers(id)
+}
+
 // GetID a new unique ID to track routing information in the dispatch system
 func (m *Mux) GetID() (uuid.UUID, error) {
 	if m == nil {
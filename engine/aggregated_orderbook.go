@@ -0,0 +1,468 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const orderbookAggregatorName = "Orderbook aggregator"
+
+// vars for the orderbook aggregator
+var (
+	// AggregatorSubscribeDelay is how often exchange orderbooks which could
+	// not be subscribed to are retried, the exchange orderbooks of each
+	// aggregated orderbook recomputed and conversion rates refreshed
+	AggregatorSubscribeDelay = time.Second * 10
+	// AggregatorIdleTimeout is how long an aggregated orderbook with no
+	// subscribers is kept after it was last requested
+	AggregatorIdleTimeout    = time.Minute * 5
+	errAggregatorNoExchanges = errors.New("no enabled exchanges support the currency pair")
+)
+
+// Started returns if the orderbook aggregator subsystem is started
+func (o *orderbookAggregator) Started() bool {
+	return atomic.LoadInt32(&o.started) == 1
+}
+
+// Start starts the orderbook aggregator subsystem, aggregated orderbooks are
+// created when first requested
+func (o *orderbookAggregator) Start() error {
+	if !dispatch.IsRunning() {
+		return fmt.Errorf("%s requires the dispatch system to be running",
+			orderbookAggregatorName)
+	}
+
+	if atomic.AddInt32(&o.started, 1) != 1 {
+		return fmt.Errorf("%s %s", orderbookAggregatorName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.OrderBook, orderbookAggregatorName, MsgSubSystemStarting)
+	o.m.Lock()
+	if o.mux == nil {
+		o.mux = dispatch.GetNewMux()
+	}
+	o.books = make(map[string]*aggregatedBook)
+	o.shutdown = make(chan struct{})
+	o.m.Unlock()
+
+	o.wg.Add(1)
+	go o.run()
+	log.Debugln(log.OrderBook, orderbookAggregatorName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the orderbook aggregator subsystem
+func (o *orderbookAggregator) Stop() error {
+	if atomic.LoadInt32(&o.started) == 0 {
+		return fmt.Errorf("%s %s", orderbookAggregatorName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&o.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", orderbookAggregatorName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.OrderBook, orderbookAggregatorName, MsgSubSystemShuttingDown)
+	o.m.Lock()
+	close(o.shutdown)
+	o.m.Unlock()
+	o.wg.Wait()
+	atomic.CompareAndSwapInt32(&o.stopped, 1, 0)
+	atomic.CompareAndSwapInt32(&o.started, 1, 0)
+	log.Debugln(log.OrderBook, orderbookAggregatorName, MsgSubSystemShutdown)
+	return nil
+}
+
+// GetAggregatedOrderbook returns the merged orderbook of the currency pair
+// across every enabled exchange. When convert is set the orderbooks of pairs
+// with the same base currency quoted in other fiat currencies are included
+// and their prices converted into the pair's quote currency
+func (o *orderbookAggregator) GetAggregatedOrderbook(p currency.Pair, convert bool) (*AggregatedOrderbook, error) {
+	b, err := o.aggregate(p, convert)
+	if err != nil {
+		return nil, err
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	return b.snapshot(), nil
+}
+
+// SubscribeAggregatedOrderbook returns a pipe which receives the aggregated
+// orderbook of the currency pair each time one of its exchange orderbooks
+// updates
+func (o *orderbookAggregator) SubscribeAggregatedOrderbook(p currency.Pair, convert bool) (dispatch.Pipe, error) {
+	b, err := o.aggregate(p, convert)
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return o.mux.Subscribe(b.id)
+}
+
+// aggregate returns the aggregated orderbook of the pair, creating it and
+// subscribing to its exchange orderbooks if it is not yet aggregated
+func (o *orderbookAggregator) aggregate(p currency.Pair, convert bool) (*aggregatedBook, error) {
+	if !o.Started() {
+		return nil, fmt.Errorf("%s %s", orderbookAggregatorName, ErrSubSystemNotStarted)
+	}
+	if p.IsEmpty() {
+		return nil, errors.New("currency pair is empty")
+	}
+
+	key := aggregatedBookKey(p, convert)
+	o.m.Lock()
+	if b, ok := o.books[key]; ok {
+		b.requested = time.Now()
+		o.m.Unlock()
+		return b, nil
+	}
+	sources := aggregatedSources(p, convert)
+	if len(sources) == 0 {
+		o.m.Unlock()
+		return nil, fmt.Errorf("%v: %s", errAggregatorNoExchanges, p)
+	}
+	id, err := o.mux.GetID()
+	if err != nil {
+		o.m.Unlock()
+		return nil, err
+	}
+
+	b := &aggregatedBook{
+		id: id,
+		book: AggregatedOrderbook{
+			Pair:      p,
+			Converted: convert,
+		},
+		subscribed: make(map[string]chan struct{}),
+		rates:      make(map[string]float64),
+		requested:  time.Now(),
+	}
+	b.setSources(sources)
+	o.books[key] = b
+	o.m.Unlock()
+
+	o.subscribe(b)
+	return b, nil
+}
+
+// aggregatedSources returns the exchange orderbooks merged into the
+// aggregated orderbook of the pair
+func aggregatedSources(p currency.Pair, convert bool) []aggregatedSource {
+	convert = convert && p.Quote.IsFiatCurrency()
+	var sources []aggregatedSource
+	for x := range Bot.Exchanges {
+		if !Bot.Exchanges[x].IsEnabled() {
+			continue
+		}
+		pairs := Bot.Exchanges[x].GetEnabledPairs(asset.Spot)
+		for y := range pairs {
+			if !pairs[y].Base.Match(p.Base) {
+				continue
+			}
+			if !pairs[y].Quote.Match(p.Quote) &&
+				!(convert && pairs[y].Quote.IsFiatCurrency()) {
+				continue
+			}
+			sources = append(sources, aggregatedSource{
+				exchange: Bot.Exchanges[x].GetName(),
+				pair:     pairs[y],
+			})
+		}
+	}
+	return sources
+}
+
+// setSources replaces the exchange orderbooks merged into the aggregated
+// orderbook, the relays of sources no longer merged are stopped and their
+// levels removed. The caller must hold the lock
+func (b *aggregatedBook) setSources(sources []aggregatedSource) {
+	b.sources = sources
+	b.book.Exchanges = nil
+	current := make(map[string]bool, len(sources))
+	for x := range sources {
+		current[sources[x].key()] = true
+		if !common.StringDataCompare(b.book.Exchanges, sources[x].exchange) {
+			b.book.Exchanges = append(b.book.Exchanges, sources[x].exchange)
+		}
+	}
+	for key, stop := range b.subscribed {
+		if current[key] {
+			continue
+		}
+		close(stop)
+		delete(b.subscribed, key)
+		delete(b.rates, key)
+	}
+}
+
+// close stops the relays of every source of an evicted aggregated orderbook
+func (b *aggregatedBook) close() {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.closed = true
+	b.setSources(nil)
+}
+
+func (o *orderbookAggregator) run() {
+	tick := time.NewTicker(AggregatorSubscribeDelay)
+	defer func() {
+		tick.Stop()
+		o.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-o.shutdown:
+			return
+		case <-tick.C:
+			for _, b := range o.evictIdle() {
+				sources := aggregatedSources(b.book.Pair, b.book.Converted)
+				b.m.Lock()
+				b.setSources(sources)
+				b.m.Unlock()
+				o.subscribe(b)
+			}
+		}
+	}
+}
+
+// evictIdle removes the aggregated orderbooks which have had no subscribers
+// since they were last requested for the idle timeout, stopping the relays of
+// their exchange orderbooks, and returns the aggregated orderbooks kept
+func (o *orderbookAggregator) evictIdle() []*aggregatedBook {
+	var evicted []*aggregatedBook
+	o.m.Lock()
+	books := make([]*aggregatedBook, 0, len(o.books))
+	for key, b := range o.books {
+		if time.Since(b.requested) >= AggregatorIdleTimeout &&
+			!o.mux.HasSubscribers(b.id) {
+			delete(o.books, key)
+			evicted = append(evicted, b)
+			continue
+		}
+		books = append(books, b)
+	}
+	o.m.Unlock()
+
+	for x := range evicted {
+		evicted[x].close()
+		log.Debugf(log.OrderBook, "%s: Removed %s aggregated orderbook with no subscribers.\n",
+			orderbookAggregatorName, evicted[x].book.Pair)
+	}
+	return books
+}
+
+// subscribe subscribes to each exchange orderbook of the aggregated orderbook
+// which is not yet subscribed to and refreshes the conversion rates of those
+// which are
+func (o *orderbookAggregator) subscribe(b *aggregatedBook) {
+	b.m.Lock()
+	sources := append([]aggregatedSource(nil), b.sources...)
+	b.m.Unlock()
+	for x := range sources {
+		src := sources[x]
+		key := src.key()
+		rate, err := aggregatedRate(src.pair.Quote, b.book.Pair.Quote)
+		if err != nil {
+			log.Debugf(log.OrderBook, "%s: Unable to convert %s %s orderbook to %s. Err: %s\n",
+				orderbookAggregatorName, src.exchange, src.pair, b.book.Pair.Quote, err)
+			continue
+		}
+
+		b.m.Lock()
+		if b.closed {
+			b.m.Unlock()
+			return
+		}
+		b.rates[key] = rate
+		if _, ok := b.subscribed[key]; ok {
+			b.m.Unlock()
+			continue
+		}
+		pipe, err := orderbook.SubscribeOrderbook(src.exchange, src.pair, asset.Spot)
+		if err != nil {
+			// the orderbook has not been fetched yet
+			b.m.Unlock()
+			continue
+		}
+		o.m.Lock()
+		select {
+		case <-o.shutdown:
+			o.m.Unlock()
+			b.m.Unlock()
+			if err := pipe.Release(); err != nil {
+				log.Errorf(log.OrderBook, "%s: Unable to release %s %s orderbook pipe. Err: %s\n",
+					orderbookAggregatorName, src.exchange, src.pair, err)
+			}
+			return
+		default:
+		}
+		o.wg.Add(1)
+		o.m.Unlock()
+		stop := make(chan struct{})
+		b.subscribed[key] = stop
+		b.m.Unlock()
+
+		if ob, err := orderbook.Get(src.exchange, src.pair, asset.Spot); err == nil {
+			o.update(b, src, ob)
+		}
+		go o.relay(b, src, pipe, stop)
+	}
+}
+
+// relay merges each orderbook update received for the exchange orderbook
+// until the pipe closes, the source is removed or the aggregator is stopped,
+// its levels are then removed from the aggregated orderbook
+func (o *orderbookAggregator) relay(b *aggregatedBook, src aggregatedSource, pipe dispatch.Pipe, stop chan struct{}) {
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Errorf(log.OrderBook, "%s: Unable to release %s %s orderbook pipe. Err: %s\n",
+				orderbookAggregatorName, src.exchange, src.pair, err)
+		}
+		b.m.Lock()
+		if b.subscribed[src.key()] == stop {
+			delete(b.subscribed, src.key())
+		}
+		b.m.Unlock()
+		o.update(b, src, nil)
+		o.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-o.shutdown:
+			return
+		case <-stop:
+			return
+		case data, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			ob := (*data.(*interface{})).(orderbook.Base)
+			o.update(b, src, &ob)
+		}
+	}
+}
+
+// update replaces the levels of the exchange orderbook in the aggregated
+// orderbook and publishes it, a nil orderbook removes its levels. Orderbooks
+// of sources which are no longer subscribed to are ignored
+func (o *orderbookAggregator) update(b *aggregatedBook, src aggregatedSource, ob *orderbook.Base) {
+	var bids, asks []AggregatedOrderbookItem
+	b.m.Lock()
+	if ob != nil {
+		if _, ok := b.subscribed[src.key()]; !ok {
+			b.m.Unlock()
+			return
+		}
+		rate := b.rates[src.key()]
+		bids = aggregatedLevels(src, ob.Bids, rate, true)
+		asks = aggregatedLevels(src, ob.Asks, rate, false)
+	}
+	b.book.Bids = mergeAggregatedLevels(b.book.Bids, src, bids, true)
+	b.book.Asks = mergeAggregatedLevels(b.book.Asks, src, asks, false)
+	b.book.LastUpdated = time.Now()
+	snapshot := b.snapshot()
+	b.m.Unlock()
+
+	if err := o.mux.Publish([]uuid.UUID{b.id}, snapshot); err != nil {
+		log.Errorf(log.OrderBook, "%s: Unable to publish %s aggregated orderbook. Err: %s\n",
+			orderbookAggregatorName, snapshot.Pair, err)
+	}
+}
+
+// snapshot returns a copy of the aggregated orderbook, the caller must hold
+// the lock
+func (b *aggregatedBook) snapshot() *AggregatedOrderbook {
+	c := b.book
+	c.Exchanges = append([]string(nil), b.book.Exchanges...)
+	c.Bids = append([]AggregatedOrderbookItem(nil), b.book.Bids...)
+	c.Asks = append([]AggregatedOrderbookItem(nil), b.book.Asks...)
+	return &c
+}
+
+// aggregatedLevels returns the orderbook levels attributed to the exchange
+// orderbook with their prices converted by the rate, best first
+func aggregatedLevels(src aggregatedSource, items []orderbook.Item, rate float64, bids bool) []AggregatedOrderbookItem {
+	levels := make([]AggregatedOrderbookItem, 0, len(items))
+	for x := range items {
+		if items[x].Amount <= 0 || items[x].Price <= 0 {
+			continue
+		}
+		levels = append(levels, AggregatedOrderbookItem{
+			Exchange:      src.exchange,
+			Pair:          src.pair,
+			Amount:        items[x].Amount,
+			Price:         items[x].Price * rate,
+			OriginalPrice: items[x].Price,
+		})
+	}
+	sort.SliceStable(levels, func(i, j int) bool {
+		if bids {
+			return levels[i].Price > levels[j].Price
+		}
+		return levels[i].Price < levels[j].Price
+	})
+	return levels
+}
+
+// mergeAggregatedLevels replaces the levels of the exchange orderbook in the
+// sorted aggregated levels with its new sorted levels in a single pass.
+// Existing levels are kept ahead of new levels at the same price
+func mergeAggregatedLevels(current []AggregatedOrderbookItem, src aggregatedSource, levels []AggregatedOrderbookItem, bids bool) []AggregatedOrderbookItem {
+	merged := make([]AggregatedOrderbookItem, 0, len(current)+len(levels))
+	var i, j int
+	for i < len(current) || j < len(levels) {
+		if i < len(current) && current[i].Exchange == src.exchange &&
+			current[i].Pair.Equal(src.pair) {
+			i++
+			continue
+		}
+		if j >= len(levels) ||
+			(i < len(current) &&
+				((bids && current[i].Price >= levels[j].Price) ||
+					(!bids && current[i].Price <= levels[j].Price))) {
+			merged = append(merged, current[i])
+			i++
+			continue
+		}
+		merged = append(merged, levels[j])
+		j++
+	}
+	return merged
+}
+
+// aggregatedRate returns the rate converting prices in the from currency into
+// the to currency
+func aggregatedRate(from, to currency.Code) (float64, error) {
+	if from.Match(to) {
+		return 1, nil
+	}
+	rate, err := currency.ConvertCurrency(1, from, to)
+	if err != nil {
+		return 0, err
+	}
+	if rate <= 0 {
+		return 0, fmt.Errorf("invalid %s to %s conversion rate %v", from, to, rate)
+	}
+	return rate, nil
+}
+
+func aggregatedBookKey(p currency.Pair, convert bool) string {
+	return strings.ToUpper(p.Base.String()+"/"+p.Quote.String()) +
+		fmt.Sprintf("/%v", convert)
+}
+
+func (s aggregatedSource) key() string {
+	return s.exchange + " " + s.pair.String()
+}
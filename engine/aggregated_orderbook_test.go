@@ -0,0 +1,183 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestAggregatedLevels(t *testing.T) {
+	t.Parallel()
+	src := aggregatedSource{
+		exchange: "a",
+		pair:     currency.NewPair(currency.BTC, currency.EUR),
+	}
+	items := []orderbook.Item{
+		{Price: 100, Amount: 1},
+		{Price: 102, Amount: 1},
+		{Price: 101, Amount: 0},
+		{Price: 99, Amount: 1},
+	}
+
+	bids := aggregatedLevels(src, items, 1.5, true)
+	if len(bids) != 3 {
+		t.Fatalf("expected empty levels to be skipped, received %+v", bids)
+	}
+	if bids[0].Price != 153 || bids[0].OriginalPrice != 102 ||
+		bids[2].Price != 148.5 || bids[0].Exchange != "a" ||
+		!bids[0].Pair.Equal(src.pair) {
+		t.Errorf("unexpected converted bids %+v", bids)
+	}
+
+	asks := aggregatedLevels(src, items, 1, false)
+	if asks[0].Price != 99 || asks[2].Price != 102 {
+		t.Errorf("expected asks lowest first, received %+v", asks)
+	}
+}
+
+func TestMergeAggregatedLevels(t *testing.T) {
+	t.Parallel()
+	a := aggregatedSource{exchange: "a", pair: currency.NewPair(currency.BTC, currency.USD)}
+	b := aggregatedSource{exchange: "b", pair: currency.NewPair(currency.BTC, currency.USD)}
+	level := func(src aggregatedSource, price float64) AggregatedOrderbookItem {
+		return AggregatedOrderbookItem{
+			Exchange: src.exchange,
+			Pair:     src.pair,
+			Amount:   1,
+			Price:    price,
+		}
+	}
+
+	asks := mergeAggregatedLevels(nil, a,
+		[]AggregatedOrderbookItem{level(a, 100), level(a, 102)}, false)
+	asks = mergeAggregatedLevels(asks, b,
+		[]AggregatedOrderbookItem{level(b, 101), level(b, 102), level(b, 103)}, false)
+	expected := []AggregatedOrderbookItem{
+		level(a, 100), level(b, 101), level(a, 102), level(b, 102), level(b, 103),
+	}
+	checkAggregatedLevels(t, asks, expected)
+
+	// an update replaces only the updated exchange's levels
+	asks = mergeAggregatedLevels(asks, a, []AggregatedOrderbookItem{level(a, 101.5)}, false)
+	expected = []AggregatedOrderbookItem{
+		level(b, 101), level(a, 101.5), level(b, 102), level(b, 103),
+	}
+	checkAggregatedLevels(t, asks, expected)
+
+	// no levels removes the exchange
+	asks = mergeAggregatedLevels(asks, b, nil, false)
+	checkAggregatedLevels(t, asks, []AggregatedOrderbookItem{level(a, 101.5)})
+
+	bids := mergeAggregatedLevels(nil, a,
+		[]AggregatedOrderbookItem{level(a, 100), level(a, 98)}, true)
+	bids = mergeAggregatedLevels(bids, b,
+		[]AggregatedOrderbookItem{level(b, 99)}, true)
+	checkAggregatedLevels(t, bids, []AggregatedOrderbookItem{
+		level(a, 100), level(b, 99), level(a, 98),
+	})
+}
+
+func TestAggregatedBookSetSources(t *testing.T) {
+	t.Parallel()
+	a := aggregatedSource{exchange: "a", pair: currency.NewPair(currency.BTC, currency.USD)}
+	b := aggregatedSource{exchange: "b", pair: currency.NewPair(currency.BTC, currency.USD)}
+	stopA, stopB := make(chan struct{}), make(chan struct{})
+	book := aggregatedBook{
+		subscribed: map[string]chan struct{}{a.key(): stopA, b.key(): stopB},
+		rates:      map[string]float64{a.key(): 1, b.key(): 1},
+	}
+
+	book.setSources([]aggregatedSource{a})
+	select {
+	case <-stopB:
+	default:
+		t.Error("expected the relay of the removed source to be stopped")
+	}
+	select {
+	case <-stopA:
+		t.Error("expected the relay of the kept source to keep running")
+	default:
+	}
+	if _, ok := book.subscribed[b.key()]; ok {
+		t.Error("expected the removed source to be unsubscribed")
+	}
+	if len(book.book.Exchanges) != 1 || book.book.Exchanges[0] != "a" {
+		t.Errorf("unexpected exchanges %v", book.book.Exchanges)
+	}
+}
+
+func TestOrderbookAggregatorEvictIdle(t *testing.T) {
+	if !dispatch.IsRunning() {
+		if err := dispatch.Start(1, dispatch.DefaultJobsLimit); err != nil {
+			t.Fatal(err)
+		}
+	}
+	o := orderbookAggregator{
+		mux:   dispatch.GetNewMux(),
+		books: make(map[string]*aggregatedBook),
+	}
+	newBook := func(requested time.Time) *aggregatedBook {
+		id, err := o.mux.GetID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &aggregatedBook{
+			id:         id,
+			subscribed: make(map[string]chan struct{}),
+			rates:      make(map[string]float64),
+			requested:  requested,
+		}
+	}
+
+	stale := time.Now().Add(-AggregatorIdleTimeout)
+	idle := newBook(stale)
+	stop := make(chan struct{})
+	idle.subscribed["a"] = stop
+	o.books["idle"] = idle
+	o.books["requested"] = newBook(time.Now())
+	o.books["subscribed"] = newBook(stale)
+	pipe, err := o.mux.Subscribe(o.books["subscribed"].id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pipe.Release()
+
+	if kept := o.evictIdle(); len(kept) != 2 {
+		t.Errorf("expected 2 aggregated orderbooks to be kept, received %d", len(kept))
+	}
+	if _, ok := o.books["idle"]; ok {
+		t.Error("expected the idle aggregated orderbook to be evicted")
+	}
+	if _, ok := o.books["requested"]; !ok {
+		t.Error("expected the recently requested aggregated orderbook to be kept")
+	}
+	if _, ok := o.books["subscribed"]; !ok {
+		t.Error("expected the subscribed aggregated orderbook to be kept")
+	}
+	select {
+	case <-stop:
+	default:
+		t.Error("expected the relays of the evicted aggregated orderbook to be stopped")
+	}
+	if !idle.closed {
+		t.Error("expected the evicted aggregated orderbook to be closed")
+	}
+}
+
+func checkAggregatedLevels(t *testing.T, received, expected []AggregatedOrderbookItem) {
+	t.Helper()
+	if len(received) != len(expected) {
+		t.Fatalf("expected %d levels, received %+v", len(expected), received)
+	}
+	for x := range expected {
+		if received[x].Exchange != expected[x].Exchange ||
+			received[x].Price != expected[x].Price {
+			t.Errorf("level %d expected %s at %v, received %s at %v", x,
+				expected[x].Exchange, expected[x].Price,
+				received[x].Exchange, received[x].Price)
+		}
+	}
+}
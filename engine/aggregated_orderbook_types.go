@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+)
+
+// AggregatedOrderbookItem is an orderbook level and the exchange orderbook it
+// belongs to
+type AggregatedOrderbookItem struct {
+	Exchange string
+	// Pair is the exchange's currency pair, its quote currency differs from
+	// the aggregated orderbook's when quote currencies are converted
+	Pair   currency.Pair
+	Amount float64
+	// Price is in the aggregated orderbook's quote currency and
+	// OriginalPrice in the exchange pair's quote currency
+	Price         float64
+	OriginalPrice float64
+}
+
+// AggregatedOrderbook is the merged orderbook of a currency pair across every
+// enabled exchange, bids and asks are best first
+type AggregatedOrderbook struct {
+	Pair currency.Pair
+	// Converted is set when the orderbooks of pairs quoted in other fiat
+	// currencies are converted into the pair's quote currency
+	Converted   bool
+	Exchanges   []string
+	Bids        []AggregatedOrderbookItem
+	Asks        []AggregatedOrderbookItem
+	LastUpdated time.Time
+}
+
+type orderbookAggregator struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	m        sync.Mutex
+	books    map[string]*aggregatedBook
+	mux      *dispatch.Mux
+}
+
+// aggregatedBook holds the orderbooks merged into an aggregated orderbook and
+// the subscriptions keeping it up to date
+type aggregatedBook struct {
+	m       sync.Mutex
+	id      uuid.UUID
+	book    AggregatedOrderbook
+	sources []aggregatedSource
+	// subscribed holds the channel stopping the relay of each subscribed
+	// source
+	subscribed map[string]chan struct{}
+	// rates holds the conversion rate of each source into the aggregated
+	// quote currency
+	rates map[string]float64
+	// closed is set once the book is evicted so its sources are no longer
+	// subscribed to
+	closed bool
+	// requested is when the book was last requested, it is protected by the
+	// aggregator's lock
+	requested time.Time
+}
+
+// aggregatedSource is an exchange orderbook merged into an aggregated
+// orderbook
+type aggregatedSource struct {
+	exchange string
+	pair     currency.Pair
+}
@@ -0,0 +1,621 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const arbitrageManagerName = "Arbitrage manager"
+
+// vars for the arbitrage manager
+var (
+	// ArbitrageSubscribeDelay is how often orderbooks which could not be
+	// subscribed to are retried, an orderbook can only be subscribed to once
+	// it has been fetched by the syncer or websocket
+	ArbitrageSubscribeDelay = time.Second * 10
+	errArbitrageNoPairs     = errors.New("no arbitrage currency pairs are enabled on at least two exchanges and triangular arbitrage is disabled")
+	errArbitrageNoRiskCheck = errors.New("auto execute requires the risk manager to be started")
+)
+
+// Started returns if the arbitrage manager subsystem is started
+func (a *arbitrageManager) Started() bool {
+	return atomic.LoadInt32(&a.started) == 1
+}
+
+// Start starts the arbitrage manager subsystem, the orderbooks of each
+// configured currency pair are watched on every exchange it is enabled on and
+// exchanges are scanned for triangular arbitrage when it is enabled. Auto
+// execute is refused unless the risk manager is started to check the orders
+func (a *arbitrageManager) Start() error {
+	if !dispatch.IsRunning() {
+		return fmt.Errorf("%s requires the dispatch system to be running",
+			arbitrageManagerName)
+	}
+
+	pairs := arbitragePairs(&Bot.Config.Arbitrage)
+	triangular := Bot.Config.Arbitrage.Triangular.Enabled
+	if len(pairs) == 0 && !triangular {
+		return fmt.Errorf("%s %s", arbitrageManagerName, errArbitrageNoPairs)
+	}
+
+	if Bot.Config.Arbitrage.AutoExecute && !Bot.RiskManager.Started() {
+		return fmt.Errorf("%s %s", arbitrageManagerName, errArbitrageNoRiskCheck)
+	}
+
+	if atomic.AddInt32(&a.started, 1) != 1 {
+		return fmt.Errorf("%s %s", arbitrageManagerName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.OrderMgr, arbitrageManagerName, MsgSubSystemStarting)
+	a.m.Lock()
+	if a.mux == nil {
+		a.mux = dispatch.GetNewMux()
+	}
+	if a.id == (uuid.UUID{}) {
+		id, err := a.mux.GetID()
+		if err != nil {
+			a.m.Unlock()
+			atomic.CompareAndSwapInt32(&a.started, 1, 0)
+			return err
+		}
+		a.id = id
+	}
+	a.pairs = pairs
+	a.opportunities = make(map[string]*ArbitrageOpportunity)
+	a.cycles = make(map[string]bool)
+	if a.executed == nil {
+		a.executed = make(map[string]time.Time)
+	}
+	a.m.Unlock()
+
+	a.shutdown = make(chan struct{})
+	for x := range pairs {
+		a.wg.Add(1)
+		go a.watch(pairs[x])
+	}
+	a.wg.Add(1)
+	go a.run()
+	if triangular {
+		a.wg.Add(1)
+		go a.runTriangular()
+	}
+	log.Debugln(log.OrderMgr, arbitrageManagerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the arbitrage manager subsystem
+func (a *arbitrageManager) Stop() error {
+	if atomic.LoadInt32(&a.started) == 0 {
+		return fmt.Errorf("%s %s", arbitrageManagerName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&a.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", arbitrageManagerName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.OrderMgr, arbitrageManagerName, MsgSubSystemShuttingDown)
+	close(a.shutdown)
+	a.wg.Wait()
+	atomic.CompareAndSwapInt32(&a.stopped, 1, 0)
+	atomic.CompareAndSwapInt32(&a.started, 1, 0)
+	log.Debugln(log.OrderMgr, arbitrageManagerName, MsgSubSystemShutdown)
+	return nil
+}
+
+// arbitragePairs returns the configured currency pairs with the enabled
+// exchanges they are enabled on, pairs enabled on less than two exchanges are
+// skipped
+func arbitragePairs(cfg *config.ArbitrageConfig) []*arbitragePair {
+	var pairs []*arbitragePair
+	for x := range cfg.Pairs {
+		var exchanges []string
+		for y := range Bot.Exchanges {
+			exch := Bot.Exchanges[y]
+			if !exch.IsEnabled() {
+				continue
+			}
+			if len(cfg.Exchanges) > 0 &&
+				!common.StringDataCompareInsensitive(cfg.Exchanges, exch.GetName()) {
+				continue
+			}
+			if !exch.GetEnabledPairs(asset.Spot).Contains(cfg.Pairs[x], true) {
+				continue
+			}
+			exchanges = append(exchanges, exch.GetName())
+		}
+		if len(exchanges) < 2 {
+			log.Warnf(log.OrderMgr, "%s: %s is enabled on less than two exchanges, skipping.\n",
+				arbitrageManagerName, cfg.Pairs[x])
+			continue
+		}
+		pairs = append(pairs, &arbitragePair{
+			pair:       cfg.Pairs[x],
+			exchanges:  exchanges,
+			books:      make(map[string]*orderbook.Base),
+			subscribed: make(map[string]bool),
+			notify:     make(chan struct{}, 1),
+		})
+	}
+	return pairs
+}
+
+func (a *arbitrageManager) run() {
+	tick := time.NewTicker(ArbitrageSubscribeDelay)
+	defer func() {
+		tick.Stop()
+		a.wg.Done()
+	}()
+
+	a.subscribe()
+	for {
+		select {
+		case <-a.shutdown:
+			return
+		case <-tick.C:
+			a.subscribe()
+		}
+	}
+}
+
+// subscribe subscribes to each watched orderbook which is not yet subscribed
+// to
+func (a *arbitrageManager) subscribe() {
+	for x := range a.pairs {
+		p := a.pairs[x]
+		for y := range p.exchanges {
+			exchName := p.exchanges[y]
+			p.m.Lock()
+			if p.subscribed[exchName] {
+				p.m.Unlock()
+				continue
+			}
+			pipe, err := orderbook.SubscribeOrderbook(exchName, p.pair, asset.Spot)
+			if err != nil {
+				// the orderbook has not been fetched yet
+				p.m.Unlock()
+				continue
+			}
+			p.subscribed[exchName] = true
+			p.m.Unlock()
+
+			if ob, err := orderbook.Get(exchName, p.pair, asset.Spot); err == nil {
+				p.update(exchName, ob)
+			}
+			a.wg.Add(1)
+			go a.relay(p, exchName, pipe)
+		}
+	}
+}
+
+// relay stores each orderbook update received for the exchange until the
+// pipe closes or the manager is stopped
+func (a *arbitrageManager) relay(p *arbitragePair, exchName string, pipe dispatch.Pipe) {
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Errorf(log.OrderMgr, "%s: Unable to release %s %s orderbook pipe. Err: %s\n",
+				arbitrageManagerName, exchName, p.pair, err)
+		}
+		p.m.Lock()
+		p.subscribed[exchName] = false
+		delete(p.books, exchName)
+		p.m.Unlock()
+		a.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-a.shutdown:
+			return
+		case data, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			ob := (*data.(*interface{})).(orderbook.Base)
+			p.update(exchName, &ob)
+		}
+	}
+}
+
+// update stores a sorted copy of the orderbook and notifies the pair's
+// evaluator
+func (p *arbitragePair) update(exchName string, ob *orderbook.Base) {
+	cpy := *ob
+	cpy.Bids = append([]orderbook.Item(nil), ob.Bids...)
+	cpy.Asks = append([]orderbook.Item(nil), ob.Asks...)
+	sort.Slice(cpy.Bids, func(i, j int) bool { return cpy.Bids[i].Price > cpy.Bids[j].Price })
+	sort.Slice(cpy.Asks, func(i, j int) bool { return cpy.Asks[i].Price < cpy.Asks[j].Price })
+
+	p.m.Lock()
+	p.books[exchName] = &cpy
+	p.m.Unlock()
+
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+// watch evaluates the pair each time one of its orderbooks updates
+func (a *arbitrageManager) watch(p *arbitragePair) {
+	defer a.wg.Done()
+	for {
+		select {
+		case <-a.shutdown:
+			return
+		case <-p.notify:
+			a.evaluate(p)
+		}
+	}
+}
+
+// evaluate finds the opportunities between every ordered pair of exchanges,
+// publishes them and executes them when auto execution is enabled
+func (a *arbitrageManager) evaluate(p *arbitragePair) {
+	p.m.Lock()
+	books := make(map[string]*orderbook.Base, len(p.books))
+	for k, v := range p.books {
+		books[k] = v
+	}
+	p.m.Unlock()
+
+	var found []*ArbitrageOpportunity
+	for x := range p.exchanges {
+		buyBook := books[p.exchanges[x]]
+		if buyBook == nil || len(buyBook.Asks) == 0 {
+			continue
+		}
+		for y := range p.exchanges {
+			sellBook := books[p.exchanges[y]]
+			if x == y || sellBook == nil || len(sellBook.Bids) == 0 ||
+				sellBook.Bids[0].Price <= buyBook.Asks[0].Price {
+				continue
+			}
+			o, err := a.opportunity(p.exchanges[x], p.exchanges[y], p.pair,
+				buyBook, sellBook, Bot.Config.Arbitrage.MaxAmount)
+			if err != nil {
+				log.Errorf(log.OrderMgr, "%s: Unable to evaluate %s buying on %s and selling on %s. Err: %s\n",
+					arbitrageManagerName, p.pair, p.exchanges[x], p.exchanges[y], err)
+				continue
+			}
+			if o != nil {
+				found = append(found, o)
+			}
+		}
+	}
+
+	a.publish(p.pair, found)
+
+	// orders are only submitted while the risk manager checks them
+	if !Bot.Config.Arbitrage.AutoExecute || !Bot.RiskManager.Started() {
+		return
+	}
+	for x := range found {
+		a.execute(found[x], books[found[x].BuyExchange], books[found[x].SellExchange])
+	}
+}
+
+// opportunity returns the opportunity of buying the pair on one exchange and
+// selling it on another after fees, nil is returned when it does not meet the
+// configured profit thresholds
+func (a *arbitrageManager) opportunity(buyExch, sellExch string, p currency.Pair, buyBook, sellBook *orderbook.Base, maxAmount float64) (*ArbitrageOpportunity, error) {
+	buyRate, err := exchangeFees.tradeFeeRate(buyExch, p, buyBook.Asks[0].Price)
+	if err != nil {
+		return nil, err
+	}
+	sellRate, err := exchangeFees.tradeFeeRate(sellExch, p, sellBook.Bids[0].Price)
+	if err != nil {
+		return nil, err
+	}
+
+	o := findArbitrage(buyBook, sellBook, buyRate, sellRate, maxAmount)
+	if o == nil {
+		return nil, nil
+	}
+	o.Pair = p
+	o.BuyExchange = buyExch
+	o.SellExchange = sellExch
+	o.Time = time.Now()
+
+	if Bot.Config.Arbitrage.IncludeWithdrawalFees {
+		o.WithdrawalFees, err = a.rebalanceFees(o)
+		if err != nil {
+			return nil, err
+		}
+		o.NetProfit -= o.WithdrawalFees
+		o.NetProfitPercent = o.NetProfit / o.Cost * 100
+	}
+
+	if !meetsArbitrageThresholds(o, &Bot.Config.Arbitrage) {
+		return nil, nil
+	}
+	return o, nil
+}
+
+// findArbitrage walks the asks of the buy orderbook and the bids of the sell
+// orderbook while buying and selling the next unit is profitable after the
+// taker fee rates. The amount is capped at maxAmount when it is set and nil is
+// returned when nothing is profitable. The orderbooks must be sorted best
+// price first
+func findArbitrage(buyBook, sellBook *orderbook.Base, buyFeeRate, sellFeeRate, maxAmount float64) *ArbitrageOpportunity {
+	asks, bids := buyBook.Asks, sellBook.Bids
+	if len(asks) == 0 || len(bids) == 0 {
+		return nil
+	}
+
+	var o ArbitrageOpportunity
+	var a, b int
+	askLeft, bidLeft := asks[0].Amount, bids[0].Amount
+	for a < len(asks) && b < len(bids) {
+		ask, bid := asks[a].Price, bids[b].Price
+		if bid*(1-sellFeeRate) <= ask*(1+buyFeeRate) {
+			break
+		}
+
+		size := math.Min(askLeft, bidLeft)
+		if maxAmount > 0 && o.Amount+size > maxAmount {
+			size = maxAmount - o.Amount
+		}
+		if size > 0 {
+			o.Amount += size
+			o.Cost += size * ask
+			o.Proceeds += size * bid
+			o.BuyLimit = ask
+			o.SellLimit = bid
+		}
+		if maxAmount > 0 && o.Amount >= maxAmount {
+			break
+		}
+
+		askLeft -= size
+		bidLeft -= size
+		if askLeft <= 0 {
+			a++
+			if a < len(asks) {
+				askLeft = asks[a].Amount
+			}
+		}
+		if bidLeft <= 0 {
+			b++
+			if b < len(bids) {
+				bidLeft = bids[b].Amount
+			}
+		}
+	}
+
+	if o.Amount <= 0 {
+		return nil
+	}
+	o.BuyPrice = o.Cost / o.Amount
+	o.SellPrice = o.Proceeds / o.Amount
+	o.TradingFees = o.Cost*buyFeeRate + o.Proceeds*sellFeeRate
+	o.NetProfit = o.Proceeds - o.Cost - o.TradingFees
+	o.NetProfitPercent = o.NetProfit / o.Cost * 100
+	return &o
+}
+
+// meetsArbitrageThresholds returns if the opportunity is profitable and meets
+// the configured minimum profits
+func meetsArbitrageThresholds(o *ArbitrageOpportunity, cfg *config.ArbitrageConfig) bool {
+	return o.NetProfit > 0 &&
+		o.NetProfit >= cfg.MinProfit &&
+		o.NetProfitPercent >= cfg.MinProfitPercent
+}
+
+// rebalanceFees returns the withdrawal fees valued in the quote currency of
+// moving the bought currency to the selling exchange when its balance there
+// cannot cover the amount, and of moving the proceeds back to the buying
+// exchange when its quote balance cannot cover the cost
+func (a *arbitrageManager) rebalanceFees(o *ArbitrageOpportunity) (float64, error) {
+	var fees float64
+	if availableBalance(o.SellExchange, o.Pair.Base) < o.Amount {
+		fee, err := exchangeFees.withdrawalFee(o.BuyExchange, o.Pair.Base, o.Amount)
+		if err != nil {
+			return 0, err
+		}
+		fees += fee * o.SellPrice
+	}
+	if availableBalance(o.BuyExchange, o.Pair.Quote) < o.Cost {
+		fee, err := exchangeFees.withdrawalFee(o.SellExchange, o.Pair.Quote, o.Proceeds)
+		if err != nil {
+			return 0, err
+		}
+		fees += fee
+	}
+	return fees, nil
+}
+
+// availableBalance returns the balance of the currency not on hold across the
+// exchange's accounts, zero is returned if the holdings are unavailable
+func availableBalance(exchName string, c currency.Code) float64 {
+	h, err := account.GetHoldings(exchName)
+	if err != nil {
+		return 0
+	}
+	var available float64
+	for x := range h.Accounts {
+		for y := range h.Accounts[x].Currencies {
+			b := h.Accounts[x].Currencies[y]
+			if b.CurrencyName.Match(c) {
+				available += b.TotalValue - b.Hold
+			}
+		}
+	}
+	return available
+}
+
+func arbitrageKey(o *ArbitrageOpportunity) string {
+	return strings.ToLower(o.Pair.String() + "|" + o.BuyExchange + "|" + o.SellExchange)
+}
+
+// publish replaces the open opportunities of the pair, opportunities which are
+// new or have changed are published to subscribers and new opportunities are
+// pushed to the communications manager
+func (a *arbitrageManager) publish(p currency.Pair, found []*ArbitrageOpportunity) {
+	var changed, opened []*ArbitrageOpportunity
+	seen := make(map[string]bool, len(found))
+	a.m.Lock()
+	for x := range found {
+		key := arbitrageKey(found[x])
+		seen[key] = true
+		prev, ok := a.opportunities[key]
+		switch {
+		case !ok:
+			opened = append(opened, found[x])
+			changed = append(changed, found[x])
+		case prev.Amount != found[x].Amount || prev.NetProfit != found[x].NetProfit:
+			changed = append(changed, found[x])
+		}
+		a.opportunities[key] = found[x]
+	}
+	for k, v := range a.opportunities {
+		if v.Pair.Equal(p) && !seen[k] {
+			delete(a.opportunities, k)
+		}
+	}
+	mux, id := a.mux, a.id
+	a.m.Unlock()
+
+	for x := range changed {
+		if err := mux.Publish([]uuid.UUID{id}, changed[x]); err != nil {
+			log.Errorf(log.OrderMgr, "%s: Unable to publish opportunity. Err: %s\n",
+				arbitrageManagerName, err)
+		}
+	}
+	for x := range opened {
+		o := opened[x]
+		msg := fmt.Sprintf("%s: %s buy %v on %s at %v and sell on %s at %v for a net profit of %.8f %s (%.4f%%)",
+			arbitrageManagerName, o.Pair, o.Amount, o.BuyExchange, o.BuyPrice,
+			o.SellExchange, o.SellPrice, o.NetProfit, o.Pair.Quote, o.NetProfitPercent)
+		log.Infoln(log.OrderMgr, msg)
+		Bot.CommsManager.PushEvent(base.Event{
+			Type:    "arbitrage",
+			Message: msg,
+		})
+	}
+}
+
+// GetOpportunities returns the open opportunities, most profitable first. An
+// empty pair returns the opportunities of every pair
+func (a *arbitrageManager) GetOpportunities(p currency.Pair) []ArbitrageOpportunity {
+	a.m.Lock()
+	var resp []ArbitrageOpportunity
+	for _, v := range a.opportunities {
+		if p.IsEmpty() || v.Pair.Equal(p) {
+			resp = append(resp, *v)
+		}
+	}
+	a.m.Unlock()
+	sort.Slice(resp, func(i, j int) bool { return resp[i].NetProfit > resp[j].NetProfit })
+	return resp
+}
+
+// SubscribeOpportunities returns a pipe which receives an ArbitrageOpportunity
+// each time an opportunity opens or changes
+func (a *arbitrageManager) SubscribeOpportunities() (dispatch.Pipe, error) {
+	if !a.Started() {
+		return dispatch.Pipe{}, fmt.Errorf("%s %s", arbitrageManagerName, ErrSubSystemNotStarted)
+	}
+	a.m.Lock()
+	mux, id := a.mux, a.id
+	a.m.Unlock()
+	return mux.Subscribe(id)
+}
+
+// execute submits limit orders for both legs of the opportunity through the
+// order manager once the execution cooldown has passed. The amount is capped
+// to the balances available on each exchange so no funds need to be moved
+func (a *arbitrageManager) execute(o *ArbitrageOpportunity, buyBook, sellBook *orderbook.Base) {
+	if !Bot.OrderManager.Started() || buyBook == nil || sellBook == nil {
+		return
+	}
+
+	key := arbitrageKey(o)
+	a.m.Lock()
+	last, ok := a.executed[key]
+	a.m.Unlock()
+	if ok && time.Since(last) < Bot.Config.Arbitrage.ExecutionCooldown {
+		return
+	}
+
+	buyRate, err := exchangeFees.tradeFeeRate(o.BuyExchange, o.Pair, o.BuyLimit)
+	if err != nil {
+		return
+	}
+	amount := math.Min(o.Amount, availableBalance(o.SellExchange, o.Pair.Base))
+	amount = math.Min(amount,
+		availableBalance(o.BuyExchange, o.Pair.Quote)/(o.BuyLimit*(1+buyRate)))
+	if amount <= 0 {
+		log.Debugf(log.OrderMgr, "%s: Insufficient balances to execute %s buying on %s and selling on %s.\n",
+			arbitrageManagerName, o.Pair, o.BuyExchange, o.SellExchange)
+		return
+	}
+	if amount < o.Amount {
+		o, err = a.opportunity(o.BuyExchange, o.SellExchange, o.Pair, buyBook, sellBook, amount)
+		if err != nil || o == nil {
+			return
+		}
+	}
+
+	a.m.Lock()
+	a.executed[key] = time.Now()
+	a.m.Unlock()
+
+	buy, err := Bot.OrderManager.Submit(o.BuyExchange, &order.Submit{
+		Pair:      o.Pair,
+		OrderType: order.Limit,
+		OrderSide: order.Buy,
+		Price:     o.BuyLimit,
+		Amount:    o.Amount,
+	})
+	if err != nil {
+		a.notify(fmt.Sprintf("%s: Unable to execute %s buy order on %s. Err: %s",
+			arbitrageManagerName, o.Pair, o.BuyExchange, err), true)
+		return
+	}
+
+	sell, err := Bot.OrderManager.Submit(o.SellExchange, &order.Submit{
+		Pair:      o.Pair,
+		OrderType: order.Limit,
+		OrderSide: order.Sell,
+		Price:     o.SellLimit,
+		Amount:    o.Amount,
+	})
+	if err != nil {
+		a.notify(fmt.Sprintf("%s: %s buy order ID=%v placed on %s but the sell order on %s failed, the position is unhedged. Err: %s",
+			arbitrageManagerName, o.Pair, buy.OrderID, o.BuyExchange, o.SellExchange, err), true)
+		return
+	}
+
+	a.notify(fmt.Sprintf("%s: Executed %s buying %v on %s order ID=%v and selling on %s order ID=%v for an expected net profit of %.8f %s",
+		arbitrageManagerName, o.Pair, o.Amount, o.BuyExchange, buy.OrderID,
+		o.SellExchange, sell.OrderID, o.NetProfit, o.Pair.Quote), false)
+}
+
+func (a *arbitrageManager) notify(msg string, failed bool) {
+	if failed {
+		log.Errorln(log.OrderMgr, msg)
+	} else {
+		log.Infoln(log.OrderMgr, msg)
+	}
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "arbitrage",
+		Message: msg,
+	})
+}
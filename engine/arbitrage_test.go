@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestFindArbitrage(t *testing.T) {
+	t.Parallel()
+	buy := &orderbook.Base{
+		Asks: []orderbook.Item{
+			{Price: 100, Amount: 1},
+			{Price: 101, Amount: 2},
+			{Price: 105, Amount: 5},
+		},
+	}
+	sell := &orderbook.Base{
+		Bids: []orderbook.Item{
+			{Price: 104, Amount: 2},
+			{Price: 102, Amount: 3},
+		},
+	}
+
+	// without fees the asks at 100 and 101 can be sold into the bids at 104
+	// and 102, the ask at 105 is above every bid
+	o := findArbitrage(buy, sell, 0, 0, 0)
+	if o == nil {
+		t.Fatal("expected an opportunity")
+	}
+	if o.Amount != 3 || o.Cost != 302 || o.Proceeds != 310 || o.NetProfit != 8 {
+		t.Errorf("unexpected opportunity %+v", o)
+	}
+	if o.BuyLimit != 101 || o.SellLimit != 102 {
+		t.Errorf("expected limits 101 and 102, received %v and %v", o.BuyLimit, o.SellLimit)
+	}
+
+	// a 1% fee on each side makes the 101 ask sold at 102 unprofitable
+	o = findArbitrage(buy, sell, 0.01, 0.01, 0)
+	if o == nil {
+		t.Fatal("expected an opportunity")
+	}
+	if o.Amount != 2 || o.Cost != 201 || o.Proceeds != 208 {
+		t.Errorf("unexpected opportunity %+v", o)
+	}
+	if math.Abs(o.TradingFees-4.09) > 1e-9 || math.Abs(o.NetProfit-2.91) > 1e-9 {
+		t.Errorf("expected fees 4.09 and net profit 2.91, received %v and %v",
+			o.TradingFees, o.NetProfit)
+	}
+
+	o = findArbitrage(buy, sell, 0, 0, 1.5)
+	if o == nil || o.Amount != 1.5 || o.BuyLimit != 101 || o.SellLimit != 104 {
+		t.Errorf("expected the amount to be capped, received %+v", o)
+	}
+
+	if o = findArbitrage(buy, sell, 0.05, 0.05, 0); o != nil {
+		t.Errorf("expected fees to remove the opportunity, received %+v", o)
+	}
+	if o = findArbitrage(sell, buy, 0, 0, 0); o != nil {
+		t.Errorf("expected no opportunity without asks, received %+v", o)
+	}
+}
+
+func TestMeetsArbitrageThresholds(t *testing.T) {
+	t.Parallel()
+	cfg := &config.ArbitrageConfig{MinProfit: 5, MinProfitPercent: 1}
+	for _, tc := range []struct {
+		profit, percent float64
+		expected        bool
+	}{
+		{10, 2, true},
+		{4, 2, false},
+		{10, 0.5, false},
+		{-1, -1, false},
+	} {
+		o := &ArbitrageOpportunity{NetProfit: tc.profit, NetProfitPercent: tc.percent}
+		if meetsArbitrageThresholds(o, cfg) != tc.expected {
+			t.Errorf("expected %v for %+v", tc.expected, o)
+		}
+	}
+	if meetsArbitrageThresholds(&ArbitrageOpportunity{}, &config.ArbitrageConfig{}) {
+		t.Error("expected an opportunity without profit to be rejected")
+	}
+}
+
+func TestArbitragePublish(t *testing.T) {
+	SetupTestHelpers(t)
+	btcusd := currency.NewPair(currency.BTC, currency.USD)
+	ltcusd := currency.NewPair(currency.LTC, currency.USD)
+	a := arbitrageManager{
+		opportunities: make(map[string]*ArbitrageOpportunity),
+		mux:           dispatch.GetNewMux(),
+	}
+
+	a.publish(btcusd, []*ArbitrageOpportunity{
+		{Pair: btcusd, BuyExchange: "Bitstamp", SellExchange: "Kraken", NetProfit: 5},
+		{Pair: btcusd, BuyExchange: "Kraken", SellExchange: "Bitstamp", NetProfit: 10},
+	})
+	a.publish(ltcusd, []*ArbitrageOpportunity{
+		{Pair: ltcusd, BuyExchange: "Bitstamp", SellExchange: "Kraken", NetProfit: 1},
+	})
+
+	o := a.GetOpportunities(currency.Pair{})
+	if len(o) != 3 || o[0].NetProfit != 10 || o[2].NetProfit != 1 {
+		t.Errorf("expected opportunities sorted by profit, received %+v", o)
+	}
+
+	// opportunities of the pair which are no longer found are closed
+	a.publish(btcusd, []*ArbitrageOpportunity{
+		{Pair: btcusd, BuyExchange: "Bitstamp", SellExchange: "Kraken", NetProfit: 6},
+	})
+	o = a.GetOpportunities(btcusd)
+	if len(o) != 1 || o[0].NetProfit != 6 {
+		t.Errorf("unexpected opportunities %+v", o)
+	}
+	if o = a.GetOpportunities(ltcusd); len(o) != 1 {
+		t.Errorf("expected other pairs to be unaffected, received %+v", o)
+	}
+
+	if _, err := a.SubscribeOpportunities(); err == nil {
+		t.Error("expected subscribing to a stopped manager to return an error")
+	}
+}
+
+func TestArbitrageStartRequiresRiskManager(t *testing.T) {
+	SetupTestHelpers(t)
+	if !dispatch.IsRunning() {
+		if err := dispatch.Start(1, dispatch.DefaultJobsLimit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	arbCfg := Bot.Config.Arbitrage
+	defer func() { Bot.Config.Arbitrage = arbCfg }()
+	Bot.Config.Arbitrage = config.ArbitrageConfig{
+		Enabled:     true,
+		AutoExecute: true,
+		Triangular:  config.TriangularArbitrageConfig{Enabled: true},
+	}
+
+	var a arbitrageManager
+	if err := a.Start(); err == nil || !strings.Contains(err.Error(), errArbitrageNoRiskCheck.Error()) {
+		t.Errorf("expected auto execute without the risk manager to be refused, received %v", err)
+	}
+	if a.Started() {
+		t.Error("expected the arbitrage manager not to be started")
+	}
+}
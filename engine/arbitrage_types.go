@@ -0,0 +1,63 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// ArbitrageOpportunity is a spread between two exchanges which can be bought
+// on one and sold on the other for a profit. Prices, costs and profits are in
+// the quote currency of the pair, the amount in the base currency
+type ArbitrageOpportunity struct {
+	Pair         currency.Pair
+	BuyExchange  string
+	SellExchange string
+	Amount       float64
+	// BuyPrice and SellPrice are the average prices the amount executes at
+	BuyPrice  float64
+	SellPrice float64
+	// BuyLimit and SellLimit are the worst orderbook levels reached and are
+	// used as the limit prices when the opportunity is executed
+	BuyLimit         float64
+	SellLimit        float64
+	Cost             float64
+	Proceeds         float64
+	TradingFees      float64
+	WithdrawalFees   float64
+	NetProfit        float64
+	NetProfitPercent float64
+	Time             time.Time
+}
+
+type arbitrageManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	m        sync.Mutex
+	pairs    []*arbitragePair
+	// opportunities holds the open opportunities by key
+	opportunities map[string]*ArbitrageOpportunity
+	// executed holds when an opportunity key was last executed
+	executed map[string]time.Time
+	// cycles holds the keys of the open triangular cycles
+	cycles map[string]bool
+	mux    *dispatch.Mux
+	id     uuid.UUID
+}
+
+// arbitragePair holds the latest orderbook of each exchange watched for a
+// currency pair
+type arbitragePair struct {
+	pair       currency.Pair
+	exchanges  []string
+	m          sync.Mutex
+	books      map[string]*orderbook.Base
+	subscribed map[string]bool
+	notify     chan struct{}
+}
@@ -0,0 +1,207 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const (
+	autoLendManagerName = "Auto-lend manager"
+	// autoLendRateTolerance is the relative difference to the target rate an
+	// open offer is left at, it allows for rounding by the exchange
+	autoLendRateTolerance = 0.001
+)
+
+var (
+	errAutoLendNoCurrencies = errors.New("no auto-lend currencies are set")
+	errLendingNotSupported  = errors.New("exchange does not support lending")
+)
+
+// Started returns if the auto-lend manager subsystem is started
+func (a *autoLendManager) Started() bool {
+	return atomic.LoadInt32(&a.started) == 1
+}
+
+// Start starts the auto-lend manager subsystem, the idle balance of each
+// configured currency is offered for lending every check interval
+func (a *autoLendManager) Start() error {
+	if len(Bot.Config.AutoLend.Currencies) == 0 {
+		return fmt.Errorf("%s %s", autoLendManagerName, errAutoLendNoCurrencies)
+	}
+
+	if atomic.AddInt32(&a.started, 1) != 1 {
+		return fmt.Errorf("%s %s", autoLendManagerName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.OrderMgr, autoLendManagerName, MsgSubSystemStarting)
+	a.shutdown = make(chan struct{})
+	a.wg.Add(1)
+	go a.run()
+	log.Debugln(log.OrderMgr, autoLendManagerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the auto-lend manager subsystem, open offers are left on the
+// exchanges
+func (a *autoLendManager) Stop() error {
+	if atomic.LoadInt32(&a.started) == 0 {
+		return fmt.Errorf("%s %s", autoLendManagerName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&a.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", autoLendManagerName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.OrderMgr, autoLendManagerName, MsgSubSystemShuttingDown)
+	close(a.shutdown)
+	a.wg.Wait()
+	atomic.CompareAndSwapInt32(&a.stopped, 1, 0)
+	atomic.CompareAndSwapInt32(&a.started, 1, 0)
+	log.Debugln(log.OrderMgr, autoLendManagerName, MsgSubSystemShutdown)
+	return nil
+}
+
+func (a *autoLendManager) run() {
+	tick := time.NewTicker(Bot.Config.AutoLend.CheckInterval)
+	defer func() {
+		tick.Stop()
+		a.wg.Done()
+	}()
+
+	a.check()
+	for {
+		select {
+		case <-a.shutdown:
+			return
+		case <-tick.C:
+			a.check()
+		}
+	}
+}
+
+// check re-offers the idle balance of each configured currency
+func (a *autoLendManager) check() {
+	for x := range Bot.Config.AutoLend.Currencies {
+		cfg := &Bot.Config.AutoLend.Currencies[x]
+		if err := autoLend(cfg); err != nil {
+			log.Errorf(log.OrderMgr, "%s: Unable to lend %s on %s. Err: %s\n",
+				autoLendManagerName, cfg.Currency, cfg.Exchange, err)
+		}
+	}
+}
+
+// autoLend cancels the open lend offers of the currency which are no longer
+// at the rate derived from the lend book, then offers the currency's idle
+// balance at that rate
+func autoLend(cfg *config.AutoLendCurrencyConfig) error {
+	exch := GetExchangeByName(cfg.Exchange)
+	if exch == nil {
+		return ErrExchangeNotFound
+	}
+	lender, ok := exch.(exchange.Lender)
+	if !ok {
+		return errLendingNotSupported
+	}
+
+	book, err := lender.GetLendBook(cfg.Currency)
+	if err != nil {
+		return err
+	}
+	rate, err := autoLendRate(&book, cfg)
+	if err != nil {
+		return err
+	}
+
+	offers, err := lender.GetFundingOffers()
+	if err != nil {
+		return err
+	}
+	offers = lending.Filter(offers, cfg.Currency, lending.Lend)
+	for x := range offers {
+		if math.Abs(offers[x].Rate-rate) <= rate*autoLendRateTolerance {
+			continue
+		}
+		if err = lender.CancelFundingOffer(offers[x].ID); err != nil {
+			return err
+		}
+		log.Debugf(log.OrderMgr, "%s: Cancelled %s %s offer %s at rate %v, target rate %v.\n",
+			autoLendManagerName, exch.GetName(), cfg.Currency, offers[x].ID,
+			offers[x].Rate, rate)
+	}
+
+	// cancelled offers release their funds, the holdings are fetched after
+	// cancelling so they are offered again
+	h, err := exch.UpdateAccountInfo()
+	if err != nil {
+		return err
+	}
+	amount := idleLendBalance(&h, cfg)
+	if amount <= 0 || amount < cfg.MinAmount {
+		return nil
+	}
+
+	id, err := lender.SubmitFundingOffer(&lending.OfferRequest{
+		Currency:  cfg.Currency,
+		Side:      lending.Lend,
+		Amount:    amount,
+		Rate:      rate,
+		Period:    cfg.Period,
+		AutoRenew: cfg.AutoRenew,
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof(log.OrderMgr, "%s: Offered %v %s on %s at a daily rate of %v for %d days, offer ID %s.\n",
+		autoLendManagerName, amount, cfg.Currency, exch.GetName(), rate,
+		cfg.Period, id)
+	return nil
+}
+
+// autoLendRate returns the rate to offer the currency at, the rate of the
+// lend book offer at the configured depth bounded by the minimum rate. The
+// minimum rate is used when the book has no offers
+func autoLendRate(book *lending.Book, cfg *config.AutoLendCurrencyConfig) (float64, error) {
+	rate, err := book.RateAtDepth(cfg.BookDepth)
+	if err != nil {
+		if cfg.MinRate > 0 {
+			return cfg.MinRate, nil
+		}
+		return 0, err
+	}
+	if rate < cfg.MinRate {
+		return cfg.MinRate, nil
+	}
+	return rate, nil
+}
+
+// idleLendBalance returns the balance of the currency not on hold in the
+// configured account, less the balance which is kept
+func idleLendBalance(h *account.Holdings, cfg *config.AutoLendCurrencyConfig) float64 {
+	var available float64
+	for x := range h.Accounts {
+		if cfg.Account != "" && !strings.EqualFold(h.Accounts[x].ID, cfg.Account) {
+			continue
+		}
+		for y := range h.Accounts[x].Currencies {
+			b := h.Accounts[x].Currencies[y]
+			if b.CurrencyName.Match(cfg.Currency) {
+				available += b.TotalValue - b.Hold
+			}
+		}
+	}
+	available -= cfg.KeepBalance
+	if available < 0 {
+		return 0
+	}
+	return available
+}
@@ -0,0 +1,122 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitfinex"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/okcoin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/okex"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/poloniex"
+)
+
+func TestAutoLendRate(t *testing.T) {
+	t.Parallel()
+	book := &lending.Book{
+		Exchange: "test",
+		Currency: currency.USD,
+		Offers: []lending.BookItem{
+			{Amount: 1000, Rate: 0.0001},
+			{Amount: 5000, Rate: 0.0003},
+		},
+	}
+	cfg := &config.AutoLendCurrencyConfig{BookDepth: 2000}
+	rate, err := autoLendRate(book, cfg)
+	if err != nil || rate != 0.0003 {
+		t.Errorf("expected the rate at depth 0.0003, received %v %v", rate, err)
+	}
+
+	cfg.MinRate = 0.0005
+	rate, err = autoLendRate(book, cfg)
+	if err != nil || rate != 0.0005 {
+		t.Errorf("expected the min rate 0.0005, received %v %v", rate, err)
+	}
+
+	book.Offers = nil
+	rate, err = autoLendRate(book, cfg)
+	if err != nil || rate != 0.0005 {
+		t.Errorf("expected the min rate for an empty book, received %v %v", rate, err)
+	}
+	cfg.MinRate = 0
+	if _, err = autoLendRate(book, cfg); err == nil {
+		t.Error("expected an error for an empty book without a min rate")
+	}
+}
+
+func TestIdleLendBalance(t *testing.T) {
+	t.Parallel()
+	h := &account.Holdings{
+		Accounts: []account.SubAccount{
+			{
+				ID: "deposit",
+				Currencies: []account.Balance{
+					{CurrencyName: currency.USD, TotalValue: 1000, Hold: 250},
+					{CurrencyName: currency.BTC, TotalValue: 1},
+				},
+			},
+			{
+				ID: "exchange",
+				Currencies: []account.Balance{
+					{CurrencyName: currency.USD, TotalValue: 500},
+				},
+			},
+		},
+	}
+
+	cfg := &config.AutoLendCurrencyConfig{Currency: currency.USD}
+	if b := idleLendBalance(h, cfg); b != 1250 {
+		t.Errorf("expected 1250 across every account, received %v", b)
+	}
+	cfg.Account = "DEPOSIT"
+	if b := idleLendBalance(h, cfg); b != 750 {
+		t.Errorf("expected 750 in the deposit account, received %v", b)
+	}
+	cfg.KeepBalance = 500
+	if b := idleLendBalance(h, cfg); b != 250 {
+		t.Errorf("expected 250 after the kept balance, received %v", b)
+	}
+	cfg.KeepBalance = 1000
+	if b := idleLendBalance(h, cfg); b != 0 {
+		t.Errorf("expected no idle balance, received %v", b)
+	}
+}
+
+func TestAutoLendManagerStartStop(t *testing.T) {
+	SetupTestHelpers(t)
+	var a autoLendManager
+	if err := a.Stop(); err == nil {
+		t.Error("expected an error stopping a manager which is not started")
+	}
+
+	currencies := Bot.Config.AutoLend.Currencies
+	Bot.Config.AutoLend.Currencies = nil
+	if err := a.Start(); err == nil {
+		t.Error("expected an error starting without currencies")
+	}
+	Bot.Config.AutoLend.Currencies = currencies
+}
+
+func TestLenders(t *testing.T) {
+	t.Parallel()
+	lenders := []exchange.IBotExchange{
+		new(bitfinex.Bitfinex),
+		new(poloniex.Poloniex),
+		new(okcoin.OKCoin),
+		new(okex.OKEX),
+	}
+	for x := range lenders {
+		if _, ok := lenders[x].(exchange.Lender); !ok {
+			t.Errorf("expected %T to support lending", lenders[x])
+		}
+	}
+
+	var e exchange.IBotExchange = new(bitstamp.Bitstamp)
+	if _, ok := e.(exchange.Lender); ok {
+		t.Error("expected Bitstamp to not support lending")
+	}
+}
@@ -0,0 +1,10 @@
+package engine
+
+import "sync"
+
+type autoLendManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+}
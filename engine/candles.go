@@ -0,0 +1,315 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const candleManagerName = "Candle"
+
+// vars for the candle manager
+var (
+	// CandleRequestLimit is the maximum number of candles requested from an
+	// exchange in a single call
+	CandleRequestLimit uint32 = 500
+
+	errCandleJobNotFound   = errors.New("candle job not found")
+	errCandleDatabaseUnset = errors.New("database is not connected, candles cannot be stored")
+)
+
+// Started returns if the candle manager subsystem is started
+func (c *candleManager) Started() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+// Start starts the candle manager subsystem
+func (c *candleManager) Start() error {
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return fmt.Errorf("%s %s", candleManagerName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.DatabaseMgr, candleManagerName, MsgSubSystemStarting)
+	c.shutdown = make(chan struct{})
+	c.m.Lock()
+	if c.jobs == nil {
+		c.jobs = make(map[string]*CandleJob)
+	}
+	c.m.Unlock()
+	log.Debugln(log.DatabaseMgr, candleManagerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the candle manager subsystem and waits for running jobs to
+// finish their current request
+func (c *candleManager) Stop() error {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return fmt.Errorf("%s %s", candleManagerName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", candleManagerName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.DatabaseMgr, candleManagerName, MsgSubSystemShuttingDown)
+	close(c.shutdown)
+	c.wg.Wait()
+	atomic.CompareAndSwapInt32(&c.stopped, 1, 0)
+	atomic.CompareAndSwapInt32(&c.started, 1, 0)
+	log.Debugln(log.DatabaseMgr, candleManagerName, MsgSubSystemShutdown)
+	return nil
+}
+
+// StartJob queues a job which downloads and stores candles for the supplied
+// exchange, pair, asset and interval. Only intervals which are not already
+// stored in the database are requested from the exchange
+func (c *candleManager) StartJob(exchName string, p currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*CandleJob, error) {
+	if !c.Started() {
+		return nil, fmt.Errorf("%s %s", candleManagerName, ErrSubSystemNotStarted)
+	}
+	if !database.DB.Connected {
+		return nil, errCandleDatabaseUnset
+	}
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	if !exch.GetAssetTypes().Contains(a) {
+		return nil, fmt.Errorf("%s asset type %s is not supported", exch.GetName(), a)
+	}
+
+	// the candle for the current interval is still forming so is excluded
+	now := time.Now().UTC().Truncate(interval.Duration())
+	if end.After(now) {
+		end = now
+	}
+	err := kline.ValidateRequest(start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	j := &CandleJob{
+		ID:        id.String(),
+		Exchange:  exch.GetName(),
+		Pair:      p,
+		Asset:     a,
+		Interval:  interval,
+		Start:     start.UTC().Truncate(interval.Duration()),
+		End:       end.UTC(),
+		Status:    CandleJobQueued,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	c.m.Lock()
+	c.jobs[j.ID] = j
+	c.m.Unlock()
+
+	c.wg.Add(1)
+	go c.process(j)
+
+	cpy := *j
+	return &cpy, nil
+}
+
+// GetJob returns a copy of the job matching the supplied ID
+func (c *candleManager) GetJob(id string) (*CandleJob, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	j, ok := c.jobs[id]
+	if !ok {
+		return nil, errCandleJobNotFound
+	}
+	return j.copy(), nil
+}
+
+// GetJobs returns a copy of all jobs ordered by creation time
+func (c *candleManager) GetJobs() []CandleJob {
+	c.m.Lock()
+	jobs := make([]CandleJob, 0, len(c.jobs))
+	for _, j := range c.jobs {
+		jobs = append(jobs, *j.copy())
+	}
+	c.m.Unlock()
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].CreatedAt.Before(jobs[j].CreatedAt)
+	})
+	return jobs
+}
+
+// copy returns a copy of the job which does not share its uncovered ranges
+func (j *CandleJob) copy() *CandleJob {
+	cpy := *j
+	cpy.Uncovered = append([]kline.DateRange(nil), j.Uncovered...)
+	return &cpy
+}
+
+func (c *candleManager) update(j *CandleJob, fn func(j *CandleJob)) {
+	c.m.Lock()
+	fn(j)
+	j.UpdatedAt = time.Now()
+	c.m.Unlock()
+}
+
+func (c *candleManager) fail(j *CandleJob, err error) {
+	log.Errorf(log.DatabaseMgr, "%s job %s for %s %s %s %s failed: %v\n",
+		candleManagerName, j.ID, j.Exchange, j.Pair, j.Asset, j.Interval, err)
+	c.update(j, func(j *CandleJob) {
+		j.Status = CandleJobFailed
+		j.Error = err.Error()
+	})
+}
+
+func (c *candleManager) process(j *CandleJob) {
+	defer c.wg.Done()
+	c.update(j, func(j *CandleJob) {
+		j.Status = CandleJobRunning
+	})
+
+	stored, err := candle.Timestamps(j.Exchange,
+		j.Pair.Base.String(),
+		j.Pair.Quote.String(),
+		j.Asset.String(),
+		int64(j.Interval.Duration().Seconds()),
+		j.Start,
+		j.End)
+	if err != nil {
+		c.fail(j, err)
+		return
+	}
+
+	var requests []kline.DateRange
+	missing := kline.FindMissingRanges(j.Start, j.End, j.Interval, stored)
+	for x := range missing {
+		requests = append(requests, missing[x].Split(j.Interval, CandleRequestLimit)...)
+	}
+	c.update(j, func(j *CandleJob) {
+		j.RangesTotal = int64(len(requests))
+	})
+
+	exch := GetExchangeByName(j.Exchange)
+	if exch == nil {
+		c.fail(j, ErrExchangeNotFound)
+		return
+	}
+
+	for x := range requests {
+		select {
+		case <-c.shutdown:
+			c.update(j, func(j *CandleJob) {
+				j.Status = CandleJobCancelled
+			})
+			return
+		default:
+		}
+
+		k, err := GetHistoricCandles(exch, j.Pair, j.Asset, requests[x].Start, requests[x].End, j.Interval)
+		if _, ok := err.(*kline.RangeError); !ok && err != nil && err != kline.ErrNoTrades {
+			c.fail(j, err)
+			return
+		}
+		k.FilterByTimeRange(requests[x].Start, requests[x].End)
+		if len(k.Candles) == 0 {
+			r := requests[x]
+			c.update(j, func(j *CandleJob) {
+				j.RangesComplete++
+				j.Uncovered = append(j.Uncovered, r)
+			})
+			continue
+		}
+
+		saved, err := candle.Insert(candleItemFromKline(j, k.Candles))
+		if err != nil {
+			c.fail(j, err)
+			return
+		}
+		c.update(j, func(j *CandleJob) {
+			j.RangesComplete++
+			j.CandlesSaved += saved
+		})
+	}
+
+	c.update(j, func(j *CandleJob) {
+		j.Status = CandleJobComplete
+		if len(j.Uncovered) > 0 {
+			j.Status = CandleJobIncomplete
+			j.Error = fmt.Sprintf("no candles returned for %d of %d ranges",
+				len(j.Uncovered), j.RangesTotal)
+		}
+	})
+	if len(j.Uncovered) > 0 {
+		log.Warnf(log.DatabaseMgr, "%s job %s for %s %s %s %s incomplete, no candles returned for %d of %d ranges, %d candles saved\n",
+			candleManagerName, j.ID, j.Exchange, j.Pair, j.Asset, j.Interval,
+			len(j.Uncovered), j.RangesTotal, j.CandlesSaved)
+	} else if Bot.Settings.Verbose {
+		log.Debugf(log.DatabaseMgr, "%s job %s for %s %s %s %s complete, %d candles saved\n",
+			candleManagerName, j.ID, j.Exchange, j.Pair, j.Asset, j.Interval, j.CandlesSaved)
+	}
+}
+
+func candleItemFromKline(j *CandleJob, candles []kline.Candle) *candle.Item {
+	item := &candle.Item{
+		ExchangeName: j.Exchange,
+		Base:         j.Pair.Base.String(),
+		Quote:        j.Pair.Quote.String(),
+		Asset:        j.Asset.String(),
+		Interval:     int64(j.Interval.Duration().Seconds()),
+	}
+	for x := range candles {
+		item.Candles = append(item.Candles, candle.Candle{
+			Timestamp: candles[x].Time,
+			Open:      candles[x].Open,
+			High:      candles[x].High,
+			Low:       candles[x].Low,
+			Close:     candles[x].Close,
+			Volume:    candles[x].Volume,
+		})
+	}
+	return item
+}
+
+// GetCandleCoverage returns the date ranges of candles stored in the database
+// and those still missing between the start and end date
+func GetCandleCoverage(exchName string, p currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*CandleCoverage, error) {
+	if !database.DB.Connected {
+		return nil, errCandleDatabaseUnset
+	}
+	err := kline.ValidateRequest(start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := candle.Timestamps(exchName,
+		p.Base.String(),
+		p.Quote.String(),
+		a.String(),
+		int64(interval.Duration().Seconds()),
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+	return &CandleCoverage{
+		Exchange:      exchName,
+		Pair:          p,
+		Asset:         a,
+		Interval:      interval,
+		StoredCandles: uint64(len(stored)),
+		Stored:        kline.FindContiguousRanges(stored, interval),
+		Missing:       kline.FindMissingRanges(start, end, interval, stored),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestCandleManager(t *testing.T) {
+	var c candleManager
+	if c.Started() {
+		t.Error("candle manager should not be started")
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	end := time.Now()
+	_, err := c.StartJob("Bitstamp", p, asset.Spot, kline.OneHour, end.AddDate(0, 0, -1), end)
+	if err == nil {
+		t.Error("expected error when manager not started")
+	}
+
+	if err = c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Start(); err == nil {
+		t.Error("expected error when manager already started")
+	}
+
+	if _, err = c.GetJob("meow"); err != errCandleJobNotFound {
+		t.Errorf("expected %v, received %v", errCandleJobNotFound, err)
+	}
+	if jobs := c.GetJobs(); len(jobs) != 0 {
+		t.Error("expected no jobs")
+	}
+
+	if err = c.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Stop(); err == nil {
+		t.Error("expected error when manager already stopped")
+	}
+}
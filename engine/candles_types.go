@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Candle job statuses
+const (
+	CandleJobQueued   = "queued"
+	CandleJobRunning  = "running"
+	CandleJobComplete = "complete"
+	// CandleJobIncomplete is set when the job finished but the exchange
+	// returned no candles for some of its ranges
+	CandleJobIncomplete = "incomplete"
+	CandleJobFailed     = "failed"
+	CandleJobCancelled  = "cancelled"
+)
+
+// CandleJob holds the state of a candle download job, Uncovered holds the
+// ranges the exchange returned no candles for
+type CandleJob struct {
+	ID             string
+	Exchange       string
+	Pair           currency.Pair
+	Asset          asset.Item
+	Interval       kline.Interval
+	Start          time.Time
+	End            time.Time
+	Status         string
+	RangesTotal    int64
+	RangesComplete int64
+	CandlesSaved   uint64
+	Uncovered      []kline.DateRange
+	Error          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// CandleCoverage holds the stored and missing date ranges for a candle series
+type CandleCoverage struct {
+	Exchange      string
+	Pair          currency.Pair
+	Asset         asset.Item
+	Interval      kline.Interval
+	StoredCandles uint64
+	Stored        []kline.DateRange
+	Missing       []kline.DateRange
+}
+
+type candleManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	m        sync.Mutex
+	jobs     map[string]*CandleJob
+}
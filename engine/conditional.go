@@ -0,0 +1,880 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	conditionalRepo "github.com/thrasher-corp/gocryptotrader/database/repository/conditional"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const conditionalOrderManagerName = "Conditional order manager"
+
+// vars for the conditional order manager
+var (
+	// ConditionalOrderCheckDelay is how often bracket entry orders are checked
+	// for fills and exchange price subscriptions are retried. Prices are
+	// polled at this interval for exchanges which could not be subscribed to
+	ConditionalOrderCheckDelay = time.Second
+
+	errConditionalOrderNotFound = errors.New("conditional order not found")
+)
+
+// Started returns if the conditional order manager subsystem is started
+func (c *conditionalOrderManager) Started() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+// Start starts the conditional order manager subsystem, the order manager
+// must be started as triggered orders are submitted through it
+func (c *conditionalOrderManager) Start() error {
+	if !Bot.OrderManager.Started() {
+		return fmt.Errorf("%s requires the order manager to be started",
+			conditionalOrderManagerName)
+	}
+
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.OrderMgr, conditionalOrderManagerName, MsgSubSystemStarting)
+	c.shutdown = make(chan struct{})
+	c.m.Lock()
+	c.orders = make(map[string]*ConditionalOrder)
+	c.dirty = make(map[string]struct{})
+	c.unresolved = make(map[string]struct{})
+	c.watching = make(map[string]bool)
+	c.m.Unlock()
+	c.loadStoredOrders()
+	c.wg.Add(1)
+	go c.run()
+	log.Debugln(log.OrderMgr, conditionalOrderManagerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the conditional order manager subsystem, pending orders are
+// stored and watched again when it is next started
+func (c *conditionalOrderManager) Stop() error {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.OrderMgr, conditionalOrderManagerName, MsgSubSystemShuttingDown)
+	close(c.shutdown)
+	c.wg.Wait()
+	c.flushWatermarks()
+	atomic.CompareAndSwapInt32(&c.stopped, 1, 0)
+	atomic.CompareAndSwapInt32(&c.started, 1, 0)
+	log.Debugln(log.OrderMgr, conditionalOrderManagerName, MsgSubSystemShutdown)
+	return nil
+}
+
+// loadStoredOrders restores the pending and inactive conditional orders which
+// were stored when the bot last shut down along with the entry orders of any
+// bracket legs
+func (c *conditionalOrderManager) loadStoredOrders() {
+	if !database.DB.Connected {
+		return
+	}
+
+	stored, err := conditionalRepo.GetByStatus(string(ConditionalPending),
+		string(ConditionalInactive))
+	if err != nil {
+		log.Errorf(log.OrderMgr, "%s: Unable to load stored conditional orders. Err: %s\n",
+			conditionalOrderManagerName, err)
+		return
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	for x := range stored {
+		o := conditionalFromRepository(&stored[x])
+		c.orders[o.ID] = &o
+	}
+	for x := range stored {
+		parentID := stored[x].ParentID
+		if parentID == "" {
+			continue
+		}
+		if _, ok := c.orders[parentID]; ok {
+			continue
+		}
+		parent, err := conditionalRepo.GetByID(parentID)
+		if err != nil || parent == nil {
+			log.Warnf(log.OrderMgr, "%s: Unable to load bracket entry order ID=%v. Err: %v\n",
+				conditionalOrderManagerName, parentID, err)
+			continue
+		}
+		o := conditionalFromRepository(parent)
+		c.orders[o.ID] = &o
+	}
+	log.Debugf(log.OrderMgr, "%s: Loaded %d stored conditional orders.\n",
+		conditionalOrderManagerName, len(stored))
+}
+
+func (c *conditionalOrderManager) run() {
+	tick := time.NewTicker(ConditionalOrderCheckDelay)
+	defer func() {
+		tick.Stop()
+		c.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-tick.C:
+			c.checkBrackets(Bot.OrderManager.orderStore.GetOrder)
+			c.subscribe()
+			c.poll()
+			c.flushWatermarks()
+		}
+	}
+}
+
+// Add validates a conditional order and starts watching it
+func (c *conditionalOrderManager) Add(o *ConditionalOrder) (*ConditionalOrder, error) {
+	if !c.Started() {
+		return nil, fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+	if err := validateConditionalOrder(o); err != nil {
+		return nil, err
+	}
+	added, err := c.add(o)
+	if err != nil {
+		return nil, err
+	}
+	return &added[0], nil
+}
+
+// AddOCO adds two conditional orders for the same exchange currency pair as a
+// one-cancels-the-other group, once either order is triggered the other is
+// cancelled
+func (c *conditionalOrderManager) AddOCO(first, second *ConditionalOrder) ([]ConditionalOrder, error) {
+	if !c.Started() {
+		return nil, fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+	if err := validateConditionalOrder(first); err != nil {
+		return nil, err
+	}
+	if err := validateConditionalOrder(second); err != nil {
+		return nil, err
+	}
+	if first.Exchange != second.Exchange ||
+		!first.Pair.Equal(second.Pair) ||
+		first.Asset != second.Asset {
+		return nil, errors.New("OCO orders must be for the same exchange, currency pair and asset")
+	}
+
+	groupID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	first.GroupID = groupID.String()
+	second.GroupID = groupID.String()
+	return c.add(first, second)
+}
+
+// AddBracket adds an entry order with take profit and stop loss legs for the
+// same amount on the opposite side. Limit and market entries are submitted
+// straight away, stop entries are watched like any other conditional order.
+// The legs are an OCO group which is only watched once the entry has filled
+func (c *conditionalOrderManager) AddBracket(entry *ConditionalOrder, takeProfitPrice, stopLossPrice float64) ([]ConditionalOrder, error) {
+	if !c.Started() {
+		return nil, fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+	if entry == nil {
+		return nil, errors.New("bracket entry order is nil")
+	}
+	if takeProfitPrice <= 0 || stopLossPrice <= 0 {
+		return nil, errors.New("bracket take profit and stop loss prices must be set")
+	}
+
+	submitEntry := entry.Type == order.Limit || entry.Type == order.Market
+	if submitEntry {
+		if err := validateConditionalDetails(entry); err != nil {
+			return nil, err
+		}
+		if entry.Type == order.Limit && entry.LimitPrice <= 0 {
+			return nil, order.ErrPriceMustBeSetIfLimitOrder
+		}
+		if entry.Type == order.Market {
+			entry.LimitPrice = 0
+		}
+	} else if err := validateConditionalOrder(entry); err != nil {
+		return nil, err
+	}
+
+	entryPrice := entry.LimitPrice
+	if entryPrice == 0 {
+		entryPrice = entry.TriggerPrice
+	}
+	if isSellSide(entry.Side) {
+		if takeProfitPrice >= stopLossPrice ||
+			(entryPrice > 0 && (takeProfitPrice >= entryPrice || stopLossPrice <= entryPrice)) {
+			return nil, errors.New("sell bracket take profit price must be below the entry price and stop loss price above it")
+		}
+	} else if takeProfitPrice <= stopLossPrice ||
+		(entryPrice > 0 && (takeProfitPrice <= entryPrice || stopLossPrice >= entryPrice)) {
+		return nil, errors.New("buy bracket take profit price must be above the entry price and stop loss price below it")
+	}
+
+	entryID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	groupID, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	entry.ID = entryID.String()
+	entry.GroupID = groupID.String()
+
+	legSide := order.Sell
+	if isSellSide(entry.Side) {
+		legSide = order.Buy
+	}
+	takeProfit := ConditionalOrder{
+		GroupID:      entry.GroupID,
+		ParentID:     entry.ID,
+		Exchange:     entry.Exchange,
+		Pair:         entry.Pair,
+		Asset:        entry.Asset,
+		Side:         legSide,
+		Type:         order.TakeProfit,
+		TriggerPrice: takeProfitPrice,
+		Amount:       entry.Amount,
+		Status:       ConditionalInactive,
+	}
+	stopLoss := takeProfit
+	stopLoss.Type = order.Stop
+	stopLoss.TriggerPrice = stopLossPrice
+
+	if submitEntry {
+		orderID, err := submitConditionalOrder(entry)
+		if err != nil {
+			return nil, err
+		}
+		entry.Status = ConditionalTriggered
+		entry.OrderID = orderID
+	}
+	return c.add(entry, &takeProfit, &stopLoss)
+}
+
+// add assigns IDs and timestamps to validated orders, stores them and starts
+// watching them
+func (c *conditionalOrderManager) add(orders ...*ConditionalOrder) ([]ConditionalOrder, error) {
+	now := time.Now()
+	added := make([]ConditionalOrder, len(orders))
+	for x := range orders {
+		if orders[x].ID == "" {
+			id, err := uuid.NewV4()
+			if err != nil {
+				return nil, err
+			}
+			orders[x].ID = id.String()
+		}
+		if orders[x].Status == "" {
+			orders[x].Status = ConditionalPending
+		}
+		orders[x].CreatedAt = now
+		orders[x].UpdatedAt = now
+		added[x] = *orders[x]
+	}
+
+	c.m.Lock()
+	for x := range added {
+		o := added[x]
+		c.orders[o.ID] = &o
+	}
+	c.m.Unlock()
+
+	for x := range added {
+		c.persist(&added[x])
+		log.Debugf(log.OrderMgr, "%s: Added %s %s %s %s conditional order ID=%v trigger=%v amount=%v status=%v.\n",
+			conditionalOrderManagerName,
+			added[x].Exchange,
+			added[x].Pair,
+			added[x].Side,
+			added[x].Type,
+			added[x].ID,
+			added[x].TriggerPrice,
+			added[x].Amount,
+			added[x].Status)
+	}
+	return added, nil
+}
+
+// Get returns a copy of the conditional orders tracked since the manager was
+// started, filtered by exchange if one is supplied
+func (c *conditionalOrderManager) Get(exchName string) []ConditionalOrder {
+	c.m.Lock()
+	defer c.m.Unlock()
+	var orders []ConditionalOrder
+	for _, o := range c.orders {
+		if exchName != "" && !strings.EqualFold(o.Exchange, exchName) {
+			continue
+		}
+		orders = append(orders, *o)
+	}
+	return orders
+}
+
+// Cancel stops watching a pending or inactive conditional order along with
+// any bracket legs which reference it, orders which have already been
+// submitted to the exchange are not affected
+func (c *conditionalOrderManager) Cancel(id string) ([]ConditionalOrder, error) {
+	if !c.Started() {
+		return nil, fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+
+	c.m.Lock()
+	o, ok := c.orders[id]
+	if !ok {
+		c.m.Unlock()
+		return nil, errConditionalOrderNotFound
+	}
+	var cancelled []ConditionalOrder
+	if o.Status == ConditionalPending || o.Status == ConditionalInactive {
+		o.Status = ConditionalCancelled
+		o.UpdatedAt = time.Now()
+		cancelled = append(cancelled, *o)
+	}
+	for _, leg := range c.orders {
+		if leg.ParentID == id &&
+			(leg.Status == ConditionalPending || leg.Status == ConditionalInactive) {
+			leg.Status = ConditionalCancelled
+			leg.UpdatedAt = time.Now()
+			cancelled = append(cancelled, *leg)
+		}
+	}
+	c.m.Unlock()
+
+	if len(cancelled) == 0 {
+		return nil, fmt.Errorf("conditional order ID=%v is %v and cannot be cancelled",
+			id, o.Status)
+	}
+	for x := range cancelled {
+		c.persist(&cancelled[x])
+	}
+	return cancelled, nil
+}
+
+// subscribe starts watching the ticker and orderbook updates of each exchange
+// with pending orders, exchanges which have not yet published any prices are
+// retried on the next check
+func (c *conditionalOrderManager) subscribe() {
+	if !dispatch.IsRunning() {
+		return
+	}
+
+	c.m.Lock()
+	var exchanges []string
+	for _, o := range c.orders {
+		name := strings.ToLower(o.Exchange)
+		if o.Status != ConditionalPending || c.watching[name] ||
+			common.StringDataCompare(exchanges, name) {
+			continue
+		}
+		exchanges = append(exchanges, name)
+	}
+	c.m.Unlock()
+
+	for x := range exchanges {
+		tickers, tickerErr := ticker.SubscribeToExchangeTickers(exchanges[x])
+		orderbooks, orderbookErr := orderbook.SubscribeToExchangeOrderbooks(exchanges[x])
+		if tickerErr != nil && orderbookErr != nil {
+			continue
+		}
+		c.m.Lock()
+		c.watching[exchanges[x]] = true
+		c.m.Unlock()
+		c.wg.Add(1)
+		go c.watch(exchanges[x], tickers, tickerErr == nil, orderbooks, orderbookErr == nil)
+	}
+}
+
+func (c *conditionalOrderManager) watch(exchName string, tickers dispatch.Pipe, hasTickers bool, orderbooks dispatch.Pipe, hasOrderbooks bool) {
+	defer func() {
+		if hasTickers {
+			if err := tickers.Release(); err != nil {
+				log.Errorln(log.OrderMgr, err)
+			}
+		}
+		if hasOrderbooks {
+			if err := orderbooks.Release(); err != nil {
+				log.Errorln(log.OrderMgr, err)
+			}
+		}
+		c.m.Lock()
+		delete(c.watching, exchName)
+		c.m.Unlock()
+		c.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case data, ok := <-tickers.C:
+			if !ok {
+				return
+			}
+			t := (*data.(*interface{})).(ticker.Price)
+			c.processPrice(exchName, t.Pair, t.AssetType, t.Last, t.Bid, t.Ask)
+		case data, ok := <-orderbooks.C:
+			if !ok {
+				return
+			}
+			ob := (*data.(*interface{})).(orderbook.Base)
+			var bid, ask float64
+			if len(ob.Bids) > 0 {
+				bid = ob.Bids[0].Price
+			}
+			if len(ob.Asks) > 0 {
+				ask = ob.Asks[0].Price
+			}
+			c.processPrice(exchName, ob.Pair, ob.AssetType, 0, bid, ask)
+		}
+	}
+}
+
+// poll checks pending orders for exchanges which are not subscribed to
+// against the latest stored ticker
+func (c *conditionalOrderManager) poll() {
+	type watched struct {
+		exchange string
+		pair     currency.Pair
+		asset    asset.Item
+	}
+	c.m.Lock()
+	var items []watched
+	for _, o := range c.orders {
+		if o.Status != ConditionalPending || c.watching[strings.ToLower(o.Exchange)] {
+			continue
+		}
+		items = append(items, watched{o.Exchange, o.Pair, o.Asset})
+	}
+	c.m.Unlock()
+
+	for x := range items {
+		t, err := ticker.GetTicker(items[x].exchange, items[x].pair, items[x].asset)
+		if err != nil {
+			continue
+		}
+		c.processPrice(items[x].exchange, items[x].pair, items[x].asset, t.Last, t.Bid, t.Ask)
+	}
+}
+
+// processPrice evaluates the pending orders for an exchange currency pair
+// against a price update and submits any which trigger
+func (c *conditionalOrderManager) processPrice(exchName string, p currency.Pair, a asset.Item, last, bid, ask float64) {
+	triggered, cancelled := c.evaluate(exchName, p, a, last, bid, ask)
+	for x := range cancelled {
+		c.persist(&cancelled[x])
+	}
+	for x := range triggered {
+		c.trigger(&triggered[x])
+	}
+}
+
+// evaluate updates the pending orders for an exchange currency pair with a
+// price update and returns the orders which have triggered along with the OCO
+// orders cancelled by them. Triggered orders are marked as such before they
+// are submitted so they can only be submitted once
+func (c *conditionalOrderManager) evaluate(exchName string, p currency.Pair, a asset.Item, last, bid, ask float64) (triggered, cancelled []ConditionalOrder) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	for _, o := range c.orders {
+		if o.Status != ConditionalPending ||
+			!strings.EqualFold(o.Exchange, exchName) ||
+			!o.Pair.Equal(p) ||
+			o.Asset != a {
+			continue
+		}
+		price := last
+		if isSellSide(o.Side) {
+			if bid > 0 {
+				price = bid
+			}
+		} else if ask > 0 {
+			price = ask
+		}
+
+		hit, moved := o.evaluate(price)
+		if moved {
+			c.dirty[o.ID] = struct{}{}
+		}
+		if !hit {
+			continue
+		}
+		o.Status = ConditionalTriggered
+		o.UpdatedAt = time.Now()
+		delete(c.dirty, o.ID)
+		triggered = append(triggered, *o)
+		if o.GroupID == "" {
+			continue
+		}
+		for _, sibling := range c.orders {
+			if sibling.ID == o.ID ||
+				sibling.GroupID != o.GroupID ||
+				sibling.ParentID != o.ParentID ||
+				(sibling.Status != ConditionalPending && sibling.Status != ConditionalInactive) {
+				continue
+			}
+			sibling.Status = ConditionalCancelled
+			sibling.UpdatedAt = time.Now()
+			delete(c.dirty, sibling.ID)
+			cancelled = append(cancelled, *sibling)
+		}
+	}
+	return triggered, cancelled
+}
+
+// evaluate checks whether the price reaches the trigger price of the order,
+// trailing stops move their watermark and trigger price first. moved is
+// returned when the trailing stop trigger price has changed
+func (c *ConditionalOrder) evaluate(price float64) (triggered, moved bool) {
+	if price <= 0 {
+		return false, false
+	}
+	sell := isSellSide(c.Side)
+	switch c.Type {
+	case order.TrailingStop:
+		if c.WatermarkPrice == 0 ||
+			(sell && price > c.WatermarkPrice) ||
+			(!sell && price < c.WatermarkPrice) {
+			c.WatermarkPrice = price
+			c.TriggerPrice = c.trailingTriggerPrice()
+			moved = true
+		}
+		fallthrough
+	case order.Stop:
+		if sell {
+			return price <= c.TriggerPrice, moved
+		}
+		return price >= c.TriggerPrice, moved
+	case order.TakeProfit:
+		if sell {
+			return price >= c.TriggerPrice, false
+		}
+		return price <= c.TriggerPrice, false
+	}
+	return false, false
+}
+
+// trailingTriggerPrice returns the trigger price of a trailing stop for its
+// current watermark
+func (c *ConditionalOrder) trailingTriggerPrice() float64 {
+	offset := c.TrailingDistance
+	if c.TrailingPercent > 0 {
+		offset = c.WatermarkPrice * c.TrailingPercent / 100
+	}
+	if isSellSide(c.Side) {
+		return c.WatermarkPrice - offset
+	}
+	return c.WatermarkPrice + offset
+}
+
+// trigger submits a triggered conditional order to the exchange through the
+// order manager and records the result
+func (c *conditionalOrderManager) trigger(o *ConditionalOrder) {
+	orderID, err := submitConditionalOrder(o)
+	msg := fmt.Sprintf("%s: %s %s %s %s conditional order ID=%v triggered at %v",
+		conditionalOrderManagerName,
+		o.Exchange,
+		o.Pair,
+		o.Side,
+		o.Type,
+		o.ID,
+		o.TriggerPrice)
+	if err != nil {
+		o.Status = ConditionalFailed
+		o.Error = err.Error()
+		msg += fmt.Sprintf(" but could not be submitted. Err: %s", err)
+		log.Errorln(log.OrderMgr, msg)
+	} else {
+		o.OrderID = orderID
+		msg += fmt.Sprintf(", submitted order ID=%v.", orderID)
+		log.Infoln(log.OrderMgr, msg)
+	}
+	o.UpdatedAt = time.Now()
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+
+	c.m.Lock()
+	if stored, ok := c.orders[o.ID]; ok {
+		stored.Status = o.Status
+		stored.OrderID = o.OrderID
+		stored.Error = o.Error
+		stored.UpdatedAt = o.UpdatedAt
+	}
+	c.m.Unlock()
+	c.persist(o)
+}
+
+// submitConditionalOrder submits a conditional order to its exchange as a
+// limit order if it has a limit price and a market order otherwise
+func submitConditionalOrder(o *ConditionalOrder) (string, error) {
+	s := &order.Submit{
+		Pair:      o.Pair,
+		AssetType: o.Asset,
+		OrderSide: o.Side,
+		OrderType: order.Market,
+		Amount:    o.Amount,
+	}
+	if o.LimitPrice > 0 {
+		s.OrderType = order.Limit
+		s.Price = o.LimitPrice
+	}
+	resp, err := Bot.OrderManager.Submit(o.Exchange, s)
+	if err != nil {
+		return "", err
+	}
+	return resp.OrderID, nil
+}
+
+// checkBrackets activates the legs of bracket orders whose entry order has
+// filled and cancels them if the entry order can no longer fill. Legs of an
+// entry order whose status is unknown are left inactive, as it may still have
+// filled, and the entry order is reported once for it to be resolved manually
+func (c *conditionalOrderManager) checkBrackets(lookup func(exchName, id string) (order.Detail, bool)) {
+	c.m.Lock()
+	if c.unresolved == nil {
+		c.unresolved = make(map[string]struct{})
+	}
+	var changed, unresolved []ConditionalOrder
+	for _, leg := range c.orders {
+		if leg.Status != ConditionalInactive || leg.ParentID == "" {
+			continue
+		}
+		parent, ok := c.orders[leg.ParentID]
+		if !ok {
+			continue
+		}
+		switch parent.Status {
+		case ConditionalCancelled, ConditionalFailed:
+			leg.Status = ConditionalCancelled
+		case ConditionalTriggered:
+			d, ok := lookup(parent.Exchange, parent.OrderID)
+			if !ok {
+				continue
+			}
+			if d.Status == order.UnknownStatus {
+				if _, reported := c.unresolved[parent.ID]; !reported {
+					c.unresolved[parent.ID] = struct{}{}
+					unresolved = append(unresolved, *parent)
+				}
+				continue
+			}
+			delete(c.unresolved, parent.ID)
+			switch {
+			case d.Status == order.Filled:
+				leg.Status = ConditionalPending
+			case isOrderClosed(d.Status) && d.ExecutedAmount > 0:
+				// protect the partially filled amount
+				leg.Amount = d.ExecutedAmount
+				leg.Status = ConditionalPending
+			case isOrderClosed(d.Status):
+				leg.Status = ConditionalCancelled
+			default:
+				continue
+			}
+		default:
+			continue
+		}
+		leg.UpdatedAt = time.Now()
+		changed = append(changed, *leg)
+	}
+	c.m.Unlock()
+
+	for x := range unresolved {
+		log.Warnf(log.OrderMgr,
+			"%s: Bracket entry ID=%v exchange %s order ID=%v status is unknown, its legs are kept inactive until the order is resolved.\n",
+			conditionalOrderManagerName,
+			unresolved[x].ID,
+			unresolved[x].Exchange,
+			unresolved[x].OrderID)
+	}
+	for x := range changed {
+		log.Debugf(log.OrderMgr, "%s: Bracket %s leg ID=%v is now %v.\n",
+			conditionalOrderManagerName, changed[x].Type, changed[x].ID, changed[x].Status)
+		c.persist(&changed[x])
+	}
+}
+
+// flushWatermarks stores the trailing stops whose watermark has moved
+func (c *conditionalOrderManager) flushWatermarks() {
+	c.m.Lock()
+	var moved []ConditionalOrder
+	for id := range c.dirty {
+		if o, ok := c.orders[id]; ok {
+			o.UpdatedAt = time.Now()
+			moved = append(moved, *o)
+		}
+		delete(c.dirty, id)
+	}
+	c.m.Unlock()
+
+	for x := range moved {
+		c.persist(&moved[x])
+	}
+}
+
+// persist stores the conditional order in the database if it is connected
+func (c *conditionalOrderManager) persist(o *ConditionalOrder) {
+	if !database.DB.Connected {
+		return
+	}
+	if err := conditionalRepo.Upsert(conditionalToRepository(o)); err != nil {
+		log.Errorf(log.OrderMgr, "%s: Unable to store conditional order ID=%v. Err: %s\n",
+			conditionalOrderManagerName, o.ID, err)
+	}
+}
+
+// validateConditionalOrder checks a conditional order can be watched and
+// normalises its exchange name and side
+func validateConditionalOrder(o *ConditionalOrder) error {
+	if err := validateConditionalDetails(o); err != nil {
+		return err
+	}
+
+	switch o.Type {
+	case order.Stop, order.TakeProfit:
+		if o.TriggerPrice <= 0 {
+			return errors.New("conditional order trigger price must be set")
+		}
+	case order.TrailingStop:
+		if (o.TrailingDistance > 0) == (o.TrailingPercent > 0) {
+			return errors.New("trailing stop orders require either a trailing distance or percent")
+		}
+		if o.TrailingDistance < 0 || o.TrailingPercent < 0 || o.TrailingPercent >= 100 {
+			return errors.New("trailing stop distance must be positive and percent between 0 and 100")
+		}
+		// the trigger price is set from the first price seen unless the
+		// order already has a watermark
+		if o.WatermarkPrice > 0 {
+			o.TriggerPrice = o.trailingTriggerPrice()
+		}
+	default:
+		return fmt.Errorf("%s is not a supported conditional order type", o.Type)
+	}
+	return nil
+}
+
+// validateConditionalDetails checks the exchange, currency pair, asset type,
+// side and amount of a conditional order
+func validateConditionalDetails(o *ConditionalOrder) error {
+	if o == nil {
+		return errors.New("conditional order is nil")
+	}
+	exch := GetExchangeByName(o.Exchange)
+	if exch == nil {
+		return errors.New("unable to get exchange by name")
+	}
+	o.Exchange = exch.GetName()
+	if o.Pair.IsEmpty() {
+		return order.ErrPairIsEmpty
+	}
+	if o.Asset == "" {
+		o.Asset = asset.Spot
+	}
+	if !asset.IsValid(o.Asset) {
+		return fmt.Errorf("%s is not a valid asset type", o.Asset)
+	}
+	if !exch.GetAssetTypes().Contains(o.Asset) {
+		return fmt.Errorf("%s asset type %s not supported", o.Exchange, o.Asset)
+	}
+	side, err := order.StringToOrderSide(o.Side.String())
+	if err != nil || (side != order.Buy && side != order.Sell &&
+		side != order.Bid && side != order.Ask) {
+		return order.ErrSideIsInvalid
+	}
+	o.Side = side
+	if o.Amount <= 0 {
+		return order.ErrAmountIsInvalid
+	}
+	if o.LimitPrice < 0 {
+		return errors.New("conditional order limit price cannot be negative")
+	}
+
+	return nil
+}
+
+// isSellSide returns whether an order side sells the base currency
+func isSellSide(side order.Side) bool {
+	return signedAmount(side, 1) < 0
+}
+
+func conditionalToRepository(o *ConditionalOrder) *conditionalRepo.Details {
+	return &conditionalRepo.Details{
+		ID:               o.ID,
+		GroupID:          o.GroupID,
+		ParentID:         o.ParentID,
+		ExchangeName:     o.Exchange,
+		Base:             o.Pair.Base.String(),
+		Quote:            o.Pair.Quote.String(),
+		Asset:            o.Asset.String(),
+		Side:             o.Side.String(),
+		OrderType:        o.Type.String(),
+		TriggerPrice:     o.TriggerPrice,
+		LimitPrice:       o.LimitPrice,
+		Amount:           o.Amount,
+		TrailingDistance: o.TrailingDistance,
+		TrailingPercent:  o.TrailingPercent,
+		WatermarkPrice:   o.WatermarkPrice,
+		Status:           string(o.Status),
+		OrderID:          o.OrderID,
+		Error:            o.Error,
+		CreatedAt:        o.CreatedAt,
+		UpdatedAt:        o.UpdatedAt,
+	}
+}
+
+func conditionalFromRepository(d *conditionalRepo.Details) ConditionalOrder {
+	exchName := d.ExchangeName
+	if exch := GetExchangeByName(exchName); exch != nil {
+		// stored exchange names are lower case
+		exchName = exch.GetName()
+	}
+	return ConditionalOrder{
+		ID:               d.ID,
+		GroupID:          d.GroupID,
+		ParentID:         d.ParentID,
+		Exchange:         exchName,
+		Pair:             currency.NewPair(currency.NewCode(d.Base), currency.NewCode(d.Quote)),
+		Asset:            asset.Item(d.Asset),
+		Side:             order.Side(d.Side),
+		Type:             order.Type(d.OrderType),
+		TriggerPrice:     d.TriggerPrice,
+		LimitPrice:       d.LimitPrice,
+		Amount:           d.Amount,
+		TrailingDistance: d.TrailingDistance,
+		TrailingPercent:  d.TrailingPercent,
+		WatermarkPrice:   d.WatermarkPrice,
+		Status:           ConditionalStatus(d.Status),
+		OrderID:          d.OrderID,
+		Error:            d.Error,
+		CreatedAt:        d.CreatedAt,
+		UpdatedAt:        d.UpdatedAt,
+	}
+}
@@ -0,0 +1,238 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestConditionalOrderEvaluate(t *testing.T) {
+	t.Parallel()
+	stop := ConditionalOrder{Side: order.Sell, Type: order.Stop, TriggerPrice: 100}
+	if hit, _ := stop.evaluate(101); hit {
+		t.Error("sell stop should not trigger above the trigger price")
+	}
+	if hit, _ := stop.evaluate(100); !hit {
+		t.Error("sell stop should trigger at the trigger price")
+	}
+	stop.Side = order.Buy
+	if hit, _ := stop.evaluate(99); hit {
+		t.Error("buy stop should not trigger below the trigger price")
+	}
+	if hit, _ := stop.evaluate(101); !hit {
+		t.Error("buy stop should trigger above the trigger price")
+	}
+
+	takeProfit := ConditionalOrder{Side: order.Sell, Type: order.TakeProfit, TriggerPrice: 100}
+	if hit, _ := takeProfit.evaluate(99); hit {
+		t.Error("sell take profit should not trigger below the trigger price")
+	}
+	if hit, _ := takeProfit.evaluate(100); !hit {
+		t.Error("sell take profit should trigger at the trigger price")
+	}
+	if hit, _ := takeProfit.evaluate(0); hit {
+		t.Error("orders should not trigger without a price")
+	}
+
+	trailing := ConditionalOrder{Side: order.Sell, Type: order.TrailingStop, TrailingDistance: 10}
+	if hit, moved := trailing.evaluate(100); hit || !moved || trailing.TriggerPrice != 90 {
+		t.Errorf("unexpected trailing stop %+v", trailing)
+	}
+	if hit, moved := trailing.evaluate(95); hit || moved || trailing.TriggerPrice != 90 {
+		t.Errorf("trailing stop should not move on a lower price %+v", trailing)
+	}
+	if hit, moved := trailing.evaluate(120); hit || !moved ||
+		trailing.WatermarkPrice != 120 || trailing.TriggerPrice != 110 {
+		t.Errorf("trailing stop should follow a higher price %+v", trailing)
+	}
+	if hit, _ := trailing.evaluate(110); !hit {
+		t.Error("trailing stop should trigger at the trigger price")
+	}
+
+	trailing = ConditionalOrder{Side: order.Buy, Type: order.TrailingStop, TrailingPercent: 10}
+	if hit, _ := trailing.evaluate(200); hit || trailing.TriggerPrice != 220 {
+		t.Errorf("unexpected trailing stop %+v", trailing)
+	}
+	if _, moved := trailing.evaluate(100); !moved || trailing.TriggerPrice != 110 {
+		t.Errorf("buy trailing stop should follow a lower price %+v", trailing)
+	}
+	if hit, _ := trailing.evaluate(111); !hit {
+		t.Error("buy trailing stop should trigger above the trigger price")
+	}
+}
+
+func TestConditionalOrderManagerEvaluate(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	c := conditionalOrderManager{
+		orders: map[string]*ConditionalOrder{
+			"tp": {ID: "tp", GroupID: "oco", Exchange: "Bitstamp", Pair: p, Asset: asset.Spot,
+				Side: order.Sell, Type: order.TakeProfit, TriggerPrice: 120, Status: ConditionalPending},
+			"sl": {ID: "sl", GroupID: "oco", Exchange: "Bitstamp", Pair: p, Asset: asset.Spot,
+				Side: order.Sell, Type: order.Stop, TriggerPrice: 80, Status: ConditionalPending},
+			"trailing": {ID: "trailing", Exchange: "Bitstamp", Pair: p, Asset: asset.Spot,
+				Side: order.Sell, Type: order.TrailingStop, TrailingDistance: 5, Status: ConditionalPending},
+			"other": {ID: "other", Exchange: "Bitstamp", Pair: currency.NewPair(currency.LTC, currency.USD),
+				Asset: asset.Spot, Side: order.Sell, Type: order.TakeProfit, TriggerPrice: 1, Status: ConditionalPending},
+		},
+		dirty: make(map[string]struct{}),
+	}
+
+	triggered, cancelled := c.evaluate("bitstamp", p, asset.Spot, 100, 0, 0)
+	if len(triggered) != 0 || len(cancelled) != 0 {
+		t.Fatalf("unexpected triggered %v cancelled %v", triggered, cancelled)
+	}
+	if _, ok := c.dirty["trailing"]; !ok {
+		t.Error("expected the trailing stop to be marked as moved")
+	}
+
+	// sell orders are evaluated against the bid
+	triggered, cancelled = c.evaluate("bitstamp", p, asset.Spot, 125, 119, 126)
+	if len(triggered) != 0 || len(cancelled) != 0 {
+		t.Fatalf("unexpected triggered %v cancelled %v", triggered, cancelled)
+	}
+
+	triggered, cancelled = c.evaluate("bitstamp", p, asset.Spot, 125, 121, 126)
+	if len(triggered) != 1 || triggered[0].ID != "tp" {
+		t.Fatalf("expected the take profit to trigger, received %v", triggered)
+	}
+	if len(cancelled) != 1 || cancelled[0].ID != "sl" {
+		t.Fatalf("expected the stop loss to be cancelled, received %v", cancelled)
+	}
+	if c.orders["tp"].Status != ConditionalTriggered ||
+		c.orders["sl"].Status != ConditionalCancelled ||
+		c.orders["other"].Status != ConditionalPending {
+		t.Error("unexpected conditional order statuses")
+	}
+
+	triggered, _ = c.evaluate("bitstamp", p, asset.Spot, 70, 0, 0)
+	if len(triggered) != 1 || triggered[0].ID != "trailing" {
+		t.Errorf("expected only the trailing stop to trigger, received %v", triggered)
+	}
+}
+
+func TestCheckBrackets(t *testing.T) {
+	t.Parallel()
+	c := conditionalOrderManager{
+		orders: map[string]*ConditionalOrder{
+			"entry": {ID: "entry", Exchange: "Bitstamp", Status: ConditionalTriggered, OrderID: "1"},
+			"leg":   {ID: "leg", ParentID: "entry", Amount: 1, Status: ConditionalInactive},
+			"partial": {ID: "partial", Exchange: "Bitstamp", Status: ConditionalTriggered,
+				OrderID: "2"},
+			"partialleg": {ID: "partialleg", ParentID: "partial", Amount: 1, Status: ConditionalInactive},
+			"failed":     {ID: "failed", Status: ConditionalFailed},
+			"failedleg":  {ID: "failedleg", ParentID: "failed", Status: ConditionalInactive},
+			"unknown": {ID: "unknown", Exchange: "Bitstamp", Status: ConditionalTriggered,
+				OrderID: "3"},
+			"unknownleg": {ID: "unknownleg", ParentID: "unknown", Amount: 1, Status: ConditionalInactive},
+		},
+	}
+
+	exchangeOrders := map[string]order.Detail{
+		"1": {Status: order.New},
+		"2": {Status: order.PartiallyCancelled, ExecutedAmount: 0.4},
+		"3": {Status: order.UnknownStatus},
+	}
+	lookup := func(exchName, id string) (order.Detail, bool) {
+		d, ok := exchangeOrders[id]
+		return d, ok
+	}
+
+	c.checkBrackets(lookup)
+	if c.orders["leg"].Status != ConditionalInactive {
+		t.Error("legs should stay inactive until the entry order fills")
+	}
+	if c.orders["failedleg"].Status != ConditionalCancelled {
+		t.Error("legs of a failed entry order should be cancelled")
+	}
+	if c.orders["partialleg"].Status != ConditionalPending || c.orders["partialleg"].Amount != 0.4 {
+		t.Errorf("legs should protect the filled amount %+v", c.orders["partialleg"])
+	}
+	if c.orders["unknownleg"].Status != ConditionalInactive || c.orders["unknownleg"].Amount != 1 {
+		t.Errorf("legs of an entry order with an unknown status should be kept %+v", c.orders["unknownleg"])
+	}
+	if _, ok := c.unresolved["unknown"]; !ok {
+		t.Error("entry order with an unknown status should be reported")
+	}
+
+	exchangeOrders["1"] = order.Detail{Status: order.Filled}
+	exchangeOrders["3"] = order.Detail{Status: order.Filled}
+	c.checkBrackets(lookup)
+	if c.orders["leg"].Status != ConditionalPending {
+		t.Error("legs should be watched once the entry order fills")
+	}
+	if c.orders["unknownleg"].Status != ConditionalPending {
+		t.Error("legs should be watched once the unknown entry order is resolved as filled")
+	}
+	if _, ok := c.unresolved["unknown"]; ok {
+		t.Error("resolved entry order should no longer be reported")
+	}
+}
+
+func TestValidateConditionalOrder(t *testing.T) {
+	SetupTest(t)
+	p := currency.NewPair(currency.BTC, currency.USD)
+	valid := func() *ConditionalOrder {
+		return &ConditionalOrder{
+			Exchange:     "bitstamp",
+			Pair:         p,
+			Side:         order.Side("sell"),
+			Type:         order.Stop,
+			TriggerPrice: 100,
+			Amount:       1,
+		}
+	}
+
+	o := valid()
+	if err := validateConditionalOrder(o); err != nil {
+		t.Fatal(err)
+	}
+	if o.Exchange != testExchange || o.Asset != asset.Spot || o.Side != order.Sell {
+		t.Errorf("expected the order to be normalised %+v", o)
+	}
+
+	o = valid()
+	o.Exchange = "nope"
+	if err := validateConditionalOrder(o); err == nil {
+		t.Error("expected error for an unloaded exchange")
+	}
+
+	o = valid()
+	o.Asset = asset.Futures
+	if err := validateConditionalOrder(o); err == nil {
+		t.Error("expected error for an asset type the exchange does not support")
+	}
+
+	o = valid()
+	o.TriggerPrice = 0
+	if err := validateConditionalOrder(o); err == nil {
+		t.Error("expected error without a trigger price")
+	}
+
+	o = valid()
+	o.Type = order.Limit
+	if err := validateConditionalOrder(o); err == nil {
+		t.Error("expected error for an unsupported order type")
+	}
+
+	o = valid()
+	o.Type = order.TrailingStop
+	if err := validateConditionalOrder(o); err == nil {
+		t.Error("expected error without a trailing distance or percent")
+	}
+	o.TrailingDistance = 10
+	o.TrailingPercent = 1
+	if err := validateConditionalOrder(o); err == nil {
+		t.Error("expected error with both a trailing distance and percent")
+	}
+	o.TrailingPercent = 0
+	o.WatermarkPrice = 150
+	if err := validateConditionalOrder(o); err != nil {
+		t.Error(err)
+	}
+	if o.TriggerPrice != 140 {
+		t.Errorf("expected trigger price to be set from the watermark, received %v", o.TriggerPrice)
+	}
+}
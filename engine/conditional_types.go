@@ -0,0 +1,77 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// ConditionalStatus is the state of a conditional order managed by the engine
+type ConditionalStatus string
+
+// Conditional order statuses
+const (
+	// ConditionalPending orders are watched and submitted once triggered
+	ConditionalPending ConditionalStatus = "PENDING"
+	// ConditionalInactive orders are bracket legs waiting on their entry
+	// order to fill
+	ConditionalInactive ConditionalStatus = "INACTIVE"
+	// ConditionalTriggered orders have been submitted to the exchange
+	ConditionalTriggered ConditionalStatus = "TRIGGERED"
+	ConditionalCancelled ConditionalStatus = "CANCELLED"
+	ConditionalFailed    ConditionalStatus = "FAILED"
+)
+
+// ConditionalOrder is an order held by the engine until its trigger price is
+// reached. Orders sharing a GroupID and ParentID are one-cancels-the-other,
+// bracket take profit and stop loss legs reference their entry order by
+// ParentID and are only watched once the entry has filled
+type ConditionalOrder struct {
+	ID       string
+	GroupID  string
+	ParentID string
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Side     order.Side
+	// Type is one of order.Stop, order.TakeProfit or order.TrailingStop, a
+	// bracket entry may also be an order.Limit or order.Market order which is
+	// submitted straight away
+	Type         order.Type
+	TriggerPrice float64
+	// LimitPrice is the price of the limit order submitted once triggered, a
+	// market order is submitted when it is not set
+	LimitPrice       float64
+	Amount           float64
+	TrailingDistance float64
+	TrailingPercent  float64
+	// WatermarkPrice is the best price seen by a trailing stop, the highest
+	// for sell orders and the lowest for buy orders
+	WatermarkPrice float64
+	Status         ConditionalStatus
+	OrderID        string
+	Error          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type conditionalOrderManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	m        sync.Mutex
+	orders   map[string]*ConditionalOrder
+	// dirty holds the IDs of orders whose trailing stop watermark has moved
+	// since they were last stored
+	dirty map[string]struct{}
+	// unresolved holds the IDs of bracket entry orders already reported as
+	// having an unknown status
+	unresolved map[string]struct{}
+	// watching holds the exchanges which have ticker and orderbook dispatch
+	// subscriptions, keyed by lower case exchange name
+	watching map[string]bool
+}
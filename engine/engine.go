@@ -34,6 +34,15 @@ type Engine struct {
 	ConnectionManager           connectionManager
 	DatabaseManager             databaseManager
 	GctScriptManager            gctScriptManager
+	CandleManager               candleManager
+	TradeRecorder               tradeRecorder
+	RiskManager                 riskManager
+	ConditionalOrderManager     conditionalOrderManager
+	ExecutionManager            executionManager
+	ArbitrageManager            arbitrageManager
+	AutoLendManager             autoLendManager
+	OrderRouter                 orderRouter
+	OrderbookAggregator         orderbookAggregator
 	OrderManager                orderManager
 	PortfolioManager            portfolioManager
 	CommsManager                commsManager
@@ -118,6 +127,7 @@ func ValidateSettings(b *Engine, s *Settings) {
 	b.Settings.EnableCoinmarketcapAnalysis = s.EnableCoinmarketcapAnalysis
 	b.Settings.EnableDatabaseManager = s.EnableDatabaseManager
 	b.Settings.EnableGCTScriptManager = s.EnableGCTScriptManager
+	b.Settings.EnableCandleManager = s.EnableCandleManager
 	b.Settings.MaxVirtualMachines = s.MaxVirtualMachines
 	b.Settings.EnableDispatcher = s.EnableDispatcher
 
@@ -145,6 +155,12 @@ func ValidateSettings(b *Engine, s *Settings) {
 		b.Settings.EnableDeprecatedRPC = b.Config.RemoteControl.DeprecatedRPC.Enabled
 	}
 
+	if flagSet["papertrading"] {
+		b.Settings.EnablePaperTrading = s.EnablePaperTrading
+	} else {
+		b.Settings.EnablePaperTrading = b.Config.PaperTrading.Enabled
+	}
+
 	if flagSet["gctscriptmanager"] {
 		gctscript.GCTScriptConfig.Enabled = s.EnableGCTScriptManager
 	}
@@ -167,10 +183,15 @@ func ValidateSettings(b *Engine, s *Settings) {
 	b.Settings.EnableConnectivityMonitor = s.EnableConnectivityMonitor
 	b.Settings.EnableNTPClient = s.EnableNTPClient
 	b.Settings.EnableOrderManager = s.EnableOrderManager
+	b.Settings.RoundOrdersToTradingRules = s.RoundOrdersToTradingRules
+	b.Settings.EnableConditionalOrders = s.EnableConditionalOrders
+	b.Settings.EnableExecutionManager = s.EnableExecutionManager
+	b.Settings.EnableOrderbookAggregator = s.EnableOrderbookAggregator
 	b.Settings.EnableExchangeSyncManager = s.EnableExchangeSyncManager
 	b.Settings.EnableTickerSyncing = s.EnableTickerSyncing
 	b.Settings.EnableOrderbookSyncing = s.EnableOrderbookSyncing
 	b.Settings.EnableTradeSyncing = s.EnableTradeSyncing
+	b.Settings.EnableDerivativesSyncing = s.EnableDerivativesSyncing
 	b.Settings.SyncWorkers = s.SyncWorkers
 	b.Settings.SyncTimeout = s.SyncTimeout
 	b.Settings.SyncContinuously = s.SyncContinuously
@@ -234,6 +255,7 @@ func PrintSettings(s *Settings) {
 	log.Debugf(log.Global, "- CORE SETTINGS:")
 	log.Debugf(log.Global, "\t Verbose mode: %v", s.Verbose)
 	log.Debugf(log.Global, "\t Enable dry run mode: %v", s.EnableDryRun)
+	log.Debugf(log.Global, "\t Enable paper trading: %v", s.EnablePaperTrading)
 	log.Debugf(log.Global, "\t Enable all exchanges: %v", s.EnableAllExchanges)
 	log.Debugf(log.Global, "\t Enable all pairs: %v", s.EnableAllPairs)
 	log.Debugf(log.Global, "\t Enable coinmarketcap analaysis: %v", s.EnableCoinmarketcapAnalysis)
@@ -246,11 +268,16 @@ func PrintSettings(s *Settings) {
 	log.Debugf(log.Global, "\t Enable event manager: %v", s.EnableEventManager)
 	log.Debugf(log.Global, "\t Event manager sleep delay: %v", s.EventManagerDelay)
 	log.Debugf(log.Global, "\t Enable order manager: %v", s.EnableOrderManager)
+	log.Debugf(log.Global, "\t Round orders to trading rules: %v", s.RoundOrdersToTradingRules)
+	log.Debugf(log.Global, "\t Enable conditional orders: %v", s.EnableConditionalOrders)
+	log.Debugf(log.Global, "\t Enable execution manager: %v", s.EnableExecutionManager)
+	log.Debugf(log.Global, "\t Enable orderbook aggregator: %v", s.EnableOrderbookAggregator)
 	log.Debugf(log.Global, "\t Enable exchange sync manager: %v", s.EnableExchangeSyncManager)
 	log.Debugf(log.Global, "\t Enable deposit address manager: %v\n", s.EnableDepositAddressManager)
 	log.Debugf(log.Global, "\t Enable websocket routine: %v\n", s.EnableWebsocketRoutine)
 	log.Debugf(log.Global, "\t Enable NTP client: %v", s.EnableNTPClient)
 	log.Debugf(log.Global, "\t Enable Database manager: %v", s.EnableDatabaseManager)
+	log.Debugf(log.Global, "\t Enable candle manager: %v", s.EnableCandleManager)
 	log.Debugf(log.Global, "\t Enable dispatcher: %v", s.EnableDispatcher)
 	log.Debugf(log.Global, "\t Dispatch package max worker amount: %d", s.DispatchMaxWorkerAmount)
 	log.Debugf(log.Global, "\t Dispatch package jobs limit: %d", s.DispatchJobsLimit)
@@ -260,6 +287,7 @@ func PrintSettings(s *Settings) {
 	log.Debugf(log.Global, "\t Enable ticker syncing: %v\n", s.EnableTickerSyncing)
 	log.Debugf(log.Global, "\t Enable orderbook syncing: %v\n", s.EnableOrderbookSyncing)
 	log.Debugf(log.Global, "\t Enable trade syncing: %v\n", s.EnableTradeSyncing)
+	log.Debugf(log.Global, "\t Enable derivatives syncing: %v\n", s.EnableDerivativesSyncing)
 	log.Debugf(log.Global, "\t Exchange sync timeout: %v\n", s.SyncTimeout)
 	log.Debugf(log.Global, "- FOREX SETTINGS:")
 	log.Debugf(log.Global, "\t Enable currency conveter: %v", s.EnableCurrencyConverter)
@@ -407,17 +435,61 @@ func (e *Engine) Start() error {
 		e.DepositAddressManager.Sync()
 	}
 
+	if e.Config.RiskManager.Enabled {
+		if err = e.RiskManager.Start(); err != nil {
+			log.Errorf(log.Global, "Risk manager unable to start: %v", err)
+		}
+	}
+
 	if e.Settings.EnableOrderManager {
 		if err = e.OrderManager.Start(); err != nil {
 			log.Errorf(log.Global, "Order manager unable to start: %v", err)
 		}
 	}
 
+	if e.Settings.EnableOrderManager && e.Settings.EnableConditionalOrders {
+		if err = e.ConditionalOrderManager.Start(); err != nil {
+			log.Errorf(log.Global, "Conditional order manager unable to start: %v", err)
+		}
+	}
+
+	if e.Settings.EnableOrderManager && e.Settings.EnableExecutionManager {
+		if err = e.ExecutionManager.Start(); err != nil {
+			log.Errorf(log.Global, "Execution manager unable to start: %v", err)
+		}
+	}
+
+	if e.Config.Arbitrage.Enabled {
+		if err = e.ArbitrageManager.Start(); err != nil {
+			log.Errorf(log.Global, "Arbitrage manager unable to start: %v", err)
+		}
+	}
+
+	if e.Config.AutoLend.Enabled {
+		if err = e.AutoLendManager.Start(); err != nil {
+			log.Errorf(log.Global, "Auto-lend manager unable to start: %v", err)
+		}
+	}
+
+	if e.Settings.EnableOrderbookAggregator {
+		if err = e.OrderbookAggregator.Start(); err != nil {
+			log.Errorf(log.Global, "Orderbook aggregator unable to start: %v", err)
+		}
+	}
+
+	if e.Settings.EnableExchangeSyncManager && e.Settings.EnableTradeSyncing &&
+		e.Config.Database.Enabled {
+		if err = e.TradeRecorder.Start(); err != nil {
+			log.Errorf(log.Global, "Trade recorder unable to start: %v", err)
+		}
+	}
+
 	if e.Settings.EnableExchangeSyncManager {
 		exchangeSyncCfg := CurrencyPairSyncerConfig{
 			SyncTicker:       e.Settings.EnableTickerSyncing,
 			SyncOrderbook:    e.Settings.EnableOrderbookSyncing,
 			SyncTrades:       e.Settings.EnableTradeSyncing,
+			SyncDerivatives:  e.Settings.EnableDerivativesSyncing,
 			SyncContinuously: e.Settings.SyncContinuously,
 			NumWorkers:       e.Settings.SyncWorkers,
 			Verbose:          e.Settings.Verbose,
@@ -447,6 +519,12 @@ func (e *Engine) Start() error {
 		}
 	}
 
+	if e.Settings.EnableCandleManager && e.Config.Database.Enabled {
+		if err := e.CandleManager.Start(); err != nil {
+			log.Errorf(log.Global, "Candle manager unable to start: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -463,11 +541,51 @@ func (e *Engine) Stop() {
 			log.Errorf(log.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if e.CandleManager.Started() {
+		if err := e.CandleManager.Stop(); err != nil {
+			log.Errorf(log.Global, "Candle manager unable to stop. Error: %v", err)
+		}
+	}
+	if e.TradeRecorder.Started() {
+		if err := e.TradeRecorder.Stop(); err != nil {
+			log.Errorf(log.Global, "Trade recorder unable to stop. Error: %v", err)
+		}
+	}
+	if e.OrderbookAggregator.Started() {
+		if err := e.OrderbookAggregator.Stop(); err != nil {
+			log.Errorf(log.Global, "Orderbook aggregator unable to stop. Error: %v", err)
+		}
+	}
+	if e.AutoLendManager.Started() {
+		if err := e.AutoLendManager.Stop(); err != nil {
+			log.Errorf(log.Global, "Auto-lend manager unable to stop. Error: %v", err)
+		}
+	}
+	if e.ArbitrageManager.Started() {
+		if err := e.ArbitrageManager.Stop(); err != nil {
+			log.Errorf(log.Global, "Arbitrage manager unable to stop. Error: %v", err)
+		}
+	}
+	if e.ExecutionManager.Started() {
+		if err := e.ExecutionManager.Stop(); err != nil {
+			log.Errorf(log.Global, "Execution manager unable to stop. Error: %v", err)
+		}
+	}
+	if e.ConditionalOrderManager.Started() {
+		if err := e.ConditionalOrderManager.Stop(); err != nil {
+			log.Errorf(log.Global, "Conditional order manager unable to stop. Error: %v", err)
+		}
+	}
 	if e.OrderManager.Started() {
 		if err := e.OrderManager.Stop(); err != nil {
 			log.Errorf(log.Global, "Order manager unable to stop. Error: %v", err)
 		}
 	}
+	if e.RiskManager.Started() {
+		if err := e.RiskManager.Stop(); err != nil {
+			log.Errorf(log.Global, "Risk manager unable to stop. Error: %v", err)
+		}
+	}
 
 	if e.NTPManager.Started() {
 		if err := e.NTPManager.Stop(); err != nil {
@@ -13,6 +13,7 @@ type Settings struct {
 
 	// Core Settings
 	EnableDryRun                bool
+	EnablePaperTrading          bool
 	EnableAllExchanges          bool
 	EnableAllPairs              bool
 	EnableCoinmarketcapAnalysis bool
@@ -26,21 +27,27 @@ type Settings struct {
 	EnableDepositAddressManager bool
 	EnableEventManager          bool
 	EnableOrderManager          bool
+	EnableConditionalOrders     bool
+	EnableExecutionManager      bool
+	EnableOrderbookAggregator   bool
 	EnableConnectivityMonitor   bool
 	EnableDatabaseManager       bool
 	EnableGCTScriptManager      bool
+	EnableCandleManager         bool
 	EnableNTPClient             bool
 	EnableWebsocketRoutine      bool
+	RoundOrdersToTradingRules   bool
 	EventManagerDelay           time.Duration
 	Verbose                     bool
 
 	// Exchange syncer settings
-	EnableTickerSyncing    bool
-	EnableOrderbookSyncing bool
-	EnableTradeSyncing     bool
-	SyncWorkers            int
-	SyncContinuously       bool
-	SyncTimeout            time.Duration
+	EnableTickerSyncing      bool
+	EnableOrderbookSyncing   bool
+	EnableTradeSyncing       bool
+	EnableDerivativesSyncing bool
+	SyncWorkers              int
+	SyncContinuously         bool
+	SyncTimeout              time.Duration
 
 	// Forex settings
 	EnableCurrencyConverter bool
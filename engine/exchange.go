@@ -31,6 +31,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/localbitcoins"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okcoin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okex"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/poloniex"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/yobit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/zb"
@@ -275,6 +276,11 @@ func LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
 		return err
 	}
 
+	if Bot.Settings.EnablePaperTrading {
+		exch = paper.New(exch, Bot.Config.GetPaperTradingBalances(name))
+		log.Debugf(log.ExchangeSys, "%s: Paper trading enabled, orders will be simulated.\n", name)
+	}
+
 	Bot.Exchanges = append(Bot.Exchanges, exch)
 
 	base := exch.GetBase()
@@ -0,0 +1,689 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const executionManagerName = "Execution manager"
+
+// vars for the execution manager
+var (
+	// ExecutionCheckDelay is how often child order fills are checked and due
+	// slices are submitted
+	ExecutionCheckDelay = time.Second
+	// DefaultExecutionProfileDays is the number of days of candles a VWAP
+	// volume profile is built from when not supplied
+	DefaultExecutionProfileDays = 7
+	// ExecutionRetention is how long complete, cancelled and failed
+	// executions are kept before they are removed
+	ExecutionRetention = time.Hour * 24
+
+	errExecutionNotFound = errors.New("execution not found")
+)
+
+// executionAmountTolerance is the amount below which an execution is
+// considered fully executed to allow for float rounding
+const executionAmountTolerance = 1e-10
+
+// Started returns if the execution manager subsystem is started
+func (e *executionManager) Started() bool {
+	return atomic.LoadInt32(&e.started) == 1
+}
+
+// Start starts the execution manager subsystem, the order manager must be
+// started as child orders are submitted through it
+func (e *executionManager) Start() error {
+	if !Bot.OrderManager.Started() {
+		return fmt.Errorf("%s requires the order manager to be started",
+			executionManagerName)
+	}
+
+	if atomic.AddInt32(&e.started, 1) != 1 {
+		return fmt.Errorf("%s %s", executionManagerName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.OrderMgr, executionManagerName, MsgSubSystemStarting)
+	e.shutdown = make(chan struct{})
+	e.m.Lock()
+	if e.executions == nil {
+		e.executions = make(map[string]*Execution)
+	}
+	e.m.Unlock()
+	e.wg.Add(1)
+	go e.run()
+	log.Debugln(log.OrderMgr, executionManagerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the execution manager subsystem, running executions stop
+// submitting child orders until it is started again
+func (e *executionManager) Stop() error {
+	if atomic.LoadInt32(&e.started) == 0 {
+		return fmt.Errorf("%s %s", executionManagerName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&e.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", executionManagerName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.OrderMgr, executionManagerName, MsgSubSystemShuttingDown)
+	close(e.shutdown)
+	e.wg.Wait()
+	atomic.CompareAndSwapInt32(&e.stopped, 1, 0)
+	atomic.CompareAndSwapInt32(&e.started, 1, 0)
+	log.Debugln(log.OrderMgr, executionManagerName, MsgSubSystemShutdown)
+	return nil
+}
+
+func (e *executionManager) run() {
+	tick := time.NewTicker(ExecutionCheckDelay)
+	defer func() {
+		tick.Stop()
+		e.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-e.shutdown:
+			return
+		case <-tick.C:
+			e.process(time.Now(), Bot.OrderManager.orderStore.GetOrder, submitExecutionChild)
+		}
+	}
+}
+
+// StartExecution validates a parent order, builds its slice schedule and
+// starts working it
+func (e *executionManager) StartExecution(req *ExecutionRequest) (*Execution, error) {
+	if !e.Started() {
+		return nil, fmt.Errorf("%s %s", executionManagerName, ErrSubSystemNotStarted)
+	}
+	if err := validateExecutionRequest(req); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	ex := &Execution{
+		ExecutionRequest: *req,
+		ID:               id.String(),
+		Status:           ExecutionRunning,
+		RemainingAmount:  req.Amount,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	switch req.Algorithm {
+	case ExecutionTWAP:
+		ex.Schedule = twapSchedule(req.Start, req.End, req.Slices, req.Amount)
+	case ExecutionVWAP:
+		ex.Schedule = vwapSchedule(req.Start, req.End, req.Interval, req.Amount,
+			executionVolumeProfile(req))
+	}
+
+	e.m.Lock()
+	e.executions[ex.ID] = ex
+	result := ex.copy()
+	e.m.Unlock()
+
+	log.Debugf(log.OrderMgr, "%s: Started %s %s %s %s execution ID=%v amount=%v slices=%d.\n",
+		executionManagerName,
+		ex.Algorithm,
+		ex.Exchange,
+		ex.Pair,
+		ex.Side,
+		ex.ID,
+		ex.Amount,
+		len(ex.Schedule))
+	return result, nil
+}
+
+// Pause stops an execution from submitting child orders, resting child orders
+// are left on the order book
+func (e *executionManager) Pause(id string) (*Execution, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	ex, ok := e.executions[id]
+	if !ok {
+		return nil, errExecutionNotFound
+	}
+	if ex.Status != ExecutionRunning {
+		return nil, fmt.Errorf("execution ID=%v is %s and cannot be paused", id, ex.Status)
+	}
+	ex.Status = ExecutionPaused
+	ex.PausedAt = time.Now()
+	ex.UpdatedAt = ex.PausedAt
+	return ex.copy(), nil
+}
+
+// Resume restarts a paused execution, the remaining schedule is moved back by
+// the time the execution was paused for
+func (e *executionManager) Resume(id string) (*Execution, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	ex, ok := e.executions[id]
+	if !ok {
+		return nil, errExecutionNotFound
+	}
+	if ex.Status != ExecutionPaused {
+		return nil, fmt.Errorf("execution ID=%v is %s and cannot be resumed", id, ex.Status)
+	}
+	ex.resume(time.Now())
+	return ex.copy(), nil
+}
+
+// Cancel stops an execution and cancels any of its child orders which are
+// still open
+func (e *executionManager) Cancel(id string) (*Execution, error) {
+	e.m.Lock()
+	ex, ok := e.executions[id]
+	if !ok {
+		e.m.Unlock()
+		return nil, errExecutionNotFound
+	}
+	if ex.Status != ExecutionRunning && ex.Status != ExecutionPaused {
+		e.m.Unlock()
+		return nil, fmt.Errorf("execution ID=%v is %s and cannot be cancelled", id, ex.Status)
+	}
+	ex.Status = ExecutionCancelled
+	ex.UpdatedAt = time.Now()
+	open := ex.openChildren()
+	result := ex.copy()
+	e.m.Unlock()
+
+	for x := range open {
+		cancelExecutionChild(result, &open[x])
+	}
+	return result, nil
+}
+
+// GetExecution returns a copy of an execution
+func (e *executionManager) GetExecution(id string) (*Execution, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	ex, ok := e.executions[id]
+	if !ok {
+		return nil, errExecutionNotFound
+	}
+	return ex.copy(), nil
+}
+
+// GetExecutions returns a copy of all executions, filtered by exchange if one
+// is supplied
+func (e *executionManager) GetExecutions(exchName string) []Execution {
+	e.m.Lock()
+	defer e.m.Unlock()
+	var executions []Execution
+	for _, ex := range e.executions {
+		if exchName != "" && !strings.EqualFold(ex.Exchange, exchName) {
+			continue
+		}
+		executions = append(executions, *ex.copy())
+	}
+	return executions
+}
+
+type executionWork struct {
+	id     string
+	amount float64
+}
+
+// process refreshes the fill progress of every running execution, submits
+// any child orders which are due, marks executions which have finished as
+// complete and removes finished executions once they are past the retention
+// period
+func (e *executionManager) process(now time.Time, lookup func(exchName, id string) (order.Detail, bool), submit func(ex *Execution, amount float64) (ExecutionChild, error)) {
+	e.m.Lock()
+	var work []executionWork
+	var snapshots = make(map[string]*Execution)
+	for id, ex := range e.executions {
+		if ex.Status != ExecutionRunning && ex.Status != ExecutionPaused {
+			if now.Sub(ex.UpdatedAt) > ExecutionRetention {
+				delete(e.executions, id)
+			}
+			continue
+		}
+		ex.refresh(lookup)
+		if ex.Status != ExecutionRunning {
+			continue
+		}
+		if ex.finished() {
+			ex.complete(now)
+			continue
+		}
+		if amount := ex.due(now); amount > 0 {
+			work = append(work, executionWork{id: ex.ID, amount: amount})
+			snapshots[ex.ID] = ex.copy()
+		}
+	}
+	e.m.Unlock()
+
+	for x := range work {
+		child, err := submit(snapshots[work[x].id], work[x].amount)
+
+		e.m.Lock()
+		ex := e.executions[work[x].id]
+		if err != nil {
+			msg := fmt.Sprintf("%s: Execution ID=%v failed to submit a child order",
+				executionManagerName, ex.ID)
+			if ex.Algorithm == ExecutionIceberg {
+				// iceberg children are resubmitted until they fill so a
+				// rejected child would be retried forever
+				ex.Status = ExecutionFailed
+				ex.Error = err.Error()
+			} else {
+				// the remaining slices of a TWAP or VWAP execution are
+				// still worked
+				child.Amount = work[x].amount
+				child.Status = order.Rejected
+				child.Error = err.Error()
+				ex.Children = append(ex.Children, child)
+				msg += " and will continue with its remaining slices"
+			}
+			ex.UpdatedAt = time.Now()
+			e.m.Unlock()
+			msg += fmt.Sprintf(". Err: %s", err)
+			log.Errorln(log.OrderMgr, msg)
+			Bot.CommsManager.PushEvent(base.Event{
+				Type:    "order",
+				Message: msg,
+			})
+			continue
+		}
+		ex.Children = append(ex.Children, child)
+		ex.UpdatedAt = time.Now()
+		ex.refresh(nil)
+		cancelled := ex.Status == ExecutionCancelled
+		e.m.Unlock()
+
+		if cancelled {
+			// the execution was cancelled while the child was being submitted
+			cancelExecutionChild(snapshots[work[x].id], &child)
+		}
+	}
+}
+
+// complete marks a finished execution as complete, it is failed when none of
+// its child orders executed because they were all rejected
+func (ex *Execution) complete(now time.Time) {
+	var failed int
+	for x := range ex.Children {
+		if ex.Children[x].Error != "" {
+			failed++
+		}
+	}
+	ex.Status = ExecutionComplete
+	ex.UpdatedAt = now
+	if failed == 0 {
+		log.Debugf(log.OrderMgr, "%s: Execution ID=%v complete, executed %v at an average price of %v.\n",
+			executionManagerName, ex.ID, ex.ExecutedAmount, ex.AveragePrice)
+		return
+	}
+
+	ex.Error = fmt.Sprintf("%d of %d child orders failed to submit", failed, len(ex.Children))
+	if ex.ExecutedAmount <= executionAmountTolerance {
+		ex.Status = ExecutionFailed
+	}
+	log.Warnf(log.OrderMgr, "%s: Execution ID=%v %s, executed %v of %v at an average price of %v, %s.\n",
+		executionManagerName, ex.ID, ex.Status, ex.ExecutedAmount, ex.Amount, ex.AveragePrice, ex.Error)
+}
+
+// due returns the amount of the next child order if one should be submitted,
+// TWAP and VWAP slices which are due are combined into one child order and
+// an iceberg child is submitted once the previous child has closed
+func (ex *Execution) due(now time.Time) float64 {
+	if ex.Algorithm == ExecutionIceberg {
+		if len(ex.openChildren()) > 0 {
+			return 0
+		}
+		return math.Min(ex.VisibleAmount, ex.Amount-ex.ExecutedAmount)
+	}
+
+	var amount float64
+	for x := range ex.Schedule {
+		if ex.Schedule[x].Sent || ex.Schedule[x].Time.After(now) {
+			continue
+		}
+		ex.Schedule[x].Sent = true
+		amount += ex.Schedule[x].Amount
+	}
+	return amount
+}
+
+// finished returns whether an execution has no more child orders to submit
+// and all of its child orders have closed
+func (ex *Execution) finished() bool {
+	if len(ex.openChildren()) > 0 {
+		return false
+	}
+	if ex.Amount-ex.ExecutedAmount <= executionAmountTolerance {
+		return true
+	}
+	if ex.Algorithm == ExecutionIceberg {
+		return false
+	}
+	for x := range ex.Schedule {
+		if !ex.Schedule[x].Sent {
+			return false
+		}
+	}
+	return true
+}
+
+// refresh updates the child orders from the order manager and recalculates
+// the executed amount and average price of the execution
+func (ex *Execution) refresh(lookup func(exchName, id string) (order.Detail, bool)) {
+	var executed, cost float64
+	for x := range ex.Children {
+		c := &ex.Children[x]
+		if lookup != nil && !isOrderClosed(c.Status) {
+			if d, ok := lookup(ex.Exchange, c.OrderID); ok {
+				c.Status = d.Status
+				c.ExecutedAmount = d.ExecutedAmount
+				c.AveragePrice = childFillPrice(&d, c.ReferencePrice)
+			}
+		}
+		executed += c.ExecutedAmount
+		cost += c.ExecutedAmount * c.AveragePrice
+	}
+	ex.ExecutedAmount = executed
+	ex.RemainingAmount = math.Max(ex.Amount-executed, 0)
+	ex.AveragePrice = 0
+	if executed > 0 {
+		ex.AveragePrice = cost / executed
+	}
+}
+
+// resume sets a paused execution running again and moves the unsent slices
+// back by the time it was paused for
+func (ex *Execution) resume(now time.Time) {
+	paused := now.Sub(ex.PausedAt)
+	for x := range ex.Schedule {
+		if !ex.Schedule[x].Sent {
+			ex.Schedule[x].Time = ex.Schedule[x].Time.Add(paused)
+		}
+	}
+	if !ex.End.IsZero() {
+		ex.End = ex.End.Add(paused)
+	}
+	ex.Status = ExecutionRunning
+	ex.PausedAt = time.Time{}
+	ex.UpdatedAt = now
+}
+
+func (ex *Execution) openChildren() []ExecutionChild {
+	var open []ExecutionChild
+	for x := range ex.Children {
+		if !isOrderClosed(ex.Children[x].Status) {
+			open = append(open, ex.Children[x])
+		}
+	}
+	return open
+}
+
+func (ex *Execution) copy() *Execution {
+	c := *ex
+	c.Schedule = append([]ExecutionSlice(nil), ex.Schedule...)
+	c.Children = append([]ExecutionChild(nil), ex.Children...)
+	return &c
+}
+
+// childFillPrice returns the average price of a child order's fills, falling
+// back to the order price and then the reference price for market orders
+// which do not report their fills
+func childFillPrice(d *order.Detail, referencePrice float64) float64 {
+	var amount, cost float64
+	for x := range d.Trades {
+		amount += d.Trades[x].Amount
+		cost += d.Trades[x].Amount * d.Trades[x].Price
+	}
+	if amount > 0 {
+		return cost / amount
+	}
+	if d.Price > 0 {
+		return d.Price
+	}
+	return referencePrice
+}
+
+// executionChildSubmit returns the child order of an execution, it is a limit
+// order if the execution has a limit price and a market order otherwise
+func executionChildSubmit(ex *Execution, amount float64) *order.Submit {
+	s := &order.Submit{
+		Pair:      ex.Pair,
+		AssetType: ex.Asset,
+		OrderSide: ex.Side,
+		OrderType: order.Market,
+		Amount:    amount,
+	}
+	if ex.LimitPrice > 0 {
+		s.OrderType = order.Limit
+		s.Price = ex.LimitPrice
+	}
+	return s
+}
+
+// submitExecutionChild submits a child order for an execution through the
+// order manager
+func submitExecutionChild(ex *Execution, amount float64) (ExecutionChild, error) {
+	s := executionChildSubmit(ex, amount)
+	child := ExecutionChild{
+		Amount:      amount,
+		Status:      order.New,
+		SubmittedAt: time.Now(),
+	}
+	if t, err := ticker.GetTicker(ex.Exchange, ex.Pair, ex.Asset); err == nil {
+		child.ReferencePrice = t.Last
+	}
+
+	resp, err := Bot.OrderManager.Submit(ex.Exchange, s)
+	if err != nil {
+		return child, err
+	}
+	child.OrderID = resp.OrderID
+	if resp.FullyMatched {
+		child.Status = order.Filled
+		child.ExecutedAmount = amount
+		child.AveragePrice = child.ReferencePrice
+		if s.Price > 0 {
+			child.AveragePrice = s.Price
+		}
+	}
+	log.Debugf(log.OrderMgr, "%s: Execution ID=%v submitted child order ID=%v amount=%v.\n",
+		executionManagerName, ex.ID, child.OrderID, amount)
+	return child, nil
+}
+
+func cancelExecutionChild(ex *Execution, child *ExecutionChild) {
+	err := Bot.OrderManager.Cancel(ex.Exchange, &order.Cancel{
+		OrderID:      child.OrderID,
+		CurrencyPair: ex.Pair,
+		AssetType:    ex.Asset,
+		Side:         ex.Side,
+	})
+	if err != nil {
+		log.Errorf(log.OrderMgr, "%s: Execution ID=%v unable to cancel child order ID=%v. Err: %s\n",
+			executionManagerName, ex.ID, child.OrderID, err)
+	}
+}
+
+// validateExecutionRequest checks an execution request and fills in its
+// defaults
+func validateExecutionRequest(req *ExecutionRequest) error {
+	if req == nil {
+		return errors.New("execution request is nil")
+	}
+	req.Algorithm = strings.ToLower(req.Algorithm)
+	exch := GetExchangeByName(req.Exchange)
+	if exch == nil {
+		return errors.New("unable to get exchange by name")
+	}
+	req.Exchange = exch.GetName()
+	if req.Pair.IsEmpty() {
+		return order.ErrPairIsEmpty
+	}
+	if req.Asset == "" {
+		req.Asset = asset.Spot
+	}
+	if !asset.IsValid(req.Asset) {
+		return fmt.Errorf("%s is not a valid asset type", req.Asset)
+	}
+	if !exch.GetAssetTypes().Contains(req.Asset) {
+		return fmt.Errorf("%s asset type %s not supported", req.Exchange, req.Asset)
+	}
+	side, err := order.StringToOrderSide(req.Side.String())
+	if err != nil || (side != order.Buy && side != order.Sell &&
+		side != order.Bid && side != order.Ask) {
+		return order.ErrSideIsInvalid
+	}
+	req.Side = side
+	if req.Amount <= 0 {
+		return order.ErrAmountIsInvalid
+	}
+	if req.LimitPrice < 0 {
+		return errors.New("execution limit price cannot be negative")
+	}
+
+	now := time.Now()
+	if req.Start.IsZero() {
+		req.Start = now
+	}
+	switch req.Algorithm {
+	case ExecutionTWAP:
+		if req.Slices <= 0 {
+			return errors.New("TWAP executions require at least one slice")
+		}
+		if !req.End.After(req.Start) || !req.End.After(now) {
+			return errors.New("execution end must be after the start and in the future")
+		}
+	case ExecutionVWAP:
+		if req.Interval == 0 {
+			req.Interval = kline.OneHour
+		}
+		if !req.Interval.IsSupported() || req.Interval > kline.OneDay {
+			return fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, req.Interval)
+		}
+		if req.ProfileDays <= 0 {
+			req.ProfileDays = DefaultExecutionProfileDays
+		}
+		if !req.End.After(req.Start) || !req.End.After(now) {
+			return errors.New("execution end must be after the start and in the future")
+		}
+	case ExecutionIceberg:
+		if req.LimitPrice <= 0 {
+			return errors.New("iceberg executions require a limit price")
+		}
+		if req.VisibleAmount <= 0 || req.VisibleAmount > req.Amount {
+			return errors.New("iceberg visible amount must be positive and no more than the order amount")
+		}
+	default:
+		return fmt.Errorf("%s is not a supported execution algorithm", req.Algorithm)
+	}
+	return nil
+}
+
+// twapSchedule splits the amount into equal slices spread evenly between the
+// start and end
+func twapSchedule(start, end time.Time, slices int, amount float64) []ExecutionSlice {
+	if slices <= 0 || !end.After(start) {
+		return nil
+	}
+	step := end.Sub(start) / time.Duration(slices)
+	schedule := make([]ExecutionSlice, slices)
+	for x := range schedule {
+		schedule[x] = ExecutionSlice{
+			Time:   start.Add(step * time.Duration(x)),
+			Amount: amount / float64(slices),
+		}
+	}
+	return schedule
+}
+
+// vwapSchedule splits the amount into a slice per interval between the start
+// and end, each slice is sized by the average volume traded at the same time
+// of day in the supplied candles. Slices are equal if there is no volume
+// profile
+func vwapSchedule(start, end time.Time, interval kline.Interval, amount float64, candles []kline.Candle) []ExecutionSlice {
+	if interval <= 0 || !end.After(start) {
+		return nil
+	}
+
+	volumes := make(map[time.Duration]float64)
+	counts := make(map[time.Duration]float64)
+	var totalVolume float64
+	for x := range candles {
+		offset := timeOfDay(candles[x].Time, interval)
+		volumes[offset] += candles[x].Volume
+		counts[offset]++
+		totalVolume += candles[x].Volume
+	}
+	var averageVolume float64
+	if len(candles) > 0 {
+		averageVolume = totalVolume / float64(len(candles))
+	}
+
+	var schedule []ExecutionSlice
+	var weights []float64
+	var totalWeight float64
+	for t := start; t.Before(end); t = t.Add(interval.Duration()) {
+		weight := averageVolume
+		offset := timeOfDay(t, interval)
+		if counts[offset] > 0 {
+			weight = volumes[offset] / counts[offset]
+		}
+		schedule = append(schedule, ExecutionSlice{Time: t})
+		weights = append(weights, weight)
+		totalWeight += weight
+	}
+	for x := range schedule {
+		if totalWeight > 0 {
+			schedule[x].Amount = amount * weights[x] / totalWeight
+		} else {
+			schedule[x].Amount = amount / float64(len(schedule))
+		}
+	}
+	return schedule
+}
+
+// timeOfDay returns the offset of the interval containing t from the start of
+// its UTC day
+func timeOfDay(t time.Time, interval kline.Interval) time.Duration {
+	t = t.UTC()
+	day := t.Truncate(time.Hour * 24)
+	return t.Truncate(interval.Duration()).Sub(day)
+}
+
+// executionVolumeProfile returns the candles a VWAP volume profile is built
+// from, an empty profile is returned if they cannot be retrieved so the
+// execution falls back to equal slices
+func executionVolumeProfile(req *ExecutionRequest) []kline.Candle {
+	exch := GetExchangeByName(req.Exchange)
+	if exch == nil {
+		return nil
+	}
+	end := req.Start.UTC().Truncate(req.Interval.Duration())
+	start := end.AddDate(0, 0, -req.ProfileDays)
+	candles, err := GetHistoricCandles(exch, req.Pair, req.Asset, start, end, req.Interval)
+	if err != nil {
+		log.Warnf(log.OrderMgr, "%s: Unable to retrieve %s %s candles for the VWAP volume profile, slices will be equal. Err: %s\n",
+			executionManagerName, req.Exchange, req.Pair, err)
+		return nil
+	}
+	return candles.Candles
+}
@@ -0,0 +1,307 @@
+package engine
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestTWAPSchedule(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := twapSchedule(start, start.Add(time.Hour), 4, 10)
+	if len(s) != 4 {
+		t.Fatalf("expected 4 slices, received %d", len(s))
+	}
+	for x := range s {
+		if !s[x].Time.Equal(start.Add(time.Minute*15*time.Duration(x))) || s[x].Amount != 2.5 {
+			t.Errorf("unexpected slice %+v", s[x])
+		}
+	}
+	if s := twapSchedule(start, start, 4, 10); s != nil {
+		t.Error("expected no slices for an empty window")
+	}
+}
+
+func TestVWAPSchedule(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour * 3)
+	s := vwapSchedule(start, end, kline.OneHour, 10, nil)
+	if len(s) != 3 {
+		t.Fatalf("expected 3 slices, received %d", len(s))
+	}
+	for x := range s {
+		if math.Abs(s[x].Amount-10.0/3) > 1e-9 {
+			t.Errorf("expected equal slices without a volume profile %+v", s[x])
+		}
+	}
+
+	// two days of history, the second hour of the day trades three times the
+	// volume of the first and the third hour has no history
+	profile := []kline.Candle{
+		{Time: start.AddDate(0, 0, -2), Volume: 10},
+		{Time: start.AddDate(0, 0, -2).Add(time.Hour), Volume: 20},
+		{Time: start.AddDate(0, 0, -1), Volume: 10},
+		{Time: start.AddDate(0, 0, -1).Add(time.Hour), Volume: 40},
+	}
+	s = vwapSchedule(start, end, kline.OneHour, 10, profile)
+	if len(s) != 3 {
+		t.Fatalf("expected 3 slices, received %d", len(s))
+	}
+	// weights are 10, 30 and the 20 average volume
+	expected := []float64{10.0 / 6, 5, 10.0 / 3}
+	var total float64
+	for x := range s {
+		total += s[x].Amount
+		if math.Abs(s[x].Amount-expected[x]) > 1e-9 {
+			t.Errorf("expected slice %d amount %v, received %v", x, expected[x], s[x].Amount)
+		}
+	}
+	if math.Abs(total-10) > 1e-9 {
+		t.Errorf("expected slices to total 10, received %v", total)
+	}
+}
+
+func TestExecutionProcess(t *testing.T) {
+	SetupTestHelpers(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	twap := &Execution{
+		ExecutionRequest: ExecutionRequest{
+			Algorithm: ExecutionTWAP,
+			Exchange:  "Bitstamp",
+			Pair:      currency.NewPair(currency.BTC, currency.USD),
+			Side:      order.Buy,
+			Amount:    4,
+		},
+		ID:       "twap",
+		Status:   ExecutionRunning,
+		Schedule: twapSchedule(start, start.Add(time.Hour*4), 4, 4),
+	}
+	iceberg := &Execution{
+		ExecutionRequest: ExecutionRequest{
+			Algorithm:     ExecutionIceberg,
+			Exchange:      "Bitstamp",
+			Pair:          currency.NewPair(currency.BTC, currency.USD),
+			Side:          order.Sell,
+			Amount:        3,
+			LimitPrice:    100,
+			VisibleAmount: 2,
+		},
+		ID:     "iceberg",
+		Status: ExecutionRunning,
+	}
+	e := executionManager{
+		executions: map[string]*Execution{
+			twap.ID:    twap,
+			iceberg.ID: iceberg,
+		},
+	}
+
+	exchangeOrders := make(map[string]order.Detail)
+	lookup := func(exchName, id string) (order.Detail, bool) {
+		d, ok := exchangeOrders[id]
+		return d, ok
+	}
+	var submitted int
+	submit := func(ex *Execution, amount float64) (ExecutionChild, error) {
+		submitted++
+		id := ex.ID + string(rune('0'+submitted))
+		exchangeOrders[id] = order.Detail{ID: id, Status: order.New, Price: ex.LimitPrice}
+		return ExecutionChild{
+			OrderID:        id,
+			Amount:         amount,
+			Status:         order.New,
+			ReferencePrice: 50,
+		}, nil
+	}
+
+	// the first TWAP slice and iceberg child are due
+	e.process(start, lookup, submit)
+	if len(twap.Children) != 1 || twap.Children[0].Amount != 1 {
+		t.Fatalf("unexpected TWAP children %+v", twap.Children)
+	}
+	if len(iceberg.Children) != 1 || iceberg.Children[0].Amount != 2 {
+		t.Fatalf("unexpected iceberg children %+v", iceberg.Children)
+	}
+
+	// the iceberg child is still resting so no new child is submitted, the
+	// two overdue TWAP slices are combined
+	e.process(start.Add(time.Hour*2), lookup, submit)
+	if len(iceberg.Children) != 1 {
+		t.Errorf("expected one iceberg child, received %d", len(iceberg.Children))
+	}
+	if len(twap.Children) != 2 || twap.Children[1].Amount != 2 {
+		t.Fatalf("unexpected TWAP children %+v", twap.Children)
+	}
+
+	// fill the first iceberg child and both TWAP children
+	id := iceberg.Children[0].OrderID
+	exchangeOrders[id] = order.Detail{Status: order.Filled, ExecutedAmount: 2, Price: 100}
+	exchangeOrders[twap.Children[0].OrderID] = order.Detail{
+		Status:         order.Filled,
+		ExecutedAmount: 1,
+		Trades:         []order.TradeHistory{{Price: 10, Amount: 1}},
+	}
+	exchangeOrders[twap.Children[1].OrderID] = order.Detail{Status: order.Filled, ExecutedAmount: 2}
+	e.process(start.Add(time.Hour*2), lookup, submit)
+	if len(iceberg.Children) != 2 || iceberg.Children[1].Amount != 1 {
+		t.Fatalf("expected the remaining iceberg amount to be submitted %+v", iceberg.Children)
+	}
+	if twap.ExecutedAmount != 3 || twap.RemainingAmount != 1 || twap.AveragePrice != 110.0/3 {
+		t.Errorf("unexpected TWAP progress %+v", twap)
+	}
+
+	if _, err := e.Pause(twap.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Pause(twap.ID); err == nil {
+		t.Error("expected error pausing a paused execution")
+	}
+	e.process(start.Add(time.Hour*5), lookup, submit)
+	if len(twap.Children) != 2 {
+		t.Error("paused executions should not submit child orders")
+	}
+	twap.resume(twap.PausedAt.Add(time.Hour))
+	if twap.Schedule[3].Time != start.Add(time.Hour*4) || twap.Status != ExecutionRunning {
+		t.Errorf("expected the unsent slices to be moved back %+v", twap.Schedule[3])
+	}
+	e.process(start.Add(time.Hour*4), lookup, submit)
+	exchangeOrders[twap.Children[2].OrderID] = order.Detail{Status: order.Filled, ExecutedAmount: 1, Price: 20}
+	exchangeOrders[iceberg.Children[1].OrderID] = order.Detail{Status: order.Filled, ExecutedAmount: 1, Price: 100}
+	e.process(start.Add(time.Hour*4), lookup, submit)
+	if twap.Status != ExecutionComplete || twap.ExecutedAmount != 4 || twap.AveragePrice != 32.5 {
+		t.Errorf("expected the TWAP execution to complete %+v", twap)
+	}
+	if iceberg.Status != ExecutionComplete || iceberg.AveragePrice != 100 {
+		t.Errorf("expected the iceberg execution to complete %+v", iceberg)
+	}
+
+	failed := &Execution{
+		ExecutionRequest: ExecutionRequest{Algorithm: ExecutionIceberg, Amount: 1, VisibleAmount: 1},
+		ID:               "failed",
+		Status:           ExecutionRunning,
+	}
+	e.executions[failed.ID] = failed
+	e.process(start, lookup, func(*Execution, float64) (ExecutionChild, error) {
+		return ExecutionChild{}, errors.New("rejected")
+	})
+	if failed.Status != ExecutionFailed || failed.Error != "rejected" {
+		t.Errorf("expected the execution to fail %+v", failed)
+	}
+}
+
+func TestExecutionChildFailure(t *testing.T) {
+	SetupTestHelpers(t)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	twap := &Execution{
+		ExecutionRequest: ExecutionRequest{
+			Algorithm: ExecutionTWAP,
+			Exchange:  "Bitstamp",
+			Pair:      currency.NewPair(currency.BTC, currency.USD),
+			Side:      order.Buy,
+			Amount:    2,
+		},
+		ID:       "twap",
+		Status:   ExecutionRunning,
+		Schedule: twapSchedule(start, start.Add(time.Hour*2), 2, 2),
+	}
+	e := executionManager{
+		executions: map[string]*Execution{twap.ID: twap},
+	}
+
+	exchangeOrders := make(map[string]order.Detail)
+	lookup := func(exchName, id string) (order.Detail, bool) {
+		d, ok := exchangeOrders[id]
+		return d, ok
+	}
+	reject := true
+	submit := func(ex *Execution, amount float64) (ExecutionChild, error) {
+		if reject {
+			return ExecutionChild{}, errors.New("rejected")
+		}
+		exchangeOrders["child"] = order.Detail{Status: order.Filled, ExecutedAmount: amount, Price: 10}
+		return ExecutionChild{OrderID: "child", Amount: amount, Status: order.New}, nil
+	}
+
+	e.process(start, lookup, submit)
+	if twap.Status != ExecutionRunning {
+		t.Fatalf("expected a rejected child not to fail the execution %+v", twap)
+	}
+	if len(twap.Children) != 1 || twap.Children[0].Error != "rejected" ||
+		twap.Children[0].Status != order.Rejected || twap.Children[0].Amount != 1 {
+		t.Fatalf("expected the rejected child to be recorded %+v", twap.Children)
+	}
+
+	reject = false
+	e.process(start.Add(time.Hour), lookup, submit)
+	e.process(start.Add(time.Hour), lookup, submit)
+	if twap.Status != ExecutionComplete || twap.ExecutedAmount != 1 || twap.RemainingAmount != 1 {
+		t.Errorf("expected the execution to complete with the remaining slice %+v", twap)
+	}
+	if twap.Error != "1 of 2 child orders failed to submit" {
+		t.Errorf("unexpected execution error %q", twap.Error)
+	}
+
+	e.process(start.Add(time.Hour+ExecutionRetention), lookup, submit)
+	if _, err := e.GetExecution(twap.ID); err != nil {
+		t.Errorf("expected the execution to be kept for the retention period, received %v", err)
+	}
+	e.process(start.Add(time.Hour*2+ExecutionRetention), lookup, submit)
+	if _, err := e.GetExecution(twap.ID); err != errExecutionNotFound {
+		t.Errorf("expected %v, received %v", errExecutionNotFound, err)
+	}
+}
+
+func TestExecutionChildSubmit(t *testing.T) {
+	t.Parallel()
+	ex := &Execution{
+		ExecutionRequest: ExecutionRequest{
+			Algorithm:  ExecutionTWAP,
+			Exchange:   "Binance",
+			Pair:       currency.NewPair(currency.BTC, currency.USDT),
+			Asset:      asset.Futures,
+			Side:       order.Sell,
+			Amount:     4,
+			LimitPrice: 100,
+		},
+	}
+	s := executionChildSubmit(ex, 1)
+	if s.AssetType != asset.Futures || s.OrderType != order.Limit ||
+		s.Price != 100 || s.Amount != 1 || s.OrderSide != order.Sell {
+		t.Errorf("expected a futures limit child order, received %+v", s)
+	}
+	ex.LimitPrice = 0
+	if s = executionChildSubmit(ex, 1); s.AssetType != asset.Futures || s.OrderType != order.Market {
+		t.Errorf("expected a futures market child order, received %+v", s)
+	}
+}
+
+func TestValidateExecutionRequest(t *testing.T) {
+	SetupTest(t)
+	req := &ExecutionRequest{
+		Algorithm:     ExecutionIceberg,
+		Exchange:      "bitstamp",
+		Pair:          currency.NewPair(currency.BTC, currency.USD),
+		Side:          order.Buy,
+		Amount:        2,
+		LimitPrice:    100,
+		VisibleAmount: 1,
+	}
+	if err := validateExecutionRequest(req); err != nil {
+		t.Fatal(err)
+	}
+	if req.Asset != asset.Spot {
+		t.Errorf("expected the asset type to default to spot, received %v", req.Asset)
+	}
+	req.Asset = asset.Futures
+	if err := validateExecutionRequest(req); err == nil {
+		t.Error("expected error for an asset type the exchange does not support")
+	}
+}
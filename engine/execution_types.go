@@ -0,0 +1,109 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Execution algorithms
+const (
+	// ExecutionTWAP splits the order into equal slices spread evenly over the
+	// execution window
+	ExecutionTWAP = "twap"
+	// ExecutionVWAP splits the order into a slice per interval sized by the
+	// volume traded at the same time of day in historical candles
+	ExecutionVWAP = "vwap"
+	// ExecutionIceberg rests a limit order with a small visible amount which
+	// is replaced each time it fills until the whole order has executed
+	ExecutionIceberg = "iceberg"
+)
+
+// Execution statuses
+const (
+	ExecutionRunning   = "running"
+	ExecutionPaused    = "paused"
+	ExecutionComplete  = "complete"
+	ExecutionCancelled = "cancelled"
+	ExecutionFailed    = "failed"
+)
+
+// ExecutionRequest holds the parameters of a parent order to be worked by an
+// execution algorithm
+type ExecutionRequest struct {
+	Algorithm string
+	Exchange  string
+	Pair      currency.Pair
+	Asset     asset.Item
+	Side      order.Side
+	Amount    float64
+	// LimitPrice submits child orders as limit orders at this price, market
+	// orders are submitted when it is not set. It is required for iceberg
+	// orders
+	LimitPrice float64
+	// Start and End are the execution window of TWAP and VWAP orders, Start
+	// defaults to now
+	Start time.Time
+	End   time.Time
+	// Slices is the number of TWAP child orders
+	Slices int
+	// Interval is the VWAP slice and volume profile candle interval
+	Interval kline.Interval
+	// ProfileDays is the number of days of candles the VWAP volume profile
+	// is built from
+	ProfileDays int
+	// VisibleAmount is the amount of each iceberg child order
+	VisibleAmount float64
+}
+
+// ExecutionSlice is a scheduled child order of a TWAP or VWAP execution
+type ExecutionSlice struct {
+	Time   time.Time
+	Amount float64
+	Sent   bool
+}
+
+// ExecutionChild is a child order submitted by an execution
+type ExecutionChild struct {
+	OrderID        string
+	Amount         float64
+	ExecutedAmount float64
+	AveragePrice   float64
+	// ReferencePrice is the last traded price when the child was submitted
+	// and is used to value fills which do not report a price
+	ReferencePrice float64
+	Status         order.Status
+	SubmittedAt    time.Time
+	// Error is set when the child order could not be submitted
+	Error string
+}
+
+// Execution holds the state and fill progress of a parent order being worked
+// by an execution algorithm
+type Execution struct {
+	ExecutionRequest
+	ID              string
+	Status          string
+	Schedule        []ExecutionSlice
+	Children        []ExecutionChild
+	ExecutedAmount  float64
+	AveragePrice    float64
+	RemainingAmount float64
+	Error           string
+	PausedAt        time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type executionManager struct {
+	started    int32
+	stopped    int32
+	shutdown   chan struct{}
+	wg         sync.WaitGroup
+	m          sync.Mutex
+	executions map[string]*Execution
+}
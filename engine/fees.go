@@ -0,0 +1,111 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+// FeeRefreshDelay is how long trade fee rates and crypto withdrawal fees are
+// cached for
+var FeeRefreshDelay = time.Hour
+
+// exchangeFees caches the fees used to value arbitrage and order routing
+var exchangeFees feeCache
+
+// feeCache caches exchange fees as they rarely change and can require a
+// request to the exchange, trade fees are stored as a rate and withdrawal fees
+// as an amount
+type feeCache struct {
+	m    sync.Mutex
+	fees map[string]cachedFee
+}
+
+type cachedFee struct {
+	value   float64
+	updated time.Time
+}
+
+// tradeFeeRate returns the taker fee of the exchange as a rate of the traded
+// value
+func (f *feeCache) tradeFeeRate(exchName string, p currency.Pair, price float64) (float64, error) {
+	key := strings.ToLower(exchName + p.String())
+	f.m.Lock()
+	fee, ok := f.fees[key]
+	f.m.Unlock()
+	if ok && time.Since(fee.updated) < FeeRefreshDelay {
+		return fee.value, nil
+	}
+
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return 0, ErrExchangeNotFound
+	}
+	if price <= 0 {
+		return 0, fmt.Errorf("invalid %s %s price %v", exchName, p, price)
+	}
+	value, err := exch.GetFeeByType(&exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          p,
+		PurchasePrice: price,
+		Amount:        1,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	rate := value / price
+	f.m.Lock()
+	if f.fees == nil {
+		f.fees = make(map[string]cachedFee)
+	}
+	f.fees[key] = cachedFee{value: rate, updated: time.Now()}
+	f.m.Unlock()
+	return rate, nil
+}
+
+// withdrawalFee returns the fee of withdrawing the amount of the currency from
+// the exchange in the currency, bank withdrawal fees are used for fiat
+// currencies
+func (f *feeCache) withdrawalFee(exchName string, c currency.Code, amount float64) (float64, error) {
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return 0, ErrExchangeNotFound
+	}
+	if c.IsFiatCurrency() {
+		// bank fees are commonly a percentage of the amount so are not cached
+		return exch.GetFeeByType(&exchange.FeeBuilder{
+			FeeType:      exchange.InternationalBankWithdrawalFee,
+			FiatCurrency: c,
+			Amount:       amount,
+		})
+	}
+
+	key := strings.ToLower(exchName + "-withdraw-" + c.String())
+	f.m.Lock()
+	fee, ok := f.fees[key]
+	f.m.Unlock()
+	if ok && time.Since(fee.updated) < FeeRefreshDelay {
+		return fee.value, nil
+	}
+
+	value, err := exch.GetFeeByType(&exchange.FeeBuilder{
+		FeeType: exchange.CryptocurrencyWithdrawalFee,
+		Pair:    currency.Pair{Base: c},
+		Amount:  amount,
+	})
+	if err != nil {
+		return 0, err
+	}
+	f.m.Lock()
+	if f.fees == nil {
+		f.fees = make(map[string]cachedFee)
+	}
+	f.fees[key] = cachedFee{value: value, updated: time.Now()}
+	f.m.Unlock()
+	return value, nil
+}
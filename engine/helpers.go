@@ -13,6 +13,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,6 +25,8 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -47,6 +50,11 @@ func GetSubsystemsStatus() map[string]bool {
 	systems["grpc"] = Bot.Settings.EnableGRPC
 	systems["grpc_proxy"] = Bot.Settings.EnableGRPCProxy
 	systems["gctscript"] = Bot.GctScriptManager.Started()
+	systems["candles"] = Bot.CandleManager.Started()
+	systems["trades"] = Bot.TradeRecorder.Started()
+	systems["risk"] = Bot.RiskManager.Started()
+	systems["conditional_orders"] = Bot.ConditionalOrderManager.Started()
+	systems["execution"] = Bot.ExecutionManager.Started()
 	systems["deprecated_rpc"] = Bot.Settings.EnableDeprecatedRPC
 	systems["websocket_rpc"] = Bot.Settings.EnableWebsocketRPC
 	systems["dispatch"] = dispatch.IsRunning()
@@ -131,6 +139,31 @@ func SetSubsystem(subsys string, enable bool) error {
 		}
 		vm.GCTScriptConfig.Enabled = false
 		return Bot.GctScriptManager.Stop()
+	case "candles":
+		if enable {
+			return Bot.CandleManager.Start()
+		}
+		return Bot.CandleManager.Stop()
+	case "trades":
+		if enable {
+			return Bot.TradeRecorder.Start()
+		}
+		return Bot.TradeRecorder.Stop()
+	case "risk":
+		if enable {
+			return Bot.RiskManager.Start()
+		}
+		return Bot.RiskManager.Stop()
+	case "conditional_orders":
+		if enable {
+			return Bot.ConditionalOrderManager.Start()
+		}
+		return Bot.ConditionalOrderManager.Stop()
+	case "execution":
+		if enable {
+			return Bot.ExecutionManager.Start()
+		}
+		return Bot.ExecutionManager.Stop()
 	}
 
 	return errors.New("subsystem not found")
@@ -706,6 +739,69 @@ func FormatCurrency(p currency.Pair) currency.Pair {
 		Bot.Config.Currency.CurrencyPairFormat.Uppercase)
 }
 
+// GetHistoricCandles returns candles between a time period for a set time
+// interval. If the exchange does not support a native kline endpoint, candles
+// are built from its recent trade history instead and a *kline.RangeError is
+// returned when the trade history does not reach back to the start time
+func GetHistoricCandles(exch exchange.IBotExchange, p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	err := kline.ValidateRequest(start, end, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	candles, err := exch.GetHistoricCandles(p, a, start, end, interval)
+	if err != common.ErrNotYetImplemented && err != common.ErrFunctionNotSupported {
+		return candles, err
+	}
+
+	trades, err := exch.GetExchangeHistory(p, a)
+	if err != nil {
+		return kline.Item{}, err
+	}
+	if len(trades) == 0 {
+		return kline.Item{}, &kline.RangeError{
+			Exchange: exch.GetName(),
+			Start:    start,
+			End:      end,
+			Reason:   "no recent trades returned to build candles from",
+		}
+	}
+	earliest := trades[0].Timestamp
+	for x := range trades {
+		if trades[x].Timestamp.Before(earliest) {
+			earliest = trades[x].Timestamp
+		}
+	}
+	if start.Before(earliest) {
+		return kline.Item{}, &kline.RangeError{
+			Exchange: exch.GetName(),
+			Start:    start,
+			End:      end,
+			Reason: fmt.Sprintf("recent trade history starts at %s",
+				earliest.UTC().Format(time.RFC3339)),
+		}
+	}
+	history := make([]order.TradeHistory, len(trades))
+	for x := range trades {
+		history[x] = order.TradeHistory{
+			Timestamp: trades[x].Timestamp,
+			TID:       strconv.FormatInt(trades[x].TID, 10),
+			Price:     trades[x].Price,
+			Amount:    trades[x].Amount,
+			Exchange:  exch.GetName(),
+			Side:      order.Side(strings.ToUpper(trades[x].Type)),
+			Fee:       trades[x].Fee,
+		}
+	}
+
+	candles, err = kline.CreateKline(history, interval, p, a, exch.GetName())
+	if err != nil {
+		return kline.Item{}, err
+	}
+	candles.FilterByTimeRange(start, end)
+	return candles, nil
+}
+
 // GetExchanges returns a list of loaded exchanges
 func GetExchanges(enabled bool) []string {
 	var exchanges []string
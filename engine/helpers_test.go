@@ -7,8 +7,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -562,3 +564,50 @@ func TestGetCryptocurrenciesByExchange(t *testing.T) {
 		t.Fatalf("Err %s", err)
 	}
 }
+
+// tradeHistoryExchange has no kline endpoint and returns its recent trades
+type tradeHistoryExchange struct {
+	exchange.IBotExchange
+	trades []exchange.TradeHistory
+}
+
+func (e *tradeHistoryExchange) GetName() string {
+	return "tradehistory"
+}
+
+func (e *tradeHistoryExchange) GetHistoricCandles(currency.Pair, asset.Item, time.Time, time.Time, kline.Interval) (kline.Item, error) {
+	return kline.Item{}, common.ErrFunctionNotSupported
+}
+
+func (e *tradeHistoryExchange) GetExchangeHistory(currency.Pair, asset.Item) ([]exchange.TradeHistory, error) {
+	return e.trades, nil
+}
+
+func TestGetHistoricCandlesFromTrades(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	start := time.Now().UTC().Truncate(time.Hour).Add(-time.Hour * 2)
+	e := &tradeHistoryExchange{}
+
+	_, err := GetHistoricCandles(e, p, asset.Spot, start, start.Add(time.Hour), kline.OneHour)
+	if _, ok := err.(*kline.RangeError); !ok {
+		t.Errorf("expected a range error without trades, received %v", err)
+	}
+
+	e.trades = []exchange.TradeHistory{
+		{Timestamp: start.Add(time.Minute * 90), Price: 2, Amount: 1, Type: "buy"},
+		{Timestamp: start.Add(time.Minute), Price: 1, Amount: 1, Type: "sell"},
+	}
+	_, err = GetHistoricCandles(e, p, asset.Spot, start, start.Add(time.Hour*2), kline.OneHour)
+	if _, ok := err.(*kline.RangeError); !ok {
+		t.Errorf("expected a range error for a start before the trade history, received %v", err)
+	}
+
+	k, err := GetHistoricCandles(e, p, asset.Spot, start.Add(time.Hour), start.Add(time.Hour*2), kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(k.Candles) != 1 || k.Candles[0].Close != 2 {
+		t.Errorf("expected a single candle from the trade history, received %+v", k.Candles)
+	}
+}
@@ -3,13 +3,22 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	orderRepo "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
 
@@ -17,8 +26,29 @@ import (
 var (
 	OrderManagerDelay      = time.Second * 10
 	ErrOrdersAlreadyExists = errors.New("order already exists")
+
+	// closedOrderStatuses are the statuses an order will not transition out
+	// of. Orders which are no longer active and cannot be looked up on the
+	// exchange are marked unknown and are no longer tracked
+	closedOrderStatuses = []order.Status{
+		order.Filled,
+		order.Cancelled,
+		order.PartiallyCancelled,
+		order.Rejected,
+		order.Expired,
+		order.UnknownStatus,
+	}
 )
 
+func isOrderClosed(s order.Status) bool {
+	for x := range closedOrderStatuses {
+		if closedOrderStatuses[x] == s {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *orderStore) Get() map[string][]order.Detail {
 	o.m.Lock()
 	defer o.m.Unlock()
@@ -26,18 +56,90 @@ func (o *orderStore) Get() map[string][]order.Detail {
 }
 
 func (o *orderStore) exists(order *order.Detail) bool {
-	r, ok := o.Orders[order.Exchange]
+	return o.get(order.Exchange, order.ID) != nil
+}
+
+func (o *orderStore) get(exchName, id string) *order.Detail {
+	r, ok := o.Orders[exchName]
 	if !ok {
-		return false
+		return nil
 	}
 
 	for x := range r {
-		if r[x].ID == order.ID {
-			return true
+		if r[x].ID == id {
+			return &r[x]
 		}
 	}
 
-	return false
+	return nil
+}
+
+// GetOrder returns a copy of a stored order
+func (o *orderStore) GetOrder(exchName, id string) (order.Detail, bool) {
+	o.m.Lock()
+	defer o.m.Unlock()
+	d := o.get(exchName, id)
+	if d == nil {
+		return order.Detail{}, false
+	}
+	return *d, true
+}
+
+// GetOpenOrders returns a copy of all stored orders for an exchange which
+// have not reached a closed status
+func (o *orderStore) GetOpenOrders(exchName string) []order.Detail {
+	o.m.Lock()
+	defer o.m.Unlock()
+	var open []order.Detail
+	for x := range o.Orders[exchName] {
+		if !isOrderClosed(o.Orders[exchName][x].Status) {
+			open = append(open, o.Orders[exchName][x])
+		}
+	}
+	return open
+}
+
+// GetExchanges returns the names of all exchanges with tracked orders
+func (o *orderStore) GetExchanges() []string {
+	o.m.Lock()
+	defer o.m.Unlock()
+	var exchanges []string
+	for k := range o.Orders {
+		exchanges = append(exchanges, k)
+	}
+	return exchanges
+}
+
+// Update replaces a stored order with the supplied order and returns the
+// previously stored order
+func (o *orderStore) Update(d *order.Detail) (order.Detail, error) {
+	previous, _, err := o.Modify(d.Exchange, d.ID, func(stored *order.Detail) bool {
+		*stored = *d
+		return true
+	})
+	return previous, err
+}
+
+// Modify applies fn to a copy of the stored order under the store lock and
+// stores the copy when fn reports a change, so updates are applied to the
+// latest state of the order rather than to a snapshot which may be stale. The
+// previously stored order is returned with the updated order, which is nil
+// when fn reports no change
+func (o *orderStore) Modify(exchName, id string, fn func(d *order.Detail) bool) (order.Detail, *order.Detail, error) {
+	o.m.Lock()
+	defer o.m.Unlock()
+	stored := o.get(exchName, id)
+	if stored == nil {
+		return order.Detail{}, nil, errors.New("order not found")
+	}
+	previous := *stored
+	updated := previous
+	updated.Trades = append([]order.TradeHistory(nil), previous.Trades...)
+	if !fn(&updated) {
+		return previous, nil, nil
+	}
+	*stored = updated
+	return previous, &updated, nil
 }
 
 func (o *orderStore) Add(order *order.Detail) error {
@@ -67,10 +169,53 @@ func (o *orderManager) Start() error {
 
 	o.shutdown = make(chan struct{})
 	o.orderStore.Orders = make(map[string][]order.Detail)
+	o.loadStoredOrders()
 	go o.run()
 	return nil
 }
 
+// loadStoredOrders adds the orders which were still open when the bot last
+// shut down to the order store so they can be reconciled with the exchange
+func (o *orderManager) loadStoredOrders() {
+	if !database.DB.Connected {
+		return
+	}
+
+	statuses := make([]string, len(closedOrderStatuses))
+	for x := range closedOrderStatuses {
+		statuses[x] = string(closedOrderStatuses[x])
+	}
+	stored, err := orderRepo.GetByStatusExcluding(statuses...)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to load stored orders. Err: %s\n", err)
+		return
+	}
+
+	for x := range stored {
+		d := orderFromRepository(&stored[x])
+		if exch := GetExchangeByName(d.Exchange); exch != nil {
+			// stored exchange names are lower case
+			d.Exchange = exch.GetName()
+		}
+		if err = o.orderStore.Add(&d); err != nil {
+			log.Warnf(log.OrderMgr, "Order manager: Unable to load stored order ID=%v. Err: %s\n",
+				d.ID, err)
+		}
+	}
+	log.Debugf(log.OrderMgr, "Order manager: Loaded %d stored open orders.\n", len(stored))
+}
+
+// persist stores the order in the database if it is connected
+func (o *orderManager) persist(d *order.Detail) {
+	if !database.DB.Connected {
+		return
+	}
+	if err := orderRepo.Upsert(orderToRepository(d)); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to store order ID=%v. Err: %s\n",
+			d.ID, err)
+	}
+}
+
 func (o *orderManager) Stop() error {
 	if atomic.LoadInt32(&o.started) == 0 {
 		return errors.New("order manager not started")
@@ -92,39 +237,7 @@ func (o *orderManager) Stop() error {
 func (o *orderManager) gracefulShutdown() {
 	if o.cfg.CancelOrdersOnShutdown {
 		log.Debugln(log.OrderMgr, "Order manager: Cancelling any open orders...")
-		orders := o.orderStore.Get()
-		if orders == nil {
-			return
-		}
-
-		for k, v := range orders {
-			log.Debugf(log.OrderMgr, "Order manager: Cancelling order(s) for exchange %s.\n", k)
-			for y := range v {
-				log.Debugf(log.OrderMgr, "order manager: Cancelling order ID %v [%v]",
-					v[y].ID, v[y])
-				err := o.Cancel(k, &order.Cancel{
-					OrderID: v[y].ID,
-				})
-				if err != nil {
-					msg := fmt.Sprintf("Order manager: Exchange %s unable to cancel order ID=%v. Err: %s",
-						k, v[y].ID, err)
-					log.Debugln(log.OrderBook, msg)
-					Bot.CommsManager.PushEvent(base.Event{
-						Type:    "order",
-						Message: msg,
-					})
-					continue
-				}
-
-				msg := fmt.Sprintf("Order manager: Exchange %s order ID=%v cancelled.",
-					k, v[y].ID)
-				log.Debugln(log.OrderBook, msg)
-				Bot.CommsManager.PushEvent(base.Event{
-					Type:    "order",
-					Message: msg,
-				})
-			}
-		}
+		o.CancelAllOrders(nil)
 	}
 }
 
@@ -138,6 +251,9 @@ func (o *orderManager) run() {
 		Bot.ServicesWG.Done()
 	}()
 
+	// reconcile any stored orders with the exchanges straight away so fills
+	// and cancellations which happened while the bot was down are reported
+	o.processOrders()
 	for {
 		select {
 		case <-o.shutdown:
@@ -149,8 +265,55 @@ func (o *orderManager) run() {
 	}
 }
 
-func (o *orderManager) CancelAllOrders() {}
+// CancelAllOrders cancels every tracked open order on the supplied exchanges,
+// or on all exchanges if none are supplied. The returned map holds the
+// cancellation status of each order keyed by exchange name
+func (o *orderManager) CancelAllOrders(exchangeNames []string) map[string]order.CancelAllResponse {
+	result := make(map[string]order.CancelAllResponse)
+	exchanges := o.orderStore.GetExchanges()
+	for x := range exchanges {
+		if len(exchangeNames) > 0 &&
+			!common.StringDataCompareInsensitive(exchangeNames, exchanges[x]) {
+			continue
+		}
+
+		open := o.orderStore.GetOpenOrders(exchanges[x])
+		if len(open) == 0 {
+			continue
+		}
 
+		log.Debugf(log.OrderMgr, "Order manager: Cancelling order(s) for exchange %s.\n", exchanges[x])
+		resp := order.CancelAllResponse{Status: make(map[string]string)}
+		for y := range open {
+			err := o.Cancel(exchanges[x], &order.Cancel{
+				OrderID:      open[y].ID,
+				CurrencyPair: open[y].CurrencyPair,
+				AssetType:    open[y].AssetType,
+				Side:         open[y].OrderSide,
+			})
+			var msg string
+			if err != nil {
+				resp.Status[open[y].ID] = err.Error()
+				msg = fmt.Sprintf("Order manager: Exchange %s unable to cancel order ID=%v. Err: %s",
+					exchanges[x], open[y].ID, err)
+			} else {
+				resp.Status[open[y].ID] = order.Cancelled.String()
+				msg = fmt.Sprintf("Order manager: Exchange %s order ID=%v cancelled.",
+					exchanges[x], open[y].ID)
+			}
+			log.Debugln(log.OrderMgr, msg)
+			Bot.CommsManager.PushEvent(base.Event{
+				Type:    "order",
+				Message: msg,
+			})
+		}
+		result[exchanges[x]] = resp
+	}
+	return result
+}
+
+// Cancel cancels an order on the exchange and marks the tracked order as
+// cancelled
 func (o *orderManager) Cancel(exchName string, cancel *order.Cancel) error {
 	if exchName == "" {
 		return errors.New("order exchange name is empty")
@@ -173,7 +336,204 @@ func (o *orderManager) Cancel(exchName string, cancel *order.Cancel) error {
 		return errors.New("order asset type not supported by exchange")
 	}
 
-	return exch.CancelOrder(cancel)
+	err := exch.CancelOrder(cancel)
+	if err != nil {
+		return err
+	}
+
+	o.setOrderStatus(exch.GetName(), cancel.OrderID, order.Cancelled)
+	return nil
+}
+
+// CancelOrders cancels a batch of orders together, through the exchange's
+// batch cancel endpoint or one at a time when it has none. The tracked orders
+// cancelled are marked as cancelled, the orders which failed to cancel are
+// returned in the response status with their error
+func (o *orderManager) CancelOrders(exchName string, cancels []order.Cancel) (order.CancelAllResponse, error) {
+	var resp order.CancelAllResponse
+	if exchName == "" {
+		return resp, errors.New("order exchange name is empty")
+	}
+
+	if len(cancels) == 0 {
+		return resp, errors.New("no orders to cancel")
+	}
+
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return resp, errors.New("unable to get exchange by name")
+	}
+
+	for x := range cancels {
+		if cancels[x].OrderID == "" {
+			return resp, fmt.Errorf("order %d: order id is empty", x+1)
+		}
+		if cancels[x].AssetType.String() != "" &&
+			!exch.GetAssetTypes().Contains(cancels[x].AssetType) {
+			return resp, fmt.Errorf("order %d: order asset type not supported by exchange", x+1)
+		}
+	}
+
+	resp, err := exchange.CancelBatch(exch, cancels)
+	if err != nil {
+		return resp, err
+	}
+
+	for x := range cancels {
+		if _, ok := resp.Status[cancels[x].OrderID]; ok {
+			continue
+		}
+		o.setOrderStatus(exch.GetName(), cancels[x].OrderID, order.Cancelled)
+	}
+	return resp, nil
+}
+
+// Modify amends an order on the exchange and updates the tracked order.
+// Exchanges which replace the order return a new order ID, the original order
+// is then marked cancelled and the replacement tracked under the same internal
+// order ID
+func (o *orderManager) Modify(exchName string, mod *order.Modify) (*order.ModifyResponse, error) {
+	if exchName == "" {
+		return nil, errors.New("order exchange name is empty")
+	}
+
+	if mod == nil {
+		return nil, errors.New("order modify param is nil")
+	}
+
+	if mod.OrderID == "" {
+		return nil, errors.New("order id is empty")
+	}
+
+	if mod.Price < 0 || mod.Amount < 0 {
+		return nil, errors.New("order price and amount cannot be negative")
+	}
+
+	if o.cfg.EnforceLimitConfig && o.cfg.LimitAmount > 0 && mod.Amount > o.cfg.LimitAmount {
+		return nil, errors.New("order limit exceeds allowed limit")
+	}
+
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, errors.New("unable to get exchange by name")
+	}
+
+	if stored, ok := o.orderStore.GetOrder(exch.GetName(), mod.OrderID); ok {
+		check := order.Submit{
+			Pair:      stored.CurrencyPair,
+			AssetType: stored.AssetType,
+			OrderType: stored.OrderType,
+			OrderSide: stored.OrderSide,
+			Price:     stored.Price,
+			Amount:    stored.RemainingAmount,
+		}
+		if mod.Price > 0 {
+			check.Price = mod.Price
+		}
+		if mod.Amount > 0 {
+			check.Amount = mod.Amount - stored.ExecutedAmount
+		}
+		if err := Bot.RiskManager.CheckModify(exch.GetName(), &check); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := exch.ModifyOrder(mod)
+	if err != nil {
+		return nil, err
+	}
+	if id == "" {
+		id = mod.OrderID
+	}
+
+	msg := fmt.Sprintf("Order manager: Exchange %s modified order ID=%v new ID=%v price=%v amount=%v.",
+		exch.GetName(), mod.OrderID, id, mod.Price, mod.Amount)
+	log.Debugln(log.OrderMgr, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+
+	stored, ok := o.orderStore.GetOrder(exch.GetName(), mod.OrderID)
+	if !ok {
+		return &order.ModifyResponse{OrderID: id}, nil
+	}
+
+	amend := func(d *order.Detail) {
+		if mod.Price > 0 {
+			d.Price = mod.Price
+		}
+		if mod.Amount > 0 {
+			d.Amount = mod.Amount
+			d.RemainingAmount = mod.Amount - d.ExecutedAmount
+		}
+	}
+	if id == mod.OrderID {
+		o.modifyOrder(exch.GetName(), mod.OrderID, func(d *order.Detail) (float64, []order.TradeHistory, bool) {
+			amend(d)
+			return 0, nil, true
+		})
+		return &order.ModifyResponse{OrderID: id}, nil
+	}
+
+	updated := stored
+	amend(&updated)
+
+	o.setOrderStatus(exch.GetName(), mod.OrderID, order.Cancelled)
+	updated.ID = id
+	updated.Status = order.New
+	updated.OrderDate = time.Now()
+	updated.ExecutedAmount = 0
+	updated.RemainingAmount = updated.Amount
+	updated.Fee = 0
+	updated.Trades = nil
+	if err = o.orderStore.Add(&updated); err != nil {
+		log.Warnf(log.OrderMgr, "Order manager: Unable to track order ID=%v. Err: %s\n",
+			id, err)
+	}
+	o.persist(&updated)
+	o.publish(&OrderUpdate{Detail: updated})
+	return &order.ModifyResponse{OrderID: id}, nil
+}
+
+// rulesNotLoaded holds the exchange asset types already warned of having no
+// trading rules loaded
+var rulesNotLoaded sync.Map
+
+// checkTradingRules rejects orders which do not conform to the exchange's
+// trading rules for the pair and asset type, when round is set the order's
+// price and amount are rounded to the rules first. Orders are left for the
+// exchange to check when it has not loaded rules for the asset type, which is
+// logged once, orders for pairs missing from loaded rules are rejected as the
+// pair is not tradable
+func checkTradingRules(exch exchange.IBotExchange, s *order.Submit, round bool) error {
+	a := s.AssetType
+	if a == "" {
+		a = asset.Spot
+	}
+	r, err := exch.GetTradingRules(s.Pair, a)
+	if err == rules.ErrRulesNotLoaded {
+		if _, warned := rulesNotLoaded.LoadOrStore(exch.GetName()+" "+a.String(), true); !warned {
+			log.Warnf(log.OrderMgr,
+				"Order manager: %s has no trading rules loaded for %s, orders are left for the exchange to check\n",
+				exch.GetName(),
+				a)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s %s order %v", exch.GetName(), s.Pair, err)
+	}
+
+	if round {
+		s.Price = r.RoundPrice(s.Price, s.OrderSide == order.Buy || s.OrderSide == order.Bid)
+		s.Amount = r.RoundAmount(s.Amount)
+	}
+
+	if err = r.Conforms(s.Price, s.Amount); err != nil {
+		return fmt.Errorf("%s %s order %v", exch.GetName(), s.Pair, err)
+	}
+	return nil
 }
 
 func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSubmitResponse, error) {
@@ -181,34 +541,133 @@ func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSu
 		return nil, errors.New("order exchange name must be specified")
 	}
 
-	if err := newOrder.Validate(); err != nil {
+	if err := o.validate(exchName, newOrder); err != nil {
+		return nil, err
+	}
+
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, errors.New("unable to get exchange by name")
+	}
+
+	if err := o.check(exch, newOrder); err != nil {
+		return nil, err
+	}
+
+	result, err := exch.SubmitOrder(newOrder)
+	if err != nil {
 		return nil, err
 	}
 
+	if !result.IsOrderPlaced {
+		return nil, errors.New("order unable to be placed")
+	}
+
+	return o.track(exch, newOrder, &result), nil
+}
+
+// SubmitOrders submits a batch of orders together, through the exchange's
+// batch order endpoint or one at a time when it has none. Every order is
+// checked before any are submitted. The orders placed are tracked and their
+// responses returned in the order they were submitted, with an error if any
+// order was not placed
+func (o *orderManager) SubmitOrders(exchName string, orders []order.Submit) ([]*orderSubmitResponse, error) {
+	if exchName == "" {
+		return nil, errors.New("order exchange name must be specified")
+	}
+
+	if len(orders) == 0 {
+		return nil, errors.New("no orders to submit")
+	}
+
+	for x := range orders {
+		if err := o.validate(exchName, &orders[x]); err != nil {
+			return nil, fmt.Errorf("order %d: %v", x+1, err)
+		}
+	}
+
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, errors.New("unable to get exchange by name")
+	}
+
+	for x := range orders {
+		if err := checkExchangeOrder(exch, &orders[x]); err != nil {
+			return nil, fmt.Errorf("order %d: %v", x+1, err)
+		}
+	}
+	if err := Bot.RiskManager.CheckOrders(exch.GetName(), orders); err != nil {
+		return nil, err
+	}
+
+	results, err := exchange.SubmitBatch(exch, orders)
+	resp := make([]*orderSubmitResponse, len(results))
+	var unplaced int
+	for x := range results {
+		if !results[x].IsOrderPlaced {
+			resp[x] = &orderSubmitResponse{SubmitResponse: results[x]}
+			unplaced++
+			continue
+		}
+		resp[x] = o.track(exch, &orders[x], &results[x])
+	}
+	if err == nil && unplaced > 0 {
+		err = fmt.Errorf("%d of %d orders unable to be placed", unplaced, len(orders))
+	}
+	return resp, err
+}
+
+// validate checks the order's fields and the order manager's limits
+func (o *orderManager) validate(exchName string, newOrder *order.Submit) error {
+	if err := newOrder.Validate(); err != nil {
+		return err
+	}
+
+	// orders without an asset type are spot orders
+	if newOrder.AssetType == "" {
+		newOrder.AssetType = asset.Spot
+	}
+
 	if o.cfg.EnforceLimitConfig {
 		if !o.cfg.AllowMarketOrders && newOrder.OrderType == order.Market {
-			return nil, errors.New("order market type is not allowed")
+			return errors.New("order market type is not allowed")
 		}
 
 		if o.cfg.LimitAmount > 0 && newOrder.Amount > o.cfg.LimitAmount {
-			return nil, errors.New("order limit exceeds allowed limit")
+			return errors.New("order limit exceeds allowed limit")
 		}
 
 		if len(o.cfg.AllowedExchanges) > 0 &&
 			!common.StringDataCompareInsensitive(o.cfg.AllowedExchanges, exchName) {
-			return nil, errors.New("order exchange not found in allowed list")
+			return errors.New("order exchange not found in allowed list")
 		}
 
 		if len(o.cfg.AllowedPairs) > 0 && !o.cfg.AllowedPairs.Contains(newOrder.Pair, true) {
-			return nil, errors.New("order pair not found in allowed list")
+			return errors.New("order pair not found in allowed list")
 		}
 	}
+	return nil
+}
 
-	exch := GetExchangeByName(exchName)
-	if exch == nil {
-		return nil, errors.New("unable to get exchange by name")
+// check checks the order against the exchange and the risk manager
+func (o *orderManager) check(exch exchange.IBotExchange, newOrder *order.Submit) error {
+	if err := checkExchangeOrder(exch, newOrder); err != nil {
+		return err
 	}
+	return Bot.RiskManager.CheckOrder(exch.GetName(), newOrder)
+}
 
+// checkExchangeOrder checks the exchange supports the order's asset type and
+// the order conforms to the exchange's trading rules
+func checkExchangeOrder(exch exchange.IBotExchange, s *order.Submit) error {
+	if !exch.GetAssetTypes().Contains(s.AssetType) {
+		return fmt.Errorf("order asset type %s not supported by exchange", s.AssetType)
+	}
+	return checkTradingRules(exch, s, Bot.Settings.RoundOrdersToTradingRules)
+}
+
+// track announces a placed order and adds it to the order store
+func (o *orderManager) track(exch exchange.IBotExchange, newOrder *order.Submit, result *order.SubmitResponse) *orderSubmitResponse {
 	id, err := uuid.NewV4()
 	if err != nil {
 		log.Warnf(log.OrderMgr,
@@ -216,17 +675,8 @@ func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSu
 			err)
 	}
 
-	result, err := exch.SubmitOrder(newOrder)
-	if err != nil {
-		return nil, err
-	}
-
-	if !result.IsOrderPlaced {
-		return nil, errors.New("order unable to be placed")
-	}
-
 	msg := fmt.Sprintf("Order manager: Exchange %s submitted order ID=%v [Ours: %v] pair=%v price=%v amount=%v side=%v type=%v.",
-		exchName,
+		exch.GetName(),
 		result.OrderID,
 		id.String(),
 		newOrder.Pair,
@@ -241,12 +691,43 @@ func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSu
 		Message: msg,
 	})
 
+	status := order.New
+	if result.FullyMatched {
+		status = order.Filled
+	}
+	d := order.Detail{
+		Exchange:        exch.GetName(),
+		ID:              result.OrderID,
+		InternalOrderID: id.String(),
+		CurrencyPair:    newOrder.Pair,
+		AssetType:       newOrder.AssetType,
+		OrderSide:       newOrder.OrderSide,
+		OrderType:       newOrder.OrderType,
+		OrderDate:       time.Now(),
+		Status:          status,
+		Price:           newOrder.Price,
+		Amount:          newOrder.Amount,
+		RemainingAmount: newOrder.Amount,
+	}
+	if result.FullyMatched {
+		d.ExecutedAmount = newOrder.Amount
+		d.RemainingAmount = 0
+	}
+	if err = o.orderStore.Add(&d); err != nil {
+		log.Warnf(log.OrderMgr, "Order manager: Unable to track order ID=%v. Err: %s\n",
+			result.OrderID, err)
+	}
+	o.persist(&d)
+	o.publish(&OrderUpdate{Detail: d, ExecutedDelta: d.ExecutedAmount})
+
 	return &orderSubmitResponse{
 		SubmitResponse: order.SubmitResponse{
-			OrderID: result.OrderID,
+			OrderID:       result.OrderID,
+			IsOrderPlaced: true,
+			FullyMatched:  result.FullyMatched,
 		},
 		OurOrderID: id.String(),
-	}, nil
+	}
 }
 
 func (o *orderManager) processOrders() {
@@ -266,8 +747,18 @@ func (o *orderManager) processOrders() {
 
 		for x := range result {
 			ord := &result[x]
+			if ord.Exchange == "" {
+				ord.Exchange = exch.GetName()
+			}
+			stored, ok := o.orderStore.GetOrder(ord.Exchange, ord.ID)
+			if ok {
+				o.updateOrder(&stored, ord)
+				continue
+			}
 			result := o.orderStore.Add(ord)
 			if result != ErrOrdersAlreadyExists {
+				o.persist(ord)
+				o.publish(&OrderUpdate{Detail: *ord})
 				msg := fmt.Sprintf("Order manager: Exchange %s added order ID=%v pair=%v price=%v amount=%v side=%v type=%v.",
 					ord.Exchange, ord.ID, ord.CurrencyPair, ord.Price, ord.Amount, ord.OrderSide, ord.OrderType)
 				log.Debugf(log.OrderMgr, "%v\n", msg)
@@ -278,5 +769,236 @@ func (o *orderManager) processOrders() {
 				continue
 			}
 		}
+
+		o.reconcile(exch)
+	}
+}
+
+// reconcile checks the status of tracked open orders which were not returned
+// by an unfiltered active order request. Orders are requested again for their
+// currency pairs, as some exchanges only return orders when the pair is
+// specified, and any order still missing is looked up individually to find
+// out whether it was filled or cancelled
+func (o *orderManager) reconcile(exch exchange.IBotExchange) {
+	tracked := o.orderStore.GetOpenOrders(exch.GetName())
+	if len(tracked) == 0 {
+		return
+	}
+
+	var pairs currency.Pairs
+	for x := range tracked {
+		if !tracked[x].CurrencyPair.IsEmpty() && !pairs.Contains(tracked[x].CurrencyPair, true) {
+			pairs = append(pairs, tracked[x].CurrencyPair)
+		}
+	}
+	active, err := exch.GetActiveOrders(&order.GetOrdersRequest{
+		OrderSide:  order.AnySide,
+		OrderType:  order.AnyType,
+		Currencies: pairs,
+	})
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Order manager: Unable to reconcile %s orders: %s\n",
+			exch.GetName(), err)
+		return
+	}
+
+	for x := range tracked {
+		var found *order.Detail
+		for y := range active {
+			if active[y].ID == tracked[x].ID {
+				found = &active[y]
+				break
+			}
+		}
+		if found != nil {
+			o.updateOrder(&tracked[x], found)
+			continue
+		}
+
+		info, err := exch.GetOrderInfo(tracked[x].ID)
+		if err != nil {
+			log.Warnf(log.OrderMgr,
+				"Order manager: Exchange %s order ID=%v is no longer active and its status cannot be retrieved. Err: %s\n",
+				exch.GetName(), tracked[x].ID, err)
+			info = tracked[x]
+			info.Status = order.UnknownStatus
+		}
+		o.updateOrder(&tracked[x], &info)
+	}
+}
+
+// updateOrder merges the latest exchange order state into the stored order,
+// persisting and publishing any status change, fill or amendment. The state is
+// merged into the order as currently stored rather than the snapshot taken
+// before the exchange request, so an order which reached a final status in
+// the meantime is not reopened
+func (o *orderManager) updateOrder(stored, latest *order.Detail) {
+	o.modifyOrder(stored.Exchange, stored.ID, func(d *order.Detail) (float64, []order.TradeHistory, bool) {
+		previous := *d
+		executed, trades := d.UpdateFromDetail(latest)
+		return executed, trades, d.Status != previous.Status ||
+			d.Price != previous.Price ||
+			d.Amount != previous.Amount ||
+			executed != 0 ||
+			len(trades) != 0
+	})
+}
+
+// setOrderStatus sets the status of a tracked order after a successful
+// exchange request, an order with a final status keeps it
+func (o *orderManager) setOrderStatus(exchName, id string, status order.Status) {
+	o.modifyOrder(exchName, id, func(d *order.Detail) (float64, []order.TradeHistory, bool) {
+		if d.Status == status || d.Status.IsFinal() {
+			return 0, nil, false
+		}
+		d.Status = status
+		return 0, nil, true
+	})
+}
+
+// modifyOrder applies fn to the stored order, fn returns the amount executed
+// and trades recorded by the change and whether the order changed. A changed
+// order is persisted and reported through the comms manager and dispatch
+// system, orders which are not tracked are left alone
+func (o *orderManager) modifyOrder(exchName, id string, fn func(d *order.Detail) (float64, []order.TradeHistory, bool)) {
+	var executed float64
+	var trades []order.TradeHistory
+	stored, updated, err := o.orderStore.Modify(exchName, id, func(d *order.Detail) bool {
+		var changed bool
+		executed, trades, changed = fn(d)
+		return changed
+	})
+	if err != nil || updated == nil {
+		return
+	}
+	o.persist(updated)
+
+	msg := fmt.Sprintf("Order manager: Exchange %s order ID=%v [Ours: %v] pair=%v status changed from %v to %v executed amount=%v remaining amount=%v.",
+		updated.Exchange,
+		updated.ID,
+		updated.InternalOrderID,
+		updated.CurrencyPair,
+		stored.Status,
+		updated.Status,
+		updated.ExecutedAmount,
+		updated.RemainingAmount)
+	log.Debugln(log.OrderMgr, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+
+	o.publish(&OrderUpdate{
+		Detail:         *updated,
+		PreviousStatus: stored.Status,
+		ExecutedDelta:  executed,
+		NewTrades:      trades,
+	})
+}
+
+// getRoutes returns the dispatch IDs an order update for the exchange is
+// published to, creating them on first use. An empty exchange name returns
+// only the ID for updates across all exchanges
+func (o *orderManager) getRoutes(exchName string) ([]uuid.UUID, error) {
+	o.routesMtx.Lock()
+	defer o.routesMtx.Unlock()
+	if o.mux == nil {
+		o.mux = dispatch.GetNewMux()
+	}
+	if o.exchangeIDs == nil {
+		o.exchangeIDs = make(map[string]uuid.UUID)
+	}
+
+	var err error
+	if o.allOrders == (uuid.UUID{}) {
+		o.allOrders, err = o.mux.GetID()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if exchName == "" {
+		return []uuid.UUID{o.allOrders}, nil
+	}
+
+	exchName = strings.ToLower(exchName)
+	id, ok := o.exchangeIDs[exchName]
+	if !ok {
+		id, err = o.mux.GetID()
+		if err != nil {
+			return nil, err
+		}
+		o.exchangeIDs[exchName] = id
+	}
+	return []uuid.UUID{o.allOrders, id}, nil
+}
+
+// SubscribeOrders returns a pipe which receives an OrderUpdate each time an
+// order on the exchange changes state, or an order on any exchange if the
+// exchange name is empty
+func (o *orderManager) SubscribeOrders(exchName string) (dispatch.Pipe, error) {
+	ids, err := o.getRoutes(exchName)
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	o.routesMtx.Lock()
+	mux := o.mux
+	o.routesMtx.Unlock()
+	return mux.Subscribe(ids[len(ids)-1])
+}
+
+// publish pushes an order update to the risk manager and any dispatch
+// subscribers
+func (o *orderManager) publish(update *OrderUpdate) {
+	Bot.RiskManager.RecordUpdate(update)
+	ids, err := o.getRoutes(update.Detail.Exchange)
+	if err == nil {
+		o.routesMtx.Lock()
+		mux := o.mux
+		o.routesMtx.Unlock()
+		err = mux.Publish(ids, update)
+	}
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to publish order ID=%v update. Err: %s\n",
+			update.Detail.ID, err)
+	}
+}
+
+func orderToRepository(d *order.Detail) *orderRepo.Details {
+	return &orderRepo.Details{
+		OurOrderID:      d.InternalOrderID,
+		ExchangeName:    d.Exchange,
+		OrderID:         d.ID,
+		Base:            d.CurrencyPair.Base.String(),
+		Quote:           d.CurrencyPair.Quote.String(),
+		Asset:           d.AssetType.String(),
+		Side:            d.OrderSide.String(),
+		OrderType:       d.OrderType.String(),
+		Status:          d.Status.String(),
+		Price:           d.Price,
+		Amount:          d.Amount,
+		ExecutedAmount:  d.ExecutedAmount,
+		RemainingAmount: d.RemainingAmount,
+		Fee:             d.Fee,
+		OrderDate:       d.OrderDate,
+		LastUpdated:     time.Now(),
+	}
+}
+
+func orderFromRepository(d *orderRepo.Details) order.Detail {
+	return order.Detail{
+		Exchange:        d.ExchangeName,
+		ID:              d.OrderID,
+		InternalOrderID: d.OurOrderID,
+		CurrencyPair:    currency.NewPair(currency.NewCode(d.Base), currency.NewCode(d.Quote)),
+		AssetType:       asset.Item(d.Asset),
+		OrderSide:       order.Side(d.Side),
+		OrderType:       order.Type(d.OrderType),
+		OrderDate:       d.OrderDate,
+		Status:          order.Status(d.Status),
+		Price:           d.Price,
+		Amount:          d.Amount,
+		ExecutedAmount:  d.ExecutedAmount,
+		RemainingAmount: d.RemainingAmount,
+		Fee:             d.Fee,
 	}
 }
@@ -0,0 +1,303 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
+)
+
+func TestOrderStore(t *testing.T) {
+	t.Parallel()
+	s := orderStore{Orders: make(map[string][]order.Detail)}
+	d := order.Detail{
+		Exchange: "Bitstamp",
+		ID:       "1337",
+		Status:   order.New,
+	}
+	if err := s.Add(&d); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add(&d); err != ErrOrdersAlreadyExists {
+		t.Errorf("expected %v, received %v", ErrOrdersAlreadyExists, err)
+	}
+	closed := order.Detail{
+		Exchange: "Bitstamp",
+		ID:       "1338",
+		Status:   order.Cancelled,
+	}
+	if err := s.Add(&closed); err != nil {
+		t.Fatal(err)
+	}
+	if open := s.GetOpenOrders("Bitstamp"); len(open) != 1 || open[0].ID != "1337" {
+		t.Errorf("expected a single open order, received %v", open)
+	}
+
+	d.Status = order.Filled
+	previous, err := s.Update(&d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if previous.Status != order.New {
+		t.Errorf("expected previous status %v, received %v", order.New, previous.Status)
+	}
+	stored, ok := s.GetOrder("Bitstamp", "1337")
+	if !ok || stored.Status != order.Filled {
+		t.Error("expected stored order to be updated")
+	}
+	if open := s.GetOpenOrders("Bitstamp"); len(open) != 0 {
+		t.Errorf("expected no open orders, received %v", open)
+	}
+	if _, err = s.Update(&order.Detail{Exchange: "Bitstamp", ID: "meow"}); err == nil {
+		t.Error("expected error updating an unknown order")
+	}
+}
+
+func TestOrderRepositoryConversion(t *testing.T) {
+	t.Parallel()
+	d := order.Detail{
+		Exchange:        "Bitstamp",
+		ID:              "1337",
+		InternalOrderID: "5b2ae7f4-d53d-4a6e-a2a1-9a4a7f6e63b8",
+		CurrencyPair:    currency.NewPair(currency.BTC, currency.USD),
+		AssetType:       asset.Spot,
+		OrderSide:       order.Buy,
+		OrderType:       order.Limit,
+		OrderDate:       time.Now(),
+		Status:          order.PartiallyFilled,
+		Price:           1000,
+		Amount:          2,
+		ExecutedAmount:  1,
+		RemainingAmount: 1,
+	}
+	r := orderToRepository(&d)
+	if r.Base != "BTC" || r.Quote != "USD" || r.Status != "PARTIALLY_FILLED" ||
+		r.OurOrderID != d.InternalOrderID {
+		t.Errorf("unexpected repository order %+v", r)
+	}
+	c := orderFromRepository(r)
+	if !c.CurrencyPair.Equal(d.CurrencyPair) || c.Status != d.Status ||
+		c.OrderSide != d.OrderSide || c.AssetType != d.AssetType ||
+		c.InternalOrderID != d.InternalOrderID || c.ExecutedAmount != d.ExecutedAmount {
+		t.Errorf("unexpected order %+v", c)
+	}
+}
+
+func TestIsOrderClosed(t *testing.T) {
+	t.Parallel()
+	if isOrderClosed(order.Active) || isOrderClosed(order.PartiallyFilled) {
+		t.Error("open statuses should not be closed")
+	}
+	if !isOrderClosed(order.Filled) || !isOrderClosed(order.Cancelled) {
+		t.Error("filled and cancelled orders should be closed")
+	}
+}
+
+func TestOrderManagerUpdates(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	if !dispatch.IsRunning() {
+		if err := dispatch.Start(1, dispatch.DefaultJobsLimit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	o := orderManager{orderStore: orderStore{Orders: make(map[string][]order.Detail)}}
+	d := order.Detail{
+		Exchange:        "Bitstamp",
+		ID:              "1337",
+		Status:          order.New,
+		Price:           100,
+		Amount:          2,
+		RemainingAmount: 2,
+	}
+	if err := o.orderStore.Add(&d); err != nil {
+		t.Fatal(err)
+	}
+
+	pipe, err := o.SubscribeOrders("bitstamp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pipe.Release()
+
+	receive := func() OrderUpdate {
+		select {
+		case data := <-pipe.C:
+			return (*data.(*interface{})).(OrderUpdate)
+		case <-time.After(time.Second * 5):
+			t.Fatal("timed out waiting for order update")
+		}
+		return OrderUpdate{}
+	}
+
+	o.updateOrder(&d, &order.Detail{ExecutedAmount: 1})
+	u := receive()
+	if u.PreviousStatus != order.New || u.Detail.Status != order.PartiallyFilled ||
+		u.ExecutedDelta != 1 || u.Detail.RemainingAmount != 1 {
+		t.Errorf("unexpected order update %+v", u)
+	}
+
+	stored, _ := o.orderStore.GetOrder("Bitstamp", "1337")
+	o.setOrderStatus("Bitstamp", "1337", order.Cancelled)
+	u = receive()
+	if u.PreviousStatus != order.PartiallyFilled || u.Detail.Status != order.Cancelled {
+		t.Errorf("unexpected order update %+v", u)
+	}
+	if stored.Status != order.PartiallyFilled {
+		t.Error("stored copy should not be modified by an update")
+	}
+	if open := o.orderStore.GetOpenOrders("Bitstamp"); len(open) != 0 {
+		t.Errorf("expected no open orders, received %v", open)
+	}
+
+	ids, err := o.getRoutes("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 {
+		t.Errorf("expected a single route for all orders, received %d", len(ids))
+	}
+}
+
+func TestOrderManagerStaleUpdate(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	if !dispatch.IsRunning() {
+		if err := dispatch.Start(1, dispatch.DefaultJobsLimit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	o := orderManager{orderStore: orderStore{Orders: make(map[string][]order.Detail)}}
+	d := order.Detail{
+		Exchange:        "Bitstamp",
+		ID:              "1338",
+		Status:          order.Active,
+		Price:           100,
+		Amount:          2,
+		RemainingAmount: 2,
+	}
+	if err := o.orderStore.Add(&d); err != nil {
+		t.Fatal(err)
+	}
+
+	pipe, err := o.SubscribeOrders("bitstamp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pipe.Release()
+
+	// the snapshot is taken before the exchange request, the order is then
+	// cancelled before the request returns the order as active
+	snapshot, _ := o.orderStore.GetOrder("Bitstamp", "1338")
+	o.setOrderStatus("Bitstamp", "1338", order.Cancelled)
+	<-pipe.C
+
+	o.updateOrder(&snapshot, &order.Detail{Status: order.Active})
+	select {
+	case data := <-pipe.C:
+		t.Errorf("unexpected order update %+v", *data.(*interface{}))
+	case <-time.After(time.Millisecond * 100):
+	}
+	stored, _ := o.orderStore.GetOrder("Bitstamp", "1338")
+	if stored.Status != order.Cancelled {
+		t.Errorf("stale snapshot reopened cancelled order, status %v", stored.Status)
+	}
+
+	o.updateOrder(&snapshot, &order.Detail{Status: order.PartiallyFilled, ExecutedAmount: 1})
+	select {
+	case data := <-pipe.C:
+		u := (*data.(*interface{})).(OrderUpdate)
+		if u.PreviousStatus != order.Cancelled || u.Detail.Status != order.Cancelled ||
+			u.ExecutedDelta != 1 {
+			t.Errorf("unexpected order update %+v", u)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for order update")
+	}
+}
+
+func TestCheckTradingRules(t *testing.T) {
+	t.Parallel()
+	exch := new(bitstamp.Bitstamp)
+	exch.Name = testExchange
+	p := currency.NewPair(currency.BTC, currency.USD)
+	s := &order.Submit{
+		Pair:      p,
+		OrderType: order.Limit,
+		OrderSide: order.Buy,
+		Price:     10000.123,
+		Amount:    0.123456,
+	}
+	if err := checkTradingRules(exch, s, false); err != nil {
+		t.Errorf("expected orders to pass without trading rules loaded, received %v", err)
+	}
+
+	err := exch.LoadTradingRules([]rules.Rules{
+		{
+			Pair:        p,
+			Asset:       asset.Spot,
+			PriceTick:   0.01,
+			AmountStep:  0.0001,
+			MinAmount:   0.001,
+			MinNotional: 25,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = checkTradingRules(exch, s, false); err == nil {
+		t.Error("expected an error for an order off the price tick")
+	}
+	if err = checkTradingRules(exch, s, true); err != nil {
+		t.Fatal(err)
+	}
+	if s.Price != 10000.12 || s.Amount != 0.1234 {
+		t.Errorf("expected the order rounded to 10000.12 0.1234, received %v %v",
+			s.Price, s.Amount)
+	}
+
+	s.Amount = 0.002
+	if err = checkTradingRules(exch, s, true); err == nil {
+		t.Error("expected an error for an order below the min notional")
+	}
+
+	s.Pair = currency.NewPair(currency.LTC, currency.USD)
+	s.Amount = 1
+	if err = checkTradingRules(exch, s, true); err == nil {
+		t.Error("expected an error for a pair missing from the loaded rules")
+	}
+
+	s.AssetType = asset.Margin
+	if err = checkTradingRules(exch, s, true); err != nil {
+		t.Errorf("expected orders to pass without trading rules loaded for the asset type, received %v", err)
+	}
+}
+
+func TestOrderManagerBatchValidation(t *testing.T) {
+	t.Parallel()
+	var o orderManager
+	if _, err := o.SubmitOrders("", []order.Submit{{}}); err == nil {
+		t.Error("expected an error without an exchange name")
+	}
+	if _, err := o.SubmitOrders(testExchange, nil); err == nil {
+		t.Error("expected an error without orders")
+	}
+	if _, err := o.SubmitOrders(testExchange, []order.Submit{{}}); err == nil {
+		t.Error("expected an error for an invalid order")
+	}
+	if _, err := o.CancelOrders("", []order.Cancel{{OrderID: "1"}}); err == nil {
+		t.Error("expected an error without an exchange name")
+	}
+	if _, err := o.CancelOrders(testExchange, nil); err == nil {
+		t.Error("expected an error without orders")
+	}
+}
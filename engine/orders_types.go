@@ -3,7 +3,9 @@ package engine
 import (
 	"sync"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -28,6 +30,22 @@ type orderManager struct {
 	shutdown   chan struct{}
 	orderStore orderStore
 	cfg        orderManagerConfig
+
+	// dispatch routing for order updates, allOrders receives updates for
+	// every exchange
+	mux         *dispatch.Mux
+	routesMtx   sync.Mutex
+	allOrders   uuid.UUID
+	exchangeIDs map[string]uuid.UUID
+}
+
+// OrderUpdate is published on the dispatch system each time a tracked order
+// changes state
+type OrderUpdate struct {
+	Detail         order.Detail
+	PreviousStatus order.Status
+	ExecutedDelta  float64
+	NewTrades      []order.TradeHistory
 }
 
 type orderSubmitResponse struct {
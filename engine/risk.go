@@ -0,0 +1,557 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	orderRepo "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const (
+	riskManagerName = "Risk"
+	riskAuditType   = "risk"
+)
+
+// Started returns if the risk manager subsystem is started
+func (r *riskManager) Started() bool {
+	return atomic.LoadInt32(&r.started) == 1
+}
+
+// Start starts the risk manager subsystem. When the database is connected the
+// positions and today's realised profit and loss are first rebuilt from the
+// fills of the stored orders, so the limits hold across restarts
+func (r *riskManager) Start() error {
+	if atomic.AddInt32(&r.started, 1) != 1 {
+		return fmt.Errorf("%s %s", riskManagerName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.OrderMgr, riskManagerName, MsgSubSystemStarting)
+	r.m.Lock()
+	if r.positions == nil {
+		r.positions = make(map[string]*RiskPosition)
+		if database.DB.Connected {
+			if err := r.loadPositions(); err != nil {
+				log.Errorf(log.OrderMgr, "%s manager: Unable to rebuild positions from stored orders. Err: %s\n",
+					riskManagerName, err)
+			}
+		}
+	}
+	r.m.Unlock()
+	log.Debugln(log.OrderMgr, riskManagerName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the risk manager subsystem, orders are no longer checked until it
+// is started again
+func (r *riskManager) Stop() error {
+	if atomic.LoadInt32(&r.started) == 0 {
+		return fmt.Errorf("%s %s", riskManagerName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&r.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", riskManagerName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.OrderMgr, riskManagerName, MsgSubSystemShuttingDown)
+	atomic.CompareAndSwapInt32(&r.stopped, 1, 0)
+	atomic.CompareAndSwapInt32(&r.started, 1, 0)
+	log.Debugln(log.OrderMgr, riskManagerName, MsgSubSystemShutdown)
+	return nil
+}
+
+// CheckOrder checks a new order against the configured risk limits for the
+// exchange and currency pair and returns a *RiskError if a limit is breached
+func (r *riskManager) CheckOrder(exchName string, s *order.Submit) error {
+	return r.check(exchName, s, true, riskPending{})
+}
+
+// CheckOrders checks a batch of new orders against the configured risk limits.
+// Each order is checked with the open orders and net position of the orders
+// before it in the batch, so a batch cannot breach a limit its orders would
+// each pass alone
+func (r *riskManager) CheckOrders(exchName string, orders []order.Submit) error {
+	pending := make(map[string]riskPending)
+	for x := range orders {
+		key := positionKey(exchName, orders[x].Pair, riskAsset(orders[x].AssetType))
+		p := pending[key]
+		if err := r.check(exchName, &orders[x], true, p); err != nil {
+			return fmt.Errorf("order %d: %v", x+1, err)
+		}
+		p.Open++
+		p.Net += signedAmount(orders[x].OrderSide, orders[x].Amount)
+		pending[key] = p
+	}
+	return nil
+}
+
+// CheckModify checks the new state of an amended order against the configured
+// risk limits. The order is already open so it is not counted against the
+// open order limit
+func (r *riskManager) CheckModify(exchName string, s *order.Submit) error {
+	return r.check(exchName, s, false, riskPending{})
+}
+
+// check checks an order against the risk limits, the pending open orders and
+// net position are added to those tracked for the currency pair and asset type
+func (r *riskManager) check(exchName string, s *order.Submit, isNew bool, pending riskPending) error {
+	// the kill switch stays engaged when the risk manager is stopped
+	if r.KillSwitchEngaged() {
+		return r.reject(exchName, s.Pair, RiskLimitKillSwitch,
+			"kill switch is engaged, all orders are rejected")
+	}
+
+	if !r.Started() {
+		return nil
+	}
+
+	limits, ok := getRiskLimits(&Bot.Config.RiskManager, exchName, s.Pair)
+	if !ok {
+		return nil
+	}
+
+	a := riskAsset(s.AssetType)
+	var last float64
+	if limits.PriceBandPercent > 0 || (limits.MaxNotional > 0 && s.Price <= 0) {
+		t, err := ticker.GetTicker(exchName, s.Pair, a)
+		if err == nil {
+			last = t.Last
+		}
+	}
+
+	if limits.PriceBandPercent > 0 && s.OrderType != order.Market && s.Price > 0 {
+		if last <= 0 {
+			return r.reject(exchName, s.Pair, RiskLimitPriceBand,
+				"unable to retrieve the last ticker price")
+		}
+		deviation := math.Abs(s.Price-last) / last * 100
+		if deviation > limits.PriceBandPercent {
+			return r.reject(exchName, s.Pair, RiskLimitPriceBand,
+				fmt.Sprintf("price %v is %.2f%% from the last price %v, limit %v%%",
+					s.Price, deviation, last, limits.PriceBandPercent))
+		}
+	}
+
+	if limits.MaxNotional > 0 {
+		price := s.Price
+		if price <= 0 {
+			price = last
+		}
+		if price <= 0 {
+			return r.reject(exchName, s.Pair, RiskLimitNotional,
+				"unable to determine the order price")
+		}
+		notional, err := valueInFiat(exchName, s.Pair.Quote, a, price*s.Amount)
+		if err != nil {
+			return r.reject(exchName, s.Pair, RiskLimitNotional, err.Error())
+		}
+		if notional > limits.MaxNotional {
+			return r.reject(exchName, s.Pair, RiskLimitNotional,
+				fmt.Sprintf("notional %.2f %s exceeds limit %v",
+					notional, Bot.Config.Currency.FiatDisplayCurrency, limits.MaxNotional))
+		}
+	}
+
+	if isNew && limits.MaxOpenOrders > 0 {
+		open := pending.Open
+		orders := Bot.OrderManager.orderStore.GetOpenOrders(exchName)
+		for x := range orders {
+			if orders[x].CurrencyPair.Equal(s.Pair) && riskAsset(orders[x].AssetType) == a {
+				open++
+			}
+		}
+		if open >= limits.MaxOpenOrders {
+			return r.reject(exchName, s.Pair, RiskLimitOpenOrders,
+				fmt.Sprintf("%d orders are open, limit %d", open, limits.MaxOpenOrders))
+		}
+	}
+
+	pos := r.GetPosition(exchName, s.Pair, a)
+	if limits.MaxNetPosition > 0 {
+		net := pos.Net + pending.Net
+		projected := net + signedAmount(s.OrderSide, s.Amount)
+		// orders which reduce the position are always allowed
+		if math.Abs(projected) > limits.MaxNetPosition &&
+			math.Abs(projected) > math.Abs(net) {
+			return r.reject(exchName, s.Pair, RiskLimitPosition,
+				fmt.Sprintf("net position would be %v, limit %v",
+					projected, limits.MaxNetPosition))
+		}
+	}
+
+	if limits.MaxDailyLoss > 0 && -pos.DailyPNL >= limits.MaxDailyLoss {
+		return r.reject(exchName, s.Pair, RiskLimitDailyLoss,
+			fmt.Sprintf("realised loss of %.2f %s today has reached limit %v",
+				-pos.DailyPNL, Bot.Config.Currency.FiatDisplayCurrency, limits.MaxDailyLoss))
+	}
+
+	return nil
+}
+
+// reject logs, audits and reports an order which breached a risk limit
+func (r *riskManager) reject(exchName string, p currency.Pair, limit RiskLimit, reason string) error {
+	err := &RiskError{
+		Exchange: exchName,
+		Pair:     p,
+		Limit:    limit,
+		Reason:   reason,
+	}
+	log.Warnf(log.OrderMgr, "%s manager: %s\n", riskManagerName, err)
+	audit.Event(exchName, riskAuditType, err.Error())
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "risk",
+		Message: err.Error(),
+	})
+	return err
+}
+
+// RecordUpdate updates the tracked position and realised profit and loss of an
+// exchange currency pair and asset type with any amount filled by an order
+// update
+func (r *riskManager) RecordUpdate(u *OrderUpdate) {
+	if !r.Started() || u.ExecutedDelta <= 0 {
+		return
+	}
+
+	a := riskAsset(u.Detail.AssetType)
+	price := u.Detail.Price
+	if len(u.NewTrades) > 0 {
+		var cost, amount float64
+		for x := range u.NewTrades {
+			cost += u.NewTrades[x].Price * u.NewTrades[x].Amount
+			amount += u.NewTrades[x].Amount
+		}
+		if amount > 0 {
+			price = cost / amount
+		}
+	}
+	if price <= 0 {
+		t, err := ticker.GetTicker(u.Detail.Exchange, u.Detail.CurrencyPair, a)
+		if err != nil {
+			log.Warnf(log.OrderMgr,
+				"%s manager: Unable to determine fill price of %s order ID=%v, position not updated.\n",
+				riskManagerName, u.Detail.Exchange, u.Detail.ID)
+			return
+		}
+		price = t.Last
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	pos := r.getPosition(u.Detail.Exchange, u.Detail.CurrencyPair, a)
+	realised := pos.fill(signedAmount(u.Detail.OrderSide, u.ExecutedDelta), price)
+	if realised == 0 {
+		return
+	}
+	value, err := valueInFiat(u.Detail.Exchange, u.Detail.CurrencyPair.Quote, a, realised)
+	if err != nil {
+		log.Warnf(log.OrderMgr,
+			"%s manager: Unable to value realised profit of %s order ID=%v. Err: %s\n",
+			riskManagerName, u.Detail.Exchange, u.Detail.ID, err)
+		return
+	}
+	pos.DailyPNL += value
+}
+
+// loadPositions replays the fills of the stored orders in time order to
+// rebuild the tracked positions and today's realised profit and loss. Fills
+// without a known price cannot be valued and are skipped. The mutex must be
+// held by the caller
+func (r *riskManager) loadPositions() error {
+	stored, err := orderRepo.GetExecuted()
+	if err != nil {
+		return err
+	}
+
+	today := time.Now().UTC().Truncate(time.Hour * 24)
+	var fills []riskFill
+	for x := range stored {
+		fills = append(fills, storedFills(&stored[x], today)...)
+	}
+	sort.SliceStable(fills, func(i, j int) bool {
+		return fills[i].Time.Before(fills[j].Time)
+	})
+
+	var skipped int
+	for x := range fills {
+		if fills[x].Price <= 0 {
+			skipped++
+			continue
+		}
+		pos := r.getPosition(fills[x].Exchange, fills[x].Pair, fills[x].Asset)
+		realised := pos.fill(fills[x].Amount, fills[x].Price)
+		if realised == 0 || fills[x].Time.Before(today) {
+			continue
+		}
+		value, err := valueInFiat(fills[x].Exchange, fills[x].Pair.Quote, fills[x].Asset, realised)
+		if err != nil {
+			log.Warnf(log.OrderMgr,
+				"%s manager: Unable to value realised profit of stored %s order ID=%v. Err: %s\n",
+				riskManagerName, fills[x].Exchange, fills[x].OrderID, err)
+			continue
+		}
+		pos.DailyPNL += value
+	}
+	if skipped > 0 {
+		log.Warnf(log.OrderMgr,
+			"%s manager: %d stored fills without a price were not added to the positions.\n",
+			riskManagerName, skipped)
+	}
+	log.Debugf(log.OrderMgr, "%s manager: Rebuilt positions from %d stored fills.\n",
+		riskManagerName, len(fills)-skipped)
+	return nil
+}
+
+// storedFills returns the fills of a stored order. The fills of orders last
+// updated today are taken from the order's events so fills before the start
+// of the day are not counted towards today's profit and loss, older orders are
+// treated as a single fill
+func storedFills(d *orderRepo.Details, today time.Time) []riskFill {
+	exchName := d.ExchangeName
+	if exch := GetExchangeByName(exchName); exch != nil {
+		// stored exchange names are lower case
+		exchName = exch.GetName()
+	}
+	fill := riskFill{
+		Exchange: exchName,
+		OrderID:  d.OrderID,
+		Pair:     currency.NewPairFromStrings(d.Base, d.Quote),
+		Asset:    riskAsset(asset.Item(d.Asset)),
+		Price:    d.Price,
+	}
+	side := order.Side(d.Side)
+
+	var events []orderRepo.Event
+	if !d.LastUpdated.Before(today) {
+		var err error
+		events, err = orderRepo.GetEvents(d.ExchangeName, d.OrderID)
+		if err != nil {
+			log.Warnf(log.OrderMgr,
+				"%s manager: Unable to load events of stored %s order ID=%v. Err: %s\n",
+				riskManagerName, exchName, d.OrderID, err)
+		}
+	}
+
+	var fills []riskFill
+	var executed float64
+	for x := range events {
+		if events[x].ExecutedAmount <= executed {
+			continue
+		}
+		fill.Amount = signedAmount(side, events[x].ExecutedAmount-executed)
+		fill.Time = events[x].Timestamp
+		fills = append(fills, fill)
+		executed = events[x].ExecutedAmount
+	}
+	if d.ExecutedAmount > executed {
+		fill.Amount = signedAmount(side, d.ExecutedAmount-executed)
+		fill.Time = d.LastUpdated
+		fills = append(fills, fill)
+	}
+	return fills
+}
+
+// GetPosition returns a copy of the tracked position for an exchange currency
+// pair and asset type
+func (r *riskManager) GetPosition(exchName string, p currency.Pair, a asset.Item) RiskPosition {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.positions == nil {
+		return RiskPosition{Exchange: exchName, Pair: p, Asset: a}
+	}
+	return *r.getPosition(exchName, p, a)
+}
+
+// getPosition returns the position for an exchange currency pair and asset
+// type, creating it if needed and resetting the realised profit and loss at
+// the start of each UTC day. The mutex must be held by the caller
+func (r *riskManager) getPosition(exchName string, p currency.Pair, a asset.Item) *RiskPosition {
+	key := positionKey(exchName, p, a)
+	pos, ok := r.positions[key]
+	if !ok {
+		pos = &RiskPosition{Exchange: exchName, Pair: p, Asset: a}
+		r.positions[key] = pos
+	}
+	today := time.Now().UTC().Truncate(time.Hour * 24)
+	if !pos.Day.Equal(today) {
+		pos.Day = today
+		pos.DailyPNL = 0
+	}
+	return pos
+}
+
+// positionKey returns the key of an exchange currency pair and asset type's
+// position
+func positionKey(exchName string, p currency.Pair, a asset.Item) string {
+	return strings.ToLower(exchName) + "|" + p.Base.Upper().String() + "|" +
+		p.Quote.Upper().String() + "|" + strings.ToLower(a.String())
+}
+
+// riskAsset returns the asset type of an order, orders without an asset type
+// are treated as spot
+func riskAsset(a asset.Item) asset.Item {
+	if a == "" {
+		return asset.Spot
+	}
+	return a
+}
+
+// fill applies a signed filled amount to the position and returns the profit
+// or loss realised in the quote currency
+func (p *RiskPosition) fill(amount, price float64) float64 {
+	if p.Net == 0 || (p.Net > 0) == (amount > 0) {
+		total := p.Net + amount
+		p.AverageCost = (p.AverageCost*math.Abs(p.Net) + price*math.Abs(amount)) / math.Abs(total)
+		p.Net = total
+		return 0
+	}
+
+	closed := math.Min(math.Abs(amount), math.Abs(p.Net))
+	realised := (price - p.AverageCost) * closed
+	if p.Net < 0 {
+		realised = -realised
+	}
+	previous := p.Net
+	p.Net += amount
+	switch {
+	case p.Net == 0:
+		p.AverageCost = 0
+	case (p.Net > 0) != (previous > 0):
+		// the fill reversed the position, the remainder was opened at the
+		// fill price
+		p.AverageCost = price
+	}
+	return realised
+}
+
+// EngageKillSwitch rejects all new orders and cancels every open order tracked
+// by the order manager
+func (r *riskManager) EngageKillSwitch() (map[string]order.CancelAllResponse, error) {
+	if !r.Started() {
+		return nil, fmt.Errorf("%s %s", riskManagerName, ErrSubSystemNotStarted)
+	}
+
+	atomic.StoreInt32(&r.killSwitch, 1)
+	msg := "Kill switch engaged, all new orders will be rejected and open orders cancelled."
+	log.Warnf(log.OrderMgr, "%s manager: %s\n", riskManagerName, msg)
+	audit.Event("killswitch", riskAuditType, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    "risk",
+		Message: msg,
+	})
+
+	if !Bot.OrderManager.Started() {
+		return nil, errors.New("order manager is not started, open orders cannot be cancelled")
+	}
+	return Bot.OrderManager.CancelAllOrders(nil), nil
+}
+
+// ReleaseKillSwitch allows new orders to be submitted after the kill switch
+// was engaged
+func (r *riskManager) ReleaseKillSwitch() error {
+	if !r.Started() {
+		return fmt.Errorf("%s %s", riskManagerName, ErrSubSystemNotStarted)
+	}
+
+	if !atomic.CompareAndSwapInt32(&r.killSwitch, 1, 0) {
+		return errors.New("kill switch is not engaged")
+	}
+	msg := "Kill switch released, new orders are allowed."
+	log.Warnf(log.OrderMgr, "%s manager: %s\n", riskManagerName, msg)
+	audit.Event("killswitch", riskAuditType, msg)
+	return nil
+}
+
+// KillSwitchEngaged returns whether the kill switch is engaged
+func (r *riskManager) KillSwitchEngaged() bool {
+	return atomic.LoadInt32(&r.killSwitch) == 1
+}
+
+// getRiskLimits returns the risk limits of an exchange currency pair, pair
+// limits override the limits of the exchange
+func getRiskLimits(cfg *config.RiskManagerConfig, exchName string, p currency.Pair) (config.RiskLimits, bool) {
+	for x := range cfg.Exchanges {
+		if !strings.EqualFold(cfg.Exchanges[x].Name, exchName) {
+			continue
+		}
+		limits := cfg.Exchanges[x].RiskLimits
+		for y := range cfg.Exchanges[x].Pairs {
+			if !cfg.Exchanges[x].Pairs[y].Pair.Equal(p) {
+				continue
+			}
+			pair := cfg.Exchanges[x].Pairs[y].RiskLimits
+			if pair.MaxNotional > 0 {
+				limits.MaxNotional = pair.MaxNotional
+			}
+			if pair.MaxOpenOrders > 0 {
+				limits.MaxOpenOrders = pair.MaxOpenOrders
+			}
+			if pair.MaxNetPosition > 0 {
+				limits.MaxNetPosition = pair.MaxNetPosition
+			}
+			if pair.MaxDailyLoss > 0 {
+				limits.MaxDailyLoss = pair.MaxDailyLoss
+			}
+			if pair.PriceBandPercent > 0 {
+				limits.PriceBandPercent = pair.PriceBandPercent
+			}
+		}
+		return limits, true
+	}
+	return config.RiskLimits{}, false
+}
+
+// valueInFiat converts an amount of currency to the fiat display currency.
+// Fiat currencies are converted with the stored foreign exchange rates and
+// cryptocurrencies with the last ticker price of the asset type on the
+// exchange, falling back to the spot ticker price
+func valueInFiat(exchName string, c currency.Code, a asset.Item, amount float64) (float64, error) {
+	fiat := Bot.Config.Currency.FiatDisplayCurrency
+	if c.Match(fiat) {
+		return amount, nil
+	}
+	if c.IsFiatCurrency() {
+		return currency.ConvertCurrency(amount, c, fiat)
+	}
+
+	assets := []asset.Item{a}
+	if a != asset.Spot {
+		assets = append(assets, asset.Spot)
+	}
+	for x := range assets {
+		t, err := ticker.GetTicker(exchName, currency.NewPair(c, fiat), assets[x])
+		if err == nil && t.Last > 0 {
+			return amount * t.Last, nil
+		}
+		if !fiat.Match(currency.USD) {
+			t, err = ticker.GetTicker(exchName, currency.NewPair(c, currency.USD), assets[x])
+			if err == nil && t.Last > 0 {
+				return currency.ConvertCurrency(amount*t.Last, currency.USD, fiat)
+			}
+		}
+	}
+	return 0, fmt.Errorf("unable to value %s in %s", c, fiat)
+}
+
+// signedAmount returns the amount as a positive value for buy orders and a
+// negative value for sell orders
+func signedAmount(side order.Side, amount float64) float64 {
+	if strings.EqualFold(side.String(), order.Sell.String()) ||
+		strings.EqualFold(side.String(), order.Ask.String()) {
+		return -amount
+	}
+	return amount
+}
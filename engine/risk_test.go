@@ -0,0 +1,296 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	orderRepo "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func TestRiskPositionFill(t *testing.T) {
+	t.Parallel()
+	var p RiskPosition
+	if r := p.fill(2, 100); r != 0 || p.Net != 2 || p.AverageCost != 100 {
+		t.Errorf("unexpected position %+v realised %v", p, r)
+	}
+	if r := p.fill(2, 200); r != 0 || p.Net != 4 || p.AverageCost != 150 {
+		t.Errorf("unexpected position %+v realised %v", p, r)
+	}
+	if r := p.fill(-1, 100); r != -50 || p.Net != 3 || p.AverageCost != 150 {
+		t.Errorf("unexpected position %+v realised %v", p, r)
+	}
+	if r := p.fill(-5, 200); r != 150 || p.Net != -2 || p.AverageCost != 200 {
+		t.Errorf("unexpected position %+v realised %v", p, r)
+	}
+	if r := p.fill(2, 150); r != 100 || p.Net != 0 || p.AverageCost != 0 {
+		t.Errorf("unexpected position %+v realised %v", p, r)
+	}
+}
+
+func TestGetRiskLimits(t *testing.T) {
+	t.Parallel()
+	cfg := config.RiskManagerConfig{
+		Exchanges: []config.ExchangeRiskConfig{
+			{
+				Name: "Bitstamp",
+				RiskLimits: config.RiskLimits{
+					MaxNotional:   1000,
+					MaxOpenOrders: 5,
+				},
+				Pairs: []config.PairRiskConfig{
+					{
+						Pair: currency.NewPair(currency.BTC, currency.USD),
+						RiskLimits: config.RiskLimits{
+							MaxNotional:    500,
+							MaxNetPosition: 1,
+						},
+					},
+				},
+			},
+		},
+	}
+	if _, ok := getRiskLimits(&cfg, "Bitfinex", currency.NewPair(currency.BTC, currency.USD)); ok {
+		t.Error("expected no limits for an unconfigured exchange")
+	}
+	l, ok := getRiskLimits(&cfg, "bitstamp", currency.NewPair(currency.BTC, currency.USD))
+	if !ok || l.MaxNotional != 500 || l.MaxOpenOrders != 5 || l.MaxNetPosition != 1 {
+		t.Errorf("unexpected limits %+v", l)
+	}
+	l, ok = getRiskLimits(&cfg, "Bitstamp", currency.NewPair(currency.LTC, currency.USD))
+	if !ok || l.MaxNotional != 1000 || l.MaxNetPosition != 0 {
+		t.Errorf("unexpected limits %+v", l)
+	}
+}
+
+func TestSignedAmount(t *testing.T) {
+	t.Parallel()
+	if signedAmount(order.Buy, 1) != 1 || signedAmount(order.Bid, 1) != 1 {
+		t.Error("buy orders should be positive")
+	}
+	if signedAmount(order.Sell, 1) != -1 || signedAmount(order.Side("sell"), 1) != -1 {
+		t.Error("sell orders should be negative")
+	}
+}
+
+func TestRiskManagerCheckOrder(t *testing.T) {
+	SetupTestHelpers(t)
+	const exchName = "risktest"
+	p := currency.NewPair(currency.BTC, currency.USD)
+	err := ticker.ProcessTicker(exchName, &ticker.Price{Pair: p, Last: 100}, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	riskCfg := Bot.Config.RiskManager
+	fiat := Bot.Config.Currency.FiatDisplayCurrency
+	defer func() {
+		Bot.Config.RiskManager = riskCfg
+		Bot.Config.Currency.FiatDisplayCurrency = fiat
+	}()
+	Bot.Config.Currency.FiatDisplayCurrency = currency.USD
+	Bot.Config.RiskManager = config.RiskManagerConfig{
+		Enabled: true,
+		Exchanges: []config.ExchangeRiskConfig{
+			{
+				Name: exchName,
+				RiskLimits: config.RiskLimits{
+					MaxNotional:      1000,
+					MaxNetPosition:   5,
+					MaxDailyLoss:     100,
+					PriceBandPercent: 10,
+				},
+			},
+		},
+	}
+
+	var r riskManager
+	if err = r.CheckOrder(exchName, &order.Submit{Pair: p, Price: 1000, Amount: 1}); err != nil {
+		t.Errorf("orders should not be checked when the risk manager is stopped: %v", err)
+	}
+	if err = r.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer r.Stop()
+
+	checkLimit := func(s *order.Submit, expected RiskLimit) {
+		t.Helper()
+		err := r.CheckOrder(exchName, s)
+		if expected == "" {
+			if err != nil {
+				t.Errorf("expected order to be accepted, received %v", err)
+			}
+			return
+		}
+		riskErr, ok := err.(*RiskError)
+		if !ok || riskErr.Limit != expected {
+			t.Errorf("expected %v limit breach, received %v", expected, err)
+		}
+	}
+
+	checkLimit(&order.Submit{Pair: p, OrderSide: order.Buy, OrderType: order.Limit, Price: 120, Amount: 1}, RiskLimitPriceBand)
+	checkLimit(&order.Submit{Pair: p, OrderSide: order.Buy, OrderType: order.Limit, Price: 100, Amount: 20}, RiskLimitNotional)
+	checkLimit(&order.Submit{Pair: p, OrderSide: order.Buy, OrderType: order.Market, Amount: 20}, RiskLimitNotional)
+	checkLimit(&order.Submit{Pair: p, OrderSide: order.Buy, OrderType: order.Limit, Price: 100, Amount: 5}, "")
+
+	r.RecordUpdate(&OrderUpdate{
+		Detail: order.Detail{
+			Exchange:     exchName,
+			CurrencyPair: p,
+			OrderSide:    order.Buy,
+			Price:        100,
+		},
+		ExecutedDelta: 5,
+	})
+	checkLimit(&order.Submit{Pair: p, OrderSide: order.Buy, OrderType: order.Limit, Price: 100, Amount: 1}, RiskLimitPosition)
+	checkLimit(&order.Submit{Pair: p, OrderSide: order.Sell, OrderType: order.Limit, Price: 100, Amount: 1}, "")
+
+	r.RecordUpdate(&OrderUpdate{
+		Detail: order.Detail{
+			Exchange:     exchName,
+			CurrencyPair: p,
+			OrderSide:    order.Sell,
+		},
+		NewTrades:     []order.TradeHistory{{Price: 70, Amount: 5}},
+		ExecutedDelta: 5,
+	})
+	pos := r.GetPosition(exchName, p, asset.Spot)
+	if pos.Net != 0 || pos.DailyPNL != -150 {
+		t.Errorf("unexpected position %+v", pos)
+	}
+
+	// futures positions and prices are tracked apart from spot
+	err = ticker.ProcessTicker(exchName, &ticker.Price{Pair: p, Last: 200}, asset.Futures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.RecordUpdate(&OrderUpdate{
+		Detail: order.Detail{
+			Exchange:     exchName,
+			CurrencyPair: p,
+			AssetType:    asset.Futures,
+			OrderSide:    order.Buy,
+		},
+		ExecutedDelta: 2,
+	})
+	pos = r.GetPosition(exchName, p, asset.Futures)
+	if pos.Net != 2 || pos.AverageCost != 200 {
+		t.Errorf("expected the futures fill to be priced from the futures ticker %+v", pos)
+	}
+	if pos = r.GetPosition(exchName, p, asset.Spot); pos.Net != 0 {
+		t.Errorf("expected the futures fill not to change the spot position %+v", pos)
+	}
+	checkLimit(&order.Submit{Pair: p, AssetType: asset.Futures, OrderSide: order.Buy, OrderType: order.Limit, Price: 100, Amount: 1}, RiskLimitPriceBand)
+	checkLimit(&order.Submit{Pair: p, OrderSide: order.Buy, OrderType: order.Limit, Price: 100, Amount: 1}, RiskLimitDailyLoss)
+
+	_, _ = r.EngageKillSwitch()
+	if !r.KillSwitchEngaged() {
+		t.Error("expected kill switch to be engaged")
+	}
+	checkLimit(&order.Submit{Pair: currency.NewPair(currency.LTC, currency.USD), Amount: 1}, RiskLimitKillSwitch)
+	if err = r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	checkLimit(&order.Submit{Pair: currency.NewPair(currency.LTC, currency.USD), Amount: 1}, RiskLimitKillSwitch)
+	if err = r.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err = r.ReleaseKillSwitch(); err != nil {
+		t.Error(err)
+	}
+	if err = r.ReleaseKillSwitch(); err == nil {
+		t.Error("expected error releasing a released kill switch")
+	}
+}
+
+func TestRiskManagerCheckOrders(t *testing.T) {
+	SetupTestHelpers(t)
+	const exchName = "riskbatchtest"
+	p := currency.NewPair(currency.BTC, currency.USD)
+
+	riskCfg := Bot.Config.RiskManager
+	defer func() { Bot.Config.RiskManager = riskCfg }()
+	Bot.Config.RiskManager = config.RiskManagerConfig{
+		Enabled: true,
+		Exchanges: []config.ExchangeRiskConfig{
+			{
+				Name: exchName,
+				RiskLimits: config.RiskLimits{
+					MaxOpenOrders:  2,
+					MaxNetPosition: 3,
+				},
+			},
+		},
+	}
+
+	var r riskManager
+	if err := r.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer r.Stop()
+
+	buy := order.Submit{Pair: p, OrderSide: order.Buy, OrderType: order.Limit, Price: 100, Amount: 1}
+	if err := r.CheckOrders(exchName, []order.Submit{buy, buy}); err != nil {
+		t.Errorf("expected the batch to be accepted, received %v", err)
+	}
+	err := r.CheckOrders(exchName, []order.Submit{buy, buy, buy})
+	if err == nil || !strings.Contains(err.Error(), string(RiskLimitOpenOrders)) {
+		t.Errorf("expected the batch to breach the %v limit, received %v", RiskLimitOpenOrders, err)
+	}
+
+	buy.Amount = 2
+	if err = r.CheckOrder(exchName, &buy); err != nil {
+		t.Errorf("expected the order to be accepted alone, received %v", err)
+	}
+	err = r.CheckOrders(exchName, []order.Submit{buy, buy})
+	if err == nil || !strings.Contains(err.Error(), string(RiskLimitPosition)) {
+		t.Errorf("expected the batch to breach the %v limit, received %v", RiskLimitPosition, err)
+	}
+
+	sell := buy
+	sell.OrderSide = order.Sell
+	if err = r.CheckOrders(exchName, []order.Submit{buy, sell}); err != nil {
+		t.Errorf("expected offsetting orders to be accepted, received %v", err)
+	}
+}
+
+func TestStoredFills(t *testing.T) {
+	t.Parallel()
+	today := time.Now().UTC().Truncate(time.Hour * 24)
+	d := orderRepo.Details{
+		ExchangeName:   "bitstamp",
+		OrderID:        "1",
+		Base:           "BTC",
+		Quote:          "USD",
+		Side:           order.Sell.String(),
+		Price:          100,
+		ExecutedAmount: 2,
+		LastUpdated:    today.Add(-time.Hour),
+	}
+	fills := storedFills(&d, today)
+	if len(fills) != 1 || fills[0].Amount != -2 || fills[0].Price != 100 ||
+		!fills[0].Time.Equal(d.LastUpdated) {
+		t.Errorf("expected a single sell fill of the executed amount, received %+v", fills)
+	}
+	if !fills[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USD)) {
+		t.Errorf("unexpected fill pair %v", fills[0].Pair)
+	}
+	if fills[0].Asset != asset.Spot {
+		t.Errorf("expected a stored order without an asset to be spot, received %v", fills[0].Asset)
+	}
+
+	d.Asset = asset.Futures.String()
+	if fills = storedFills(&d, today); len(fills) != 1 || fills[0].Asset != asset.Futures {
+		t.Errorf("expected a futures fill, received %+v", fills)
+	}
+
+	d.ExecutedAmount = 0
+	if fills = storedFills(&d, today); len(fills) != 0 {
+		t.Errorf("expected no fills for an unfilled order, received %+v", fills)
+	}
+}
@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// RiskLimit identifies the pre-trade risk limit an order breached
+type RiskLimit string
+
+// Risk limits checked by the risk manager
+const (
+	RiskLimitNotional   RiskLimit = "max notional"
+	RiskLimitOpenOrders RiskLimit = "max open orders"
+	RiskLimitPosition   RiskLimit = "max net position"
+	RiskLimitDailyLoss  RiskLimit = "max daily loss"
+	RiskLimitPriceBand  RiskLimit = "price band"
+	RiskLimitKillSwitch RiskLimit = "kill switch"
+)
+
+// RiskError is returned when an order is rejected by the risk manager
+type RiskError struct {
+	Exchange string
+	Pair     currency.Pair
+	Limit    RiskLimit
+	Reason   string
+}
+
+// Error implements the error interface
+func (r *RiskError) Error() string {
+	return fmt.Sprintf("%s %s order rejected by %s limit: %s",
+		r.Exchange, r.Pair, r.Limit, r.Reason)
+}
+
+// RiskPosition holds the filled position and realised profit and loss for the
+// current UTC day of an exchange currency pair and asset type
+type RiskPosition struct {
+	Exchange    string
+	Pair        currency.Pair
+	Asset       asset.Item
+	Net         float64
+	AverageCost float64
+	DailyPNL    float64
+	Day         time.Time
+}
+
+// riskPending holds the open orders and net position of the orders of a batch
+// which are checked but not yet placed
+type riskPending struct {
+	Open int
+	Net  float64
+}
+
+// riskFill holds a signed filled amount of a stored order, used to rebuild
+// the positions on startup
+type riskFill struct {
+	Exchange string
+	OrderID  string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Amount   float64
+	Price    float64
+	Time     time.Time
+}
+
+type riskManager struct {
+	started    int32
+	stopped    int32
+	killSwitch int32
+	m          sync.Mutex
+	positions  map[string]*RiskPosition
+}
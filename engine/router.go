@@ -0,0 +1,377 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const orderRouterName = "Order router"
+
+var (
+	errRouteNotFound    = errors.New("route not found")
+	errRouteNoLiquidity = errors.New("no liquidity available within the balances and price limit")
+)
+
+// Route allocates the order across the enabled exchanges which support its
+// currency pair cheapest fee adjusted orderbook level first, limited by the
+// balances available on each exchange. A child order is submitted to each
+// exchange through the order manager unless the request is a dry run
+func (r *orderRouter) Route(req *RouteRequest) (*RoutedOrder, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if !req.DryRun && !Bot.OrderManager.Started() {
+		return nil, fmt.Errorf("%s requires the order manager to be started",
+			orderRouterName)
+	}
+
+	buy := isBuySide(req.OrderSide)
+	venues, excluded := routeVenues(req, buy)
+	var limitPrice float64
+	if req.OrderType == order.Limit {
+		limitPrice = req.Price
+	}
+
+	rt := &RoutedOrder{
+		Pair:        req.Pair,
+		Side:        req.OrderSide,
+		OrderType:   req.OrderType,
+		Amount:      req.Amount,
+		DryRun:      req.DryRun,
+		Allocations: planRoute(venues, buy, req.Amount, limitPrice),
+		Excluded:    excluded,
+		CreatedAt:   time.Now(),
+	}
+	if len(rt.Allocations) == 0 {
+		var reasons []string
+		for x := range excluded {
+			reasons = append(reasons, excluded[x].Exchange+": "+excluded[x].Reason)
+		}
+		if len(reasons) == 0 {
+			return nil, errRouteNoLiquidity
+		}
+		return nil, fmt.Errorf("%v, %s", errRouteNoLiquidity, strings.Join(reasons, ", "))
+	}
+	for x := range rt.Allocations {
+		rt.Allocated += rt.Allocations[x].Amount
+		rt.Cost += rt.Allocations[x].Cost
+		rt.Fees += rt.Allocations[x].Fee
+	}
+	rt.AveragePrice = rt.Cost / rt.Allocated
+	if buy {
+		rt.EffectivePrice = (rt.Cost + rt.Fees) / rt.Allocated
+	} else {
+		rt.EffectivePrice = (rt.Cost - rt.Fees) / rt.Allocated
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	rt.ID = id.String()
+	if req.DryRun {
+		rt.Status = RoutePlanned
+		return rt, nil
+	}
+
+	for x := range rt.Allocations {
+		submitRouteChild(rt, &rt.Allocations[x])
+	}
+	rt.refresh(nil)
+	log.Infof(log.OrderMgr, "%s: Route ID=%v %s %v %s across %d exchanges status %s.\n",
+		orderRouterName, rt.ID, rt.Side, rt.Allocated, rt.Pair, len(rt.Allocations), rt.Status)
+
+	r.m.Lock()
+	if r.routes == nil {
+		r.routes = make(map[string]*RoutedOrder)
+	}
+	r.routes[rt.ID] = rt
+	result := rt.copy()
+	r.m.Unlock()
+	return result, nil
+}
+
+// GetRoute returns a route with the fill of its child orders refreshed from
+// the order manager
+func (r *orderRouter) GetRoute(id string) (*RoutedOrder, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	rt, ok := r.routes[id]
+	if !ok {
+		return nil, errRouteNotFound
+	}
+	rt.refresh(Bot.OrderManager.orderStore.GetOrder)
+	return rt.copy(), nil
+}
+
+// GetRoutes returns a copy of every route with the fill of its child orders
+// refreshed from the order manager
+func (r *orderRouter) GetRoutes() []RoutedOrder {
+	r.m.Lock()
+	defer r.m.Unlock()
+	var routes []RoutedOrder
+	for _, rt := range r.routes {
+		rt.refresh(Bot.OrderManager.orderStore.GetOrder)
+		routes = append(routes, *rt.copy())
+	}
+	return routes
+}
+
+// routeVenues returns the exchanges an order can be routed to and the reason
+// any exchange supporting the pair was excluded
+func routeVenues(req *RouteRequest, buy bool) ([]routeVenue, []RouteExclusion) {
+	var venues []routeVenue
+	var excluded []RouteExclusion
+	for x := range Bot.Exchanges {
+		name := Bot.Exchanges[x].GetName()
+		requested := common.StringDataCompareInsensitive(req.Exchanges, name)
+		if len(req.Exchanges) > 0 && !requested {
+			continue
+		}
+		if !Bot.Exchanges[x].IsEnabled() ||
+			!Bot.Exchanges[x].GetEnabledPairs(asset.Spot).Contains(req.Pair, true) {
+			if requested {
+				excluded = append(excluded, RouteExclusion{
+					Exchange: name,
+					Reason:   fmt.Sprintf("%s is not enabled", req.Pair),
+				})
+			}
+			continue
+		}
+
+		v, err := routeVenueFor(name, req, buy)
+		if err != nil {
+			excluded = append(excluded, RouteExclusion{
+				Exchange: name,
+				Reason:   err.Error(),
+			})
+			continue
+		}
+		venues = append(venues, v)
+	}
+	return venues, excluded
+}
+
+// routeVenueFor returns the orderbook side, taker fee rate and spendable
+// balance of the exchange
+func routeVenueFor(exchName string, req *RouteRequest, buy bool) (routeVenue, error) {
+	ob, err := orderbook.Get(exchName, req.Pair, asset.Spot)
+	if err != nil {
+		return routeVenue{}, err
+	}
+
+	v := routeVenue{exchange: exchName}
+	if buy {
+		v.levels = append([]orderbook.Item(nil), ob.Asks...)
+		sort.Slice(v.levels, func(i, j int) bool { return v.levels[i].Price < v.levels[j].Price })
+	} else {
+		v.levels = append([]orderbook.Item(nil), ob.Bids...)
+		sort.Slice(v.levels, func(i, j int) bool { return v.levels[i].Price > v.levels[j].Price })
+	}
+	if len(v.levels) == 0 {
+		return routeVenue{}, errors.New("orderbook side has no levels")
+	}
+
+	v.feeRate, err = exchangeFees.tradeFeeRate(exchName, req.Pair, v.levels[0].Price)
+	if err != nil {
+		return routeVenue{}, err
+	}
+
+	spend := req.Pair.Base
+	if buy {
+		spend = req.Pair.Quote
+	}
+	v.balance = availableBalance(exchName, spend)
+	if v.balance <= 0 {
+		return routeVenue{}, fmt.Errorf("no available %s balance", spend)
+	}
+	return v, nil
+}
+
+// planRoute allocates the amount across the venues by walking every level of
+// their orderbooks cheapest fee adjusted price first. Levels worse than the
+// limit price are skipped when it is set and each venue is limited to what its
+// balance can fill. An allocation is returned for each venue used
+func planRoute(venues []routeVenue, buy bool, amount, limitPrice float64) []RouteAllocation {
+	type routeLevel struct {
+		venue     int
+		price     float64
+		amount    float64
+		effective float64
+	}
+	var levels []routeLevel
+	for x := range venues {
+		for y := range venues[x].levels {
+			l := venues[x].levels[y]
+			if l.Amount <= 0 || l.Price <= 0 {
+				continue
+			}
+			if limitPrice > 0 &&
+				((buy && l.Price > limitPrice) || (!buy && l.Price < limitPrice)) {
+				continue
+			}
+			effective := l.Price * (1 - venues[x].feeRate)
+			if buy {
+				effective = l.Price * (1 + venues[x].feeRate)
+			}
+			levels = append(levels, routeLevel{
+				venue:     x,
+				price:     l.Price,
+				amount:    l.Amount,
+				effective: effective,
+			})
+		}
+	}
+	sort.SliceStable(levels, func(i, j int) bool {
+		if buy {
+			return levels[i].effective < levels[j].effective
+		}
+		return levels[i].effective > levels[j].effective
+	})
+
+	allocations := make([]RouteAllocation, len(venues))
+	budget := make([]float64, len(venues))
+	for x := range venues {
+		budget[x] = venues[x].balance
+	}
+	remaining := amount
+	for x := range levels {
+		if remaining <= 0 {
+			break
+		}
+		l := levels[x]
+		feeRate := venues[l.venue].feeRate
+		take := math.Min(l.amount, remaining)
+		if buy {
+			take = math.Min(take, budget[l.venue]/(l.price*(1+feeRate)))
+		} else {
+			take = math.Min(take, budget[l.venue])
+		}
+		if take <= 0 {
+			continue
+		}
+
+		a := &allocations[l.venue]
+		a.Amount += take
+		a.Cost += take * l.price
+		a.Fee += take * l.price * feeRate
+		// levels of a venue are reached best first so the last is the worst
+		a.LimitPrice = l.price
+		if buy {
+			budget[l.venue] -= take * l.price * (1 + feeRate)
+		} else {
+			budget[l.venue] -= take
+		}
+		remaining -= take
+	}
+
+	var resp []RouteAllocation
+	for x := range allocations {
+		if allocations[x].Amount <= 0 {
+			continue
+		}
+		allocations[x].Exchange = venues[x].exchange
+		allocations[x].FeeRate = venues[x].feeRate
+		allocations[x].Price = allocations[x].Cost / allocations[x].Amount
+		resp = append(resp, allocations[x])
+	}
+	return resp
+}
+
+// submitRouteChild submits the child order of an allocation through the order
+// manager, limit orders are submitted at the worst level the allocation
+// reached
+func submitRouteChild(rt *RoutedOrder, a *RouteAllocation) {
+	s := &order.Submit{
+		Pair:      rt.Pair,
+		OrderSide: rt.Side,
+		OrderType: rt.OrderType,
+		Amount:    a.Amount,
+	}
+	if rt.OrderType == order.Limit {
+		s.Price = a.LimitPrice
+	}
+
+	resp, err := Bot.OrderManager.Submit(a.Exchange, s)
+	if err != nil {
+		a.Status = order.Rejected
+		a.Error = err.Error()
+		log.Errorf(log.OrderMgr, "%s: Route ID=%v failed to submit %s child order. Err: %s\n",
+			orderRouterName, rt.ID, a.Exchange, err)
+		return
+	}
+	a.OrderID = resp.OrderID
+	a.Status = order.New
+	if resp.FullyMatched {
+		a.Status = order.Filled
+		a.ExecutedAmount = a.Amount
+		a.FillPrice = a.Price
+	}
+}
+
+// refresh updates the child orders from the order manager and recalculates
+// the aggregate fill and status of the route
+func (rt *RoutedOrder) refresh(lookup func(exchName, id string) (order.Detail, bool)) {
+	if rt.DryRun {
+		return
+	}
+
+	var executed, cost float64
+	var accepted, open bool
+	for x := range rt.Allocations {
+		a := &rt.Allocations[x]
+		if a.OrderID == "" {
+			continue
+		}
+		accepted = true
+		if lookup != nil && !isOrderClosed(a.Status) {
+			if d, ok := lookup(a.Exchange, a.OrderID); ok {
+				a.Status = d.Status
+				a.ExecutedAmount = d.ExecutedAmount
+				a.FillPrice = childFillPrice(&d, a.Price)
+			}
+		}
+		if !isOrderClosed(a.Status) {
+			open = true
+		}
+		executed += a.ExecutedAmount
+		cost += a.ExecutedAmount * a.FillPrice
+	}
+
+	rt.ExecutedAmount = executed
+	rt.FillPrice = 0
+	if executed > 0 {
+		rt.FillPrice = cost / executed
+	}
+	switch {
+	case !accepted:
+		rt.Status = RouteFailed
+	case open:
+		rt.Status = RouteOpen
+	default:
+		rt.Status = RouteComplete
+	}
+}
+
+func (rt *RoutedOrder) copy() *RoutedOrder {
+	c := *rt
+	c.Allocations = append([]RouteAllocation(nil), rt.Allocations...)
+	c.Excluded = append([]RouteExclusion(nil), rt.Excluded...)
+	return &c
+}
+
+// isBuySide returns if the order side buys the base currency
+func isBuySide(s order.Side) bool {
+	return s == order.Buy || s == order.Bid
+}
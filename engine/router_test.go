@@ -0,0 +1,121 @@
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestPlanRoute(t *testing.T) {
+	t.Parallel()
+	venues := []routeVenue{
+		{
+			exchange: "a",
+			levels:   []orderbook.Item{{Price: 100, Amount: 1}, {Price: 102, Amount: 5}},
+			feeRate:  0.02,
+			balance:  1000000,
+		},
+		{
+			exchange: "b",
+			levels:   []orderbook.Item{{Price: 101, Amount: 1}, {Price: 103, Amount: 5}},
+			feeRate:  0,
+			balance:  1000000,
+		},
+	}
+
+	// b's 101 ask is cheaper than a's 100 ask after fees so it fills first
+	a := planRoute(venues, true, 1.5, 0)
+	if len(a) != 2 {
+		t.Fatalf("expected allocations on both exchanges, received %+v", a)
+	}
+	if a[0].Exchange != "a" || a[0].Amount != 0.5 || a[0].Price != 100 ||
+		math.Abs(a[0].Fee-1) > 1e-9 || a[0].FeeRate != 0.02 {
+		t.Errorf("unexpected allocation %+v", a[0])
+	}
+	if a[1].Exchange != "b" || a[1].Amount != 1 || a[1].Price != 101 ||
+		a[1].LimitPrice != 101 || a[1].Fee != 0 {
+		t.Errorf("unexpected allocation %+v", a[1])
+	}
+
+	a = planRoute(venues, true, 3, 0)
+	if a[1].Amount != 2 || a[1].LimitPrice != 103 || a[1].Price != 102 {
+		t.Errorf("expected b to reach its second level, received %+v", a[1])
+	}
+
+	// the limit price excludes both second levels
+	a = planRoute(venues, true, 10, 101)
+	if len(a) != 2 || a[0].Amount != 1 || a[1].Amount != 1 {
+		t.Errorf("expected only levels within the limit price, received %+v", a)
+	}
+
+	// b can only afford 0.5 of its 101 ask
+	venues[1].balance = 50.5
+	a = planRoute(venues, true, 1.5, 0)
+	if len(a) != 2 || math.Abs(a[1].Amount-0.5) > 1e-9 || a[0].Amount != 1 {
+		t.Errorf("expected the quote balance to limit b, received %+v", a)
+	}
+
+	sells := []routeVenue{
+		{
+			exchange: "a",
+			levels:   []orderbook.Item{{Price: 100, Amount: 2}},
+			feeRate:  0.02,
+			balance:  1,
+		},
+		{
+			exchange: "b",
+			levels:   []orderbook.Item{{Price: 99, Amount: 2}},
+			balance:  0.25,
+		},
+	}
+	// a's bid nets 98 after fees so b's 99 bid fills first, limited to the
+	// base balance
+	a = planRoute(sells, false, 2, 0)
+	if len(a) != 2 || a[0].Amount != 1 || a[1].Amount != 0.25 {
+		t.Errorf("expected the base balances to limit both exchanges, received %+v", a)
+	}
+	if a = planRoute(sells, false, 2, 100.5); len(a) != 0 {
+		t.Errorf("expected no allocation below the limit price, received %+v", a)
+	}
+}
+
+func TestRouteRefresh(t *testing.T) {
+	t.Parallel()
+	rt := &RoutedOrder{
+		Allocations: []RouteAllocation{
+			{Exchange: "a", Amount: 1, Price: 100, OrderID: "1", Status: order.New},
+			{Exchange: "b", Amount: 1, Price: 110, OrderID: "2", Status: order.Filled,
+				ExecutedAmount: 1, FillPrice: 110},
+			{Exchange: "c", Amount: 1, Price: 120, Status: order.Rejected},
+		},
+	}
+	details := map[string]order.Detail{
+		"1": {Status: order.PartiallyFilled, ExecutedAmount: 0.5, Price: 100},
+	}
+	lookup := func(exchName, id string) (order.Detail, bool) {
+		d, ok := details[id]
+		return d, ok
+	}
+
+	rt.refresh(lookup)
+	if rt.Status != RouteOpen || rt.ExecutedAmount != 1.5 ||
+		math.Abs(rt.FillPrice-(50+110)/1.5) > 1e-9 {
+		t.Errorf("unexpected open route %+v", rt)
+	}
+
+	details["1"] = order.Detail{Status: order.Filled, ExecutedAmount: 1, Price: 100}
+	rt.refresh(lookup)
+	if rt.Status != RouteComplete || rt.ExecutedAmount != 2 || rt.FillPrice != 105 {
+		t.Errorf("unexpected complete route %+v", rt)
+	}
+
+	rt = &RoutedOrder{
+		Allocations: []RouteAllocation{{Exchange: "a", Status: order.Rejected}},
+	}
+	rt.refresh(lookup)
+	if rt.Status != RouteFailed {
+		t.Errorf("expected a route without accepted child orders to fail, received %s", rt.Status)
+	}
+}
@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Route statuses
+const (
+	// RoutePlanned is the status of a dry run route, no orders are submitted
+	RoutePlanned = "planned"
+	RouteOpen    = "open"
+	// RouteComplete is the status of a route once every child order is closed
+	RouteComplete = "complete"
+	// RouteFailed is the status of a route when no child order was accepted
+	RouteFailed = "failed"
+)
+
+// RouteRequest is an order without an exchange to be split across the
+// exchanges with the best fee adjusted liquidity. Market orders are split
+// across the whole orderbooks and limit orders across the levels at or better
+// than the price
+type RouteRequest struct {
+	order.Submit
+	// Exchanges limits the exchanges routed to, every enabled exchange with
+	// the pair enabled is used when empty
+	Exchanges []string
+	// DryRun returns the allocation without submitting any orders
+	DryRun bool
+}
+
+// RouteAllocation is the part of a routed order allocated to an exchange and
+// its child order
+type RouteAllocation struct {
+	Exchange string
+	Amount   float64
+	// Price is the average orderbook price of the amount and LimitPrice the
+	// worst level reached, limit child orders are submitted at LimitPrice
+	Price      float64
+	LimitPrice float64
+	Cost       float64
+	Fee        float64
+	FeeRate    float64
+	OrderID    string
+	Status     order.Status
+	// ExecutedAmount and FillPrice are the child order's progress
+	ExecutedAmount float64
+	FillPrice      float64
+	Error          string
+}
+
+// RouteExclusion is an exchange which was not allocated any of a routed order
+// and why
+type RouteExclusion struct {
+	Exchange string
+	Reason   string
+}
+
+// RoutedOrder is an order split across exchanges and the aggregate fill of its
+// child orders
+type RoutedOrder struct {
+	ID        string
+	Pair      currency.Pair
+	Side      order.Side
+	OrderType order.Type
+	Amount    float64
+	// Allocated is the amount the orderbooks and balances can fill, it is
+	// less than the amount when liquidity or balances run out
+	Allocated float64
+	Cost      float64
+	Fees      float64
+	// AveragePrice is the average orderbook price of the allocated amount
+	// and EffectivePrice the average price including fees
+	AveragePrice   float64
+	EffectivePrice float64
+	ExecutedAmount float64
+	FillPrice      float64
+	Status         string
+	DryRun         bool
+	Allocations    []RouteAllocation
+	Excluded       []RouteExclusion
+	CreatedAt      time.Time
+}
+
+type orderRouter struct {
+	m      sync.Mutex
+	routes map[string]*RoutedOrder
+}
+
+// routeVenue is the orderbook side, fee rate and spendable balance of an
+// exchange an order can be routed to
+type routeVenue struct {
+	exchange string
+	// levels are asks for buys and bids for sells, best first
+	levels  []orderbook.Item
+	feeRate float64
+	// balance is the available quote currency for buys and base currency
+	// for sells
+	balance float64
+}
@@ -294,6 +294,14 @@ func WebsocketDataHandler(ws *wshandler.Websocket) {
 				log.Errorf(log.WebsocketMgr, "routines.go exchange %s websocket error - %s", ws.GetName(), data)
 			case wshandler.TradeData:
 				// Websocket Trade Data
+				if Bot.Settings.EnableExchangeSyncManager && Bot.ExchangeCurrencyPairManager != nil {
+					Bot.ExchangeCurrencyPairManager.update(ws.GetName(),
+						d.CurrencyPair,
+						d.AssetType,
+						SyncItemTrade,
+						nil)
+				}
+				Bot.TradeRecorder.RecordWebsocketTrade(ws.GetName(), &d)
 				if Bot.Settings.Verbose {
 					log.Infof(log.WebsocketMgr, "%s websocket %s %s trade updated %+v\n",
 						ws.GetName(),
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -24,14 +25,18 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/fixture"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/utils"
@@ -756,17 +761,30 @@ func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderReques
 	p := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
 	submission := &order.Submit{
 		Pair:      p,
+		AssetType: asset.Item(r.AssetType),
 		OrderSide: order.Side(r.Side),
 		OrderType: order.Type(r.OrderType),
 		Amount:    r.Amount,
 		Price:     r.Price,
 		ClientID:  r.ClientId,
 	}
-	result, err := exch.SubmitOrder(submission)
+
+	if !Bot.OrderManager.Started() {
+		result, err := exch.SubmitOrder(submission)
+		return &gctrpc.SubmitOrderResponse{
+			OrderId:     result.OrderID,
+			OrderPlaced: result.IsOrderPlaced,
+		}, err
+	}
+
+	result, err := Bot.OrderManager.Submit(exch.GetName(), submission)
+	if err != nil {
+		return nil, err
+	}
 	return &gctrpc.SubmitOrderResponse{
 		OrderId:     result.OrderID,
 		OrderPlaced: result.IsOrderPlaced,
-	}, err
+	}, nil
 }
 
 // SimulateOrder simulates an order specified by exchange, currency pair and asset
@@ -851,19 +869,390 @@ func (s *RPCServer) CancelOrder(ctx context.Context, r *gctrpc.CancelOrderReques
 		return nil, errors.New("exchange is not loaded/doesn't exist")
 	}
 
-	err := exch.CancelOrder(&order.Cancel{
+	cancel := &order.Cancel{
 		AccountID:     r.AccountId,
 		OrderID:       r.OrderId,
 		Side:          order.Side(r.Side),
 		WalletAddress: r.WalletAddress,
-	})
+	}
+
+	var err error
+	if Bot.OrderManager.Started() {
+		err = Bot.OrderManager.Cancel(exch.GetName(), cancel)
+	} else {
+		err = exch.CancelOrder(cancel)
+	}
 
 	return &gctrpc.CancelOrderResponse{}, err
 }
 
-// CancelAllOrders cancels all orders, filterable by exchange
+// ModifyOrder modifies an existing order specified by exchange and order ID
+func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderRequest) (*gctrpc.ModifyOrderResponse, error) {
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+
+	mod := &order.Modify{
+		OrderID: r.OrderId,
+		Type:    order.Type(r.OrderType),
+		Side:    order.Side(r.Side),
+		Price:   r.Price,
+		Amount:  r.Amount,
+	}
+	if r.Pair != nil {
+		mod.CurrencyPair = currency.NewPairWithDelimiter(r.Pair.Base,
+			r.Pair.Quote, r.Pair.Delimiter)
+	}
+
+	if !Bot.OrderManager.Started() {
+		id, err := exch.ModifyOrder(mod)
+		if err != nil {
+			return nil, err
+		}
+		return &gctrpc.ModifyOrderResponse{OrderId: id}, nil
+	}
+
+	resp, err := Bot.OrderManager.Modify(exch.GetName(), mod)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ModifyOrderResponse{OrderId: resp.OrderID}, nil
+}
+
+// CancelAllOrders cancels all orders tracked by the order manager, filterable
+// by exchange
 func (s *RPCServer) CancelAllOrders(ctx context.Context, r *gctrpc.CancelAllOrdersRequest) (*gctrpc.CancelAllOrdersResponse, error) {
-	return &gctrpc.CancelAllOrdersResponse{}, common.ErrNotYetImplemented
+	if !Bot.OrderManager.Started() {
+		return nil, errors.New("order manager is not started")
+	}
+
+	var exchanges []string
+	if r.Exchange != "" {
+		exch := GetExchangeByName(r.Exchange)
+		if exch == nil {
+			return nil, errors.New("exchange is not loaded/doesn't exist")
+		}
+		exchanges = append(exchanges, exch.GetName())
+	}
+
+	result := Bot.OrderManager.CancelAllOrders(exchanges)
+	var resp gctrpc.CancelAllOrdersResponse
+	for k, v := range result {
+		resp.Orders = append(resp.Orders, &gctrpc.CancelAllOrdersResponse_Orders{
+			Exchange:    k,
+			OrderStatus: v.Status,
+		})
+	}
+	return &resp, nil
+}
+
+// SetRiskKillSwitch engages or releases the risk manager kill switch, engaging
+// it cancels all open orders tracked by the order manager
+func (s *RPCServer) SetRiskKillSwitch(ctx context.Context, r *gctrpc.SetRiskKillSwitchRequest) (*gctrpc.SetRiskKillSwitchResponse, error) {
+	if !r.Engage {
+		err := Bot.RiskManager.ReleaseKillSwitch()
+		if err != nil {
+			return nil, err
+		}
+		return &gctrpc.SetRiskKillSwitchResponse{}, nil
+	}
+
+	result, err := Bot.RiskManager.EngageKillSwitch()
+	resp := gctrpc.SetRiskKillSwitchResponse{
+		Engaged: Bot.RiskManager.KillSwitchEngaged(),
+	}
+	for k, v := range result {
+		resp.Orders = append(resp.Orders, &gctrpc.CancelAllOrdersResponse_Orders{
+			Exchange:    k,
+			OrderStatus: v.Status,
+		})
+	}
+	return &resp, err
+}
+
+// AddConditionalOrder adds a stop, take profit or trailing stop order which is
+// held by the engine and submitted once its trigger price is reached
+func (s *RPCServer) AddConditionalOrder(ctx context.Context, r *gctrpc.AddConditionalOrderRequest) (*gctrpc.ConditionalOrdersResponse, error) {
+	o, err := conditionalOrderFromRPC(r)
+	if err != nil {
+		return nil, err
+	}
+	added, err := Bot.ConditionalOrderManager.Add(o)
+	if err != nil {
+		return nil, err
+	}
+	return conditionalOrdersToRPC([]ConditionalOrder{*added}), nil
+}
+
+// AddOCOOrder adds two conditional orders where triggering either order
+// cancels the other
+func (s *RPCServer) AddOCOOrder(ctx context.Context, r *gctrpc.AddOCOOrderRequest) (*gctrpc.ConditionalOrdersResponse, error) {
+	first, err := conditionalOrderFromRPC(r.First)
+	if err != nil {
+		return nil, err
+	}
+	second, err := conditionalOrderFromRPC(r.Second)
+	if err != nil {
+		return nil, err
+	}
+	added, err := Bot.ConditionalOrderManager.AddOCO(first, second)
+	if err != nil {
+		return nil, err
+	}
+	return conditionalOrdersToRPC(added), nil
+}
+
+// AddBracketOrder adds an entry order with take profit and stop loss legs
+// which are watched once the entry order has filled
+func (s *RPCServer) AddBracketOrder(ctx context.Context, r *gctrpc.AddBracketOrderRequest) (*gctrpc.ConditionalOrdersResponse, error) {
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	entryType, err := order.StringToOrderType(r.EntryType)
+	if err != nil {
+		return nil, err
+	}
+	entry := &ConditionalOrder{
+		Exchange: r.Exchange,
+		Pair: currency.NewPairWithDelimiter(r.Pair.Base,
+			r.Pair.Quote, r.Pair.Delimiter),
+		Asset:  asset.Item(r.AssetType),
+		Side:   order.Side(r.Side),
+		Type:   entryType,
+		Amount: r.Amount,
+	}
+	if entryType == order.Limit {
+		entry.LimitPrice = r.EntryPrice
+	} else {
+		entry.TriggerPrice = r.EntryPrice
+	}
+	added, err := Bot.ConditionalOrderManager.AddBracket(entry,
+		r.TakeProfitPrice, r.StopLossPrice)
+	if err != nil {
+		return nil, err
+	}
+	return conditionalOrdersToRPC(added), nil
+}
+
+// GetConditionalOrders returns the conditional orders tracked by the engine,
+// filterable by exchange
+func (s *RPCServer) GetConditionalOrders(ctx context.Context, r *gctrpc.GetConditionalOrdersRequest) (*gctrpc.ConditionalOrdersResponse, error) {
+	if !Bot.ConditionalOrderManager.Started() {
+		return nil, fmt.Errorf("%s %s", conditionalOrderManagerName, ErrSubSystemNotStarted)
+	}
+	return conditionalOrdersToRPC(Bot.ConditionalOrderManager.Get(r.Exchange)), nil
+}
+
+// CancelConditionalOrder cancels a pending conditional order along with any
+// bracket legs which depend on it
+func (s *RPCServer) CancelConditionalOrder(ctx context.Context, r *gctrpc.CancelConditionalOrderRequest) (*gctrpc.ConditionalOrdersResponse, error) {
+	cancelled, err := Bot.ConditionalOrderManager.Cancel(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return conditionalOrdersToRPC(cancelled), nil
+}
+
+func conditionalOrderFromRPC(r *gctrpc.AddConditionalOrderRequest) (*ConditionalOrder, error) {
+	if r == nil {
+		return nil, errors.New("conditional order request is nil")
+	}
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	orderType, err := order.StringToOrderType(r.OrderType)
+	if err != nil {
+		return nil, err
+	}
+	return &ConditionalOrder{
+		Exchange: r.Exchange,
+		Pair: currency.NewPairWithDelimiter(r.Pair.Base,
+			r.Pair.Quote, r.Pair.Delimiter),
+		Asset:            asset.Item(r.AssetType),
+		Side:             order.Side(r.Side),
+		Type:             orderType,
+		TriggerPrice:     r.TriggerPrice,
+		LimitPrice:       r.LimitPrice,
+		Amount:           r.Amount,
+		TrailingDistance: r.TrailingDistance,
+		TrailingPercent:  r.TrailingPercent,
+	}, nil
+}
+
+func conditionalOrdersToRPC(orders []ConditionalOrder) *gctrpc.ConditionalOrdersResponse {
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].CreatedAt.Before(orders[j].CreatedAt)
+	})
+	var resp gctrpc.ConditionalOrdersResponse
+	for x := range orders {
+		resp.Orders = append(resp.Orders, &gctrpc.ConditionalOrder{
+			Id:       orders[x].ID,
+			GroupId:  orders[x].GroupID,
+			ParentId: orders[x].ParentID,
+			Exchange: orders[x].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: orders[x].Pair.Delimiter,
+				Base:      orders[x].Pair.Base.String(),
+				Quote:     orders[x].Pair.Quote.String(),
+			},
+			AssetType:        orders[x].Asset.String(),
+			Side:             orders[x].Side.String(),
+			OrderType:        orders[x].Type.String(),
+			TriggerPrice:     orders[x].TriggerPrice,
+			LimitPrice:       orders[x].LimitPrice,
+			Amount:           orders[x].Amount,
+			TrailingDistance: orders[x].TrailingDistance,
+			TrailingPercent:  orders[x].TrailingPercent,
+			WatermarkPrice:   orders[x].WatermarkPrice,
+			Status:           string(orders[x].Status),
+			OrderId:          orders[x].OrderID,
+			Error:            orders[x].Error,
+			CreatedAt:        orders[x].CreatedAt.Unix(),
+			UpdatedAt:        orders[x].UpdatedAt.Unix(),
+		})
+	}
+	return &resp
+}
+
+// StartExecution starts working a parent order with the TWAP, VWAP or iceberg
+// execution algorithm
+func (s *RPCServer) StartExecution(ctx context.Context, r *gctrpc.StartExecutionRequest) (*gctrpc.ExecutionResponse, error) {
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	req := ExecutionRequest{
+		Algorithm: r.Algorithm,
+		Exchange:  r.Exchange,
+		Pair: currency.NewPairWithDelimiter(r.Pair.Base,
+			r.Pair.Quote, r.Pair.Delimiter),
+		Asset:         asset.Item(strings.ToLower(r.AssetType)),
+		Side:          order.Side(r.Side),
+		Amount:        r.Amount,
+		LimitPrice:    r.LimitPrice,
+		Slices:        int(r.Slices),
+		ProfileDays:   int(r.ProfileDays),
+		VisibleAmount: r.VisibleAmount,
+	}
+	var err error
+	if r.Start != "" {
+		req.Start, err = time.Parse(audit.TableTimeFormat, r.Start)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.End != "" {
+		req.End, err = time.Parse(audit.TableTimeFormat, r.End)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.Interval != "" {
+		req.Interval, err = kline.ParseInterval(r.Interval)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ex, err := Bot.ExecutionManager.StartExecution(&req)
+	if err != nil {
+		return nil, err
+	}
+	return executionToRPC(ex), nil
+}
+
+// PauseExecution stops an execution from submitting child orders
+func (s *RPCServer) PauseExecution(ctx context.Context, r *gctrpc.ExecutionIDRequest) (*gctrpc.ExecutionResponse, error) {
+	ex, err := Bot.ExecutionManager.Pause(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return executionToRPC(ex), nil
+}
+
+// ResumeExecution restarts a paused execution
+func (s *RPCServer) ResumeExecution(ctx context.Context, r *gctrpc.ExecutionIDRequest) (*gctrpc.ExecutionResponse, error) {
+	ex, err := Bot.ExecutionManager.Resume(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return executionToRPC(ex), nil
+}
+
+// CancelExecution stops an execution and cancels its open child orders
+func (s *RPCServer) CancelExecution(ctx context.Context, r *gctrpc.ExecutionIDRequest) (*gctrpc.ExecutionResponse, error) {
+	ex, err := Bot.ExecutionManager.Cancel(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return executionToRPC(ex), nil
+}
+
+// GetExecutions returns an execution by ID or all executions, filterable by
+// exchange
+func (s *RPCServer) GetExecutions(ctx context.Context, r *gctrpc.GetExecutionsRequest) (*gctrpc.GetExecutionsResponse, error) {
+	var resp gctrpc.GetExecutionsResponse
+	if r.Id != "" {
+		ex, err := Bot.ExecutionManager.GetExecution(r.Id)
+		if err != nil {
+			return nil, err
+		}
+		resp.Executions = append(resp.Executions, executionToRPC(ex))
+		return &resp, nil
+	}
+
+	executions := Bot.ExecutionManager.GetExecutions(r.Exchange)
+	sort.Slice(executions, func(i, j int) bool {
+		return executions[i].CreatedAt.Before(executions[j].CreatedAt)
+	})
+	for x := range executions {
+		resp.Executions = append(resp.Executions, executionToRPC(&executions[x]))
+	}
+	return &resp, nil
+}
+
+func executionToRPC(ex *Execution) *gctrpc.ExecutionResponse {
+	resp := &gctrpc.ExecutionResponse{
+		Id:        ex.ID,
+		Algorithm: ex.Algorithm,
+		Exchange:  ex.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: ex.Pair.Delimiter,
+			Base:      ex.Pair.Base.String(),
+			Quote:     ex.Pair.Quote.String(),
+		},
+		AssetType:       ex.Asset.String(),
+		Side:            ex.Side.String(),
+		Status:          ex.Status,
+		Amount:          ex.Amount,
+		LimitPrice:      ex.LimitPrice,
+		ExecutedAmount:  ex.ExecutedAmount,
+		RemainingAmount: ex.RemainingAmount,
+		AveragePrice:    ex.AveragePrice,
+		SlicesTotal:     int64(len(ex.Schedule)),
+		Start:           ex.Start.UTC().Format(audit.TableTimeFormat),
+		Error:           ex.Error,
+	}
+	if !ex.End.IsZero() {
+		resp.End = ex.End.UTC().Format(audit.TableTimeFormat)
+	}
+	for x := range ex.Schedule {
+		if ex.Schedule[x].Sent {
+			resp.SlicesSent++
+		}
+	}
+	for x := range ex.Children {
+		resp.Children = append(resp.Children, &gctrpc.ExecutionChildOrder{
+			OrderId:        ex.Children[x].OrderID,
+			Amount:         ex.Children[x].Amount,
+			ExecutedAmount: ex.Children[x].ExecutedAmount,
+			AveragePrice:   ex.Children[x].AveragePrice,
+			Status:         ex.Children[x].Status.String(),
+			SubmittedAt:    ex.Children[x].SubmittedAt.Unix(),
+			Error:          ex.Children[x].Error,
+		})
+	}
+	return resp
 }
 
 // GetEvents returns the stored events list
@@ -1255,6 +1644,55 @@ func (s *RPCServer) GetExchangeTickerStream(r *gctrpc.GetExchangeTickerStreamReq
 	}
 }
 
+// GetOrderUpdateStream streams order state changes tracked by the order
+// manager, filterable by exchange
+func (s *RPCServer) GetOrderUpdateStream(r *gctrpc.GetOrderUpdateStreamRequest, stream gctrpc.GoCryptoTrader_GetOrderUpdateStreamServer) error {
+	if !Bot.OrderManager.Started() {
+		return errors.New("order manager is not started")
+	}
+
+	pipe, err := Bot.OrderManager.SubscribeOrders(r.Exchange)
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		u := (*data.(*interface{})).(OrderUpdate)
+
+		err := stream.Send(&gctrpc.OrderUpdateResponse{
+			Order: &gctrpc.OrderDetails{
+				Exchange:      u.Detail.Exchange,
+				Id:            u.Detail.ID,
+				BaseCurrency:  u.Detail.CurrencyPair.Base.String(),
+				QuoteCurrency: u.Detail.CurrencyPair.Quote.String(),
+				AssetType:     u.Detail.AssetType.String(),
+				OrderSide:     u.Detail.OrderSide.String(),
+				OrderType:     u.Detail.OrderType.String(),
+				CreationTime:  u.Detail.OrderDate.Unix(),
+				Status:        u.Detail.Status.String(),
+				Price:         u.Detail.Price,
+				Amount:        u.Detail.Amount,
+				OpenVolume:    u.Detail.RemainingAmount,
+			},
+			InternalOrderId: u.Detail.InternalOrderID,
+			PreviousStatus:  u.PreviousStatus.String(),
+			ExecutedAmount:  u.Detail.ExecutedAmount,
+			RemainingAmount: u.Detail.RemainingAmount,
+			ExecutedDelta:   u.ExecutedDelta,
+			Fee:             u.Detail.Fee,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
 // GetAuditEvent returns matching audit events from database
 func (s *RPCServer) GetAuditEvent(ctx context.Context, r *gctrpc.GetAuditEventRequest) (*gctrpc.GetAuditEventResponse, error) {
 	UTCStartTime, err := time.Parse(audit.TableTimeFormat, r.StartDate)
@@ -1313,30 +1751,61 @@ func (s *RPCServer) GetHistoricCandles(ctx context.Context, req *gctrpc.GetHisto
 		return nil, errors.New(errCurrencyPairUnset)
 	}
 
+	if req.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	start, err := time.Parse(audit.TableTimeFormat, req.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := time.Parse(audit.TableTimeFormat, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := kline.ParseInterval(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
 	exchange := GetExchangeByName(req.Exchange)
 	if exchange == nil {
 		return nil, errors.New("Exchange " + req.Exchange + " not found")
 	}
 
-	candles, err := exchange.GetHistoricCandles(currency.Pair{
-		Delimiter: req.Pair.Delimiter,
-		Base:      currency.NewCode(req.Pair.Base),
-		Quote:     currency.NewCode(req.Pair.Quote),
-	}, req.Rangesize, req.Granularity)
+	candles, err := GetHistoricCandles(exchange,
+		currency.Pair{
+			Delimiter: req.Pair.Delimiter,
+			Base:      currency.NewCode(req.Pair.Base),
+			Quote:     currency.NewCode(req.Pair.Quote),
+		},
+		asset.Item(strings.ToLower(req.AssetType)),
+		start,
+		end,
+		interval)
 	if err != nil {
 		return nil, err
 	}
-	resp := gctrpc.GetHistoricCandlesResponse{}
-	for _, candle := range candles {
-		tempCandle := &gctrpc.Candle{
-			Time:   candle.Time,
-			Low:    candle.Low,
-			High:   candle.High,
-			Open:   candle.Open,
-			Close:  candle.Close,
-			Volume: candle.Volume,
-		}
-		resp.Candle = append(resp.Candle, tempCandle)
+
+	resp := gctrpc.GetHistoricCandlesResponse{
+		Exchange:  candles.Exchange,
+		Pair:      req.Pair,
+		AssetType: candles.Asset.String(),
+		Start:     req.Start,
+		End:       req.End,
+		Interval:  candles.Interval.Short(),
+	}
+	for x := range candles.Candles {
+		resp.Candle = append(resp.Candle, &gctrpc.Candle{
+			Time:   candles.Candles[x].Time.UTC().Format(audit.TableTimeFormat),
+			Low:    candles.Candles[x].Low,
+			High:   candles.Candles[x].High,
+			Open:   candles.Candles[x].Open,
+			Close:  candles.Candles[x].Close,
+			Volume: candles.Candles[x].Volume,
+		})
 	}
 	return &resp, nil
 }
@@ -1633,3 +2102,833 @@ func (s *RPCServer) GCTScriptAutoLoadToggle(ctx context.Context, r *gctrpc.GCTSc
 	}
 	return &gctrpc.GCTScriptGenericResponse{Status: "success", Data: "script " + r.Script + " added to autoload list"}, nil
 }
+
+// GCTScriptState returns the values stored by a script through the state
+// module, values for all scripts are returned if no script is specified
+func (s *RPCServer) GCTScriptState(ctx context.Context, r *gctrpc.GCTScriptStateRequest) (*gctrpc.GCTScriptStateResponse, error) {
+	if !gctscript.GCTScriptConfig.Enabled {
+		return &gctrpc.GCTScriptStateResponse{Status: gctscript.ErrScriptingDisabled.Error()}, nil
+	}
+
+	script := r.Script
+	if script != "" && filepath.Ext(script) != ".gct" {
+		script += ".gct"
+	}
+
+	values, err := gctscript.GetState(script)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GCTScriptStateResponse{Status: MsgStatusOK}
+	for x := range values {
+		resp.Values = append(resp.Values, &gctrpc.GCTScriptStateValue{
+			Script:    values[x].ScriptName,
+			Key:       values[x].Key,
+			Value:     values[x].Value,
+			UpdatedAt: values[x].UpdatedAt.UTC().Format(audit.TableTimeFormat),
+		})
+	}
+	return resp, nil
+}
+
+// GCTScriptTest runs scripts against a fixture of canned exchange data in place
+// of the live exchanges and reports if each passed along with the orders it
+// submitted
+func (s *RPCServer) GCTScriptTest(ctx context.Context, r *gctrpc.GCTScriptTestRequest) (*gctrpc.GCTScriptTestResponse, error) {
+	if !gctscript.GCTScriptConfig.Enabled {
+		return &gctrpc.GCTScriptTestResponse{Status: gctscript.ErrScriptingDisabled.Error()}, nil
+	}
+	if len(r.Scripts) == 0 {
+		return nil, errors.New("no scripts specified")
+	}
+	if r.Path == "" {
+		r.Path = gctscript.ScriptPath
+	}
+	if len(r.Fixture) == 0 {
+		r.Fixture = []byte("{}")
+	}
+
+	resp := &gctrpc.GCTScriptTestResponse{Status: MsgStatusOK}
+	for x := range r.Scripts {
+		// each script is served a fresh fixture so submitted orders are not
+		// shared between scripts
+		w, err := fixture.Load(r.Fixture)
+		if err != nil {
+			return nil, err
+		}
+		result, err := gctscript.Test(filepath.Join(r.Path, r.Scripts[x]), w)
+		if err != nil {
+			result = &gctscript.TestResult{Script: r.Scripts[x], Error: err.Error()}
+		}
+
+		t := &gctrpc.GCTScriptTestResult{
+			Script:     result.Script,
+			Passed:     result.Passed,
+			Assertions: int64(result.Assertions),
+			Failures:   result.Failures,
+			Error:      result.Error,
+		}
+		orders := w.Orders()
+		for y := range orders {
+			t.Orders = append(t.Orders, &gctrpc.OrderDetails{
+				Exchange:      orders[y].Exchange,
+				Id:            orders[y].ID,
+				BaseCurrency:  orders[y].CurrencyPair.Base.String(),
+				QuoteCurrency: orders[y].CurrencyPair.Quote.String(),
+				AssetType:     orders[y].AssetType.String(),
+				OrderSide:     orders[y].OrderSide.String(),
+				OrderType:     orders[y].OrderType.String(),
+				CreationTime:  orders[y].OrderDate.Unix(),
+				Status:        orders[y].Status.String(),
+				Price:         orders[y].Price,
+				Amount:        orders[y].Amount,
+				OpenVolume:    orders[y].RemainingAmount,
+			})
+		}
+		resp.Results = append(resp.Results, t)
+	}
+	return resp, nil
+}
+
+// StartCandleJob starts a job which downloads and stores historic candles for
+// any intervals missing from the database
+func (s *RPCServer) StartCandleJob(ctx context.Context, req *gctrpc.StartCandleJobRequest) (*gctrpc.CandleJob, error) {
+	if req.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	if req.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+
+	if req.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	start, err := time.Parse(audit.TableTimeFormat, req.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := time.Parse(audit.TableTimeFormat, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := kline.ParseInterval(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := Bot.CandleManager.StartJob(req.Exchange,
+		currency.Pair{
+			Delimiter: req.Pair.Delimiter,
+			Base:      currency.NewCode(req.Pair.Base),
+			Quote:     currency.NewCode(req.Pair.Quote),
+		},
+		asset.Item(strings.ToLower(req.AssetType)),
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+	return candleJobToRPC(j), nil
+}
+
+// GetCandleJobs returns the status of a candle job, or all candle jobs if no
+// ID is supplied
+func (s *RPCServer) GetCandleJobs(ctx context.Context, req *gctrpc.GetCandleJobsRequest) (*gctrpc.GetCandleJobsResponse, error) {
+	var resp gctrpc.GetCandleJobsResponse
+	if req.Id != "" {
+		j, err := Bot.CandleManager.GetJob(req.Id)
+		if err != nil {
+			return nil, err
+		}
+		resp.Jobs = append(resp.Jobs, candleJobToRPC(j))
+		return &resp, nil
+	}
+
+	jobs := Bot.CandleManager.GetJobs()
+	for x := range jobs {
+		resp.Jobs = append(resp.Jobs, candleJobToRPC(&jobs[x]))
+	}
+	return &resp, nil
+}
+
+// GetCandleCoverage returns the date ranges of stored and missing candles
+func (s *RPCServer) GetCandleCoverage(ctx context.Context, req *gctrpc.GetCandleCoverageRequest) (*gctrpc.GetCandleCoverageResponse, error) {
+	if req.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	if req.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+
+	if req.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	start, err := time.Parse(audit.TableTimeFormat, req.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := time.Parse(audit.TableTimeFormat, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := kline.ParseInterval(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	coverage, err := GetCandleCoverage(req.Exchange,
+		currency.Pair{
+			Delimiter: req.Pair.Delimiter,
+			Base:      currency.NewCode(req.Pair.Base),
+			Quote:     currency.NewCode(req.Pair.Quote),
+		},
+		asset.Item(strings.ToLower(req.AssetType)),
+		interval,
+		start,
+		end)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gctrpc.GetCandleCoverageResponse{
+		Exchange:      req.Exchange,
+		Pair:          req.Pair,
+		AssetType:     coverage.Asset.String(),
+		Interval:      coverage.Interval.Short(),
+		StoredCandles: coverage.StoredCandles,
+	}
+	for x := range coverage.Stored {
+		resp.Stored = append(resp.Stored, &gctrpc.CandleDateRange{
+			Start: coverage.Stored[x].Start.UTC().Format(audit.TableTimeFormat),
+			End:   coverage.Stored[x].End.UTC().Format(audit.TableTimeFormat),
+		})
+	}
+	for x := range coverage.Missing {
+		resp.Missing = append(resp.Missing, &gctrpc.CandleDateRange{
+			Start: coverage.Missing[x].Start.UTC().Format(audit.TableTimeFormat),
+			End:   coverage.Missing[x].End.UTC().Format(audit.TableTimeFormat),
+		})
+	}
+	return &resp, nil
+}
+
+func candleJobToRPC(j *CandleJob) *gctrpc.CandleJob {
+	uncovered := make([]*gctrpc.CandleDateRange, len(j.Uncovered))
+	for x := range j.Uncovered {
+		uncovered[x] = &gctrpc.CandleDateRange{
+			Start: j.Uncovered[x].Start.UTC().Format(audit.TableTimeFormat),
+			End:   j.Uncovered[x].End.UTC().Format(audit.TableTimeFormat),
+		}
+	}
+	return &gctrpc.CandleJob{
+		Id:       j.ID,
+		Exchange: j.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: j.Pair.Delimiter,
+			Base:      j.Pair.Base.String(),
+			Quote:     j.Pair.Quote.String(),
+		},
+		AssetType:      j.Asset.String(),
+		Interval:       j.Interval.Short(),
+		Start:          j.Start.UTC().Format(audit.TableTimeFormat),
+		End:            j.End.UTC().Format(audit.TableTimeFormat),
+		Status:         j.Status,
+		RangesTotal:    j.RangesTotal,
+		RangesComplete: j.RangesComplete,
+		CandlesSaved:   j.CandlesSaved,
+		Uncovered:      uncovered,
+		Error:          j.Error,
+		CreatedAt:      j.CreatedAt.UTC().Format(audit.TableTimeFormat),
+		UpdatedAt:      j.UpdatedAt.UTC().Format(audit.TableTimeFormat),
+	}
+}
+
+// GetArbitrageOpportunities returns the open cross exchange arbitrage
+// opportunities, filterable by currency pair
+func (s *RPCServer) GetArbitrageOpportunities(ctx context.Context, r *gctrpc.GetArbitrageOpportunitiesRequest) (*gctrpc.GetArbitrageOpportunitiesResponse, error) {
+	if !Bot.ArbitrageManager.Started() {
+		return nil, fmt.Errorf("%s %s", arbitrageManagerName, ErrSubSystemNotStarted)
+	}
+
+	p := currency.NewPairFromStrings(r.GetPair().GetBase(), r.GetPair().GetQuote())
+	opportunities := Bot.ArbitrageManager.GetOpportunities(p)
+	resp := &gctrpc.GetArbitrageOpportunitiesResponse{}
+	for x := range opportunities {
+		resp.Opportunities = append(resp.Opportunities,
+			arbitrageOpportunityToRPC(&opportunities[x]))
+	}
+	return resp, nil
+}
+
+// GetArbitrageStream streams cross exchange arbitrage opportunities each time
+// one opens or changes, filterable by currency pair
+func (s *RPCServer) GetArbitrageStream(r *gctrpc.GetArbitrageStreamRequest, stream gctrpc.GoCryptoTrader_GetArbitrageStreamServer) error {
+	pipe, err := Bot.ArbitrageManager.SubscribeOpportunities()
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	p := currency.NewPairFromStrings(r.GetPair().GetBase(), r.GetPair().GetQuote())
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		o := (*data.(*interface{})).(ArbitrageOpportunity)
+		if !p.IsEmpty() && !o.Pair.Equal(p) {
+			continue
+		}
+
+		err := stream.Send(arbitrageOpportunityToRPC(&o))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func arbitrageOpportunityToRPC(o *ArbitrageOpportunity) *gctrpc.ArbitrageOpportunity {
+	return &gctrpc.ArbitrageOpportunity{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: o.Pair.Delimiter,
+			Base:      o.Pair.Base.String(),
+			Quote:     o.Pair.Quote.String(),
+		},
+		BuyExchange:      o.BuyExchange,
+		SellExchange:     o.SellExchange,
+		Amount:           o.Amount,
+		BuyPrice:         o.BuyPrice,
+		SellPrice:        o.SellPrice,
+		BuyLimit:         o.BuyLimit,
+		SellLimit:        o.SellLimit,
+		Cost:             o.Cost,
+		Proceeds:         o.Proceeds,
+		TradingFees:      o.TradingFees,
+		WithdrawalFees:   o.WithdrawalFees,
+		NetProfit:        o.NetProfit,
+		NetProfitPercent: o.NetProfitPercent,
+		Timestamp:        o.Time.Unix(),
+	}
+}
+
+// GetTriangularArbitrage returns the three leg cycles between an exchange's
+// enabled currency pairs which return more than the minimum return after
+// taker fees, the configured minimum is used when it is not set
+func (s *RPCServer) GetTriangularArbitrage(ctx context.Context, r *gctrpc.GetTriangularArbitrageRequest) (*gctrpc.GetTriangularArbitrageResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	minReturn := r.MinReturnPercent
+	if minReturn <= 0 {
+		minReturn = Bot.Config.Arbitrage.Triangular.MinReturnPercent
+	}
+	cycles, err := FindTriangularArbitrage(r.Exchange, minReturn)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetTriangularArbitrageResponse{}
+	for x := range cycles {
+		c := &gctrpc.TriangularArbitrageCycle{
+			Exchange:         cycles[x].Exchange,
+			Input:            cycles[x].Input,
+			Output:           cycles[x].Output,
+			NetReturnPercent: cycles[x].NetReturnPercent,
+			Timestamp:        cycles[x].Time.Unix(),
+		}
+		for y := range cycles[x].Currencies {
+			c.Currencies = append(c.Currencies, cycles[x].Currencies[y].String())
+		}
+		for y := range cycles[x].Legs {
+			l := &cycles[x].Legs[y]
+			c.Legs = append(c.Legs, &gctrpc.TriangularArbitrageLeg{
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: l.Pair.Delimiter,
+					Base:      l.Pair.Base.String(),
+					Quote:     l.Pair.Quote.String(),
+				},
+				Side:       l.Side.String(),
+				From:       l.From.String(),
+				To:         l.To.String(),
+				Input:      l.Input,
+				Output:     l.Output,
+				Amount:     l.Amount,
+				Price:      l.Price,
+				LimitPrice: l.LimitPrice,
+				FeeRate:    l.FeeRate,
+			})
+		}
+		resp.Cycles = append(resp.Cycles, c)
+	}
+	return resp, nil
+}
+
+// RouteOrder splits an order across the enabled exchanges with the best fee
+// adjusted liquidity for the pair and returns the allocation
+func (s *RPCServer) RouteOrder(ctx context.Context, r *gctrpc.RouteOrderRequest) (*gctrpc.RouteResponse, error) {
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	rt, err := Bot.OrderRouter.Route(&RouteRequest{
+		Submit: order.Submit{
+			Pair: currency.NewPairWithDelimiter(r.Pair.Base,
+				r.Pair.Quote, r.Pair.Delimiter),
+			OrderSide: order.Side(r.Side),
+			OrderType: order.Type(r.OrderType),
+			Amount:    r.Amount,
+			Price:     r.Price,
+		},
+		Exchanges: r.Exchanges,
+		DryRun:    r.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return routeToRPC(rt), nil
+}
+
+// GetRoutes returns a routed order by its ID or every routed order with the
+// aggregate fill of their child orders
+func (s *RPCServer) GetRoutes(ctx context.Context, r *gctrpc.GetRoutesRequest) (*gctrpc.GetRoutesResponse, error) {
+	var resp gctrpc.GetRoutesResponse
+	if r.Id != "" {
+		rt, err := Bot.OrderRouter.GetRoute(r.Id)
+		if err != nil {
+			return nil, err
+		}
+		resp.Routes = append(resp.Routes, routeToRPC(rt))
+		return &resp, nil
+	}
+
+	routes := Bot.OrderRouter.GetRoutes()
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].CreatedAt.Before(routes[j].CreatedAt)
+	})
+	for x := range routes {
+		resp.Routes = append(resp.Routes, routeToRPC(&routes[x]))
+	}
+	return &resp, nil
+}
+
+func routeToRPC(rt *RoutedOrder) *gctrpc.RouteResponse {
+	resp := &gctrpc.RouteResponse{
+		Id: rt.ID,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: rt.Pair.Delimiter,
+			Base:      rt.Pair.Base.String(),
+			Quote:     rt.Pair.Quote.String(),
+		},
+		Side:           rt.Side.String(),
+		OrderType:      rt.OrderType.String(),
+		Amount:         rt.Amount,
+		Allocated:      rt.Allocated,
+		Cost:           rt.Cost,
+		Fees:           rt.Fees,
+		AveragePrice:   rt.AveragePrice,
+		EffectivePrice: rt.EffectivePrice,
+		ExecutedAmount: rt.ExecutedAmount,
+		FillPrice:      rt.FillPrice,
+		Status:         rt.Status,
+		DryRun:         rt.DryRun,
+		CreatedAt:      rt.CreatedAt.Unix(),
+	}
+	for x := range rt.Allocations {
+		a := &rt.Allocations[x]
+		resp.Allocations = append(resp.Allocations, &gctrpc.RouteAllocation{
+			Exchange:       a.Exchange,
+			Amount:         a.Amount,
+			Price:          a.Price,
+			LimitPrice:     a.LimitPrice,
+			Cost:           a.Cost,
+			Fee:            a.Fee,
+			FeeRate:        a.FeeRate,
+			OrderId:        a.OrderID,
+			Status:         a.Status.String(),
+			ExecutedAmount: a.ExecutedAmount,
+			FillPrice:      a.FillPrice,
+			Error:          a.Error,
+		})
+	}
+	for x := range rt.Excluded {
+		resp.Excluded = append(resp.Excluded, &gctrpc.RouteExclusion{
+			Exchange: rt.Excluded[x].Exchange,
+			Reason:   rt.Excluded[x].Reason,
+		})
+	}
+	return resp
+}
+
+// GetAggregatedOrderbook returns the merged orderbook of a currency pair
+// across every enabled exchange
+func (s *RPCServer) GetAggregatedOrderbook(ctx context.Context, r *gctrpc.GetAggregatedOrderbookRequest) (*gctrpc.AggregatedOrderbookResponse, error) {
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	ob, err := Bot.OrderbookAggregator.GetAggregatedOrderbook(
+		currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter),
+		r.Convert)
+	if err != nil {
+		return nil, err
+	}
+	return aggregatedOrderbookToRPC(ob, int(r.Depth)), nil
+}
+
+// GetAggregatedOrderbookStream streams the merged orderbook of a currency pair
+// each time one of its exchange orderbooks updates
+func (s *RPCServer) GetAggregatedOrderbookStream(r *gctrpc.GetAggregatedOrderbookRequest, stream gctrpc.GoCryptoTrader_GetAggregatedOrderbookStreamServer) error {
+	if r.Pair == nil {
+		return errors.New(errCurrencyPairUnset)
+	}
+	pipe, err := Bot.OrderbookAggregator.SubscribeAggregatedOrderbook(
+		currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter),
+		r.Convert)
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+
+		ob := (*data.(*interface{})).(AggregatedOrderbook)
+		err := stream.Send(aggregatedOrderbookToRPC(&ob, int(r.Depth)))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// aggregatedOrderbookToRPC converts the aggregated orderbook limiting each
+// side to depth levels when depth is set
+func aggregatedOrderbookToRPC(ob *AggregatedOrderbook, depth int) *gctrpc.AggregatedOrderbookResponse {
+	resp := &gctrpc.AggregatedOrderbookResponse{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: ob.Pair.Delimiter,
+			Base:      ob.Pair.Base.String(),
+			Quote:     ob.Pair.Quote.String(),
+		},
+		Converted:   ob.Converted,
+		Exchanges:   ob.Exchanges,
+		LastUpdated: ob.LastUpdated.Unix(),
+	}
+	levels := func(items []AggregatedOrderbookItem) []*gctrpc.AggregatedOrderbookItem {
+		if depth > 0 && len(items) > depth {
+			items = items[:depth]
+		}
+		converted := make([]*gctrpc.AggregatedOrderbookItem, len(items))
+		for x := range items {
+			converted[x] = &gctrpc.AggregatedOrderbookItem{
+				Exchange: items[x].Exchange,
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: items[x].Pair.Delimiter,
+					Base:      items[x].Pair.Base.String(),
+					Quote:     items[x].Pair.Quote.String(),
+				},
+				Amount:        items[x].Amount,
+				Price:         items[x].Price,
+				OriginalPrice: items[x].OriginalPrice,
+			}
+		}
+		return converted
+	}
+	resp.Bids = levels(ob.Bids)
+	resp.Asks = levels(ob.Asks)
+	return resp
+}
+
+// GetPositions returns the open derivatives positions of an exchange's asset
+// type, filtered to a currency pair when it is set
+func (s *RPCServer) GetPositions(ctx context.Context, r *gctrpc.GetPositionsRequest) (*gctrpc.GetPositionsResponse, error) {
+	exch, a, err := derivativesExchange(r.Exchange, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := exch.GetPositions(a)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetPositionsResponse{}
+	for x := range positions {
+		p := &positions[x]
+		if r.Pair != nil && r.Pair.String() != "" &&
+			!p.Pair.Equal(currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)) {
+			continue
+		}
+		resp.Positions = append(resp.Positions, &gctrpc.PositionDetails{
+			Exchange:  p.Exchange,
+			AssetType: p.AssetType.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Pair.Delimiter,
+				Base:      p.Pair.Base.String(),
+				Quote:     p.Pair.Quote.String(),
+			},
+			Side:             p.Side.String(),
+			Amount:           p.Amount,
+			AvailableAmount:  p.AvailableAmount,
+			EntryPrice:       p.EntryPrice,
+			MarkPrice:        p.MarkPrice,
+			LiquidationPrice: p.LiquidationPrice,
+			Leverage:         p.Leverage,
+			MarginMode:       p.MarginMode.String(),
+			Margin:           p.Margin,
+			UnrealisedPnl:    p.UnrealisedPNL,
+			RealisedPnl:      p.RealisedPNL,
+			UpdatedAt:        p.UpdatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (s *RPCServer) SetLeverage(ctx context.Context, r *gctrpc.SetLeverageRequest) (*gctrpc.SetLeverageResponse, error) {
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	exch, a, err := derivativesExchange(r.Exchange, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	err = exch.SetLeverage(currency.NewPairWithDelimiter(r.Pair.Base,
+		r.Pair.Quote, r.Pair.Delimiter), a, r.Leverage)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.SetLeverageResponse{}, nil
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (s *RPCServer) SetMarginMode(ctx context.Context, r *gctrpc.SetMarginModeRequest) (*gctrpc.SetMarginModeResponse, error) {
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	mode, err := position.StringToMarginMode(r.MarginMode)
+	if err != nil {
+		return nil, err
+	}
+	exch, a, err := derivativesExchange(r.Exchange, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	err = exch.SetMarginMode(currency.NewPairWithDelimiter(r.Pair.Base,
+		r.Pair.Quote, r.Pair.Delimiter), a, mode)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.SetMarginModeResponse{}, nil
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (s *RPCServer) GetFundingRates(ctx context.Context, r *gctrpc.GetFundingRatesRequest) (*gctrpc.GetFundingRatesResponse, error) {
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	exch, a, err := derivativesExchange(r.Exchange, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := exch.GetFundingRates(currency.NewPairWithDelimiter(r.Pair.Base,
+		r.Pair.Quote, r.Pair.Delimiter), a)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetFundingRatesResponse{
+		Exchange:  exch.GetName(),
+		Pair:      r.Pair,
+		AssetType: a.String(),
+	}
+	for x := range rates {
+		resp.Rates = append(resp.Rates, &gctrpc.FundingRate{
+			Rate: rates[x].Rate,
+			Time: rates[x].Time.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// ClosePosition closes a derivatives position at market price, the side is
+// only required when both sides of the pair are open
+func (s *RPCServer) ClosePosition(ctx context.Context, r *gctrpc.ClosePositionRequest) (*gctrpc.SubmitOrderResponse, error) {
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	var side position.Side
+	if r.Side != "" {
+		var err error
+		side, err = position.StringToSide(r.Side)
+		if err != nil {
+			return nil, err
+		}
+	}
+	exch, a, err := derivativesExchange(r.Exchange, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	p := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+	resp, err := exch.ClosePosition(p, a, side)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof(log.OrderMgr, "Exchange %s closed %s %s position, order ID %s.\n",
+		exch.GetName(), p, a, resp.OrderID)
+	return &gctrpc.SubmitOrderResponse{
+		OrderPlaced: resp.IsOrderPlaced,
+		OrderId:     resp.OrderID,
+	}, nil
+}
+
+// derivativesExchange returns the loaded exchange and its supported asset
+// type for a derivatives request
+func derivativesExchange(exchName, assetType string) (exchange.IBotExchange, asset.Item, error) {
+	if exchName == "" {
+		return nil, "", errors.New(errExchangeNameUnset)
+	}
+	if assetType == "" {
+		return nil, "", errors.New(errAssetTypeUnset)
+	}
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, "", errors.New("exchange is not loaded/doesn't exist")
+	}
+	a := asset.Item(strings.ToLower(assetType))
+	if !exch.SupportsAsset(a) {
+		return nil, "", fmt.Errorf("%s does not support asset type %s",
+			exch.GetName(), a)
+	}
+	return exch, a, nil
+}
+
+// GetTradingRules returns the price tick, amount step and order size limits an
+// exchange enforces on a currency pair, the asset type defaults to spot
+func (s *RPCServer) GetTradingRules(ctx context.Context, r *gctrpc.GetTradingRulesRequest) (*gctrpc.TradingRules, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+	a := asset.Spot
+	if r.AssetType != "" {
+		a = asset.Item(strings.ToLower(r.AssetType))
+	}
+
+	rules, err := exch.GetTradingRules(currency.NewPairWithDelimiter(r.Pair.Base,
+		r.Pair.Quote, r.Pair.Delimiter), a)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.TradingRules{
+		Exchange:    exch.GetName(),
+		Pair:        r.Pair,
+		AssetType:   a.String(),
+		PriceTick:   rules.PriceTick,
+		AmountStep:  rules.AmountStep,
+		MinAmount:   rules.MinAmount,
+		MaxAmount:   rules.MaxAmount,
+		MinNotional: rules.MinNotional,
+	}, nil
+}
+
+// SubmitOrders submits a batch of orders to an exchange together, the orders
+// are all submitted to the exchange of the request
+func (s *RPCServer) SubmitOrders(ctx context.Context, r *gctrpc.SubmitOrdersRequest) (*gctrpc.SubmitOrdersResponse, error) {
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+
+	submissions := make([]order.Submit, len(r.Orders))
+	for x := range r.Orders {
+		if r.Orders[x].Pair == nil {
+			return nil, errors.New(errCurrencyPairUnset)
+		}
+		submissions[x] = order.Submit{
+			Pair:      currency.NewPairFromStrings(r.Orders[x].Pair.Base, r.Orders[x].Pair.Quote),
+			AssetType: asset.Item(r.Orders[x].AssetType),
+			OrderSide: order.Side(r.Orders[x].Side),
+			OrderType: order.Type(r.Orders[x].OrderType),
+			Amount:    r.Orders[x].Amount,
+			Price:     r.Orders[x].Price,
+			ClientID:  r.Orders[x].ClientId,
+		}
+	}
+
+	resp := &gctrpc.SubmitOrdersResponse{}
+	if !Bot.OrderManager.Started() {
+		results, err := exchange.SubmitBatch(exch, submissions)
+		for x := range results {
+			resp.Orders = append(resp.Orders, &gctrpc.SubmitOrderResponse{
+				OrderId:     results[x].OrderID,
+				OrderPlaced: results[x].IsOrderPlaced,
+			})
+		}
+		return resp, err
+	}
+
+	results, err := Bot.OrderManager.SubmitOrders(exch.GetName(), submissions)
+	for x := range results {
+		resp.Orders = append(resp.Orders, &gctrpc.SubmitOrderResponse{
+			OrderId:     results[x].OrderID,
+			OrderPlaced: results[x].IsOrderPlaced,
+		})
+	}
+	return resp, err
+}
+
+// CancelOrders cancels a batch of orders on an exchange together, the orders
+// which failed to cancel are returned with their error
+func (s *RPCServer) CancelOrders(ctx context.Context, r *gctrpc.CancelOrdersRequest) (*gctrpc.CancelOrdersResponse, error) {
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+
+	cancels := make([]order.Cancel, len(r.Orders))
+	for x := range r.Orders {
+		cancels[x] = order.Cancel{
+			AccountID:     r.Orders[x].AccountId,
+			OrderID:       r.Orders[x].OrderId,
+			AssetType:     asset.Item(r.Orders[x].AssetType),
+			Side:          order.Side(r.Orders[x].Side),
+			WalletAddress: r.Orders[x].WalletAddress,
+		}
+		if r.Orders[x].Pair != nil {
+			cancels[x].CurrencyPair = currency.NewPairWithDelimiter(r.Orders[x].Pair.Base,
+				r.Orders[x].Pair.Quote, r.Orders[x].Pair.Delimiter)
+		}
+	}
+
+	var result order.CancelAllResponse
+	var err error
+	if Bot.OrderManager.Started() {
+		result, err = Bot.OrderManager.CancelOrders(exch.GetName(), cancels)
+	} else {
+		result, err = exchange.CancelBatch(exch, cancels)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.CancelOrdersResponse{OrderStatus: result.Status}, nil
+}
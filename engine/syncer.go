@@ -6,8 +6,13 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	derivativesRepo "github.com/thrasher-corp/gocryptotrader/database/repository/derivatives"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -17,9 +22,11 @@ const (
 	SyncItemTicker = iota
 	SyncItemOrderbook
 	SyncItemTrade
+	SyncItemDerivatives
 
-	DefaultSyncerWorkers = 15
-	DefaultSyncerTimeout = time.Second * 15
+	DefaultSyncerWorkers           = 15
+	DefaultSyncerTimeout           = time.Second * 15
+	DefaultDerivativesSyncInterval = time.Minute
 )
 
 var (
@@ -29,7 +36,7 @@ var (
 
 // NewCurrencyPairSyncer starts a new CurrencyPairSyncer
 func NewCurrencyPairSyncer(c CurrencyPairSyncerConfig) (*ExchangeCurrencyPairSyncer, error) {
-	if !c.SyncOrderbook && !c.SyncTicker && !c.SyncTrades {
+	if !c.SyncOrderbook && !c.SyncTicker && !c.SyncTrades && !c.SyncDerivatives {
 		return nil, errors.New("no sync items enabled")
 	}
 
@@ -41,24 +48,34 @@ func NewCurrencyPairSyncer(c CurrencyPairSyncerConfig) (*ExchangeCurrencyPairSyn
 		c.SyncTimeout = DefaultSyncerTimeout
 	}
 
+	if c.DerivativesSyncInterval <= time.Duration(0) {
+		c.DerivativesSyncInterval = DefaultDerivativesSyncInterval
+	}
+
 	s := ExchangeCurrencyPairSyncer{
 		Cfg: CurrencyPairSyncerConfig{
-			SyncTicker:       c.SyncTicker,
-			SyncOrderbook:    c.SyncOrderbook,
-			SyncTrades:       c.SyncTrades,
-			SyncContinuously: c.SyncContinuously,
-			SyncTimeout:      c.SyncTimeout,
-			NumWorkers:       c.NumWorkers,
+			SyncTicker:              c.SyncTicker,
+			SyncOrderbook:           c.SyncOrderbook,
+			SyncTrades:              c.SyncTrades,
+			SyncDerivatives:         c.SyncDerivatives,
+			SyncContinuously:        c.SyncContinuously,
+			SyncTimeout:             c.SyncTimeout,
+			DerivativesSyncInterval: c.DerivativesSyncInterval,
+			NumWorkers:              c.NumWorkers,
 		},
 	}
 
 	s.tickerBatchLastRequested = make(map[string]time.Time)
+	s.tradeHistoryUnsupported = make(map[string]bool)
+	s.derivativesUnsupported = make(map[string]bool)
 
 	log.Debugf(log.SyncMgr,
 		"Exchange currency pair syncer config: continuous: %v ticker: %v"+
-			" orderbook: %v trades: %v workers: %v verbose: %v timeout: %v\n",
+			" orderbook: %v trades: %v derivatives: %v workers: %v verbose: %v"+
+			" timeout: %v derivatives interval: %v\n",
 		s.Cfg.SyncContinuously, s.Cfg.SyncTicker, s.Cfg.SyncOrderbook,
-		s.Cfg.SyncTrades, s.Cfg.NumWorkers, s.Cfg.Verbose, s.Cfg.SyncTimeout)
+		s.Cfg.SyncTrades, s.Cfg.SyncDerivatives, s.Cfg.NumWorkers, s.Cfg.Verbose,
+		s.Cfg.SyncTimeout, s.Cfg.DerivativesSyncInterval)
 	return &s, nil
 }
 
@@ -134,6 +151,18 @@ func (e *ExchangeCurrencyPairSyncer) add(c *CurrencyPairSyncAgent) {
 		}
 	}
 
+	if e.Cfg.SyncDerivatives && c.AssetType.IsDerivative() {
+		if e.Cfg.Verbose {
+			log.Debugf(log.SyncMgr,
+				"%s: Added derivatives sync item %v %s\n",
+				c.Exchange, FormatCurrency(c.Pair).String(), c.AssetType)
+		}
+		if atomic.LoadInt32(&e.initSyncCompleted) != 1 {
+			e.initSyncWG.Add(1)
+			createdCounter++
+		}
+	}
+
 	c.Created = time.Now()
 	e.CurrencyPairs = append(e.CurrencyPairs, *c)
 }
@@ -167,6 +196,8 @@ func (e *ExchangeCurrencyPairSyncer) isProcessing(exchangeName string, p currenc
 				return e.CurrencyPairs[x].Orderbook.IsProcessing
 			case SyncItemTrade:
 				return e.CurrencyPairs[x].Trade.IsProcessing
+			case SyncItemDerivatives:
+				return e.CurrencyPairs[x].Derivatives.IsProcessing
 			}
 		}
 	}
@@ -189,6 +220,8 @@ func (e *ExchangeCurrencyPairSyncer) setProcessing(exchangeName string, p curren
 				e.CurrencyPairs[x].Orderbook.IsProcessing = processing
 			case SyncItemTrade:
 				e.CurrencyPairs[x].Trade.IsProcessing = processing
+			case SyncItemDerivatives:
+				e.CurrencyPairs[x].Derivatives.IsProcessing = processing
 			}
 		}
 	}
@@ -200,7 +233,7 @@ func (e *ExchangeCurrencyPairSyncer) update(exchangeName string, p currency.Pair
 	}
 
 	switch syncType {
-	case SyncItemOrderbook, SyncItemTrade, SyncItemTicker:
+	case SyncItemOrderbook, SyncItemTrade, SyncItemTicker, SyncItemDerivatives:
 		if !e.Cfg.SyncOrderbook && syncType == SyncItemOrderbook {
 			return
 		}
@@ -212,6 +245,10 @@ func (e *ExchangeCurrencyPairSyncer) update(exchangeName string, p currency.Pair
 		if !e.Cfg.SyncTrades && syncType == SyncItemTrade {
 			return
 		}
+
+		if !e.Cfg.SyncDerivatives && syncType == SyncItemDerivatives {
+			return
+		}
 	default:
 		log.Warnf(log.SyncMgr, "ExchangeCurrencyPairSyncer: unknown sync item %v\n", syncType)
 		return
@@ -269,11 +306,159 @@ func (e *ExchangeCurrencyPairSyncer) update(exchangeName string, p currency.Pair
 						exchangeName, FormatCurrency(p).String(), removedCounter, createdCounter)
 					e.initSyncWG.Done()
 				}
+
+			case SyncItemDerivatives:
+				origHadData := e.CurrencyPairs[x].Derivatives.HaveData
+				e.CurrencyPairs[x].Derivatives.LastUpdated = time.Now()
+				if err != nil {
+					e.CurrencyPairs[x].Derivatives.NumErrors++
+				}
+				e.CurrencyPairs[x].Derivatives.HaveData = true
+				e.CurrencyPairs[x].Derivatives.IsProcessing = false
+				if atomic.LoadInt32(&e.initSyncCompleted) != 1 && !origHadData {
+					removedCounter++
+					log.Debugf(log.SyncMgr, "%s derivatives sync complete %v [%d/%d].\n",
+						exchangeName, FormatCurrency(p).String(), removedCounter, createdCounter)
+					e.initSyncWG.Done()
+				}
 			}
 		}
 	}
 }
 
+// syncTradeHistory fetches recent trades over REST and passes them to the
+// trade recorder. Exchanges which do not implement GetExchangeHistory are
+// only warned about once and skipped from then on
+func (e *ExchangeCurrencyPairSyncer) syncTradeHistory(exch exchange.IBotExchange, p currency.Pair, a asset.Item) error {
+	exchName := exch.GetName()
+	e.mux.Lock()
+	unsupported := e.tradeHistoryUnsupported[exchName]
+	e.mux.Unlock()
+	if unsupported {
+		return nil
+	}
+
+	trades, err := exch.GetExchangeHistory(p, a)
+	if err != nil {
+		if err == common.ErrNotYetImplemented || err == common.ErrFunctionNotSupported {
+			e.mux.Lock()
+			e.tradeHistoryUnsupported[exchName] = true
+			e.mux.Unlock()
+			log.Warnf(log.SyncMgr,
+				"%s does not support REST trade history, trades will only be synced over websocket\n",
+				exchName)
+			return nil
+		}
+		log.Errorf(log.SyncMgr, "%s %s %s: failed to sync trades. Err: %s\n",
+			exchName,
+			FormatCurrency(p).String(),
+			strings.ToUpper(a.String()),
+			err)
+		return err
+	}
+
+	if e.Cfg.Verbose {
+		log.Debugf(log.SyncMgr, "%s %s %s: REST trade sync returned %d trades\n",
+			exchName,
+			FormatCurrency(p).String(),
+			strings.ToUpper(a.String()),
+			len(trades))
+	}
+	Bot.TradeRecorder.RecordTradeHistory(exchName, p, a, trades)
+	return nil
+}
+
+// syncDerivativesStats fetches the funding, pricing and open interest stats of
+// a contract, publishing them over dispatch, and stores them when the database
+// is connected. Exchanges which do not support an asset type are only warned
+// about once and skipped from then on
+func (e *ExchangeCurrencyPairSyncer) syncDerivativesStats(exch exchange.IBotExchange, p currency.Pair, a asset.Item) error {
+	exchName := exch.GetName()
+	key := exchName + a.String()
+	e.mux.Lock()
+	unsupported := e.derivativesUnsupported[key]
+	e.mux.Unlock()
+	if unsupported {
+		return nil
+	}
+
+	stats, err := exch.UpdateDerivativesStats(p, a)
+	if err != nil {
+		if err == common.ErrNotYetImplemented || err == common.ErrFunctionNotSupported {
+			e.mux.Lock()
+			e.derivativesUnsupported[key] = true
+			e.mux.Unlock()
+			log.Warnf(log.SyncMgr,
+				"%s does not support %s derivatives stats, they will not be synced\n",
+				exchName,
+				strings.ToUpper(a.String()))
+			return nil
+		}
+		log.Errorf(log.SyncMgr, "%s %s %s: failed to sync derivatives stats. Err: %s\n",
+			exchName,
+			FormatCurrency(p).String(),
+			strings.ToUpper(a.String()),
+			err)
+		return err
+	}
+
+	if e.Cfg.Verbose {
+		log.Debugf(log.SyncMgr, "%s %s %s: funding rate %v predicted %v mark %v index %v open interest %v\n",
+			exchName,
+			FormatCurrency(p).String(),
+			strings.ToUpper(a.String()),
+			stats.FundingRate,
+			stats.PredictedFundingRate,
+			stats.MarkPrice,
+			stats.IndexPrice,
+			stats.OpenInterest)
+	}
+	return storeDerivativesStats(stats)
+}
+
+// storeDerivativesStats stores the contract stats and, for perpetual
+// contracts which report a next funding time, the funding rate
+func storeDerivativesStats(stats *derivatives.Stats) error {
+	if !database.DB.Connected {
+		return nil
+	}
+
+	_, err := derivativesRepo.InsertContractStats(derivativesRepo.ContractStat{
+		ExchangeName: stats.Exchange,
+		Base:         stats.Pair.Base.String(),
+		Quote:        stats.Pair.Quote.String(),
+		Asset:        stats.AssetType.String(),
+		MarkPrice:    stats.MarkPrice,
+		IndexPrice:   stats.IndexPrice,
+		OpenInterest: stats.OpenInterest,
+		Timestamp:    stats.LastUpdated,
+	})
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "%s %s %s: failed to store contract stats. Err: %s\n",
+			stats.Exchange, stats.Pair, stats.AssetType, err)
+		return err
+	}
+
+	if stats.NextFundingTime.IsZero() {
+		return nil
+	}
+	_, err = derivativesRepo.InsertFundingRates(derivativesRepo.FundingRate{
+		ExchangeName:  stats.Exchange,
+		Base:          stats.Pair.Base.String(),
+		Quote:         stats.Pair.Quote.String(),
+		Asset:         stats.AssetType.String(),
+		Rate:          stats.FundingRate,
+		PredictedRate: stats.PredictedFundingRate,
+		FundingTime:   stats.NextFundingTime,
+		Timestamp:     stats.LastUpdated,
+	})
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "%s %s %s: failed to store funding rate. Err: %s\n",
+			stats.Exchange, stats.Pair, stats.AssetType, err)
+	}
+	return err
+}
+
 func (e *ExchangeCurrencyPairSyncer) worker() {
 	cleanup := func() {
 		log.Debugln(log.SyncMgr, "Exchange CurrencyPairSyncer worker shutting down.")
@@ -345,6 +530,12 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 							}
 						}
 
+						if e.Cfg.SyncDerivatives && c.AssetType.IsDerivative() {
+							c.Derivatives = SyncBase{
+								IsUsingREST: true,
+							}
+						}
+
 						e.add(&c)
 					}
 
@@ -465,12 +656,48 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 								time.Sleep(time.Millisecond * 50)
 							}
 						}
-						if e.Cfg.SyncTrades {
-							if !e.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemTrade) {
-								if c.Trade.LastUpdated.IsZero() || time.Since(c.Trade.LastUpdated) > e.Cfg.SyncTimeout {
-									e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, true)
-									e.update(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, nil)
+					}
+
+					if e.Cfg.SyncTrades {
+						if !e.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemTrade) {
+							if c.Trade.LastUpdated.IsZero() || time.Since(c.Trade.LastUpdated) > e.Cfg.SyncTimeout {
+								if c.Trade.IsUsingWebsocket {
+									if time.Since(c.Created) < e.Cfg.SyncTimeout {
+										continue
+									}
+									if supportsREST {
+										e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, true)
+										c.Trade.IsUsingWebsocket = false
+										c.Trade.IsUsingREST = true
+										log.Warnf(log.SyncMgr,
+											"%s %s %s: No trade update after %s, switching from websocket to rest\n",
+											c.Exchange,
+											FormatCurrency(c.Pair).String(),
+											strings.ToUpper(c.AssetType.String()),
+											e.Cfg.SyncTimeout,
+										)
+										switchedToRest = true
+										e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, false)
+									}
 								}
+
+								e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, true)
+								err = e.syncTradeHistory(Bot.Exchanges[x], c.Pair, c.AssetType)
+								e.update(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, err)
+							} else {
+								time.Sleep(time.Millisecond * 50)
+							}
+						}
+					}
+
+					if e.Cfg.SyncDerivatives && c.Derivatives.IsUsingREST {
+						if !e.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemDerivatives) {
+							if c.Derivatives.LastUpdated.IsZero() || time.Since(c.Derivatives.LastUpdated) > e.Cfg.DerivativesSyncInterval {
+								e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemDerivatives, true)
+								err = e.syncDerivativesStats(Bot.Exchanges[x], c.Pair, c.AssetType)
+								e.update(c.Exchange, c.Pair, c.AssetType, SyncItemDerivatives, err)
+							} else {
+								time.Sleep(time.Millisecond * 50)
 							}
 						}
 					}
@@ -563,6 +790,12 @@ func (e *ExchangeCurrencyPairSyncer) Start() {
 					}
 				}
 
+				if e.Cfg.SyncDerivatives && c.AssetType.IsDerivative() {
+					c.Derivatives = SyncBase{
+						IsUsingREST: true,
+					}
+				}
+
 				e.add(&c)
 			}
 		}
@@ -43,3 +43,18 @@ func TestNewCurrencyPairSyncer(t *testing.T) {
 	time.Sleep(time.Second * 15)
 	Bot.ExchangeCurrencyPairManager.Stop()
 }
+
+func TestNewCurrencyPairSyncerDerivatives(t *testing.T) {
+	if _, err := NewCurrencyPairSyncer(CurrencyPairSyncerConfig{}); err == nil {
+		t.Error("expected an error when no sync items are enabled")
+	}
+
+	s, err := NewCurrencyPairSyncer(CurrencyPairSyncerConfig{SyncDerivatives: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.Cfg.SyncDerivatives ||
+		s.Cfg.DerivativesSyncInterval != DefaultDerivativesSyncInterval {
+		t.Errorf("unexpected syncer config %+v", s.Cfg)
+	}
+}
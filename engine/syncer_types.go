@@ -10,13 +10,15 @@ import (
 
 // CurrencyPairSyncerConfig stores the currency pair config
 type CurrencyPairSyncerConfig struct {
-	SyncTicker       bool
-	SyncOrderbook    bool
-	SyncTrades       bool
-	SyncContinuously bool
-	SyncTimeout      time.Duration
-	NumWorkers       int
-	Verbose          bool
+	SyncTicker              bool
+	SyncOrderbook           bool
+	SyncTrades              bool
+	SyncDerivatives         bool
+	SyncContinuously        bool
+	SyncTimeout             time.Duration
+	DerivativesSyncInterval time.Duration
+	NumWorkers              int
+	Verbose                 bool
 }
 
 // ExchangeSyncerConfig stores the exchange syncer config
@@ -30,6 +32,8 @@ type ExchangeCurrencyPairSyncer struct {
 	Cfg                      CurrencyPairSyncerConfig
 	CurrencyPairs            []CurrencyPairSyncAgent
 	tickerBatchLastRequested map[string]time.Time
+	tradeHistoryUnsupported  map[string]bool
+	derivativesUnsupported   map[string]bool
 	mux                      sync.Mutex
 	initSyncWG               sync.WaitGroup
 
@@ -51,11 +55,12 @@ type SyncBase struct {
 
 // CurrencyPairSyncAgent stores the sync agent info
 type CurrencyPairSyncAgent struct {
-	Created   time.Time
-	Exchange  string
-	AssetType asset.Item
-	Pair      currency.Pair
-	Ticker    SyncBase
-	Orderbook SyncBase
-	Trade     SyncBase
+	Created     time.Time
+	Exchange    string
+	AssetType   asset.Item
+	Pair        currency.Pair
+	Ticker      SyncBase
+	Orderbook   SyncBase
+	Trade       SyncBase
+	Derivatives SyncBase
 }
@@ -0,0 +1,229 @@
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+const tradeRecorderName = "Trade recorder"
+
+// vars for the trade recorder
+var (
+	// TradeBatchSize is the number of buffered trades which triggers a write
+	// to the database and the maximum number of trades written per
+	// transaction
+	TradeBatchSize = 500
+	// TradeFlushInterval is how often buffered trades are written to the
+	// database regardless of the batch size
+	TradeFlushInterval = time.Second * 10
+
+	// tradeDedupeLimit is the number of trade IDs remembered per exchange,
+	// currency pair and asset to filter trades which are received more than
+	// once, e.g. overlapping REST requests
+	tradeDedupeLimit = 10000
+)
+
+// Started returns if the trade recorder subsystem is started
+func (t *tradeRecorder) Started() bool {
+	return atomic.LoadInt32(&t.started) == 1
+}
+
+// Start starts the trade recorder subsystem
+func (t *tradeRecorder) Start() error {
+	if atomic.AddInt32(&t.started, 1) != 1 {
+		return fmt.Errorf("%s %s", tradeRecorderName, ErrSubSystemAlreadyStarted)
+	}
+
+	log.Debugln(log.DatabaseMgr, tradeRecorderName, MsgSubSystemStarting)
+	t.shutdown = make(chan struct{})
+	t.flush = make(chan struct{}, 1)
+	t.m.Lock()
+	t.seen = make(map[string]*seenTrades)
+	t.m.Unlock()
+	t.wg.Add(1)
+	go t.run()
+	log.Debugln(log.DatabaseMgr, tradeRecorderName, MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the trade recorder subsystem, any buffered trades are written
+// to the database before it returns
+func (t *tradeRecorder) Stop() error {
+	if atomic.LoadInt32(&t.started) == 0 {
+		return fmt.Errorf("%s %s", tradeRecorderName, ErrSubSystemNotStarted)
+	}
+
+	if atomic.AddInt32(&t.stopped, 1) != 1 {
+		return fmt.Errorf("%s %s", tradeRecorderName, ErrSubSystemAlreadyStopped)
+	}
+
+	log.Debugln(log.DatabaseMgr, tradeRecorderName, MsgSubSystemShuttingDown)
+	close(t.shutdown)
+	t.wg.Wait()
+	atomic.CompareAndSwapInt32(&t.stopped, 1, 0)
+	atomic.CompareAndSwapInt32(&t.started, 1, 0)
+	log.Debugln(log.DatabaseMgr, tradeRecorderName, MsgSubSystemShutdown)
+	return nil
+}
+
+// RecordTradeHistory normalises and buffers trades returned by an exchange
+// GetExchangeHistory request
+func (t *tradeRecorder) RecordTradeHistory(exchName string, p currency.Pair, a asset.Item, h []exchange.TradeHistory) {
+	trades := make([]trade.Data, len(h))
+	for x := range h {
+		trades[x] = tradeFromHistory(exchName, p, a, &h[x])
+	}
+	t.record(trades...)
+}
+
+// RecordWebsocketTrade normalises and buffers a trade received over an
+// exchange websocket
+func (t *tradeRecorder) RecordWebsocketTrade(exchName string, d *wshandler.TradeData) {
+	t.record(tradeFromWebsocket(exchName, d))
+}
+
+func (t *tradeRecorder) record(trades ...trade.Data) {
+	if !t.Started() {
+		return
+	}
+
+	t.m.Lock()
+	for x := range trades {
+		if trades[x].Base == "" || trades[x].Quote == "" || trades[x].Asset == "" ||
+			trades[x].Price <= 0 || trades[x].Timestamp.IsZero() {
+			continue
+		}
+		key := strings.ToLower(trades[x].ExchangeName + "|" + trades[x].Base +
+			"|" + trades[x].Quote + "|" + trades[x].Asset)
+		s, ok := t.seen[key]
+		if !ok {
+			s = &seenTrades{ids: make(map[string]struct{})}
+			t.seen[key] = s
+		}
+		if _, ok = s.ids[trades[x].TID]; ok {
+			continue
+		}
+		s.ids[trades[x].TID] = struct{}{}
+		s.order = append(s.order, trades[x].TID)
+		if len(s.order) > tradeDedupeLimit {
+			delete(s.ids, s.order[0])
+			s.order = s.order[1:]
+		}
+		t.buffer = append(t.buffer, trades[x])
+	}
+	full := len(t.buffer) >= TradeBatchSize
+	t.m.Unlock()
+
+	if full {
+		select {
+		case t.flush <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (t *tradeRecorder) run() {
+	defer t.wg.Done()
+	tick := time.NewTicker(TradeFlushInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-t.shutdown:
+			t.flushTrades()
+			return
+		case <-tick.C:
+			t.flushTrades()
+		case <-t.flush:
+			t.flushTrades()
+		}
+	}
+}
+
+// flushTrades writes all buffered trades to the database in batches
+func (t *tradeRecorder) flushTrades() {
+	t.m.Lock()
+	trades := t.buffer
+	t.buffer = nil
+	t.m.Unlock()
+
+	if len(trades) == 0 {
+		return
+	}
+	if !database.DB.Connected {
+		log.Warnf(log.DatabaseMgr, "%s database is not connected, dropping %d trades\n",
+			tradeRecorderName, len(trades))
+		return
+	}
+
+	for x := 0; x < len(trades); x += TradeBatchSize {
+		end := x + TradeBatchSize
+		if end > len(trades) {
+			end = len(trades)
+		}
+		_, err := trade.Insert(trades[x:end]...)
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "%s failed to store %d trades. Err: %s\n",
+				tradeRecorderName, end-x, err)
+		}
+	}
+}
+
+func tradeFromHistory(exchName string, p currency.Pair, a asset.Item, h *exchange.TradeHistory) trade.Data {
+	d := trade.Data{
+		ExchangeName: exchName,
+		Base:         p.Base.String(),
+		Quote:        p.Quote.String(),
+		Asset:        a.String(),
+		Price:        h.Price,
+		Amount:       h.Amount,
+		Side:         h.Type,
+		Timestamp:    h.Timestamp,
+	}
+	if h.TID != 0 {
+		d.TID = strconv.FormatInt(h.TID, 10)
+	} else {
+		d.TID = deriveTradeID(&d)
+	}
+	return d
+}
+
+func tradeFromWebsocket(exchName string, w *wshandler.TradeData) trade.Data {
+	if w.Exchange != "" {
+		exchName = w.Exchange
+	}
+	d := trade.Data{
+		TID:          w.TID,
+		ExchangeName: exchName,
+		Base:         w.CurrencyPair.Base.String(),
+		Quote:        w.CurrencyPair.Quote.String(),
+		Asset:        w.AssetType.String(),
+		Price:        w.Price,
+		Amount:       w.Amount,
+		Side:         w.Side,
+		Timestamp:    w.Timestamp,
+	}
+	if d.TID == "" {
+		d.TID = deriveTradeID(&d)
+	}
+	return d
+}
+
+// deriveTradeID builds a deterministic trade ID for exchanges which do not
+// supply one so the same trade received twice can still be deduplicated
+func deriveTradeID(d *trade.Data) string {
+	return strconv.FormatInt(d.Timestamp.UnixNano(), 10) + "-" +
+		strconv.FormatFloat(d.Price, 'f', -1, 64) + "-" +
+		strconv.FormatFloat(d.Amount, 'f', -1, 64) + "-" +
+		strings.ToUpper(d.Side)
+}
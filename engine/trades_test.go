@@ -0,0 +1,145 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+)
+
+func TestTradeRecorder(t *testing.T) {
+	var r tradeRecorder
+	if r.Started() {
+		t.Error("trade recorder should not be started")
+	}
+	if err := r.Stop(); err == nil {
+		t.Error("expected error when recorder not started")
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	now := time.Now()
+	history := []exchange.TradeHistory{
+		{Timestamp: now, TID: 1, Price: 100, Amount: 1, Type: "buy"},
+		{Timestamp: now, TID: 2, Price: 101, Amount: 2, Type: "sell"},
+	}
+	r.RecordTradeHistory("Bitstamp", p, asset.Spot, history)
+	if len(r.buffer) != 0 {
+		t.Error("trades should not be buffered when recorder not started")
+	}
+
+	if err := r.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Start(); err == nil {
+		t.Error("expected error when recorder already started")
+	}
+
+	r.RecordTradeHistory("Bitstamp", p, asset.Spot, history)
+	r.RecordTradeHistory("Bitstamp", p, asset.Spot, history)
+	r.RecordTradeHistory("Bitstamp", p, asset.Futures, history[:1])
+	r.RecordWebsocketTrade("Bitstamp", &wshandler.TradeData{
+		Timestamp:    now,
+		CurrencyPair: p,
+		AssetType:    asset.Spot,
+		TID:          "3",
+		Price:        102,
+		Amount:       1,
+	})
+	r.RecordWebsocketTrade("Bitstamp", &wshandler.TradeData{
+		Timestamp:    now,
+		CurrencyPair: p,
+		AssetType:    asset.Spot,
+		TID:          "4",
+	})
+	r.m.Lock()
+	if len(r.buffer) != 4 {
+		t.Errorf("expected 4 buffered trades, received %d", len(r.buffer))
+	}
+	r.m.Unlock()
+
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.buffer) != 0 {
+		t.Error("expected buffer to be flushed on stop")
+	}
+}
+
+func TestTradeRecorderDedupeLimit(t *testing.T) {
+	var r tradeRecorder
+	if err := r.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := r.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	history := make([]exchange.TradeHistory, tradeDedupeLimit+1)
+	for x := range history {
+		history[x] = exchange.TradeHistory{
+			Timestamp: time.Now(),
+			TID:       int64(x + 1),
+			Price:     100,
+			Amount:    1,
+		}
+	}
+	r.RecordTradeHistory("Bitstamp", p, asset.Spot, history)
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	s := r.seen["bitstamp|btc|usd|spot"]
+	if s == nil {
+		t.Fatal("expected seen trades for pair")
+	}
+	if len(s.ids) != tradeDedupeLimit || len(s.order) != tradeDedupeLimit {
+		t.Errorf("expected %d remembered trades, received %d", tradeDedupeLimit, len(s.ids))
+	}
+	if _, ok := s.ids["1"]; ok {
+		t.Error("expected oldest trade ID to be pruned")
+	}
+}
+
+func TestTradeConversion(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	now := time.Now()
+	d := tradeFromHistory("Bitstamp", p, asset.Spot, &exchange.TradeHistory{
+		Timestamp: now,
+		TID:       1337,
+		Price:     100,
+		Amount:    1,
+		Type:      "buy",
+	})
+	if d.TID != "1337" || d.Base != "BTC" || d.Quote != "USD" ||
+		d.Asset != "spot" || d.ExchangeName != "Bitstamp" {
+		t.Errorf("unexpected trade %+v", d)
+	}
+
+	d = tradeFromHistory("Bitstamp", p, asset.Spot, &exchange.TradeHistory{
+		Timestamp: now,
+		Price:     100,
+		Amount:    1,
+		Type:      "buy",
+	})
+	w := tradeFromWebsocket("", &wshandler.TradeData{
+		Timestamp:    now,
+		CurrencyPair: p,
+		AssetType:    asset.Spot,
+		Exchange:     "Bitstamp",
+		Price:        100,
+		Amount:       1,
+		Side:         "BUY",
+	})
+	if d.TID == "" || d.TID != w.TID {
+		t.Errorf("expected matching derived trade IDs, received %s and %s", d.TID, w.TID)
+	}
+	if w.ExchangeName != "Bitstamp" {
+		t.Errorf("expected exchange name Bitstamp, received %s", w.ExchangeName)
+	}
+}
@@ -0,0 +1,26 @@
+package engine
+
+import (
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+)
+
+type tradeRecorder struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	flush    chan struct{}
+	wg       sync.WaitGroup
+	m        sync.Mutex
+	buffer   []trade.Data
+	seen     map[string]*seenTrades
+}
+
+// seenTrades holds the most recently recorded trade IDs for an exchange,
+// currency pair and asset in the order they were received so the oldest can
+// be pruned
+type seenTrades struct {
+	ids   map[string]struct{}
+	order []string
+}
@@ -0,0 +1,388 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// triangularLevelTolerance is the fraction of an orderbook level below which
+// it is considered fully consumed to allow for float rounding
+const triangularLevelTolerance = 1e-12
+
+var errTriangularEmptyOrderbook = errors.New("orderbook side has no levels")
+
+// FindTriangularArbitrage walks the orderbooks of every three leg cycle between
+// the exchange's enabled spot currency pairs and returns the cycles whose net
+// return after taker fees exceeds minReturnPercent, best first. Cycles with an
+// orderbook which has not been fetched are skipped
+func FindTriangularArbitrage(exchName string, minReturnPercent float64) ([]TriangularCycle, error) {
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+
+	paths := triangularPaths(exch.GetEnabledPairs(asset.Spot))
+	books := make(map[string]*orderbook.Base)
+	now := time.Now()
+	var cycles []TriangularCycle
+	for x := range paths {
+		var legs [3]triangularBook
+		var err error
+		for y := range paths[x] {
+			legs[y], err = triangularBookFor(exch, &paths[x][y], books)
+			if err != nil {
+				break
+			}
+		}
+		if err != nil {
+			continue
+		}
+		c := walkTriangle(&legs, minReturnPercent)
+		if c == nil {
+			continue
+		}
+		c.Exchange = exch.GetName()
+		c.Time = now
+		cycles = append(cycles, *c)
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i].NetReturnPercent > cycles[j].NetReturnPercent
+	})
+	return cycles, nil
+}
+
+// triangularBookFor returns the step with the side of its orderbook it is
+// walked against and the exchange's taker fee rate. Orderbooks are cached in
+// books so each is retrieved once per scan
+func triangularBookFor(exch exchange.IBotExchange, s *triangularStep, books map[string]*orderbook.Base) (triangularBook, error) {
+	key := s.pair.String()
+	ob, ok := books[key]
+	if !ok {
+		var err error
+		ob, err = orderbook.Get(exch.GetName(), s.pair, asset.Spot)
+		if err != nil {
+			ob = nil
+		}
+		books[key] = ob
+	}
+	if ob == nil {
+		return triangularBook{}, fmt.Errorf("%s %s orderbook not found",
+			exch.GetName(), s.pair)
+	}
+
+	b := triangularBook{
+		triangularStep: *s,
+		sell:           s.from.Match(s.pair.Base),
+	}
+	if b.sell {
+		b.levels = append([]orderbook.Item(nil), ob.Bids...)
+		sort.Slice(b.levels, func(i, j int) bool { return b.levels[i].Price > b.levels[j].Price })
+	} else {
+		b.levels = append([]orderbook.Item(nil), ob.Asks...)
+		sort.Slice(b.levels, func(i, j int) bool { return b.levels[i].Price < b.levels[j].Price })
+	}
+	if len(b.levels) == 0 {
+		return triangularBook{}, errTriangularEmptyOrderbook
+	}
+
+	var err error
+	b.feeRate, err = exchangeFees.tradeFeeRate(exch.GetName(), s.pair, b.levels[0].Price)
+	return b, err
+}
+
+// triangularPaths returns the steps of every three leg cycle between the
+// currencies of the pairs. Each cycle starts with the currency quoted by most
+// of its pairs and is returned in both directions
+func triangularPaths(pairs currency.Pairs) [][3]triangularStep {
+	edges := make(map[*currency.Item]map[*currency.Item]currency.Pair)
+	codes := make(map[*currency.Item]currency.Code)
+	for _, p := range pairs {
+		if p.Base.Item == nil || p.Quote.Item == nil || p.Base.Item == p.Quote.Item {
+			continue
+		}
+		for _, c := range []currency.Code{p.Base, p.Quote} {
+			if edges[c.Item] == nil {
+				edges[c.Item] = make(map[*currency.Item]currency.Pair)
+			}
+			codes[c.Item] = c
+		}
+		edges[p.Base.Item][p.Quote.Item] = p
+		edges[p.Quote.Item][p.Base.Item] = p
+	}
+
+	name := func(i *currency.Item) string { return strings.ToUpper(codes[i].String()) }
+	items := make([]*currency.Item, 0, len(codes))
+	for i := range codes {
+		items = append(items, i)
+	}
+	sort.Slice(items, func(i, j int) bool { return name(items[i]) < name(items[j]) })
+	index := make(map[*currency.Item]int, len(items))
+	for x := range items {
+		index[items[x]] = x
+	}
+	neighbours := func(i *currency.Item) []*currency.Item {
+		var n []*currency.Item
+		for j := range edges[i] {
+			if index[j] > index[i] {
+				n = append(n, j)
+			}
+		}
+		sort.Slice(n, func(x, y int) bool { return index[n[x]] < index[n[y]] })
+		return n
+	}
+
+	step := func(from, to *currency.Item) triangularStep {
+		return triangularStep{pair: edges[from][to], from: codes[from], to: codes[to]}
+	}
+	var paths [][3]triangularStep
+	for _, a := range items {
+		for _, b := range neighbours(a) {
+			for _, c := range neighbours(b) {
+				if _, ok := edges[c][a]; !ok {
+					continue
+				}
+				// start with the currency quoted by most of the cycle's
+				// pairs, earliest alphabetically when tied
+				cycle := [3]*currency.Item{a, b, c}
+				quoted := make(map[*currency.Item]int, 3)
+				for _, p := range []currency.Pair{edges[a][b], edges[b][c], edges[c][a]} {
+					quoted[p.Quote.Item]++
+				}
+				start := 0
+				for x := 1; x < 3; x++ {
+					if quoted[cycle[x]] > quoted[cycle[start]] {
+						start = x
+					}
+				}
+				s, x, y := cycle[start], cycle[(start+1)%3], cycle[(start+2)%3]
+				if index[y] < index[x] {
+					x, y = y, x
+				}
+				paths = append(paths,
+					[3]triangularStep{step(s, x), step(x, y), step(y, s)},
+					[3]triangularStep{step(s, y), step(y, x), step(x, s)})
+			}
+		}
+	}
+	return paths
+}
+
+// walkTriangle walks the levels of each leg while converting one more unit of
+// the starting currency through the cycle returns more than minReturnPercent
+// after fees, nil is returned when no amount does
+func walkTriangle(legs *[3]triangularBook, minReturnPercent float64) *TriangularCycle {
+	minReturn := 1 + minReturnPercent/100
+	var idx [3]int
+	var left, capacity, rate [3]float64
+	for x := range legs {
+		if len(legs[x].levels) == 0 {
+			return nil
+		}
+		rate[x], capacity[x] = legs[x].level(0)
+		left[x] = capacity[x]
+	}
+
+	c := TriangularCycle{
+		Currencies: []currency.Code{legs[0].from, legs[1].from, legs[2].from},
+		Legs:       make([]TriangularLeg, 3),
+	}
+	for x := range legs {
+		c.Legs[x] = TriangularLeg{
+			Pair:    legs[x].pair,
+			Side:    order.Buy,
+			From:    legs[x].from,
+			To:      legs[x].to,
+			FeeRate: legs[x].feeRate,
+		}
+		if legs[x].sell {
+			c.Legs[x].Side = order.Sell
+		}
+	}
+
+	var quote [3]float64
+	for {
+		if rate[0]*rate[1]*rate[2] <= minReturn {
+			break
+		}
+
+		// the starting amount which can be converted before any leg moves
+		// to its next level
+		size, scale := left[0], 1.0
+		for x := 1; x < 3; x++ {
+			scale *= rate[x-1]
+			if left[x]/scale < size {
+				size = left[x] / scale
+			}
+		}
+
+		in := size
+		done := false
+		for x := range legs {
+			out := in * rate[x]
+			l := &c.Legs[x]
+			l.Input += in
+			l.Output += out
+			price := legs[x].levels[idx[x]].Price
+			if legs[x].sell {
+				l.Amount += in
+				quote[x] += in * price
+			} else {
+				l.Amount += in / price
+				quote[x] += in
+			}
+			l.LimitPrice = price
+
+			left[x] -= in
+			if left[x] <= capacity[x]*triangularLevelTolerance {
+				idx[x]++
+				if idx[x] >= len(legs[x].levels) {
+					done = true
+				} else {
+					rate[x], capacity[x] = legs[x].level(idx[x])
+					left[x] = capacity[x]
+				}
+			}
+			in = out
+		}
+		if done {
+			break
+		}
+	}
+
+	if c.Legs[0].Input <= 0 {
+		return nil
+	}
+	for x := range c.Legs {
+		if c.Legs[x].Amount > 0 {
+			c.Legs[x].Price = quote[x] / c.Legs[x].Amount
+		}
+	}
+	c.Input = c.Legs[0].Input
+	c.Output = c.Legs[2].Output
+	c.NetReturnPercent = (c.Output/c.Input - 1) * 100
+	return &c
+}
+
+// level returns the amount of the To currency returned per unit of the From
+// currency after fees at the orderbook level and the amount of the From
+// currency the level can absorb
+func (b *triangularBook) level(i int) (rate, capacity float64) {
+	l := b.levels[i]
+	if b.sell {
+		return l.Price * (1 - b.feeRate), l.Amount
+	}
+	if l.Price <= 0 {
+		return 0, 0
+	}
+	return (1 - b.feeRate) / l.Price, l.Amount * l.Price
+}
+
+// triangularExchanges returns the enabled exchanges scanned for triangular
+// arbitrage
+func triangularExchanges(cfg *config.TriangularArbitrageConfig) []string {
+	var exchanges []string
+	for x := range Bot.Exchanges {
+		if !Bot.Exchanges[x].IsEnabled() {
+			continue
+		}
+		name := Bot.Exchanges[x].GetName()
+		if len(cfg.Exchanges) > 0 && !common.StringDataCompareInsensitive(cfg.Exchanges, name) {
+			continue
+		}
+		exchanges = append(exchanges, name)
+	}
+	return exchanges
+}
+
+// runTriangular scans the exchanges for triangular arbitrage each check
+// interval
+func (a *arbitrageManager) runTriangular() {
+	tick := time.NewTicker(Bot.Config.Arbitrage.Triangular.CheckInterval)
+	defer func() {
+		tick.Stop()
+		a.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-a.shutdown:
+			return
+		case <-tick.C:
+			cfg := &Bot.Config.Arbitrage.Triangular
+			exchanges := triangularExchanges(cfg)
+			for x := range exchanges {
+				cycles, err := FindTriangularArbitrage(exchanges[x], cfg.MinReturnPercent)
+				if err != nil {
+					log.Errorf(log.OrderMgr, "%s: Unable to scan %s for triangular arbitrage. Err: %s\n",
+						arbitrageManagerName, exchanges[x], err)
+					continue
+				}
+				a.recordCycles(exchanges[x], cycles)
+			}
+		}
+	}
+}
+
+func triangularKey(c *TriangularCycle) string {
+	key := c.Exchange
+	for x := range c.Currencies {
+		key += "|" + c.Currencies[x].String()
+	}
+	return strings.ToLower(key)
+}
+
+// recordCycles replaces the open cycles of the exchange and pushes newly found
+// cycles to the communications manager
+func (a *arbitrageManager) recordCycles(exchName string, cycles []TriangularCycle) {
+	open := make(map[string]bool, len(cycles))
+	var found []*TriangularCycle
+	a.m.Lock()
+	for x := range cycles {
+		key := triangularKey(&cycles[x])
+		open[key] = true
+		if !a.cycles[key] {
+			found = append(found, &cycles[x])
+		}
+	}
+	prefix := strings.ToLower(exchName) + "|"
+	for k := range a.cycles {
+		if strings.HasPrefix(k, prefix) && !open[k] {
+			delete(a.cycles, k)
+		}
+	}
+	for k := range open {
+		a.cycles[k] = true
+	}
+	a.m.Unlock()
+
+	for x := range found {
+		c := found[x]
+		path := make([]string, 0, len(c.Currencies)+1)
+		for y := range c.Currencies {
+			path = append(path, c.Currencies[y].String())
+		}
+		path = append(path, c.Currencies[0].String())
+		msg := fmt.Sprintf("%s: %s triangular cycle %s returns %.4f%% converting %v %s",
+			arbitrageManagerName, c.Exchange, strings.Join(path, " -> "),
+			c.NetReturnPercent, c.Input, c.Currencies[0])
+		log.Infoln(log.OrderMgr, msg)
+		Bot.CommsManager.PushEvent(base.Event{
+			Type:    "arbitrage",
+			Message: msg,
+		})
+	}
+}
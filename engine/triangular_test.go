@@ -0,0 +1,140 @@
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestTriangularPaths(t *testing.T) {
+	t.Parallel()
+	btcusd := currency.NewPair(currency.BTC, currency.USD)
+	ethbtc := currency.NewPair(currency.ETH, currency.BTC)
+	ethusd := currency.NewPair(currency.ETH, currency.USD)
+	paths := triangularPaths(currency.Pairs{
+		btcusd,
+		ethbtc,
+		ethusd,
+		currency.NewPair(currency.LTC, currency.USD),
+	})
+	if len(paths) != 2 {
+		t.Fatalf("expected both directions of one cycle, received %d paths", len(paths))
+	}
+
+	// the cycle starts with USD as it quotes two of the three pairs
+	expected := [2][3]triangularStep{
+		{
+			{pair: btcusd, from: currency.USD, to: currency.BTC},
+			{pair: ethbtc, from: currency.BTC, to: currency.ETH},
+			{pair: ethusd, from: currency.ETH, to: currency.USD},
+		},
+		{
+			{pair: ethusd, from: currency.USD, to: currency.ETH},
+			{pair: ethbtc, from: currency.ETH, to: currency.BTC},
+			{pair: btcusd, from: currency.BTC, to: currency.USD},
+		},
+	}
+	for x := range expected {
+		for y := range expected[x] {
+			e, r := expected[x][y], paths[x][y]
+			if !r.pair.Equal(e.pair) || !r.from.Match(e.from) || !r.to.Match(e.to) {
+				t.Errorf("path %d step %d expected %s %s->%s, received %s %s->%s",
+					x, y, e.pair, e.from, e.to, r.pair, r.from, r.to)
+			}
+		}
+	}
+
+	if paths = triangularPaths(currency.Pairs{btcusd, ethbtc}); len(paths) != 0 {
+		t.Errorf("expected no paths without a closing pair, received %d", len(paths))
+	}
+}
+
+func testTriangle(feeRate float64) *[3]triangularBook {
+	return &[3]triangularBook{
+		{
+			triangularStep: triangularStep{
+				pair: currency.NewPair(currency.BTC, currency.USD),
+				from: currency.USD,
+				to:   currency.BTC,
+			},
+			levels:  []orderbook.Item{{Price: 10000, Amount: 1}},
+			feeRate: feeRate,
+		},
+		{
+			triangularStep: triangularStep{
+				pair: currency.NewPair(currency.ETH, currency.BTC),
+				from: currency.BTC,
+				to:   currency.ETH,
+			},
+			levels:  []orderbook.Item{{Price: 0.02, Amount: 10}},
+			feeRate: feeRate,
+		},
+		{
+			triangularStep: triangularStep{
+				pair: currency.NewPair(currency.ETH, currency.USD),
+				from: currency.ETH,
+				to:   currency.USD,
+			},
+			sell:    true,
+			levels:  []orderbook.Item{{Price: 210, Amount: 5}, {Price: 190, Amount: 100}},
+			feeRate: feeRate,
+		},
+	}
+}
+
+func TestWalkTriangle(t *testing.T) {
+	t.Parallel()
+
+	// 1000 USD buys 0.1 BTC which buys 5 ETH, the whole first ETH-USD bid
+	// level, which sells for 1050 USD. The next bid level loses money
+	c := walkTriangle(testTriangle(0), 0)
+	if c == nil {
+		t.Fatal("expected a cycle")
+	}
+	if math.Abs(c.Input-1000) > 1e-6 || math.Abs(c.Output-1050) > 1e-6 ||
+		math.Abs(c.NetReturnPercent-5) > 1e-6 {
+		t.Errorf("unexpected cycle %+v", c)
+	}
+	expected := []struct {
+		side   order.Side
+		amount float64
+		price  float64
+	}{
+		{order.Buy, 0.1, 10000},
+		{order.Buy, 5, 0.02},
+		{order.Sell, 5, 210},
+	}
+	for x := range expected {
+		l := c.Legs[x]
+		if l.Side != expected[x].side ||
+			math.Abs(l.Amount-expected[x].amount) > 1e-9 ||
+			math.Abs(l.Price-expected[x].price) > 1e-9 ||
+			l.LimitPrice != expected[x].price {
+			t.Errorf("leg %d expected %v %v at %v, received %+v", x,
+				expected[x].side, expected[x].amount, expected[x].price, l)
+		}
+	}
+	if !c.Currencies[0].Match(currency.USD) || !c.Currencies[2].Match(currency.ETH) {
+		t.Errorf("unexpected currencies %v", c.Currencies)
+	}
+
+	c = walkTriangle(testTriangle(0.01), 0)
+	if c == nil {
+		t.Fatal("expected a cycle after fees")
+	}
+	if r := (1.05*math.Pow(0.99, 3) - 1) * 100; math.Abs(c.NetReturnPercent-r) > 1e-6 {
+		t.Errorf("expected a return of %v after fees, received %v", r, c.NetReturnPercent)
+	}
+
+	if c = walkTriangle(testTriangle(0), 6); c != nil {
+		t.Errorf("expected the return to not exceed the threshold, received %+v", c)
+	}
+	legs := testTriangle(0)
+	legs[1].levels = nil
+	if c = walkTriangle(legs, 0); c != nil {
+		t.Errorf("expected no cycle with an empty orderbook, received %+v", c)
+	}
+}
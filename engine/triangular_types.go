@@ -0,0 +1,60 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// TriangularCycle is a three leg cycle on a single exchange which converts a
+// currency through two others and back in to more of it than it started with
+type TriangularCycle struct {
+	Exchange string
+	// Currencies are the currencies in the order they are converted, the
+	// cycle starts and ends with the first
+	Currencies []currency.Code
+	Legs       []TriangularLeg
+	// Input is the amount of the first currency spent and Output the amount
+	// returned by the last leg
+	Input            float64
+	Output           float64
+	NetReturnPercent float64
+	Time             time.Time
+}
+
+// TriangularLeg is a single order of a triangular cycle. Input is the amount
+// of the From currency the leg can absorb and Output the amount of the To
+// currency it returns after fees
+type TriangularLeg struct {
+	Pair   currency.Pair
+	Side   order.Side
+	From   currency.Code
+	To     currency.Code
+	Input  float64
+	Output float64
+	// Amount is the order amount in the base currency of the pair
+	Amount float64
+	// Price is the average price the amount executes at and LimitPrice the
+	// worst orderbook level reached
+	Price      float64
+	LimitPrice float64
+	FeeRate    float64
+}
+
+// triangularStep converts a currency in to another through a currency pair
+type triangularStep struct {
+	pair     currency.Pair
+	from, to currency.Code
+}
+
+// triangularBook is a step with the orderbook levels it is walked against,
+// bids best first when the base currency is sold and asks best first when it
+// is bought
+type triangularBook struct {
+	triangularStep
+	sell    bool
+	levels  []orderbook.Item
+	feeRate float64
+}
@@ -35,6 +35,14 @@ var supported = Items{
 	DownsideProfitContract,
 }
 
+var derivatives = Items{
+	PerpetualContract,
+	PerpetualSwap,
+	Futures,
+	UpsideProfitContract,
+	DownsideProfitContract,
+}
+
 // Supported returns a list of supported asset types
 func Supported() Items {
 	return supported
@@ -45,6 +53,12 @@ func (a Item) String() string {
 	return string(a)
 }
 
+// IsDerivative returns whether or not the asset type is a futures or
+// perpetual contract
+func (a Item) IsDerivative() bool {
+	return derivatives.Contains(a)
+}
+
 // Strings converts an asset type array to a string array
 func (a Items) Strings() []string {
 	var assets []string
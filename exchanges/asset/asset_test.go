@@ -83,3 +83,12 @@ func TestNew(t *testing.T) {
 		t.Fatal("TestNew returned an unexpected result")
 	}
 }
+
+func TestIsDerivative(t *testing.T) {
+	if Spot.IsDerivative() || Margin.IsDerivative() {
+		t.Fatal("TestIsDerivative returned an unexpected result")
+	}
+	if !PerpetualSwap.IsDerivative() || !Item("FUTURES").IsDerivative() {
+		t.Fatal("TestIsDerivative returned an unexpected result")
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -60,6 +61,9 @@ const (
 	// to-do
 	binanceAuthRate   = 0
 	binanceUnauthRate = 0
+
+	// klineLimit is the maximum number of candles returned per kline request
+	klineLimit = 1000
 )
 
 // Binance is the overarching type across the Bithumb package
@@ -72,11 +76,6 @@ type Binance struct {
 	validIntervals []TimeInterval
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *Binance) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
-
 // GetExchangeInfo returns exchange information. Check binance_types for more
 // information
 func (b *Binance) GetExchangeInfo() (ExchangeInfo, error) {
@@ -689,3 +688,34 @@ func (b *Binance) GetDepositAddressForCurrency(currency string) (string, error)
 	return resp.Address,
 		b.SendAuthHTTPRequest(http.MethodGet, path, params, &resp)
 }
+
+// tradingRules converts the filters of the exchange info's trading symbols to
+// spot trading rules
+func tradingRules(info *ExchangeInfo) []rules.Rules {
+	var r []rules.Rules
+	for x := range info.Symbols {
+		if info.Symbols[x].Status != "TRADING" {
+			continue
+		}
+		pairRules := rules.Rules{
+			Pair: currency.NewPairFromStrings(info.Symbols[x].BaseAsset,
+				info.Symbols[x].QuoteAsset),
+			Asset: asset.Spot,
+		}
+		for y := range info.Symbols[x].Filters {
+			f := info.Symbols[x].Filters[y]
+			switch f.FilterType {
+			case "PRICE_FILTER":
+				pairRules.PriceTick = f.TickSize
+			case "LOT_SIZE":
+				pairRules.AmountStep = f.StepSize
+				pairRules.MinAmount = f.MinQty
+				pairRules.MaxAmount = f.MaxQty
+			case "MIN_NOTIONAL":
+				pairRules.MinNotional = f.MinNotional
+			}
+		}
+		r = append(r, pairRules)
+	}
+	return r
+}
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -42,6 +43,28 @@ func TestFetchTradablePairs(t *testing.T) {
 	}
 }
 
+func TestTradingRules(t *testing.T) {
+	t.Parallel()
+	var info ExchangeInfo
+	err := json.Unmarshal([]byte(`{"symbols":[{"symbol":"BTCUSDT","status":"TRADING","baseAsset":"BTC","quoteAsset":"USDT","filters":[{"filterType":"PRICE_FILTER","minPrice":"0.01000000","maxPrice":"1000000.00000000","tickSize":"0.01000000"},{"filterType":"LOT_SIZE","minQty":"0.00000100","maxQty":"9000.00000000","stepSize":"0.00000100"},{"filterType":"MIN_NOTIONAL","minNotional":"10.00000000","applyToMarket":true,"avgPriceMins":5}]},{"symbol":"BCCUSDT","status":"BREAK","baseAsset":"BCC","quoteAsset":"USDT","filters":[]}]}`), &info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := tradingRules(&info)
+	if len(r) != 1 {
+		t.Fatalf("expected rules for the trading symbol only, received %v", len(r))
+	}
+	if !r[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USDT)) ||
+		r[0].Asset != asset.Spot ||
+		r[0].PriceTick != 0.01 ||
+		r[0].AmountStep != 0.000001 ||
+		r[0].MinAmount != 0.000001 ||
+		r[0].MaxAmount != 9000 ||
+		r[0].MinNotional != 10 {
+		t.Errorf("unexpected trading rules %+v", r[0])
+	}
+}
+
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
 
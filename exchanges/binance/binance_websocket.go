@@ -144,6 +144,7 @@ func (b *Binance) WsHandleData() {
 					Amount:    amount,
 					Exchange:  b.Name,
 					AssetType: asset.Spot,
+					TID:       strconv.FormatInt(trade.TradeID, 10),
 					Side:      trade.EventType,
 				}
 				continue
@@ -13,8 +13,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -226,13 +229,15 @@ func (b *Binance) Run() {
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
 func (b *Binance) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	var validCurrencyPairs []string
-
 	info, err := b.GetExchangeInfo()
 	if err != nil {
 		return nil, err
 	}
+	return b.tradablePairs(&info, asset), nil
+}
 
+func (b *Binance) tradablePairs(info *ExchangeInfo, asset asset.Item) []string {
+	var validCurrencyPairs []string
 	for x := range info.Symbols {
 		if info.Symbols[x].Status == "TRADING" {
 			validCurrencyPairs = append(validCurrencyPairs, info.Symbols[x].BaseAsset+
@@ -240,21 +245,26 @@ func (b *Binance) FetchTradablePairs(asset asset.Item) ([]string, error) {
 				info.Symbols[x].QuoteAsset)
 		}
 	}
-	return validCurrencyPairs, nil
+	return validCurrencyPairs
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the trading rules of the pairs are loaded
+// from the same exchange info
 func (b *Binance) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+	info, err := b.GetExchangeInfo()
 	if err != nil {
 		return err
 	}
 
-	return b.UpdatePairs(currency.NewPairsFromStrings(pairs),
+	err = b.UpdatePairs(currency.NewPairsFromStrings(b.tradablePairs(&info, asset.Spot)),
 		asset.Spot,
 		false,
 		forceUpdate)
+	if err != nil {
+		return err
+	}
+	return b.LoadTradingRules(tradingRules(&info))
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -403,9 +413,32 @@ func (b *Binance) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
+// GetExchangeHistory returns the most recent public trades for a currency pair
 func (b *Binance) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
-	return nil, common.ErrNotYetImplemented
+	trades, err := b.GetRecentTrades(RecentTradeRequestParams{
+		Symbol: b.FormatExchangeCurrency(p, assetType).String(),
+		Limit:  500,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		side := order.Buy
+		if trades[i].IsBuyerMaker {
+			side = order.Sell
+		}
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(0, int64(trades[i].Time)*int64(time.Millisecond)),
+			TID:       trades[i].ID,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Quantity,
+			Exchange:  b.Name,
+			Type:      side.String(),
+		}
+	}
+	return resp, nil
 }
 
 // SubmitOrder submits a new order
@@ -659,3 +692,86 @@ func (b *Binance) ValidateCredentials() error {
 	_, err := b.UpdateAccountInfo()
 	return b.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time
+// interval, ranges longer than the kline limit are requested a page at a time
+func (b *Binance) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	ret := kline.Item{
+		Exchange: b.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	for from := start; from.Before(end); {
+		candles, err := b.GetSpotKline(KlinesRequestParams{
+			Symbol:    b.FormatExchangeCurrency(pair, a).String(),
+			Interval:  TimeInterval(interval.Short()),
+			Limit:     klineLimit,
+			StartTime: from.UnixNano() / int64(time.Millisecond),
+			EndTime:   end.UnixNano() / int64(time.Millisecond),
+		})
+		if err != nil {
+			return kline.Item{}, err
+		}
+		for x := range candles {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   time.Unix(0, int64(candles[x].OpenTime)*int64(time.Millisecond)),
+				Open:   candles[x].Open,
+				High:   candles[x].High,
+				Low:    candles[x].Low,
+				Close:  candles[x].Close,
+				Volume: candles[x].Volume,
+			})
+		}
+		if len(candles) < klineLimit {
+			break
+		}
+		from = ret.Candles[len(ret.Candles)-1].Time.Add(interval.Duration())
+	}
+	return ret, nil
+}
+
+// GetPositions returns the open derivatives positions of the asset type
+func (b *Binance) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (b *Binance) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (b *Binance) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (b *Binance) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (b *Binance) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (b *Binance) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrders submits a batch of orders
+func (b *Binance) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (b *Binance) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
@@ -13,7 +13,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
@@ -68,6 +70,8 @@ const (
 	bitfinexWithdrawal         = "withdraw"
 	bitfinexActiveCredits      = "credits"
 	bitfinexPlatformStatus     = "platform/status"
+	bitfinexCandles            = "candles"
+	bitfinexCandlesLimit       = 1000
 
 	// requests per minute
 	bitfinexAuthRate   = 10
@@ -91,11 +95,6 @@ type Bitfinex struct {
 	WebsocketSubdChannels      map[int]WebsocketChanInfo
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *Bitfinex) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
-
 // GetPlatformStatus returns the Bifinex platform status
 func (b *Bitfinex) GetPlatformStatus() (int, error) {
 	var response []interface{}
@@ -114,6 +113,45 @@ func (b *Bitfinex) GetPlatformStatus() (int, error) {
 	return int(response[0].(float64)), nil
 }
 
+// GetCandles returns the trade candles of a symbol between the start and end
+// date in ascending order, timeFrame is a Bitfinex candle time frame such as
+// 1m or 1D
+func (b *Bitfinex) GetCandles(symbol, timeFrame string, start, end time.Time, limit int) ([]Candle, error) {
+	v := url.Values{}
+	v.Set("start", strconv.FormatInt(start.UnixNano()/int64(time.Millisecond), 10))
+	v.Set("end", strconv.FormatInt(end.UnixNano()/int64(time.Millisecond), 10))
+	v.Set("limit", strconv.Itoa(limit))
+	v.Set("sort", "1")
+	path := common.EncodeURLValues(fmt.Sprintf("%s/v%s/%s/trade:%s:%s/hist",
+		b.API.Endpoints.URL,
+		bitfinexAPIVersion2,
+		bitfinexCandles,
+		timeFrame,
+		symbol), v)
+
+	var response [][]float64
+	err := b.SendHTTPRequest(path, &response, b.Verbose)
+	if err != nil {
+		return nil, err
+	}
+
+	candles := make([]Candle, len(response))
+	for x := range response {
+		if len(response[x]) < 6 {
+			return nil, errors.New("unexpected candle data")
+		}
+		candles[x] = Candle{
+			Timestamp: time.Unix(0, int64(response[x][0])*int64(time.Millisecond)),
+			Open:      response[x][1],
+			Close:     response[x][2],
+			High:      response[x][3],
+			Low:       response[x][4],
+			Volume:    response[x][5],
+		}
+	}
+	return candles, nil
+}
+
 // GetLatestSpotPrice returns latest spot price of symbol
 //
 // symbol: string of currency pair
@@ -1156,3 +1194,19 @@ func (b *Bitfinex) ConvertSymbolToDepositMethod(c currency.Code) (method string,
 	}
 	return
 }
+
+// tradingRules converts the symbol details to trading rules, the price
+// precision is a number of significant digits rather than decimal places so
+// only the order size limits are loaded
+func tradingRules(details []SymbolDetails) []rules.Rules {
+	r := make([]rules.Rules, len(details))
+	for x := range details {
+		r[x] = rules.Rules{
+			Pair:      currency.NewPairFromString(details[x].Pair),
+			Asset:     asset.Spot,
+			MinAmount: details[x].MinimumOrderSize,
+			MaxAmount: details[x].MaximumOrderSize,
+		}
+	}
+	return r
+}
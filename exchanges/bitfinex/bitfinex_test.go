@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -78,6 +79,22 @@ func TestAppendOptionalDelimiter(t *testing.T) {
 	}
 }
 
+func TestTradingRules(t *testing.T) {
+	t.Parallel()
+	r := tradingRules([]SymbolDetails{
+		{Pair: "btcusd", MinimumOrderSize: 0.0006, MaximumOrderSize: 2000},
+	})
+	if len(r) != 1 {
+		t.Fatalf("expected rules for one symbol, received %v", len(r))
+	}
+	if !r[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USD)) ||
+		r[0].MinAmount != 0.0006 ||
+		r[0].MaxAmount != 2000 ||
+		r[0].PriceTick != 0 {
+		t.Errorf("unexpected trading rules %+v", r[0])
+	}
+}
+
 func TestGetPlatformStatus(t *testing.T) {
 	t.Parallel()
 	result, err := b.GetPlatformStatus()
@@ -1155,3 +1172,87 @@ func TestWsCancelOffer(t *testing.T) {
 	}
 	time.Sleep(time.Second)
 }
+
+func TestGetLendBook(t *testing.T) {
+	t.Parallel()
+	book, err := b.GetLendBook(currency.USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if book.Exchange != b.Name || !book.Currency.Match(currency.USD) {
+		t.Errorf("unexpected lend book %+v", book)
+	}
+}
+
+func TestGetFundingOffers(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetFundingOffers()
+	if areTestAPIKeysSet() && err != nil {
+		t.Error(err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("GetFundingOffers() Expected error")
+	}
+}
+
+func TestSubmitFundingOffer(t *testing.T) {
+	t.Parallel()
+	if _, err := b.SubmitFundingOffer(&lending.OfferRequest{}); err != lending.ErrCurrencyNotSet {
+		t.Errorf("expected %v, received %v", lending.ErrCurrencyNotSet, err)
+	}
+	if areTestAPIKeysSet() && !canManipulateRealOrders {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	_, err := b.SubmitFundingOffer(&lending.OfferRequest{
+		Currency: currency.USD,
+		Side:     lending.Lend,
+		Amount:   50,
+		Rate:     0.0002,
+		Period:   2,
+	})
+	if !areTestAPIKeysSet() && err == nil {
+		t.Error("SubmitFundingOffer() Expected error")
+	}
+}
+
+func TestCancelFundingOffer(t *testing.T) {
+	t.Parallel()
+	if areTestAPIKeysSet() && !canManipulateRealOrders {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	if err := b.CancelFundingOffer("1337"); err == nil {
+		t.Error("CancelFundingOffer() Expected error")
+	}
+}
+
+func TestGetLoans(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetLoans()
+	if areTestAPIKeysSet() && err != nil {
+		t.Error(err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("GetLoans() Expected error")
+	}
+}
+
+func TestRepay(t *testing.T) {
+	t.Parallel()
+	if err := b.Repay(&lending.RepayRequest{Currency: currency.USD}); err != lending.ErrLoanIDNotSet {
+		t.Errorf("expected %v, received %v", lending.ErrLoanIDNotSet, err)
+	}
+	if areTestAPIKeysSet() && !canManipulateRealOrders {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	if err := b.Repay(&lending.RepayRequest{LoanID: "1337"}); err == nil {
+		t.Error("Repay() Expected error")
+	}
+}
+
+func TestParseLendingTimestamp(t *testing.T) {
+	t.Parallel()
+	if ts := parseLendingTimestamp("1444141982.0"); ts.Unix() != 1444141982 {
+		t.Errorf("unexpected timestamp %v", ts)
+	}
+	if ts := parseLendingTimestamp("meow"); !ts.IsZero() {
+		t.Errorf("expected a zero timestamp, received %v", ts)
+	}
+}
@@ -1,6 +1,10 @@
 package bitfinex
 
-import "time"
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
 
 // Ticker holds basic ticker information from the exchange
 type Ticker struct {
@@ -33,6 +37,27 @@ type Tickerv2 struct {
 	Timestamp       time.Time
 }
 
+// Candle holds a version 2 trade candle
+type Candle struct {
+	Timestamp time.Time
+	Open      float64
+	Close     float64
+	High      float64
+	Low       float64
+	Volume    float64
+}
+
+// candleTimeFrames maps the kline intervals to the Bitfinex candle time
+// frames, Bitfinex has no four hour candles
+var candleTimeFrames = map[kline.Interval]string{
+	kline.OneMin:     "1m",
+	kline.FiveMin:    "5m",
+	kline.FifteenMin: "15m",
+	kline.OneHour:    "1h",
+	kline.OneDay:     "1D",
+	kline.OneWeek:    "7D",
+}
+
 // Tickersv2 holds the version 2 tickers information
 type Tickersv2 struct {
 	Symbol string
@@ -348,6 +373,7 @@ type Offer struct {
 	OriginalAmount  float64 `json:"original_amount,string"`
 	RemainingAmount float64 `json:"remaining_amount,string"`
 	ExecutedAmount  float64 `json:"executed_amount,string"`
+	Amount          float64 `json:"amount,string"`
 }
 
 // MarginFunds holds active funding information used in a margin position
@@ -295,6 +295,7 @@ func (b *Bitfinex) WsDataHandler() {
 								Amount:       newAmount,
 								Exchange:     b.Name,
 								AssetType:    asset.Spot,
+								TID:          strconv.FormatInt(trades[i].ID, 10),
 								Side:         side,
 							}
 						}
@@ -2,6 +2,7 @@ package bitfinex
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
@@ -14,8 +15,12 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -230,14 +235,24 @@ func (b *Bitfinex) FetchTradablePairs(asset asset.Item) ([]string, error) {
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the trading rules of the pairs are loaded
+// from the symbol details
 func (b *Bitfinex) UpdateTradablePairs(forceUpdate bool) error {
 	pairs, err := b.FetchTradablePairs(asset.Spot)
 	if err != nil {
 		return err
 	}
 
-	return b.UpdatePairs(currency.NewPairsFromStrings(pairs), asset.Spot, false, forceUpdate)
+	err = b.UpdatePairs(currency.NewPairsFromStrings(pairs), asset.Spot, false, forceUpdate)
+	if err != nil {
+		return err
+	}
+
+	details, err := b.GetSymbolsDetails()
+	if err != nil {
+		return err
+	}
+	return b.LoadTradingRules(tradingRules(details))
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -736,9 +751,255 @@ func (b *Bitfinex) appendOptionalDelimiter(p *currency.Pair) {
 	}
 }
 
+// GetHistoricCandles returns candles between a time period for a set time interval
+func (b *Bitfinex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	timeFrame, ok := candleTimeFrames[interval]
+	if !ok {
+		return kline.Item{}, fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, interval)
+	}
+	p := b.FormatExchangeCurrency(pair, a)
+	b.appendOptionalDelimiter(&p)
+	symbol := "t" + strings.ToUpper(p.String())
+
+	ret := kline.Item{
+		Exchange: b.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	dates := kline.DateRange{Start: start, End: end}.Split(interval, bitfinexCandlesLimit)
+	for x := range dates {
+		// the end date is inclusive
+		candles, err := b.GetCandles(symbol, timeFrame, dates[x].Start,
+			dates[x].End.Add(-time.Millisecond), bitfinexCandlesLimit)
+		if err != nil {
+			return kline.Item{}, err
+		}
+		for y := range candles {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   candles[y].Timestamp,
+				Open:   candles[y].Open,
+				High:   candles[y].High,
+				Low:    candles[y].Low,
+				Close:  candles[y].Close,
+				Volume: candles[y].Volume,
+			})
+		}
+	}
+	ret.FilterByTimeRange(start, end)
+	return ret, nil
+}
+
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
 func (b *Bitfinex) ValidateCredentials() error {
 	_, err := b.UpdateAccountInfo()
 	return b.CheckTransientError(err)
 }
+
+// GetPositions returns the open derivatives positions of the asset type
+func (b *Bitfinex) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (b *Bitfinex) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (b *Bitfinex) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (b *Bitfinex) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (b *Bitfinex) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (b *Bitfinex) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetLendBook returns the funding offers and demands of a currency
+func (b *Bitfinex) GetLendBook(c currency.Code) (lending.Book, error) {
+	resp, err := b.GetLendbook(c.Upper().String(), url.Values{})
+	if err != nil {
+		return lending.Book{}, err
+	}
+
+	book := lending.Book{
+		Exchange: b.Name,
+		Currency: c,
+	}
+	for x := range resp.Asks {
+		book.Offers = append(book.Offers, lending.BookItem{
+			Amount:    resp.Asks[x].Amount,
+			Rate:      lending.AnnualPercentToDaily(resp.Asks[x].Rate),
+			MinPeriod: resp.Asks[x].Period,
+			MaxPeriod: resp.Asks[x].Period,
+		})
+	}
+	for x := range resp.Bids {
+		book.Demands = append(book.Demands, lending.BookItem{
+			Amount:    resp.Bids[x].Amount,
+			Rate:      lending.AnnualPercentToDaily(resp.Bids[x].Rate),
+			MinPeriod: resp.Bids[x].Period,
+			MaxPeriod: resp.Bids[x].Period,
+		})
+	}
+	return book, nil
+}
+
+// GetFundingOffers returns the account's open funding offers
+func (b *Bitfinex) GetFundingOffers() ([]lending.Offer, error) {
+	resp, err := b.GetActiveOffers()
+	if err != nil {
+		return nil, err
+	}
+
+	offers := make([]lending.Offer, 0, len(resp))
+	for x := range resp {
+		side, err := lending.StringToSide(resp[x].Direction)
+		if err != nil {
+			return nil, err
+		}
+		offers = append(offers, lending.Offer{
+			ID:              strconv.FormatInt(resp[x].ID, 10),
+			Exchange:        b.Name,
+			Currency:        currency.NewCode(resp[x].Currency),
+			Side:            side,
+			Amount:          resp[x].OriginalAmount,
+			RemainingAmount: resp[x].RemainingAmount,
+			Rate:            lending.AnnualPercentToDaily(resp[x].Rate),
+			Period:          int(resp[x].Period),
+			Date:            parseLendingTimestamp(resp[x].Timestamp),
+		})
+	}
+	return offers, nil
+}
+
+// SubmitFundingOffer submits a funding offer, Borrow offers request funds
+// for margin trading
+func (b *Bitfinex) SubmitFundingOffer(o *lending.OfferRequest) (string, error) {
+	if err := o.Validate(); err != nil {
+		return "", err
+	}
+
+	direction := "lend"
+	if o.Side == lending.Borrow {
+		direction = "loan"
+	}
+	resp, err := b.NewOffer(o.Currency.Upper().String(),
+		o.Amount,
+		lending.DailyToAnnualPercent(o.Rate),
+		int64(o.Period),
+		direction)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(resp.ID, 10), nil
+}
+
+// CancelFundingOffer cancels an open funding offer
+func (b *Bitfinex) CancelFundingOffer(id string) error {
+	offerID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = b.CancelOffer(offerID)
+	return err
+}
+
+// GetLoans returns the account's funds lent out and the margin funding
+// it has borrowed
+func (b *Bitfinex) GetLoans() ([]lending.Loan, error) {
+	credits, err := b.GetActiveCredits()
+	if err != nil {
+		return nil, err
+	}
+	funding, err := b.GetActiveMarginFunding()
+	if err != nil {
+		return nil, err
+	}
+
+	loans := make([]lending.Loan, 0, len(credits)+len(funding))
+	for x := range credits {
+		loans = append(loans, lending.Loan{
+			ID:       strconv.FormatInt(credits[x].ID, 10),
+			Exchange: b.Name,
+			Currency: currency.NewCode(credits[x].Currency),
+			Side:     lending.Lend,
+			Amount:   credits[x].Amount,
+			Rate:     lending.AnnualPercentToDaily(credits[x].Rate),
+			Period:   int(credits[x].Period),
+			Date:     parseLendingTimestamp(credits[x].Timestamp),
+		})
+	}
+	for x := range funding {
+		loans = append(loans, lending.Loan{
+			ID:       strconv.FormatInt(funding[x].ID, 10),
+			Exchange: b.Name,
+			Currency: currency.NewCode(funding[x].Currency),
+			Side:     lending.Borrow,
+			Amount:   funding[x].Amount,
+			Rate:     lending.AnnualPercentToDaily(funding[x].Rate),
+			Period:   funding[x].Period,
+			Date:     parseLendingTimestamp(funding[x].Timestamp),
+		})
+	}
+	return loans, nil
+}
+
+// Borrow is not supported, margin funding is borrowed by submitting a Borrow
+// funding offer
+func (b *Bitfinex) Borrow(_ *lending.BorrowRequest) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// Repay closes the margin funding of the loan ID, returning it to the lender
+func (b *Bitfinex) Repay(r *lending.RepayRequest) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if r.LoanID == "" {
+		return lending.ErrLoanIDNotSet
+	}
+	swapID, err := strconv.ParseInt(r.LoanID, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = b.CloseMarginFunding(swapID)
+	return err
+}
+
+// parseLendingTimestamp converts a funding timestamp in fractional unix
+// seconds, a zero time is returned if it cannot be parsed
+func parseLendingTimestamp(ts string) time.Time {
+	seconds, err := strconv.ParseFloat(ts, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(int64(seconds), 0)
+}
+
+// SubmitOrders submits a batch of orders
+func (b *Bitfinex) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (b *Bitfinex) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
@@ -14,8 +14,10 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -585,3 +587,45 @@ func (b *Bithumb) ValidateCredentials() error {
 	_, err := b.UpdateAccountInfo()
 	return b.CheckTransientError(err)
 }
+
+// GetPositions returns the open derivatives positions of the asset type
+func (b *Bithumb) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (b *Bithumb) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (b *Bithumb) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (b *Bithumb) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (b *Bithumb) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (b *Bithumb) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrders submits a batch of orders
+func (b *Bithumb) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (b *Bithumb) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
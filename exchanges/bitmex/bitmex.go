@@ -9,7 +9,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -98,6 +97,10 @@ const (
 	// 300 requests per 5 minutes
 	bitmexAuthRate = 40
 
+	// bitmexBucketLimit is the maximum number of trade buckets returned per
+	// request
+	bitmexBucketLimit = 1000
+
 	// ContractPerpetual perpetual contract type
 	ContractPerpetual = iota
 	// ContractFutures futures contract type
@@ -232,6 +235,15 @@ func (b *Bitmex) GetFullFundingHistory() ([]Funding, error) {
 		&fundingHistory)
 }
 
+// GetFundingRateHistory returns the funding rate history of a contract
+func (b *Bitmex) GetFundingRateHistory(params *GenericRequestParams) ([]Funding, error) {
+	var fundingHistory []Funding
+
+	return fundingHistory, b.SendHTTPRequest(bitmexEndpointFundingHistory,
+		params,
+		&fundingHistory)
+}
+
 // GetInstruments returns instrument data
 func (b *Bitmex) GetInstruments(params *GenericRequestParams) ([]Instrument, error) {
 	var instruments []Instrument
@@ -354,9 +366,9 @@ func (b *Bitmex) CreateOrder(params *OrderNewParams) (Order, error) {
 		&orderInfo)
 }
 
-// CancelOrders cancels one or a batch of orders on the exchange and returns
-// a cancelled order list
-func (b *Bitmex) CancelOrders(params *OrderCancelParams) ([]Order, error) {
+// CancelExistingOrders cancels one or a batch of orders on the exchange and
+// returns a cancelled order list
+func (b *Bitmex) CancelExistingOrders(params *OrderCancelParams) ([]Order, error) {
 	var cancelledOrders []Order
 
 	return cancelledOrders, b.SendAuthenticatedHTTPRequest(http.MethodDelete,
@@ -405,8 +417,8 @@ func (b *Bitmex) CancelAllOrdersAfterTime(params OrderCancelAllAfterParams) ([]O
 		&cancelledOrder)
 }
 
-// ClosePosition closes a position WARNING deprecated use /order endpoint
-func (b *Bitmex) ClosePosition(params OrderClosePositionParams) ([]Order, error) {
+// ClosePositionOrder closes a position WARNING deprecated use /order endpoint
+func (b *Bitmex) ClosePositionOrder(params OrderClosePositionParams) ([]Order, error) {
 	var closedPositions []Order
 
 	return closedPositions, b.SendAuthenticatedHTTPRequest(http.MethodPost,
@@ -424,8 +436,8 @@ func (b *Bitmex) GetOrderbook(params OrderBookGetL2Params) ([]OrderBookL2, error
 		&orderBooks)
 }
 
-// GetPositions returns positions
-func (b *Bitmex) GetPositions(params PositionGetParams) ([]Position, error) {
+// GetUserPositions returns positions
+func (b *Bitmex) GetUserPositions(params PositionGetParams) ([]Position, error) {
 	var positions []Position
 
 	return positions, b.SendAuthenticatedHTTPRequest(http.MethodGet,
@@ -530,8 +542,8 @@ func (b *Bitmex) GetTrade(params *GenericRequestParams) ([]Trade, error) {
 }
 
 // GetPreviousTrades previous trade history in time buckets
-func (b *Bitmex) GetPreviousTrades(params *TradeGetBucketedParams) ([]Trade, error) {
-	var trade []Trade
+func (b *Bitmex) GetPreviousTrades(params *TradeGetBucketedParams) ([]TradeBucket, error) {
+	var trade []TradeBucket
 
 	return trade, b.SendHTTPRequest(bitmexEndpointTradeBucketed,
 		params,
@@ -909,8 +921,3 @@ func calculateTradingFee(purchasePrice, amount float64, isMaker bool) float64 {
 
 	return fee * purchasePrice * amount
 }
-
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *Bitmex) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
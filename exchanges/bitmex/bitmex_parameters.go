@@ -628,7 +628,7 @@ func (p PositionGetParams) IsNil() bool {
 // endpoint
 type PositionIsolateMarginParams struct {
 	// Enabled - True for isolated margin, false for cross margin.
-	Enabled bool `json:"enabled,omitempty"`
+	Enabled bool `json:"enabled"`
 
 	// Symbol - Position symbol to isolate.
 	Symbol string `json:"symbol,omitempty"`
@@ -842,7 +842,11 @@ func (p *TradeGetBucketedParams) VerifyData() error {
 // ToURLVals converts struct values to url.values and encodes it on the supplied
 // path
 func (p *TradeGetBucketedParams) ToURLVals(path string) (string, error) {
-	return "", nil
+	values, err := StructValsToURLVals(p)
+	if err != nil {
+		return "", err
+	}
+	return common.EncodeURLValues(path, values), nil
 }
 
 // IsNil checks to see if any values has been set for the paramater
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -144,6 +145,17 @@ func TestGetFundingHistory(t *testing.T) {
 	}
 }
 
+func TestGetFundingRateHistory(t *testing.T) {
+	_, err := b.GetFundingRateHistory(&GenericRequestParams{
+		Symbol:  "XBTUSD",
+		Count:   10,
+		Reverse: true,
+	})
+	if err != nil {
+		t.Error("GetFundingRateHistory() error", err)
+	}
+}
+
 func TestGetInstruments(t *testing.T) {
 	_, err := b.GetInstruments(&GenericRequestParams{})
 	if err != nil {
@@ -238,10 +250,10 @@ func TestCreateOrder(t *testing.T) {
 	}
 }
 
-func TestCancelOrders(t *testing.T) {
-	_, err := b.CancelOrders(&OrderCancelParams{})
+func TestCancelExistingOrders(t *testing.T) {
+	_, err := b.CancelExistingOrders(&OrderCancelParams{})
 	if err == nil {
-		t.Error("CancelOrders() Expected error")
+		t.Error("CancelExistingOrders() Expected error")
 	}
 }
 
@@ -273,10 +285,10 @@ func TestCancelAllOrdersAfterTime(t *testing.T) {
 	}
 }
 
-func TestClosePosition(t *testing.T) {
-	_, err := b.ClosePosition(OrderClosePositionParams{})
+func TestClosePositionOrder(t *testing.T) {
+	_, err := b.ClosePositionOrder(OrderClosePositionParams{})
 	if err == nil {
-		t.Error("ClosePosition() Expected error")
+		t.Error("ClosePositionOrder() Expected error")
 	}
 }
 
@@ -287,10 +299,10 @@ func TestGetOrderbook(t *testing.T) {
 	}
 }
 
-func TestGetPositions(t *testing.T) {
-	_, err := b.GetPositions(PositionGetParams{})
+func TestGetUserPositions(t *testing.T) {
+	_, err := b.GetUserPositions(PositionGetParams{})
 	if err == nil {
-		t.Error("GetPositions() Expected error")
+		t.Error("GetUserPositions() Expected error")
 	}
 }
 
@@ -488,6 +500,45 @@ func TestGetActiveOrders(t *testing.T) {
 	}
 }
 
+func TestGetPositions(t *testing.T) {
+	_, err := b.GetPositions(asset.PerpetualContract)
+	if areTestAPIKeysSet() && err != nil {
+		t.Errorf("Could not get positions: %s", err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+}
+
+func TestGetFundingRates(t *testing.T) {
+	rates, err := b.GetFundingRates(currency.NewPairFromString("XBTUSD"),
+		asset.PerpetualContract)
+	if err != nil {
+		t.Error("GetFundingRates() error", err)
+	}
+	if len(rates) == 0 {
+		t.Error("GetFundingRates() expected funding rates")
+	}
+	_, err = b.GetFundingRates(currency.NewPairFromString("XBTUSD"), asset.Futures)
+	if err == nil {
+		t.Error("GetFundingRates() Expected error for futures")
+	}
+}
+
+func TestUpdateDerivativesStats(t *testing.T) {
+	stats, err := b.UpdateDerivativesStats(currency.NewPairFromString("XBTUSD"),
+		asset.PerpetualContract)
+	if err != nil {
+		t.Fatal("UpdateDerivativesStats() error", err)
+	}
+	if stats.MarkPrice == 0 || stats.NextFundingTime.IsZero() {
+		t.Errorf("UpdateDerivativesStats() unexpected stats %+v", stats)
+	}
+	_, err = b.UpdateDerivativesStats(currency.NewPairFromString("XBTUSD"), asset.Spot)
+	if err == nil {
+		t.Error("UpdateDerivativesStats() Expected error for spot")
+	}
+}
+
 func TestGetOrderHistory(t *testing.T) {
 	var getOrdersRequest = order.GetOrdersRequest{
 		OrderType: order.AnyType,
@@ -491,6 +491,24 @@ type Trade struct {
 	TrdMatchID      string  `json:"trdMatchID"`
 }
 
+// TradeBucket holds the trades of a time bucket, the timestamp is the end of
+// the bucket
+type TradeBucket struct {
+	Timestamp       time.Time `json:"timestamp"`
+	Symbol          string    `json:"symbol"`
+	Open            float64   `json:"open"`
+	High            float64   `json:"high"`
+	Low             float64   `json:"low"`
+	Close           float64   `json:"close"`
+	Trades          int64     `json:"trades"`
+	Volume          int64     `json:"volume"`
+	VWAP            float64   `json:"vwap"`
+	LastSize        int64     `json:"lastSize"`
+	Turnover        int64     `json:"turnover"`
+	HomeNotional    float64   `json:"homeNotional"`
+	ForeignNotional float64   `json:"foreignNotional"`
+}
+
 // User Account Operations
 type User struct {
 	TFAEnabled   string          `json:"TFAEnabled"`
@@ -230,6 +230,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 							CurrencyPair: currency.NewPairFromString(trades.Data[i].Symbol),
 							Exchange:     b.Name,
 							AssetType:    "CONTRACT",
+							TID:          trades.Data[i].TrdMatchID,
 							Side:         trades.Data[i].Side,
 						}
 					}
@@ -2,6 +2,7 @@ package bitmex
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"strings"
 	"sync"
@@ -13,8 +14,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -488,7 +492,7 @@ func (b *Bitmex) CancelOrder(order *order.Cancel) error {
 	var params = OrderCancelParams{
 		OrderID: order.OrderID,
 	}
-	_, err := b.CancelOrders(&params)
+	_, err := b.CancelExistingOrders(&params)
 	return err
 }
 
@@ -683,3 +687,258 @@ func (b *Bitmex) ValidateCredentials() error {
 	_, err := b.UpdateAccountInfo()
 	return b.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time
+// interval, BitMEX only serves 1m, 5m, 1h and 1d trade buckets. The volume is
+// in contracts
+func (b *Bitmex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	switch interval {
+	case kline.OneMin, kline.FiveMin, kline.OneHour, kline.OneDay:
+	default:
+		return kline.Item{}, fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, interval)
+	}
+
+	ret := kline.Item{
+		Exchange: b.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	symbol := b.FormatExchangeCurrency(pair, a).String()
+	dates := kline.DateRange{Start: start, End: end}.Split(interval, bitmexBucketLimit)
+	for x := range dates {
+		// buckets are timestamped with their end time
+		buckets, err := b.GetPreviousTrades(&TradeGetBucketedParams{
+			BinSize:   interval.Short(),
+			Count:     bitmexBucketLimit,
+			Symbol:    symbol,
+			StartTime: dates[x].Start.Add(interval.Duration()).UTC().Format(time.RFC3339),
+			EndTime:   dates[x].End.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return kline.Item{}, err
+		}
+		for y := range buckets {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   buckets[y].Timestamp.Add(-interval.Duration()),
+				Open:   buckets[y].Open,
+				High:   buckets[y].High,
+				Low:    buckets[y].Low,
+				Close:  buckets[y].Close,
+				Volume: float64(buckets[y].Volume),
+			})
+		}
+	}
+	ret.FilterByTimeRange(start, end)
+	return ret, nil
+}
+
+// GetPositions returns the open positions of the asset type's contracts
+func (b *Bitmex) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	if !b.SupportsAsset(assetType) {
+		return nil, fmt.Errorf("%v: %s", position.ErrUnsupportedAsset, assetType)
+	}
+	resp, err := b.GetUserPositions(PositionGetParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := b.GetAvailablePairs(assetType)
+	var positions []position.Position
+	for x := range resp {
+		if !resp[x].IsOpen || resp[x].CurrentQty == 0 {
+			continue
+		}
+		p, ok := b.symbolToPair(pairs, assetType, resp[x].Symbol)
+		if !ok {
+			continue
+		}
+		side := position.Long
+		if resp[x].CurrentQty < 0 {
+			side = position.Short
+		}
+		mode := position.Isolated
+		if resp[x].CrossMargin {
+			mode = position.Cross
+		}
+		scale := settlementScale(resp[x].Currency)
+		updated, _ := time.Parse(time.RFC3339, resp[x].Timestamp)
+		amount := math.Abs(float64(resp[x].CurrentQty))
+		positions = append(positions, position.Position{
+			Exchange:         b.Name,
+			AssetType:        assetType,
+			Pair:             p,
+			Side:             side,
+			Amount:           amount,
+			AvailableAmount:  amount,
+			EntryPrice:       resp[x].AvgEntryPrice,
+			MarkPrice:        resp[x].MarkPrice,
+			LiquidationPrice: resp[x].LiquidationPrice,
+			Leverage:         resp[x].Leverage,
+			MarginMode:       mode,
+			Margin:           float64(resp[x].PosMargin) / scale,
+			UnrealisedPNL:    float64(resp[x].UnrealisedPnl) / scale,
+			RealisedPNL:      float64(resp[x].RealisedPnl) / scale,
+			UpdatedAt:        updated,
+		})
+	}
+	return positions, nil
+}
+
+// SetLeverage sets the leverage of a contract's position, Bitmex switches the
+// position to isolated margin when a leverage is set
+func (b *Bitmex) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	if leverage < 0.01 || leverage > 100 {
+		return fmt.Errorf("%v: %v must be between 0.01 and 100", position.ErrInvalidLeverage, leverage)
+	}
+	_, err := b.LeveragePosition(PositionUpdateLeverageParams{
+		Symbol:   b.FormatExchangeCurrency(p, assetType).String(),
+		Leverage: leverage,
+	})
+	return err
+}
+
+// SetMarginMode switches a contract's position between cross and isolated
+// margin
+func (b *Bitmex) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	if mode != position.Cross && mode != position.Isolated {
+		return fmt.Errorf("%v: %s", position.ErrUnknownMarginMode, mode)
+	}
+	_, err := b.IsolatePosition(PositionIsolateMarginParams{
+		Symbol:  b.FormatExchangeCurrency(p, assetType).String(),
+		Enabled: mode == position.Isolated,
+	})
+	return err
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (b *Bitmex) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	if assetType != asset.PerpetualContract {
+		return nil, fmt.Errorf("%v: %s", position.ErrUnsupportedAsset, assetType)
+	}
+	resp, err := b.GetFundingRateHistory(&GenericRequestParams{
+		Symbol:  b.FormatExchangeCurrency(p, assetType).String(),
+		Count:   100,
+		Reverse: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]position.FundingRate, len(resp))
+	for x := range resp {
+		fundingTime, _ := time.Parse(time.RFC3339, resp[x].Timestamp)
+		rates[x] = position.FundingRate{
+			Exchange:  b.Name,
+			AssetType: assetType,
+			Pair:      p,
+			Rate:      resp[x].FundingRate,
+			Time:      fundingTime,
+		}
+	}
+	return rates, nil
+}
+
+// ClosePosition closes a contract's position with a market order
+func (b *Bitmex) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	positions, err := b.GetPositions(assetType)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	pos, err := position.Find(positions, p, assetType, side)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	orderSide := "Sell"
+	if pos.Side == position.Short {
+		orderSide = "Buy"
+	}
+	resp, err := b.CreateOrder(&OrderNewParams{
+		Symbol:   b.FormatExchangeCurrency(p, assetType).String(),
+		Side:     orderSide,
+		OrderQty: pos.Amount,
+		OrdType:  "Market",
+		ExecInst: "Close",
+	})
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return order.SubmitResponse{
+		IsOrderPlaced: true,
+		FullyMatched:  true,
+		OrderID:       resp.OrderID,
+	}, nil
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a contract, funding is only set for perpetual contracts
+func (b *Bitmex) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	if assetType != asset.PerpetualContract && assetType != asset.Futures {
+		return nil, common.ErrFunctionNotSupported
+	}
+	symbol := b.FormatExchangeCurrency(p, assetType).String()
+	resp, err := b.GetInstruments(&GenericRequestParams{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%s instrument not found", symbol)
+	}
+
+	stats := derivatives.Stats{
+		Exchange:     b.Name,
+		AssetType:    assetType,
+		Pair:         p,
+		MarkPrice:    resp[0].MarkPrice,
+		IndexPrice:   resp[0].IndicativeSettlePrice,
+		OpenInterest: float64(resp[0].OpenInterest),
+		LastUpdated:  resp[0].Timestamp,
+	}
+	if assetType == asset.PerpetualContract {
+		stats.FundingRate = resp[0].FundingRate
+		stats.PredictedFundingRate = resp[0].IndicativeFundingRate
+		stats.NextFundingTime, _ = time.Parse(time.RFC3339, resp[0].FundingTimestamp)
+	}
+
+	err = derivatives.Process(&stats)
+	if err != nil {
+		return nil, err
+	}
+	return derivatives.Get(b.Name, p, assetType)
+}
+
+// symbolToPair returns the available pair of the asset type matching a
+// contract symbol
+func (b *Bitmex) symbolToPair(pairs currency.Pairs, assetType asset.Item, symbol string) (currency.Pair, bool) {
+	for x := range pairs {
+		if b.FormatExchangeCurrency(pairs[x], assetType).String() == symbol {
+			return pairs[x], true
+		}
+	}
+	return currency.Pair{}, false
+}
+
+// settlementScale returns the number of units of a settlement currency's
+// smallest denomination, in which margin and PNL are reported, per unit
+func settlementScale(c string) float64 {
+	switch c {
+	case "XBt":
+		return 1e8
+	case "USDt":
+		return 1e6
+	default:
+		return 1
+	}
+}
+
+// SubmitOrders submits a batch of orders
+func (b *Bitmex) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (b *Bitmex) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
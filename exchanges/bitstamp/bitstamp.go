@@ -15,7 +15,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -52,6 +54,8 @@ const (
 	bitstampAPIXrpDeposit         = "xrp_address"
 	bitstampAPIReturnType         = "string"
 	bitstampAPITradingPairsInfo   = "trading-pairs-info"
+	bitstampAPIOHLC               = "ohlc"
+	bitstampOHLCLimit             = 1000
 
 	bitstampAuthRate   = 8000
 	bitstampUnauthRate = 8000
@@ -64,11 +68,6 @@ type Bitstamp struct {
 	WebsocketConn *wshandler.WebsocketConnection
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *Bitstamp) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
-
 // GetFee returns an estimate of fee based on type of transaction
 func (b *Bitstamp) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
@@ -252,6 +251,30 @@ func (b *Bitstamp) GetTransactions(currencyPair string, values url.Values) ([]Tr
 	return transactions, b.SendHTTPRequest(path, &transactions)
 }
 
+// GetOHLC returns up to limit candles of a currency pair from the start
+// date, step is the candle interval in seconds
+func (b *Bitstamp) GetOHLC(currencyPair string, start, end time.Time, step int64, limit int) ([]OHLC, error) {
+	values := url.Values{}
+	values.Set("step", strconv.FormatInt(step, 10))
+	values.Set("limit", strconv.Itoa(limit))
+	values.Set("start", strconv.FormatInt(start.Unix(), 10))
+	values.Set("end", strconv.FormatInt(end.Unix(), 10))
+
+	var resp OHLCResponse
+	path := common.EncodeURLValues(
+		fmt.Sprintf(
+			"%s/v%s/%s/%s/",
+			b.API.Endpoints.URL,
+			bitstampAPIVersion,
+			bitstampAPIOHLC,
+			strings.ToLower(currencyPair),
+		),
+		values,
+	)
+
+	return resp.Data.OHLC, b.SendHTTPRequest(path, &resp)
+}
+
 // GetEURUSDConversionRate returns the conversion rate between Euro and USD
 func (b *Bitstamp) GetEURUSDConversionRate() (EURUSDConversionRate, error) {
 	rate := EURUSDConversionRate{}
@@ -708,3 +731,31 @@ func (b *Bitstamp) SendAuthenticatedHTTPRequest(path string, v2 bool, values url
 func parseTime(dateTime string) (time.Time, error) {
 	return time.Parse(bitstampTimeLayout, dateTime)
 }
+
+// tradingRules converts the enabled trading pairs to trading rules, the
+// minimum order is the order value in the counter currency such as "5.0 USD"
+// and is loaded as the minimum notional
+func tradingRules(pairs []TradingPair) ([]rules.Rules, error) {
+	var r []rules.Rules
+	for x := range pairs {
+		if pairs[x].Trading != "Enabled" {
+			continue
+		}
+		minimum := strings.Fields(pairs[x].MinimumOrder)
+		if len(minimum) == 0 {
+			return nil, fmt.Errorf("%s minimum order not set", pairs[x].Name)
+		}
+		minNotional, err := strconv.ParseFloat(minimum[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s minimum order: %v", pairs[x].Name, err)
+		}
+		r = append(r, rules.Rules{
+			Pair:        currency.NewPairDelimiter(pairs[x].Name, "/"),
+			Asset:       asset.Spot,
+			PriceTick:   rules.PrecisionToStep(pairs[x].CounterDecimals),
+			AmountStep:  rules.PrecisionToStep(pairs[x].BaseDecimals),
+			MinNotional: minNotional,
+		})
+	}
+	return r, nil
+}
@@ -193,6 +193,37 @@ func TestGetTradingPairs(t *testing.T) {
 	}
 }
 
+func TestTradingRules(t *testing.T) {
+	t.Parallel()
+	r, err := tradingRules([]TradingPair{
+		{
+			Name:            "BTC/USD",
+			BaseDecimals:    8,
+			CounterDecimals: 2,
+			MinimumOrder:    "5.0 USD",
+			Trading:         "Enabled",
+		},
+		{Name: "BTC/EUR", MinimumOrder: "5.0 EUR", Trading: "Disabled"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r) != 1 {
+		t.Fatalf("expected rules for the enabled pair only, received %v", len(r))
+	}
+	if !r[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USD)) ||
+		r[0].PriceTick != 0.01 ||
+		r[0].AmountStep != 0.00000001 ||
+		r[0].MinNotional != 5 {
+		t.Errorf("unexpected trading rules %+v", r[0])
+	}
+
+	_, err = tradingRules([]TradingPair{{Name: "BTC/USD", MinimumOrder: "five", Trading: "Enabled"}})
+	if err == nil {
+		t.Error("expected an error for an unparsable minimum order")
+	}
+}
+
 func TestGetTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -60,6 +60,24 @@ type Transactions struct {
 	Amount  float64 `json:"amount,string"`
 }
 
+// OHLCResponse holds the candles of a currency pair
+type OHLCResponse struct {
+	Data struct {
+		Pair string `json:"pair"`
+		OHLC []OHLC `json:"ohlc"`
+	} `json:"data"`
+}
+
+// OHLC holds a single candle
+type OHLC struct {
+	Timestamp int64   `json:"timestamp,string"`
+	Open      float64 `json:"open,string"`
+	High      float64 `json:"high,string"`
+	Low       float64 `json:"low,string"`
+	Close     float64 `json:"close,string"`
+	Volume    float64 `json:"volume,string"`
+}
+
 // EURUSDConversionRate holds buy sell conversion rate information
 type EURUSDConversionRate struct {
 	Buy  float64 `json:"buy,string"`
@@ -11,6 +11,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
@@ -108,12 +109,26 @@ func (b *Bitstamp) WsHandleData() {
 				currencyPair := strings.Split(wsResponse.Channel, "_")
 				p := currency.NewPairFromString(strings.ToUpper(currencyPair[2]))
 
+				microtime, err := strconv.ParseInt(wsTradeTemp.Data.Microtimestamp, 10, 64)
+				if err != nil {
+					b.Websocket.DataHandler <- err
+					continue
+				}
+				// 0 for buy 1 for sell
+				side := order.Buy
+				if wsTradeTemp.Data.Type == 1 {
+					side = order.Sell
+				}
+
 				b.Websocket.DataHandler <- wshandler.TradeData{
+					Timestamp:    time.Unix(0, microtime*int64(time.Microsecond)),
 					Price:        wsTradeTemp.Data.Price,
 					Amount:       wsTradeTemp.Data.Amount,
 					CurrencyPair: p,
 					Exchange:     b.Name,
 					AssetType:    asset.Spot,
+					TID:          strconv.Itoa(wsTradeTemp.Data.ID),
+					Side:         side.String(),
 				}
 			}
 		}
@@ -2,6 +2,7 @@ package bitstamp
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -13,8 +14,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -202,7 +206,10 @@ func (b *Bitstamp) FetchTradablePairs(asset asset.Item) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	return tradablePairs(pairs), nil
+}
 
+func tradablePairs(pairs []TradingPair) []string {
 	var products []string
 	for x := range pairs {
 		if pairs[x].Trading != "Enabled" {
@@ -212,19 +219,31 @@ func (b *Bitstamp) FetchTradablePairs(asset asset.Item) ([]string, error) {
 		pair := strings.Split(pairs[x].Name, "/")
 		products = append(products, pair[0]+pair[1])
 	}
-
-	return products, nil
+	return products
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the trading rules of the pairs are loaded
+// from the same trading pairs
 func (b *Bitstamp) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+	pairs, err := b.GetTradingPairs()
+	if err != nil {
+		return err
+	}
+
+	err = b.UpdatePairs(currency.NewPairsFromStrings(tradablePairs(pairs)),
+		asset.Spot,
+		false,
+		forceUpdate)
 	if err != nil {
 		return err
 	}
 
-	return b.UpdatePairs(currency.NewPairsFromStrings(pairs), asset.Spot, false, forceUpdate)
+	r, err := tradingRules(pairs)
+	if err != nil {
+		return err
+	}
+	return b.LoadTradingRules(r)
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -362,9 +381,30 @@ func (b *Bitstamp) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
+// GetExchangeHistory returns the most recent public trades for a currency pair
 func (b *Bitstamp) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
-	return nil, common.ErrNotYetImplemented
+	trades, err := b.GetTransactions(p.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		// 0 for buy 1 for sell
+		side := order.Buy
+		if trades[i].Type == 1 {
+			side = order.Sell
+		}
+		resp[i] = exchange.TradeHistory{
+			Timestamp: time.Unix(trades[i].Date, 0),
+			TID:       trades[i].TradeID,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Exchange:  b.Name,
+			Type:      side.String(),
+		}
+	}
+	return resp, nil
 }
 
 // SubmitOrder submits a new order
@@ -661,9 +701,90 @@ func (b *Bitstamp) AuthenticateWebsocket() error {
 	return common.ErrFunctionNotSupported
 }
 
+// GetHistoricCandles returns candles between a time period for a set time
+// interval, Bitstamp has no weekly candles
+func (b *Bitstamp) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	if interval == kline.OneWeek {
+		return kline.Item{}, fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, interval)
+	}
+
+	ret := kline.Item{
+		Exchange: b.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	symbol := b.FormatExchangeCurrency(pair, a).String()
+	dates := kline.DateRange{Start: start, End: end}.Split(interval, bitstampOHLCLimit)
+	for x := range dates {
+		candles, err := b.GetOHLC(symbol, dates[x].Start, dates[x].End.Add(-time.Second),
+			int64(interval.Duration().Seconds()), bitstampOHLCLimit)
+		if err != nil {
+			return kline.Item{}, err
+		}
+		for y := range candles {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   time.Unix(candles[y].Timestamp, 0),
+				Open:   candles[y].Open,
+				High:   candles[y].High,
+				Low:    candles[y].Low,
+				Close:  candles[y].Close,
+				Volume: candles[y].Volume,
+			})
+		}
+	}
+	ret.FilterByTimeRange(start, end)
+	return ret, nil
+}
+
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
 func (b *Bitstamp) ValidateCredentials() error {
 	_, err := b.UpdateAccountInfo()
 	return b.CheckTransientError(err)
 }
+
+// GetPositions returns the open derivatives positions of the asset type
+func (b *Bitstamp) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (b *Bitstamp) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (b *Bitstamp) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (b *Bitstamp) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (b *Bitstamp) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (b *Bitstamp) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrders submits a batch of orders
+func (b *Bitstamp) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (b *Bitstamp) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
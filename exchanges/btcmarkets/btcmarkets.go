@@ -51,6 +51,7 @@ const (
 
 	btcmarketsAuthLimit   = 3
 	btcmarketsUnauthLimit = 50
+	btcmarketsCandleLimit = 1000
 
 	orderFailed             = "Failed"
 	orderPartiallyCancelled = "Partially Cancelled"
@@ -82,11 +83,6 @@ type BTCMarkets struct {
 	WebsocketConn *wshandler.WebsocketConnection
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *BTCMarkets) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
-
 // GetMarkets returns the BTCMarkets instruments
 func (b *BTCMarkets) GetMarkets() ([]Market, error) {
 	var resp []Market
@@ -153,6 +153,7 @@ func (b *BTCMarkets) WsHandleData() {
 					CurrencyPair: p,
 					AssetType:    asset.Spot,
 					Exchange:     b.Name,
+					TID:          strconv.FormatInt(trade.TradeID, 10),
 					Price:        trade.Price,
 					Amount:       trade.Volume,
 					Side:         order.SideUnknown.String(),
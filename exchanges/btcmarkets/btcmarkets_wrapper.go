@@ -13,8 +13,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -726,3 +729,90 @@ func (b *BTCMarkets) ValidateCredentials() error {
 
 	return nil
 }
+
+// GetHistoricCandles returns candles between a time period for a set time
+// interval, BTC Markets only serves 1m, 1h and 1d candles
+func (b *BTCMarkets) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	switch interval {
+	case kline.OneMin, kline.OneHour, kline.OneDay:
+	default:
+		return kline.Item{}, fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, interval)
+	}
+
+	ret := kline.Item{
+		Exchange: b.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	marketID := b.FormatExchangeCurrency(pair, a).String()
+	dates := kline.DateRange{Start: start, End: end}.Split(interval, btcmarketsCandleLimit)
+	for x := range dates {
+		candles, err := b.GetMarketCandles(marketID,
+			interval.Short(),
+			dates[x].Start.UTC().Format(time.RFC3339),
+			dates[x].End.UTC().Format(time.RFC3339),
+			0, -1, btcmarketsCandleLimit)
+		if err != nil {
+			return kline.Item{}, err
+		}
+		for y := range candles {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   candles[y].Time,
+				Open:   candles[y].Open,
+				High:   candles[y].High,
+				Low:    candles[y].Low,
+				Close:  candles[y].Close,
+				Volume: candles[y].Volume,
+			})
+		}
+	}
+	ret.FilterByTimeRange(start, end)
+	ret.SortCandlesByTimestamp(false)
+	return ret, nil
+}
+
+// GetPositions returns the open derivatives positions of the asset type
+func (b *BTCMarkets) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (b *BTCMarkets) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (b *BTCMarkets) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (b *BTCMarkets) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (b *BTCMarkets) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (b *BTCMarkets) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrders submits a batch of orders
+func (b *BTCMarkets) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (b *BTCMarkets) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -54,6 +56,9 @@ const (
 
 	coinbaseproAuthRate   = 5
 	coinbaseproUnauthRate = 3
+	// coinbaseproCandleLimit is the maximum number of candles returned per
+	// request
+	coinbaseproCandleLimit = 300
 )
 
 // CoinbasePro is the overarching type across the coinbasepro package
@@ -552,9 +557,10 @@ func (c *CoinbasePro) GetPosition() (AccountOverview, error) {
 		c.SendAuthenticatedHTTPRequest(http.MethodGet, coinbaseproPosition, nil, &resp)
 }
 
-// ClosePosition closes a position and allowing you to repay position as well
+// CloseMarginPosition closes a position and allowing you to repay position as
+// well
 // repayOnly -  allows the position to be repaid
-func (c *CoinbasePro) ClosePosition(repayOnly bool) (AccountOverview, error) {
+func (c *CoinbasePro) CloseMarginPosition(repayOnly bool) (AccountOverview, error) {
 	resp := AccountOverview{}
 	req := make(map[string]interface{})
 	req["repay_only"] = repayOnly
@@ -854,3 +860,22 @@ func getInternationalBankDepositFee(c currency.Code) float64 {
 
 	return fee
 }
+
+// tradingRules converts the products to trading rules, the minimum market
+// funds are in the quote currency and are loaded as the minimum notional
+func tradingRules(products []Product) []rules.Rules {
+	r := make([]rules.Rules, len(products))
+	for x := range products {
+		r[x] = rules.Rules{
+			Pair: currency.NewPairFromStrings(products[x].BaseCurrency,
+				products[x].QuoteCurrency),
+			Asset:       asset.Spot,
+			PriceTick:   products[x].QuoteIncrement,
+			AmountStep:  products[x].BaseIncrement,
+			MinAmount:   products[x].BaseMinSize,
+			MaxAmount:   products[x].BaseMaxSize,
+			MinNotional: products[x].MinMarketFunds,
+		}
+	}
+	return r
+}
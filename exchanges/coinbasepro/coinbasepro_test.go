@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -76,6 +79,16 @@ func TestGetTrades(t *testing.T) {
 	}
 }
 
+func TestGetExchangeHistory(t *testing.T) {
+	trades, err := c.GetExchangeHistory(currency.NewPairFromString(testPair), asset.Spot)
+	if err != nil {
+		t.Fatal("GetExchangeHistory() error", err)
+	}
+	if len(trades) > 0 && (trades[0].TID == 0 || trades[0].Timestamp.IsZero()) {
+		t.Error("GetExchangeHistory() returned an invalid trade")
+	}
+}
+
 func TestGetHistoricRatesApiCheck(t *testing.T) {
 	e := expectedCandles(5, 300, 60)
 	if e != nil {
@@ -128,6 +141,44 @@ func TestGetHistoricRatesGranularityCheck(t *testing.T) {
 	}
 }
 
+func TestTradingRules(t *testing.T) {
+	t.Parallel()
+	r := tradingRules([]Product{
+		{
+			BaseCurrency:   "BTC",
+			QuoteCurrency:  "USD",
+			BaseMinSize:    0.001,
+			BaseMaxSize:    280,
+			BaseIncrement:  0.00000001,
+			QuoteIncrement: 0.01,
+			MinMarketFunds: 5,
+		},
+	})
+	if len(r) != 1 {
+		t.Fatalf("expected rules for one product, received %v", len(r))
+	}
+	if !r[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USD)) ||
+		r[0].PriceTick != 0.01 ||
+		r[0].AmountStep != 0.00000001 ||
+		r[0].MinAmount != 0.001 ||
+		r[0].MaxAmount != 280 ||
+		r[0].MinNotional != 5 {
+		t.Errorf("unexpected trading rules %+v", r[0])
+	}
+}
+
+func TestGetHistoricCandlesUnsupportedInterval(t *testing.T) {
+	t.Parallel()
+	end := time.Now().UTC()
+	for _, interval := range []kline.Interval{kline.FourHour, kline.OneWeek} {
+		_, err := c.GetHistoricCandles(currency.NewPairFromString(testPair), asset.Spot,
+			end.Add(-interval.Duration()*2), end, interval)
+		if err == nil || !strings.Contains(err.Error(), kline.ErrUnsupportedInterval.Error()) {
+			t.Errorf("expected %s interval to be unsupported, received %v", interval, err)
+		}
+	}
+}
+
 func TestGetStats(t *testing.T) {
 	_, err := c.GetStats(testPair)
 	if err != nil {
@@ -220,7 +271,7 @@ func TestAuthRequests(t *testing.T) {
 	if err == nil {
 		t.Error("Expecting error")
 	}
-	_, err = c.ClosePosition(false)
+	_, err = c.CloseMarginPosition(false)
 	if err == nil {
 		t.Error("Expecting error")
 	}
@@ -13,8 +13,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -233,26 +236,36 @@ func (c *CoinbasePro) FetchTradablePairs(asset asset.Item) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	return c.tradablePairs(pairs, asset), nil
+}
 
+func (c *CoinbasePro) tradablePairs(pairs []Product, asset asset.Item) []string {
 	var products []string
 	for x := range pairs {
 		products = append(products, pairs[x].BaseCurrency+
 			c.GetPairFormat(asset, false).Delimiter+
 			pairs[x].QuoteCurrency)
 	}
-
-	return products, nil
+	return products
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the trading rules of the pairs are loaded
+// from the same products
 func (c *CoinbasePro) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := c.FetchTradablePairs(asset.Spot)
+	pairs, err := c.GetProducts()
 	if err != nil {
 		return err
 	}
 
-	return c.UpdatePairs(currency.NewPairsFromStrings(pairs), asset.Spot, false, forceUpdate)
+	err = c.UpdatePairs(currency.NewPairsFromStrings(c.tradablePairs(pairs, asset.Spot)),
+		asset.Spot,
+		false,
+		forceUpdate)
+	if err != nil {
+		return err
+	}
+	return c.LoadTradingRules(tradingRules(pairs))
 }
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
@@ -383,9 +396,29 @@ func (c *CoinbasePro) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
+// GetExchangeHistory returns the most recent public trades for a currency pair
 func (c *CoinbasePro) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
-	return nil, common.ErrNotYetImplemented
+	trades, err := c.GetTrades(c.FormatExchangeCurrency(p, assetType).String())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		tradeTime, err := time.Parse(time.RFC3339, trades[i].Time)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = exchange.TradeHistory{
+			Timestamp: tradeTime,
+			TID:       trades[i].TradeID,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Size,
+			Exchange:  c.Name,
+			Type:      trades[i].Side,
+		}
+	}
+	return resp, nil
 }
 
 // SubmitOrder submits a new order
@@ -633,27 +666,49 @@ func (c *CoinbasePro) AuthenticateWebsocket() error {
 	return common.ErrFunctionNotSupported
 }
 
-// GetHistoricCandles Allows to retrieve an amount of candles back in time starting from now up to rangesize * granularity, where granularity is the trade period covered by each candle
-func (c *CoinbasePro) GetHistoricCandles(p currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	end := time.Now().UTC()
-	b := granularity * rangesize
-	start := time.Now().UTC().Add(-time.Second * time.Duration(b))
-	history, err := c.GetHistoricRates(p.String(), start.Format(time.RFC3339), end.Format(time.RFC3339), granularity)
-	if err != nil {
-		return nil, err
+// GetHistoricCandles returns candles between a time period for a set time
+// interval, Coinbase Pro has no four hour or weekly candles
+func (c *CoinbasePro) GetHistoricCandles(p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
 	}
-	var candles []exchange.Candle
-	for x := range history {
-		candles = append(candles, exchange.Candle{
-			Time:   history[x].Time,
-			Low:    history[x].Low,
-			High:   history[x].High,
-			Open:   history[x].Open,
-			Close:  history[x].Close,
-			Volume: history[x].Volume,
-		})
+	switch interval {
+	case kline.OneMin, kline.FiveMin, kline.FifteenMin, kline.OneHour, kline.OneDay:
+	default:
+		return kline.Item{}, fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, interval)
+	}
+
+	ret := kline.Item{
+		Exchange: c.Name,
+		Pair:     p,
+		Asset:    a,
+		Interval: interval,
+	}
+	product := c.FormatExchangeCurrency(p, a).String()
+	dates := kline.DateRange{Start: start, End: end}.Split(interval, coinbaseproCandleLimit)
+	for x := range dates {
+		// the end date is inclusive
+		history, err := c.GetHistoricRates(product,
+			dates[x].Start.UTC().Format(time.RFC3339),
+			dates[x].End.Add(-time.Second).UTC().Format(time.RFC3339),
+			int64(interval.Duration().Seconds()))
+		if err != nil {
+			return kline.Item{}, err
+		}
+		for y := range history {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   time.Unix(history[y].Time, 0),
+				Low:    history[y].Low,
+				High:   history[y].High,
+				Open:   history[y].Open,
+				Close:  history[y].Close,
+				Volume: history[y].Volume,
+			})
+		}
 	}
-	return candles, nil
+	ret.FilterByTimeRange(start, end)
+	ret.SortCandlesByTimestamp(false)
+	return ret, nil
 }
 
 // ValidateCredentials validates current credentials used for wrapper
@@ -662,3 +717,45 @@ func (c *CoinbasePro) ValidateCredentials() error {
 	_, err := c.UpdateAccountInfo()
 	return c.CheckTransientError(err)
 }
+
+// GetPositions returns the open derivatives positions of the asset type
+func (c *CoinbasePro) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (c *CoinbasePro) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (c *CoinbasePro) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (c *CoinbasePro) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (c *CoinbasePro) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (c *CoinbasePro) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrders submits a batch of orders
+func (c *CoinbasePro) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (c *CoinbasePro) CancelOrders(cancellations []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
@@ -221,6 +221,7 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 				c.GetPairFormat(asset.Spot, true)),
 			AssetType: asset.Spot,
 			Exchange:  c.Name,
+			TID:       strconv.FormatInt(tradeUpdate.TransID, 10),
 			Price:     tradeUpdate.Price,
 			Side:      tradeUpdate.Side,
 		}
@@ -0,0 +1,143 @@
+package derivatives
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func init() {
+	service = new(Service)
+	service.Stats = make(map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Item)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// SubscribeStats subscribes to the stats of a contract and returns a
+// communication channel to stream new stats updates
+func SubscribeStats(exchange string, p currency.Pair, a asset.Item) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	item, ok := service.Stats[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("%v: %s %s %s",
+			ErrStatsNotFound,
+			exchange,
+			p,
+			a)
+	}
+	return service.mux.Subscribe(item.Main)
+}
+
+// SubscribeToExchangeStats subscribes to the stats of every contract on an
+// exchange
+func SubscribeToExchangeStats(exchange string) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	id, ok := service.Exchange[exchange]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("%v: %s", ErrStatsNotFound, exchange)
+	}
+	return service.mux.Subscribe(id)
+}
+
+// Get returns the latest stats of a contract
+func Get(exchange string, p currency.Pair, a asset.Item) (*Stats, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	item, ok := service.Stats[exchange][p.Base.Item][p.Quote.Item][a]
+	if !ok {
+		return nil, fmt.Errorf("%v: %s %s %s",
+			ErrStatsNotFound,
+			exchange,
+			p,
+			a)
+	}
+	s := item.Stats
+	return &s, nil
+}
+
+// Process validates and stores the stats of a contract then publishes them to
+// its subscribers
+func Process(s *Stats) error {
+	if s == nil {
+		return errStatsNil
+	}
+	if s.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if s.Pair.IsEmpty() {
+		return fmt.Errorf("%s %v", s.Exchange, errPairNotSet)
+	}
+	if s.AssetType == "" {
+		return fmt.Errorf("%s %s %v", s.Exchange, s.Pair, errAssetTypeNotSet)
+	}
+	s.Exchange = strings.ToLower(s.Exchange)
+	if s.LastUpdated.IsZero() {
+		s.LastUpdated = time.Now()
+	}
+	return service.Update(s)
+}
+
+// Update stores the stats, assigning dispatch IDs to contracts seen for the
+// first time, and publishes them
+func (s *Service) Update(stats *Stats) error {
+	s.Lock()
+	base, ok := s.Stats[stats.Exchange]
+	if !ok {
+		base = make(map[*currency.Item]map[*currency.Item]map[asset.Item]*Item)
+		s.Stats[stats.Exchange] = base
+	}
+	quote, ok := base[stats.Pair.Base.Item]
+	if !ok {
+		quote = make(map[*currency.Item]map[asset.Item]*Item)
+		base[stats.Pair.Base.Item] = quote
+	}
+	assets, ok := quote[stats.Pair.Quote.Item]
+	if !ok {
+		assets = make(map[asset.Item]*Item)
+		quote[stats.Pair.Quote.Item] = assets
+	}
+	item, ok := assets[stats.AssetType]
+	if !ok {
+		ids, err := s.GetAssociations(stats.Exchange)
+		if err != nil {
+			s.Unlock()
+			return err
+		}
+		mainID, err := s.mux.GetID()
+		if err != nil {
+			s.Unlock()
+			return err
+		}
+		item = &Item{Main: mainID, Assoc: ids}
+		assets[stats.AssetType] = item
+	}
+	item.Stats = *stats
+	ids := append([]uuid.UUID{item.Main}, item.Assoc...)
+	s.Unlock()
+	return s.mux.Publish(ids, stats)
+}
+
+// GetAssociations returns the exchange wide dispatch ID the stats of an
+// exchange contract are also published to
+func (s *Service) GetAssociations(exchange string) ([]uuid.UUID, error) {
+	exchangeID, ok := s.Exchange[exchange]
+	if !ok {
+		var err error
+		exchangeID, err = s.mux.GetID()
+		if err != nil {
+			return nil, err
+		}
+		s.Exchange[exchange] = exchangeID
+	}
+	return []uuid.UUID{exchangeID}, nil
+}
@@ -0,0 +1,100 @@
+package derivatives
+
+import (
+	"log"
+	"os"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestProcess(t *testing.T) {
+	p := currency.NewPairWithDelimiter("BTC-USD", "SWAP", "_")
+	if err := Process(nil); err == nil {
+		t.Error("expected an error processing nil stats")
+	}
+	if err := Process(&Stats{Pair: p, AssetType: asset.PerpetualSwap}); err == nil {
+		t.Error("expected an error when the exchange is unset")
+	}
+	if err := Process(&Stats{Exchange: "ProcessTest", AssetType: asset.PerpetualSwap}); err == nil {
+		t.Error("expected an error when the pair is unset")
+	}
+	if err := Process(&Stats{Exchange: "ProcessTest", Pair: p}); err == nil {
+		t.Error("expected an error when the asset type is unset")
+	}
+
+	err := Process(&Stats{
+		Exchange:    "ProcessTest",
+		Pair:        p,
+		AssetType:   asset.PerpetualSwap,
+		FundingRate: 0.0001,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := Get("processtest", p, asset.PerpetualSwap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.FundingRate != 0.0001 || s.LastUpdated.IsZero() {
+		t.Errorf("unexpected stats %+v", s)
+	}
+
+	err = Process(&Stats{
+		Exchange:     "ProcessTest",
+		Pair:         p,
+		AssetType:    asset.PerpetualSwap,
+		FundingRate:  0.0002,
+		OpenInterest: 1337,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err = Get("ProcessTest", p, asset.PerpetualSwap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.FundingRate != 0.0002 || s.OpenInterest != 1337 {
+		t.Errorf("expected stats to be updated, received %+v", s)
+	}
+
+	if _, err = Get("ProcessTest", p, asset.Futures); err == nil {
+		t.Error("expected an error for an unknown asset type")
+	}
+}
+
+func TestSubscribeStats(t *testing.T) {
+	p := currency.NewPairWithDelimiter("ETH-USD", "SWAP", "_")
+	if _, err := SubscribeStats("SubscribeTest", p, asset.PerpetualSwap); err == nil {
+		t.Error("expected an error subscribing to unknown stats")
+	}
+	if _, err := SubscribeToExchangeStats("SubscribeTest"); err == nil {
+		t.Error("expected an error subscribing to an unknown exchange")
+	}
+
+	stats := &Stats{
+		Exchange:  "SubscribeTest",
+		Pair:      p,
+		AssetType: asset.PerpetualSwap,
+	}
+	if err := Process(stats); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := SubscribeStats("SubscribeTest", p, asset.PerpetualSwap); err != nil {
+		t.Error(err)
+	}
+	if _, err := SubscribeToExchangeStats("subscribetest"); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,57 @@
+package derivatives
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Public errors
+var (
+	ErrStatsNotFound = errors.New("derivatives stats not found")
+)
+
+var (
+	errExchangeNameUnset = errors.New("derivatives stats exchange name not set")
+	errPairNotSet        = errors.New("derivatives stats currency pair not set")
+	errAssetTypeNotSet   = errors.New("derivatives stats asset type not set")
+	errStatsNil          = errors.New("derivatives stats is nil")
+
+	service *Service
+)
+
+// Service holds the latest derivatives stats of each exchange contract and
+// publishes updates to their dispatch subscribers
+type Service struct {
+	Stats    map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Item
+	Exchange map[string]uuid.UUID
+	mux      *dispatch.Mux
+	sync.RWMutex
+}
+
+// Stats defines the funding, pricing and open interest of a futures or
+// perpetual contract. Funding fields are only set for perpetual contracts
+type Stats struct {
+	Exchange             string
+	AssetType            asset.Item
+	Pair                 currency.Pair
+	FundingRate          float64
+	PredictedFundingRate float64
+	NextFundingTime      time.Time
+	MarkPrice            float64
+	IndexPrice           float64
+	OpenInterest         float64
+	LastUpdated          time.Time
+}
+
+// Item holds the stats of a contract and its dispatch IDs
+type Item struct {
+	Stats
+	Main  uuid.UUID
+	Assoc []uuid.UUID
+}
@@ -9,12 +9,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
 
@@ -795,3 +798,80 @@ func (e *Base) CheckTransientError(err error) error {
 	}
 	return err
 }
+
+// LoadTradingRules stores the exchange's trading rules, the rules of each
+// asset type loaded replace the asset type's previously stored rules
+func (e *Base) LoadTradingRules(r []rules.Rules) error {
+	return e.TradingRules.Load(r)
+}
+
+// GetTradingRules returns the trading rules of a currency pair, orders must
+// conform to them for the exchange to accept them
+func (e *Base) GetTradingRules(p currency.Pair, a asset.Item) (*rules.Rules, error) {
+	return e.TradingRules.Get(p, a)
+}
+
+// SubmitBatch submits a batch of orders through the exchange's batch order
+// endpoint. Exchanges without one have the orders submitted one at a time,
+// spaced out across their authenticated rate limit, stopping at the first
+// order which fails. The responses of the orders submitted are returned with
+// the error
+func SubmitBatch(e IBotExchange, s []order.Submit) ([]order.SubmitResponse, error) {
+	resp, err := e.SubmitOrders(s)
+	if err != common.ErrFunctionNotSupported {
+		return resp, err
+	}
+
+	resp = make([]order.SubmitResponse, 0, len(s))
+	interval := batchInterval(e)
+	for x := range s {
+		if x > 0 {
+			time.Sleep(interval)
+		}
+		r, err := e.SubmitOrder(&s[x])
+		if err != nil {
+			return resp, fmt.Errorf("%s order %d of %d: %v",
+				e.GetName(), x+1, len(s), err)
+		}
+		resp = append(resp, r)
+	}
+	return resp, nil
+}
+
+// CancelBatch cancels a batch of orders through the exchange's batch cancel
+// endpoint. Exchanges without one have the orders cancelled one at a time,
+// spaced out across their authenticated rate limit. The orders which fail to
+// cancel are returned in the response status with their error
+func CancelBatch(e IBotExchange, c []order.Cancel) (order.CancelAllResponse, error) {
+	resp, err := e.CancelOrders(c)
+	if err != common.ErrFunctionNotSupported {
+		return resp, err
+	}
+
+	resp = order.CancelAllResponse{Status: make(map[string]string)}
+	interval := batchInterval(e)
+	for x := range c {
+		if x > 0 {
+			time.Sleep(interval)
+		}
+		if err = e.CancelOrder(&c[x]); err != nil {
+			resp.Status[c[x].OrderID] = err.Error()
+		}
+	}
+	return resp, nil
+}
+
+// batchInterval returns the delay between the orders of a batch sent one at a
+// time, the exchange's authenticated rate limit duration divided by its rate
+func batchInterval(e IBotExchange) time.Duration {
+	r := e.GetBase().Requester
+	if r == nil || r.AuthLimit == nil || r.DisableRateLimiter ||
+		request.DisableRateLimiter {
+		return 0
+	}
+	rate := r.AuthLimit.GetRate()
+	if rate <= 0 {
+		return 0
+	}
+	return r.AuthLimit.GetDuration() / time.Duration(rate)
+}
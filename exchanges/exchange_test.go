@@ -1,7 +1,9 @@
 package exchange
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -10,8 +12,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
 
@@ -1509,3 +1513,109 @@ func TestGetAssetType(t *testing.T) {
 		t.Error("should be spot but is", a)
 	}
 }
+
+func TestTradingRules(t *testing.T) {
+	t.Parallel()
+	var b Base
+	p := currency.NewPair(currency.BTC, currency.USD)
+	if _, err := b.GetTradingRules(p, asset.Spot); err == nil {
+		t.Error("expected an error without trading rules loaded")
+	}
+	err := b.LoadTradingRules([]rules.Rules{
+		{Pair: p, Asset: asset.Spot, PriceTick: 0.5, MinAmount: 0.01},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := b.GetTradingRules(p, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.PriceTick != 0.5 || r.MinAmount != 0.01 {
+		t.Errorf("unexpected trading rules %+v", r)
+	}
+}
+
+// batchTestExchange submits and cancels orders one at a time, failing orders
+// without an amount or order ID
+type batchTestExchange struct {
+	IBotExchange
+	base      Base
+	submitted int
+	cancelled int
+}
+
+func (b *batchTestExchange) GetName() string { return "test" }
+
+func (b *batchTestExchange) GetBase() *Base { return &b.base }
+
+func (b *batchTestExchange) SubmitOrders(_ []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+func (b *batchTestExchange) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+	if s.Amount <= 0 {
+		return order.SubmitResponse{}, errors.New("invalid amount")
+	}
+	b.submitted++
+	return order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       strconv.Itoa(b.submitted),
+	}, nil
+}
+
+func (b *batchTestExchange) CancelOrders(_ []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
+
+func (b *batchTestExchange) CancelOrder(c *order.Cancel) error {
+	if c.OrderID == "" {
+		return errors.New("order id is empty")
+	}
+	b.cancelled++
+	return nil
+}
+
+func TestSubmitBatch(t *testing.T) {
+	t.Parallel()
+	e := &batchTestExchange{}
+	e.base.Requester = request.New("test",
+		request.NewRateLimit(time.Millisecond*20, 2),
+		request.NewRateLimit(time.Second, 0),
+		common.NewHTTPClientWithTimeout(0))
+
+	start := time.Now()
+	resp, err := SubmitBatch(e, []order.Submit{{Amount: 1}, {Amount: 2}, {Amount: 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 3 || resp[2].OrderID != "3" {
+		t.Errorf("expected three orders submitted, received %+v", resp)
+	}
+	if !request.DisableRateLimiter && time.Since(start) < time.Millisecond*20 {
+		t.Error("expected the orders to be spaced out across the rate limit")
+	}
+
+	resp, err = SubmitBatch(e, []order.Submit{{Amount: 1}, {}, {Amount: 3}})
+	if err == nil {
+		t.Error("expected an error for the invalid order")
+	}
+	if len(resp) != 1 || e.submitted != 4 {
+		t.Errorf("expected submission to stop at the invalid order, received %+v", resp)
+	}
+}
+
+func TestCancelBatch(t *testing.T) {
+	t.Parallel()
+	e := &batchTestExchange{}
+	resp, err := CancelBatch(e, []order.Cancel{{OrderID: "1"}, {}, {OrderID: "3"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.cancelled != 2 {
+		t.Errorf("expected the valid orders to be cancelled, received %v", e.cancelled)
+	}
+	if len(resp.Status) != 1 || resp.Status[""] == "" {
+		t.Errorf("expected the failed order in the status, received %+v", resp.Status)
+	}
+}
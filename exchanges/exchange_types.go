@@ -5,8 +5,10 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
 
@@ -97,8 +99,9 @@ type InternationalBankTransactionType uint8
 type FeeBuilder struct {
 	FeeType FeeType
 	// Used for calculating crypto trading fees, deposits & withdrawals
-	Pair    currency.Pair
-	IsMaker bool
+	Pair      currency.Pair
+	AssetType asset.Item
+	IsMaker   bool
 	// Fiat currency used for bank deposits & withdrawals
 	FiatCurrency        currency.Code
 	BankTransactionType InternationalBankTransactionType
@@ -119,16 +122,6 @@ type TradeHistory struct {
 	Description string
 }
 
-// Candle holds historic rate information. Modelled after the Coinbase historic rate structure
-type Candle struct {
-	Time   int64
-	Low    float64
-	High   float64
-	Open   float64
-	Close  float64
-	Volume float64
-}
-
 // FundHistory holds exchange funding history data
 type FundHistory struct {
 	ExchangeName      string
@@ -219,6 +212,7 @@ type Base struct {
 	WebsocketResponseMaxLimit     time.Duration
 	WebsocketOrderbookBufferLimit int64
 	Websocket                     *wshandler.Websocket
+	TradingRules                  rules.Store
 	*request.Requester
 	Config *config.ExchangeConfig
 }
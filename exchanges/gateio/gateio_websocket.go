@@ -170,6 +170,7 @@ func (g *Gateio) WsHandleData() {
 						CurrencyPair: currency.NewPairFromString(c),
 						AssetType:    asset.Spot,
 						Exchange:     g.Name,
+						TID:          strconv.FormatInt(trades[i].ID, 10),
 						Price:        trades[i].Price,
 						Amount:       trades[i].Amount,
 						Side:         trades[i].Type,
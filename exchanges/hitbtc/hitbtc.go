@@ -7,8 +7,8 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
-	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -41,8 +41,9 @@ const (
 	tradableBalances    = "returnTradableBalances"
 	transferBalance     = "transferBalance"
 
-	hitbtcAuthRate   = 0
-	hitbtcUnauthRate = 0
+	hitbtcAuthRate    = 0
+	hitbtcUnauthRate  = 0
+	hitbtcCandleLimit = 1000
 )
 
 // HitBTC is the overarching type across the hitbtc package
@@ -51,11 +52,6 @@ type HitBTC struct {
 	WebsocketConn *wshandler.WebsocketConnection
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (h *HitBTC) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
-
 // Public Market Data
 // https://api.hitbtc.com/?python#market-data
 
@@ -200,7 +196,7 @@ func (h *HitBTC) GetOrderbook(currencyPair string, limit int) (Orderbook, error)
 
 // GetCandles returns candles which is used for OHLC a specific currency.
 // Note: Result contain candles only with non zero volume.
-func (h *HitBTC) GetCandles(currencyPair, limit, period string) ([]ChartData, error) {
+func (h *HitBTC) GetCandles(currencyPair, limit, period string, start, end time.Time) ([]ChartData, error) {
 	// limit   Limit of candles, default 100.
 	// period  One of: M1 (one minute), M3, M5, M15, M30, H1, H4, D1, D7, 1M (one month). Default is M30 (30 minutes).
 	vals := url.Values{}
@@ -213,6 +209,14 @@ func (h *HitBTC) GetCandles(currencyPair, limit, period string) ([]ChartData, er
 		vals.Set("period", period)
 	}
 
+	if !start.IsZero() {
+		vals.Set("from", start.UTC().Format(time.RFC3339))
+	}
+
+	if !end.IsZero() {
+		vals.Set("till", end.UTC().Format(time.RFC3339))
+	}
+
 	var resp []ChartData
 	path := fmt.Sprintf("%s/%s/%s?%s", h.API.Endpoints.URL, apiV2Candles, currencyPair, vals.Encode())
 	return resp, h.SendHTTPRequest(path, &resp)
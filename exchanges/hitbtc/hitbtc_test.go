@@ -68,7 +68,7 @@ func TestGetTrades(t *testing.T) {
 }
 
 func TestGetChartCandles(t *testing.T) {
-	_, err := h.GetCandles("BTCUSD", "", "")
+	_, err := h.GetCandles("BTCUSD", "", "", time.Time{}, time.Time{})
 	if err != nil {
 		t.Error("Test faild - HitBTC GetChartData() error", err)
 	}
@@ -14,8 +14,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -620,3 +623,100 @@ func (h *HitBTC) ValidateCredentials() error {
 	_, err := h.UpdateAccountInfo()
 	return h.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time
+// interval. Candles are only returned for intervals with trades
+func (h *HitBTC) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	var period string
+	switch interval {
+	case kline.OneMin:
+		period = "M1"
+	case kline.FiveMin:
+		period = "M5"
+	case kline.FifteenMin:
+		period = "M15"
+	case kline.OneHour:
+		period = "H1"
+	case kline.FourHour:
+		period = "H4"
+	case kline.OneDay:
+		period = "D1"
+	case kline.OneWeek:
+		period = "D7"
+	default:
+		return kline.Item{}, fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, interval)
+	}
+
+	ret := kline.Item{
+		Exchange: h.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	symbol := h.FormatExchangeCurrency(pair, a).String()
+	dates := kline.DateRange{Start: start, End: end}.Split(interval, hitbtcCandleLimit)
+	for x := range dates {
+		candles, err := h.GetCandles(symbol, strconv.Itoa(hitbtcCandleLimit), period,
+			dates[x].Start, dates[x].End.Add(-time.Second))
+		if err != nil {
+			return kline.Item{}, err
+		}
+		for y := range candles {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   candles[y].Timestamp,
+				Open:   candles[y].Open,
+				High:   candles[y].Max,
+				Low:    candles[y].Min,
+				Close:  candles[y].Close,
+				Volume: candles[y].Volume,
+			})
+		}
+	}
+	ret.FilterByTimeRange(start, end)
+	return ret, nil
+}
+
+// GetPositions returns the open derivatives positions of the asset type
+func (h *HitBTC) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (h *HitBTC) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (h *HitBTC) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (h *HitBTC) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (h *HitBTC) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (h *HitBTC) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrders submits a batch of orders
+func (h *HitBTC) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (h *HitBTC) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
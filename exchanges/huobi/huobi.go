@@ -20,6 +20,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
 
@@ -29,6 +31,7 @@ const (
 	huobiAPIVersion2 = "2"
 
 	huobiMarketHistoryKline    = "market/history/kline"
+	huobiKlineLimit            = 2000
 	huobiMarketDetail          = "market/detail"
 	huobiMarketDetailMerged    = "market/detail/merged"
 	huobiMarketDepth           = "market/depth"
@@ -75,11 +78,6 @@ type HUOBI struct {
 	AuthenticatedWebsocketConn *wshandler.WebsocketConnection
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (h *HUOBI) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
-
 // GetSpotKline returns kline data
 // KlinesRequestParams contains symbol, period and size
 func (h *HUOBI) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
@@ -863,3 +861,25 @@ func calculateTradingFee(c currency.Pair, price, amount float64) float64 {
 	}
 	return 0.002 * price * amount
 }
+
+// tradingRules converts the precision and limits of the online symbols to spot
+// trading rules, the minimum order value is the minimum notional
+func tradingRules(symbols []Symbol) []rules.Rules {
+	var r []rules.Rules
+	for x := range symbols {
+		if symbols[x].State != "online" {
+			continue
+		}
+		r = append(r, rules.Rules{
+			Pair: currency.NewPairFromStrings(symbols[x].BaseCurrency,
+				symbols[x].QuoteCurrency),
+			Asset:       asset.Spot,
+			PriceTick:   rules.PrecisionToStep(symbols[x].PricePrecision),
+			AmountStep:  rules.PrecisionToStep(symbols[x].AmountPrecision),
+			MinAmount:   symbols[x].MinimumOrderAmount,
+			MaxAmount:   symbols[x].MaximumOrderAmount,
+			MinNotional: symbols[x].MinimumOrderValue,
+		})
+	}
+	return r
+}
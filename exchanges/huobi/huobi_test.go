@@ -169,6 +169,34 @@ func TestGetSymbols(t *testing.T) {
 	}
 }
 
+func TestTradingRules(t *testing.T) {
+	t.Parallel()
+	r := tradingRules([]Symbol{
+		{
+			BaseCurrency:       "btc",
+			QuoteCurrency:      "usdt",
+			PricePrecision:     2,
+			AmountPrecision:    6,
+			State:              "online",
+			MinimumOrderAmount: 0.0001,
+			MaximumOrderAmount: 1000,
+			MinimumOrderValue:  5,
+		},
+		{BaseCurrency: "bcc", QuoteCurrency: "usdt", State: "offline"},
+	})
+	if len(r) != 1 {
+		t.Fatalf("expected rules for the online symbol only, received %v", len(r))
+	}
+	if !r[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USDT)) ||
+		r[0].PriceTick != 0.01 ||
+		r[0].AmountStep != 0.000001 ||
+		r[0].MinAmount != 0.0001 ||
+		r[0].MaxAmount != 1000 ||
+		r[0].MinNotional != 5 {
+		t.Errorf("unexpected trading rules %+v", r[0])
+	}
+}
+
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
 	_, err := h.GetCurrencies()
@@ -14,8 +14,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -264,7 +267,10 @@ func (h *HUOBI) FetchTradablePairs(asset asset.Item) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	return h.tradablePairs(symbols, asset), nil
+}
 
+func (h *HUOBI) tradablePairs(symbols []Symbol, asset asset.Item) []string {
 	var pairs []string
 	for x := range symbols {
 		if symbols[x].State != "online" {
@@ -274,22 +280,26 @@ func (h *HUOBI) FetchTradablePairs(asset asset.Item) ([]string, error) {
 			h.GetPairFormat(asset, false).Delimiter+
 			symbols[x].QuoteCurrency)
 	}
-
-	return pairs, nil
+	return pairs
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the trading rules of the pairs are loaded
+// from the same symbols
 func (h *HUOBI) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := h.FetchTradablePairs(asset.Spot)
+	symbols, err := h.GetSymbols()
 	if err != nil {
 		return err
 	}
 
-	return h.UpdatePairs(currency.NewPairsFromStrings(pairs),
+	err = h.UpdatePairs(currency.NewPairsFromStrings(h.tradablePairs(symbols, asset.Spot)),
 		asset.Spot,
 		false,
 		forceUpdate)
+	if err != nil {
+		return err
+	}
+	return h.LoadTradingRules(tradingRules(symbols))
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -500,9 +510,27 @@ func (h *HUOBI) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
+// GetExchangeHistory returns the most recent public trades for a currency pair
 func (h *HUOBI) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
-	return nil, common.ErrNotYetImplemented
+	history, err := h.GetTradeHistory(h.FormatExchangeCurrency(p, assetType).String(), "2000")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []exchange.TradeHistory
+	for i := range history {
+		for j := range history[i].Trades {
+			// Huobi trade IDs overflow an int64 so no TID is set
+			resp = append(resp, exchange.TradeHistory{
+				Timestamp: time.Unix(0, history[i].Trades[j].Timestamp*int64(time.Millisecond)),
+				Price:     history[i].Trades[j].Price,
+				Amount:    history[i].Trades[j].Amount,
+				Exchange:  h.Name,
+				Type:      history[i].Trades[j].Direction,
+			})
+		}
+	}
+	return resp, nil
 }
 
 // SubmitOrder submits a new order
@@ -865,9 +893,117 @@ func (h *HUOBI) AuthenticateWebsocket() error {
 	return h.wsLogin()
 }
 
+// GetHistoricCandles returns candles between a time period for a set time
+// interval. Huobi only serves its most recent candles so a *kline.RangeError
+// is returned when the start date is older than the candles served
+func (h *HUOBI) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	var period TimeInterval
+	switch interval {
+	case kline.OneMin:
+		period = TimeIntervalMinute
+	case kline.FiveMin:
+		period = TimeIntervalFiveMinutes
+	case kline.FifteenMin:
+		period = TimeIntervalFifteenMinutes
+	case kline.OneHour:
+		period = TimeIntervalHour
+	case kline.FourHour:
+		period = TimeIntervalFourHours
+	case kline.OneDay:
+		period = TimeIntervalDay
+	case kline.OneWeek:
+		period = TimeIntervalWeek
+	default:
+		return kline.Item{}, fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, interval)
+	}
+
+	size := kline.TotalCandlesPerInterval(start, time.Now(), interval) + 1
+	if size > huobiKlineLimit {
+		return kline.Item{}, &kline.RangeError{
+			Exchange: h.Name,
+			Start:    start,
+			End:      end,
+			Reason:   fmt.Sprintf("only the most recent %d candles are served", huobiKlineLimit),
+		}
+	}
+	candles, err := h.GetSpotKline(KlinesRequestParams{
+		Symbol: h.FormatExchangeCurrency(pair, a).String(),
+		Period: period,
+		Size:   int(size),
+	})
+	if err != nil {
+		return kline.Item{}, err
+	}
+	ret := kline.Item{
+		Exchange: h.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	// amount is the base currency volume, vol is the quote currency turnover
+	for x := range candles {
+		ret.Candles = append(ret.Candles, kline.Candle{
+			Time:   time.Unix(candles[x].ID, 0),
+			Open:   candles[x].Open,
+			High:   candles[x].High,
+			Low:    candles[x].Low,
+			Close:  candles[x].Close,
+			Volume: candles[x].Amount,
+		})
+	}
+	ret.FilterByTimeRange(start, end)
+	ret.SortCandlesByTimestamp(false)
+	return ret, nil
+}
+
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
 func (h *HUOBI) ValidateCredentials() error {
 	_, err := h.UpdateAccountInfo()
 	return h.CheckTransientError(err)
 }
+
+// GetPositions returns the open derivatives positions of the asset type
+func (h *HUOBI) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (h *HUOBI) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (h *HUOBI) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (h *HUOBI) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (h *HUOBI) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (h *HUOBI) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrders submits a batch of orders
+func (h *HUOBI) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (h *HUOBI) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
@@ -2,13 +2,19 @@ package exchange
 
 import (
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
@@ -50,6 +56,8 @@ type IBotExchange interface {
 	ModifyOrder(action *order.Modify) (string, error)
 	CancelOrder(order *order.Cancel) error
 	CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse, error)
+	SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error)
+	CancelOrders(c []order.Cancel) (order.CancelAllResponse, error)
 	GetOrderInfo(orderID string) (order.Detail, error)
 	GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error)
 	GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
@@ -71,5 +79,24 @@ type IBotExchange interface {
 	GetDefaultConfig() (*config.ExchangeConfig, error)
 	GetBase() *Base
 	SupportsAsset(assetType asset.Item) bool
-	GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]Candle, error)
+	GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error)
+	GetPositions(assetType asset.Item) ([]position.Position, error)
+	SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error
+	SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error
+	GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error)
+	ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error)
+	UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error)
+	GetTradingRules(p currency.Pair, a asset.Item) (*rules.Rules, error)
+}
+
+// Lender enforces standard functions for exchanges which support lending and
+// borrowing funds
+type Lender interface {
+	GetLendBook(c currency.Code) (lending.Book, error)
+	GetFundingOffers() ([]lending.Offer, error)
+	SubmitFundingOffer(o *lending.OfferRequest) (string, error)
+	CancelFundingOffer(id string) error
+	GetLoans() ([]lending.Loan, error)
+	Borrow(b *lending.BorrowRequest) (string, error)
+	Repay(r *lending.RepayRequest) error
 }
@@ -0,0 +1,249 @@
+package kline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Duration returns interval casted as time.Duration for compatibility
+func (i Interval) Duration() time.Duration {
+	return time.Duration(i)
+}
+
+// Short returns short string version of interval e.g. 1m, 4h, 1w
+func (i Interval) Short() string {
+	switch i {
+	case OneWeek:
+		return "1w"
+	case OneDay:
+		return "1d"
+	}
+	s := i.Duration().String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+	return s
+}
+
+// String returns the short string version of the interval
+func (i Interval) String() string {
+	return i.Short()
+}
+
+// IsSupported returns whether the interval is one of the supported intervals
+func (i Interval) IsSupported() bool {
+	for x := range SupportedIntervals {
+		if SupportedIntervals[x] == i {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseInterval converts a short interval string such as 15m or 1d to a
+// supported Interval
+func ParseInterval(interval string) (Interval, error) {
+	for x := range SupportedIntervals {
+		if strings.EqualFold(SupportedIntervals[x].Short(), interval) {
+			return SupportedIntervals[x], nil
+		}
+	}
+	return 0, fmt.Errorf("%s: %s", ErrUnsupportedInterval, interval)
+}
+
+// Error implements the error interface
+func (r *RangeError) Error() string {
+	return fmt.Sprintf("%s cannot serve candles from %s to %s: %s",
+		r.Exchange, r.Start.UTC().Format(time.RFC3339), r.End.UTC().Format(time.RFC3339), r.Reason)
+}
+
+// ValidateRequest checks that the interval is supported and the date range is
+// valid
+func ValidateRequest(start, end time.Time, interval Interval) error {
+	if !interval.IsSupported() {
+		return fmt.Errorf("%s: %s", ErrUnsupportedInterval, interval.Duration())
+	}
+	if start.IsZero() || end.IsZero() || !start.Before(end) {
+		return ErrInvalidDateRange
+	}
+	return nil
+}
+
+// TotalCandlesPerInterval returns the total number of candle intervals
+// between the start and end date
+func TotalCandlesPerInterval(start, end time.Time, interval Interval) uint32 {
+	if interval <= 0 || !start.Before(end) {
+		return 0
+	}
+	return uint32(end.Sub(start) / interval.Duration())
+}
+
+// CreateKline builds candles of the supplied interval from trade history for
+// exchanges which do not have a native kline endpoint
+func CreateKline(trades []order.TradeHistory, interval Interval, p currency.Pair, a asset.Item, exchange string) (Item, error) {
+	if !interval.IsSupported() {
+		return Item{}, fmt.Errorf("%s: %s", ErrUnsupportedInterval, interval.Duration())
+	}
+	if len(trades) == 0 {
+		return Item{}, ErrNoTrades
+	}
+
+	sorted := make([]order.TradeHistory, len(trades))
+	copy(sorted, trades)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	item := Item{
+		Exchange: exchange,
+		Pair:     p,
+		Asset:    a,
+		Interval: interval,
+	}
+	for x := range sorted {
+		if sorted[x].Timestamp.IsZero() || sorted[x].Price <= 0 {
+			continue
+		}
+		bucket := sorted[x].Timestamp.UTC().Truncate(interval.Duration())
+		last := len(item.Candles) - 1
+		if last >= 0 && item.Candles[last].Time.Equal(bucket) {
+			c := &item.Candles[last]
+			if sorted[x].Price > c.High {
+				c.High = sorted[x].Price
+			}
+			if sorted[x].Price < c.Low {
+				c.Low = sorted[x].Price
+			}
+			c.Close = sorted[x].Price
+			c.Volume += sorted[x].Amount
+			continue
+		}
+		item.Candles = append(item.Candles, Candle{
+			Time:   bucket,
+			Open:   sorted[x].Price,
+			High:   sorted[x].Price,
+			Low:    sorted[x].Price,
+			Close:  sorted[x].Price,
+			Volume: sorted[x].Amount,
+		})
+	}
+	if len(item.Candles) == 0 {
+		return Item{}, ErrNoTrades
+	}
+	return item, nil
+}
+
+// SortCandlesByTimestamp sorts candles by timestamp, ascending unless desc is
+// set
+func (k *Item) SortCandlesByTimestamp(desc bool) {
+	sort.Slice(k.Candles, func(i, j int) bool {
+		if desc {
+			return k.Candles[i].Time.After(k.Candles[j].Time)
+		}
+		return k.Candles[i].Time.Before(k.Candles[j].Time)
+	})
+}
+
+// FilterByTimeRange removes any candles which open outside of the start and
+// end date
+func (k *Item) FilterByTimeRange(start, end time.Time) {
+	var filtered []Candle
+	for x := range k.Candles {
+		if k.Candles[x].Time.Before(start) || !k.Candles[x].Time.Before(end) {
+			continue
+		}
+		filtered = append(filtered, k.Candles[x])
+	}
+	k.Candles = filtered
+}
+
+// FindMissingRanges returns the date ranges between the start and end date
+// which are not covered by the supplied candle open times, adjoining missing
+// intervals are merged into a single range
+func FindMissingRanges(start, end time.Time, interval Interval, have []time.Time) []DateRange {
+	if interval <= 0 || !start.Before(end) {
+		return nil
+	}
+	stored := make(map[int64]struct{}, len(have))
+	for x := range have {
+		stored[have[x].UTC().Truncate(interval.Duration()).Unix()] = struct{}{}
+	}
+
+	var missing []DateRange
+	var current *DateRange
+	for t := start.UTC().Truncate(interval.Duration()); t.Before(end); t = t.Add(interval.Duration()) {
+		if _, ok := stored[t.Unix()]; ok {
+			if current != nil {
+				missing = append(missing, *current)
+				current = nil
+			}
+			continue
+		}
+		if current == nil {
+			current = &DateRange{Start: t}
+		}
+		current.End = t.Add(interval.Duration())
+	}
+	if current != nil {
+		missing = append(missing, *current)
+	}
+	return missing
+}
+
+// FindContiguousRanges groups candle open times into unbroken date ranges
+func FindContiguousRanges(have []time.Time, interval Interval) []DateRange {
+	if interval <= 0 || len(have) == 0 {
+		return nil
+	}
+	sorted := make([]time.Time, len(have))
+	for x := range have {
+		sorted[x] = have[x].UTC().Truncate(interval.Duration())
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Before(sorted[j])
+	})
+
+	ranges := []DateRange{{Start: sorted[0], End: sorted[0].Add(interval.Duration())}}
+	for x := 1; x < len(sorted); x++ {
+		last := &ranges[len(ranges)-1]
+		if sorted[x].Before(last.End) {
+			continue
+		}
+		if sorted[x].Equal(last.End) {
+			last.End = sorted[x].Add(interval.Duration())
+			continue
+		}
+		ranges = append(ranges, DateRange{
+			Start: sorted[x],
+			End:   sorted[x].Add(interval.Duration()),
+		})
+	}
+	return ranges
+}
+
+// Split breaks the date range into smaller ranges which each contain no more
+// than the limit of candles for the interval
+func (d DateRange) Split(interval Interval, limit uint32) []DateRange {
+	if interval <= 0 || limit == 0 || !d.Start.Before(d.End) {
+		return nil
+	}
+	step := interval.Duration() * time.Duration(limit)
+	var ranges []DateRange
+	for start := d.Start; start.Before(d.End); start = start.Add(step) {
+		end := start.Add(step)
+		if end.After(d.End) {
+			end = d.End
+		}
+		ranges = append(ranges, DateRange{Start: start, End: end})
+	}
+	return ranges
+}
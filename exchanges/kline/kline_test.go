@@ -0,0 +1,234 @@
+package kline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestIntervalShort(t *testing.T) {
+	t.Parallel()
+	expected := []string{"1m", "5m", "15m", "1h", "4h", "1d", "1w"}
+	for x := range SupportedIntervals {
+		if s := SupportedIntervals[x].Short(); s != expected[x] {
+			t.Errorf("expected %s, received %s", expected[x], s)
+		}
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	t.Parallel()
+	for x := range SupportedIntervals {
+		i, err := ParseInterval(SupportedIntervals[x].Short())
+		if err != nil {
+			t.Error(err)
+		}
+		if i != SupportedIntervals[x] {
+			t.Errorf("expected %s, received %s", SupportedIntervals[x], i)
+		}
+	}
+	if _, err := ParseInterval("2m"); err == nil {
+		t.Error("expected error for unsupported interval")
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	t.Parallel()
+	end := time.Now()
+	start := end.Add(-time.Hour)
+	if err := ValidateRequest(start, end, OneMin); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateRequest(start, end, Interval(time.Second)); err == nil {
+		t.Error("expected error for unsupported interval")
+	}
+	if err := ValidateRequest(end, start, OneMin); err != ErrInvalidDateRange {
+		t.Errorf("expected %v, received %v", ErrInvalidDateRange, err)
+	}
+	if err := ValidateRequest(time.Time{}, end, OneMin); err != ErrInvalidDateRange {
+		t.Errorf("expected %v, received %v", ErrInvalidDateRange, err)
+	}
+}
+
+func TestTotalCandlesPerInterval(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	if c := TotalCandlesPerInterval(start, end, OneHour); c != 168 {
+		t.Errorf("expected 168, received %d", c)
+	}
+	if c := TotalCandlesPerInterval(start, end, OneWeek); c != 1 {
+		t.Errorf("expected 1, received %d", c)
+	}
+	if c := TotalCandlesPerInterval(end, start, OneHour); c != 0 {
+		t.Errorf("expected 0, received %d", c)
+	}
+}
+
+func TestCreateKline(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err := CreateKline(nil, OneMin, p, asset.Spot, "test")
+	if err != ErrNoTrades {
+		t.Errorf("expected %v, received %v", ErrNoTrades, err)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	trades := []order.TradeHistory{
+		{Timestamp: start.Add(time.Minute + time.Second*10), Price: 7, Amount: 1},
+		{Timestamp: start.Add(time.Second * 5), Price: 10, Amount: 1},
+		{Timestamp: start.Add(time.Second * 20), Price: 12, Amount: 2},
+		{Timestamp: start.Add(time.Second * 30), Price: 9, Amount: 0.5},
+		{Timestamp: start.Add(time.Second * 50), Price: 11, Amount: 1.5},
+	}
+	_, err = CreateKline(trades, Interval(time.Second), p, asset.Spot, "test")
+	if err == nil {
+		t.Error("expected error for unsupported interval")
+	}
+
+	k, err := CreateKline(trades, OneMin, p, asset.Spot, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(k.Candles) != 2 {
+		t.Fatalf("expected 2 candles, received %d", len(k.Candles))
+	}
+	c := k.Candles[0]
+	if !c.Time.Equal(start) || c.Open != 10 || c.High != 12 || c.Low != 9 ||
+		c.Close != 11 || c.Volume != 5 {
+		t.Errorf("unexpected candle %+v", c)
+	}
+	c = k.Candles[1]
+	if !c.Time.Equal(start.Add(time.Minute)) || c.Open != 7 || c.Close != 7 ||
+		c.Volume != 1 {
+		t.Errorf("unexpected candle %+v", c)
+	}
+
+	k, err = CreateKline(trades, OneHour, p, asset.Spot, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(k.Candles) != 1 || k.Candles[0].Close != 7 || k.Candles[0].Volume != 6 {
+		t.Error("unexpected result")
+	}
+}
+
+func TestSortAndFilter(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	k := Item{
+		Candles: []Candle{
+			{Time: start.Add(time.Hour * 2)},
+			{Time: start},
+			{Time: start.Add(time.Hour)},
+		},
+	}
+	k.SortCandlesByTimestamp(false)
+	if !k.Candles[0].Time.Equal(start) {
+		t.Error("expected ascending order")
+	}
+	k.SortCandlesByTimestamp(true)
+	if !k.Candles[0].Time.Equal(start.Add(time.Hour * 2)) {
+		t.Error("expected descending order")
+	}
+	k.FilterByTimeRange(start, start.Add(time.Hour*2))
+	if len(k.Candles) != 2 {
+		t.Errorf("expected 2 candles, received %d", len(k.Candles))
+	}
+}
+
+func TestFindMissingRanges(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour * 10)
+	if r := FindMissingRanges(start, end, OneHour, nil); len(r) != 1 ||
+		!r[0].Start.Equal(start) || !r[0].End.Equal(end) {
+		t.Errorf("expected the full range to be missing, received %v", r)
+	}
+
+	have := []time.Time{
+		start,
+		start.Add(time.Hour),
+		start.Add(time.Hour * 4),
+		start.Add(time.Hour * 9),
+	}
+	r := FindMissingRanges(start, end, OneHour, have)
+	if len(r) != 2 {
+		t.Fatalf("expected 2 missing ranges, received %d", len(r))
+	}
+	if !r[0].Start.Equal(start.Add(time.Hour*2)) || !r[0].End.Equal(start.Add(time.Hour*4)) {
+		t.Errorf("unexpected range %v", r[0])
+	}
+	if !r[1].Start.Equal(start.Add(time.Hour*5)) || !r[1].End.Equal(start.Add(time.Hour*9)) {
+		t.Errorf("unexpected range %v", r[1])
+	}
+
+	for x := 0; x < 10; x++ {
+		have = append(have, start.Add(time.Hour*time.Duration(x)))
+	}
+	if r := FindMissingRanges(start, end, OneHour, have); len(r) != 0 {
+		t.Errorf("expected no missing ranges, received %v", r)
+	}
+	if r := FindMissingRanges(end, start, OneHour, nil); r != nil {
+		t.Error("expected no ranges for an invalid date range")
+	}
+}
+
+func TestFindContiguousRanges(t *testing.T) {
+	t.Parallel()
+	if r := FindContiguousRanges(nil, OneMin); r != nil {
+		t.Error("expected no ranges")
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	have := []time.Time{
+		start.Add(time.Minute * 5),
+		start,
+		start.Add(time.Minute),
+		start.Add(time.Minute),
+		start.Add(time.Minute * 6),
+	}
+	r := FindContiguousRanges(have, OneMin)
+	if len(r) != 2 {
+		t.Fatalf("expected 2 ranges, received %d", len(r))
+	}
+	if !r[0].Start.Equal(start) || !r[0].End.Equal(start.Add(time.Minute*2)) {
+		t.Errorf("unexpected range %v", r[0])
+	}
+	if !r[1].Start.Equal(start.Add(time.Minute*5)) || !r[1].End.Equal(start.Add(time.Minute*7)) {
+		t.Errorf("unexpected range %v", r[1])
+	}
+}
+
+func TestDateRangeSplit(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := DateRange{Start: start, End: start.Add(time.Hour * 25)}
+	r := d.Split(OneHour, 10)
+	if len(r) != 3 {
+		t.Fatalf("expected 3 ranges, received %d", len(r))
+	}
+	if !r[2].Start.Equal(start.Add(time.Hour*20)) || !r[2].End.Equal(d.End) {
+		t.Errorf("unexpected range %v", r[2])
+	}
+	if r := d.Split(OneHour, 0); r != nil {
+		t.Error("expected no ranges for a zero limit")
+	}
+}
+
+func TestRangeError(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var err error = &RangeError{
+		Exchange: "Bitstamp",
+		Start:    start,
+		End:      start.Add(time.Hour),
+		Reason:   "meow",
+	}
+	expected := "Bitstamp cannot serve candles from 2020-01-01T00:00:00Z to 2020-01-01T01:00:00Z: meow"
+	if err.Error() != expected {
+		t.Errorf("expected %q, received %q", expected, err.Error())
+	}
+}
@@ -0,0 +1,78 @@
+package kline
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Consts here define basic time intervals
+const (
+	OneMin     = Interval(time.Minute)
+	FiveMin    = 5 * OneMin
+	FifteenMin = 15 * OneMin
+	OneHour    = Interval(time.Hour)
+	FourHour   = 4 * OneHour
+	OneDay     = 24 * OneHour
+	OneWeek    = 7 * OneDay
+)
+
+// vars for the kline package
+var (
+	ErrUnsupportedInterval = errors.New("unsupported interval")
+	ErrInvalidDateRange    = errors.New("start date must be before end date")
+	ErrNoTrades            = errors.New("no trades supplied to build candles from")
+
+	// SupportedIntervals is a list of all intervals supported by the kline
+	// package
+	SupportedIntervals = []Interval{
+		OneMin,
+		FiveMin,
+		FifteenMin,
+		OneHour,
+		FourHour,
+		OneDay,
+		OneWeek,
+	}
+)
+
+// Interval type for kline Interval usage
+type Interval time.Duration
+
+// Item holds all the relevant information for an exchanges candle data
+// between a time range
+type Item struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval Interval
+	Candles  []Candle
+}
+
+// Candle holds historic rate information for a single interval
+type Candle struct {
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// RangeError is returned when an exchange cannot serve candles for all of the
+// requested date range
+type RangeError struct {
+	Exchange string
+	Start    time.Time
+	End      time.Time
+	Reason   string
+}
+
+// DateRange holds a start and end date for a series of candles, the start is
+// inclusive and the end exclusive
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
@@ -9,11 +9,12 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -49,6 +50,7 @@ const (
 	krakenWithdrawStatus   = "WithdrawStatus"
 	krakenWithdrawCancel   = "WithdrawCancel"
 	krakenWebsocketToken   = "GetWebSocketsToken"
+	krakenOHLCLimit        = 720
 
 	krakenAuthRate   = 0
 	krakenUnauthRate = 0
@@ -64,11 +66,6 @@ type Kraken struct {
 	wsRequestMtx               sync.Mutex
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (k *Kraken) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
-
 // GetServerTime returns current server time
 func (k *Kraken) GetServerTime() (TimeResponse, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.API.Endpoints.URL, krakenAPIVersion, krakenServerTime)
@@ -202,10 +199,18 @@ func (k *Kraken) GetTickers(pairList string) (map[string]Ticker, error) {
 	return tickers, nil
 }
 
-// GetOHLC returns an array of open high low close values of a currency pair
-func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
+// GetOHLC returns an array of open high low close values of a currency pair,
+// interval is in minutes and since is an optional unix timestamp. Only the
+// most recent 720 values are served
+func (k *Kraken) GetOHLC(symbol, interval string, since int64) ([]OpenHighLowClose, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
+	if interval != "" {
+		values.Set("interval", interval)
+	}
+	if since > 0 {
+		values.Set("since", strconv.FormatInt(since, 10))
+	}
 
 	type Response struct {
 		Error []interface{}          `json:"error"`
@@ -226,7 +231,15 @@ func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
 		return OHLC, fmt.Errorf("getOHLC error: %s", result.Error)
 	}
 
-	for _, y := range result.Data[symbol].([]interface{}) {
+	// the result is keyed by Kraken's own pair name which can differ from the
+	// requested symbol, alongside the last timestamp
+	var data []interface{}
+	for key, v := range result.Data {
+		if key != "last" {
+			data, _ = v.([]interface{})
+		}
+	}
+	for _, y := range data {
 		o := OpenHighLowClose{}
 		for i, x := range y.([]interface{}) {
 			switch i {
@@ -1056,3 +1069,36 @@ func (k *Kraken) GetWebsocketToken() (string, error) {
 	}
 	return response.Result.Token, nil
 }
+
+// assetPairCodes returns the base and quote currency codes of an asset pair
+// with Kraken's X and Z asset class prefixes removed
+func assetPairCodes(v AssetPairs) (base, quote string) {
+	base, quote = v.Base, v.Quote
+	if base[0] == 'X' && len(base) > 3 {
+		base = base[1:]
+	}
+	if quote[0] == 'Z' || quote[0] == 'X' {
+		quote = quote[1:]
+	}
+	return base, quote
+}
+
+// tradingRules converts the asset pairs to trading rules, dark pool pairs are
+// skipped as they are not tradable pairs
+func tradingRules(pairs map[string]AssetPairs) []rules.Rules {
+	var r []rules.Rules
+	for i := range pairs {
+		if strings.Contains(pairs[i].Altname, ".d") {
+			continue
+		}
+		base, quote := assetPairCodes(pairs[i])
+		r = append(r, rules.Rules{
+			Pair:       currency.NewPairFromStrings(base, quote),
+			Asset:      asset.Spot,
+			PriceTick:  rules.PrecisionToStep(pairs[i].PairDecimals),
+			AmountStep: rules.PrecisionToStep(pairs[i].LotDecimals),
+			MinAmount:  pairs[i].OrderMinimum,
+		})
+	}
+	return r
+}
@@ -80,6 +80,30 @@ func TestGetAssetPairs(t *testing.T) {
 	}
 }
 
+func TestTradingRules(t *testing.T) {
+	t.Parallel()
+	r := tradingRules(map[string]AssetPairs{
+		"XXBTZUSD": {
+			Altname:      "XBTUSD",
+			Base:         "XXBT",
+			Quote:        "ZUSD",
+			PairDecimals: 1,
+			LotDecimals:  8,
+			OrderMinimum: 0.002,
+		},
+		"XXBTZUSD.d": {Altname: "XBTUSD.d", Base: "XXBT", Quote: "ZUSD"},
+	})
+	if len(r) != 1 {
+		t.Fatalf("expected rules for the tradable pair only, received %v", len(r))
+	}
+	if !r[0].Pair.Equal(currency.NewPairFromStrings("XBT", "USD")) ||
+		r[0].PriceTick != 0.1 ||
+		r[0].AmountStep != 0.00000001 ||
+		r[0].MinAmount != 0.002 {
+		t.Errorf("unexpected trading rules %+v", r[0])
+	}
+}
+
 // TestGetTicker API endpoint test
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
@@ -101,7 +125,7 @@ func TestGetTickers(t *testing.T) {
 // TestGetOHLC API endpoint test
 func TestGetOHLC(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetOHLC("BCHEUR")
+	_, err := k.GetOHLC("BCHEUR", "1", 0)
 	if err != nil {
 		t.Error("GetOHLC() error", err)
 	}
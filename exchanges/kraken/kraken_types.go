@@ -31,6 +31,7 @@ type AssetPairs struct {
 	PairDecimals      int         `json:"pair_decimals"`
 	LotDecimals       int         `json:"lot_decimals"`
 	LotMultiplier     int         `json:"lot_multiplier"`
+	OrderMinimum      float64     `json:"ordermin,string"`
 	LeverageBuy       []int       `json:"leverage_buy"`
 	LeverageSell      []int       `json:"leverage_sell"`
 	Fees              [][]float64 `json:"fees"`
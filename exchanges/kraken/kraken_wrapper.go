@@ -2,6 +2,7 @@ package kraken
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,8 +15,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -249,37 +253,40 @@ func (k *Kraken) FetchTradablePairs(asset asset.Item) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	return k.tradablePairs(pairs, asset), nil
+}
 
+func (k *Kraken) tradablePairs(pairs map[string]AssetPairs, asset asset.Item) []string {
 	var products []string
 	for i := range pairs {
-		v := pairs[i]
-		if strings.Contains(v.Altname, ".d") {
+		if strings.Contains(pairs[i].Altname, ".d") {
 			continue
 		}
-		if v.Base[0] == 'X' {
-			if len(v.Base) > 3 {
-				v.Base = v.Base[1:]
-			}
-		}
-		if v.Quote[0] == 'Z' || v.Quote[0] == 'X' {
-			v.Quote = v.Quote[1:]
-		}
-		products = append(products, v.Base+
+		base, quote := assetPairCodes(pairs[i])
+		products = append(products, base+
 			k.GetPairFormat(asset, false).Delimiter+
-			v.Quote)
+			quote)
 	}
-	return products, nil
+	return products
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, the trading rules of the pairs are loaded
+// from the same asset pairs
 func (k *Kraken) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := k.FetchTradablePairs(asset.Spot)
+	pairs, err := k.GetAssetPairs()
 	if err != nil {
 		return err
 	}
 
-	return k.UpdatePairs(currency.NewPairsFromStrings(pairs), asset.Spot, false, forceUpdate)
+	err = k.UpdatePairs(currency.NewPairsFromStrings(k.tradablePairs(pairs, asset.Spot)),
+		asset.Spot,
+		false,
+		forceUpdate)
+	if err != nil {
+		return err
+	}
+	return k.LoadTradingRules(tradingRules(pairs))
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -722,9 +729,94 @@ func (k *Kraken) AuthenticateWebsocket() error {
 	return err
 }
 
+// GetHistoricCandles returns candles between a time period for a set time
+// interval. Kraken only serves its most recent candles so a *kline.RangeError
+// is returned when the start date is older than the candles served
+func (k *Kraken) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	ohlc, err := k.GetOHLC(k.FormatExchangeCurrency(pair, a).String(),
+		strconv.FormatInt(int64(interval.Duration()/time.Minute), 10),
+		start.Add(-interval.Duration()).Unix())
+	if err != nil {
+		return kline.Item{}, err
+	}
+	if len(ohlc) >= krakenOHLCLimit &&
+		time.Unix(int64(ohlc[0].Time), 0).After(start) {
+		return kline.Item{}, &kline.RangeError{
+			Exchange: k.Name,
+			Start:    start,
+			End:      end,
+			Reason: fmt.Sprintf("candles are only served from %s",
+				time.Unix(int64(ohlc[0].Time), 0).UTC().Format(time.RFC3339)),
+		}
+	}
+	ret := kline.Item{
+		Exchange: k.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	for x := range ohlc {
+		ret.Candles = append(ret.Candles, kline.Candle{
+			Time:   time.Unix(int64(ohlc[x].Time), 0),
+			Open:   ohlc[x].Open,
+			High:   ohlc[x].High,
+			Low:    ohlc[x].Low,
+			Close:  ohlc[x].Close,
+			Volume: ohlc[x].Volume,
+		})
+	}
+	ret.FilterByTimeRange(start, end)
+	return ret, nil
+}
+
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
 func (k *Kraken) ValidateCredentials() error {
 	_, err := k.UpdateAccountInfo()
 	return k.CheckTransientError(err)
 }
+
+// GetPositions returns the open derivatives positions of the asset type
+func (k *Kraken) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (k *Kraken) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (k *Kraken) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (k *Kraken) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (k *Kraken) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (k *Kraken) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrders submits a batch of orders
+func (k *Kraken) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (k *Kraken) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
@@ -15,9 +15,7 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/thrasher-corp/gocryptotrader/common"
 	gctcrypto "github.com/thrasher-corp/gocryptotrader/common/crypto"
-	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -35,6 +33,7 @@ const (
 	lbankAPIVersion      = "1"
 	lbankAuthRateLimit   = 0
 	lbankUnAuthRateLimit = 0
+	lbankKlineLimit      = 2000
 	lbankFeeNotFound     = 0.0
 
 	// Public endpoints
@@ -580,8 +579,3 @@ func (l *Lbank) SendAuthHTTPRequest(method, endpoint string, vals url.Values, re
 		l.HTTPDebugging,
 		l.HTTPRecording)
 }
-
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (l *Lbank) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrFunctionNotSupported
-}
@@ -13,8 +13,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -713,3 +716,101 @@ func (l *Lbank) ValidateCredentials() error {
 	_, err := l.UpdateAccountInfo()
 	return l.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time interval
+func (l *Lbank) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	var klineType string
+	switch interval {
+	case kline.OneMin:
+		klineType = "minute1"
+	case kline.FiveMin:
+		klineType = "minute5"
+	case kline.FifteenMin:
+		klineType = "minute15"
+	case kline.OneHour:
+		klineType = "hour1"
+	case kline.FourHour:
+		klineType = "hour4"
+	case kline.OneDay:
+		klineType = "day1"
+	case kline.OneWeek:
+		klineType = "week1"
+	default:
+		return kline.Item{}, fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, interval)
+	}
+
+	ret := kline.Item{
+		Exchange: l.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	symbol := l.FormatExchangeCurrency(pair, a).String()
+	dates := kline.DateRange{Start: start, End: end}.Split(interval, lbankKlineLimit)
+	for x := range dates {
+		candles, err := l.GetKlines(symbol,
+			strconv.Itoa(lbankKlineLimit),
+			klineType,
+			strconv.FormatInt(dates[x].Start.Unix(), 10))
+		if err != nil {
+			return kline.Item{}, err
+		}
+		for y := range candles {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   time.Unix(candles[y].TimeStamp, 0),
+				Open:   candles[y].OpenPrice,
+				High:   candles[y].HigestPrice,
+				Low:    candles[y].LowestPrice,
+				Close:  candles[y].ClosePrice,
+				Volume: candles[y].TradingVolume,
+			})
+		}
+	}
+	ret.FilterByTimeRange(start, end)
+	return ret, nil
+}
+
+// GetPositions returns the open derivatives positions of the asset type
+func (l *Lbank) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (l *Lbank) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (l *Lbank) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (l *Lbank) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (l *Lbank) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (l *Lbank) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrders submits a batch of orders
+func (l *Lbank) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (l *Lbank) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
@@ -0,0 +1,125 @@
+package lending
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// daysPerYear is used to convert between daily and annual rates
+const daysPerYear = 365
+
+// String implements the stringer interface
+func (s Side) String() string {
+	return string(s)
+}
+
+// StringToSide converts a case insensitive lending side to a Side
+func StringToSide(side string) (Side, error) {
+	switch {
+	case strings.EqualFold(side, Lend.String()):
+		return Lend, nil
+	case strings.EqualFold(side, Borrow.String()),
+		strings.EqualFold(side, "loan"):
+		return Borrow, nil
+	default:
+		return SideUnknown, fmt.Errorf("%s: %s", ErrUnknownSide, side)
+	}
+}
+
+// AnnualPercentToDaily converts a yearly percentage rate to a daily rate
+func AnnualPercentToDaily(rate float64) float64 {
+	return rate / daysPerYear / 100
+}
+
+// DailyToAnnualPercent converts a daily rate to a yearly percentage rate
+func DailyToAnnualPercent(rate float64) float64 {
+	return rate * daysPerYear * 100
+}
+
+// Validate checks the offer request
+func (o *OfferRequest) Validate() error {
+	if o == nil {
+		return ErrRequestIsNil
+	}
+	if o.Currency.IsEmpty() {
+		return ErrCurrencyNotSet
+	}
+	if o.Side != Lend && o.Side != Borrow {
+		return fmt.Errorf("%s: %s", ErrUnknownSide, o.Side)
+	}
+	if o.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+	if o.Rate <= 0 {
+		return ErrInvalidRate
+	}
+	if o.Period <= 0 {
+		return ErrInvalidPeriod
+	}
+	return nil
+}
+
+// Validate checks the borrow request
+func (b *BorrowRequest) Validate() error {
+	if b == nil {
+		return ErrRequestIsNil
+	}
+	if b.Currency.IsEmpty() {
+		return ErrCurrencyNotSet
+	}
+	if b.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+// Validate checks the repay request, either the loan ID or the currency must
+// be set
+func (r *RepayRequest) Validate() error {
+	if r == nil {
+		return ErrRequestIsNil
+	}
+	if r.LoanID == "" && r.Currency.IsEmpty() {
+		return ErrLoanIDNotSet
+	}
+	if r.Amount < 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+// RateAtDepth returns the rate of the offer at which the amount offered at
+// lower rates reaches depth, an offer placed at this rate is filled after the
+// depth amount of cheaper funds. The highest offered rate is returned when the
+// book is shallower than depth
+func (b *Book) RateAtDepth(depth float64) (float64, error) {
+	if len(b.Offers) == 0 {
+		return 0, fmt.Errorf("%s %s %v", b.Exchange, b.Currency, ErrEmptyLendBook)
+	}
+	var total float64
+	for x := range b.Offers {
+		total += b.Offers[x].Amount
+		if total > depth {
+			return b.Offers[x].Rate, nil
+		}
+	}
+	return b.Offers[len(b.Offers)-1].Rate, nil
+}
+
+// Filter returns the offers of the currency and side, an empty currency or
+// side matches every offer
+func Filter(offers []Offer, c currency.Code, side Side) []Offer {
+	var resp []Offer
+	for x := range offers {
+		if !c.IsEmpty() && !offers[x].Currency.Match(c) {
+			continue
+		}
+		if side != "" && offers[x].Side != side {
+			continue
+		}
+		resp = append(resp, offers[x])
+	}
+	return resp
+}
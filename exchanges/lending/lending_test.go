@@ -0,0 +1,128 @@
+package lending
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+func TestStringToSide(t *testing.T) {
+	t.Parallel()
+	s, err := StringToSide("lend")
+	if err != nil || s != Lend {
+		t.Errorf("expected %s, received %s %v", Lend, s, err)
+	}
+	s, err = StringToSide("LOAN")
+	if err != nil || s != Borrow {
+		t.Errorf("expected %s, received %s %v", Borrow, s, err)
+	}
+	if _, err = StringToSide("buy"); err == nil {
+		t.Error("expected an error for an unknown side")
+	}
+}
+
+func TestRateConversion(t *testing.T) {
+	t.Parallel()
+	if r := AnnualPercentToDaily(36.5); r != 0.001 {
+		t.Errorf("expected 0.001, received %v", r)
+	}
+	if r := DailyToAnnualPercent(0.001); r != 36.5 {
+		t.Errorf("expected 36.5, received %v", r)
+	}
+}
+
+func TestOfferRequestValidate(t *testing.T) {
+	t.Parallel()
+	var o *OfferRequest
+	if err := o.Validate(); err != ErrRequestIsNil {
+		t.Errorf("expected %v, received %v", ErrRequestIsNil, err)
+	}
+	o = &OfferRequest{}
+	if err := o.Validate(); err != ErrCurrencyNotSet {
+		t.Errorf("expected %v, received %v", ErrCurrencyNotSet, err)
+	}
+	o.Currency = currency.USD
+	if err := o.Validate(); err == nil {
+		t.Error("expected an error for an unset side")
+	}
+	o.Side = Lend
+	if err := o.Validate(); err != ErrInvalidAmount {
+		t.Errorf("expected %v, received %v", ErrInvalidAmount, err)
+	}
+	o.Amount = 100
+	if err := o.Validate(); err != ErrInvalidRate {
+		t.Errorf("expected %v, received %v", ErrInvalidRate, err)
+	}
+	o.Rate = 0.0002
+	if err := o.Validate(); err != ErrInvalidPeriod {
+		t.Errorf("expected %v, received %v", ErrInvalidPeriod, err)
+	}
+	o.Period = 2
+	if err := o.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBorrowRepayValidate(t *testing.T) {
+	t.Parallel()
+	b := &BorrowRequest{Currency: currency.BTC}
+	if err := b.Validate(); err != ErrInvalidAmount {
+		t.Errorf("expected %v, received %v", ErrInvalidAmount, err)
+	}
+	b.Amount = 1
+	if err := b.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	r := &RepayRequest{}
+	if err := r.Validate(); err != ErrLoanIDNotSet {
+		t.Errorf("expected %v, received %v", ErrLoanIDNotSet, err)
+	}
+	r.LoanID = "1337"
+	if err := r.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRateAtDepth(t *testing.T) {
+	t.Parallel()
+	b := Book{Exchange: "test", Currency: currency.USD}
+	if _, err := b.RateAtDepth(0); err == nil {
+		t.Error("expected an error for an empty book")
+	}
+	b.Offers = []BookItem{
+		{Amount: 100, Rate: 0.0001},
+		{Amount: 50, Rate: 0.0002},
+		{Amount: 200, Rate: 0.0003},
+	}
+	tester := map[float64]float64{
+		0:    0.0001,
+		100:  0.0002,
+		149:  0.0002,
+		150:  0.0003,
+		1000: 0.0003,
+	}
+	for depth, expected := range tester {
+		r, err := b.RateAtDepth(depth)
+		if err != nil || r != expected {
+			t.Errorf("depth %v expected %v, received %v %v", depth, expected, r, err)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+	offers := []Offer{
+		{Currency: currency.USD, Side: Lend},
+		{Currency: currency.BTC, Side: Lend},
+		{Currency: currency.USD, Side: Borrow},
+	}
+	if len(Filter(offers, currency.Code{}, "")) != 3 {
+		t.Error("expected every offer without a currency or side")
+	}
+	if len(Filter(offers, currency.USD, "")) != 2 ||
+		len(Filter(offers, currency.USD, Lend)) != 1 ||
+		len(Filter(offers, currency.Code{}, Lend)) != 2 {
+		t.Error("unexpected filtered offers")
+	}
+}
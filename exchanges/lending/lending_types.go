@@ -0,0 +1,108 @@
+package lending
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// vars for the lending package
+var (
+	ErrCurrencyNotSet  = errors.New("lending currency not set")
+	ErrInvalidAmount   = errors.New("lending amount must be greater than zero")
+	ErrInvalidRate     = errors.New("lending rate must be greater than zero")
+	ErrInvalidPeriod   = errors.New("lending period must be greater than zero")
+	ErrUnknownSide     = errors.New("lending side not recognised")
+	ErrLoanIDNotSet    = errors.New("loan ID not set")
+	ErrEmptyLendBook   = errors.New("lend book has no offers")
+	ErrRequestIsNil    = errors.New("lending request is nil")
+	ErrPairRequired    = errors.New("currency pair required to borrow or repay margin funds")
+	ErrUnsupportedSide = errors.New("lending side not supported")
+)
+
+// Side enforces a standard for lending sides across the code base
+type Side string
+
+// Side types
+const (
+	Lend        Side = "LEND"
+	Borrow      Side = "BORROW"
+	SideUnknown Side = "SIDEUNKNOWN"
+)
+
+// Book holds the funding offers and demands of a currency. Rates across the
+// code base are daily rates expressed as a fraction, 0.0002 is 0.02% a day
+type Book struct {
+	Exchange string
+	Currency currency.Code
+	// Offers are funds offered to be lent, ordered lowest rate first
+	Offers []BookItem
+	// Demands are funds requested to be borrowed, ordered highest rate first
+	Demands []BookItem
+}
+
+// BookItem holds the amount offered or demanded at a rate, periods are in
+// days
+type BookItem struct {
+	Amount    float64
+	Rate      float64
+	MinPeriod int
+	MaxPeriod int
+}
+
+// Offer holds an open funding offer of the account
+type Offer struct {
+	ID              string
+	Exchange        string
+	Currency        currency.Code
+	Side            Side
+	Amount          float64
+	RemainingAmount float64
+	Rate            float64
+	Period          int
+	AutoRenew       bool
+	Date            time.Time
+}
+
+// Loan holds funds lent out or borrowed by the account. Pair is set for
+// margin loans which are borrowed against a currency pair
+type Loan struct {
+	ID       string
+	Exchange string
+	Currency currency.Code
+	Pair     currency.Pair
+	Side     Side
+	Amount   float64
+	Rate     float64
+	Period   int
+	Date     time.Time
+}
+
+// OfferRequest is used to submit a funding offer, Lend offers funds to be
+// borrowed and Borrow requests funds on exchanges which match both sides
+type OfferRequest struct {
+	Currency  currency.Code
+	Side      Side
+	Amount    float64
+	Rate      float64
+	Period    int
+	AutoRenew bool
+}
+
+// BorrowRequest is used to borrow funds, Pair is required by exchanges which
+// borrow against a margin currency pair
+type BorrowRequest struct {
+	Currency currency.Code
+	Pair     currency.Pair
+	Amount   float64
+}
+
+// RepayRequest is used to repay borrowed funds, exchanges which repay by
+// currency pair repay every loan of the pair when LoanID is not set
+type RepayRequest struct {
+	LoanID   string
+	Currency currency.Code
+	Pair     currency.Pair
+	Amount   float64
+}
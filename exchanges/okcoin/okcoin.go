@@ -1,9 +1,6 @@
 package okcoin
 
 import (
-	"github.com/thrasher-corp/gocryptotrader/common"
-	"github.com/thrasher-corp/gocryptotrader/currency"
-	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 )
 
@@ -21,8 +18,3 @@ const (
 type OKCoin struct {
 	okgroup.OKGroup
 }
-
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (o *OKCoin) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrFunctionNotSupported
-}
@@ -202,15 +202,19 @@ func (o *OKCoin) FetchTradablePairs(asset asset.Item) ([]string, error) {
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, then loads the pairs' trading rules
 func (o *OKCoin) UpdateTradablePairs(forceUpdate bool) error {
 	pairs, err := o.FetchTradablePairs(asset.Spot)
 	if err != nil {
 		return err
 	}
 
-	return o.UpdatePairs(currency.NewPairsFromStrings(pairs),
+	err = o.UpdatePairs(currency.NewPairsFromStrings(pairs),
 		asset.Spot, false, forceUpdate)
+	if err != nil {
+		return err
+	}
+	return o.UpdateSpotTradingRules()
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
@@ -5,8 +5,6 @@ import (
 	"net/http"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
-	"github.com/thrasher-corp/gocryptotrader/currency"
-	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 )
 
@@ -28,6 +26,7 @@ const (
 	okGroupFutureLeverage = "leverage"
 	okGroupFutureOrder    = "order"
 	okGroupFutureHolds    = "holds"
+	okGroupMarginMode     = "margin_mode"
 	okGroupIndices        = "index"
 	okGroupRate           = "rate"
 	okGroupEsimtatedPrice = "estimated_price"
@@ -47,11 +46,6 @@ type OKEX struct {
 	okgroup.OKGroup
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (o *OKEX) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrFunctionNotSupported
-}
-
 // GetFuturesPostions Get the information of all holding positions in futures trading.
 // Due to high energy consumption, you are advised to capture data with the "Futures Account of a Currency" API instead.
 func (o *OKEX) GetFuturesPostions() (resp okgroup.GetFuturesPositionsResponse, _ error) {
@@ -90,6 +84,13 @@ func (o *OKEX) SetFuturesLeverage(request okgroup.SetFuturesLeverageRequest) (re
 	return resp, o.SendHTTPRequest(http.MethodPost, okGroupFuturesSubsection, requestURL, request, &resp, true)
 }
 
+// SetFuturesMarginMode Switching the margin mode of an underlying between
+// crossed and fixed margin. All positions of the underlying must be closed
+func (o *OKEX) SetFuturesMarginMode(request okgroup.SetFuturesMarginModeRequest) (resp okgroup.SetFuturesMarginModeResponse, _ error) {
+	requestURL := fmt.Sprintf("%v/%v", okgroup.OKGroupAccounts, okGroupMarginMode)
+	return resp, o.SendHTTPRequest(http.MethodPost, okGroupFuturesSubsection, requestURL, request, &resp, true)
+}
+
 // GetFuturesBillDetails Shows the account’s historical coin in flow and out flow.
 // All paginated requests return the latest information (newest) as the first page sorted by newest (in chronological time) first.
 func (o *OKEX) GetFuturesBillDetails(request okgroup.GetSpotBillDetailsForCurrencyRequest) (resp []okgroup.GetSpotBillDetailsForCurrencyResponse, _ error) {
@@ -351,7 +352,7 @@ func (o *OKEX) GetSwapExchangeRates() (resp okgroup.GetSwapExchangeRatesResponse
 }
 
 // GetSwapOpenInterest Get the open interest of a contract.
-func (o *OKEX) GetSwapOpenInterest(instrumentID string) (resp okgroup.GetSwapExchangeRatesResponse, _ error) {
+func (o *OKEX) GetSwapOpenInterest(instrumentID string) (resp okgroup.GetSwapOpenInterestResponse, _ error) {
 	requestURL := fmt.Sprintf("%v/%v/%v", okgroup.OKGroupInstruments, instrumentID, okGroupOpenInterest)
 	return resp, o.SendHTTPRequest(http.MethodGet, okGroupSwapSubsection, requestURL, nil, &resp, false)
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
@@ -485,6 +486,21 @@ func TestGetSpotTokenPairDetails(t *testing.T) {
 	}
 }
 
+// TestUpdateSpotTradingRules API endpoint test
+func TestUpdateSpotTradingRules(t *testing.T) {
+	t.Parallel()
+	if err := o.UpdateSpotTradingRules(); err != nil {
+		t.Fatal(err)
+	}
+	r, err := o.GetTradingRules(currency.NewPair(currency.BTC, currency.USDT), asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.PriceTick <= 0 || r.AmountStep <= 0 || r.MinAmount <= 0 {
+		t.Errorf("expected the price tick, amount step and min amount to be set %+v", r)
+	}
+}
+
 // TestGetSpotAllTokenPairsInformation API endpoint test
 func TestGetSpotAllTokenPairsInformation(t *testing.T) {
 	t.Parallel()
@@ -863,6 +879,16 @@ func TestSetFuturesLeverage(t *testing.T) {
 	testStandardErrorHandling(t, err)
 }
 
+// TestSetFuturesMarginMode API endpoint test
+func TestSetFuturesMarginMode(t *testing.T) {
+	TestSetRealOrderDefaults(t)
+	_, err := o.SetFuturesMarginMode(okgroup.SetFuturesMarginModeRequest{
+		Underlying: fmt.Sprintf("%v-%v", currency.BTC, currency.USD),
+		MarginMode: "crossed",
+	})
+	testStandardErrorHandling(t, err)
+}
+
 // TestGetFuturesBillDetails API endpoint test
 func TestGetFuturesBillDetails(t *testing.T) {
 	t.Parallel()
@@ -1337,6 +1363,62 @@ func TestGetSwapFundingRateHistory(t *testing.T) {
 	}
 }
 
+// TestGetPositions wrapper test
+func TestGetPositions(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetPositions(asset.PerpetualSwap)
+	testStandardErrorHandling(t, err)
+	_, err = o.GetPositions(asset.Spot)
+	if err == nil {
+		t.Error("Expecting an error for spot positions")
+	}
+}
+
+// TestGetFundingRates wrapper test
+func TestGetFundingRates(t *testing.T) {
+	t.Parallel()
+	rates, err := o.GetFundingRates(currency.NewPairWithDelimiter("BTC-USD", "SWAP", delimiterUnderscore),
+		asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(rates) == 0 {
+		t.Error("Expecting funding rates")
+	}
+}
+
+// TestUpdateDerivativesStats wrapper test
+func TestUpdateDerivativesStats(t *testing.T) {
+	t.Parallel()
+	stats, err := o.UpdateDerivativesStats(currency.NewPairWithDelimiter("BTC-USD", "SWAP", delimiterUnderscore),
+		asset.PerpetualSwap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.MarkPrice == 0 || stats.NextFundingTime.IsZero() {
+		t.Errorf("Expecting mark price and funding time, received %+v", stats)
+	}
+	_, err = o.UpdateDerivativesStats(currency.NewPair(currency.BTC, currency.USDT), asset.Spot)
+	if err == nil {
+		t.Error("Expecting an error for spot")
+	}
+}
+
+// TestInstrumentToPair logic test
+func TestInstrumentToPair(t *testing.T) {
+	t.Parallel()
+	p, err := instrumentToPair("BTC-USD-200327")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.String() != "BTC-USD_200327" {
+		t.Errorf("Expected BTC-USD_200327, received %s", p)
+	}
+	if _, err = instrumentToPair("BTC-USDT"); err == nil {
+		t.Error("Expecting an error for a spot instrument")
+	}
+}
+
 // TestGetETT API endpoint test
 func TestGetETT(t *testing.T) {
 	t.Parallel()
@@ -1694,6 +1776,47 @@ func TestSubmitOrder(t *testing.T) {
 	}
 }
 
+// TestSubmitOrders Wrapper test
+func TestSubmitOrders(t *testing.T) {
+	TestSetRealOrderDefaults(t)
+	t.Parallel()
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	orders := []order.Submit{
+		{Pair: pair, OrderSide: order.Buy, OrderType: order.Limit, Price: 1, Amount: 1},
+		{Pair: pair, OrderSide: order.Buy, OrderType: order.Limit, Price: 2, Amount: 1},
+	}
+	resp, err := o.SubmitOrders(orders)
+	if areTestAPIKeysSet() && (err != nil || len(resp) != 2 || !resp[1].IsOrderPlaced) {
+		t.Errorf("Orders failed to be placed: %v", err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+
+	if _, err = o.SubmitOrders([]order.Submit{{Pair: pair}}); err == nil {
+		t.Error("Expecting an error for an invalid order")
+	}
+}
+
+// TestCancelOrders Wrapper test
+func TestCancelOrders(t *testing.T) {
+	TestSetRealOrderDefaults(t)
+	t.Parallel()
+	pair := currency.NewPair(currency.LTC, currency.BTC)
+	resp, err := o.CancelOrders([]order.Cancel{
+		{OrderID: "1", CurrencyPair: pair},
+		{OrderID: "meow", CurrencyPair: pair},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status["meow"] == "" {
+		t.Error("Expecting an error for an invalid order ID")
+	}
+	if !areTestAPIKeysSet() && resp.Status["1"] == "" {
+		t.Error("Expecting an error when no keys are set")
+	}
+}
+
 // TestCancelExchangeOrder Wrapper test
 func TestCancelExchangeOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
@@ -1813,3 +1936,40 @@ func TestGetOrderbook(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestGetLoans(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetLoans()
+	testStandardErrorHandling(t, err)
+}
+
+func TestBorrow(t *testing.T) {
+	t.Parallel()
+	_, err := o.Borrow(&lending.BorrowRequest{Currency: currency.USDT, Amount: 100})
+	if err != lending.ErrPairRequired {
+		t.Errorf("expected %v, received %v", lending.ErrPairRequired, err)
+	}
+	TestSetRealOrderDefaults(t)
+	_, err = o.Borrow(&lending.BorrowRequest{
+		Currency: currency.USDT,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Amount:   100,
+	})
+	testStandardErrorHandling(t, err)
+}
+
+func TestRepay(t *testing.T) {
+	t.Parallel()
+	err := o.Repay(&lending.RepayRequest{LoanID: "1", Currency: currency.USDT})
+	if err != lending.ErrPairRequired {
+		t.Errorf("expected %v, received %v", lending.ErrPairRequired, err)
+	}
+	TestSetRealOrderDefaults(t)
+	err = o.Repay(&lending.RepayRequest{
+		LoanID:   "1",
+		Currency: currency.USDT,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Amount:   100,
+	})
+	testStandardErrorHandling(t, err)
+}
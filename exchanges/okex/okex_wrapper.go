@@ -3,6 +3,8 @@ package okex
 import (
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -12,6 +14,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -22,6 +28,16 @@ import (
 const (
 	delimiterDash       = "-"
 	delimiterUnderscore = "_"
+
+	okexMarginModeCrossed = "crossed"
+	okexMarginModeFixed   = "fixed"
+	// swap leverage sides
+	okexSwapFixedLong  = 1
+	okexSwapFixedShort = 2
+	okexSwapCrossed    = 3
+	// futures and swap order types closing a position
+	okexCloseLong  = 3
+	okexCloseShort = 4
 )
 
 // GetDefaultConfig returns a default exchange config
@@ -270,7 +286,7 @@ func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
-// them in the exchanges config
+// them in the exchanges config, then loads the spot pairs' trading rules
 func (o *OKEX) UpdateTradablePairs(forceUpdate bool) error {
 	for x := range o.CurrencyPairs.AssetTypes {
 		if o.CurrencyPairs.AssetTypes[x] == asset.Index {
@@ -304,6 +320,10 @@ func (o *OKEX) UpdateTradablePairs(forceUpdate bool) error {
 			return err
 		}
 	}
+
+	if o.SupportsAsset(asset.Spot) {
+		return o.UpdateSpotTradingRules()
+	}
 	return nil
 }
 
@@ -413,3 +433,392 @@ func (o *OKEX) FetchTicker(p currency.Pair, assetType asset.Item) (tickerData *t
 	}
 	return
 }
+
+// GetPositions returns the open futures or perpetual swap positions
+func (o *OKEX) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	var positions []position.Position
+	switch assetType {
+	case asset.Futures:
+		resp, err := o.GetFuturesPostions()
+		if err != nil {
+			return nil, err
+		}
+		for x := range resp.Holding {
+			for y := range resp.Holding[x] {
+				p, err := o.futuresPositions(&resp.Holding[x][y])
+				if err != nil {
+					return nil, err
+				}
+				positions = append(positions, p...)
+			}
+		}
+	case asset.PerpetualSwap:
+		resp, err := o.GetSwapPostions()
+		if err != nil {
+			return nil, err
+		}
+		for x := range resp {
+			for y := range resp[x].Holding {
+				h := &resp[x].Holding[y]
+				amount := parseOKEXFloat(h.Position)
+				if amount == 0 {
+					continue
+				}
+				p, err := instrumentToPair(h.InstrumentID)
+				if err != nil {
+					return nil, err
+				}
+				side, err := position.StringToSide(h.Side)
+				if err != nil {
+					return nil, err
+				}
+				positions = append(positions, position.Position{
+					Exchange:         o.Name,
+					AssetType:        assetType,
+					Pair:             p,
+					Side:             side,
+					Amount:           amount,
+					AvailableAmount:  parseOKEXFloat(h.AvailPosition),
+					EntryPrice:       parseOKEXFloat(h.AvgCost),
+					LiquidationPrice: parseOKEXFloat(h.LiquidationPrice),
+					Leverage:         parseOKEXFloat(h.Leverage),
+					MarginMode:       marginModeFromOKEX(resp[x].MarginMode),
+					Margin:           parseOKEXFloat(h.Margin),
+					RealisedPNL:      parseOKEXFloat(h.RealizedPnl),
+					UpdatedAt:        h.Timestamp,
+				})
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%v: %s", position.ErrUnsupportedAsset, assetType)
+	}
+	return positions, nil
+}
+
+// futuresPositions converts the long and short holdings of a futures contract
+// to positions
+func (o *OKEX) futuresPositions(h *okgroup.GetFuturePostionsDetails) ([]position.Position, error) {
+	p, err := instrumentToPair(h.InstrumentID)
+	if err != nil {
+		return nil, err
+	}
+	updated, _ := time.Parse(time.RFC3339, h.UpdatedAt)
+	mode := marginModeFromOKEX(h.MarginMode)
+	sides := []struct {
+		side                                            position.Side
+		qty, avail, cost, leverage, liquidation, margin string
+	}{
+		{position.Long, h.LongQty, h.LongAvailQty, h.LongAvgCost, h.LongLeverage, h.LongLiquiPrice, h.LongMargin},
+		{position.Short, h.ShortQty, h.ShortAvailQty, h.ShortAvgCost, h.ShortLeverage, h.ShortLiquiPrice, h.ShortMargin},
+	}
+
+	var positions []position.Position
+	for x := range sides {
+		amount := parseOKEXFloat(sides[x].qty)
+		if amount == 0 {
+			continue
+		}
+		// crossed margin positions only report the contract's leverage and
+		// liquidation price
+		leverage := parseOKEXFloat(sides[x].leverage)
+		if leverage == 0 {
+			leverage = parseOKEXFloat(h.Leverage)
+		}
+		liquidation := parseOKEXFloat(sides[x].liquidation)
+		if liquidation == 0 {
+			liquidation = parseOKEXFloat(h.LiquidationPrice)
+		}
+		positions = append(positions, position.Position{
+			Exchange:         o.Name,
+			AssetType:        asset.Futures,
+			Pair:             p,
+			Side:             sides[x].side,
+			Amount:           amount,
+			AvailableAmount:  parseOKEXFloat(sides[x].avail),
+			EntryPrice:       parseOKEXFloat(sides[x].cost),
+			LiquidationPrice: liquidation,
+			Leverage:         leverage,
+			MarginMode:       mode,
+			Margin:           parseOKEXFloat(sides[x].margin),
+			// realised PNL is reported for the contract rather than each side
+			RealisedPNL: parseOKEXFloat(h.RealisedPnl),
+			UpdatedAt:   updated,
+		})
+	}
+	return positions, nil
+}
+
+// SetLeverage sets the leverage of a futures underlying or perpetual swap
+// contract for its current margin mode
+func (o *OKEX) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	if leverage < 1 || leverage != math.Trunc(leverage) {
+		return fmt.Errorf("%v: %v must be a whole number", position.ErrInvalidLeverage, leverage)
+	}
+	instrumentID := o.FormatExchangeCurrency(p, assetType).String()
+	switch assetType {
+	case asset.Futures:
+		underlying := p.Base.String()
+		current, err := o.GetFuturesLeverage(underlying)
+		if err != nil {
+			return err
+		}
+		if marginModeFromOKEX(current.MarginMode) == position.Cross {
+			_, err = o.SetFuturesLeverage(okgroup.SetFuturesLeverageRequest{
+				Currency: underlying,
+				Leverage: int64(leverage),
+			})
+			return err
+		}
+		for _, direction := range []string{"long", "short"} {
+			_, err = o.SetFuturesLeverage(okgroup.SetFuturesLeverageRequest{
+				Currency:     underlying,
+				InstrumentID: instrumentID,
+				Direction:    direction,
+				Leverage:     int64(leverage),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case asset.PerpetualSwap:
+		settings, err := o.GetSwapAccountSettingsOfAContract(instrumentID)
+		if err != nil {
+			return err
+		}
+		sides := []int64{okexSwapCrossed}
+		if marginModeFromOKEX(settings.MarginMode) == position.Isolated {
+			sides = []int64{okexSwapFixedLong, okexSwapFixedShort}
+		}
+		for x := range sides {
+			_, err = o.SetSwapLeverageLevelOfAContract(okgroup.SetSwapLeverageLevelOfAContractRequest{
+				InstrumentID: instrumentID,
+				Leverage:     int64(leverage),
+				Side:         sides[x],
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("%v: %s", position.ErrUnsupportedAsset, assetType)
+}
+
+// SetMarginMode switches a futures underlying or perpetual swap contract
+// between crossed and fixed margin
+func (o *OKEX) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	var okexMode string
+	switch mode {
+	case position.Cross:
+		okexMode = okexMarginModeCrossed
+	case position.Isolated:
+		okexMode = okexMarginModeFixed
+	default:
+		return fmt.Errorf("%v: %s", position.ErrUnknownMarginMode, mode)
+	}
+
+	switch assetType {
+	case asset.Futures:
+		_, err := o.SetFuturesMarginMode(okgroup.SetFuturesMarginModeRequest{
+			Underlying: p.Base.String(),
+			MarginMode: okexMode,
+		})
+		return err
+	case asset.PerpetualSwap:
+		// the margin mode of a swap contract is switched by setting its
+		// leverage for the mode, retaining the current leverage
+		instrumentID := o.FormatExchangeCurrency(p, assetType).String()
+		settings, err := o.GetSwapAccountSettingsOfAContract(instrumentID)
+		if err != nil {
+			return err
+		}
+		requests := []okgroup.SetSwapLeverageLevelOfAContractRequest{
+			{Side: okexSwapCrossed, Leverage: int64(math.Max(settings.LongLeverage, settings.ShortLeverage))},
+		}
+		if mode == position.Isolated {
+			requests = []okgroup.SetSwapLeverageLevelOfAContractRequest{
+				{Side: okexSwapFixedLong, Leverage: int64(settings.LongLeverage)},
+				{Side: okexSwapFixedShort, Leverage: int64(settings.ShortLeverage)},
+			}
+		}
+		for x := range requests {
+			requests[x].InstrumentID = instrumentID
+			_, err = o.SetSwapLeverageLevelOfAContract(requests[x])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("%v: %s", position.ErrUnsupportedAsset, assetType)
+}
+
+// GetFundingRates returns the funding rate history of a perpetual swap
+// contract
+func (o *OKEX) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	if assetType != asset.PerpetualSwap {
+		return nil, fmt.Errorf("%v: %s", position.ErrUnsupportedAsset, assetType)
+	}
+	resp, err := o.GetSwapFundingRateHistory(okgroup.GetSwapFundingRateHistoryRequest{
+		InstrumentID: o.FormatExchangeCurrency(p, assetType).String(),
+		Limit:        100,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]position.FundingRate, len(resp))
+	for x := range resp {
+		fundingTime, _ := time.Parse(time.RFC3339, resp[x].FundingTime)
+		rates[x] = position.FundingRate{
+			Exchange:  o.Name,
+			AssetType: assetType,
+			Pair:      p,
+			Rate:      resp[x].FundingRate,
+			Time:      fundingTime,
+		}
+	}
+	return rates, nil
+}
+
+// ClosePosition closes a futures or perpetual swap position at the best
+// counter party price
+func (o *OKEX) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	positions, err := o.GetPositions(assetType)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	pos, err := position.Find(positions, p, assetType, side)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	closeType := int64(okexCloseLong)
+	if pos.Side == position.Short {
+		closeType = okexCloseShort
+	}
+
+	instrumentID := o.FormatExchangeCurrency(p, assetType).String()
+	if assetType == asset.Futures {
+		resp, err := o.PlaceFuturesOrder(okgroup.PlaceFuturesOrderRequest{
+			InstrumentID: instrumentID,
+			Type:         closeType,
+			Size:         int64(pos.AvailableAmount),
+			MatchPrice:   1,
+			Leverage:     int64(pos.Leverage),
+		})
+		if err != nil {
+			return order.SubmitResponse{}, err
+		}
+		if !resp.Result {
+			return order.SubmitResponse{}, errors.New(resp.ErrorMesssage)
+		}
+		return order.SubmitResponse{IsOrderPlaced: true, OrderID: resp.OrderID}, nil
+	}
+
+	resp, err := o.PlaceSwapOrder(okgroup.PlaceSwapOrderRequest{
+		InstrumentID: instrumentID,
+		Type:         closeType,
+		Size:         pos.AvailableAmount,
+		MatchPrice:   1,
+	})
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	if !resp.Result {
+		return order.SubmitResponse{}, errors.New(resp.ErrorMessage)
+	}
+	return order.SubmitResponse{IsOrderPlaced: true, OrderID: resp.OrderID}, nil
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual swap contract
+func (o *OKEX) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	instrumentID := o.FormatExchangeCurrency(p, assetType).String()
+	stats := derivatives.Stats{
+		Exchange:  o.Name,
+		AssetType: assetType,
+		Pair:      p,
+	}
+	switch assetType {
+	case asset.PerpetualSwap:
+		funding, err := o.GetSwapNextSettlementTime(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		stats.FundingRate = funding.FundingRate
+		stats.PredictedFundingRate = funding.EstimatedRate
+		stats.NextFundingTime, _ = time.Parse(time.RFC3339, funding.FundingTime)
+
+		mark, err := o.GetSwapMarkPrice(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		stats.MarkPrice = parseOKEXFloat(mark.MarkPrice)
+
+		index, err := o.GetSwapIndices(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		stats.IndexPrice = index.Index
+
+		openInterest, err := o.GetSwapOpenInterest(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		stats.OpenInterest = openInterest.Amount
+	case asset.Futures:
+		mark, err := o.GetFuturesCurrentMarkPrice(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		stats.MarkPrice = mark.MarkPrice
+
+		index, err := o.GetFuturesIndices(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		stats.IndexPrice = index.Index
+
+		openInterest, err := o.GetFuturesOpenInterests(instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		stats.OpenInterest = openInterest.Amount
+	default:
+		return nil, common.ErrFunctionNotSupported
+	}
+
+	err := derivatives.Process(&stats)
+	if err != nil {
+		return nil, err
+	}
+	return derivatives.Get(o.Name, p, assetType)
+}
+
+// instrumentToPair converts a futures or perpetual swap instrument ID such as
+// BTC-USD-SWAP to its currency pair
+func instrumentToPair(instrumentID string) (currency.Pair, error) {
+	p := strings.Split(instrumentID, delimiterDash)
+	if len(p) != 3 {
+		return currency.Pair{}, fmt.Errorf("unexpected instrument ID %s", instrumentID)
+	}
+	return currency.NewPairWithDelimiter(p[0]+delimiterDash+p[1],
+		p[2],
+		delimiterUnderscore), nil
+}
+
+// marginModeFromOKEX converts an OKEX margin mode to a position margin mode
+func marginModeFromOKEX(mode string) position.MarginMode {
+	m, err := position.StringToMarginMode(mode)
+	if err != nil {
+		return position.MarginModeUnknown
+	}
+	return m
+}
+
+// parseOKEXFloat parses the string encoded numbers of position responses,
+// fields which are not returned for a margin mode are empty
+func parseOKEXFloat(v string) float64 {
+	f, _ := strconv.ParseFloat(v, 64)
+	return f
+}
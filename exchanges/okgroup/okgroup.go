@@ -23,6 +23,9 @@ import (
 const (
 	okGroupAuthRate   = 0
 	okGroupUnauthRate = 0
+	// okGroupBatchLimit is the maximum number of pairs, and orders of each
+	// pair, of a batch order request
+	okGroupBatchLimit = 4
 	// OKGroupAPIPath const to help with api url formatting
 	OKGroupAPIPath = "api/"
 	// API subsections
@@ -78,6 +81,9 @@ const (
 	okGroupGetLoanHistory        = "borrowed"
 	okGroupGetLoan               = "borrow"
 	okGroupGetRepayment          = "repayment"
+	// okGroupCandlesLimit is the maximum number of candles returned per
+	// request
+	okGroupCandlesLimit = 200
 )
 
 var errMissValue = errors.New("warning - resp value is missing from exchange")
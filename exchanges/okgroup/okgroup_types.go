@@ -434,10 +434,10 @@ type OpenMarginLoanResponse struct {
 
 // RepayMarginLoanRequest request data for RepayMarginLoan
 type RepayMarginLoanRequest struct {
-	Amount        float64 `json:"amount,string"` // [required] amount repaid
-	BorrowID      float64 `json:"borrow_id"`     // [optional] borrow ID . all borrowed token under this trading pair will be repay if the field is left blank
-	QuoteCurrency string  `json:"currency"`      // [required] Second currency eg BTC-USDT: USDT is quote
-	InstrumentID  string  `json:"instrument_id"` // [required] Full pair BTC-USDT
+	Amount        float64 `json:"amount,string"`       // [required] amount repaid
+	BorrowID      float64 `json:"borrow_id,omitempty"` // [optional] borrow ID . all borrowed token under this trading pair will be repay if the field is left blank
+	QuoteCurrency string  `json:"currency"`            // [required] Second currency eg BTC-USDT: USDT is quote
+	InstrumentID  string  `json:"instrument_id"`       // [required] Full pair BTC-USDT
 }
 
 // RepayMarginLoanResponse response data for RepayMarginLoan
@@ -553,6 +553,19 @@ type SetFutureLeverageShortLongData struct {
 	Short int `json:"short"`
 }
 
+// SetFuturesMarginModeRequest request data for SetFuturesMarginMode
+type SetFuturesMarginModeRequest struct {
+	Underlying string `json:"underlying"`  // [required] Underlying index, e.g. "BTC-USD"
+	MarginMode string `json:"margin_mode"` // [required] Margin mode: crossed or fixed
+}
+
+// SetFuturesMarginModeResponse returned data for SetFuturesMarginMode
+type SetFuturesMarginModeResponse struct {
+	Underlying string `json:"underlying"`
+	MarginMode string `json:"margin_mode"`
+	Result     bool   `json:"result"`
+}
+
 // PlaceFuturesOrderRequest request data for PlaceFuturesOrder
 type PlaceFuturesOrderRequest struct {
 	ClientOid    string  `json:"client_oid,omitempty"`         // [optional] 	the order ID customized by yourself
@@ -1157,8 +1170,11 @@ type GetSwapOnHoldAmountForOpenOrdersResponse struct {
 
 // GetSwapNextSettlementTimeResponse response data for GetSwapNextSettlementTime
 type GetSwapNextSettlementTimeResponse struct {
-	InstrumentID string `json:"instrument_id"`
-	FundingTime  string `json:"funding_time"`
+	InstrumentID   string  `json:"instrument_id"`
+	FundingTime    string  `json:"funding_time"`
+	FundingRate    float64 `json:"funding_rate,string"`
+	EstimatedRate  float64 `json:"estimated_rate,string"`
+	SettlementTime string  `json:"settlement_time"`
 }
 
 // GetSwapMarkPriceResponse response data for GetSwapMarkPrice
@@ -1170,7 +1186,7 @@ type GetSwapMarkPriceResponse struct {
 
 // GetSwapFundingRateHistoryRequest request data for GetSwapFundingRateHistory
 type GetSwapFundingRateHistoryRequest struct {
-	InstrumentID string `url:"ins-trument_id"`         // [required] Contract ID, e.g. "BTC-USD-SWAP
+	InstrumentID string `url:"-"`                      // [required] Contract ID, e.g. "BTC-USD-SWAP
 	From         int64  `url:"from,string,omitempty"`  // [optional] Request paging content for this page number.（Example: 1,2,3,4,5. From 4 we only have 4, to 4 we only have 3）
 	To           int64  `url:"to,string,omitempty"`    // [optional] Request page after (older) this pagination id. （Example: 1,2,3,4,5. From 4 we only have 4, to 4 we only have 3）
 	Limit        int64  `url:"limit,string,omitempty"` // [optional] Number of results per request. Maximum 100.
@@ -448,6 +448,7 @@ func (o *OKGroup) wsProcessTrades(response *WebsocketDataResponse) {
 			AssetType:    o.GetAssetTypeFromTableName(response.Table),
 			CurrencyPair: c,
 			Exchange:     o.Name,
+			TID:          response.Data[i].WebsocketTradeResponse.TradeID,
 			Price:        response.Data[i].WebsocketTradeResponse.Price,
 			Side:         response.Data[i].Side,
 			Timestamp:    response.Data[i].Timestamp,
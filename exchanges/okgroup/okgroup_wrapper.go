@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
@@ -12,8 +13,13 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/rules"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
 )
@@ -273,6 +279,21 @@ func (o *OKGroup) SubmitOrder(s *order.Submit) (resp order.SubmitResponse, err e
 		return resp, err
 	}
 
+	request := o.spotOrderRequest(s)
+	orderResponse, err := o.PlaceSpotOrder(&request)
+	if err != nil {
+		return
+	}
+
+	resp.IsOrderPlaced = orderResponse.Result
+	resp.OrderID = orderResponse.OrderID
+	if s.OrderType == order.Market {
+		resp.FullyMatched = true
+	}
+	return
+}
+
+func (o *OKGroup) spotOrderRequest(s *order.Submit) PlaceOrderRequest {
 	request := PlaceOrderRequest{
 		ClientOID:    s.ClientID,
 		InstrumentID: o.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
@@ -283,18 +304,74 @@ func (o *OKGroup) SubmitOrder(s *order.Submit) (resp order.SubmitResponse, err e
 	if s.OrderType == order.Limit {
 		request.Price = strconv.FormatFloat(s.Price, 'f', -1, 64)
 	}
+	return request
+}
 
-	orderResponse, err := o.PlaceSpotOrder(&request)
-	if err != nil {
-		return
+// SubmitOrders places a batch of spot orders, the orders are split into batch
+// requests of up to four orders of up to four pairs. The responses are in the
+// order of the orders submitted, when a request fails the responses of the
+// orders placed by earlier requests are returned with the error
+func (o *OKGroup) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	requests := make([]PlaceOrderRequest, len(s))
+	for x := range s {
+		if err := s[x].Validate(); err != nil {
+			return nil, err
+		}
+		requests[x] = o.spotOrderRequest(&s[x])
 	}
 
-	resp.IsOrderPlaced = orderResponse.Result
-	resp.OrderID = orderResponse.OrderID
-	if s.OrderType == order.Market {
-		resp.FullyMatched = true
+	resp := make([]order.SubmitResponse, len(s))
+	for _, batch := range spotOrderBatches(requests) {
+		batchRequests := make([]PlaceOrderRequest, len(batch))
+		for x := range batch {
+			batchRequests[x] = requests[batch[x]]
+		}
+		placed, errs := o.PlaceMultipleSpotOrders(batchRequests)
+		if len(placed) == 0 && len(errs) > 0 {
+			return resp, errs[0]
+		}
+
+		// the responses of each pair are in the order the pair's orders were
+		// requested
+		responses := make(map[string][]PlaceOrderResponse, len(placed))
+		for instrument := range placed {
+			responses[strings.ToLower(instrument)] = placed[instrument]
+		}
+		for _, x := range batch {
+			instrument := strings.ToLower(requests[x].InstrumentID)
+			if len(responses[instrument]) == 0 {
+				continue
+			}
+			resp[x].IsOrderPlaced = responses[instrument][0].Result
+			resp[x].OrderID = responses[instrument][0].OrderID
+			resp[x].FullyMatched = resp[x].IsOrderPlaced && s[x].OrderType == order.Market
+			responses[instrument] = responses[instrument][1:]
+		}
 	}
-	return
+	return resp, nil
+}
+
+// spotOrderBatches splits the order requests into batches within the batch
+// order request limits, returning the indexes of each batch's requests
+func spotOrderBatches(requests []PlaceOrderRequest) [][]int {
+	var batches [][]int
+	var batch []int
+	pairOrders := make(map[string]int)
+	for x := range requests {
+		instrument := requests[x].InstrumentID
+		if pairOrders[instrument] == okGroupBatchLimit ||
+			(pairOrders[instrument] == 0 && len(pairOrders) == okGroupBatchLimit) {
+			batches = append(batches, batch)
+			batch = nil
+			pairOrders = make(map[string]int)
+		}
+		batch = append(batch, x)
+		pairOrders[instrument]++
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
 }
 
 // ModifyOrder will allow of changing orderbook placement and limit to
@@ -354,6 +431,62 @@ func (o *OKGroup) CancelAllOrders(orderCancellation *order.Cancel) (resp order.C
 	return
 }
 
+// CancelOrders cancels a batch of spot orders, the orders of each pair are
+// cancelled in batch requests of up to four orders. The orders which fail to
+// cancel are returned in the response status with their error
+func (o *OKGroup) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	resp := order.CancelAllResponse{Status: make(map[string]string)}
+	var instruments []string
+	orderIDs := make(map[string][]int64)
+	for x := range c {
+		orderID, err := strconv.ParseInt(c[x].OrderID, 10, 64)
+		if err != nil {
+			resp.Status[c[x].OrderID] = err.Error()
+			continue
+		}
+		instrument := o.FormatExchangeCurrency(c[x].CurrencyPair, asset.Spot).String()
+		if _, ok := orderIDs[instrument]; !ok {
+			instruments = append(instruments, instrument)
+		}
+		orderIDs[instrument] = append(orderIDs[instrument], orderID)
+	}
+
+	for _, instrument := range instruments {
+		ids := orderIDs[instrument]
+		for start := 0; start < len(ids); start += okGroupBatchLimit {
+			end := start + okGroupBatchLimit
+			if end > len(ids) {
+				end = len(ids)
+			}
+			batch := ids[start:end]
+			cancelled, err := o.CancelMultipleSpotOrders(CancelMultipleSpotOrdersRequest{
+				InstrumentID: instrument,
+				OrderIDs:     batch,
+			})
+			results := make(map[int64]bool)
+			for k := range cancelled {
+				for y := range cancelled[k] {
+					if cancelled[k][y].Result {
+						results[cancelled[k][y].OrderID] = true
+					}
+				}
+			}
+			for y := range batch {
+				if results[batch[y]] {
+					continue
+				}
+				id := strconv.FormatInt(batch[y], 10)
+				if err != nil {
+					resp.Status[id] = err.Error()
+				} else {
+					resp.Status[id] = fmt.Sprintf("order %s failed to be cancelled", id)
+				}
+			}
+		}
+	}
+	return resp, nil
+}
+
 // GetOrderInfo returns information on a current open order
 func (o *OKGroup) GetOrderInfo(orderID string) (resp order.Detail, err error) {
 	mOrder, err := o.GetSpotOrder(GetSpotOrderRequest{OrderID: orderID})
@@ -521,9 +654,252 @@ func (o *OKGroup) AuthenticateWebsocket() error {
 	return o.WsLogin()
 }
 
+// GetHistoricCandles returns candles between a time period for a set time
+// interval, only spot candles are supported
+func (o *OKGroup) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	if a != asset.Spot {
+		return kline.Item{}, common.ErrFunctionNotSupported
+	}
+
+	ret := kline.Item{
+		Exchange: o.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	instrument := o.FormatExchangeCurrency(pair, a).String()
+	dates := kline.DateRange{Start: start, End: end}.Split(interval, okGroupCandlesLimit)
+	for x := range dates {
+		data, err := o.GetSpotMarketData(GetSpotMarketDataRequest{
+			Start:        dates[x].Start.UTC().Format(time.RFC3339),
+			End:          dates[x].End.UTC().Format(time.RFC3339),
+			Granularity:  int64(interval.Duration().Seconds()),
+			InstrumentID: instrument,
+		})
+		if err != nil {
+			return kline.Item{}, err
+		}
+		for y := range data {
+			candle, err := parseCandle(data[y])
+			if err != nil {
+				return kline.Item{}, err
+			}
+			ret.Candles = append(ret.Candles, candle)
+		}
+	}
+	ret.FilterByTimeRange(start, end)
+	ret.SortCandlesByTimestamp(false)
+	return ret, nil
+}
+
+// parseCandle converts a candle returned as an array of time, open, high,
+// low, close and volume strings
+func parseCandle(data interface{}) (kline.Candle, error) {
+	fields, ok := data.([]interface{})
+	if !ok || len(fields) < 6 {
+		return kline.Candle{}, errors.New("unexpected candle data")
+	}
+	var values [6]string
+	for x := range values {
+		if values[x], ok = fields[x].(string); !ok {
+			return kline.Candle{}, errors.New("unexpected candle data")
+		}
+	}
+	var candle kline.Candle
+	var err error
+	if candle.Time, err = time.Parse(time.RFC3339, values[0]); err != nil {
+		return kline.Candle{}, err
+	}
+	prices := []*float64{&candle.Open, &candle.High, &candle.Low, &candle.Close, &candle.Volume}
+	for x := range prices {
+		if *prices[x], err = strconv.ParseFloat(values[x+1], 64); err != nil {
+			return kline.Candle{}, err
+		}
+	}
+	return candle, nil
+}
+
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
 func (o *OKGroup) ValidateCredentials() error {
 	_, err := o.UpdateAccountInfo()
 	return o.CheckTransientError(err)
 }
+
+// GetPositions returns the open derivatives positions of the asset type
+func (o *OKGroup) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (o *OKGroup) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (o *OKGroup) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (o *OKGroup) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (o *OKGroup) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (o *OKGroup) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetLendBook is not supported, margin loans are borrowed from the exchange
+func (o *OKGroup) GetLendBook(c currency.Code) (lending.Book, error) {
+	return lending.Book{}, common.ErrFunctionNotSupported
+}
+
+// GetFundingOffers is not supported, margin loans are borrowed from the
+// exchange
+func (o *OKGroup) GetFundingOffers() ([]lending.Offer, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitFundingOffer is not supported, margin loans are borrowed from the
+// exchange
+func (o *OKGroup) SubmitFundingOffer(_ *lending.OfferRequest) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// CancelFundingOffer is not supported, margin loans are borrowed from the
+// exchange
+func (o *OKGroup) CancelFundingOffer(_ string) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetLoans returns the outstanding margin loans of the margin trading account
+func (o *OKGroup) GetLoans() ([]lending.Loan, error) {
+	resp, err := o.GetMarginLoanHistory(GetMarginLoanHistoryRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	var loans []lending.Loan
+	for x := range resp {
+		outstanding := resp[x].Amount - resp[x].ReturnedAmount
+		if outstanding <= 0 {
+			continue
+		}
+		loans = append(loans, lending.Loan{
+			ID:       strconv.FormatInt(resp[x].BorrowID, 10),
+			Exchange: o.Name,
+			Currency: currency.NewCode(resp[x].Currency),
+			Pair: currency.NewPairDelimiter(resp[x].InstrumentID,
+				o.GetPairFormat(asset.Spot, false).Delimiter),
+			Side:   lending.Borrow,
+			Amount: outstanding,
+			Rate:   resp[x].Rate,
+			Date:   resp[x].Timestamp,
+		})
+	}
+	return loans, nil
+}
+
+// Borrow borrows funds against a currency pair in the margin trading account
+func (o *OKGroup) Borrow(b *lending.BorrowRequest) (string, error) {
+	if err := b.Validate(); err != nil {
+		return "", err
+	}
+	if b.Pair.IsEmpty() {
+		return "", lending.ErrPairRequired
+	}
+
+	resp, err := o.OpenMarginLoan(OpenMarginLoanRequest{
+		QuoteCurrency: b.Currency.Upper().String(),
+		InstrumentID:  o.FormatExchangeCurrency(b.Pair, asset.Spot).String(),
+		Amount:        b.Amount,
+	})
+	if err != nil {
+		return "", err
+	}
+	if !resp.Result {
+		return "", fmt.Errorf("%s margin loan of %v %s failed",
+			o.Name, b.Amount, b.Currency)
+	}
+	return strconv.FormatInt(resp.BorrowID, 10), nil
+}
+
+// Repay repays a margin loan, every loan of the currency pair is repaid when
+// the loan ID is not set
+func (o *OKGroup) Repay(r *lending.RepayRequest) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if r.Pair.IsEmpty() {
+		return lending.ErrPairRequired
+	}
+	if r.Currency.IsEmpty() {
+		return lending.ErrCurrencyNotSet
+	}
+	if r.Amount <= 0 {
+		return lending.ErrInvalidAmount
+	}
+
+	request := RepayMarginLoanRequest{
+		Amount:        r.Amount,
+		QuoteCurrency: r.Currency.Upper().String(),
+		InstrumentID:  o.FormatExchangeCurrency(r.Pair, asset.Spot).String(),
+	}
+	if r.LoanID != "" {
+		borrowID, err := strconv.ParseInt(r.LoanID, 10, 64)
+		if err != nil {
+			return err
+		}
+		request.BorrowID = float64(borrowID)
+	}
+
+	resp, err := o.RepayMarginLoan(request)
+	if err != nil {
+		return err
+	}
+	if !resp.Result {
+		return fmt.Errorf("%s margin loan repayment of %v %s failed",
+			o.Name, r.Amount, r.Currency)
+	}
+	return nil
+}
+
+// UpdateSpotTradingRules loads the price tick, size increment and minimum size
+// of the spot token pairs as their trading rules
+func (o *OKGroup) UpdateSpotTradingRules() error {
+	prods, err := o.GetSpotTokenPairDetails()
+	if err != nil {
+		return err
+	}
+
+	r := make([]rules.Rules, len(prods))
+	for x := range prods {
+		r[x] = rules.Rules{
+			Pair: currency.NewPairFromStrings(prods[x].BaseCurrency,
+				prods[x].QuoteCurrency),
+			Asset: asset.Spot,
+		}
+		if r[x].PriceTick, err = strconv.ParseFloat(prods[x].TickSize, 64); err != nil {
+			return fmt.Errorf("%s %s tick size: %v", o.Name, prods[x].InstrumentID, err)
+		}
+		if r[x].AmountStep, err = strconv.ParseFloat(prods[x].SizeIncrement, 64); err != nil {
+			return fmt.Errorf("%s %s size increment: %v", o.Name, prods[x].InstrumentID, err)
+		}
+		if r[x].MinAmount, err = strconv.ParseFloat(prods[x].MinSize, 64); err != nil {
+			return fmt.Errorf("%s %s min size: %v", o.Name, prods[x].InstrumentID, err)
+		}
+	}
+	return o.LoadTradingRules(r)
+}
@@ -38,6 +38,7 @@ var (
 // Submit contains the order submission data
 type Submit struct {
 	Pair         currency.Pair
+	AssetType    asset.Item
 	OrderType    Type
 	OrderSide    Side
 	TriggerPrice float64
@@ -92,6 +93,7 @@ const (
 	ImmediateOrCancel Type = "IMMEDIATE_OR_CANCEL"
 	Stop              Type = "STOP"
 	TrailingStop      Type = "TRAILINGSTOP"
+	TakeProfit        Type = "TAKE_PROFIT"
 	Unknown           Type = "UNKNOWN"
 )
 
@@ -113,7 +115,9 @@ type Detail struct {
 	Exchange        string
 	AccountID       string
 	ID              string
+	InternalOrderID string
 	CurrencyPair    currency.Pair
+	AssetType       asset.Item
 	OrderSide       Side
 	OrderType       Type
 	OrderDate       time.Time
@@ -92,6 +92,62 @@ func (s *Submit) Validate() error {
 	return nil
 }
 
+// UpdateFromDetail applies the latest exchange state of an order to the
+// stored order. It returns the amount executed since the last update and any
+// trades which were not already recorded. When the exchange only reports
+// amounts the status is advanced from New or Active to PartiallyFilled or
+// Filled. An order with a final status keeps it, as a later status can only
+// come from a stale snapshot, though fills reported late are still applied
+func (d *Detail) UpdateFromDetail(latest *Detail) (executed float64, trades []TradeHistory) {
+	if latest.Status != "" && !d.Status.IsFinal() {
+		d.Status = latest.Status
+	}
+	if latest.Price > 0 {
+		d.Price = latest.Price
+	}
+	if latest.Amount > 0 {
+		d.Amount = latest.Amount
+	}
+	if latest.ExecutedAmount > d.ExecutedAmount {
+		executed = latest.ExecutedAmount - d.ExecutedAmount
+		d.ExecutedAmount = latest.ExecutedAmount
+	}
+	if latest.RemainingAmount > 0 {
+		d.RemainingAmount = latest.RemainingAmount
+	} else if d.Amount > 0 {
+		d.RemainingAmount = d.Amount - d.ExecutedAmount
+	}
+	if latest.Fee > d.Fee {
+		d.Fee = latest.Fee
+	}
+
+	for x := range latest.Trades {
+		var recorded bool
+		for y := range d.Trades {
+			if (latest.Trades[x].TID != "" && d.Trades[y].TID == latest.Trades[x].TID) ||
+				(latest.Trades[x].TID == "" && d.Trades[y] == latest.Trades[x]) {
+				recorded = true
+				break
+			}
+		}
+		if !recorded {
+			trades = append(trades, latest.Trades[x])
+		}
+	}
+	d.Trades = append(d.Trades, trades...)
+
+	if d.ExecutedAmount > 0 && (d.Status == New || d.Status == Active ||
+		d.Status == PartiallyFilled || d.Status == "") {
+		if d.Amount > 0 && d.ExecutedAmount >= d.Amount {
+			d.Status = Filled
+			d.RemainingAmount = 0
+		} else {
+			d.Status = PartiallyFilled
+		}
+	}
+	return executed, trades
+}
+
 // String implements the stringer interface
 func (t Type) String() string {
 	return string(t)
@@ -117,6 +173,16 @@ func (s Status) String() string {
 	return string(s)
 }
 
+// IsFinal returns whether the status is one an order does not transition out
+// of
+func (s Status) IsFinal() bool {
+	switch s {
+	case Filled, Cancelled, PartiallyCancelled, Rejected, Expired:
+		return true
+	}
+	return false
+}
+
 // FilterOrdersBySide removes any order details that don't match the
 // order status provided
 func FilterOrdersBySide(orders *[]Detail, side Side) {
@@ -335,6 +401,8 @@ func StringToOrderType(oType string) (Type, error) {
 		return Stop, nil
 	case strings.EqualFold(oType, TrailingStop.String()):
 		return TrailingStop, nil
+	case strings.EqualFold(oType, TakeProfit.String()):
+		return TakeProfit, nil
 	case strings.EqualFold(oType, AnyType.String()):
 		return AnyType, nil
 	default:
@@ -35,3 +35,81 @@ func TestGetOrderByOrderID(t *testing.T) {
 		t.Error("Orders_test.go GetOrdersByExchange() - Error")
 	}
 }
+
+func TestUpdateFromDetail(t *testing.T) {
+	d := Detail{
+		ID:              "1337",
+		Status:          New,
+		Price:           100,
+		Amount:          10,
+		RemainingAmount: 10,
+	}
+
+	executed, trades := d.UpdateFromDetail(&Detail{
+		ExecutedAmount: 4,
+		Trades: []TradeHistory{
+			{TID: "1", Price: 100, Amount: 4},
+		},
+	})
+	if executed != 4 || len(trades) != 1 {
+		t.Errorf("expected 4 executed and 1 trade, received %v and %d", executed, len(trades))
+	}
+	if d.Status != PartiallyFilled || d.RemainingAmount != 6 {
+		t.Errorf("unexpected order state %v %v", d.Status, d.RemainingAmount)
+	}
+
+	executed, trades = d.UpdateFromDetail(&Detail{
+		Status:         Active,
+		ExecutedAmount: 10,
+		Trades: []TradeHistory{
+			{TID: "1", Price: 100, Amount: 4},
+			{TID: "2", Price: 100, Amount: 6},
+		},
+	})
+	if executed != 6 || len(trades) != 1 || trades[0].TID != "2" {
+		t.Errorf("expected 6 executed and trade 2, received %v and %v", executed, trades)
+	}
+	if d.Status != Filled || d.RemainingAmount != 0 || len(d.Trades) != 2 {
+		t.Errorf("unexpected order state %v %v %d", d.Status, d.RemainingAmount, len(d.Trades))
+	}
+
+	c := Detail{Status: Active, Amount: 10, ExecutedAmount: 2}
+	executed, _ = c.UpdateFromDetail(&Detail{Status: Cancelled})
+	if executed != 0 || c.Status != Cancelled {
+		t.Errorf("unexpected order state %v %v", executed, c.Status)
+	}
+}
+
+func TestUpdateFromDetailFinalStatus(t *testing.T) {
+	d := Detail{Status: Filled, Amount: 10, ExecutedAmount: 10}
+	d.UpdateFromDetail(&Detail{Status: Active, ExecutedAmount: 4})
+	if d.Status != Filled || d.ExecutedAmount != 10 {
+		t.Errorf("stale snapshot reopened filled order %v %v", d.Status, d.ExecutedAmount)
+	}
+
+	c := Detail{Status: Cancelled, Amount: 10, ExecutedAmount: 2}
+	executed, _ := c.UpdateFromDetail(&Detail{Status: PartiallyFilled, ExecutedAmount: 3})
+	if c.Status != Cancelled || executed != 1 || c.RemainingAmount != 7 {
+		t.Errorf("expected cancelled order to keep its status and apply the late fill, received %v %v %v",
+			c.Status, executed, c.RemainingAmount)
+	}
+
+	r := Detail{Status: Rejected}
+	r.UpdateFromDetail(&Detail{Status: New})
+	if r.Status != Rejected {
+		t.Errorf("stale snapshot reopened rejected order %v", r.Status)
+	}
+}
+
+func TestStatusIsFinal(t *testing.T) {
+	for _, s := range []Status{Filled, Cancelled, PartiallyCancelled, Rejected, Expired} {
+		if !s.IsFinal() {
+			t.Errorf("expected %s to be final", s)
+		}
+	}
+	for _, s := range []Status{New, Active, PartiallyFilled, UnknownStatus, ""} {
+		if s.IsFinal() {
+			t.Errorf("expected %s not to be final", s)
+		}
+	}
+}
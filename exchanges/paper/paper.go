@@ -0,0 +1,514 @@
+package paper
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// New wraps an exchange for paper trading, seeding its virtual account with
+// the supplied balances
+func New(exch exchange.IBotExchange, balances []config.PaperTradingBalance) *Exchange {
+	e := &Exchange{
+		IBotExchange: exch,
+		balances:     make(map[string]*account.Balance),
+		orders:       make(map[string]*paperOrder),
+	}
+	for x := range balances {
+		e.balance(balances[x].Currency).TotalValue += balances[x].Amount
+	}
+	return e
+}
+
+// SubmitOrder simulates an order against the exchange's orderbook. Market
+// orders and the crossing amount of limit orders are filled immediately as a
+// taker, the remainder of a limit order rests until the orderbook crosses its
+// price
+func (e *Exchange) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+	var resp order.SubmitResponse
+	err := s.Validate()
+	if err != nil {
+		return resp, err
+	}
+
+	a := s.AssetType
+	if a == "" {
+		a = asset.Spot
+	}
+	ob, err := e.FetchOrderbook(s.Pair, a)
+	if err != nil {
+		return resp, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return resp, err
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.matchResting()
+
+	o := &paperOrder{
+		Detail: order.Detail{
+			Exchange:        e.GetName(),
+			AccountID:       AccountID,
+			ID:              id.String(),
+			CurrencyPair:    s.Pair,
+			AssetType:       a,
+			OrderSide:       s.OrderSide,
+			OrderType:       s.OrderType,
+			OrderDate:       time.Now(),
+			Status:          order.New,
+			Price:           s.Price,
+			Amount:          s.Amount,
+			RemainingAmount: s.Amount,
+		},
+		lastMatched: ob.LastUpdated,
+	}
+	buy := isBuy(s.OrderSide)
+
+	if s.OrderType == order.Market {
+		if !buy && s.Amount > available(e.balance(s.Pair.Base)) {
+			return resp, ErrInsufficientBalance
+		}
+		price, amount := match(ob, buy, s.Amount, 0)
+		if amount == 0 {
+			return resp, ErrNoLiquidity
+		}
+		err = e.fill(o, price, amount, false)
+		if err != nil {
+			return resp, err
+		}
+		o.Price = averagePrice(o.Trades)
+		if o.RemainingAmount > 0 {
+			// the orderbook could not fill the entire order
+			o.Status = order.PartiallyCancelled
+		}
+	} else {
+		err = e.reserve(o)
+		if err != nil {
+			return resp, err
+		}
+		price, amount := match(ob, buy, s.Amount, s.Price)
+		if amount > 0 {
+			err = e.fill(o, price, amount, false)
+			if err != nil {
+				e.cancel(o)
+				return resp, err
+			}
+		}
+		if o.Status == order.New {
+			o.Status = order.Active
+		}
+	}
+
+	e.orders[o.ID] = o
+	resp.IsOrderPlaced = true
+	resp.FullyMatched = o.Status == order.Filled
+	resp.OrderID = o.ID
+	return resp, nil
+}
+
+// ModifyOrder is not supported in paper trading mode, orders must be
+// cancelled and resubmitted
+func (e *Exchange) ModifyOrder(_ *order.Modify) (string, error) {
+	return "", ErrNotSupported
+}
+
+// CancelOrder cancels an open paper trading order and releases its reserved
+// funds
+func (e *Exchange) CancelOrder(c *order.Cancel) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.matchResting()
+
+	o, ok := e.orders[c.OrderID]
+	if !ok {
+		return ErrOrderNotFound
+	}
+	if !isOpen(o.Status) {
+		return ErrOrderClosed
+	}
+	e.cancel(o)
+	return nil
+}
+
+// CancelAllOrders cancels all open paper trading orders, restricted to the
+// currency pair if one is set, and reports each order it cancelled
+func (e *Exchange) CancelAllOrders(c *order.Cancel) (order.CancelAllResponse, error) {
+	resp := order.CancelAllResponse{
+		Status: make(map[string]string),
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.matchResting()
+
+	for _, o := range e.orders {
+		if !isOpen(o.Status) {
+			continue
+		}
+		if c != nil && !c.CurrencyPair.IsEmpty() && !o.CurrencyPair.Equal(c.CurrencyPair) {
+			continue
+		}
+		e.cancel(o)
+		resp.Status[o.ID] = "cancelled"
+	}
+	return resp, nil
+}
+
+// SubmitOrders simulates a batch of orders one at a time, stopping at the
+// first order which fails
+func (e *Exchange) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	resp := make([]order.SubmitResponse, 0, len(s))
+	for x := range s {
+		r, err := e.SubmitOrder(&s[x])
+		if err != nil {
+			return resp, err
+		}
+		resp = append(resp, r)
+	}
+	return resp, nil
+}
+
+// CancelOrders cancels a batch of paper trading orders, the orders which fail
+// to cancel are returned in the response status with their error
+func (e *Exchange) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	resp := order.CancelAllResponse{
+		Status: make(map[string]string),
+	}
+	for x := range c {
+		if err := e.CancelOrder(&c[x]); err != nil {
+			resp.Status[c[x].OrderID] = err.Error()
+		}
+	}
+	return resp, nil
+}
+
+// GetOrderInfo returns a paper trading order
+func (e *Exchange) GetOrderInfo(orderID string) (order.Detail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.matchResting()
+
+	o, ok := e.orders[orderID]
+	if !ok {
+		return order.Detail{}, ErrOrderNotFound
+	}
+	return o.copy(), nil
+}
+
+// GetActiveOrders returns the open paper trading orders matching the request
+func (e *Exchange) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+	return e.getOrders(req, true), nil
+}
+
+// GetOrderHistory returns the closed paper trading orders matching the request
+func (e *Exchange) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+	return e.getOrders(req, false), nil
+}
+
+// FetchAccountInfo returns the paper trading account balances
+func (e *Exchange) FetchAccountInfo() (account.Holdings, error) {
+	return e.UpdateAccountInfo()
+}
+
+// UpdateAccountInfo matches any resting orders and returns the paper trading
+// account balances
+func (e *Exchange) UpdateAccountInfo() (account.Holdings, error) {
+	e.m.Lock()
+	e.matchResting()
+	codes := make([]string, 0, len(e.balances))
+	for k := range e.balances {
+		codes = append(codes, k)
+	}
+	sort.Strings(codes)
+	sub := account.SubAccount{ID: AccountID}
+	for x := range codes {
+		sub.Currencies = append(sub.Currencies, *e.balances[codes[x]])
+	}
+	e.m.Unlock()
+
+	h := account.Holdings{
+		Exchange: e.GetName(),
+		Accounts: []account.SubAccount{sub},
+	}
+	return h, account.Process(&h)
+}
+
+// WithdrawCryptocurrencyFunds is not supported in paper trading mode
+func (e *Exchange) WithdrawCryptocurrencyFunds(_ *withdraw.CryptoRequest) (string, error) {
+	return "", ErrNotSupported
+}
+
+// WithdrawFiatFunds is not supported in paper trading mode
+func (e *Exchange) WithdrawFiatFunds(_ *withdraw.FiatRequest) (string, error) {
+	return "", ErrNotSupported
+}
+
+// WithdrawFiatFundsToInternationalBank is not supported in paper trading mode
+func (e *Exchange) WithdrawFiatFundsToInternationalBank(_ *withdraw.FiatRequest) (string, error) {
+	return "", ErrNotSupported
+}
+
+// GetPositions is not supported in paper trading mode
+func (e *Exchange) GetPositions(_ asset.Item) ([]position.Position, error) {
+	return nil, ErrNotSupported
+}
+
+// SetLeverage is not supported in paper trading mode
+func (e *Exchange) SetLeverage(_ currency.Pair, _ asset.Item, _ float64) error {
+	return ErrNotSupported
+}
+
+// SetMarginMode is not supported in paper trading mode
+func (e *Exchange) SetMarginMode(_ currency.Pair, _ asset.Item, _ position.MarginMode) error {
+	return ErrNotSupported
+}
+
+// ClosePosition is not supported in paper trading mode
+func (e *Exchange) ClosePosition(_ currency.Pair, _ asset.Item, _ position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, ErrNotSupported
+}
+
+// getOrders returns the open or closed orders matching the request
+func (e *Exchange) getOrders(req *order.GetOrdersRequest, open bool) []order.Detail {
+	e.m.Lock()
+	e.matchResting()
+	var orders []order.Detail
+	for _, o := range e.orders {
+		if isOpen(o.Status) == open {
+			orders = append(orders, o.copy())
+		}
+	}
+	e.m.Unlock()
+
+	if req != nil {
+		order.FilterOrdersByType(&orders, req.OrderType)
+		order.FilterOrdersBySide(&orders, req.OrderSide)
+		order.FilterOrdersByTickRange(&orders, req.StartTicks, req.EndTicks)
+		order.FilterOrdersByCurrencies(&orders, req.Currencies)
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].OrderDate.Before(orders[j].OrderDate)
+	})
+	return orders
+}
+
+// matchResting fills open limit orders as a maker at their limit price against
+// any orderbook update received since they were last matched. The caller must
+// hold the lock
+func (e *Exchange) matchResting() {
+	for _, o := range e.orders {
+		if o.OrderType != order.Limit || !isOpen(o.Status) {
+			continue
+		}
+		ob, err := orderbook.Get(e.GetName(), o.CurrencyPair, o.AssetType)
+		if err != nil || !ob.LastUpdated.After(o.lastMatched) {
+			continue
+		}
+		o.lastMatched = ob.LastUpdated
+		_, amount := match(ob, isBuy(o.OrderSide), o.RemainingAmount, o.Price)
+		if amount == 0 {
+			continue
+		}
+		err = e.fill(o, o.Price, amount, true)
+		if err != nil {
+			log.Errorf(log.ExchangeSys, "%s paper trading order %s fill failed: %v\n",
+				e.GetName(), o.ID, err)
+		}
+	}
+}
+
+// reserve holds the funds required to fill a limit order, buys reserve the
+// quote amount plus the taker fee and sells reserve the base amount
+func (e *Exchange) reserve(o *paperOrder) error {
+	if isBuy(o.OrderSide) {
+		fee, err := e.fee(o, o.Price, o.Amount, false)
+		if err != nil {
+			return err
+		}
+		quote := e.balance(o.CurrencyPair.Quote)
+		hold := o.Price*o.Amount + fee
+		if hold > available(quote) {
+			return ErrInsufficientBalance
+		}
+		quote.Hold += hold
+		o.hold = hold
+		return nil
+	}
+
+	base := e.balance(o.CurrencyPair.Base)
+	if o.Amount > available(base) {
+		return ErrInsufficientBalance
+	}
+	base.Hold += o.Amount
+	o.hold = o.Amount
+	return nil
+}
+
+// fill executes an amount of an order at a price, charging the exchange fee in
+// the quote currency and releasing the matching share of its reserved funds
+func (e *Exchange) fill(o *paperOrder, price, amount float64, isMaker bool) error {
+	fee, err := e.fee(o, price, amount, isMaker)
+	if err != nil {
+		return err
+	}
+
+	release := o.hold * amount / o.RemainingAmount
+	base := e.balance(o.CurrencyPair.Base)
+	quote := e.balance(o.CurrencyPair.Quote)
+	value := price * amount
+	if isBuy(o.OrderSide) {
+		if value+fee > available(quote)+release {
+			return ErrInsufficientBalance
+		}
+		quote.Hold -= release
+		quote.TotalValue -= value + fee
+		base.TotalValue += amount
+	} else {
+		if amount > available(base)+release {
+			return ErrInsufficientBalance
+		}
+		base.Hold -= release
+		base.TotalValue -= amount
+		quote.TotalValue += value - fee
+	}
+	o.hold -= release
+
+	e.trades++
+	o.Trades = append(o.Trades, order.TradeHistory{
+		Timestamp: time.Now(),
+		TID:       strconv.FormatInt(e.trades, 10),
+		Price:     price,
+		Amount:    amount,
+		Exchange:  o.Exchange,
+		Type:      o.OrderType,
+		Side:      o.OrderSide,
+		Fee:       fee,
+	})
+	o.Fee += fee
+	o.ExecutedAmount += amount
+	o.RemainingAmount -= amount
+	if o.RemainingAmount <= 0 {
+		o.RemainingAmount = 0
+		o.Status = order.Filled
+	} else {
+		o.Status = order.PartiallyFilled
+	}
+	return nil
+}
+
+// cancel closes an order and releases its reserved funds
+func (e *Exchange) cancel(o *paperOrder) {
+	if isBuy(o.OrderSide) {
+		e.balance(o.CurrencyPair.Quote).Hold -= o.hold
+	} else {
+		e.balance(o.CurrencyPair.Base).Hold -= o.hold
+	}
+	o.hold = 0
+	if o.ExecutedAmount > 0 {
+		o.Status = order.PartiallyCancelled
+	} else {
+		o.Status = order.Cancelled
+	}
+}
+
+// fee returns the exchange trading fee for an amount of an order at a price
+func (e *Exchange) fee(o *paperOrder, price, amount float64, isMaker bool) (float64, error) {
+	return e.GetFeeByType(&exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          o.CurrencyPair,
+		AssetType:     o.AssetType,
+		IsMaker:       isMaker,
+		PurchasePrice: price,
+		Amount:        amount,
+	})
+}
+
+// balance returns the balance of a currency, creating an empty one if it does
+// not exist
+func (e *Exchange) balance(c currency.Code) *account.Balance {
+	key := c.Upper().String()
+	b, ok := e.balances[key]
+	if !ok {
+		b = &account.Balance{CurrencyName: c.Upper()}
+		e.balances[key] = b
+	}
+	return b
+}
+
+// copy returns a copy of the order detail which is safe to use outside of the
+// lock
+func (o *paperOrder) copy() order.Detail {
+	d := o.Detail
+	d.Trades = append([]order.TradeHistory(nil), o.Trades...)
+	return d
+}
+
+// match walks the opposing side of the orderbook filling up to amount at
+// levels priced no worse than the limit, a zero limit accepts any price. It
+// returns the volume weighted price and the amount filled
+func match(ob *orderbook.Base, buy bool, amount, limit float64) (price, filled float64) {
+	levels := ob.Bids
+	if buy {
+		levels = ob.Asks
+	}
+
+	var value float64
+	for x := range levels {
+		if limit > 0 && ((buy && levels[x].Price > limit) ||
+			(!buy && levels[x].Price < limit)) {
+			break
+		}
+		if levels[x].Amount >= amount-filled {
+			value += (amount - filled) * levels[x].Price
+			filled = amount
+			break
+		}
+		value += levels[x].Amount * levels[x].Price
+		filled += levels[x].Amount
+	}
+	if filled == 0 {
+		return 0, 0
+	}
+	return value / filled, filled
+}
+
+// averagePrice returns the volume weighted price of an order's trades
+func averagePrice(trades []order.TradeHistory) float64 {
+	var value, amount float64
+	for x := range trades {
+		value += trades[x].Price * trades[x].Amount
+		amount += trades[x].Amount
+	}
+	if amount == 0 {
+		return 0
+	}
+	return value / amount
+}
+
+func available(b *account.Balance) float64 {
+	return b.TotalValue - b.Hold
+}
+
+func isBuy(s order.Side) bool {
+	return s == order.Buy || s == order.Bid
+}
+
+func isOpen(s order.Status) bool {
+	return s == order.New || s == order.Active || s == order.PartiallyFilled
+}
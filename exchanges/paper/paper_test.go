@@ -0,0 +1,360 @@
+package paper
+
+import (
+	"log"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const testExchange = "papertest"
+
+var testPair = currency.NewPair(currency.BTC, currency.USD)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+// fakeExchange serves the orderbook and fees required by the paper trading
+// layer, calling any other method will panic
+type fakeExchange struct {
+	exchange.IBotExchange
+	feeAsset asset.Item
+}
+
+func (f *fakeExchange) GetName() string {
+	return testExchange
+}
+
+func (f *fakeExchange) FetchOrderbook(p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+	return orderbook.Get(testExchange, p, a)
+}
+
+func (f *fakeExchange) GetFeeByType(b *exchange.FeeBuilder) (float64, error) {
+	f.feeAsset = b.AssetType
+	rate := 0.002
+	if b.IsMaker {
+		rate = 0.001
+	}
+	return rate * b.PurchasePrice * b.Amount, nil
+}
+
+func processOrderbook(t *testing.T, updated time.Time, bids, asks []orderbook.Item) {
+	t.Helper()
+	ob := orderbook.Base{
+		ExchangeName: testExchange,
+		Pair:         testPair,
+		AssetType:    asset.Spot,
+		Bids:         bids,
+		Asks:         asks,
+		LastUpdated:  updated,
+	}
+	err := ob.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func checkBalance(t *testing.T, e *Exchange, c currency.Code, total, hold float64) {
+	t.Helper()
+	b := e.balance(c)
+	if math.Abs(b.TotalValue-total) > 1e-9 || math.Abs(b.Hold-hold) > 1e-9 {
+		t.Errorf("expected %s balance %v hold %v, received %v hold %v",
+			c, total, hold, b.TotalValue, b.Hold)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+	ob := &orderbook.Base{
+		Bids: []orderbook.Item{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		Asks: []orderbook.Item{{Price: 100, Amount: 1}, {Price: 102, Amount: 1}},
+	}
+	if price, amount := match(ob, true, 1.5, 0); price != 151/1.5 || amount != 1.5 {
+		t.Errorf("unexpected market buy fill %v @ %v", amount, price)
+	}
+	if price, amount := match(ob, true, 1.5, 101); price != 100 || amount != 1 {
+		t.Errorf("unexpected limit buy fill %v @ %v", amount, price)
+	}
+	if _, amount := match(ob, true, 1, 99); amount != 0 {
+		t.Errorf("expected no fill below the best ask, received %v", amount)
+	}
+	if price, amount := match(ob, false, 5, 0); price != 295.0/3 || amount != 3 {
+		t.Errorf("unexpected market sell fill %v @ %v", amount, price)
+	}
+}
+
+func TestSubmitMarketOrder(t *testing.T) {
+	e := New(&fakeExchange{}, []config.PaperTradingBalance{
+		{Currency: currency.USD, Amount: 1000},
+	})
+	processOrderbook(t, time.Now(),
+		[]orderbook.Item{{Price: 99, Amount: 1}},
+		[]orderbook.Item{{Price: 100, Amount: 1}, {Price: 102, Amount: 1}})
+
+	resp, err := e.SubmitOrder(&order.Submit{
+		Pair:      testPair,
+		OrderSide: order.Buy,
+		OrderType: order.Market,
+		Amount:    1.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.IsOrderPlaced || !resp.FullyMatched {
+		t.Errorf("expected order to be filled, received %+v", resp)
+	}
+	checkBalance(t, e, currency.USD, 1000-151-0.302, 0)
+	checkBalance(t, e, currency.BTC, 1.5, 0)
+
+	d, err := e.GetOrderInfo(resp.OrderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Status != order.Filled || d.Price != 151/1.5 || len(d.Trades) != 1 {
+		t.Errorf("unexpected order %+v", d)
+	}
+
+	_, err = e.SubmitOrder(&order.Submit{
+		Pair:      testPair,
+		OrderSide: order.Sell,
+		OrderType: order.Market,
+		Amount:    2,
+	})
+	if err != ErrInsufficientBalance {
+		t.Errorf("expected %v, received %v", ErrInsufficientBalance, err)
+	}
+
+	resp, err = e.SubmitOrder(&order.Submit{
+		Pair:      testPair,
+		OrderSide: order.Sell,
+		OrderType: order.Market,
+		Amount:    1.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FullyMatched {
+		t.Error("expected order to be partially filled by the orderbook")
+	}
+	d, _ = e.GetOrderInfo(resp.OrderID)
+	if d.Status != order.PartiallyCancelled || d.ExecutedAmount != 1 {
+		t.Errorf("unexpected order %+v", d)
+	}
+	checkBalance(t, e, currency.BTC, 0.5, 0)
+	checkBalance(t, e, currency.USD, 1000-151-0.302+99-0.198, 0)
+
+	h, err := e.UpdateAccountInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Accounts) != 1 || len(h.Accounts[0].Currencies) != 2 {
+		t.Errorf("unexpected holdings %+v", h)
+	}
+}
+
+func TestSubmitAssetType(t *testing.T) {
+	f := &fakeExchange{}
+	e := New(f, []config.PaperTradingBalance{
+		{Currency: currency.USD, Amount: 1000},
+	})
+	processOrderbook(t, time.Now(),
+		[]orderbook.Item{{Price: 99, Amount: 1}},
+		[]orderbook.Item{{Price: 100, Amount: 1}})
+	futures := orderbook.Base{
+		ExchangeName: testExchange,
+		Pair:         testPair,
+		AssetType:    asset.Futures,
+		Bids:         []orderbook.Item{{Price: 199, Amount: 1}},
+		Asks:         []orderbook.Item{{Price: 200, Amount: 1}},
+		LastUpdated:  time.Now(),
+	}
+	if err := futures.Process(); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := e.SubmitOrder(&order.Submit{
+		Pair:      testPair,
+		AssetType: asset.Futures,
+		OrderSide: order.Buy,
+		OrderType: order.Market,
+		Amount:    1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := e.GetOrderInfo(resp.OrderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.AssetType != asset.Futures || d.Price != 200 {
+		t.Errorf("expected the order to fill against the futures orderbook %+v", d)
+	}
+	if f.feeAsset != asset.Futures {
+		t.Errorf("expected the futures fee to be used, received %v", f.feeAsset)
+	}
+
+	resp, err = e.SubmitOrder(&order.Submit{
+		Pair:      testPair,
+		OrderSide: order.Sell,
+		OrderType: order.Market,
+		Amount:    1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, _ = e.GetOrderInfo(resp.OrderID)
+	if d.AssetType != asset.Spot || d.Price != 99 {
+		t.Errorf("expected an order without an asset type to use spot %+v", d)
+	}
+}
+
+func TestLimitOrderLifecycle(t *testing.T) {
+	e := New(&fakeExchange{}, []config.PaperTradingBalance{
+		{Currency: currency.USD, Amount: 1000},
+	})
+	processOrderbook(t, time.Now().Add(-time.Minute),
+		[]orderbook.Item{{Price: 99, Amount: 1}},
+		[]orderbook.Item{{Price: 100, Amount: 1}})
+
+	_, err := e.SubmitOrder(&order.Submit{
+		Pair:      testPair,
+		OrderSide: order.Buy,
+		OrderType: order.Limit,
+		Price:     1000,
+		Amount:    1,
+	})
+	if err != ErrInsufficientBalance {
+		t.Errorf("expected %v, received %v", ErrInsufficientBalance, err)
+	}
+
+	resp, err := e.SubmitOrder(&order.Submit{
+		Pair:      testPair,
+		OrderSide: order.Buy,
+		OrderType: order.Limit,
+		Price:     95,
+		Amount:    1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.FullyMatched {
+		t.Error("expected order to rest on the book")
+	}
+	checkBalance(t, e, currency.USD, 1000, 95.19)
+
+	active, err := e.GetActiveOrders(&order.GetOrdersRequest{Currencies: []currency.Pair{testPair}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 1 || active[0].Status != order.Active {
+		t.Fatalf("unexpected active orders %+v", active)
+	}
+
+	processOrderbook(t, time.Now(),
+		[]orderbook.Item{{Price: 94, Amount: 1}},
+		[]orderbook.Item{{Price: 94.5, Amount: 0.4}, {Price: 96, Amount: 1}})
+	d, err := e.GetOrderInfo(resp.OrderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Status != order.PartiallyFilled || d.ExecutedAmount != 0.4 || d.RemainingAmount != 0.6 {
+		t.Errorf("unexpected order %+v", d)
+	}
+	checkBalance(t, e, currency.USD, 1000-38-0.038, 95.19*0.6)
+	checkBalance(t, e, currency.BTC, 0.4, 0)
+
+	// the same orderbook update must not fill the order twice
+	d, _ = e.GetOrderInfo(resp.OrderID)
+	if d.ExecutedAmount != 0.4 {
+		t.Errorf("expected order to be matched once per update, executed %v", d.ExecutedAmount)
+	}
+
+	err = e.CancelOrder(&order.Cancel{OrderID: resp.OrderID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = e.CancelOrder(&order.Cancel{OrderID: resp.OrderID}); err != ErrOrderClosed {
+		t.Errorf("expected %v, received %v", ErrOrderClosed, err)
+	}
+	if err = e.CancelOrder(&order.Cancel{OrderID: "meow"}); err != ErrOrderNotFound {
+		t.Errorf("expected %v, received %v", ErrOrderNotFound, err)
+	}
+	checkBalance(t, e, currency.USD, 1000-38-0.038, 0)
+
+	history, err := e.GetOrderHistory(&order.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Status != order.PartiallyCancelled {
+		t.Errorf("unexpected order history %+v", history)
+	}
+	if _, err = e.ModifyOrder(&order.Modify{}); err != ErrNotSupported {
+		t.Errorf("expected %v, received %v", ErrNotSupported, err)
+	}
+}
+
+func TestBatchOrders(t *testing.T) {
+	e := New(&fakeExchange{}, []config.PaperTradingBalance{
+		{Currency: currency.USD, Amount: 1000},
+	})
+	processOrderbook(t, time.Now().Add(-time.Minute),
+		[]orderbook.Item{{Price: 99, Amount: 1}},
+		[]orderbook.Item{{Price: 100, Amount: 1}})
+
+	resp, err := e.SubmitOrders([]order.Submit{
+		{Pair: testPair, OrderSide: order.Buy, OrderType: order.Limit, Price: 95, Amount: 1},
+		{Pair: testPair, OrderSide: order.Buy, OrderType: order.Limit, Price: 96, Amount: 1},
+		{Pair: testPair, OrderSide: order.Buy, OrderType: order.Limit, Price: 1000, Amount: 1},
+	})
+	if err != ErrInsufficientBalance {
+		t.Errorf("expected %v, received %v", ErrInsufficientBalance, err)
+	}
+	if len(resp) != 2 || !resp[0].IsOrderPlaced || !resp[1].IsOrderPlaced {
+		t.Fatalf("expected the orders before the failure to be placed, received %+v", resp)
+	}
+
+	cancelled, err := e.CancelOrders([]order.Cancel{
+		{OrderID: resp[0].OrderID},
+		{OrderID: resp[1].OrderID},
+		{OrderID: "meow"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cancelled.Status) != 1 || cancelled.Status["meow"] == "" {
+		t.Errorf("expected only the unknown order to fail, received %v", cancelled.Status)
+	}
+	checkBalance(t, e, currency.USD, 1000, 0)
+
+	resp, err = e.SubmitOrders([]order.Submit{
+		{Pair: testPair, OrderSide: order.Buy, OrderType: order.Limit, Price: 95, Amount: 1},
+		{Pair: testPair, OrderSide: order.Buy, OrderType: order.Limit, Price: 96, Amount: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancelled, err = e.CancelAllOrders(&order.Cancel{CurrencyPair: testPair})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cancelled.Status) != 2 ||
+		cancelled.Status[resp[0].OrderID] != "cancelled" ||
+		cancelled.Status[resp[1].OrderID] != "cancelled" {
+		t.Errorf("expected both orders to be cancelled, received %v", cancelled.Status)
+	}
+	checkBalance(t, e, currency.USD, 1000, 0)
+}
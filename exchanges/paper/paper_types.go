@@ -0,0 +1,42 @@
+package paper
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// AccountID is the sub account ID paper trading balances are reported under
+const AccountID = "paper"
+
+// Paper trading errors
+var (
+	ErrInsufficientBalance = errors.New("insufficient paper trading balance")
+	ErrNoLiquidity         = errors.New("no orderbook liquidity available to fill order")
+	ErrOrderNotFound       = errors.New("paper trading order not found")
+	ErrOrderClosed         = errors.New("paper trading order is no longer open")
+	ErrNotSupported        = errors.New("not supported in paper trading mode")
+)
+
+// Exchange wraps an exchange so that orders and account balances are
+// simulated against the live orderbooks instead of touching real funds. All
+// other functionality is served by the wrapped exchange
+type Exchange struct {
+	exchange.IBotExchange
+	m        sync.Mutex
+	balances map[string]*account.Balance
+	orders   map[string]*paperOrder
+	trades   int64
+}
+
+// paperOrder is a simulated order with the funds reserved for its unfilled
+// amount and the orderbook update it was last matched against
+type paperOrder struct {
+	order.Detail
+	hold        float64
+	lastMatched time.Time
+}
@@ -10,7 +10,6 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -60,11 +59,6 @@ type Poloniex struct {
 	WebsocketConn *wshandler.WebsocketConnection
 }
 
-// GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (p *Poloniex) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
-	return nil, common.ErrNotYetImplemented
-}
-
 // GetTicker returns current ticker information
 func (p *Poloniex) GetTicker() (map[string]Ticker, error) {
 	type response struct {
@@ -692,23 +686,24 @@ func (p *Poloniex) CancelLoanOffer(orderNumber int64) (bool, error) {
 	return true, nil
 }
 
-// GetOpenLoanOffers returns all open loan offers
+// GetOpenLoanOffers returns all open loan offers by currency
 func (p *Poloniex) GetOpenLoanOffers() (map[string][]LoanOffer, error) {
-	type Response struct {
-		Data map[string][]LoanOffer
-	}
-	result := Response{}
+	var result json.RawMessage
 
-	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexOpenLoanOffers, url.Values{}, &result.Data)
+	err := p.SendAuthenticatedHTTPRequest(http.MethodPost, poloniexOpenLoanOffers, url.Values{}, &result)
 	if err != nil {
 		return nil, err
 	}
 
-	if result.Data == nil {
-		return nil, errors.New("there are no open loan offers")
+	// an empty array is returned when there are no open loan offers
+	var nodata []interface{}
+	err = json.Unmarshal(result, &nodata)
+	if err == nil {
+		return map[string][]LoanOffer{}, nil
 	}
 
-	return result.Data, nil
+	var offers map[string][]LoanOffer
+	return offers, json.Unmarshal(result, &offers)
 }
 
 // GetActiveLoans returns active loans
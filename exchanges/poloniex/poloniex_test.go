@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -465,3 +466,38 @@ func TestWsAuth(t *testing.T) {
 	}
 	timer.Stop()
 }
+
+func TestGetLendBook(t *testing.T) {
+	t.Parallel()
+	book, err := p.GetLendBook(currency.BTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if book.Exchange != p.Name || !book.Currency.Match(currency.BTC) {
+		t.Errorf("unexpected lend book %+v", book)
+	}
+}
+
+func TestSubmitFundingOffer(t *testing.T) {
+	t.Parallel()
+	_, err := p.SubmitFundingOffer(&lending.OfferRequest{
+		Currency: currency.BTC,
+		Side:     lending.Borrow,
+		Amount:   1,
+		Rate:     0.0002,
+		Period:   2,
+	})
+	if err == nil {
+		t.Error("expected an error submitting a borrow offer")
+	}
+}
+
+func TestParseLoanDate(t *testing.T) {
+	t.Parallel()
+	if d := parseLoanDate("2015-05-10 23:33:50"); d.Unix() != 1431300830 {
+		t.Errorf("unexpected date %v", d)
+	}
+	if d := parseLoanDate("meow"); !d.IsZero() {
+		t.Errorf("expected a zero date, received %v", d)
+	}
+}
@@ -259,6 +259,7 @@ type MarginPosition struct {
 // LoanOffer holds loan offer information
 type LoanOffer struct {
 	ID        int64   `json:"id"`
+	Currency  string  `json:"currency"`
 	Rate      float64 `json:"rate,string"`
 	Amount    float64 `json:"amount,string"`
 	Duration  int     `json:"duration"`
@@ -208,6 +208,9 @@ func (p *Poloniex) WsHandleData() {
 								p.Websocket.DataHandler <- wshandler.TradeData{
 									Timestamp:    time.Unix(trade.Timestamp, 0),
 									CurrencyPair: currency.NewPairFromString(currencyPair),
+									AssetType:    asset.Spot,
+									Exchange:     p.Name,
+									TID:          strconv.FormatInt(trade.TradeID, 10),
 									Side:         trade.Side,
 									Amount:       trade.Volume,
 									Price:        trade.Price,
@@ -1,6 +1,7 @@
 package poloniex
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -13,8 +14,12 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/lending"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -368,9 +373,29 @@ func (p *Poloniex) GetFundingHistory() ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
-// GetExchangeHistory returns historic trade data since exchange opening.
+// GetExchangeHistory returns the most recent public trades for a currency pair
 func (p *Poloniex) GetExchangeHistory(currencyPair currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
-	return nil, common.ErrNotYetImplemented
+	trades, err := p.GetTradeHistory(p.FormatExchangeCurrency(currencyPair, assetType).String(), "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]exchange.TradeHistory, len(trades))
+	for i := range trades {
+		tradeTime, err := time.Parse(poloniexDateLayout, trades[i].Date)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = exchange.TradeHistory{
+			Timestamp: tradeTime,
+			TID:       trades[i].GlobalTradeID,
+			Price:     trades[i].Rate,
+			Amount:    trades[i].Amount,
+			Exchange:  p.Name,
+			Type:      trades[i].Type,
+		}
+	}
+	return resp, nil
 }
 
 // SubmitOrder submits a new order
@@ -623,9 +648,236 @@ func (p *Poloniex) AuthenticateWebsocket() error {
 	return common.ErrFunctionNotSupported
 }
 
+// GetHistoricCandles returns candles between a time period for a set time
+// interval, Poloniex only serves 5m, 15m, 4h and 1d candles
+func (p *Poloniex) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	switch interval {
+	case kline.FiveMin, kline.FifteenMin, kline.FourHour, kline.OneDay:
+	default:
+		return kline.Item{}, fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, interval)
+	}
+
+	candles, err := p.GetChartData(p.FormatExchangeCurrency(pair, a).String(),
+		strconv.FormatInt(start.Unix(), 10),
+		strconv.FormatInt(end.Unix(), 10),
+		strconv.FormatInt(int64(interval.Duration().Seconds()), 10))
+	if err != nil {
+		return kline.Item{}, err
+	}
+	ret := kline.Item{
+		Exchange: p.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	for x := range candles {
+		if candles[x].Error != "" {
+			return kline.Item{}, errors.New(candles[x].Error)
+		}
+		// a single empty candle is returned when there are no candles
+		if candles[x].Date == 0 {
+			continue
+		}
+		ret.Candles = append(ret.Candles, kline.Candle{
+			Time:   time.Unix(int64(candles[x].Date), 0),
+			Open:   candles[x].Open,
+			High:   candles[x].High,
+			Low:    candles[x].Low,
+			Close:  candles[x].Close,
+			Volume: candles[x].Volume,
+		})
+	}
+	ret.FilterByTimeRange(start, end)
+	return ret, nil
+}
+
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
 func (p *Poloniex) ValidateCredentials() error {
 	_, err := p.UpdateAccountInfo()
 	return p.CheckTransientError(err)
 }
+
+// GetPositions returns the open derivatives positions of the asset type
+func (p *Poloniex) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (p *Poloniex) SetLeverage(currencyPair currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (p *Poloniex) SetMarginMode(currencyPair currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (p *Poloniex) GetFundingRates(currencyPair currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (p *Poloniex) ClosePosition(currencyPair currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (p *Poloniex) UpdateDerivativesStats(currencyPair currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetLendBook returns the loan offers and demands of a currency
+func (p *Poloniex) GetLendBook(c currency.Code) (lending.Book, error) {
+	resp, err := p.GetLoanOrders(c.Upper().String())
+	if err != nil {
+		return lending.Book{}, err
+	}
+
+	book := lending.Book{
+		Exchange: p.Name,
+		Currency: c,
+	}
+	for x := range resp.Offers {
+		book.Offers = append(book.Offers, lending.BookItem{
+			Amount:    resp.Offers[x].Amount,
+			Rate:      resp.Offers[x].Rate,
+			MinPeriod: resp.Offers[x].RangeMin,
+			MaxPeriod: resp.Offers[x].RangeMax,
+		})
+	}
+	for x := range resp.Demands {
+		book.Demands = append(book.Demands, lending.BookItem{
+			Amount:    resp.Demands[x].Amount,
+			Rate:      resp.Demands[x].Rate,
+			MinPeriod: resp.Demands[x].RangeMin,
+			MaxPeriod: resp.Demands[x].RangeMax,
+		})
+	}
+	return book, nil
+}
+
+// GetFundingOffers returns the account's open loan offers
+func (p *Poloniex) GetFundingOffers() ([]lending.Offer, error) {
+	resp, err := p.GetOpenLoanOffers()
+	if err != nil {
+		return nil, err
+	}
+
+	var offers []lending.Offer
+	for c, loanOffers := range resp {
+		for x := range loanOffers {
+			offers = append(offers, lending.Offer{
+				ID:              strconv.FormatInt(loanOffers[x].ID, 10),
+				Exchange:        p.Name,
+				Currency:        currency.NewCode(c),
+				Side:            lending.Lend,
+				Amount:          loanOffers[x].Amount,
+				RemainingAmount: loanOffers[x].Amount,
+				Rate:            loanOffers[x].Rate,
+				Period:          loanOffers[x].Duration,
+				AutoRenew:       loanOffers[x].AutoRenew,
+				Date:            parseLoanDate(loanOffers[x].Date),
+			})
+		}
+	}
+	return offers, nil
+}
+
+// SubmitFundingOffer submits a loan offer, only Lend offers are supported
+func (p *Poloniex) SubmitFundingOffer(o *lending.OfferRequest) (string, error) {
+	if err := o.Validate(); err != nil {
+		return "", err
+	}
+	if o.Side != lending.Lend {
+		return "", fmt.Errorf("%v: %s", lending.ErrUnsupportedSide, o.Side)
+	}
+
+	id, err := p.CreateLoanOffer(o.Currency.Upper().String(),
+		o.Amount,
+		o.Rate,
+		o.Period,
+		o.AutoRenew)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// CancelFundingOffer cancels an open loan offer
+func (p *Poloniex) CancelFundingOffer(id string) error {
+	orderNumber, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = p.CancelLoanOffer(orderNumber)
+	return err
+}
+
+// GetLoans returns the account's funds lent out and the loans used by its
+// margin positions
+func (p *Poloniex) GetLoans() ([]lending.Loan, error) {
+	resp, err := p.GetActiveLoans()
+	if err != nil {
+		return nil, err
+	}
+
+	loans := make([]lending.Loan, 0, len(resp.Provided)+len(resp.Used))
+	convert := func(l *LoanOffer, side lending.Side) lending.Loan {
+		return lending.Loan{
+			ID:       strconv.FormatInt(l.ID, 10),
+			Exchange: p.Name,
+			Currency: currency.NewCode(l.Currency),
+			Side:     side,
+			Amount:   l.Amount,
+			Rate:     l.Rate,
+			Period:   l.Duration,
+			Date:     parseLoanDate(l.Date),
+		}
+	}
+	for x := range resp.Provided {
+		loans = append(loans, convert(&resp.Provided[x], lending.Lend))
+	}
+	for x := range resp.Used {
+		loans = append(loans, convert(&resp.Used[x], lending.Borrow))
+	}
+	return loans, nil
+}
+
+// Borrow is not supported, margin loans are taken automatically when margin
+// orders are placed
+func (p *Poloniex) Borrow(_ *lending.BorrowRequest) (string, error) {
+	return "", common.ErrFunctionNotSupported
+}
+
+// Repay is not supported, margin loans are repaid when margin positions are
+// closed
+func (p *Poloniex) Repay(_ *lending.RepayRequest) error {
+	return common.ErrFunctionNotSupported
+}
+
+// parseLoanDate converts a loan date, a zero time is returned if it cannot be
+// parsed
+func parseLoanDate(date string) time.Time {
+	t, err := time.Parse(poloniexDateLayout, date)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// SubmitOrders submits a batch of orders
+func (p *Poloniex) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (p *Poloniex) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
@@ -0,0 +1,93 @@
+package position
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// String implements the stringer interface
+func (s Side) String() string {
+	return string(s)
+}
+
+// Opposite returns the side which closes a position of side s
+func (s Side) Opposite() Side {
+	switch s {
+	case Long:
+		return Short
+	case Short:
+		return Long
+	default:
+		return SideUnknown
+	}
+}
+
+// String implements the stringer interface
+func (m MarginMode) String() string {
+	return string(m)
+}
+
+// StringToSide converts a case insensitive position side to a Side
+func StringToSide(side string) (Side, error) {
+	switch {
+	case strings.EqualFold(side, Long.String()):
+		return Long, nil
+	case strings.EqualFold(side, Short.String()):
+		return Short, nil
+	default:
+		return SideUnknown, fmt.Errorf("%s: %s", ErrUnknownSide, side)
+	}
+}
+
+// StringToMarginMode converts a case insensitive margin mode to a MarginMode
+func StringToMarginMode(mode string) (MarginMode, error) {
+	switch {
+	case strings.EqualFold(mode, Cross.String()),
+		strings.EqualFold(mode, "crossed"):
+		return Cross, nil
+	case strings.EqualFold(mode, Isolated.String()),
+		strings.EqualFold(mode, "fixed"):
+		return Isolated, nil
+	default:
+		return MarginModeUnknown, fmt.Errorf("%s: %s", ErrUnknownMarginMode, mode)
+	}
+}
+
+// Filter returns the positions matching the side, an empty side matches every
+// position
+func Filter(positions []Position, side Side) []Position {
+	if side == "" {
+		return positions
+	}
+	var resp []Position
+	for x := range positions {
+		if positions[x].Side == side {
+			resp = append(resp, positions[x])
+		}
+	}
+	return resp
+}
+
+// Find returns the position of the currency pair and asset type matching the
+// side, an empty side matches the pair's only open position
+func Find(positions []Position, p currency.Pair, assetType asset.Item, side Side) (*Position, error) {
+	var found *Position
+	for x := range positions {
+		if positions[x].AssetType != assetType ||
+			!positions[x].Pair.Equal(p) ||
+			(side != "" && positions[x].Side != side) {
+			continue
+		}
+		if found != nil {
+			return nil, ErrPositionSideNotSet
+		}
+		found = &positions[x]
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%v: %s %s %s", ErrPositionNotFound, p, assetType, side)
+	}
+	return found, nil
+}
@@ -0,0 +1,86 @@
+package position
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestStringToSide(t *testing.T) {
+	t.Parallel()
+	s, err := StringToSide("long")
+	if err != nil || s != Long {
+		t.Errorf("expected %s, received %s %v", Long, s, err)
+	}
+	s, err = StringToSide("SHORT")
+	if err != nil || s != Short {
+		t.Errorf("expected %s, received %s %v", Short, s, err)
+	}
+	if _, err = StringToSide("buy"); err == nil {
+		t.Error("expected an error for an unknown side")
+	}
+	if Long.Opposite() != Short || Short.Opposite() != Long ||
+		SideUnknown.Opposite() != SideUnknown {
+		t.Error("unexpected opposite side")
+	}
+}
+
+func TestStringToMarginMode(t *testing.T) {
+	t.Parallel()
+	tester := map[string]MarginMode{
+		"cross":    Cross,
+		"crossed":  Cross,
+		"ISOLATED": Isolated,
+		"fixed":    Isolated,
+	}
+	for k, v := range tester {
+		m, err := StringToMarginMode(k)
+		if err != nil || m != v {
+			t.Errorf("%s expected %s, received %s %v", k, v, m, err)
+		}
+	}
+	if _, err := StringToMarginMode("portfolio"); err == nil {
+		t.Error("expected an error for an unknown margin mode")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+	positions := []Position{{Side: Long}, {Side: Short}, {Side: Long}}
+	if len(Filter(positions, "")) != 3 {
+		t.Error("expected every position without a side")
+	}
+	if len(Filter(positions, Long)) != 2 || len(Filter(positions, Short)) != 1 {
+		t.Error("unexpected filtered positions")
+	}
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+	btc := currency.NewPairWithDelimiter("BTC-USD", "SWAP", "_")
+	eth := currency.NewPairWithDelimiter("ETH-USD", "SWAP", "_")
+	positions := []Position{
+		{Pair: btc, AssetType: asset.PerpetualSwap, Side: Long, Amount: 1},
+		{Pair: btc, AssetType: asset.PerpetualSwap, Side: Short, Amount: 2},
+		{Pair: eth, AssetType: asset.PerpetualSwap, Side: Short, Amount: 3},
+		{Pair: eth, AssetType: asset.Futures, Side: Long, Amount: 4},
+	}
+
+	p, err := Find(positions, btc, asset.PerpetualSwap, Short)
+	if err != nil || p.Amount != 2 {
+		t.Errorf("expected the short position, received %+v %v", p, err)
+	}
+	// the pair's delimiter does not need to match
+	p, err = Find(positions, currency.NewPairWithDelimiter("ETH-USD", "SWAP", "-"),
+		asset.PerpetualSwap, "")
+	if err != nil || p.Amount != 3 {
+		t.Errorf("expected the only open position, received %+v %v", p, err)
+	}
+	if _, err = Find(positions, btc, asset.PerpetualSwap, ""); err != ErrPositionSideNotSet {
+		t.Errorf("expected %v, received %v", ErrPositionSideNotSet, err)
+	}
+	if _, err = Find(positions, btc, asset.Futures, Long); err == nil {
+		t.Error("expected an error when no position matches")
+	}
+}
@@ -0,0 +1,68 @@
+package position
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// vars for the position package
+var (
+	ErrPositionNotFound   = errors.New("position not found")
+	ErrInvalidLeverage    = errors.New("invalid leverage")
+	ErrUnsupportedAsset   = errors.New("asset type does not support positions")
+	ErrUnknownSide        = errors.New("position side not recognised")
+	ErrUnknownMarginMode  = errors.New("margin mode not recognised")
+	ErrPositionSideNotSet = errors.New("position side must be set when both sides of a pair are open")
+)
+
+// Side enforces a standard for position sides across the code base
+type Side string
+
+// Side types
+const (
+	Long        Side = "LONG"
+	Short       Side = "SHORT"
+	SideUnknown Side = "SIDEUNKNOWN"
+)
+
+// MarginMode defines whether the margin of a position is shared with the
+// rest of the account or isolated to the position
+type MarginMode string
+
+// MarginMode types
+const (
+	Cross             MarginMode = "CROSS"
+	Isolated          MarginMode = "ISOLATED"
+	MarginModeUnknown MarginMode = "MARGINMODEUNKNOWN"
+)
+
+// Position holds an open derivatives position
+type Position struct {
+	Exchange         string
+	AssetType        asset.Item
+	Pair             currency.Pair
+	Side             Side
+	Amount           float64
+	AvailableAmount  float64
+	EntryPrice       float64
+	MarkPrice        float64
+	LiquidationPrice float64
+	Leverage         float64
+	MarginMode       MarginMode
+	Margin           float64
+	UnrealisedPNL    float64
+	RealisedPNL      float64
+	UpdatedAt        time.Time
+}
+
+// FundingRate holds a funding rate of a perpetual contract
+type FundingRate struct {
+	Exchange  string
+	AssetType asset.Item
+	Pair      currency.Pair
+	Rate      float64
+	Time      time.Time
+}
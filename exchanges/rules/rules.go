@@ -0,0 +1,163 @@
+package rules
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// stepTolerance is the fraction of a step a value can be off a multiple of
+// it and still conform, it allows for floating point error
+const stepTolerance = 1e-8
+
+// Load validates and stores trading rules, the rules of each asset type loaded
+// replace the asset type's previously stored rules
+func (s *Store) Load(r []Rules) error {
+	loaded := make(map[asset.Item]map[*currency.Item]map[*currency.Item]*Rules)
+	for x := range r {
+		if err := r[x].validate(); err != nil {
+			return err
+		}
+		bases, ok := loaded[r[x].Asset]
+		if !ok {
+			bases = make(map[*currency.Item]map[*currency.Item]*Rules)
+			loaded[r[x].Asset] = bases
+		}
+		quotes, ok := bases[r[x].Pair.Base.Item]
+		if !ok {
+			quotes = make(map[*currency.Item]*Rules)
+			bases[r[x].Pair.Base.Item] = quotes
+		}
+		cpy := r[x]
+		quotes[r[x].Pair.Quote.Item] = &cpy
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.rules == nil {
+		s.rules = make(map[asset.Item]map[*currency.Item]map[*currency.Item]*Rules)
+	}
+	for a, bases := range loaded {
+		s.rules[a] = bases
+	}
+	return nil
+}
+
+// Get returns the trading rules of a currency pair, ErrRulesNotLoaded is
+// returned when no rules are loaded for the asset type
+func (s *Store) Get(p currency.Pair, a asset.Item) (*Rules, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	bases, ok := s.rules[a]
+	if !ok {
+		return nil, ErrRulesNotLoaded
+	}
+	r, ok := bases[p.Base.Item][p.Quote.Item]
+	if !ok {
+		return nil, fmt.Errorf("%v: %s %s", ErrRulesNotFound, p, a)
+	}
+	cpy := *r
+	return &cpy, nil
+}
+
+func (r *Rules) validate() error {
+	if r.Pair.IsEmpty() {
+		return ErrPairNotSet
+	}
+	if r.Asset == "" {
+		return fmt.Errorf("%s %v", r.Pair, ErrAssetTypeNotSet)
+	}
+	if r.PriceTick < 0 || r.AmountStep < 0 || r.MinAmount < 0 ||
+		r.MaxAmount < 0 || r.MinNotional < 0 {
+		return fmt.Errorf("%s %s %v", r.Pair, r.Asset, ErrInvalidRule)
+	}
+	if r.MaxAmount > 0 && r.MaxAmount < r.MinAmount {
+		return fmt.Errorf("%s %s %v", r.Pair, r.Asset, ErrMaxBelowMinAmount)
+	}
+	return nil
+}
+
+// Conforms returns an error if the price or amount break the rules, the price
+// and notional are not checked when the price is zero as it is for market
+// orders
+func (r *Rules) Conforms(price, amount float64) error {
+	if r.MinAmount > 0 && amount < r.MinAmount {
+		return fmt.Errorf("%v: %v < %v", ErrAmountBelowMin, amount, r.MinAmount)
+	}
+	if r.MaxAmount > 0 && amount > r.MaxAmount {
+		return fmt.Errorf("%v: %v > %v", ErrAmountExceedsMax, amount, r.MaxAmount)
+	}
+	if r.AmountStep > 0 && !isMultiple(amount, r.AmountStep) {
+		return fmt.Errorf("%v: %v step %v", ErrAmountStep, amount, r.AmountStep)
+	}
+	if price <= 0 {
+		return nil
+	}
+	if r.PriceTick > 0 && !isMultiple(price, r.PriceTick) {
+		return fmt.Errorf("%v: %v tick %v", ErrPriceTick, price, r.PriceTick)
+	}
+	if r.MinNotional > 0 && price*amount < r.MinNotional {
+		return fmt.Errorf("%v: %v < %v", ErrNotionalBelowMin, price*amount, r.MinNotional)
+	}
+	return nil
+}
+
+// RoundPrice rounds the price to the price tick, buy prices are rounded down
+// and sell prices up so an order is never placed at a worse price than
+// requested
+func (r *Rules) RoundPrice(price float64, buy bool) float64 {
+	if r.PriceTick <= 0 || price <= 0 {
+		return price
+	}
+	return roundToStep(price, r.PriceTick, !buy)
+}
+
+// RoundAmount rounds the amount down to the amount step so an order never
+// exceeds the requested amount
+func (r *Rules) RoundAmount(amount float64) float64 {
+	if r.AmountStep <= 0 || amount <= 0 {
+		return amount
+	}
+	return roundToStep(amount, r.AmountStep, false)
+}
+
+// PrecisionToStep converts a number of decimal places to the smallest step
+// they can represent
+func PrecisionToStep(decimals int) float64 {
+	return math.Pow10(-decimals)
+}
+
+func isMultiple(v, step float64) bool {
+	q := v / step
+	return math.Abs(q-math.Round(q)) < stepTolerance
+}
+
+// roundToStep rounds the value up or down to a multiple of step, values which
+// are already multiples are left in place
+func roundToStep(v, step float64, up bool) float64 {
+	q := v / step
+	n := math.Round(q)
+	if math.Abs(q-n) >= stepTolerance {
+		if up {
+			n = math.Ceil(q)
+		} else {
+			n = math.Floor(q)
+		}
+	}
+	// the multiple is rounded to the step's decimal places to remove floating
+	// point error
+	p := math.Pow10(decimalPlaces(step))
+	return math.Round(n*step*p) / p
+}
+
+func decimalPlaces(step float64) int {
+	s := strconv.FormatFloat(step, 'f', -1, 64)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
@@ -0,0 +1,164 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var btcusdt = currency.NewPair(currency.BTC, currency.USDT)
+
+func testRules() Rules {
+	return Rules{
+		Pair:        btcusdt,
+		Asset:       asset.Spot,
+		PriceTick:   0.01,
+		AmountStep:  0.0001,
+		MinAmount:   0.001,
+		MaxAmount:   100,
+		MinNotional: 10,
+	}
+}
+
+func TestLoadGet(t *testing.T) {
+	t.Parallel()
+	var s Store
+	if _, err := s.Get(btcusdt, asset.Spot); err != ErrRulesNotLoaded {
+		t.Errorf("expected %v, received %v", ErrRulesNotLoaded, err)
+	}
+
+	if err := s.Load([]Rules{testRules()}); err != nil {
+		t.Fatal(err)
+	}
+	r, err := s.Get(currency.NewPairWithDelimiter("btc", "usdt", "-"), asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.PriceTick != 0.01 || r.MinNotional != 10 {
+		t.Errorf("unexpected rules %+v", r)
+	}
+	r.PriceTick = 1
+	if r, _ = s.Get(btcusdt, asset.Spot); r.PriceTick != 0.01 {
+		t.Error("expected Get to return a copy of the rules")
+	}
+	if _, err = s.Get(btcusdt, asset.Margin); err != ErrRulesNotLoaded {
+		t.Errorf("expected %v, received %v", ErrRulesNotLoaded, err)
+	}
+	if _, err = s.Get(currency.NewPair(currency.LTC, currency.USDT), asset.Spot); err == nil ||
+		err == ErrRulesNotLoaded {
+		t.Errorf("expected rules not found for a pair not loaded, received %v", err)
+	}
+
+	eth := testRules()
+	eth.Pair = currency.NewPair(currency.ETH, currency.USDT)
+	if err = s.Load([]Rules{eth}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Get(btcusdt, asset.Spot); err == nil {
+		t.Error("expected the loaded asset type rules to be replaced")
+	}
+	if _, err = s.Get(eth.Pair, asset.Spot); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLoadValidate(t *testing.T) {
+	t.Parallel()
+	var s Store
+	r := testRules()
+	r.Pair = currency.Pair{}
+	if err := s.Load([]Rules{r}); err != ErrPairNotSet {
+		t.Errorf("expected %v, received %v", ErrPairNotSet, err)
+	}
+	r = testRules()
+	r.Asset = ""
+	if err := s.Load([]Rules{r}); err == nil {
+		t.Error("expected an error without an asset type")
+	}
+	r = testRules()
+	r.PriceTick = -1
+	if err := s.Load([]Rules{r}); err == nil {
+		t.Error("expected an error for a negative rule")
+	}
+	r = testRules()
+	r.MaxAmount = 0.0001
+	if err := s.Load([]Rules{r}); err == nil {
+		t.Error("expected an error for a max amount below the min amount")
+	}
+	r.MaxAmount = 0
+	if err := s.Load([]Rules{r}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConforms(t *testing.T) {
+	t.Parallel()
+	r := testRules()
+	tests := []struct {
+		name   string
+		price  float64
+		amount float64
+		ok     bool
+	}{
+		{"valid", 10000.01, 0.0123, true},
+		{"market order", 0, 0.0123, true},
+		{"price tick", 10000.015, 0.0123, false},
+		{"amount step", 10000, 0.01234, false},
+		{"below min amount", 100000, 0.0001, false},
+		{"above max amount", 10000, 101, false},
+		{"below min notional", 1000, 0.005, false},
+		{"floating point step", 100.3, 0.3, true},
+	}
+	for x := range tests {
+		err := r.Conforms(tests[x].price, tests[x].amount)
+		if tests[x].ok && err != nil {
+			t.Errorf("%s: %v", tests[x].name, err)
+		}
+		if !tests[x].ok && err == nil {
+			t.Errorf("%s: expected an error", tests[x].name)
+		}
+	}
+
+	if err := (&Rules{}).Conforms(1.23456789, 0.000001); err != nil {
+		t.Errorf("expected empty rules to accept any order, received %v", err)
+	}
+}
+
+func TestRound(t *testing.T) {
+	t.Parallel()
+	r := testRules()
+	if p := r.RoundPrice(10000.016, true); p != 10000.01 {
+		t.Errorf("expected a buy price rounded down to 10000.01, received %v", p)
+	}
+	if p := r.RoundPrice(10000.011, false); p != 10000.02 {
+		t.Errorf("expected a sell price rounded up to 10000.02, received %v", p)
+	}
+	if p := r.RoundPrice(0.3, false); p != 0.3 {
+		t.Errorf("expected a price on the tick to be unchanged, received %v", p)
+	}
+	if a := r.RoundAmount(0.01239); a != 0.0123 {
+		t.Errorf("expected the amount rounded down to 0.0123, received %v", a)
+	}
+	if a := r.RoundAmount(0.0003); a != 0.0003 {
+		t.Errorf("expected an amount on the step to be unchanged, received %v", a)
+	}
+	if err := r.Conforms(r.RoundPrice(10000.016, true), r.RoundAmount(0.01239)); err != nil {
+		t.Errorf("expected a rounded order to conform, received %v", err)
+	}
+
+	var empty Rules
+	if p := empty.RoundPrice(1.2345, true); p != 1.2345 {
+		t.Errorf("expected the price unchanged without a tick, received %v", p)
+	}
+}
+
+func TestPrecisionToStep(t *testing.T) {
+	t.Parallel()
+	if s := PrecisionToStep(8); s != 0.00000001 {
+		t.Errorf("expected 0.00000001, received %v", s)
+	}
+	if s := PrecisionToStep(0); s != 1 {
+		t.Errorf("expected 1, received %v", s)
+	}
+}
@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// vars for the rules package
+var (
+	ErrRulesNotFound     = errors.New("trading rules not found")
+	ErrRulesNotLoaded    = errors.New("no trading rules loaded for asset type")
+	ErrPriceTick         = errors.New("price is not a multiple of the price tick")
+	ErrAmountStep        = errors.New("amount is not a multiple of the amount step")
+	ErrAmountBelowMin    = errors.New("amount is below the minimum amount")
+	ErrAmountExceedsMax  = errors.New("amount exceeds the maximum amount")
+	ErrNotionalBelowMin  = errors.New("order value is below the minimum notional")
+	ErrPairNotSet        = errors.New("trading rules currency pair not set")
+	ErrAssetTypeNotSet   = errors.New("trading rules asset type not set")
+	ErrInvalidRule       = errors.New("trading rules cannot be negative")
+	ErrMaxBelowMinAmount = errors.New("trading rules maximum amount is below the minimum amount")
+)
+
+// Rules holds the price and amount rules of a currency pair an order must
+// conform to for the exchange to accept it, rules which are zero are not
+// enforced. Amounts are in the base currency and the notional, price times
+// amount, in the quote currency
+type Rules struct {
+	Pair        currency.Pair
+	Asset       asset.Item
+	PriceTick   float64
+	AmountStep  float64
+	MinAmount   float64
+	MaxAmount   float64
+	MinNotional float64
+}
+
+// Store holds an exchange's trading rules by asset type and currency pair
+type Store struct {
+	rules map[asset.Item]map[*currency.Item]map[*currency.Item]*Rules
+	m     sync.RWMutex
+}
@@ -106,9 +106,12 @@ type TradeData struct {
 	AssetType    asset.Item
 	Exchange     string
 	EventType    string
-	Price        float64
-	Amount       float64
-	Side         string
+	// TID is the exchange trade ID, it is left empty when the exchange does
+	// not supply one
+	TID    string
+	Price  float64
+	Amount float64
+	Side   string
 }
 
 // FundingData defines funding data
@@ -14,8 +14,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/derivatives"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/position"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -689,3 +692,103 @@ func (z *ZB) ValidateCredentials() error {
 	_, err := z.UpdateAccountInfo()
 	return z.CheckTransientError(err)
 }
+
+// GetHistoricCandles returns candles between a time period for a set time interval
+func (z *ZB) GetHistoricCandles(pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := kline.ValidateRequest(start, end, interval); err != nil {
+		return kline.Item{}, err
+	}
+	var klineType TimeInterval
+	switch interval {
+	case kline.OneMin:
+		klineType = TimeIntervalMinute
+	case kline.FiveMin:
+		klineType = TimeIntervalFiveMinutes
+	case kline.FifteenMin:
+		klineType = TimeIntervalFifteenMinutes
+	case kline.OneHour:
+		klineType = TimeIntervalHour
+	case kline.FourHour:
+		klineType = TimeIntervalFourHours
+	case kline.OneDay:
+		klineType = TimeIntervalDay
+	case kline.OneWeek:
+		klineType = TimeIntervalWeek
+	default:
+		return kline.Item{}, fmt.Errorf("%s: %s", kline.ErrUnsupportedInterval, interval)
+	}
+
+	ret := kline.Item{
+		Exchange: z.Name,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	symbol := z.FormatExchangeCurrency(pair, a).String()
+	dates := kline.DateRange{Start: start, End: end}.Split(interval, zbKlineLimit)
+	for x := range dates {
+		candles, err := z.GetSpotKline(KlinesRequestParams{
+			Symbol: symbol,
+			Type:   klineType,
+			Since:  strconv.FormatInt(dates[x].Start.UnixNano()/int64(time.Millisecond), 10),
+			Size:   zbKlineLimit,
+		})
+		if err != nil {
+			return kline.Item{}, err
+		}
+		for y := range candles.Data {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   candles.Data[y].KlineTime,
+				Open:   candles.Data[y].Open,
+				High:   candles.Data[y].High,
+				Low:    candles.Data[y].Low,
+				Close:  candles.Data[y].Close,
+				Volume: candles.Data[y].Volume,
+			})
+		}
+	}
+	ret.FilterByTimeRange(start, end)
+	return ret, nil
+}
+
+// GetPositions returns the open derivatives positions of the asset type
+func (z *ZB) GetPositions(assetType asset.Item) ([]position.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage of a currency pair's derivatives positions
+func (z *ZB) SetLeverage(p currency.Pair, assetType asset.Item, leverage float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode of a currency pair's derivatives
+// positions
+func (z *ZB) SetMarginMode(p currency.Pair, assetType asset.Item, mode position.MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetFundingRates returns the funding rate history of a perpetual contract
+func (z *ZB) GetFundingRates(p currency.Pair, assetType asset.Item) ([]position.FundingRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// ClosePosition closes a derivatives position at market price
+func (z *ZB) ClosePosition(p currency.Pair, assetType asset.Item, side position.Side) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, common.ErrFunctionNotSupported
+}
+
+// UpdateDerivativesStats fetches and stores the funding, pricing and open
+// interest stats of a futures or perpetual contract
+func (z *ZB) UpdateDerivativesStats(p currency.Pair, assetType asset.Item) (*derivatives.Stats, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitOrders submits a batch of orders
+func (z *ZB) SubmitOrders(s []order.Submit) ([]order.SubmitResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrders cancels a batch of orders
+func (z *ZB) CancelOrders(c []order.Cancel) (order.CancelAllResponse, error) {
+	return order.CancelAllResponse{}, common.ErrFunctionNotSupported
+}
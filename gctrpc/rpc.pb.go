@@ -3097,6 +3097,7 @@ type SubmitOrderRequest struct {
 	Amount               float64       `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
 	Price                float64       `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
 	ClientId             string        `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	AssetType            string        `protobuf:"bytes,8,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -3176,6 +3177,13 @@ func (m *SubmitOrderRequest) GetClientId() string {
 	return ""
 }
 
+func (m *SubmitOrderRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
 type SubmitOrderResponse struct {
 	OrderPlaced          bool     `protobuf:"varint,1,opt,name=order_placed,json=orderPlaced,proto3" json:"order_placed,omitempty"`
 	OrderId              string   `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -3546,6 +3554,140 @@ func (m *CancelOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelOrderResponse proto.InternalMessageInfo
 
+type ModifyOrderRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderId              string        `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string        `protobuf:"bytes,6,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Price                float64       `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ModifyOrderRequest) Reset()         { *m = ModifyOrderRequest{} }
+func (m *ModifyOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyOrderRequest) ProtoMessage()    {}
+func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{71}
+}
+
+func (m *ModifyOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyOrderRequest.Unmarshal(m, b)
+}
+func (m *ModifyOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyOrderRequest.Merge(m, src)
+}
+func (m *ModifyOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyOrderRequest.Size(m)
+}
+func (m *ModifyOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyOrderRequest proto.InternalMessageInfo
+
+func (m *ModifyOrderRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ModifyOrderRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ModifyOrderRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *ModifyOrderRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *ModifyOrderRequest) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *ModifyOrderRequest) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *ModifyOrderRequest) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ModifyOrderRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ModifyOrderResponse struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyOrderResponse) Reset()         { *m = ModifyOrderResponse{} }
+func (m *ModifyOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyOrderResponse) ProtoMessage()    {}
+func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{72}
+}
+
+func (m *ModifyOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyOrderResponse.Unmarshal(m, b)
+}
+func (m *ModifyOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyOrderResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyOrderResponse.Merge(m, src)
+}
+func (m *ModifyOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyOrderResponse.Size(m)
+}
+func (m *ModifyOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyOrderResponse proto.InternalMessageInfo
+
+func (m *ModifyOrderResponse) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
 type CancelAllOrdersRequest struct {
 	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3557,7 +3699,7 @@ func (m *CancelAllOrdersRequest) Reset()         { *m = CancelAllOrdersRequest{}
 func (m *CancelAllOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelAllOrdersRequest) ProtoMessage()    {}
 func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{71}
+	return fileDescriptor_77a6da22d6a3feb1, []int{73}
 }
 
 func (m *CancelAllOrdersRequest) XXX_Unmarshal(b []byte) error {
@@ -3596,7 +3738,7 @@ func (m *CancelAllOrdersResponse) Reset()         { *m = CancelAllOrdersResponse
 func (m *CancelAllOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*CancelAllOrdersResponse) ProtoMessage()    {}
 func (*CancelAllOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72}
+	return fileDescriptor_77a6da22d6a3feb1, []int{74}
 }
 
 func (m *CancelAllOrdersResponse) XXX_Unmarshal(b []byte) error {
@@ -3636,7 +3778,7 @@ func (m *CancelAllOrdersResponse_Orders) Reset()         { *m = CancelAllOrdersR
 func (m *CancelAllOrdersResponse_Orders) String() string { return proto.CompactTextString(m) }
 func (*CancelAllOrdersResponse_Orders) ProtoMessage()    {}
 func (*CancelAllOrdersResponse_Orders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{72, 0}
+	return fileDescriptor_77a6da22d6a3feb1, []int{74, 0}
 }
 
 func (m *CancelAllOrdersResponse_Orders) XXX_Unmarshal(b []byte) error {
@@ -3671,4703 +3813,10575 @@ func (m *CancelAllOrdersResponse_Orders) GetOrderStatus() map[string]string {
 	return nil
 }
 
-type GetEventsRequest struct {
+type SetRiskKillSwitchRequest struct {
+	Engage               bool     `protobuf:"varint,1,opt,name=engage,proto3" json:"engage,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetEventsRequest) Reset()         { *m = GetEventsRequest{} }
-func (m *GetEventsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetEventsRequest) ProtoMessage()    {}
-func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{73}
+func (m *SetRiskKillSwitchRequest) Reset()         { *m = SetRiskKillSwitchRequest{} }
+func (m *SetRiskKillSwitchRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRiskKillSwitchRequest) ProtoMessage()    {}
+func (*SetRiskKillSwitchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{75}
 }
 
-func (m *GetEventsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetEventsRequest.Unmarshal(m, b)
+func (m *SetRiskKillSwitchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRiskKillSwitchRequest.Unmarshal(m, b)
 }
-func (m *GetEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetEventsRequest.Marshal(b, m, deterministic)
+func (m *SetRiskKillSwitchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRiskKillSwitchRequest.Marshal(b, m, deterministic)
 }
-func (m *GetEventsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetEventsRequest.Merge(m, src)
+func (m *SetRiskKillSwitchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRiskKillSwitchRequest.Merge(m, src)
 }
-func (m *GetEventsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetEventsRequest.Size(m)
+func (m *SetRiskKillSwitchRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRiskKillSwitchRequest.Size(m)
 }
-func (m *GetEventsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetEventsRequest.DiscardUnknown(m)
+func (m *SetRiskKillSwitchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRiskKillSwitchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetEventsRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetRiskKillSwitchRequest proto.InternalMessageInfo
 
-type ConditionParams struct {
-	Condition            string   `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
-	Price                float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	CheckBids            bool     `protobuf:"varint,3,opt,name=check_bids,json=checkBids,proto3" json:"check_bids,omitempty"`
-	CheckBidsAndAsks     bool     `protobuf:"varint,4,opt,name=check_bids_and_asks,json=checkBidsAndAsks,proto3" json:"check_bids_and_asks,omitempty"`
-	OrderbookAmount      float64  `protobuf:"fixed64,5,opt,name=orderbook_amount,json=orderbookAmount,proto3" json:"orderbook_amount,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SetRiskKillSwitchRequest) GetEngage() bool {
+	if m != nil {
+		return m.Engage
+	}
+	return false
 }
 
-func (m *ConditionParams) Reset()         { *m = ConditionParams{} }
-func (m *ConditionParams) String() string { return proto.CompactTextString(m) }
-func (*ConditionParams) ProtoMessage()    {}
-func (*ConditionParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{74}
+type SetRiskKillSwitchResponse struct {
+	Engaged              bool                              `protobuf:"varint,1,opt,name=engaged,proto3" json:"engaged,omitempty"`
+	Orders               []*CancelAllOrdersResponse_Orders `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func (m *ConditionParams) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ConditionParams.Unmarshal(m, b)
-}
-func (m *ConditionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ConditionParams.Marshal(b, m, deterministic)
+func (m *SetRiskKillSwitchResponse) Reset()         { *m = SetRiskKillSwitchResponse{} }
+func (m *SetRiskKillSwitchResponse) String() string { return proto.CompactTextString(m) }
+func (*SetRiskKillSwitchResponse) ProtoMessage()    {}
+func (*SetRiskKillSwitchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{76}
 }
-func (m *ConditionParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConditionParams.Merge(m, src)
+
+func (m *SetRiskKillSwitchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRiskKillSwitchResponse.Unmarshal(m, b)
 }
-func (m *ConditionParams) XXX_Size() int {
-	return xxx_messageInfo_ConditionParams.Size(m)
+func (m *SetRiskKillSwitchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRiskKillSwitchResponse.Marshal(b, m, deterministic)
 }
-func (m *ConditionParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConditionParams.DiscardUnknown(m)
+func (m *SetRiskKillSwitchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRiskKillSwitchResponse.Merge(m, src)
 }
-
-var xxx_messageInfo_ConditionParams proto.InternalMessageInfo
-
-func (m *ConditionParams) GetCondition() string {
-	if m != nil {
-		return m.Condition
-	}
-	return ""
+func (m *SetRiskKillSwitchResponse) XXX_Size() int {
+	return xxx_messageInfo_SetRiskKillSwitchResponse.Size(m)
 }
-
-func (m *ConditionParams) GetPrice() float64 {
-	if m != nil {
-		return m.Price
-	}
-	return 0
+func (m *SetRiskKillSwitchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRiskKillSwitchResponse.DiscardUnknown(m)
 }
 
-func (m *ConditionParams) GetCheckBids() bool {
-	if m != nil {
-		return m.CheckBids
-	}
-	return false
-}
+var xxx_messageInfo_SetRiskKillSwitchResponse proto.InternalMessageInfo
 
-func (m *ConditionParams) GetCheckBidsAndAsks() bool {
+func (m *SetRiskKillSwitchResponse) GetEngaged() bool {
 	if m != nil {
-		return m.CheckBidsAndAsks
+		return m.Engaged
 	}
 	return false
 }
 
-func (m *ConditionParams) GetOrderbookAmount() float64 {
+func (m *SetRiskKillSwitchResponse) GetOrders() []*CancelAllOrdersResponse_Orders {
 	if m != nil {
-		return m.OrderbookAmount
+		return m.Orders
 	}
-	return 0
+	return nil
 }
 
-type GetEventsResponse struct {
-	Id                   int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Exchange             string           `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Item                 string           `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	ConditionParams      *ConditionParams `protobuf:"bytes,4,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
-	Pair                 *CurrencyPair    `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
-	Action               string           `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
-	Executed             bool             `protobuf:"varint,7,opt,name=executed,proto3" json:"executed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ConditionalOrder struct {
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId              string        `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ParentId             string        `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Exchange             string        `protobuf:"bytes,4,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,6,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,7,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string        `protobuf:"bytes,8,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	TriggerPrice         float64       `protobuf:"fixed64,9,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	LimitPrice           float64       `protobuf:"fixed64,10,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,11,opt,name=amount,proto3" json:"amount,omitempty"`
+	TrailingDistance     float64       `protobuf:"fixed64,12,opt,name=trailing_distance,json=trailingDistance,proto3" json:"trailing_distance,omitempty"`
+	TrailingPercent      float64       `protobuf:"fixed64,13,opt,name=trailing_percent,json=trailingPercent,proto3" json:"trailing_percent,omitempty"`
+	WatermarkPrice       float64       `protobuf:"fixed64,14,opt,name=watermark_price,json=watermarkPrice,proto3" json:"watermark_price,omitempty"`
+	Status               string        `protobuf:"bytes,15,opt,name=status,proto3" json:"status,omitempty"`
+	OrderId              string        `protobuf:"bytes,16,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Error                string        `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt            int64         `protobuf:"varint,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            int64         `protobuf:"varint,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetEventsResponse) Reset()         { *m = GetEventsResponse{} }
-func (m *GetEventsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetEventsResponse) ProtoMessage()    {}
-func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{75}
+func (m *ConditionalOrder) Reset()         { *m = ConditionalOrder{} }
+func (m *ConditionalOrder) String() string { return proto.CompactTextString(m) }
+func (*ConditionalOrder) ProtoMessage()    {}
+func (*ConditionalOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{77}
 }
 
-func (m *GetEventsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetEventsResponse.Unmarshal(m, b)
+func (m *ConditionalOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConditionalOrder.Unmarshal(m, b)
 }
-func (m *GetEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetEventsResponse.Marshal(b, m, deterministic)
+func (m *ConditionalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConditionalOrder.Marshal(b, m, deterministic)
 }
-func (m *GetEventsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetEventsResponse.Merge(m, src)
+func (m *ConditionalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalOrder.Merge(m, src)
 }
-func (m *GetEventsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetEventsResponse.Size(m)
+func (m *ConditionalOrder) XXX_Size() int {
+	return xxx_messageInfo_ConditionalOrder.Size(m)
 }
-func (m *GetEventsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetEventsResponse.DiscardUnknown(m)
+func (m *ConditionalOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetEventsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ConditionalOrder proto.InternalMessageInfo
 
-func (m *GetEventsResponse) GetId() int64 {
+func (m *ConditionalOrder) GetId() string {
 	if m != nil {
 		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *GetEventsResponse) GetExchange() string {
+func (m *ConditionalOrder) GetGroupId() string {
 	if m != nil {
-		return m.Exchange
+		return m.GroupId
 	}
 	return ""
 }
 
-func (m *GetEventsResponse) GetItem() string {
+func (m *ConditionalOrder) GetParentId() string {
 	if m != nil {
-		return m.Item
+		return m.ParentId
 	}
 	return ""
 }
 
-func (m *GetEventsResponse) GetConditionParams() *ConditionParams {
+func (m *ConditionalOrder) GetExchange() string {
 	if m != nil {
-		return m.ConditionParams
+		return m.Exchange
 	}
-	return nil
+	return ""
 }
 
-func (m *GetEventsResponse) GetPair() *CurrencyPair {
+func (m *ConditionalOrder) GetPair() *CurrencyPair {
 	if m != nil {
 		return m.Pair
 	}
 	return nil
 }
 
-func (m *GetEventsResponse) GetAction() string {
+func (m *ConditionalOrder) GetAssetType() string {
 	if m != nil {
-		return m.Action
+		return m.AssetType
 	}
 	return ""
 }
 
-func (m *GetEventsResponse) GetExecuted() bool {
+func (m *ConditionalOrder) GetSide() string {
 	if m != nil {
-		return m.Executed
+		return m.Side
 	}
-	return false
+	return ""
 }
 
-type AddEventRequest struct {
-	Exchange             string           `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Item                 string           `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	ConditionParams      *ConditionParams `protobuf:"bytes,3,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
-	Pair                 *CurrencyPair    `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType            string           `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Action               string           `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *ConditionalOrder) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
 }
 
-func (m *AddEventRequest) Reset()         { *m = AddEventRequest{} }
-func (m *AddEventRequest) String() string { return proto.CompactTextString(m) }
-func (*AddEventRequest) ProtoMessage()    {}
-func (*AddEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{76}
+func (m *ConditionalOrder) GetTriggerPrice() float64 {
+	if m != nil {
+		return m.TriggerPrice
+	}
+	return 0
 }
 
-func (m *AddEventRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddEventRequest.Unmarshal(m, b)
+func (m *ConditionalOrder) GetLimitPrice() float64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
 }
-func (m *AddEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddEventRequest.Marshal(b, m, deterministic)
-}
-func (m *AddEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddEventRequest.Merge(m, src)
-}
-func (m *AddEventRequest) XXX_Size() int {
-	return xxx_messageInfo_AddEventRequest.Size(m)
-}
-func (m *AddEventRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddEventRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AddEventRequest proto.InternalMessageInfo
 
-func (m *AddEventRequest) GetExchange() string {
+func (m *ConditionalOrder) GetAmount() float64 {
 	if m != nil {
-		return m.Exchange
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-func (m *AddEventRequest) GetItem() string {
+func (m *ConditionalOrder) GetTrailingDistance() float64 {
 	if m != nil {
-		return m.Item
+		return m.TrailingDistance
 	}
-	return ""
+	return 0
 }
 
-func (m *AddEventRequest) GetConditionParams() *ConditionParams {
+func (m *ConditionalOrder) GetTrailingPercent() float64 {
 	if m != nil {
-		return m.ConditionParams
+		return m.TrailingPercent
 	}
-	return nil
+	return 0
 }
 
-func (m *AddEventRequest) GetPair() *CurrencyPair {
+func (m *ConditionalOrder) GetWatermarkPrice() float64 {
 	if m != nil {
-		return m.Pair
+		return m.WatermarkPrice
 	}
-	return nil
+	return 0
 }
 
-func (m *AddEventRequest) GetAssetType() string {
+func (m *ConditionalOrder) GetStatus() string {
 	if m != nil {
-		return m.AssetType
+		return m.Status
 	}
 	return ""
 }
 
-func (m *AddEventRequest) GetAction() string {
+func (m *ConditionalOrder) GetOrderId() string {
 	if m != nil {
-		return m.Action
+		return m.OrderId
 	}
 	return ""
 }
 
-type AddEventResponse struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *AddEventResponse) Reset()         { *m = AddEventResponse{} }
-func (m *AddEventResponse) String() string { return proto.CompactTextString(m) }
-func (*AddEventResponse) ProtoMessage()    {}
-func (*AddEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{77}
+func (m *ConditionalOrder) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *AddEventResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddEventResponse.Unmarshal(m, b)
-}
-func (m *AddEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddEventResponse.Marshal(b, m, deterministic)
-}
-func (m *AddEventResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddEventResponse.Merge(m, src)
-}
-func (m *AddEventResponse) XXX_Size() int {
-	return xxx_messageInfo_AddEventResponse.Size(m)
-}
-func (m *AddEventResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddEventResponse.DiscardUnknown(m)
+func (m *ConditionalOrder) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
 }
 
-var xxx_messageInfo_AddEventResponse proto.InternalMessageInfo
-
-func (m *AddEventResponse) GetId() int64 {
+func (m *ConditionalOrder) GetUpdatedAt() int64 {
 	if m != nil {
-		return m.Id
+		return m.UpdatedAt
 	}
 	return 0
 }
 
-type RemoveEventRequest struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ConditionalOrdersResponse struct {
+	Orders               []*ConditionalOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *RemoveEventRequest) Reset()         { *m = RemoveEventRequest{} }
-func (m *RemoveEventRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveEventRequest) ProtoMessage()    {}
-func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
+func (m *ConditionalOrdersResponse) Reset()         { *m = ConditionalOrdersResponse{} }
+func (m *ConditionalOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*ConditionalOrdersResponse) ProtoMessage()    {}
+func (*ConditionalOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{78}
 }
 
-func (m *RemoveEventRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveEventRequest.Unmarshal(m, b)
+func (m *ConditionalOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConditionalOrdersResponse.Unmarshal(m, b)
 }
-func (m *RemoveEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveEventRequest.Marshal(b, m, deterministic)
+func (m *ConditionalOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConditionalOrdersResponse.Marshal(b, m, deterministic)
 }
-func (m *RemoveEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveEventRequest.Merge(m, src)
+func (m *ConditionalOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalOrdersResponse.Merge(m, src)
 }
-func (m *RemoveEventRequest) XXX_Size() int {
-	return xxx_messageInfo_RemoveEventRequest.Size(m)
+func (m *ConditionalOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_ConditionalOrdersResponse.Size(m)
 }
-func (m *RemoveEventRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveEventRequest.DiscardUnknown(m)
+func (m *ConditionalOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveEventRequest proto.InternalMessageInfo
+var xxx_messageInfo_ConditionalOrdersResponse proto.InternalMessageInfo
 
-func (m *RemoveEventRequest) GetId() int64 {
+func (m *ConditionalOrdersResponse) GetOrders() []*ConditionalOrder {
 	if m != nil {
-		return m.Id
+		return m.Orders
 	}
-	return 0
+	return nil
 }
 
-type RemoveEventResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type AddConditionalOrderRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string        `protobuf:"bytes,5,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	TriggerPrice         float64       `protobuf:"fixed64,6,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	LimitPrice           float64       `protobuf:"fixed64,7,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	TrailingDistance     float64       `protobuf:"fixed64,9,opt,name=trailing_distance,json=trailingDistance,proto3" json:"trailing_distance,omitempty"`
+	TrailingPercent      float64       `protobuf:"fixed64,10,opt,name=trailing_percent,json=trailingPercent,proto3" json:"trailing_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *RemoveEventResponse) Reset()         { *m = RemoveEventResponse{} }
-func (m *RemoveEventResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveEventResponse) ProtoMessage()    {}
-func (*RemoveEventResponse) Descriptor() ([]byte, []int) {
+func (m *AddConditionalOrderRequest) Reset()         { *m = AddConditionalOrderRequest{} }
+func (m *AddConditionalOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*AddConditionalOrderRequest) ProtoMessage()    {}
+func (*AddConditionalOrderRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{79}
 }
 
-func (m *RemoveEventResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveEventResponse.Unmarshal(m, b)
+func (m *AddConditionalOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddConditionalOrderRequest.Unmarshal(m, b)
 }
-func (m *RemoveEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveEventResponse.Marshal(b, m, deterministic)
+func (m *AddConditionalOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddConditionalOrderRequest.Marshal(b, m, deterministic)
 }
-func (m *RemoveEventResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveEventResponse.Merge(m, src)
+func (m *AddConditionalOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddConditionalOrderRequest.Merge(m, src)
 }
-func (m *RemoveEventResponse) XXX_Size() int {
-	return xxx_messageInfo_RemoveEventResponse.Size(m)
+func (m *AddConditionalOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_AddConditionalOrderRequest.Size(m)
 }
-func (m *RemoveEventResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveEventResponse.DiscardUnknown(m)
+func (m *AddConditionalOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddConditionalOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveEventResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddConditionalOrderRequest proto.InternalMessageInfo
 
-type GetCryptocurrencyDepositAddressesRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AddConditionalOrderRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
 
-func (m *GetCryptocurrencyDepositAddressesRequest) Reset() {
-	*m = GetCryptocurrencyDepositAddressesRequest{}
-}
-func (m *GetCryptocurrencyDepositAddressesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage()    {}
-func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{80}
+func (m *AddConditionalOrderRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
 
-func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Unmarshal(m, b)
+func (m *AddConditionalOrderRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
-func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Marshal(b, m, deterministic)
+
+func (m *AddConditionalOrderRequest) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
 }
-func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Merge(m, src)
+
+func (m *AddConditionalOrderRequest) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
 }
-func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Size(m)
+
+func (m *AddConditionalOrderRequest) GetTriggerPrice() float64 {
+	if m != nil {
+		return m.TriggerPrice
+	}
+	return 0
 }
-func (m *GetCryptocurrencyDepositAddressesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.DiscardUnknown(m)
+
+func (m *AddConditionalOrderRequest) GetLimitPrice() float64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest proto.InternalMessageInfo
+func (m *AddConditionalOrderRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
 
-func (m *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
+func (m *AddConditionalOrderRequest) GetTrailingDistance() float64 {
 	if m != nil {
-		return m.Exchange
+		return m.TrailingDistance
 	}
-	return ""
+	return 0
 }
 
-type GetCryptocurrencyDepositAddressesResponse struct {
-	Addresses            map[string]string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *AddConditionalOrderRequest) GetTrailingPercent() float64 {
+	if m != nil {
+		return m.TrailingPercent
+	}
+	return 0
 }
 
-func (m *GetCryptocurrencyDepositAddressesResponse) Reset() {
-	*m = GetCryptocurrencyDepositAddressesResponse{}
+type AddOCOOrderRequest struct {
+	First                *AddConditionalOrderRequest `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second               *AddConditionalOrderRequest `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
-func (m *GetCryptocurrencyDepositAddressesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage()    {}
-func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{81}
+
+func (m *AddOCOOrderRequest) Reset()         { *m = AddOCOOrderRequest{} }
+func (m *AddOCOOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*AddOCOOrderRequest) ProtoMessage()    {}
+func (*AddOCOOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{80}
 }
 
-func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Unmarshal(m, b)
+func (m *AddOCOOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddOCOOrderRequest.Unmarshal(m, b)
 }
-func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Marshal(b, m, deterministic)
+func (m *AddOCOOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddOCOOrderRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Merge(m, src)
+func (m *AddOCOOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddOCOOrderRequest.Merge(m, src)
 }
-func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Size(m)
+func (m *AddOCOOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_AddOCOOrderRequest.Size(m)
 }
-func (m *GetCryptocurrencyDepositAddressesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.DiscardUnknown(m)
+func (m *AddOCOOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddOCOOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddOCOOrderRequest proto.InternalMessageInfo
 
-func (m *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]string {
+func (m *AddOCOOrderRequest) GetFirst() *AddConditionalOrderRequest {
 	if m != nil {
-		return m.Addresses
+		return m.First
 	}
 	return nil
 }
 
-type GetCryptocurrencyDepositAddressRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Cryptocurrency       string   `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AddOCOOrderRequest) GetSecond() *AddConditionalOrderRequest {
+	if m != nil {
+		return m.Second
+	}
+	return nil
 }
 
-func (m *GetCryptocurrencyDepositAddressRequest) Reset() {
-	*m = GetCryptocurrencyDepositAddressRequest{}
+type AddBracketOrderRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	EntryType            string        `protobuf:"bytes,5,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	EntryPrice           float64       `protobuf:"fixed64,6,opt,name=entry_price,json=entryPrice,proto3" json:"entry_price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	TakeProfitPrice      float64       `protobuf:"fixed64,8,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
+	StopLossPrice        float64       `protobuf:"fixed64,9,opt,name=stop_loss_price,json=stopLossPrice,proto3" json:"stop_loss_price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (m *GetCryptocurrencyDepositAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage()    {}
-func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{82}
+
+func (m *AddBracketOrderRequest) Reset()         { *m = AddBracketOrderRequest{} }
+func (m *AddBracketOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*AddBracketOrderRequest) ProtoMessage()    {}
+func (*AddBracketOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{81}
 }
 
-func (m *GetCryptocurrencyDepositAddressRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Unmarshal(m, b)
+func (m *AddBracketOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddBracketOrderRequest.Unmarshal(m, b)
 }
-func (m *GetCryptocurrencyDepositAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Marshal(b, m, deterministic)
+func (m *AddBracketOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddBracketOrderRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCryptocurrencyDepositAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Merge(m, src)
+func (m *AddBracketOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddBracketOrderRequest.Merge(m, src)
 }
-func (m *GetCryptocurrencyDepositAddressRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Size(m)
+func (m *AddBracketOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_AddBracketOrderRequest.Size(m)
 }
-func (m *GetCryptocurrencyDepositAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.DiscardUnknown(m)
+func (m *AddBracketOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddBracketOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCryptocurrencyDepositAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddBracketOrderRequest proto.InternalMessageInfo
 
-func (m *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
+func (m *AddBracketOrderRequest) GetExchange() string {
 	if m != nil {
 		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GetCryptocurrencyDepositAddressRequest) GetCryptocurrency() string {
+func (m *AddBracketOrderRequest) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.Cryptocurrency
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *AddBracketOrderRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
 	}
 	return ""
 }
 
-type GetCryptocurrencyDepositAddressResponse struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *AddBracketOrderRequest) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *AddBracketOrderRequest) GetEntryType() string {
+	if m != nil {
+		return m.EntryType
+	}
+	return ""
+}
+
+func (m *AddBracketOrderRequest) GetEntryPrice() float64 {
+	if m != nil {
+		return m.EntryPrice
+	}
+	return 0
+}
+
+func (m *AddBracketOrderRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *AddBracketOrderRequest) GetTakeProfitPrice() float64 {
+	if m != nil {
+		return m.TakeProfitPrice
+	}
+	return 0
+}
+
+func (m *AddBracketOrderRequest) GetStopLossPrice() float64 {
+	if m != nil {
+		return m.StopLossPrice
+	}
+	return 0
+}
+
+type GetConditionalOrdersRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCryptocurrencyDepositAddressResponse) Reset() {
-	*m = GetCryptocurrencyDepositAddressResponse{}
-}
-func (m *GetCryptocurrencyDepositAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage()    {}
-func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{83}
+func (m *GetConditionalOrdersRequest) Reset()         { *m = GetConditionalOrdersRequest{} }
+func (m *GetConditionalOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetConditionalOrdersRequest) ProtoMessage()    {}
+func (*GetConditionalOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{82}
 }
 
-func (m *GetCryptocurrencyDepositAddressResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Unmarshal(m, b)
+func (m *GetConditionalOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetConditionalOrdersRequest.Unmarshal(m, b)
 }
-func (m *GetCryptocurrencyDepositAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Marshal(b, m, deterministic)
+func (m *GetConditionalOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetConditionalOrdersRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCryptocurrencyDepositAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Merge(m, src)
+func (m *GetConditionalOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConditionalOrdersRequest.Merge(m, src)
 }
-func (m *GetCryptocurrencyDepositAddressResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Size(m)
+func (m *GetConditionalOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetConditionalOrdersRequest.Size(m)
 }
-func (m *GetCryptocurrencyDepositAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.DiscardUnknown(m)
+func (m *GetConditionalOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConditionalOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCryptocurrencyDepositAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetConditionalOrdersRequest proto.InternalMessageInfo
 
-func (m *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
+func (m *GetConditionalOrdersRequest) GetExchange() string {
 	if m != nil {
-		return m.Address
+		return m.Exchange
 	}
 	return ""
 }
 
-type WithdrawCurrencyRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	OneTimePassword      string   `protobuf:"bytes,3,opt,name=one_time_password,json=oneTimePassword,proto3" json:"one_time_password,omitempty"`
-	AccountId            string   `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Pin                  int64    `protobuf:"varint,5,opt,name=pin,proto3" json:"pin,omitempty"`
-	TradePassword        string   `protobuf:"bytes,6,opt,name=trade_password,json=tradePassword,proto3" json:"trade_password,omitempty"`
-	Currency             string   `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
-	Address              string   `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
-	AddressTag           string   `protobuf:"bytes,9,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
-	Amount               float64  `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
-	FeeAmount            float64  `protobuf:"fixed64,11,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
-	BankName             string   `protobuf:"bytes,12,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
-	BankAddress          string   `protobuf:"bytes,13,opt,name=bank_address,json=bankAddress,proto3" json:"bank_address,omitempty"`
-	BankCity             string   `protobuf:"bytes,14,opt,name=bank_city,json=bankCity,proto3" json:"bank_city,omitempty"`
-	BankCountry          string   `protobuf:"bytes,15,opt,name=bank_country,json=bankCountry,proto3" json:"bank_country,omitempty"`
-	SwifeCode            string   `protobuf:"bytes,16,opt,name=swife_code,json=swifeCode,proto3" json:"swife_code,omitempty"`
-	WireCurrency         string   `protobuf:"bytes,17,opt,name=wire_currency,json=wireCurrency,proto3" json:"wire_currency,omitempty"`
+type CancelConditionalOrderRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WithdrawCurrencyRequest) Reset()         { *m = WithdrawCurrencyRequest{} }
-func (m *WithdrawCurrencyRequest) String() string { return proto.CompactTextString(m) }
-func (*WithdrawCurrencyRequest) ProtoMessage()    {}
-func (*WithdrawCurrencyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{84}
+func (m *CancelConditionalOrderRequest) Reset()         { *m = CancelConditionalOrderRequest{} }
+func (m *CancelConditionalOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelConditionalOrderRequest) ProtoMessage()    {}
+func (*CancelConditionalOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{83}
 }
 
-func (m *WithdrawCurrencyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WithdrawCurrencyRequest.Unmarshal(m, b)
+func (m *CancelConditionalOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelConditionalOrderRequest.Unmarshal(m, b)
 }
-func (m *WithdrawCurrencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WithdrawCurrencyRequest.Marshal(b, m, deterministic)
+func (m *CancelConditionalOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelConditionalOrderRequest.Marshal(b, m, deterministic)
 }
-func (m *WithdrawCurrencyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WithdrawCurrencyRequest.Merge(m, src)
+func (m *CancelConditionalOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelConditionalOrderRequest.Merge(m, src)
 }
-func (m *WithdrawCurrencyRequest) XXX_Size() int {
-	return xxx_messageInfo_WithdrawCurrencyRequest.Size(m)
+func (m *CancelConditionalOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelConditionalOrderRequest.Size(m)
 }
-func (m *WithdrawCurrencyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WithdrawCurrencyRequest.DiscardUnknown(m)
+func (m *CancelConditionalOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelConditionalOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WithdrawCurrencyRequest proto.InternalMessageInfo
+var xxx_messageInfo_CancelConditionalOrderRequest proto.InternalMessageInfo
 
-func (m *WithdrawCurrencyRequest) GetExchange() string {
+func (m *CancelConditionalOrderRequest) GetId() string {
 	if m != nil {
-		return m.Exchange
+		return m.Id
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
+type StartExecutionRequest struct {
+	Algorithm            string        `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Exchange             string        `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	Amount               float64       `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	LimitPrice           float64       `protobuf:"fixed64,7,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	Start                string        `protobuf:"bytes,8,opt,name=start,proto3" json:"start,omitempty"`
+	End                  string        `protobuf:"bytes,9,opt,name=end,proto3" json:"end,omitempty"`
+	Slices               int64         `protobuf:"varint,10,opt,name=slices,proto3" json:"slices,omitempty"`
+	Interval             string        `protobuf:"bytes,11,opt,name=interval,proto3" json:"interval,omitempty"`
+	ProfileDays          int64         `protobuf:"varint,12,opt,name=profile_days,json=profileDays,proto3" json:"profile_days,omitempty"`
+	VisibleAmount        float64       `protobuf:"fixed64,13,opt,name=visible_amount,json=visibleAmount,proto3" json:"visible_amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *WithdrawCurrencyRequest) GetOneTimePassword() string {
-	if m != nil {
-		return m.OneTimePassword
-	}
-	return ""
+func (m *StartExecutionRequest) Reset()         { *m = StartExecutionRequest{} }
+func (m *StartExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*StartExecutionRequest) ProtoMessage()    {}
+func (*StartExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{84}
 }
 
-func (m *WithdrawCurrencyRequest) GetAccountId() string {
-	if m != nil {
-		return m.AccountId
-	}
-	return ""
+func (m *StartExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartExecutionRequest.Unmarshal(m, b)
+}
+func (m *StartExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartExecutionRequest.Marshal(b, m, deterministic)
+}
+func (m *StartExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartExecutionRequest.Merge(m, src)
+}
+func (m *StartExecutionRequest) XXX_Size() int {
+	return xxx_messageInfo_StartExecutionRequest.Size(m)
+}
+func (m *StartExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartExecutionRequest.DiscardUnknown(m)
 }
 
-func (m *WithdrawCurrencyRequest) GetPin() int64 {
+var xxx_messageInfo_StartExecutionRequest proto.InternalMessageInfo
+
+func (m *StartExecutionRequest) GetAlgorithm() string {
 	if m != nil {
-		return m.Pin
+		return m.Algorithm
 	}
-	return 0
+	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetTradePassword() string {
+func (m *StartExecutionRequest) GetExchange() string {
 	if m != nil {
-		return m.TradePassword
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetCurrency() string {
+func (m *StartExecutionRequest) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.Currency
+		return m.Pair
 	}
-	return ""
+	return nil
 }
 
-func (m *WithdrawCurrencyRequest) GetAddress() string {
+func (m *StartExecutionRequest) GetAssetType() string {
 	if m != nil {
-		return m.Address
+		return m.AssetType
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetAddressTag() string {
+func (m *StartExecutionRequest) GetSide() string {
 	if m != nil {
-		return m.AddressTag
+		return m.Side
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetAmount() float64 {
+func (m *StartExecutionRequest) GetAmount() float64 {
 	if m != nil {
 		return m.Amount
 	}
 	return 0
 }
 
-func (m *WithdrawCurrencyRequest) GetFeeAmount() float64 {
+func (m *StartExecutionRequest) GetLimitPrice() float64 {
 	if m != nil {
-		return m.FeeAmount
+		return m.LimitPrice
 	}
 	return 0
 }
 
-func (m *WithdrawCurrencyRequest) GetBankName() string {
+func (m *StartExecutionRequest) GetStart() string {
 	if m != nil {
-		return m.BankName
+		return m.Start
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetBankAddress() string {
+func (m *StartExecutionRequest) GetEnd() string {
 	if m != nil {
-		return m.BankAddress
+		return m.End
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetBankCity() string {
+func (m *StartExecutionRequest) GetSlices() int64 {
 	if m != nil {
-		return m.BankCity
+		return m.Slices
 	}
-	return ""
+	return 0
 }
 
-func (m *WithdrawCurrencyRequest) GetBankCountry() string {
+func (m *StartExecutionRequest) GetInterval() string {
 	if m != nil {
-		return m.BankCountry
+		return m.Interval
 	}
 	return ""
 }
 
-func (m *WithdrawCurrencyRequest) GetSwifeCode() string {
+func (m *StartExecutionRequest) GetProfileDays() int64 {
 	if m != nil {
-		return m.SwifeCode
+		return m.ProfileDays
 	}
-	return ""
+	return 0
 }
 
-func (m *WithdrawCurrencyRequest) GetWireCurrency() string {
+func (m *StartExecutionRequest) GetVisibleAmount() float64 {
 	if m != nil {
-		return m.WireCurrency
+		return m.VisibleAmount
 	}
-	return ""
+	return 0
 }
 
-type WithdrawResponse struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+type ExecutionIDRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WithdrawResponse) Reset()         { *m = WithdrawResponse{} }
-func (m *WithdrawResponse) String() string { return proto.CompactTextString(m) }
-func (*WithdrawResponse) ProtoMessage()    {}
-func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+func (m *ExecutionIDRequest) Reset()         { *m = ExecutionIDRequest{} }
+func (m *ExecutionIDRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecutionIDRequest) ProtoMessage()    {}
+func (*ExecutionIDRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{85}
 }
 
-func (m *WithdrawResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WithdrawResponse.Unmarshal(m, b)
+func (m *ExecutionIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutionIDRequest.Unmarshal(m, b)
 }
-func (m *WithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WithdrawResponse.Marshal(b, m, deterministic)
+func (m *ExecutionIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutionIDRequest.Marshal(b, m, deterministic)
 }
-func (m *WithdrawResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WithdrawResponse.Merge(m, src)
+func (m *ExecutionIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionIDRequest.Merge(m, src)
 }
-func (m *WithdrawResponse) XXX_Size() int {
-	return xxx_messageInfo_WithdrawResponse.Size(m)
+func (m *ExecutionIDRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecutionIDRequest.Size(m)
 }
-func (m *WithdrawResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WithdrawResponse.DiscardUnknown(m)
+func (m *ExecutionIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionIDRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WithdrawResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExecutionIDRequest proto.InternalMessageInfo
 
-func (m *WithdrawResponse) GetResult() string {
+func (m *ExecutionIDRequest) GetId() string {
 	if m != nil {
-		return m.Result
+		return m.Id
 	}
 	return ""
 }
 
-type GetLoggerDetailsRequest struct {
-	Logger               string   `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+type GetExecutionsRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetLoggerDetailsRequest) Reset()         { *m = GetLoggerDetailsRequest{} }
-func (m *GetLoggerDetailsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetLoggerDetailsRequest) ProtoMessage()    {}
-func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+func (m *GetExecutionsRequest) Reset()         { *m = GetExecutionsRequest{} }
+func (m *GetExecutionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExecutionsRequest) ProtoMessage()    {}
+func (*GetExecutionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{86}
 }
 
-func (m *GetLoggerDetailsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetLoggerDetailsRequest.Unmarshal(m, b)
+func (m *GetExecutionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExecutionsRequest.Unmarshal(m, b)
 }
-func (m *GetLoggerDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetLoggerDetailsRequest.Marshal(b, m, deterministic)
+func (m *GetExecutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExecutionsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetLoggerDetailsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetLoggerDetailsRequest.Merge(m, src)
+func (m *GetExecutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExecutionsRequest.Merge(m, src)
 }
-func (m *GetLoggerDetailsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetLoggerDetailsRequest.Size(m)
+func (m *GetExecutionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExecutionsRequest.Size(m)
 }
-func (m *GetLoggerDetailsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetLoggerDetailsRequest.DiscardUnknown(m)
+func (m *GetExecutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExecutionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetLoggerDetailsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetExecutionsRequest proto.InternalMessageInfo
 
-func (m *GetLoggerDetailsRequest) GetLogger() string {
+func (m *GetExecutionsRequest) GetExchange() string {
 	if m != nil {
-		return m.Logger
+		return m.Exchange
 	}
 	return ""
 }
 
-type GetLoggerDetailsResponse struct {
-	Info                 bool     `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
-	Debug                bool     `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
-	Warn                 bool     `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
-	Error                bool     `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
+func (m *GetExecutionsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ExecutionChildOrder struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExecutedAmount       float64  `protobuf:"fixed64,3,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	AveragePrice         float64  `protobuf:"fixed64,4,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	SubmittedAt          int64    `protobuf:"varint,6,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	Error                string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetLoggerDetailsResponse) Reset()         { *m = GetLoggerDetailsResponse{} }
-func (m *GetLoggerDetailsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetLoggerDetailsResponse) ProtoMessage()    {}
-func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
+func (m *ExecutionChildOrder) Reset()         { *m = ExecutionChildOrder{} }
+func (m *ExecutionChildOrder) String() string { return proto.CompactTextString(m) }
+func (*ExecutionChildOrder) ProtoMessage()    {}
+func (*ExecutionChildOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{87}
 }
 
-func (m *GetLoggerDetailsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetLoggerDetailsResponse.Unmarshal(m, b)
+func (m *ExecutionChildOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutionChildOrder.Unmarshal(m, b)
 }
-func (m *GetLoggerDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetLoggerDetailsResponse.Marshal(b, m, deterministic)
+func (m *ExecutionChildOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutionChildOrder.Marshal(b, m, deterministic)
 }
-func (m *GetLoggerDetailsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetLoggerDetailsResponse.Merge(m, src)
+func (m *ExecutionChildOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionChildOrder.Merge(m, src)
 }
-func (m *GetLoggerDetailsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetLoggerDetailsResponse.Size(m)
+func (m *ExecutionChildOrder) XXX_Size() int {
+	return xxx_messageInfo_ExecutionChildOrder.Size(m)
 }
-func (m *GetLoggerDetailsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetLoggerDetailsResponse.DiscardUnknown(m)
+func (m *ExecutionChildOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionChildOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetLoggerDetailsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExecutionChildOrder proto.InternalMessageInfo
 
-func (m *GetLoggerDetailsResponse) GetInfo() bool {
+func (m *ExecutionChildOrder) GetOrderId() string {
 	if m != nil {
-		return m.Info
+		return m.OrderId
 	}
-	return false
+	return ""
 }
 
-func (m *GetLoggerDetailsResponse) GetDebug() bool {
+func (m *ExecutionChildOrder) GetAmount() float64 {
 	if m != nil {
-		return m.Debug
+		return m.Amount
 	}
-	return false
+	return 0
 }
 
-func (m *GetLoggerDetailsResponse) GetWarn() bool {
+func (m *ExecutionChildOrder) GetExecutedAmount() float64 {
 	if m != nil {
-		return m.Warn
+		return m.ExecutedAmount
 	}
-	return false
+	return 0
 }
 
-func (m *GetLoggerDetailsResponse) GetError() bool {
+func (m *ExecutionChildOrder) GetAveragePrice() float64 {
 	if m != nil {
-		return m.Error
+		return m.AveragePrice
 	}
-	return false
+	return 0
 }
 
-type SetLoggerDetailsRequest struct {
-	Logger               string   `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
-	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ExecutionChildOrder) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func (m *SetLoggerDetailsRequest) Reset()         { *m = SetLoggerDetailsRequest{} }
-func (m *SetLoggerDetailsRequest) String() string { return proto.CompactTextString(m) }
-func (*SetLoggerDetailsRequest) ProtoMessage()    {}
-func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+func (m *ExecutionChildOrder) GetSubmittedAt() int64 {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return 0
+}
+
+func (m *ExecutionChildOrder) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ExecutionResponse struct {
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Algorithm            string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Exchange             string                 `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	Status               string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Amount               float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	LimitPrice           float64                `protobuf:"fixed64,9,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	ExecutedAmount       float64                `protobuf:"fixed64,10,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	RemainingAmount      float64                `protobuf:"fixed64,11,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	AveragePrice         float64                `protobuf:"fixed64,12,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	SlicesTotal          int64                  `protobuf:"varint,13,opt,name=slices_total,json=slicesTotal,proto3" json:"slices_total,omitempty"`
+	SlicesSent           int64                  `protobuf:"varint,14,opt,name=slices_sent,json=slicesSent,proto3" json:"slices_sent,omitempty"`
+	Start                string                 `protobuf:"bytes,15,opt,name=start,proto3" json:"start,omitempty"`
+	End                  string                 `protobuf:"bytes,16,opt,name=end,proto3" json:"end,omitempty"`
+	Error                string                 `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"`
+	Children             []*ExecutionChildOrder `protobuf:"bytes,18,rep,name=children,proto3" json:"children,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ExecutionResponse) Reset()         { *m = ExecutionResponse{} }
+func (m *ExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecutionResponse) ProtoMessage()    {}
+func (*ExecutionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{88}
 }
 
-func (m *SetLoggerDetailsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetLoggerDetailsRequest.Unmarshal(m, b)
+func (m *ExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutionResponse.Unmarshal(m, b)
 }
-func (m *SetLoggerDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetLoggerDetailsRequest.Marshal(b, m, deterministic)
+func (m *ExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutionResponse.Marshal(b, m, deterministic)
 }
-func (m *SetLoggerDetailsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetLoggerDetailsRequest.Merge(m, src)
+func (m *ExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionResponse.Merge(m, src)
 }
-func (m *SetLoggerDetailsRequest) XXX_Size() int {
-	return xxx_messageInfo_SetLoggerDetailsRequest.Size(m)
+func (m *ExecutionResponse) XXX_Size() int {
+	return xxx_messageInfo_ExecutionResponse.Size(m)
 }
-func (m *SetLoggerDetailsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetLoggerDetailsRequest.DiscardUnknown(m)
+func (m *ExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetLoggerDetailsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExecutionResponse proto.InternalMessageInfo
 
-func (m *SetLoggerDetailsRequest) GetLogger() string {
+func (m *ExecutionResponse) GetId() string {
 	if m != nil {
-		return m.Logger
+		return m.Id
 	}
 	return ""
 }
 
-func (m *SetLoggerDetailsRequest) GetLevel() string {
+func (m *ExecutionResponse) GetAlgorithm() string {
 	if m != nil {
-		return m.Level
+		return m.Algorithm
 	}
 	return ""
 }
 
-type GetExchangePairsRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                string   `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ExecutionResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
 
-func (m *GetExchangePairsRequest) Reset()         { *m = GetExchangePairsRequest{} }
-func (m *GetExchangePairsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetExchangePairsRequest) ProtoMessage()    {}
-func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+func (m *ExecutionResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
 
-func (m *GetExchangePairsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetExchangePairsRequest.Unmarshal(m, b)
-}
-func (m *GetExchangePairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetExchangePairsRequest.Marshal(b, m, deterministic)
-}
-func (m *GetExchangePairsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetExchangePairsRequest.Merge(m, src)
-}
-func (m *GetExchangePairsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetExchangePairsRequest.Size(m)
-}
-func (m *GetExchangePairsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetExchangePairsRequest.DiscardUnknown(m)
+func (m *ExecutionResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetExchangePairsRequest proto.InternalMessageInfo
-
-func (m *GetExchangePairsRequest) GetExchange() string {
+func (m *ExecutionResponse) GetSide() string {
 	if m != nil {
-		return m.Exchange
+		return m.Side
 	}
 	return ""
 }
 
-func (m *GetExchangePairsRequest) GetAsset() string {
+func (m *ExecutionResponse) GetStatus() string {
 	if m != nil {
-		return m.Asset
+		return m.Status
 	}
 	return ""
 }
 
-type GetExchangePairsResponse struct {
-	SupportedAssets      map[string]*PairsSupported `protobuf:"bytes,1,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *ExecutionResponse) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *GetExchangePairsResponse) Reset()         { *m = GetExchangePairsResponse{} }
-func (m *GetExchangePairsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetExchangePairsResponse) ProtoMessage()    {}
-func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+func (m *ExecutionResponse) GetLimitPrice() float64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
 }
 
-func (m *GetExchangePairsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetExchangePairsResponse.Unmarshal(m, b)
-}
-func (m *GetExchangePairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetExchangePairsResponse.Marshal(b, m, deterministic)
-}
-func (m *GetExchangePairsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetExchangePairsResponse.Merge(m, src)
-}
-func (m *GetExchangePairsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetExchangePairsResponse.Size(m)
-}
-func (m *GetExchangePairsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetExchangePairsResponse.DiscardUnknown(m)
+func (m *ExecutionResponse) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetExchangePairsResponse proto.InternalMessageInfo
-
-func (m *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
+func (m *ExecutionResponse) GetRemainingAmount() float64 {
 	if m != nil {
-		return m.SupportedAssets
+		return m.RemainingAmount
 	}
-	return nil
+	return 0
 }
 
-type ExchangePairRequest struct {
-	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AssetType            string        `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *ExecutionResponse) GetAveragePrice() float64 {
+	if m != nil {
+		return m.AveragePrice
+	}
+	return 0
 }
 
-func (m *ExchangePairRequest) Reset()         { *m = ExchangePairRequest{} }
-func (m *ExchangePairRequest) String() string { return proto.CompactTextString(m) }
-func (*ExchangePairRequest) ProtoMessage()    {}
-func (*ExchangePairRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{91}
+func (m *ExecutionResponse) GetSlicesTotal() int64 {
+	if m != nil {
+		return m.SlicesTotal
+	}
+	return 0
 }
 
-func (m *ExchangePairRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExchangePairRequest.Unmarshal(m, b)
-}
-func (m *ExchangePairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExchangePairRequest.Marshal(b, m, deterministic)
-}
-func (m *ExchangePairRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExchangePairRequest.Merge(m, src)
-}
-func (m *ExchangePairRequest) XXX_Size() int {
-	return xxx_messageInfo_ExchangePairRequest.Size(m)
-}
-func (m *ExchangePairRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExchangePairRequest.DiscardUnknown(m)
+func (m *ExecutionResponse) GetSlicesSent() int64 {
+	if m != nil {
+		return m.SlicesSent
+	}
+	return 0
 }
 
-var xxx_messageInfo_ExchangePairRequest proto.InternalMessageInfo
+func (m *ExecutionResponse) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
 
-func (m *ExchangePairRequest) GetExchange() string {
+func (m *ExecutionResponse) GetEnd() string {
 	if m != nil {
-		return m.Exchange
+		return m.End
 	}
 	return ""
 }
 
-func (m *ExchangePairRequest) GetAssetType() string {
+func (m *ExecutionResponse) GetError() string {
 	if m != nil {
-		return m.AssetType
+		return m.Error
 	}
 	return ""
 }
 
-func (m *ExchangePairRequest) GetPair() *CurrencyPair {
+func (m *ExecutionResponse) GetChildren() []*ExecutionChildOrder {
 	if m != nil {
-		return m.Pair
+		return m.Children
 	}
 	return nil
 }
 
-type GetOrderbookStreamRequest struct {
-	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type GetExecutionsResponse struct {
+	Executions           []*ExecutionResponse `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *GetOrderbookStreamRequest) Reset()         { *m = GetOrderbookStreamRequest{} }
-func (m *GetOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*GetOrderbookStreamRequest) ProtoMessage()    {}
-func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+func (m *GetExecutionsResponse) Reset()         { *m = GetExecutionsResponse{} }
+func (m *GetExecutionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExecutionsResponse) ProtoMessage()    {}
+func (*GetExecutionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{89}
 }
 
-func (m *GetOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetOrderbookStreamRequest.Unmarshal(m, b)
+func (m *GetExecutionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExecutionsResponse.Unmarshal(m, b)
 }
-func (m *GetOrderbookStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetOrderbookStreamRequest.Marshal(b, m, deterministic)
+func (m *GetExecutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExecutionsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetOrderbookStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOrderbookStreamRequest.Merge(m, src)
+func (m *GetExecutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExecutionsResponse.Merge(m, src)
 }
-func (m *GetOrderbookStreamRequest) XXX_Size() int {
-	return xxx_messageInfo_GetOrderbookStreamRequest.Size(m)
+func (m *GetExecutionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExecutionsResponse.Size(m)
 }
-func (m *GetOrderbookStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOrderbookStreamRequest.DiscardUnknown(m)
+func (m *GetExecutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExecutionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOrderbookStreamRequest proto.InternalMessageInfo
-
-func (m *GetOrderbookStreamRequest) GetExchange() string {
-	if m != nil {
-		return m.Exchange
-	}
-	return ""
-}
+var xxx_messageInfo_GetExecutionsResponse proto.InternalMessageInfo
 
-func (m *GetOrderbookStreamRequest) GetPair() *CurrencyPair {
+func (m *GetExecutionsResponse) GetExecutions() []*ExecutionResponse {
 	if m != nil {
-		return m.Pair
+		return m.Executions
 	}
 	return nil
 }
 
-func (m *GetOrderbookStreamRequest) GetAssetType() string {
-	if m != nil {
-		return m.AssetType
-	}
-	return ""
-}
-
-type GetExchangeOrderbookStreamRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+type GetEventsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetExchangeOrderbookStreamRequest) Reset()         { *m = GetExchangeOrderbookStreamRequest{} }
-func (m *GetExchangeOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*GetExchangeOrderbookStreamRequest) ProtoMessage()    {}
-func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+func (m *GetEventsRequest) Reset()         { *m = GetEventsRequest{} }
+func (m *GetEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEventsRequest) ProtoMessage()    {}
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{90}
 }
 
-func (m *GetExchangeOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Unmarshal(m, b)
+func (m *GetEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsRequest.Unmarshal(m, b)
 }
-func (m *GetExchangeOrderbookStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Marshal(b, m, deterministic)
+func (m *GetEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetExchangeOrderbookStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetExchangeOrderbookStreamRequest.Merge(m, src)
+func (m *GetEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsRequest.Merge(m, src)
 }
-func (m *GetExchangeOrderbookStreamRequest) XXX_Size() int {
-	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Size(m)
+func (m *GetEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEventsRequest.Size(m)
 }
-func (m *GetExchangeOrderbookStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetExchangeOrderbookStreamRequest.DiscardUnknown(m)
+func (m *GetEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetExchangeOrderbookStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetEventsRequest proto.InternalMessageInfo
 
-func (m *GetExchangeOrderbookStreamRequest) GetExchange() string {
-	if m != nil {
-		return m.Exchange
-	}
-	return ""
-}
-
-type GetTickerStreamRequest struct {
-	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type ConditionParams struct {
+	Condition            string   `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Price                float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	CheckBids            bool     `protobuf:"varint,3,opt,name=check_bids,json=checkBids,proto3" json:"check_bids,omitempty"`
+	CheckBidsAndAsks     bool     `protobuf:"varint,4,opt,name=check_bids_and_asks,json=checkBidsAndAsks,proto3" json:"check_bids_and_asks,omitempty"`
+	OrderbookAmount      float64  `protobuf:"fixed64,5,opt,name=orderbook_amount,json=orderbookAmount,proto3" json:"orderbook_amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTickerStreamRequest) Reset()         { *m = GetTickerStreamRequest{} }
-func (m *GetTickerStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTickerStreamRequest) ProtoMessage()    {}
-func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{94}
+func (m *ConditionParams) Reset()         { *m = ConditionParams{} }
+func (m *ConditionParams) String() string { return proto.CompactTextString(m) }
+func (*ConditionParams) ProtoMessage()    {}
+func (*ConditionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{91}
 }
 
-func (m *GetTickerStreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTickerStreamRequest.Unmarshal(m, b)
+func (m *ConditionParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConditionParams.Unmarshal(m, b)
 }
-func (m *GetTickerStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTickerStreamRequest.Marshal(b, m, deterministic)
+func (m *ConditionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConditionParams.Marshal(b, m, deterministic)
 }
-func (m *GetTickerStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTickerStreamRequest.Merge(m, src)
+func (m *ConditionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionParams.Merge(m, src)
 }
-func (m *GetTickerStreamRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTickerStreamRequest.Size(m)
+func (m *ConditionParams) XXX_Size() int {
+	return xxx_messageInfo_ConditionParams.Size(m)
 }
-func (m *GetTickerStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTickerStreamRequest.DiscardUnknown(m)
+func (m *ConditionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTickerStreamRequest proto.InternalMessageInfo
+var xxx_messageInfo_ConditionParams proto.InternalMessageInfo
 
-func (m *GetTickerStreamRequest) GetExchange() string {
+func (m *ConditionParams) GetCondition() string {
 	if m != nil {
-		return m.Exchange
+		return m.Condition
 	}
 	return ""
 }
 
-func (m *GetTickerStreamRequest) GetPair() *CurrencyPair {
+func (m *ConditionParams) GetPrice() float64 {
 	if m != nil {
-		return m.Pair
+		return m.Price
 	}
-	return nil
+	return 0
 }
 
-func (m *GetTickerStreamRequest) GetAssetType() string {
+func (m *ConditionParams) GetCheckBids() bool {
 	if m != nil {
-		return m.AssetType
+		return m.CheckBids
 	}
-	return ""
-}
-
-type GetExchangeTickerStreamRequest struct {
-	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetExchangeTickerStreamRequest) Reset()         { *m = GetExchangeTickerStreamRequest{} }
-func (m *GetExchangeTickerStreamRequest) String() string { return proto.CompactTextString(m) }
-func (*GetExchangeTickerStreamRequest) ProtoMessage()    {}
-func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{95}
+	return false
 }
 
-func (m *GetExchangeTickerStreamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetExchangeTickerStreamRequest.Unmarshal(m, b)
-}
-func (m *GetExchangeTickerStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetExchangeTickerStreamRequest.Marshal(b, m, deterministic)
-}
-func (m *GetExchangeTickerStreamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetExchangeTickerStreamRequest.Merge(m, src)
-}
-func (m *GetExchangeTickerStreamRequest) XXX_Size() int {
-	return xxx_messageInfo_GetExchangeTickerStreamRequest.Size(m)
-}
-func (m *GetExchangeTickerStreamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetExchangeTickerStreamRequest.DiscardUnknown(m)
+func (m *ConditionParams) GetCheckBidsAndAsks() bool {
+	if m != nil {
+		return m.CheckBidsAndAsks
+	}
+	return false
 }
 
-var xxx_messageInfo_GetExchangeTickerStreamRequest proto.InternalMessageInfo
-
-func (m *GetExchangeTickerStreamRequest) GetExchange() string {
+func (m *ConditionParams) GetOrderbookAmount() float64 {
 	if m != nil {
-		return m.Exchange
+		return m.OrderbookAmount
 	}
-	return ""
+	return 0
 }
 
-type GetAuditEventRequest struct {
-	StartDate            string   `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate              string   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	OrderBy              string   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetEventsResponse struct {
+	Id                   int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange             string           `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Item                 string           `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams      *ConditionParams `protobuf:"bytes,4,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Pair                 *CurrencyPair    `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	Action               string           `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Executed             bool             `protobuf:"varint,7,opt,name=executed,proto3" json:"executed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetAuditEventRequest) Reset()         { *m = GetAuditEventRequest{} }
-func (m *GetAuditEventRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAuditEventRequest) ProtoMessage()    {}
-func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{96}
+func (m *GetEventsResponse) Reset()         { *m = GetEventsResponse{} }
+func (m *GetEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEventsResponse) ProtoMessage()    {}
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{92}
 }
 
-func (m *GetAuditEventRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAuditEventRequest.Unmarshal(m, b)
+func (m *GetEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEventsResponse.Unmarshal(m, b)
 }
-func (m *GetAuditEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAuditEventRequest.Marshal(b, m, deterministic)
+func (m *GetEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEventsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetAuditEventRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAuditEventRequest.Merge(m, src)
+func (m *GetEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEventsResponse.Merge(m, src)
 }
-func (m *GetAuditEventRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAuditEventRequest.Size(m)
+func (m *GetEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEventsResponse.Size(m)
 }
-func (m *GetAuditEventRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAuditEventRequest.DiscardUnknown(m)
+func (m *GetEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEventsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAuditEventRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetEventsResponse proto.InternalMessageInfo
 
-func (m *GetAuditEventRequest) GetStartDate() string {
+func (m *GetEventsResponse) GetId() int64 {
 	if m != nil {
-		return m.StartDate
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *GetAuditEventRequest) GetEndDate() string {
+func (m *GetEventsResponse) GetExchange() string {
 	if m != nil {
-		return m.EndDate
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GetAuditEventRequest) GetOrderBy() string {
+func (m *GetEventsResponse) GetItem() string {
 	if m != nil {
-		return m.OrderBy
+		return m.Item
 	}
 	return ""
 }
 
-func (m *GetAuditEventRequest) GetLimit() int32 {
+func (m *GetEventsResponse) GetConditionParams() *ConditionParams {
 	if m != nil {
-		return m.Limit
+		return m.ConditionParams
 	}
-	return 0
+	return nil
 }
 
-func (m *GetAuditEventRequest) GetOffset() int32 {
+func (m *GetEventsResponse) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.Offset
+		return m.Pair
 	}
-	return 0
+	return nil
 }
 
-type GetAuditEventResponse struct {
-	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *GetEventsResponse) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
 }
 
-func (m *GetAuditEventResponse) Reset()         { *m = GetAuditEventResponse{} }
-func (m *GetAuditEventResponse) String() string { return proto.CompactTextString(m) }
-func (*GetAuditEventResponse) ProtoMessage()    {}
-func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{97}
+func (m *GetEventsResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
 }
 
-func (m *GetAuditEventResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAuditEventResponse.Unmarshal(m, b)
+type AddEventRequest struct {
+	Exchange             string           `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Item                 string           `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams      *ConditionParams `protobuf:"bytes,3,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Pair                 *CurrencyPair    `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string           `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Action               string           `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *GetAuditEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAuditEventResponse.Marshal(b, m, deterministic)
+
+func (m *AddEventRequest) Reset()         { *m = AddEventRequest{} }
+func (m *AddEventRequest) String() string { return proto.CompactTextString(m) }
+func (*AddEventRequest) ProtoMessage()    {}
+func (*AddEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{93}
 }
-func (m *GetAuditEventResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAuditEventResponse.Merge(m, src)
+
+func (m *AddEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddEventRequest.Unmarshal(m, b)
 }
-func (m *GetAuditEventResponse) XXX_Size() int {
-	return xxx_messageInfo_GetAuditEventResponse.Size(m)
+func (m *AddEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddEventRequest.Marshal(b, m, deterministic)
 }
-func (m *GetAuditEventResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAuditEventResponse.DiscardUnknown(m)
+func (m *AddEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddEventRequest.Merge(m, src)
+}
+func (m *AddEventRequest) XXX_Size() int {
+	return xxx_messageInfo_AddEventRequest.Size(m)
+}
+func (m *AddEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddEventRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAuditEventResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddEventRequest proto.InternalMessageInfo
 
-func (m *GetAuditEventResponse) GetEvents() []*AuditEvent {
+func (m *AddEventRequest) GetExchange() string {
 	if m != nil {
-		return m.Events
+		return m.Exchange
 	}
-	return nil
+	return ""
 }
 
-type GetHistoricCandlesRequest struct {
-	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Rangesize            int64         `protobuf:"varint,3,opt,name=rangesize,proto3" json:"rangesize,omitempty"`
-	Granularity          int64         `protobuf:"varint,4,opt,name=granularity,proto3" json:"granularity,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *GetHistoricCandlesRequest) Reset()         { *m = GetHistoricCandlesRequest{} }
-func (m *GetHistoricCandlesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetHistoricCandlesRequest) ProtoMessage()    {}
-func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{98}
-}
-
-func (m *GetHistoricCandlesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetHistoricCandlesRequest.Unmarshal(m, b)
-}
-func (m *GetHistoricCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetHistoricCandlesRequest.Marshal(b, m, deterministic)
-}
-func (m *GetHistoricCandlesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetHistoricCandlesRequest.Merge(m, src)
-}
-func (m *GetHistoricCandlesRequest) XXX_Size() int {
-	return xxx_messageInfo_GetHistoricCandlesRequest.Size(m)
-}
-func (m *GetHistoricCandlesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetHistoricCandlesRequest.DiscardUnknown(m)
+func (m *AddEventRequest) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetHistoricCandlesRequest proto.InternalMessageInfo
-
-func (m *GetHistoricCandlesRequest) GetExchange() string {
+func (m *AddEventRequest) GetConditionParams() *ConditionParams {
 	if m != nil {
-		return m.Exchange
+		return m.ConditionParams
 	}
-	return ""
+	return nil
 }
 
-func (m *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
+func (m *AddEventRequest) GetPair() *CurrencyPair {
 	if m != nil {
 		return m.Pair
 	}
 	return nil
 }
 
-func (m *GetHistoricCandlesRequest) GetRangesize() int64 {
+func (m *AddEventRequest) GetAssetType() string {
 	if m != nil {
-		return m.Rangesize
+		return m.AssetType
 	}
-	return 0
+	return ""
 }
 
-func (m *GetHistoricCandlesRequest) GetGranularity() int64 {
+func (m *AddEventRequest) GetAction() string {
 	if m != nil {
-		return m.Granularity
+		return m.Action
 	}
-	return 0
+	return ""
 }
 
-type GetHistoricCandlesResponse struct {
-	Candle               []*Candle `protobuf:"bytes,1,rep,name=candle,proto3" json:"candle,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type AddEventResponse struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetHistoricCandlesResponse) Reset()         { *m = GetHistoricCandlesResponse{} }
-func (m *GetHistoricCandlesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetHistoricCandlesResponse) ProtoMessage()    {}
-func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{99}
+func (m *AddEventResponse) Reset()         { *m = AddEventResponse{} }
+func (m *AddEventResponse) String() string { return proto.CompactTextString(m) }
+func (*AddEventResponse) ProtoMessage()    {}
+func (*AddEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{94}
 }
 
-func (m *GetHistoricCandlesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetHistoricCandlesResponse.Unmarshal(m, b)
+func (m *AddEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddEventResponse.Unmarshal(m, b)
 }
-func (m *GetHistoricCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetHistoricCandlesResponse.Marshal(b, m, deterministic)
+func (m *AddEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddEventResponse.Marshal(b, m, deterministic)
 }
-func (m *GetHistoricCandlesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetHistoricCandlesResponse.Merge(m, src)
+func (m *AddEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddEventResponse.Merge(m, src)
 }
-func (m *GetHistoricCandlesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetHistoricCandlesResponse.Size(m)
+func (m *AddEventResponse) XXX_Size() int {
+	return xxx_messageInfo_AddEventResponse.Size(m)
 }
-func (m *GetHistoricCandlesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetHistoricCandlesResponse.DiscardUnknown(m)
+func (m *AddEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddEventResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetHistoricCandlesResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddEventResponse proto.InternalMessageInfo
 
-func (m *GetHistoricCandlesResponse) GetCandle() []*Candle {
+func (m *AddEventResponse) GetId() int64 {
 	if m != nil {
-		return m.Candle
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-type Candle struct {
-	Time                 int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Low                  float64  `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
-	High                 float64  `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
-	Open                 float64  `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
-	Close                float64  `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
-	Volume               float64  `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+type RemoveEventRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Candle) Reset()         { *m = Candle{} }
-func (m *Candle) String() string { return proto.CompactTextString(m) }
-func (*Candle) ProtoMessage()    {}
-func (*Candle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{100}
+func (m *RemoveEventRequest) Reset()         { *m = RemoveEventRequest{} }
+func (m *RemoveEventRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveEventRequest) ProtoMessage()    {}
+func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{95}
 }
 
-func (m *Candle) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Candle.Unmarshal(m, b)
+func (m *RemoveEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveEventRequest.Unmarshal(m, b)
 }
-func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+func (m *RemoveEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveEventRequest.Marshal(b, m, deterministic)
 }
-func (m *Candle) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Candle.Merge(m, src)
+func (m *RemoveEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveEventRequest.Merge(m, src)
 }
-func (m *Candle) XXX_Size() int {
-	return xxx_messageInfo_Candle.Size(m)
+func (m *RemoveEventRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveEventRequest.Size(m)
 }
-func (m *Candle) XXX_DiscardUnknown() {
-	xxx_messageInfo_Candle.DiscardUnknown(m)
+func (m *RemoveEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveEventRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Candle proto.InternalMessageInfo
+var xxx_messageInfo_RemoveEventRequest proto.InternalMessageInfo
 
-func (m *Candle) GetTime() int64 {
+func (m *RemoveEventRequest) GetId() int64 {
 	if m != nil {
-		return m.Time
+		return m.Id
 	}
 	return 0
 }
 
-func (m *Candle) GetLow() float64 {
-	if m != nil {
-		return m.Low
-	}
-	return 0
+type RemoveEventResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Candle) GetHigh() float64 {
-	if m != nil {
-		return m.High
-	}
-	return 0
+func (m *RemoveEventResponse) Reset()         { *m = RemoveEventResponse{} }
+func (m *RemoveEventResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveEventResponse) ProtoMessage()    {}
+func (*RemoveEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{96}
 }
 
-func (m *Candle) GetOpen() float64 {
-	if m != nil {
-		return m.Open
-	}
-	return 0
+func (m *RemoveEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveEventResponse.Unmarshal(m, b)
 }
-
-func (m *Candle) GetClose() float64 {
-	if m != nil {
-		return m.Close
-	}
-	return 0
+func (m *RemoveEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveEventResponse.Marshal(b, m, deterministic)
 }
-
-func (m *Candle) GetVolume() float64 {
-	if m != nil {
-		return m.Volume
-	}
-	return 0
+func (m *RemoveEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveEventResponse.Merge(m, src)
+}
+func (m *RemoveEventResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveEventResponse.Size(m)
+}
+func (m *RemoveEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveEventResponse.DiscardUnknown(m)
 }
 
-type AuditEvent struct {
-	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Identifier           string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp            string   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+var xxx_messageInfo_RemoveEventResponse proto.InternalMessageInfo
+
+type GetCryptocurrencyDepositAddressesRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
-func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
-func (*AuditEvent) ProtoMessage()    {}
-func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{101}
+func (m *GetCryptocurrencyDepositAddressesRequest) Reset() {
+	*m = GetCryptocurrencyDepositAddressesRequest{}
+}
+func (m *GetCryptocurrencyDepositAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage()    {}
+func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{97}
 }
 
-func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Unmarshal(m, b)
 }
-func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Marshal(b, m, deterministic)
 }
-func (m *AuditEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuditEvent.Merge(m, src)
+func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Merge(m, src)
 }
-func (m *AuditEvent) XXX_Size() int {
-	return xxx_messageInfo_AuditEvent.Size(m)
+func (m *GetCryptocurrencyDepositAddressesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.Size(m)
 }
-func (m *AuditEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+func (m *GetCryptocurrencyDepositAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+var xxx_messageInfo_GetCryptocurrencyDepositAddressesRequest proto.InternalMessageInfo
 
-func (m *AuditEvent) GetType() string {
+func (m *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
 	if m != nil {
-		return m.Type
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *AuditEvent) GetIdentifier() string {
-	if m != nil {
-		return m.Identifier
-	}
-	return ""
+type GetCryptocurrencyDepositAddressesResponse struct {
+	Addresses            map[string]string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *AuditEvent) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
+func (m *GetCryptocurrencyDepositAddressesResponse) Reset() {
+	*m = GetCryptocurrencyDepositAddressesResponse{}
+}
+func (m *GetCryptocurrencyDepositAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage()    {}
+func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{98}
 }
 
-func (m *AuditEvent) GetTimestamp() string {
-	if m != nil {
-		return m.Timestamp
+func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Unmarshal(m, b)
+}
+func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Merge(m, src)
+}
+func (m *GetCryptocurrencyDepositAddressesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.Size(m)
+}
+func (m *GetCryptocurrencyDepositAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCryptocurrencyDepositAddressesResponse proto.InternalMessageInfo
+
+func (m *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]string {
+	if m != nil {
+		return m.Addresses
 	}
-	return ""
+	return nil
 }
 
-type GCTScript struct {
-	UUID                 string   `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	NextRun              string   `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+type GetCryptocurrencyDepositAddressRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Cryptocurrency       string   `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScript) Reset()         { *m = GCTScript{} }
-func (m *GCTScript) String() string { return proto.CompactTextString(m) }
-func (*GCTScript) ProtoMessage()    {}
-func (*GCTScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{102}
+func (m *GetCryptocurrencyDepositAddressRequest) Reset() {
+	*m = GetCryptocurrencyDepositAddressRequest{}
+}
+func (m *GetCryptocurrencyDepositAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage()    {}
+func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{99}
 }
 
-func (m *GCTScript) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScript.Unmarshal(m, b)
+func (m *GetCryptocurrencyDepositAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Unmarshal(m, b)
 }
-func (m *GCTScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScript.Marshal(b, m, deterministic)
+func (m *GetCryptocurrencyDepositAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScript) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScript.Merge(m, src)
+func (m *GetCryptocurrencyDepositAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Merge(m, src)
 }
-func (m *GCTScript) XXX_Size() int {
-	return xxx_messageInfo_GCTScript.Size(m)
+func (m *GetCryptocurrencyDepositAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.Size(m)
 }
-func (m *GCTScript) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScript.DiscardUnknown(m)
+func (m *GetCryptocurrencyDepositAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScript proto.InternalMessageInfo
-
-func (m *GCTScript) GetUUID() string {
-	if m != nil {
-		return m.UUID
-	}
-	return ""
-}
+var xxx_messageInfo_GetCryptocurrencyDepositAddressRequest proto.InternalMessageInfo
 
-func (m *GCTScript) GetName() string {
+func (m *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
 	if m != nil {
-		return m.Name
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScript) GetPath() string {
+func (m *GetCryptocurrencyDepositAddressRequest) GetCryptocurrency() string {
 	if m != nil {
-		return m.Path
+		return m.Cryptocurrency
 	}
 	return ""
 }
 
-func (m *GCTScript) GetNextRun() string {
-	if m != nil {
-		return m.NextRun
-	}
-	return ""
+type GetCryptocurrencyDepositAddressResponse struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type GCTScriptExecuteRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *GetCryptocurrencyDepositAddressResponse) Reset() {
+	*m = GetCryptocurrencyDepositAddressResponse{}
 }
-
-func (m *GCTScriptExecuteRequest) Reset()         { *m = GCTScriptExecuteRequest{} }
-func (m *GCTScriptExecuteRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptExecuteRequest) ProtoMessage()    {}
-func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{103}
+func (m *GetCryptocurrencyDepositAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage()    {}
+func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{100}
 }
 
-func (m *GCTScriptExecuteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptExecuteRequest.Unmarshal(m, b)
+func (m *GetCryptocurrencyDepositAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Unmarshal(m, b)
 }
-func (m *GCTScriptExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptExecuteRequest.Marshal(b, m, deterministic)
+func (m *GetCryptocurrencyDepositAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptExecuteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptExecuteRequest.Merge(m, src)
+func (m *GetCryptocurrencyDepositAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Merge(m, src)
 }
-func (m *GCTScriptExecuteRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptExecuteRequest.Size(m)
+func (m *GetCryptocurrencyDepositAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.Size(m)
 }
-func (m *GCTScriptExecuteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptExecuteRequest.DiscardUnknown(m)
+func (m *GetCryptocurrencyDepositAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCryptocurrencyDepositAddressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptExecuteRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetCryptocurrencyDepositAddressResponse proto.InternalMessageInfo
 
-func (m *GCTScriptExecuteRequest) GetScript() *GCTScript {
+func (m *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
 	if m != nil {
-		return m.Script
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptStopRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type WithdrawCurrencyRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	OneTimePassword      string   `protobuf:"bytes,3,opt,name=one_time_password,json=oneTimePassword,proto3" json:"one_time_password,omitempty"`
+	AccountId            string   `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Pin                  int64    `protobuf:"varint,5,opt,name=pin,proto3" json:"pin,omitempty"`
+	TradePassword        string   `protobuf:"bytes,6,opt,name=trade_password,json=tradePassword,proto3" json:"trade_password,omitempty"`
+	Currency             string   `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Address              string   `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag           string   `protobuf:"bytes,9,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Amount               float64  `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeAmount            float64  `protobuf:"fixed64,11,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	BankName             string   `protobuf:"bytes,12,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	BankAddress          string   `protobuf:"bytes,13,opt,name=bank_address,json=bankAddress,proto3" json:"bank_address,omitempty"`
+	BankCity             string   `protobuf:"bytes,14,opt,name=bank_city,json=bankCity,proto3" json:"bank_city,omitempty"`
+	BankCountry          string   `protobuf:"bytes,15,opt,name=bank_country,json=bankCountry,proto3" json:"bank_country,omitempty"`
+	SwifeCode            string   `protobuf:"bytes,16,opt,name=swife_code,json=swifeCode,proto3" json:"swife_code,omitempty"`
+	WireCurrency         string   `protobuf:"bytes,17,opt,name=wire_currency,json=wireCurrency,proto3" json:"wire_currency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScriptStopRequest) Reset()         { *m = GCTScriptStopRequest{} }
-func (m *GCTScriptStopRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStopRequest) ProtoMessage()    {}
-func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+func (m *WithdrawCurrencyRequest) Reset()         { *m = WithdrawCurrencyRequest{} }
+func (m *WithdrawCurrencyRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawCurrencyRequest) ProtoMessage()    {}
+func (*WithdrawCurrencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{101}
 }
 
-func (m *GCTScriptStopRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStopRequest.Unmarshal(m, b)
+func (m *WithdrawCurrencyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawCurrencyRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptStopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStopRequest.Marshal(b, m, deterministic)
+func (m *WithdrawCurrencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawCurrencyRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptStopRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStopRequest.Merge(m, src)
+func (m *WithdrawCurrencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawCurrencyRequest.Merge(m, src)
 }
-func (m *GCTScriptStopRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStopRequest.Size(m)
+func (m *WithdrawCurrencyRequest) XXX_Size() int {
+	return xxx_messageInfo_WithdrawCurrencyRequest.Size(m)
 }
-func (m *GCTScriptStopRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStopRequest.DiscardUnknown(m)
+func (m *WithdrawCurrencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawCurrencyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptStopRequest proto.InternalMessageInfo
+var xxx_messageInfo_WithdrawCurrencyRequest proto.InternalMessageInfo
 
-func (m *GCTScriptStopRequest) GetScript() *GCTScript {
+func (m *WithdrawCurrencyRequest) GetExchange() string {
 	if m != nil {
-		return m.Script
+		return m.Exchange
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptStopAllRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WithdrawCurrencyRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *GCTScriptStopAllRequest) Reset()         { *m = GCTScriptStopAllRequest{} }
-func (m *GCTScriptStopAllRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStopAllRequest) ProtoMessage()    {}
-func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+func (m *WithdrawCurrencyRequest) GetOneTimePassword() string {
+	if m != nil {
+		return m.OneTimePassword
+	}
+	return ""
 }
 
-func (m *GCTScriptStopAllRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStopAllRequest.Unmarshal(m, b)
-}
-func (m *GCTScriptStopAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStopAllRequest.Marshal(b, m, deterministic)
-}
-func (m *GCTScriptStopAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStopAllRequest.Merge(m, src)
-}
-func (m *GCTScriptStopAllRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStopAllRequest.Size(m)
-}
-func (m *GCTScriptStopAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStopAllRequest.DiscardUnknown(m)
+func (m *WithdrawCurrencyRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
 }
 
-var xxx_messageInfo_GCTScriptStopAllRequest proto.InternalMessageInfo
+func (m *WithdrawCurrencyRequest) GetPin() int64 {
+	if m != nil {
+		return m.Pin
+	}
+	return 0
+}
 
-type GCTScriptStatusRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WithdrawCurrencyRequest) GetTradePassword() string {
+	if m != nil {
+		return m.TradePassword
+	}
+	return ""
 }
 
-func (m *GCTScriptStatusRequest) Reset()         { *m = GCTScriptStatusRequest{} }
-func (m *GCTScriptStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStatusRequest) ProtoMessage()    {}
-func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{106}
-}
-
-func (m *GCTScriptStatusRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStatusRequest.Unmarshal(m, b)
-}
-func (m *GCTScriptStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStatusRequest.Marshal(b, m, deterministic)
-}
-func (m *GCTScriptStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStatusRequest.Merge(m, src)
-}
-func (m *GCTScriptStatusRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStatusRequest.Size(m)
-}
-func (m *GCTScriptStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStatusRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GCTScriptStatusRequest proto.InternalMessageInfo
-
-type GCTScriptListAllRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WithdrawCurrencyRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
 }
 
-func (m *GCTScriptListAllRequest) Reset()         { *m = GCTScriptListAllRequest{} }
-func (m *GCTScriptListAllRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptListAllRequest) ProtoMessage()    {}
-func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+func (m *WithdrawCurrencyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *GCTScriptListAllRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptListAllRequest.Unmarshal(m, b)
-}
-func (m *GCTScriptListAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptListAllRequest.Marshal(b, m, deterministic)
-}
-func (m *GCTScriptListAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptListAllRequest.Merge(m, src)
-}
-func (m *GCTScriptListAllRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptListAllRequest.Size(m)
-}
-func (m *GCTScriptListAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptListAllRequest.DiscardUnknown(m)
+func (m *WithdrawCurrencyRequest) GetAddressTag() string {
+	if m != nil {
+		return m.AddressTag
+	}
+	return ""
 }
 
-var xxx_messageInfo_GCTScriptListAllRequest proto.InternalMessageInfo
-
-type GCTScriptUploadRequest struct {
-	ScriptName           string   `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
-	ScriptData           string   `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
-	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Archived             bool     `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
-	Overwrite            bool     `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WithdrawCurrencyRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *GCTScriptUploadRequest) Reset()         { *m = GCTScriptUploadRequest{} }
-func (m *GCTScriptUploadRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptUploadRequest) ProtoMessage()    {}
-func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{108}
+func (m *WithdrawCurrencyRequest) GetFeeAmount() float64 {
+	if m != nil {
+		return m.FeeAmount
+	}
+	return 0
 }
 
-func (m *GCTScriptUploadRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptUploadRequest.Unmarshal(m, b)
-}
-func (m *GCTScriptUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptUploadRequest.Marshal(b, m, deterministic)
-}
-func (m *GCTScriptUploadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptUploadRequest.Merge(m, src)
-}
-func (m *GCTScriptUploadRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptUploadRequest.Size(m)
-}
-func (m *GCTScriptUploadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptUploadRequest.DiscardUnknown(m)
+func (m *WithdrawCurrencyRequest) GetBankName() string {
+	if m != nil {
+		return m.BankName
+	}
+	return ""
 }
 
-var xxx_messageInfo_GCTScriptUploadRequest proto.InternalMessageInfo
-
-func (m *GCTScriptUploadRequest) GetScriptName() string {
+func (m *WithdrawCurrencyRequest) GetBankAddress() string {
 	if m != nil {
-		return m.ScriptName
+		return m.BankAddress
 	}
 	return ""
 }
 
-func (m *GCTScriptUploadRequest) GetScriptData() string {
+func (m *WithdrawCurrencyRequest) GetBankCity() string {
 	if m != nil {
-		return m.ScriptData
+		return m.BankCity
 	}
 	return ""
 }
 
-func (m *GCTScriptUploadRequest) GetData() []byte {
+func (m *WithdrawCurrencyRequest) GetBankCountry() string {
 	if m != nil {
-		return m.Data
+		return m.BankCountry
 	}
-	return nil
+	return ""
 }
 
-func (m *GCTScriptUploadRequest) GetArchived() bool {
+func (m *WithdrawCurrencyRequest) GetSwifeCode() string {
 	if m != nil {
-		return m.Archived
+		return m.SwifeCode
 	}
-	return false
+	return ""
 }
 
-func (m *GCTScriptUploadRequest) GetOverwrite() bool {
+func (m *WithdrawCurrencyRequest) GetWireCurrency() string {
 	if m != nil {
-		return m.Overwrite
+		return m.WireCurrency
 	}
-	return false
+	return ""
 }
 
-type GCTScriptReadScriptRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type WithdrawResponse struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScriptReadScriptRequest) Reset()         { *m = GCTScriptReadScriptRequest{} }
-func (m *GCTScriptReadScriptRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptReadScriptRequest) ProtoMessage()    {}
-func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{109}
+func (m *WithdrawResponse) Reset()         { *m = WithdrawResponse{} }
+func (m *WithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*WithdrawResponse) ProtoMessage()    {}
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{102}
 }
 
-func (m *GCTScriptReadScriptRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptReadScriptRequest.Unmarshal(m, b)
+func (m *WithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawResponse.Unmarshal(m, b)
 }
-func (m *GCTScriptReadScriptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptReadScriptRequest.Marshal(b, m, deterministic)
+func (m *WithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawResponse.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptReadScriptRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptReadScriptRequest.Merge(m, src)
+func (m *WithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawResponse.Merge(m, src)
 }
-func (m *GCTScriptReadScriptRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptReadScriptRequest.Size(m)
+func (m *WithdrawResponse) XXX_Size() int {
+	return xxx_messageInfo_WithdrawResponse.Size(m)
 }
-func (m *GCTScriptReadScriptRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptReadScriptRequest.DiscardUnknown(m)
+func (m *WithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptReadScriptRequest proto.InternalMessageInfo
+var xxx_messageInfo_WithdrawResponse proto.InternalMessageInfo
 
-func (m *GCTScriptReadScriptRequest) GetScript() *GCTScript {
+func (m *WithdrawResponse) GetResult() string {
 	if m != nil {
-		return m.Script
+		return m.Result
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptQueryRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type GetLoggerDetailsRequest struct {
+	Logger               string   `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScriptQueryRequest) Reset()         { *m = GCTScriptQueryRequest{} }
-func (m *GCTScriptQueryRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptQueryRequest) ProtoMessage()    {}
-func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{110}
+func (m *GetLoggerDetailsRequest) Reset()         { *m = GetLoggerDetailsRequest{} }
+func (m *GetLoggerDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoggerDetailsRequest) ProtoMessage()    {}
+func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{103}
 }
 
-func (m *GCTScriptQueryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptQueryRequest.Unmarshal(m, b)
+func (m *GetLoggerDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoggerDetailsRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptQueryRequest.Marshal(b, m, deterministic)
+func (m *GetLoggerDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoggerDetailsRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptQueryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptQueryRequest.Merge(m, src)
+func (m *GetLoggerDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoggerDetailsRequest.Merge(m, src)
 }
-func (m *GCTScriptQueryRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptQueryRequest.Size(m)
+func (m *GetLoggerDetailsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoggerDetailsRequest.Size(m)
 }
-func (m *GCTScriptQueryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptQueryRequest.DiscardUnknown(m)
+func (m *GetLoggerDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoggerDetailsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptQueryRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetLoggerDetailsRequest proto.InternalMessageInfo
 
-func (m *GCTScriptQueryRequest) GetScript() *GCTScript {
+func (m *GetLoggerDetailsRequest) GetLogger() string {
 	if m != nil {
-		return m.Script
+		return m.Logger
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptAutoLoadRequest struct {
-	Script               string   `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	Status               bool     `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+type GetLoggerDetailsResponse struct {
+	Info                 bool     `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
+	Debug                bool     `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
+	Warn                 bool     `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
+	Error                bool     `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScriptAutoLoadRequest) Reset()         { *m = GCTScriptAutoLoadRequest{} }
-func (m *GCTScriptAutoLoadRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptAutoLoadRequest) ProtoMessage()    {}
-func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{111}
+func (m *GetLoggerDetailsResponse) Reset()         { *m = GetLoggerDetailsResponse{} }
+func (m *GetLoggerDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoggerDetailsResponse) ProtoMessage()    {}
+func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{104}
 }
 
-func (m *GCTScriptAutoLoadRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptAutoLoadRequest.Unmarshal(m, b)
+func (m *GetLoggerDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoggerDetailsResponse.Unmarshal(m, b)
 }
-func (m *GCTScriptAutoLoadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptAutoLoadRequest.Marshal(b, m, deterministic)
+func (m *GetLoggerDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoggerDetailsResponse.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptAutoLoadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptAutoLoadRequest.Merge(m, src)
+func (m *GetLoggerDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoggerDetailsResponse.Merge(m, src)
 }
-func (m *GCTScriptAutoLoadRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptAutoLoadRequest.Size(m)
+func (m *GetLoggerDetailsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoggerDetailsResponse.Size(m)
 }
-func (m *GCTScriptAutoLoadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptAutoLoadRequest.DiscardUnknown(m)
+func (m *GetLoggerDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoggerDetailsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptAutoLoadRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetLoggerDetailsResponse proto.InternalMessageInfo
 
-func (m *GCTScriptAutoLoadRequest) GetScript() string {
+func (m *GetLoggerDetailsResponse) GetInfo() bool {
 	if m != nil {
-		return m.Script
+		return m.Info
 	}
-	return ""
+	return false
 }
 
-func (m *GCTScriptAutoLoadRequest) GetStatus() bool {
+func (m *GetLoggerDetailsResponse) GetDebug() bool {
 	if m != nil {
-		return m.Status
+		return m.Debug
 	}
 	return false
 }
 
-type GCTScriptStatusResponse struct {
-	Status               string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Scripts              []*GCTScript `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *GetLoggerDetailsResponse) GetWarn() bool {
+	if m != nil {
+		return m.Warn
+	}
+	return false
 }
 
-func (m *GCTScriptStatusResponse) Reset()         { *m = GCTScriptStatusResponse{} }
-func (m *GCTScriptStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStatusResponse) ProtoMessage()    {}
-func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{112}
+func (m *GetLoggerDetailsResponse) GetError() bool {
+	if m != nil {
+		return m.Error
+	}
+	return false
 }
 
-func (m *GCTScriptStatusResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStatusResponse.Unmarshal(m, b)
+type SetLoggerDetailsRequest struct {
+	Logger               string   `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+	Level                string   `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GCTScriptStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStatusResponse.Marshal(b, m, deterministic)
+
+func (m *SetLoggerDetailsRequest) Reset()         { *m = SetLoggerDetailsRequest{} }
+func (m *SetLoggerDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLoggerDetailsRequest) ProtoMessage()    {}
+func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{105}
 }
-func (m *GCTScriptStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStatusResponse.Merge(m, src)
+
+func (m *SetLoggerDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLoggerDetailsRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptStatusResponse) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStatusResponse.Size(m)
+func (m *SetLoggerDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLoggerDetailsRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStatusResponse.DiscardUnknown(m)
+func (m *SetLoggerDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLoggerDetailsRequest.Merge(m, src)
+}
+func (m *SetLoggerDetailsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLoggerDetailsRequest.Size(m)
+}
+func (m *SetLoggerDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLoggerDetailsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetLoggerDetailsRequest proto.InternalMessageInfo
 
-func (m *GCTScriptStatusResponse) GetStatus() string {
+func (m *SetLoggerDetailsRequest) GetLogger() string {
 	if m != nil {
-		return m.Status
+		return m.Logger
 	}
 	return ""
 }
 
-func (m *GCTScriptStatusResponse) GetScripts() []*GCTScript {
+func (m *SetLoggerDetailsRequest) GetLevel() string {
 	if m != nil {
-		return m.Scripts
+		return m.Level
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptQueryResponse struct {
-	Status               string     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Script               *GCTScript `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
-	Data                 string     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type GetExchangePairsRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                string   `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GCTScriptQueryResponse) Reset()         { *m = GCTScriptQueryResponse{} }
-func (m *GCTScriptQueryResponse) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptQueryResponse) ProtoMessage()    {}
-func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{113}
+func (m *GetExchangePairsRequest) Reset()         { *m = GetExchangePairsRequest{} }
+func (m *GetExchangePairsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangePairsRequest) ProtoMessage()    {}
+func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{106}
 }
 
-func (m *GCTScriptQueryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptQueryResponse.Unmarshal(m, b)
+func (m *GetExchangePairsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangePairsRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptQueryResponse.Marshal(b, m, deterministic)
+func (m *GetExchangePairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangePairsRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptQueryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptQueryResponse.Merge(m, src)
+func (m *GetExchangePairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangePairsRequest.Merge(m, src)
 }
-func (m *GCTScriptQueryResponse) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptQueryResponse.Size(m)
+func (m *GetExchangePairsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangePairsRequest.Size(m)
 }
-func (m *GCTScriptQueryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptQueryResponse.DiscardUnknown(m)
+func (m *GetExchangePairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangePairsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptQueryResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetExchangePairsRequest proto.InternalMessageInfo
 
-func (m *GCTScriptQueryResponse) GetStatus() string {
+func (m *GetExchangePairsRequest) GetExchange() string {
 	if m != nil {
-		return m.Status
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScriptQueryResponse) GetScript() *GCTScript {
+func (m *GetExchangePairsRequest) GetAsset() string {
 	if m != nil {
-		return m.Script
+		return m.Asset
 	}
-	return nil
+	return ""
 }
 
-func (m *GCTScriptQueryResponse) GetData() string {
+type GetExchangePairsResponse struct {
+	SupportedAssets      map[string]*PairsSupported `protobuf:"bytes,1,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetExchangePairsResponse) Reset()         { *m = GetExchangePairsResponse{} }
+func (m *GetExchangePairsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExchangePairsResponse) ProtoMessage()    {}
+func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{107}
+}
+
+func (m *GetExchangePairsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangePairsResponse.Unmarshal(m, b)
+}
+func (m *GetExchangePairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangePairsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExchangePairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangePairsResponse.Merge(m, src)
+}
+func (m *GetExchangePairsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExchangePairsResponse.Size(m)
+}
+func (m *GetExchangePairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangePairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExchangePairsResponse proto.InternalMessageInfo
+
+func (m *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
 	if m != nil {
-		return m.Data
+		return m.SupportedAssets
 	}
-	return ""
+	return nil
 }
 
-type GCTScriptGenericResponse struct {
-	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ExchangePairRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType            string        `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GCTScriptGenericResponse) Reset()         { *m = GCTScriptGenericResponse{} }
-func (m *GCTScriptGenericResponse) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptGenericResponse) ProtoMessage()    {}
-func (*GCTScriptGenericResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{114}
+func (m *ExchangePairRequest) Reset()         { *m = ExchangePairRequest{} }
+func (m *ExchangePairRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangePairRequest) ProtoMessage()    {}
+func (*ExchangePairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{108}
 }
 
-func (m *GCTScriptGenericResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptGenericResponse.Unmarshal(m, b)
+func (m *ExchangePairRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangePairRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptGenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptGenericResponse.Marshal(b, m, deterministic)
+func (m *ExchangePairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangePairRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptGenericResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptGenericResponse.Merge(m, src)
+func (m *ExchangePairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangePairRequest.Merge(m, src)
 }
-func (m *GCTScriptGenericResponse) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptGenericResponse.Size(m)
+func (m *ExchangePairRequest) XXX_Size() int {
+	return xxx_messageInfo_ExchangePairRequest.Size(m)
 }
-func (m *GCTScriptGenericResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptGenericResponse.DiscardUnknown(m)
+func (m *ExchangePairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangePairRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptGenericResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExchangePairRequest proto.InternalMessageInfo
 
-func (m *GCTScriptGenericResponse) GetStatus() string {
+func (m *ExchangePairRequest) GetExchange() string {
 	if m != nil {
-		return m.Status
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScriptGenericResponse) GetData() string {
+func (m *ExchangePairRequest) GetAssetType() string {
 	if m != nil {
-		return m.Data
+		return m.AssetType
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*GetInfoRequest)(nil), "gctrpc.GetInfoRequest")
-	proto.RegisterType((*GetInfoResponse)(nil), "gctrpc.GetInfoResponse")
-	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetInfoResponse.RpcEndpointsEntry")
-	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetInfoResponse.SubsystemStatusEntry")
-	proto.RegisterType((*GetCommunicationRelayersRequest)(nil), "gctrpc.GetCommunicationRelayersRequest")
-	proto.RegisterType((*CommunicationRelayer)(nil), "gctrpc.CommunicationRelayer")
-	proto.RegisterType((*GetCommunicationRelayersResponse)(nil), "gctrpc.GetCommunicationRelayersResponse")
-	proto.RegisterMapType((map[string]*CommunicationRelayer)(nil), "gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry")
-	proto.RegisterType((*GenericSubsystemRequest)(nil), "gctrpc.GenericSubsystemRequest")
-	proto.RegisterType((*GenericSubsystemResponse)(nil), "gctrpc.GenericSubsystemResponse")
-	proto.RegisterType((*GetSubsystemsRequest)(nil), "gctrpc.GetSubsystemsRequest")
-	proto.RegisterType((*GetSusbsytemsResponse)(nil), "gctrpc.GetSusbsytemsResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry")
-	proto.RegisterType((*GetRPCEndpointsRequest)(nil), "gctrpc.GetRPCEndpointsRequest")
-	proto.RegisterType((*RPCEndpoint)(nil), "gctrpc.RPCEndpoint")
-	proto.RegisterType((*GetRPCEndpointsResponse)(nil), "gctrpc.GetRPCEndpointsResponse")
-	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetRPCEndpointsResponse.EndpointsEntry")
-	proto.RegisterType((*GenericExchangeNameRequest)(nil), "gctrpc.GenericExchangeNameRequest")
-	proto.RegisterType((*GenericExchangeNameResponse)(nil), "gctrpc.GenericExchangeNameResponse")
-	proto.RegisterType((*GetExchangesRequest)(nil), "gctrpc.GetExchangesRequest")
-	proto.RegisterType((*GetExchangesResponse)(nil), "gctrpc.GetExchangesResponse")
-	proto.RegisterType((*GetExchangeOTPReponse)(nil), "gctrpc.GetExchangeOTPReponse")
-	proto.RegisterType((*GetExchangeOTPsRequest)(nil), "gctrpc.GetExchangeOTPsRequest")
-	proto.RegisterType((*GetExchangeOTPsResponse)(nil), "gctrpc.GetExchangeOTPsResponse")
-	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetExchangeOTPsResponse.OtpCodesEntry")
-	proto.RegisterType((*DisableExchangeRequest)(nil), "gctrpc.DisableExchangeRequest")
-	proto.RegisterType((*PairsSupported)(nil), "gctrpc.PairsSupported")
-	proto.RegisterType((*GetExchangeInfoResponse)(nil), "gctrpc.GetExchangeInfoResponse")
-	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry")
-	proto.RegisterType((*GetTickerRequest)(nil), "gctrpc.GetTickerRequest")
-	proto.RegisterType((*CurrencyPair)(nil), "gctrpc.CurrencyPair")
-	proto.RegisterType((*TickerResponse)(nil), "gctrpc.TickerResponse")
-	proto.RegisterType((*GetTickersRequest)(nil), "gctrpc.GetTickersRequest")
-	proto.RegisterType((*Tickers)(nil), "gctrpc.Tickers")
-	proto.RegisterType((*GetTickersResponse)(nil), "gctrpc.GetTickersResponse")
-	proto.RegisterType((*GetOrderbookRequest)(nil), "gctrpc.GetOrderbookRequest")
-	proto.RegisterType((*OrderbookItem)(nil), "gctrpc.OrderbookItem")
-	proto.RegisterType((*OrderbookResponse)(nil), "gctrpc.OrderbookResponse")
-	proto.RegisterType((*GetOrderbooksRequest)(nil), "gctrpc.GetOrderbooksRequest")
-	proto.RegisterType((*Orderbooks)(nil), "gctrpc.Orderbooks")
-	proto.RegisterType((*GetOrderbooksResponse)(nil), "gctrpc.GetOrderbooksResponse")
-	proto.RegisterType((*GetAccountInfoRequest)(nil), "gctrpc.GetAccountInfoRequest")
-	proto.RegisterType((*Account)(nil), "gctrpc.Account")
-	proto.RegisterType((*AccountCurrencyInfo)(nil), "gctrpc.AccountCurrencyInfo")
-	proto.RegisterType((*GetAccountInfoResponse)(nil), "gctrpc.GetAccountInfoResponse")
-	proto.RegisterType((*GetConfigRequest)(nil), "gctrpc.GetConfigRequest")
-	proto.RegisterType((*GetConfigResponse)(nil), "gctrpc.GetConfigResponse")
-	proto.RegisterType((*PortfolioAddress)(nil), "gctrpc.PortfolioAddress")
-	proto.RegisterType((*GetPortfolioRequest)(nil), "gctrpc.GetPortfolioRequest")
-	proto.RegisterType((*GetPortfolioResponse)(nil), "gctrpc.GetPortfolioResponse")
-	proto.RegisterType((*GetPortfolioSummaryRequest)(nil), "gctrpc.GetPortfolioSummaryRequest")
-	proto.RegisterType((*Coin)(nil), "gctrpc.Coin")
-	proto.RegisterType((*OfflineCoinSummary)(nil), "gctrpc.OfflineCoinSummary")
-	proto.RegisterType((*OnlineCoinSummary)(nil), "gctrpc.OnlineCoinSummary")
-	proto.RegisterType((*OfflineCoins)(nil), "gctrpc.OfflineCoins")
-	proto.RegisterType((*OnlineCoins)(nil), "gctrpc.OnlineCoins")
-	proto.RegisterMapType((map[string]*OnlineCoinSummary)(nil), "gctrpc.OnlineCoins.CoinsEntry")
-	proto.RegisterType((*GetPortfolioSummaryResponse)(nil), "gctrpc.GetPortfolioSummaryResponse")
-	proto.RegisterMapType((map[string]*OfflineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry")
-	proto.RegisterMapType((map[string]*OnlineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry")
-	proto.RegisterType((*AddPortfolioAddressRequest)(nil), "gctrpc.AddPortfolioAddressRequest")
-	proto.RegisterType((*AddPortfolioAddressResponse)(nil), "gctrpc.AddPortfolioAddressResponse")
-	proto.RegisterType((*RemovePortfolioAddressRequest)(nil), "gctrpc.RemovePortfolioAddressRequest")
-	proto.RegisterType((*RemovePortfolioAddressResponse)(nil), "gctrpc.RemovePortfolioAddressResponse")
-	proto.RegisterType((*GetForexProvidersRequest)(nil), "gctrpc.GetForexProvidersRequest")
-	proto.RegisterType((*ForexProvider)(nil), "gctrpc.ForexProvider")
-	proto.RegisterType((*GetForexProvidersResponse)(nil), "gctrpc.GetForexProvidersResponse")
-	proto.RegisterType((*GetForexRatesRequest)(nil), "gctrpc.GetForexRatesRequest")
-	proto.RegisterType((*ForexRatesConversion)(nil), "gctrpc.ForexRatesConversion")
-	proto.RegisterType((*GetForexRatesResponse)(nil), "gctrpc.GetForexRatesResponse")
-	proto.RegisterType((*OrderDetails)(nil), "gctrpc.OrderDetails")
-	proto.RegisterType((*GetOrdersRequest)(nil), "gctrpc.GetOrdersRequest")
-	proto.RegisterType((*GetOrdersResponse)(nil), "gctrpc.GetOrdersResponse")
-	proto.RegisterType((*GetOrderRequest)(nil), "gctrpc.GetOrderRequest")
-	proto.RegisterType((*SubmitOrderRequest)(nil), "gctrpc.SubmitOrderRequest")
-	proto.RegisterType((*SubmitOrderResponse)(nil), "gctrpc.SubmitOrderResponse")
-	proto.RegisterType((*SimulateOrderRequest)(nil), "gctrpc.SimulateOrderRequest")
-	proto.RegisterType((*SimulateOrderResponse)(nil), "gctrpc.SimulateOrderResponse")
-	proto.RegisterType((*WhaleBombRequest)(nil), "gctrpc.WhaleBombRequest")
-	proto.RegisterType((*CancelOrderRequest)(nil), "gctrpc.CancelOrderRequest")
-	proto.RegisterType((*CancelOrderResponse)(nil), "gctrpc.CancelOrderResponse")
-	proto.RegisterType((*CancelAllOrdersRequest)(nil), "gctrpc.CancelAllOrdersRequest")
-	proto.RegisterType((*CancelAllOrdersResponse)(nil), "gctrpc.CancelAllOrdersResponse")
-	proto.RegisterType((*CancelAllOrdersResponse_Orders)(nil), "gctrpc.CancelAllOrdersResponse.Orders")
-	proto.RegisterMapType((map[string]string)(nil), "gctrpc.CancelAllOrdersResponse.Orders.OrderStatusEntry")
-	proto.RegisterType((*GetEventsRequest)(nil), "gctrpc.GetEventsRequest")
-	proto.RegisterType((*ConditionParams)(nil), "gctrpc.ConditionParams")
-	proto.RegisterType((*GetEventsResponse)(nil), "gctrpc.GetEventsResponse")
-	proto.RegisterType((*AddEventRequest)(nil), "gctrpc.AddEventRequest")
-	proto.RegisterType((*AddEventResponse)(nil), "gctrpc.AddEventResponse")
-	proto.RegisterType((*RemoveEventRequest)(nil), "gctrpc.RemoveEventRequest")
-	proto.RegisterType((*RemoveEventResponse)(nil), "gctrpc.RemoveEventResponse")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressesRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressesRequest")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressesResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse")
-	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressRequest")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressResponse")
-	proto.RegisterType((*WithdrawCurrencyRequest)(nil), "gctrpc.WithdrawCurrencyRequest")
-	proto.RegisterType((*WithdrawResponse)(nil), "gctrpc.WithdrawResponse")
-	proto.RegisterType((*GetLoggerDetailsRequest)(nil), "gctrpc.GetLoggerDetailsRequest")
-	proto.RegisterType((*GetLoggerDetailsResponse)(nil), "gctrpc.GetLoggerDetailsResponse")
-	proto.RegisterType((*SetLoggerDetailsRequest)(nil), "gctrpc.SetLoggerDetailsRequest")
-	proto.RegisterType((*GetExchangePairsRequest)(nil), "gctrpc.GetExchangePairsRequest")
-	proto.RegisterType((*GetExchangePairsResponse)(nil), "gctrpc.GetExchangePairsResponse")
-	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangePairsResponse.SupportedAssetsEntry")
-	proto.RegisterType((*ExchangePairRequest)(nil), "gctrpc.ExchangePairRequest")
-	proto.RegisterType((*GetOrderbookStreamRequest)(nil), "gctrpc.GetOrderbookStreamRequest")
-	proto.RegisterType((*GetExchangeOrderbookStreamRequest)(nil), "gctrpc.GetExchangeOrderbookStreamRequest")
-	proto.RegisterType((*GetTickerStreamRequest)(nil), "gctrpc.GetTickerStreamRequest")
-	proto.RegisterType((*GetExchangeTickerStreamRequest)(nil), "gctrpc.GetExchangeTickerStreamRequest")
-	proto.RegisterType((*GetAuditEventRequest)(nil), "gctrpc.GetAuditEventRequest")
-	proto.RegisterType((*GetAuditEventResponse)(nil), "gctrpc.GetAuditEventResponse")
-	proto.RegisterType((*GetHistoricCandlesRequest)(nil), "gctrpc.GetHistoricCandlesRequest")
-	proto.RegisterType((*GetHistoricCandlesResponse)(nil), "gctrpc.GetHistoricCandlesResponse")
-	proto.RegisterType((*Candle)(nil), "gctrpc.Candle")
-	proto.RegisterType((*AuditEvent)(nil), "gctrpc.AuditEvent")
-	proto.RegisterType((*GCTScript)(nil), "gctrpc.GCTScript")
-	proto.RegisterType((*GCTScriptExecuteRequest)(nil), "gctrpc.GCTScriptExecuteRequest")
-	proto.RegisterType((*GCTScriptStopRequest)(nil), "gctrpc.GCTScriptStopRequest")
-	proto.RegisterType((*GCTScriptStopAllRequest)(nil), "gctrpc.GCTScriptStopAllRequest")
-	proto.RegisterType((*GCTScriptStatusRequest)(nil), "gctrpc.GCTScriptStatusRequest")
-	proto.RegisterType((*GCTScriptListAllRequest)(nil), "gctrpc.GCTScriptListAllRequest")
-	proto.RegisterType((*GCTScriptUploadRequest)(nil), "gctrpc.GCTScriptUploadRequest")
-	proto.RegisterType((*GCTScriptReadScriptRequest)(nil), "gctrpc.GCTScriptReadScriptRequest")
-	proto.RegisterType((*GCTScriptQueryRequest)(nil), "gctrpc.GCTScriptQueryRequest")
-	proto.RegisterType((*GCTScriptAutoLoadRequest)(nil), "gctrpc.GCTScriptAutoLoadRequest")
-	proto.RegisterType((*GCTScriptStatusResponse)(nil), "gctrpc.GCTScriptStatusResponse")
-	proto.RegisterType((*GCTScriptQueryResponse)(nil), "gctrpc.GCTScriptQueryResponse")
-	proto.RegisterType((*GCTScriptGenericResponse)(nil), "gctrpc.GCTScriptGenericResponse")
+func (m *ExchangePairRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
-
-var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 5495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3c, 0x4d, 0x6f, 0x1c, 0x57,
-	0x72, 0x98, 0x21, 0x45, 0x72, 0x8a, 0x5f, 0xc3, 0xc7, 0xaf, 0xd1, 0x88, 0x12, 0xa5, 0xf6, 0x5a,
-	0x96, 0xfc, 0x41, 0xd9, 0xb2, 0x36, 0xeb, 0xac, 0x9d, 0xdd, 0x50, 0x94, 0x4c, 0x6b, 0xad, 0xb5,
-	0xb8, 0x4d, 0x5a, 0x06, 0xbc, 0x81, 0x27, 0xcd, 0xe9, 0xc7, 0x61, 0x47, 0x3d, 0xdd, 0xed, 0xee,
-	0x37, 0xa4, 0xe8, 0x4d, 0x90, 0x85, 0x91, 0x04, 0x39, 0x04, 0x9b, 0xc3, 0x22, 0x40, 0x02, 0xe4,
-	0x92, 0x9c, 0x82, 0x00, 0xb9, 0x04, 0x39, 0xe5, 0xb0, 0xc8, 0x35, 0xc8, 0x31, 0x97, 0xfc, 0x80,
-	0x20, 0xb7, 0x24, 0x40, 0x80, 0x5c, 0x72, 0x0a, 0x5e, 0xbd, 0x8f, 0x7e, 0xaf, 0xbb, 0x67, 0x38,
-	0xb4, 0xb5, 0xde, 0x8b, 0x34, 0x5d, 0xaf, 0x5e, 0x55, 0xbd, 0x7a, 0xd5, 0xf5, 0xaa, 0x5e, 0x55,
-	0x13, 0x1a, 0x69, 0xd2, 0xdd, 0x4a, 0xd2, 0x98, 0xc5, 0x64, 0xaa, 0xd7, 0x65, 0x69, 0xd2, 0x6d,
-	0x6f, 0xf4, 0xe2, 0xb8, 0x17, 0xd2, 0x3b, 0x5e, 0x12, 0xdc, 0xf1, 0xa2, 0x28, 0x66, 0x1e, 0x0b,
-	0xe2, 0x28, 0x13, 0x58, 0x4e, 0x13, 0x16, 0x76, 0x29, 0x7b, 0x14, 0x1d, 0xc5, 0x2e, 0xfd, 0x7c,
-	0x40, 0x33, 0xe6, 0xfc, 0xc3, 0x24, 0x2c, 0x6a, 0x50, 0x96, 0xc4, 0x51, 0x46, 0xc9, 0x1a, 0x4c,
-	0x0d, 0x12, 0x16, 0xf4, 0x69, 0xab, 0x76, 0xbd, 0x76, 0xab, 0xe1, 0xca, 0x27, 0x72, 0x07, 0x96,
-	0xbd, 0x13, 0x2f, 0x08, 0xbd, 0xc3, 0x90, 0x76, 0xe8, 0xf3, 0xee, 0xb1, 0x17, 0xf5, 0x68, 0xd6,
-	0xaa, 0x5f, 0xaf, 0xdd, 0x9a, 0x70, 0x89, 0x1e, 0x7a, 0xa8, 0x46, 0xc8, 0x6b, 0xb0, 0x44, 0x23,
-	0x0e, 0xf2, 0x0d, 0xf4, 0x09, 0x44, 0x6f, 0xca, 0x81, 0x1c, 0xf9, 0x1e, 0xac, 0xf9, 0xf4, 0xc8,
-	0x1b, 0x84, 0xac, 0x73, 0x14, 0xa7, 0xf4, 0x79, 0x27, 0x49, 0xe3, 0x93, 0xc0, 0xa7, 0x69, 0x6b,
-	0x12, 0xa5, 0x58, 0x91, 0xa3, 0xef, 0xf3, 0xc1, 0x3d, 0x39, 0x46, 0xee, 0xc2, 0xaa, 0x9e, 0x15,
-	0x78, 0xac, 0xd3, 0x1d, 0xa4, 0x29, 0x8d, 0xba, 0x67, 0xad, 0x4b, 0x38, 0x69, 0x59, 0x4d, 0x0a,
-	0x3c, 0xb6, 0x23, 0x87, 0xc8, 0x27, 0xd0, 0xcc, 0x06, 0x87, 0xd9, 0x59, 0xc6, 0x68, 0xbf, 0x93,
-	0x31, 0x8f, 0x0d, 0xb2, 0xd6, 0xd4, 0xf5, 0x89, 0x5b, 0xb3, 0x77, 0x5f, 0xdf, 0x12, 0x6a, 0xdc,
-	0x2a, 0xa8, 0x64, 0x6b, 0x5f, 0xe1, 0xef, 0x23, 0xfa, 0xc3, 0x88, 0xa5, 0x67, 0xee, 0x62, 0x66,
-	0x43, 0xc9, 0x47, 0x30, 0x9f, 0x26, 0xdd, 0x0e, 0x8d, 0xfc, 0x24, 0x0e, 0x22, 0x96, 0xb5, 0xa6,
-	0x91, 0xea, 0xed, 0x61, 0x54, 0xdd, 0xa4, 0xfb, 0x50, 0xe1, 0x0a, 0x92, 0x73, 0xa9, 0x01, 0x6a,
-	0xdf, 0x87, 0x95, 0x2a, 0xc6, 0xa4, 0x09, 0x13, 0xcf, 0xe8, 0x99, 0xdc, 0x1d, 0xfe, 0x93, 0xac,
-	0xc0, 0xa5, 0x13, 0x2f, 0x1c, 0x50, 0xdc, 0x8c, 0x19, 0x57, 0x3c, 0x7c, 0xb7, 0xfe, 0x4e, 0xad,
-	0x7d, 0x00, 0x4b, 0x25, 0x36, 0x15, 0x04, 0x6e, 0x9b, 0x04, 0x66, 0xef, 0x2e, 0x2b, 0x91, 0xdd,
-	0xbd, 0x1d, 0x35, 0xd7, 0xa0, 0xea, 0xdc, 0x80, 0xcd, 0x5d, 0xca, 0x76, 0xe2, 0x7e, 0x7f, 0x10,
-	0x05, 0x5d, 0xb4, 0x31, 0x97, 0x86, 0xde, 0x19, 0x4d, 0x33, 0x65, 0x59, 0x1f, 0xc1, 0x4a, 0xd5,
-	0x38, 0x69, 0xc1, 0xb4, 0xdc, 0x7b, 0xe4, 0x3f, 0xe3, 0xaa, 0x47, 0xb2, 0x01, 0x8d, 0x6e, 0x1c,
-	0x45, 0xb4, 0xcb, 0xa8, 0x2f, 0x17, 0x92, 0x03, 0x9c, 0x3f, 0xaa, 0xc3, 0xf5, 0xe1, 0x3c, 0xa5,
-	0xe9, 0x7e, 0x01, 0x6b, 0x5d, 0x13, 0xa1, 0x93, 0x4a, 0x8c, 0x56, 0x0d, 0xb7, 0x62, 0xc7, 0xd8,
-	0x8a, 0x91, 0x94, 0xb6, 0x2a, 0x47, 0xc5, 0x26, 0xad, 0x76, 0xab, 0xc6, 0xda, 0x47, 0xd0, 0x1e,
-	0x3e, 0xa9, 0x42, 0xe5, 0x77, 0x6d, 0x95, 0x6f, 0x28, 0xd1, 0xaa, 0x88, 0x98, 0xba, 0xff, 0x0e,
-	0xac, 0xef, 0xd2, 0x88, 0xa6, 0x41, 0x57, 0x1b, 0x87, 0xd4, 0x39, 0xd7, 0xa0, 0xb6, 0x49, 0xc9,
-	0x2a, 0x07, 0x38, 0x6d, 0x68, 0x95, 0x27, 0x8a, 0xe5, 0x3a, 0x6b, 0xb0, 0xb2, 0x4b, 0x99, 0x86,
-	0xeb, 0x5d, 0xfc, 0x45, 0x0d, 0x56, 0x71, 0x20, 0x3b, 0xcc, 0xce, 0xc4, 0x80, 0x54, 0xf5, 0x6f,
-	0xc3, 0x92, 0x26, 0x9d, 0xa9, 0xd7, 0x48, 0x68, 0xf9, 0x6d, 0x43, 0xcb, 0xe5, 0x99, 0xf9, 0xcb,
-	0x94, 0x99, 0x6f, 0x53, 0xfe, 0x4e, 0x4a, 0x70, 0x7b, 0x07, 0x56, 0x2b, 0x51, 0x2f, 0x62, 0xff,
-	0x4e, 0x0b, 0xd6, 0x76, 0x29, 0x33, 0xcc, 0xd8, 0x30, 0xd0, 0x59, 0x03, 0xcc, 0xed, 0x32, 0x63,
-	0x5e, 0xca, 0x72, 0xbb, 0x94, 0x8f, 0xe4, 0x65, 0x58, 0x08, 0x83, 0x8c, 0xd1, 0xa8, 0xe3, 0xf9,
-	0x7e, 0x4a, 0x33, 0xe1, 0xf2, 0x1a, 0xee, 0xbc, 0x80, 0x6e, 0x0b, 0xa0, 0xf3, 0x8f, 0x35, 0xbe,
-	0x31, 0x05, 0x56, 0x52, 0x59, 0x8f, 0xa1, 0x91, 0x7b, 0x05, 0xa1, 0xa4, 0x2d, 0x43, 0x49, 0x55,
-	0x73, 0xb6, 0x0a, 0xae, 0x21, 0x27, 0xd0, 0xfe, 0x11, 0x2c, 0xbc, 0xe8, 0x17, 0xfa, 0x1d, 0x68,
-	0x4b, 0xdb, 0x50, 0x1e, 0xf9, 0x23, 0xaf, 0x4f, 0x95, 0x5d, 0xb5, 0x61, 0x46, 0x39, 0x70, 0xc9,
-	0x43, 0x3f, 0x3b, 0x57, 0xe1, 0x4a, 0xe5, 0x4c, 0x69, 0x58, 0x77, 0x60, 0x79, 0x97, 0x32, 0xed,
-	0xe6, 0x15, 0xc5, 0xa1, 0x5e, 0xc0, 0xb9, 0x87, 0x96, 0x68, 0x4c, 0x90, 0x2a, 0xdc, 0x80, 0x46,
-	0x7e, 0x88, 0x48, 0xdb, 0xd6, 0x00, 0xe7, 0x2e, 0x9a, 0xa9, 0x9a, 0xf5, 0xe4, 0x60, 0xcf, 0xa5,
-	0x62, 0xda, 0x65, 0x98, 0x89, 0x59, 0xd2, 0xe9, 0xc6, 0xbe, 0x12, 0x7d, 0x3a, 0x66, 0xc9, 0x4e,
-	0xec, 0x53, 0x69, 0x1a, 0xc6, 0x1c, 0x6d, 0x1a, 0x7f, 0x2d, 0xb6, 0xd2, 0x1e, 0x92, 0x72, 0xfc,
-	0x00, 0x1a, 0x8a, 0xa0, 0xda, 0xca, 0x37, 0x8c, 0xad, 0xac, 0x9a, 0xb3, 0xf5, 0x44, 0x70, 0x94,
-	0x3b, 0x39, 0x23, 0x05, 0xc8, 0xda, 0xef, 0xc2, 0xbc, 0x35, 0x74, 0x9e, 0x65, 0x37, 0xcc, 0x2d,
-	0xbb, 0x07, 0x6b, 0x0f, 0x82, 0xcc, 0x3c, 0x71, 0xc7, 0xd9, 0xae, 0xcf, 0x60, 0x61, 0xcf, 0x0b,
-	0xd2, 0x6c, 0x7f, 0x90, 0x24, 0x31, 0x9a, 0xf7, 0x2b, 0xb0, 0x98, 0x1f, 0xeb, 0x09, 0x1f, 0x93,
-	0x93, 0x16, 0x34, 0x18, 0x67, 0x90, 0x97, 0x60, 0x5e, 0x1d, 0xe7, 0x02, 0x4d, 0x88, 0x34, 0x27,
-	0x81, 0x88, 0xe4, 0x7c, 0x39, 0x69, 0xa9, 0xce, 0x0a, 0x2c, 0x08, 0x4c, 0x46, 0x9e, 0x0e, 0x2b,
-	0xf0, 0xb7, 0x69, 0x08, 0x75, 0xfb, 0x38, 0x68, 0xc1, 0xf4, 0x09, 0x4d, 0x0f, 0xe3, 0x8c, 0x62,
-	0xcc, 0x30, 0xe3, 0xaa, 0x47, 0x2e, 0xc8, 0x20, 0x0b, 0xa2, 0x5e, 0x27, 0xf3, 0x22, 0xff, 0x30,
-	0x7e, 0x8e, 0x11, 0xc2, 0x8c, 0x3b, 0x87, 0xc0, 0x7d, 0x01, 0x23, 0x37, 0x60, 0xee, 0x98, 0xb1,
-	0xa4, 0xc3, 0x43, 0x97, 0x78, 0xc0, 0x64, 0x40, 0x30, 0xcb, 0x61, 0x07, 0x02, 0xc4, 0x5f, 0x6c,
-	0x44, 0x19, 0x64, 0x34, 0xf5, 0x7a, 0x34, 0x62, 0xad, 0x29, 0xf1, 0x62, 0x73, 0xe8, 0xc7, 0x0a,
-	0x48, 0xae, 0x02, 0x20, 0x5a, 0x92, 0xc6, 0xcf, 0xcf, 0x5a, 0xd3, 0xc2, 0xf4, 0x38, 0x64, 0x8f,
-	0x03, 0xb8, 0xfe, 0x0e, 0xbd, 0x8c, 0xaa, 0xd0, 0x23, 0xa0, 0x59, 0x6b, 0x46, 0xe8, 0x8f, 0x83,
-	0x77, 0x34, 0x94, 0x74, 0x78, 0xdc, 0x21, 0xb5, 0xde, 0xf1, 0xb2, 0x8c, 0xb2, 0xac, 0xd5, 0x40,
-	0x03, 0xba, 0x57, 0x61, 0x40, 0x85, 0xf8, 0x43, 0xce, 0xdb, 0xc6, 0x69, 0x3a, 0xfe, 0xb0, 0xa0,
-	0x3c, 0xde, 0xf2, 0x06, 0xec, 0x98, 0x46, 0x8c, 0x9f, 0x1e, 0x9c, 0x49, 0x12, 0xb4, 0x00, 0x75,
-	0xd3, 0xb4, 0x06, 0xb6, 0x93, 0xa0, 0xfd, 0x29, 0x0f, 0x2e, 0xca, 0x54, 0x2b, 0x4c, 0xf0, 0x75,
-	0xdb, 0x95, 0xac, 0x29, 0x61, 0x6d, 0x3b, 0x32, 0x4d, 0xf3, 0x14, 0x9a, 0xbb, 0x94, 0x1d, 0x04,
-	0xdd, 0x67, 0x34, 0x1d, 0xc3, 0x28, 0xc9, 0x2d, 0x98, 0xe4, 0x16, 0x25, 0x19, 0xac, 0xe8, 0x93,
-	0x50, 0x46, 0x6c, 0x9c, 0x91, 0x8b, 0x18, 0x7c, 0x2f, 0x50, 0x73, 0x1d, 0x76, 0x96, 0x08, 0xbb,
-	0x68, 0xb8, 0x0d, 0x84, 0x1c, 0x9c, 0x25, 0xd4, 0x79, 0x0a, 0x73, 0xe6, 0x24, 0xee, 0x34, 0x7c,
-	0x1a, 0x06, 0xfd, 0x80, 0xd1, 0x54, 0x39, 0x0d, 0x0d, 0xe0, 0xf6, 0xc8, 0xb7, 0x48, 0xda, 0x31,
-	0xfe, 0xe6, 0xef, 0xdb, 0xe7, 0x83, 0x98, 0x29, 0xda, 0xe2, 0xc1, 0xf9, 0xb3, 0x3a, 0x2c, 0xa8,
-	0xe5, 0x48, 0x63, 0x56, 0x32, 0xd7, 0xce, 0x95, 0xf9, 0x06, 0xcc, 0x85, 0x5e, 0xc6, 0x3a, 0x83,
-	0xc4, 0xf7, 0x54, 0x68, 0x33, 0xe1, 0xce, 0x72, 0xd8, 0xc7, 0x02, 0xc4, 0x2d, 0x5a, 0x45, 0xae,
-	0xf8, 0x6e, 0x49, 0xee, 0x73, 0x5d, 0x73, 0x31, 0x04, 0x26, 0xf9, 0x1c, 0xb4, 0xf6, 0x9a, 0x8b,
-	0xbf, 0x39, 0xec, 0x38, 0xe8, 0x1d, 0xa3, 0x75, 0xd7, 0x5c, 0xfc, 0xcd, 0x77, 0x30, 0x8c, 0x4f,
-	0xd1, 0x96, 0x6b, 0x2e, 0xff, 0xc9, 0x21, 0x87, 0x81, 0x8f, 0xa6, 0x5b, 0x73, 0xf9, 0x4f, 0x0e,
-	0xf1, 0xb2, 0x67, 0x68, 0xa8, 0x35, 0x97, 0xff, 0xe4, 0x51, 0xff, 0x49, 0x1c, 0x0e, 0xfa, 0xb4,
-	0xd5, 0x40, 0xa0, 0x7c, 0x22, 0x57, 0xa0, 0x91, 0xa4, 0x41, 0x97, 0x76, 0x3c, 0x76, 0x8c, 0xc6,
-	0x54, 0x73, 0x67, 0x10, 0xb0, 0xcd, 0x8e, 0x9d, 0x65, 0x58, 0xd2, 0x1b, 0xad, 0xbd, 0xe7, 0x27,
-	0x30, 0x2d, 0x21, 0x23, 0x37, 0xfd, 0x4d, 0x98, 0x66, 0x02, 0xad, 0x55, 0xc7, 0xb7, 0x40, 0x1b,
-	0x96, 0xad, 0x69, 0x57, 0xa1, 0x39, 0xdf, 0x07, 0x62, 0x72, 0x93, 0x1b, 0x71, 0x3b, 0xa7, 0x23,
-	0xdc, 0xf1, 0xa2, 0x4d, 0x27, 0xcb, 0x09, 0x7c, 0x81, 0x87, 0xd1, 0x93, 0xd4, 0xe7, 0x8e, 0x24,
-	0x7e, 0xf6, 0x8d, 0x9a, 0xe6, 0x0f, 0x61, 0x5e, 0x33, 0x7e, 0xc4, 0x68, 0x9f, 0x2b, 0xdc, 0xeb,
-	0xc7, 0x83, 0x88, 0x21, 0xcf, 0x9a, 0x2b, 0x9f, 0xb8, 0x05, 0xa2, 0x7e, 0x91, 0x65, 0xcd, 0x15,
-	0x0f, 0x64, 0x01, 0xea, 0x81, 0x2f, 0x93, 0xa7, 0x7a, 0xe0, 0x3b, 0xff, 0x57, 0x83, 0x25, 0x63,
-	0x21, 0x17, 0x36, 0xca, 0x92, 0xc5, 0xd5, 0x2b, 0x2c, 0xee, 0x36, 0x4c, 0x1e, 0x06, 0x3e, 0xcf,
-	0xd9, 0xb8, 0x5e, 0x57, 0x15, 0x39, 0x6b, 0x1d, 0x2e, 0xa2, 0x70, 0x54, 0x2f, 0x7b, 0x96, 0xb5,
-	0x26, 0x47, 0xa2, 0x72, 0x94, 0xd2, 0xfb, 0x70, 0xa9, 0xfc, 0x3e, 0xd8, 0xba, 0x9c, 0x2a, 0xea,
-	0x52, 0x44, 0xab, 0x9a, 0xb6, 0xb6, 0xbc, 0x2e, 0x40, 0x0e, 0x1c, 0xb9, 0xad, 0xbf, 0x0e, 0x10,
-	0x6b, 0x4c, 0x69, 0x7f, 0x97, 0x4b, 0x42, 0x6b, 0x13, 0x34, 0x90, 0x9d, 0x0f, 0x31, 0xd4, 0x30,
-	0x99, 0x4b, 0xe5, 0xdf, 0xb5, 0x68, 0x0a, 0x5b, 0x24, 0x25, 0x9a, 0x99, 0x45, 0xec, 0x6d, 0x24,
-	0xb6, 0xdd, 0xed, 0xf2, 0xad, 0x37, 0x12, 0xf3, 0x91, 0x67, 0xf8, 0x53, 0x98, 0x96, 0x33, 0xa4,
-	0x59, 0x08, 0x84, 0x7a, 0xe0, 0x93, 0x77, 0x01, 0x8c, 0x73, 0x48, 0xac, 0xeb, 0x8a, 0x92, 0x41,
-	0x4e, 0x52, 0xd6, 0x80, 0xec, 0x0c, 0x74, 0xe7, 0x08, 0x96, 0x2b, 0x50, 0xb8, 0x28, 0x3a, 0xad,
-	0x96, 0xa2, 0xa8, 0x67, 0xb2, 0x09, 0xb3, 0x2c, 0x66, 0x5e, 0xd8, 0xc9, 0x4f, 0x88, 0x9a, 0x0b,
-	0x08, 0x7a, 0xca, 0x21, 0xe8, 0xa0, 0xe2, 0x50, 0x58, 0x2e, 0x77, 0x50, 0x71, 0xe8, 0x3b, 0x1e,
-	0x06, 0x5e, 0xd6, 0xa2, 0xa5, 0x0a, 0x47, 0x6d, 0xd9, 0x6b, 0x30, 0xe3, 0x89, 0x29, 0x6a, 0x61,
-	0x8b, 0x85, 0x85, 0xb9, 0x1a, 0xc1, 0x21, 0x78, 0x02, 0xed, 0xc4, 0xd1, 0x51, 0xd0, 0x53, 0xd6,
-	0xf1, 0x0a, 0x3a, 0x2b, 0x05, 0xcb, 0x63, 0x12, 0xdf, 0x63, 0x1e, 0x72, 0x9b, 0x73, 0xf1, 0xb7,
-	0xf3, 0x87, 0x35, 0x68, 0xee, 0xc5, 0x29, 0x3b, 0x8a, 0xc3, 0x20, 0x96, 0xe1, 0x3d, 0x0f, 0x47,
-	0x54, 0xf8, 0x2f, 0xe3, 0x48, 0xf9, 0xc8, 0x3d, 0x64, 0x37, 0x0e, 0x22, 0x61, 0xab, 0x75, 0xa9,
-	0xa0, 0x38, 0x88, 0xb8, 0xa9, 0x92, 0xeb, 0x30, 0xeb, 0xd3, 0xac, 0x9b, 0x06, 0x09, 0x4f, 0xe7,
-	0xa4, 0x5b, 0x30, 0x41, 0x9c, 0xf0, 0xa1, 0x17, 0x7a, 0x51, 0x97, 0x4a, 0xcf, 0xae, 0x1e, 0x9d,
-	0x55, 0x74, 0x57, 0x5a, 0x12, 0x23, 0xb3, 0xb6, 0xc1, 0x72, 0x29, 0xbf, 0x06, 0x8d, 0x44, 0x01,
-	0xa5, 0xf9, 0xb5, 0xf4, 0x59, 0x5d, 0x58, 0x8e, 0x9b, 0xa3, 0x3a, 0x1b, 0x3c, 0xf6, 0xcf, 0xe9,
-	0xed, 0x0f, 0xfa, 0x7d, 0x2f, 0x3d, 0x53, 0xdc, 0x22, 0x98, 0xdc, 0x89, 0x83, 0x88, 0x2b, 0x8a,
-	0x2f, 0x4a, 0x05, 0x6f, 0xfc, 0xb7, 0x29, 0x7a, 0xdd, 0x12, 0xdd, 0xd4, 0xd6, 0x84, 0xad, 0xad,
-	0x6b, 0x00, 0x09, 0x4d, 0xbb, 0x34, 0x62, 0x5e, 0x4f, 0xad, 0xd8, 0x80, 0x38, 0xc7, 0x40, 0x9e,
-	0x1c, 0x1d, 0x85, 0x41, 0x44, 0x39, 0x5b, 0x29, 0xcc, 0x08, 0xed, 0x0f, 0x97, 0xc1, 0xe6, 0x34,
-	0x51, 0xe2, 0xf4, 0x43, 0x58, 0x7a, 0x12, 0x55, 0x30, 0x52, 0xe4, 0x6a, 0xa3, 0xc8, 0xd5, 0x4b,
-	0xe4, 0x3e, 0x80, 0x39, 0x43, 0xf0, 0x8c, 0xbc, 0x03, 0x0d, 0x29, 0xa3, 0x4e, 0x14, 0xda, 0xda,
-	0x1b, 0x94, 0x56, 0xe8, 0xe6, 0xc8, 0xce, 0x9f, 0xd7, 0x60, 0x36, 0x97, 0x2c, 0x23, 0xf7, 0xe0,
-	0x12, 0x57, 0xb7, 0xa2, 0x72, 0x4d, 0x53, 0xc9, 0x71, 0xb6, 0xf0, 0x5f, 0x11, 0x17, 0x0a, 0xe4,
-	0xf6, 0x3e, 0x40, 0x0e, 0xac, 0x08, 0xeb, 0xee, 0xd8, 0x61, 0xdd, 0xe5, 0x32, 0x55, 0x25, 0x9a,
-	0x11, 0xd9, 0xfd, 0xcb, 0x24, 0x4f, 0xf7, 0x2a, 0x8c, 0x45, 0xda, 0xe0, 0x1b, 0x30, 0x2b, 0xde,
-	0x05, 0xee, 0x01, 0x94, 0xc0, 0x73, 0xf9, 0xd5, 0x46, 0x10, 0xb9, 0x80, 0xef, 0x06, 0x8e, 0x93,
-	0xb7, 0x60, 0x1e, 0x85, 0xed, 0xc4, 0x42, 0x21, 0xf2, 0xc5, 0xb6, 0x27, 0xcc, 0x21, 0x8a, 0x54,
-	0x19, 0x49, 0x60, 0xd5, 0x9a, 0xd2, 0xc9, 0x84, 0x08, 0xf2, 0x90, 0x7a, 0xcf, 0x08, 0xa5, 0x87,
-	0x49, 0x29, 0x94, 0x25, 0x09, 0xca, 0x31, 0xa1, 0xba, 0xe5, 0x6e, 0x79, 0x84, 0xdc, 0x81, 0x39,
-	0xc9, 0x11, 0x35, 0x23, 0x8f, 0x38, 0x5b, 0xc6, 0x59, 0x31, 0x11, 0x11, 0x48, 0x1f, 0x56, 0xcc,
-	0x09, 0x5a, 0xc2, 0x4b, 0x38, 0xf1, 0xdd, 0xf1, 0x25, 0x8c, 0x4a, 0x02, 0x92, 0x6e, 0x69, 0xa0,
-	0xfd, 0x5b, 0xd0, 0x1a, 0xb6, 0xa0, 0x8a, 0x6d, 0x7f, 0xd5, 0xde, 0xf6, 0x95, 0x0a, 0x93, 0xcc,
-	0xcc, 0x0b, 0xc4, 0x4f, 0x61, 0x7d, 0x88, 0x30, 0x17, 0xb8, 0x75, 0x30, 0x2c, 0xd5, 0xb4, 0xa6,
-	0x3f, 0xad, 0x41, 0x7b, 0xdb, 0xf7, 0x4b, 0xce, 0x29, 0xbf, 0x24, 0xf8, 0xa6, 0x5d, 0xee, 0x55,
-	0xb8, 0x52, 0x29, 0x90, 0xbc, 0xcd, 0x78, 0x0e, 0x57, 0x5d, 0xda, 0x8f, 0x4f, 0xe8, 0x37, 0x2d,
-	0xb2, 0x73, 0x1d, 0xae, 0x0d, 0xe3, 0x2c, 0x65, 0xc3, 0xeb, 0x3d, 0xfb, 0x7a, 0x5c, 0x07, 0x46,
-	0xff, 0x59, 0x83, 0x79, 0xfb, 0xe2, 0xfc, 0x45, 0xe5, 0xe2, 0xaf, 0x03, 0x49, 0x69, 0xc6, 0x3a,
-	0x49, 0x1c, 0x86, 0x3c, 0x25, 0xf7, 0x69, 0xe8, 0x9d, 0xc9, 0x2b, 0xfb, 0x26, 0x1f, 0xd9, 0x13,
-	0x03, 0x0f, 0x38, 0x9c, 0xac, 0xc3, 0xb4, 0x97, 0x04, 0x1d, 0x6e, 0x35, 0x22, 0x1f, 0x9f, 0xf2,
-	0x92, 0xe0, 0x43, 0x7a, 0x46, 0x1c, 0x98, 0x97, 0x03, 0x9d, 0x90, 0x9e, 0xd0, 0x10, 0x63, 0xbe,
-	0x09, 0x77, 0x56, 0x0c, 0x3f, 0xe6, 0x20, 0x72, 0x1b, 0x9a, 0x49, 0x1a, 0x70, 0xf3, 0xcb, 0x6b,
-	0x03, 0xd3, 0x28, 0xcd, 0xa2, 0x84, 0xab, 0xd5, 0x39, 0x3f, 0x86, 0xcb, 0x15, 0xba, 0x90, 0x3e,
-	0xea, 0x7b, 0xb0, 0x68, 0x57, 0x18, 0x94, 0x9f, 0xd2, 0x51, 0xab, 0x35, 0xd1, 0x5d, 0x38, 0xb2,
-	0xe8, 0xc8, 0xe8, 0x13, 0x71, 0x5c, 0x8f, 0xe9, 0x3b, 0x2d, 0xe7, 0x73, 0x58, 0xc9, 0x81, 0x3b,
-	0x71, 0x74, 0x42, 0xd3, 0x8c, 0x5b, 0x1b, 0x81, 0xc9, 0xa3, 0x34, 0x56, 0x17, 0xb2, 0xf8, 0x9b,
-	0xc7, 0x6d, 0x2c, 0x96, 0x66, 0x50, 0x67, 0x31, 0xc7, 0x49, 0x3d, 0xa6, 0x4e, 0x29, 0xfc, 0xcd,
-	0xe3, 0xe4, 0x00, 0x89, 0xd0, 0x0e, 0x8e, 0x09, 0x53, 0x9d, 0x95, 0x30, 0xce, 0xc5, 0x79, 0x8a,
-	0xe1, 0xa3, 0x29, 0x8a, 0x5c, 0xe3, 0x6f, 0xc0, 0xac, 0x58, 0x23, 0x9f, 0xa9, 0xd6, 0xb7, 0x61,
-	0xad, 0xaf, 0x20, 0xa6, 0x0b, 0x47, 0x1a, 0xea, 0xfc, 0x77, 0x1d, 0xe6, 0x30, 0x62, 0x7d, 0x40,
-	0x99, 0x17, 0x84, 0xa3, 0x63, 0x69, 0x11, 0x83, 0xd6, 0x75, 0x0c, 0xfa, 0x12, 0xcc, 0x9b, 0x17,
-	0x22, 0x67, 0x2a, 0x99, 0x35, 0xae, 0x43, 0xce, 0xc8, 0xcb, 0xb0, 0x80, 0xa9, 0x75, 0x8e, 0x25,
-	0x6c, 0x66, 0x1e, 0xa1, 0x1a, 0xcd, 0x4e, 0x04, 0x2e, 0x15, 0x12, 0x01, 0x3e, 0x8c, 0xc1, 0x74,
-	0x27, 0x0b, 0x7c, 0x9d, 0x27, 0x20, 0x64, 0x3f, 0xf0, 0x8d, 0x61, 0x9c, 0x3d, 0x6d, 0x0c, 0xe3,
-	0x6c, 0x9e, 0x03, 0xa5, 0x54, 0x14, 0x0a, 0xb0, 0xde, 0x35, 0x83, 0x46, 0x37, 0xa7, 0x80, 0x07,
-	0x41, 0x1f, 0xab, 0x61, 0xf2, 0x72, 0xbb, 0x21, 0x2c, 0x56, 0x3c, 0xe5, 0x69, 0x1a, 0x98, 0x69,
-	0x5a, 0x9e, 0xd4, 0xcd, 0x5a, 0x49, 0xdd, 0x26, 0xcc, 0xc6, 0x09, 0x8d, 0x3a, 0x32, 0xc5, 0x9e,
-	0x13, 0xd1, 0x03, 0x07, 0x3d, 0x45, 0x88, 0xbc, 0x32, 0x41, 0x9d, 0x67, 0xe3, 0xe4, 0xa5, 0xb6,
-	0x62, 0xea, 0x45, 0xc5, 0xa8, 0x44, 0x70, 0xe2, 0xbc, 0x44, 0xd0, 0xd9, 0xc6, 0xa8, 0x58, 0x31,
-	0x96, 0xe6, 0xf3, 0x3a, 0x4c, 0xa1, 0x9a, 0x94, 0xe5, 0xac, 0x58, 0x69, 0x8c, 0x34, 0x0a, 0x57,
-	0xe2, 0x38, 0x1f, 0x60, 0x0d, 0x11, 0x87, 0xc6, 0x11, 0xfd, 0x32, 0xcc, 0x88, 0x5d, 0xd1, 0x56,
-	0x33, 0x8d, 0xcf, 0x8f, 0x7c, 0xe7, 0xdf, 0x6a, 0x40, 0xf6, 0x07, 0x87, 0xfd, 0x60, 0x7c, 0x6a,
-	0xe3, 0x27, 0xe8, 0x04, 0x26, 0xd1, 0x4c, 0x84, 0x39, 0xe2, 0xef, 0x82, 0x85, 0x4c, 0x16, 0x2d,
-	0x24, 0xdf, 0xce, 0x4b, 0xd5, 0x39, 0xfa, 0x94, 0xb9, 0xf9, 0xdc, 0xc5, 0x87, 0x01, 0x8d, 0x58,
-	0x47, 0x5e, 0xb6, 0x70, 0x17, 0x8f, 0x80, 0x47, 0xbe, 0xb3, 0x0f, 0xcb, 0xd6, 0xca, 0xa4, 0xa6,
-	0x6f, 0xc0, 0x9c, 0x10, 0x20, 0x09, 0xbd, 0xae, 0xbe, 0x0d, 0x9f, 0x45, 0xd8, 0x1e, 0x82, 0x46,
-	0xe9, 0xeb, 0x8f, 0x6b, 0xb0, 0xb2, 0x1f, 0xf4, 0x07, 0xa1, 0xc7, 0xe8, 0x2f, 0x41, 0x63, 0xf9,
-	0xf2, 0x27, 0xac, 0xe5, 0x2b, 0x4d, 0x4e, 0xe6, 0x9a, 0x74, 0xfe, 0xa7, 0x06, 0xab, 0x05, 0x51,
-	0x74, 0x4c, 0x68, 0x1b, 0xd3, 0x90, 0xcb, 0x01, 0x89, 0x64, 0x30, 0xad, 0x5b, 0x4c, 0x5f, 0x82,
-	0xf9, 0x7e, 0x10, 0x05, 0xfd, 0x41, 0xbf, 0x23, 0x74, 0x2f, 0x64, 0x9a, 0x93, 0xc0, 0x3d, 0xdc,
-	0x02, 0x8e, 0xe4, 0x3d, 0x37, 0x90, 0x26, 0x25, 0x92, 0x00, 0x0a, 0xa4, 0x37, 0x61, 0x25, 0x8f,
-	0xdb, 0x3b, 0x3d, 0x2f, 0x88, 0x3a, 0x61, 0x9c, 0x65, 0x72, 0x8f, 0x49, 0x3e, 0xb6, 0xeb, 0x05,
-	0xd1, 0xe3, 0x38, 0xcb, 0x0c, 0x27, 0x30, 0x65, 0x3a, 0x01, 0x1e, 0xc0, 0x34, 0x3f, 0x39, 0xf6,
-	0x42, 0x7a, 0x3f, 0xee, 0x1f, 0xbe, 0x58, 0xdd, 0xdf, 0x80, 0x39, 0x71, 0xef, 0xc6, 0xbc, 0xb4,
-	0x47, 0xd5, 0x0e, 0xcc, 0x22, 0xec, 0x00, 0x41, 0x95, 0xdb, 0xf0, 0x5f, 0x35, 0x20, 0x3b, 0x3c,
-	0x94, 0x09, 0xc7, 0xb6, 0x07, 0xee, 0x4a, 0x44, 0xde, 0x9c, 0x5b, 0x58, 0x43, 0x42, 0x1e, 0xd9,
-	0xe6, 0x37, 0x61, 0x99, 0x9f, 0x5e, 0xcd, 0xe4, 0x05, 0x2f, 0xc7, 0x4a, 0x7e, 0xfc, 0x65, 0x58,
-	0x38, 0xf5, 0xc2, 0x90, 0x32, 0x5d, 0x62, 0x93, 0x37, 0xf1, 0x02, 0xaa, 0x72, 0x70, 0xb5, 0xe0,
-	0x69, 0x63, 0xc1, 0xab, 0xb0, 0x6c, 0xad, 0x57, 0x46, 0x43, 0xf7, 0x60, 0x4d, 0x80, 0xb7, 0xc3,
-	0x70, 0x6c, 0xaf, 0xea, 0xfc, 0x65, 0x1d, 0xd6, 0x4b, 0xd3, 0x74, 0xd8, 0x60, 0x9b, 0xf1, 0x4d,
-	0xbd, 0xdc, 0xea, 0x09, 0x5b, 0xf2, 0x51, 0xce, 0x6a, 0xff, 0x53, 0x0d, 0xa6, 0x04, 0x68, 0xe4,
-	0x6e, 0x7c, 0xaa, 0x1c, 0x82, 0x34, 0x38, 0x91, 0x11, 0x7d, 0x67, 0x3c, 0x66, 0xe2, 0x3f, 0xb3,
-	0xac, 0x2a, 0x3c, 0x89, 0xac, 0xa8, 0x7e, 0x0f, 0x9a, 0x45, 0x84, 0x0b, 0x95, 0x9c, 0xc4, 0xad,
-	0xca, 0xc3, 0x13, 0x6a, 0x94, 0x51, 0x7f, 0x51, 0x83, 0xc5, 0x9d, 0x38, 0xf2, 0x03, 0x7e, 0x62,
-	0xee, 0x79, 0xa9, 0xd7, 0xcf, 0x64, 0x25, 0x5f, 0x80, 0xd4, 0xb5, 0xbb, 0x06, 0x0c, 0xb9, 0xe0,
-	0xbc, 0x0a, 0xd0, 0x3d, 0xa6, 0xdd, 0x67, 0x1d, 0x79, 0xe3, 0x28, 0xca, 0xff, 0x1c, 0x72, 0x3f,
-	0xf0, 0x33, 0xf2, 0x06, 0x2c, 0xe7, 0xc3, 0x1d, 0x2f, 0xf2, 0x3b, 0xf2, 0xba, 0x11, 0xab, 0x1b,
-	0x1a, 0x6f, 0x3b, 0xf2, 0xb7, 0xb3, 0x67, 0x19, 0x8f, 0x15, 0xf5, 0x2d, 0x5b, 0xc7, 0x72, 0xe1,
-	0x8b, 0x1a, 0xbe, 0x8d, 0x60, 0xe7, 0x7f, 0x6b, 0x78, 0x02, 0xaa, 0x55, 0xc9, 0xdd, 0xce, 0x2f,
-	0xd6, 0xf0, 0xbe, 0xd5, 0xda, 0xb2, 0x7a, 0x61, 0xcb, 0x08, 0x4c, 0x06, 0x8c, 0xf6, 0xd5, 0xc1,
-	0xc2, 0x7f, 0x93, 0xfb, 0xd0, 0xd4, 0x2b, 0xee, 0x24, 0xa8, 0x16, 0xf9, 0x9a, 0xac, 0xe7, 0x89,
-	0xa3, 0xa5, 0x35, 0x77, 0xb1, 0x5b, 0x50, 0xa3, 0x7a, 0xbd, 0x2e, 0x8d, 0xe5, 0xa8, 0xbb, 0xa8,
-	0x6d, 0xe9, 0x9f, 0xc4, 0x93, 0x90, 0x9a, 0x76, 0x07, 0x8c, 0xfa, 0x32, 0x54, 0xd6, 0xcf, 0xce,
-	0x7f, 0xd4, 0x60, 0x71, 0xdb, 0xf7, 0x71, 0xdd, 0xe3, 0xb8, 0x09, 0xb5, 0xca, 0xfa, 0x39, 0xab,
-	0x9c, 0xf8, 0x8a, 0xab, 0xfc, 0xda, 0x4e, 0x64, 0x88, 0x12, 0x1c, 0x07, 0x9a, 0xf9, 0x3a, 0xab,
-	0xb7, 0xd7, 0xf9, 0x16, 0x10, 0x91, 0x5e, 0x59, 0xea, 0x28, 0x62, 0xad, 0xc2, 0xb2, 0x85, 0x25,
-	0x7d, 0xcd, 0xfb, 0x70, 0x6b, 0x97, 0xb2, 0x9d, 0xf4, 0x2c, 0x61, 0xb1, 0x0a, 0x67, 0x1f, 0xd0,
-	0x24, 0xce, 0x02, 0xe5, 0xb9, 0xe8, 0x58, 0xde, 0xe7, 0x9f, 0x6b, 0x70, 0x7b, 0x0c, 0x42, 0x72,
-	0x09, 0x9f, 0x95, 0xef, 0x97, 0x7e, 0xd3, 0x6c, 0x6f, 0x19, 0x8b, 0xca, 0x96, 0x86, 0xc8, 0x2e,
-	0x03, 0x4d, 0xb2, 0xfd, 0x1e, 0x2c, 0xd8, 0x83, 0x17, 0x72, 0x15, 0x21, 0xdc, 0x3c, 0x47, 0x88,
-	0x71, 0x6c, 0xee, 0x26, 0x2c, 0x74, 0x2d, 0x12, 0x92, 0x51, 0x01, 0xea, 0xec, 0xc0, 0x2b, 0xe7,
-	0x72, 0x93, 0x6a, 0x1b, 0x9a, 0xa1, 0x3b, 0x7f, 0x37, 0x09, 0xeb, 0x9f, 0x04, 0xec, 0xd8, 0x4f,
-	0xbd, 0x53, 0x65, 0x7d, 0xe3, 0x08, 0x59, 0x48, 0xde, 0xeb, 0xe5, 0xfb, 0x86, 0x57, 0x61, 0x29,
-	0x8e, 0x28, 0xe6, 0x18, 0x9d, 0xc4, 0xcb, 0xb2, 0xd3, 0x38, 0x55, 0x67, 0xe9, 0x62, 0x1c, 0x51,
-	0x9e, 0x67, 0xec, 0x49, 0x70, 0xe1, 0x34, 0x9e, 0x2c, 0x9e, 0xc6, 0x4d, 0x98, 0x48, 0x82, 0x48,
-	0xd6, 0x4c, 0xf8, 0x4f, 0x7e, 0x76, 0xb2, 0xd4, 0xf3, 0x0d, 0xca, 0xf2, 0xec, 0x44, 0xa8, 0xa6,
-	0x6b, 0xde, 0xe2, 0x4f, 0x17, 0x6e, 0xf1, 0x0d, 0x9d, 0xcc, 0xd8, 0xb7, 0x16, 0x9b, 0x30, 0x2b,
-	0x7f, 0x76, 0x98, 0xd7, 0x93, 0x29, 0x10, 0x48, 0xd0, 0x81, 0xd7, 0x33, 0xa2, 0x35, 0xb0, 0xa2,
-	0xb5, 0xab, 0x00, 0x47, 0x94, 0x76, 0xac, 0x64, 0xa8, 0x71, 0x44, 0xa9, 0x70, 0xba, 0x3c, 0x54,
-	0x3e, 0xf4, 0xa2, 0x67, 0x1d, 0xbc, 0x83, 0x98, 0x13, 0xe2, 0x70, 0xc0, 0x47, 0x5e, 0x1f, 0x63,
-	0x62, 0x1c, 0x54, 0x32, 0xcd, 0x0b, 0x8d, 0x72, 0xd8, 0x76, 0x7e, 0x9b, 0x82, 0x28, 0xdd, 0x80,
-	0x9d, 0xb5, 0x16, 0xf2, 0xf9, 0x3b, 0x01, 0x3b, 0xd3, 0xf3, 0x51, 0x67, 0xe9, 0x59, 0x6b, 0x31,
-	0x9f, 0xbf, 0x23, 0x40, 0x5c, 0xbc, 0xec, 0x34, 0x38, 0xa2, 0xa2, 0x31, 0xa4, 0x29, 0x5b, 0xa5,
-	0x38, 0x64, 0x27, 0xf6, 0x31, 0x8c, 0x3c, 0x0d, 0x52, 0x23, 0x39, 0x5d, 0x12, 0x29, 0x2c, 0x07,
-	0x2a, 0xd3, 0x70, 0x5e, 0x85, 0xa6, 0x32, 0x17, 0xb3, 0x77, 0x32, 0xa5, 0xd9, 0x20, 0x64, 0xaa,
-	0x77, 0x52, 0x3c, 0x39, 0x6f, 0x61, 0x57, 0xc4, 0xe3, 0xb8, 0xd7, 0xcb, 0xd3, 0x27, 0x69, 0x5a,
-	0x6b, 0x30, 0x15, 0x22, 0x5c, 0x4d, 0x11, 0x4f, 0x4e, 0x84, 0xf7, 0x39, 0x85, 0x29, 0x79, 0xd5,
-	0x22, 0x88, 0x8e, 0x62, 0x99, 0x2d, 0xe0, 0x6f, 0xfe, 0x2e, 0xfa, 0xf4, 0x70, 0xd0, 0x53, 0x3d,
-	0x50, 0xf8, 0xc0, 0x31, 0x4f, 0xbd, 0x34, 0x92, 0x07, 0x2a, 0xfe, 0xe6, 0x98, 0x34, 0x4d, 0xe3,
-	0x54, 0x9e, 0x9e, 0xe2, 0xc1, 0xd9, 0x85, 0xf5, 0xfd, 0x8b, 0x89, 0xc8, 0x09, 0x89, 0xdb, 0x1a,
-	0xf9, 0xfa, 0xe3, 0x83, 0xf3, 0xa1, 0xd5, 0x01, 0x82, 0x5d, 0x02, 0xe3, 0xbc, 0x46, 0x2b, 0x70,
-	0x09, 0x7d, 0xb9, 0x22, 0x86, 0x0f, 0x3c, 0x23, 0x6c, 0x95, 0xa9, 0xe9, 0x1e, 0xb4, 0x72, 0x47,
-	0x85, 0xf0, 0x84, 0xdf, 0xae, 0xe8, 0xa8, 0xb0, 0xe6, 0x8e, 0xd7, 0x52, 0xf1, 0x4b, 0xed, 0x92,
-	0xf8, 0x02, 0x96, 0x4d, 0xd1, 0xbe, 0xd1, 0xac, 0xff, 0xa7, 0x35, 0xbc, 0x21, 0xd3, 0x19, 0xd8,
-	0x3e, 0x4b, 0xa9, 0xd7, 0xff, 0x46, 0x0b, 0xe2, 0xdf, 0x87, 0x1b, 0x66, 0xbf, 0xd4, 0x85, 0x25,
-	0x71, 0x7e, 0x0f, 0xcb, 0x88, 0xa2, 0xc8, 0xff, 0x2b, 0x90, 0xff, 0x3d, 0xb8, 0x66, 0xc8, 0x7f,
-	0x41, 0x31, 0x9c, 0xbf, 0xa8, 0xe1, 0x2d, 0xe2, 0xf6, 0xc0, 0x0f, 0x98, 0x15, 0x73, 0x70, 0xcf,
-	0xc4, 0xbc, 0x94, 0x75, 0x7c, 0x8f, 0x51, 0xdd, 0xc4, 0xc9, 0x21, 0x0f, 0x3c, 0x86, 0x97, 0x27,
-	0x34, 0xf2, 0xc5, 0xa0, 0xbc, 0x0c, 0xa0, 0x91, 0xaf, 0x86, 0x44, 0xe6, 0x70, 0x78, 0x66, 0x25,
-	0x6a, 0xf7, 0xf1, 0x9c, 0xc6, 0xa6, 0x17, 0x7c, 0xe3, 0x2f, 0xb9, 0xe2, 0x81, 0xbf, 0xd6, 0xf1,
-	0xd1, 0x11, 0x7f, 0xe5, 0x2e, 0x21, 0x58, 0x3e, 0x39, 0x3b, 0xa2, 0x28, 0x6d, 0x88, 0x26, 0xdf,
-	0xb7, 0x57, 0x61, 0x8a, 0x62, 0x98, 0x5c, 0xac, 0x6e, 0x1b, 0xb8, 0x12, 0xc3, 0xf9, 0x2b, 0x61,
-	0x61, 0x1f, 0x04, 0x19, 0x8b, 0xd3, 0xa0, 0xbb, 0xe3, 0x45, 0x7e, 0x38, 0x56, 0x18, 0x74, 0x81,
-	0x1d, 0xda, 0x80, 0x46, 0x8a, 0xfd, 0x7f, 0xc1, 0x17, 0x54, 0xf6, 0x46, 0xe4, 0x00, 0x7e, 0x2e,
-	0xf7, 0x52, 0x2f, 0x1a, 0x84, 0x5e, 0xca, 0x4f, 0x89, 0x49, 0x71, 0xa3, 0x6c, 0x80, 0x9c, 0x07,
-	0x58, 0xf9, 0x2c, 0x89, 0x28, 0x57, 0x7b, 0x13, 0xa6, 0xba, 0x08, 0x92, 0xab, 0x5d, 0x30, 0x72,
-	0x30, 0x3f, 0xa4, 0xae, 0x1c, 0x75, 0xfe, 0xa0, 0x06, 0x53, 0x02, 0xc4, 0xbd, 0xad, 0x6e, 0x9c,
-	0x9f, 0x70, 0xf1, 0xb7, 0x6a, 0xc7, 0xa9, 0xe7, 0xed, 0x38, 0xaa, 0x69, 0x67, 0xc2, 0x68, 0xda,
-	0x21, 0x30, 0x19, 0x27, 0x34, 0x52, 0xcd, 0x3d, 0xfc, 0x37, 0xdf, 0xb5, 0x6e, 0x18, 0x67, 0x54,
-	0x66, 0x2e, 0xe2, 0xc1, 0x68, 0xd4, 0x99, 0x32, 0x1b, 0x75, 0x9c, 0xe7, 0x00, 0xf9, 0x36, 0xa0,
-	0x24, 0xdc, 0x6c, 0xe5, 0xa5, 0x33, 0xff, 0x4d, 0xae, 0x01, 0x04, 0x3e, 0x8d, 0x58, 0x70, 0x14,
-	0x50, 0xd5, 0xf0, 0x61, 0x40, 0x78, 0x18, 0xd0, 0xa7, 0x59, 0xa6, 0xaa, 0xa5, 0x0d, 0x57, 0x3d,
-	0x72, 0x45, 0xf3, 0xb5, 0x64, 0xcc, 0xeb, 0x27, 0x2a, 0x26, 0xd1, 0x00, 0xe7, 0x10, 0x1a, 0xbb,
-	0x3b, 0x07, 0xfb, 0x18, 0xee, 0x70, 0xc6, 0x1f, 0x7f, 0xfc, 0xe8, 0x81, 0x62, 0xcc, 0x7f, 0xeb,
-	0x62, 0x43, 0xdd, 0x28, 0x36, 0x10, 0xbe, 0xcb, 0xec, 0x58, 0x25, 0x4d, 0xfc, 0x37, 0xb7, 0xe0,
-	0x88, 0x3e, 0x67, 0x9d, 0x74, 0x10, 0x49, 0x2e, 0xd3, 0xfc, 0xd9, 0x1d, 0x44, 0xce, 0x03, 0x58,
-	0xd7, 0x3c, 0x1e, 0x8a, 0x14, 0x46, 0xd9, 0xd2, 0x6d, 0x98, 0x12, 0xa1, 0x96, 0x6c, 0x7b, 0x59,
-	0xd2, 0xbe, 0x5f, 0x4d, 0x70, 0x25, 0x82, 0xb3, 0x0d, 0x2b, 0x1a, 0xb8, 0xcf, 0xe2, 0xe4, 0x2b,
-	0x90, 0xb8, 0x6c, 0x08, 0xc2, 0x49, 0x6c, 0x87, 0xa1, 0x4a, 0x85, 0x5b, 0xb0, 0x66, 0x0c, 0xf1,
-	0x14, 0x5b, 0x8d, 0x98, 0x93, 0x1e, 0x07, 0x19, 0x33, 0x26, 0xfd, 0x4d, 0xcd, 0x98, 0xf5, 0x71,
-	0x12, 0xc6, 0x9e, 0xaf, 0xa4, 0xda, 0x84, 0x59, 0xc1, 0xb4, 0x63, 0x94, 0x6a, 0x40, 0x80, 0x30,
-	0x50, 0xca, 0x11, 0xb0, 0x87, 0xa1, 0x6e, 0x22, 0x3c, 0xf0, 0x98, 0xa7, 0xbb, 0x1b, 0x26, 0xf2,
-	0xee, 0x06, 0xfe, 0xea, 0x79, 0x69, 0xf7, 0x38, 0x38, 0xa1, 0xbe, 0x0c, 0x00, 0xf4, 0x33, 0xdf,
-	0xe7, 0xf8, 0x84, 0xa6, 0xa7, 0x69, 0xc0, 0x84, 0xd5, 0xcd, 0xb8, 0x39, 0xc0, 0xd9, 0x85, 0x76,
-	0xae, 0x0f, 0xea, 0xf9, 0xea, 0xd7, 0x85, 0x75, 0x78, 0x1f, 0x56, 0x35, 0xf0, 0x47, 0x03, 0xaa,
-	0x9b, 0x0d, 0x2e, 0x42, 0xe3, 0x07, 0xd0, 0xd2, 0xc0, 0xed, 0x01, 0x8b, 0x1f, 0x1b, 0x8a, 0x5b,
-	0xb3, 0xc8, 0x34, 0xd4, 0x1c, 0xe3, 0x1a, 0x4f, 0xc4, 0x48, 0xea, 0x1a, 0xef, 0x33, 0x6b, 0x4f,
-	0xc5, 0xc6, 0xe5, 0x01, 0x9d, 0xee, 0x6d, 0x37, 0xaf, 0xff, 0x5f, 0x83, 0x69, 0x41, 0x54, 0xdd,
-	0xd0, 0x54, 0x88, 0xaa, 0x30, 0x9c, 0xd8, 0xd8, 0x62, 0xb9, 0xde, 0x73, 0xc8, 0xe7, 0x8a, 0xa8,
-	0x9f, 0xa3, 0x08, 0x6b, 0x8f, 0x1b, 0xb2, 0x83, 0xe5, 0x7d, 0x43, 0x39, 0xb2, 0x3b, 0xfb, 0x5c,
-	0x96, 0x8a, 0x4e, 0x3d, 0xa7, 0x73, 0xf7, 0x67, 0xdf, 0x86, 0x85, 0xdd, 0x58, 0xe4, 0x55, 0x07,
-	0x3c, 0x9d, 0x48, 0xc9, 0x13, 0x98, 0x96, 0xdf, 0xb1, 0x90, 0xb5, 0xd2, 0x87, 0x2d, 0xa8, 0xfe,
-	0xf6, 0xfa, 0x90, 0x0f, 0x5e, 0x9c, 0xe5, 0x2f, 0xff, 0xf5, 0xdf, 0x7f, 0x5e, 0x9f, 0x27, 0xb3,
-	0x77, 0x4e, 0xde, 0xba, 0xd3, 0xa3, 0x0c, 0xe3, 0xd6, 0x1e, 0xcc, 0x5b, 0x9f, 0x1e, 0x90, 0x0d,
-	0xeb, 0xf3, 0x81, 0xc2, 0x17, 0x09, 0xed, 0xab, 0x23, 0x3f, 0x2e, 0x70, 0x2e, 0x23, 0x8b, 0x65,
-	0xb2, 0x24, 0x59, 0xe4, 0x5f, 0x15, 0x90, 0xcf, 0x61, 0xf1, 0x21, 0xd6, 0x33, 0x35, 0x51, 0xb2,
-	0x99, 0x13, 0xab, 0xfc, 0xa2, 0xa2, 0x7d, 0x7d, 0x38, 0x82, 0x64, 0x78, 0x05, 0x19, 0xae, 0x92,
-	0x65, 0xce, 0x50, 0xd4, 0x4b, 0x35, 0x4f, 0x92, 0x41, 0x53, 0xf6, 0x68, 0xbf, 0x50, 0x9e, 0x1b,
-	0xc8, 0x73, 0x8d, 0xac, 0x70, 0x9e, 0xbe, 0x60, 0x90, 0x33, 0x8d, 0xb1, 0x1c, 0x63, 0x7e, 0x53,
-	0x40, 0xae, 0x0d, 0xfd, 0xd8, 0x40, 0xb0, 0xdc, 0x3c, 0xe7, 0x63, 0x04, 0x7b, 0x95, 0x3d, 0xca,
-	0x71, 0xf5, 0xf7, 0x08, 0xe4, 0xe7, 0x22, 0x46, 0xaf, 0xfc, 0xfa, 0x85, 0xbc, 0x72, 0xfe, 0x27,
-	0x37, 0x42, 0x86, 0x5b, 0xe3, 0x7e, 0x9b, 0xe3, 0x7c, 0x0b, 0x85, 0xb9, 0x46, 0x36, 0xa4, 0x30,
-	0xd6, 0xf7, 0x38, 0xea, 0x8b, 0x1f, 0xd2, 0x85, 0x39, 0xf3, 0x43, 0x02, 0x72, 0xa5, 0x22, 0x25,
-	0xd0, 0xcc, 0x37, 0xaa, 0x07, 0x25, 0xc3, 0x16, 0x32, 0x24, 0xa4, 0x29, 0x19, 0xea, 0xef, 0x0e,
-	0xc8, 0x17, 0xb0, 0x58, 0x68, 0xc2, 0x27, 0x4e, 0x61, 0xfb, 0x2a, 0x3e, 0xa8, 0x68, 0xbf, 0x34,
-	0x12, 0x47, 0x72, 0xbd, 0x86, 0x5c, 0x5b, 0xce, 0xb2, 0xb1, 0xcb, 0x8a, 0xf3, 0x77, 0x6b, 0xaf,
-	0x92, 0x0c, 0xf7, 0xd9, 0xec, 0x17, 0x1f, 0x8b, 0xf7, 0xe6, 0x39, 0xcd, 0xe6, 0xa5, 0xbd, 0x56,
-	0x3c, 0xf1, 0x6d, 0xcd, 0xb0, 0x07, 0xd7, 0xf8, 0xca, 0x01, 0xf3, 0xe5, 0x71, 0xf8, 0x5e, 0xad,
-	0xfe, 0x4a, 0x42, 0x7e, 0xa8, 0xe1, 0xb4, 0x91, 0xeb, 0x0a, 0x21, 0x05, 0xae, 0x31, 0x4b, 0x48,
-	0x66, 0x7d, 0x44, 0x22, 0x99, 0xda, 0x56, 0x5d, 0xf1, 0x19, 0x47, 0xe5, 0x4a, 0xcd, 0xef, 0x32,
-	0x86, 0xae, 0x34, 0x66, 0x49, 0x46, 0x9e, 0xc3, 0x82, 0x70, 0x17, 0x2f, 0x7e, 0x67, 0xaf, 0x22,
-	0xdf, 0x75, 0x87, 0xe4, 0x3e, 0xc3, 0xdc, 0xd8, 0x4f, 0xa0, 0xa1, 0x13, 0x1b, 0xd2, 0x32, 0x16,
-	0x61, 0x75, 0xd4, 0xb7, 0x87, 0xf4, 0x4b, 0x2b, 0x6b, 0x75, 0xe6, 0xe5, 0xaa, 0x44, 0xf7, 0x33,
-	0x27, 0xfc, 0x63, 0x80, 0xbc, 0x81, 0x9a, 0x5c, 0x2e, 0x51, 0xd6, 0x9a, 0x6b, 0x57, 0x0d, 0xa9,
-	0x4f, 0xc5, 0x90, 0x7c, 0x93, 0x2c, 0x58, 0xe4, 0xd5, 0xfb, 0xa6, 0xf3, 0x38, 0xeb, 0x7d, 0x2b,
-	0xb6, 0x5c, 0xb7, 0x87, 0xf7, 0xda, 0xaa, 0x4d, 0x71, 0xd4, 0xcb, 0xa6, 0xef, 0xeb, 0xf9, 0x0a,
-	0xc4, 0x61, 0x61, 0x34, 0xf9, 0x6e, 0x54, 0x71, 0xa9, 0x3c, 0x2c, 0xca, 0x1d, 0xbb, 0xa5, 0xc3,
-	0x22, 0x6f, 0xcc, 0x25, 0xcf, 0xf0, 0x53, 0x59, 0xa3, 0x47, 0x95, 0x98, 0xb4, 0xca, 0x0d, 0xbb,
-	0xed, 0x6b, 0xc3, 0x86, 0xb3, 0x6a, 0xfb, 0x96, 0x57, 0x7a, 0xf8, 0x52, 0x9d, 0x89, 0x5c, 0x30,
-	0x9f, 0x25, 0xf2, 0xc8, 0xaf, 0xcb, 0xf2, 0x3a, 0xb2, 0x6c, 0x93, 0x56, 0x99, 0x65, 0x86, 0x0c,
-	0xde, 0xac, 0x49, 0x5b, 0x13, 0x4d, 0xb1, 0x96, 0xad, 0x59, 0xbd, 0xb3, 0xed, 0xcb, 0x15, 0x23,
-	0x92, 0xcb, 0x2a, 0x72, 0x59, 0x24, 0xf3, 0xda, 0x1b, 0x23, 0x2d, 0x61, 0x0e, 0xba, 0x5b, 0xc9,
-	0x32, 0x87, 0x62, 0x4b, 0xab, 0xe5, 0x7e, 0x4b, 0x8d, 0xad, 0x25, 0xf7, 0xab, 0x5b, 0x57, 0xc9,
-	0xef, 0xdb, 0x1d, 0xb2, 0xaa, 0x63, 0xcf, 0x19, 0xd9, 0x62, 0x57, 0x7a, 0x51, 0x87, 0xb6, 0xe1,
-	0x39, 0x9b, 0xc8, 0xf9, 0x32, 0x59, 0x2f, 0x72, 0x96, 0x2d, 0x7d, 0xe4, 0xcb, 0x1a, 0x2c, 0x57,
-	0x34, 0x8c, 0xe5, 0x12, 0x0c, 0x6f, 0x6f, 0xcb, 0x25, 0x18, 0xd5, 0x71, 0xe6, 0xa0, 0x04, 0x1b,
-	0x0e, 0x4a, 0xe0, 0xf9, 0xbe, 0x96, 0x40, 0x5e, 0x8e, 0xf2, 0x97, 0xe2, 0x67, 0x35, 0x58, 0xab,
-	0x6e, 0x0e, 0x23, 0x2f, 0xeb, 0xef, 0xfe, 0x46, 0xb5, 0xad, 0xb5, 0x6f, 0x9e, 0x87, 0x26, 0xa5,
-	0x79, 0x19, 0xa5, 0xd9, 0x74, 0xda, 0x5c, 0x9a, 0x14, 0x71, 0xab, 0x04, 0x3a, 0xc5, 0x8a, 0x9a,
-	0xdd, 0x7e, 0x45, 0x8c, 0xb0, 0xa6, 0xba, 0x4b, 0xad, 0x7d, 0x63, 0x04, 0x86, 0xed, 0x39, 0xc9,
-	0xaa, 0xdc, 0x10, 0xec, 0x59, 0xd2, 0x7d, 0x5c, 0xd2, 0x3d, 0xe4, 0xed, 0x4d, 0x96, 0x7b, 0x28,
-	0x75, 0x6c, 0x59, 0xee, 0xa1, 0xdc, 0x44, 0x55, 0x72, 0x0f, 0xc8, 0x0c, 0x1b, 0xaa, 0xc8, 0xa7,
-	0xf8, 0xda, 0xc8, 0x72, 0x6e, 0xab, 0xe8, 0x65, 0xb2, 0xaa, 0xd7, 0xc6, 0x2e, 0xd8, 0x96, 0xbc,
-	0xb4, 0xa8, 0x12, 0x73, 0xed, 0xb9, 0x30, 0xa3, 0xd0, 0xc9, 0x7a, 0x91, 0x80, 0xa2, 0x5c, 0xd9,
-	0x91, 0xe3, 0xac, 0x23, 0xd1, 0x25, 0x67, 0xce, 0x24, 0xca, 0x69, 0x1e, 0xc2, 0xac, 0xd1, 0x7d,
-	0x42, 0xb4, 0x7f, 0x2f, 0x37, 0xdb, 0xb4, 0xaf, 0x54, 0x8e, 0xd9, 0x5e, 0xcc, 0x59, 0xe4, 0x0c,
-	0x32, 0x44, 0xd0, 0x3c, 0x7e, 0x07, 0xe6, 0xad, 0x06, 0x90, 0x5c, 0xf9, 0x55, 0x2d, 0x2a, 0xb9,
-	0xf2, 0x2b, 0xbb, 0x46, 0x54, 0x8c, 0xeb, 0xa0, 0xf2, 0x33, 0x89, 0xa2, 0x79, 0x7d, 0x06, 0x0d,
-	0xdd, 0x77, 0x91, 0xeb, 0xbf, 0xd8, 0x8a, 0x71, 0x1e, 0x0f, 0x6b, 0x0f, 0x4e, 0xf9, 0xe4, 0xc3,
-	0xb8, 0x7f, 0x28, 0xf5, 0x65, 0x74, 0x15, 0xe4, 0xfa, 0x2a, 0xb7, 0x56, 0xe4, 0xfa, 0xaa, 0x6a,
-	0x43, 0xb0, 0xf4, 0xd5, 0x45, 0x04, 0xbd, 0x86, 0x14, 0x16, 0x0b, 0xd5, 0xfc, 0x3c, 0xa2, 0xa9,
-	0xee, 0x5d, 0xc8, 0x23, 0x9a, 0x21, 0x6d, 0x00, 0x76, 0xcc, 0x28, 0xf8, 0x79, 0x61, 0x98, 0xdb,
-	0x96, 0x70, 0xf7, 0xa2, 0xd6, 0x6d, 0xd9, 0xad, 0x55, 0xd4, 0xb7, 0xec, 0xd6, 0x2e, 0x8c, 0x97,
-	0xdc, 0xbd, 0xb8, 0xee, 0x23, 0x4f, 0x61, 0x46, 0x15, 0x59, 0x73, 0xa3, 0x2d, 0x94, 0x97, 0xdb,
-	0xad, 0xf2, 0x80, 0xa4, 0x6a, 0x19, 0xae, 0xe7, 0xfb, 0x48, 0x55, 0x6e, 0x84, 0x51, 0x72, 0xcd,
-	0x37, 0xa2, 0x5c, 0xad, 0xcd, 0x37, 0xa2, 0xaa, 0x46, 0x6b, 0x6d, 0x84, 0xf0, 0x5c, 0x9a, 0xc7,
-	0xdf, 0xd7, 0xf0, 0x2a, 0x7a, 0x74, 0xc5, 0x94, 0xbc, 0x79, 0x81, 0xe2, 0xaa, 0x10, 0xe8, 0xad,
-	0x0b, 0x97, 0x63, 0x9d, 0x5b, 0x28, 0xa6, 0xe3, 0x5c, 0x55, 0x87, 0x29, 0x4e, 0xf3, 0x05, 0xba,
-	0xae, 0xcd, 0x72, 0xa1, 0xff, 0xb6, 0x26, 0xfe, 0x06, 0xc3, 0x08, 0xba, 0x64, 0x6b, 0x4c, 0x01,
-	0x94, 0xc0, 0x77, 0xc6, 0xc6, 0x97, 0xe2, 0xde, 0x44, 0x71, 0xaf, 0x3b, 0x57, 0x46, 0x88, 0xcb,
-	0x85, 0xfd, 0x5d, 0xb8, 0xa2, 0x2b, 0xab, 0x16, 0xdd, 0xf7, 0x07, 0x91, 0x9f, 0xe5, 0x29, 0xf1,
-	0x90, 0xf2, 0x6b, 0x6e, 0x38, 0xc5, 0x82, 0x9b, 0x7d, 0x3e, 0x9e, 0xca, 0x51, 0x21, 0xc6, 0x11,
-	0xa7, 0xcd, 0xb9, 0x27, 0xb0, 0xa4, 0xe6, 0xbd, 0x1f, 0x78, 0xec, 0x6b, 0xf3, 0x94, 0x71, 0x95,
-	0xb3, 0x6a, 0xf2, 0x3c, 0x0a, 0x3c, 0xa6, 0x39, 0x66, 0xd8, 0x28, 0x63, 0xd5, 0xd2, 0xcc, 0xbc,
-	0xbf, 0xb2, 0xca, 0x66, 0xe6, 0xfd, 0xd5, 0x65, 0x3f, 0x3b, 0xef, 0xef, 0x51, 0x26, 0xca, 0x70,
-	0xbe, 0x64, 0x70, 0x02, 0xcd, 0xfd, 0xa1, 0x4c, 0xf7, 0xbf, 0x32, 0x53, 0x19, 0x03, 0x39, 0xc8,
-	0x34, 0x2b, 0x30, 0xe5, 0x8b, 0x3d, 0x11, 0x5d, 0x41, 0x66, 0x95, 0x8d, 0x6c, 0x0e, 0xaf, 0xbf,
-	0x95, 0xf9, 0x56, 0x16, 0xe8, 0x6c, 0xbe, 0x46, 0x72, 0x86, 0xdf, 0x9e, 0x73, 0xbe, 0x67, 0x40,
-	0xec, 0x04, 0x0d, 0xbf, 0x59, 0xd4, 0x5e, 0xa0, 0xa2, 0xb6, 0x36, 0x5e, 0x76, 0x76, 0x03, 0x19,
-	0x5f, 0x71, 0xd6, 0xca, 0xd9, 0x19, 0xe7, 0xcd, 0x59, 0xff, 0x04, 0x96, 0x0b, 0x69, 0xff, 0x0b,
-	0xe2, 0x6d, 0x99, 0x73, 0x21, 0xe7, 0x57, 0xcc, 0x19, 0xa6, 0xe0, 0x85, 0x82, 0x19, 0xb9, 0x51,
-	0x95, 0xea, 0x58, 0xf5, 0xa8, 0x51, 0x49, 0x97, 0x3c, 0x37, 0xc8, 0x5a, 0x29, 0x13, 0x52, 0x89,
-	0xc2, 0x9f, 0xd4, 0xb0, 0x58, 0x32, 0xa4, 0x5e, 0x47, 0x6e, 0x57, 0xe5, 0xda, 0x17, 0x16, 0x43,
-	0xfa, 0x13, 0x72, 0xad, 0x98, 0x90, 0x97, 0xc4, 0x39, 0xc6, 0xcb, 0x0f, 0xb3, 0xea, 0x66, 0x5d,
-	0x07, 0x54, 0x94, 0xe3, 0x86, 0xe6, 0xcb, 0xc5, 0x5b, 0x00, 0x99, 0xd0, 0x2a, 0x4e, 0x3f, 0xb5,
-	0xff, 0x18, 0x84, 0xc5, 0xf2, 0x66, 0xc5, 0xaa, 0x2f, 0xc2, 0xfa, 0x25, 0x64, 0x7d, 0x95, 0x5c,
-	0x29, 0xac, 0xb7, 0x20, 0x82, 0x08, 0x6b, 0x8d, 0xea, 0x8e, 0x19, 0xd6, 0x96, 0x4a, 0x88, 0x56,
-	0x58, 0x5b, 0xae, 0xe2, 0x95, 0xc2, 0x5a, 0x8f, 0xa3, 0xe0, 0x61, 0x48, 0x18, 0x34, 0x8b, 0x55,
-	0x16, 0xe3, 0x55, 0xae, 0xae, 0xbf, 0x18, 0xaf, 0xf2, 0x90, 0x2b, 0xe7, 0x42, 0xd4, 0xde, 0x65,
-	0xe2, 0xe6, 0xfa, 0x8e, 0x6c, 0x45, 0x23, 0x0c, 0x16, 0x0b, 0x15, 0x10, 0x63, 0x2f, 0x2b, 0x4b,
-	0x23, 0x63, 0xf0, 0xb4, 0xdd, 0x87, 0xe6, 0x39, 0x40, 0x32, 0xfc, 0x35, 0x7a, 0x0e, 0xcb, 0x15,
-	0xd5, 0x0c, 0x23, 0x77, 0x1c, 0x5a, 0xea, 0x68, 0x97, 0xa5, 0xb3, 0x6e, 0xf5, 0xed, 0xfb, 0x9d,
-	0x9c, 0x77, 0x4a, 0x05, 0xe7, 0xc4, 0x58, 0xaf, 0xfc, 0xd3, 0x51, 0x65, 0x8a, 0x56, 0x01, 0xa9,
-	0xbd, 0x39, 0x74, 0xbc, 0xf2, 0x68, 0xd0, 0x2c, 0xe5, 0xdd, 0x7e, 0x08, 0x0b, 0xb6, 0xa8, 0xc6,
-	0xd5, 0x42, 0x55, 0x21, 0xe6, 0xdc, 0x15, 0xda, 0xef, 0x8c, 0x66, 0xf7, 0x39, 0xd2, 0x8e, 0x60,
-	0xde, 0x2a, 0x91, 0x19, 0xe6, 0x5a, 0x51, 0x7c, 0x1b, 0xdf, 0x7e, 0x8a, 0xfa, 0xcc, 0x58, 0x9c,
-	0x08, 0x87, 0xd8, 0x2c, 0x96, 0xe4, 0xc8, 0x66, 0x25, 0xcb, 0xbc, 0xee, 0xf6, 0xf5, 0xb9, 0x66,
-	0x06, 0x57, 0x59, 0xd3, 0xab, 0xe0, 0x6a, 0x57, 0xfb, 0xce, 0xdf, 0xc7, 0x73, 0x98, 0xa2, 0x33,
-	0x2a, 0x96, 0xbd, 0x0e, 0xe2, 0x5e, 0x2f, 0xa4, 0xa4, 0xbc, 0xa2, 0x42, 0x5d, 0x6c, 0x8c, 0x35,
-	0x5b, 0x67, 0x5f, 0xce, 0xde, 0x1b, 0xb0, 0x58, 0xbd, 0x37, 0x3f, 0xc1, 0xe3, 0xa7, 0x50, 0x34,
-	0xb7, 0x8e, 0x9f, 0xea, 0x9a, 0x7f, 0xdb, 0x19, 0x85, 0x32, 0xe4, 0x1c, 0x3a, 0x96, 0x78, 0xa2,
-	0xd4, 0x9e, 0x1d, 0x4e, 0xe1, 0x1f, 0xb2, 0x7b, 0xfb, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x43,
-	0x1f, 0xbd, 0xbb, 0xfb, 0x4e, 0x00, 0x00,
+type GetOrderbookStreamRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// GoCryptoTraderClient is the client API for GoCryptoTrader service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GoCryptoTraderClient interface {
-	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
-	GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error)
-	EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
-	DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
-	GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error)
-	GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error)
-	GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error)
-	DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error)
-	GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error)
-	GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error)
-	EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error)
-	GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error)
-	GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error)
-	GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error)
-	GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error)
-	GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error)
-	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
-	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
-	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
-	AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error)
-	RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error)
-	GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error)
-	GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error)
-	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
-	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error)
-	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
-	SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
-	WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
-	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
-	CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error)
-	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
-	AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error)
-	RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error)
-	GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error)
-	GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error)
-	WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
-	WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
-	GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
-	SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
-	GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error)
-	EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error)
-	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error)
-	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error)
-	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error)
-	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
-	GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
-	GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
-	GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
-	GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
-	GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error)
+func (m *GetOrderbookStreamRequest) Reset()         { *m = GetOrderbookStreamRequest{} }
+func (m *GetOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderbookStreamRequest) ProtoMessage()    {}
+func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{109}
 }
 
-type goCryptoTraderClient struct {
-	cc *grpc.ClientConn
+func (m *GetOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderbookStreamRequest.Unmarshal(m, b)
 }
-
-func NewGoCryptoTraderClient(cc *grpc.ClientConn) GoCryptoTraderClient {
-	return &goCryptoTraderClient{cc}
+func (m *GetOrderbookStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderbookStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderbookStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderbookStreamRequest.Merge(m, src)
+}
+func (m *GetOrderbookStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderbookStreamRequest.Size(m)
+}
+func (m *GetOrderbookStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderbookStreamRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
-	out := new(GetInfoResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetOrderbookStreamRequest proto.InternalMessageInfo
+
+func (m *GetOrderbookStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error) {
-	out := new(GetSusbsytemsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSubsystems", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookStreamRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
-	out := new(GenericSubsystemResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableSubsystem", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOrderbookStreamRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
-	out := new(GenericSubsystemResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableSubsystem", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetExchangeOrderbookStreamRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error) {
-	out := new(GetRPCEndpointsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRPCEndpoints", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetExchangeOrderbookStreamRequest) Reset()         { *m = GetExchangeOrderbookStreamRequest{} }
+func (m *GetExchangeOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeOrderbookStreamRequest) ProtoMessage()    {}
+func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{110}
 }
 
-func (c *goCryptoTraderClient) GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error) {
-	out := new(GetCommunicationRelayersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCommunicationRelayers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetExchangeOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Unmarshal(m, b)
+}
+func (m *GetExchangeOrderbookStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExchangeOrderbookStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeOrderbookStreamRequest.Merge(m, src)
+}
+func (m *GetExchangeOrderbookStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeOrderbookStreamRequest.Size(m)
+}
+func (m *GetExchangeOrderbookStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeOrderbookStreamRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error) {
-	out := new(GetExchangesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchanges", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetExchangeOrderbookStreamRequest proto.InternalMessageInfo
+
+func (m *GetExchangeOrderbookStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchange", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetTickerStreamRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error) {
-	out := new(GetExchangeInfoResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetTickerStreamRequest) Reset()         { *m = GetTickerStreamRequest{} }
+func (m *GetTickerStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTickerStreamRequest) ProtoMessage()    {}
+func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{111}
 }
 
-func (c *goCryptoTraderClient) GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error) {
-	out := new(GetExchangeOTPReponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCode", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetTickerStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTickerStreamRequest.Unmarshal(m, b)
+}
+func (m *GetTickerStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTickerStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTickerStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTickerStreamRequest.Merge(m, src)
+}
+func (m *GetTickerStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTickerStreamRequest.Size(m)
+}
+func (m *GetTickerStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTickerStreamRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error) {
-	out := new(GetExchangeOTPsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTickerStreamRequest proto.InternalMessageInfo
+
+func (m *GetTickerStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchange", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTickerStreamRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error) {
-	out := new(TickerResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTicker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTickerStreamRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error) {
-	out := new(GetTickersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTickers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetExchangeTickerStreamRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error) {
-	out := new(OrderbookResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbook", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetExchangeTickerStreamRequest) Reset()         { *m = GetExchangeTickerStreamRequest{} }
+func (m *GetExchangeTickerStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeTickerStreamRequest) ProtoMessage()    {}
+func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{112}
 }
 
-func (c *goCryptoTraderClient) GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error) {
-	out := new(GetOrderbooksResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbooks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetExchangeTickerStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeTickerStreamRequest.Unmarshal(m, b)
+}
+func (m *GetExchangeTickerStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeTickerStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExchangeTickerStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeTickerStreamRequest.Merge(m, src)
+}
+func (m *GetExchangeTickerStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeTickerStreamRequest.Size(m)
+}
+func (m *GetExchangeTickerStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeTickerStreamRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error) {
-	out := new(GetAccountInfoResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAccountInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetExchangeTickerStreamRequest proto.InternalMessageInfo
+
+func (m *GetExchangeTickerStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[0], "/gctrpc.GoCryptoTrader/GetAccountInfoStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetAccountInfoStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type GetOrderUpdateStreamRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type GoCryptoTrader_GetAccountInfoStreamClient interface {
-	Recv() (*GetAccountInfoResponse, error)
-	grpc.ClientStream
+func (m *GetOrderUpdateStreamRequest) Reset()         { *m = GetOrderUpdateStreamRequest{} }
+func (m *GetOrderUpdateStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderUpdateStreamRequest) ProtoMessage()    {}
+func (*GetOrderUpdateStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{113}
 }
 
-type goCryptoTraderGetAccountInfoStreamClient struct {
-	grpc.ClientStream
+func (m *GetOrderUpdateStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderUpdateStreamRequest.Unmarshal(m, b)
+}
+func (m *GetOrderUpdateStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderUpdateStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderUpdateStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderUpdateStreamRequest.Merge(m, src)
+}
+func (m *GetOrderUpdateStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderUpdateStreamRequest.Size(m)
+}
+func (m *GetOrderUpdateStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderUpdateStreamRequest.DiscardUnknown(m)
 }
 
-func (x *goCryptoTraderGetAccountInfoStreamClient) Recv() (*GetAccountInfoResponse, error) {
-	m := new(GetAccountInfoResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_GetOrderUpdateStreamRequest proto.InternalMessageInfo
+
+func (m *GetOrderUpdateStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return m, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
-	out := new(GetConfigResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type OrderUpdateResponse struct {
+	Order                *OrderDetails `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	InternalOrderId      string        `protobuf:"bytes,2,opt,name=internal_order_id,json=internalOrderId,proto3" json:"internal_order_id,omitempty"`
+	PreviousStatus       string        `protobuf:"bytes,3,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	ExecutedAmount       float64       `protobuf:"fixed64,4,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	RemainingAmount      float64       `protobuf:"fixed64,5,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	ExecutedDelta        float64       `protobuf:"fixed64,6,opt,name=executed_delta,json=executedDelta,proto3" json:"executed_delta,omitempty"`
+	Fee                  float64       `protobuf:"fixed64,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
-	out := new(GetPortfolioResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolio", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OrderUpdateResponse) Reset()         { *m = OrderUpdateResponse{} }
+func (m *OrderUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*OrderUpdateResponse) ProtoMessage()    {}
+func (*OrderUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{114}
 }
 
-func (c *goCryptoTraderClient) GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error) {
-	out := new(GetPortfolioSummaryResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioSummary", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *OrderUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderUpdateResponse.Unmarshal(m, b)
+}
+func (m *OrderUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderUpdateResponse.Marshal(b, m, deterministic)
+}
+func (m *OrderUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderUpdateResponse.Merge(m, src)
+}
+func (m *OrderUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_OrderUpdateResponse.Size(m)
+}
+func (m *OrderUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderUpdateResponse.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error) {
-	out := new(AddPortfolioAddressResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddPortfolioAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_OrderUpdateResponse proto.InternalMessageInfo
 
-func (c *goCryptoTraderClient) RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error) {
-	out := new(RemovePortfolioAddressResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemovePortfolioAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderUpdateResponse) GetOrder() *OrderDetails {
+	if m != nil {
+		return m.Order
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error) {
-	out := new(GetForexProvidersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderUpdateResponse) GetInternalOrderId() string {
+	if m != nil {
+		return m.InternalOrderId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error) {
-	out := new(GetForexRatesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexRates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderUpdateResponse) GetPreviousStatus() string {
+	if m != nil {
+		return m.PreviousStatus
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error) {
-	out := new(GetOrdersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderUpdateResponse) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error) {
-	out := new(OrderDetails)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderUpdateResponse) GetRemainingAmount() float64 {
+	if m != nil {
+		return m.RemainingAmount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
-	out := new(SubmitOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderUpdateResponse) GetExecutedDelta() float64 {
+	if m != nil {
+		return m.ExecutedDelta
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
-	out := new(SimulateOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SimulateOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrderUpdateResponse) GetFee() float64 {
+	if m != nil {
+		return m.Fee
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
-	out := new(SimulateOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WhaleBomb", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetAuditEventRequest struct {
+	StartDate            string   `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OrderBy              string   `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *goCryptoTraderClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
-	out := new(CancelOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetAuditEventRequest) Reset()         { *m = GetAuditEventRequest{} }
+func (m *GetAuditEventRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuditEventRequest) ProtoMessage()    {}
+func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{115}
 }
 
-func (c *goCryptoTraderClient) CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error) {
-	out := new(CancelAllOrdersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelAllOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetAuditEventRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditEventRequest.Unmarshal(m, b)
 }
-
-func (c *goCryptoTraderClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
-	out := new(GetEventsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetEvents", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetAuditEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditEventRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAuditEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditEventRequest.Merge(m, src)
+}
+func (m *GetAuditEventRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuditEventRequest.Size(m)
+}
+func (m *GetAuditEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditEventRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error) {
-	out := new(AddEventResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetAuditEventRequest proto.InternalMessageInfo
+
+func (m *GetAuditEventRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error) {
-	out := new(RemoveEventResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemoveEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAuditEventRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error) {
-	out := new(GetCryptocurrencyDepositAddressesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAuditEventRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error) {
-	out := new(GetCryptocurrencyDepositAddressResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAuditEventRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
-	out := new(WithdrawResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAuditEventRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
-	out := new(WithdrawResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawFiatFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetAuditEventResponse struct {
+	Events               []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
-	out := new(GetLoggerDetailsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLoggerDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetAuditEventResponse) Reset()         { *m = GetAuditEventResponse{} }
+func (m *GetAuditEventResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuditEventResponse) ProtoMessage()    {}
+func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{116}
 }
 
-func (c *goCryptoTraderClient) SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
-	out := new(GetLoggerDetailsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLoggerDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetAuditEventResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditEventResponse.Unmarshal(m, b)
+}
+func (m *GetAuditEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditEventResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAuditEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditEventResponse.Merge(m, src)
+}
+func (m *GetAuditEventResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuditEventResponse.Size(m)
+}
+func (m *GetAuditEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditEventResponse.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error) {
-	out := new(GetExchangePairsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangePairs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetAuditEventResponse proto.InternalMessageInfo
+
+func (m *GetAuditEventResponse) GetEvents() []*AuditEvent {
+	if m != nil {
+		return m.Events
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchangePair", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetHistoricCandlesRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                string        `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                  string        `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Interval             string        `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *goCryptoTraderClient) DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchangePair", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetHistoricCandlesRequest) Reset()         { *m = GetHistoricCandlesRequest{} }
+func (m *GetHistoricCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHistoricCandlesRequest) ProtoMessage()    {}
+func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{117}
 }
 
-func (c *goCryptoTraderClient) GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[1], "/gctrpc.GoCryptoTrader/GetOrderbookStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetOrderbookStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *GetHistoricCandlesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHistoricCandlesRequest.Unmarshal(m, b)
 }
-
-type GoCryptoTrader_GetOrderbookStreamClient interface {
-	Recv() (*OrderbookResponse, error)
-	grpc.ClientStream
+func (m *GetHistoricCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHistoricCandlesRequest.Marshal(b, m, deterministic)
 }
-
-type goCryptoTraderGetOrderbookStreamClient struct {
-	grpc.ClientStream
+func (m *GetHistoricCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHistoricCandlesRequest.Merge(m, src)
+}
+func (m *GetHistoricCandlesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHistoricCandlesRequest.Size(m)
+}
+func (m *GetHistoricCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHistoricCandlesRequest.DiscardUnknown(m)
 }
 
-func (x *goCryptoTraderGetOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
-	m := new(OrderbookResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_GetHistoricCandlesRequest proto.InternalMessageInfo
+
+func (m *GetHistoricCandlesRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return m, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[2], "/gctrpc.GoCryptoTrader/GetExchangeOrderbookStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetExchangeOrderbookStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
-	return x, nil
+	return nil
 }
 
-type GoCryptoTrader_GetExchangeOrderbookStreamClient interface {
-	Recv() (*OrderbookResponse, error)
-	grpc.ClientStream
+func (m *GetHistoricCandlesRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
 
-type goCryptoTraderGetExchangeOrderbookStreamClient struct {
-	grpc.ClientStream
+func (m *GetHistoricCandlesRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
 }
 
-func (x *goCryptoTraderGetExchangeOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
-	m := new(OrderbookResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetHistoricCandlesRequest) GetEnd() string {
+	if m != nil {
+		return m.End
 	}
-	return m, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[3], "/gctrpc.GoCryptoTrader/GetTickerStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetTickerStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *GetHistoricCandlesRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
 	}
-	return x, nil
+	return ""
 }
 
-type GoCryptoTrader_GetTickerStreamClient interface {
-	Recv() (*TickerResponse, error)
-	grpc.ClientStream
+type GetHistoricCandlesResponse struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                string        `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                  string        `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Interval             string        `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Candle               []*Candle     `protobuf:"bytes,7,rep,name=candle,proto3" json:"candle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-type goCryptoTraderGetTickerStreamClient struct {
-	grpc.ClientStream
+func (m *GetHistoricCandlesResponse) Reset()         { *m = GetHistoricCandlesResponse{} }
+func (m *GetHistoricCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHistoricCandlesResponse) ProtoMessage()    {}
+func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{118}
 }
 
-func (x *goCryptoTraderGetTickerStreamClient) Recv() (*TickerResponse, error) {
-	m := new(TickerResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetHistoricCandlesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHistoricCandlesResponse.Unmarshal(m, b)
 }
-
-func (c *goCryptoTraderClient) GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[4], "/gctrpc.GoCryptoTrader/GetExchangeTickerStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetExchangeTickerStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *GetHistoricCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHistoricCandlesResponse.Marshal(b, m, deterministic)
 }
-
-type GoCryptoTrader_GetExchangeTickerStreamClient interface {
-	Recv() (*TickerResponse, error)
-	grpc.ClientStream
+func (m *GetHistoricCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHistoricCandlesResponse.Merge(m, src)
 }
-
-type goCryptoTraderGetExchangeTickerStreamClient struct {
-	grpc.ClientStream
+func (m *GetHistoricCandlesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetHistoricCandlesResponse.Size(m)
 }
-
-func (x *goCryptoTraderGetExchangeTickerStreamClient) Recv() (*TickerResponse, error) {
-	m := new(TickerResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetHistoricCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHistoricCandlesResponse.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error) {
-	out := new(GetAuditEventResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAuditEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_GetHistoricCandlesResponse proto.InternalMessageInfo
 
-func (c *goCryptoTraderClient) GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptExecute", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetHistoricCandlesResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptUpload", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetHistoricCandlesResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
-	out := new(GCTScriptQueryResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptReadScript", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetHistoricCandlesResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
-	out := new(GCTScriptStatusResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetHistoricCandlesResponse) GetStart() string {
+	if m != nil {
+		return m.Start
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
-	out := new(GCTScriptQueryResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptQuery", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetHistoricCandlesResponse) GetEnd() string {
+	if m != nil {
+		return m.End
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStop", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetHistoricCandlesResponse) GetInterval() string {
+	if m != nil {
+		return m.Interval
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStopAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetHistoricCandlesResponse) GetCandle() []*Candle {
+	if m != nil {
+		return m.Candle
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
-	out := new(GCTScriptStatusResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptListAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Candle struct {
+	Time                 string   `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Low                  float64  `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
+	High                 float64  `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Open                 float64  `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
+	Close                float64  `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume               float64  `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{119}
 }
 
-func (c *goCryptoTraderClient) GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error) {
-	out := new(GetHistoricCandlesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetHistoricCandles", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Candle.Unmarshal(m, b)
 }
-
-// GoCryptoTraderServer is the server API for GoCryptoTrader service.
-type GoCryptoTraderServer interface {
-	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
-	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
-	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
-	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
-	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
-	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
-	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
-	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
-	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
-	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error)
-	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
-	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
-	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
-	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
-	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
-	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
-	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
-	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error
-	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
-	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
-	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
-	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error)
-	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error)
-	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
-	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
-	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
-	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
-	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
-	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
-	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
-	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
-	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
-	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
-	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
-	RemoveEvent(context.Context, *RemoveEventRequest) (*RemoveEventResponse, error)
-	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
-	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
-	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
-	WithdrawFiatFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
-	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
-	EnableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
-	DisableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
-	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
-	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
-	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
-	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
-	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
-	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error)
-	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
 }
-
-// UnimplementedGoCryptoTraderServer can be embedded to have forward compatible implementations.
-type UnimplementedGoCryptoTraderServer struct {
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return xxx_messageInfo_Candle.Size(m)
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
 }
 
-func (*UnimplementedGoCryptoTraderServer) GetInfo(ctx context.Context, req *GetInfoRequest) (*GetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetSubsystems(ctx context.Context, req *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
+
+func (m *Candle) GetLow() float64 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) EnableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
+
+func (m *Candle) GetHigh() float64 {
+	if m != nil {
+		return m.High
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) DisableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
+
+func (m *Candle) GetOpen() float64 {
+	if m != nil {
+		return m.Open
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetRPCEndpoints(ctx context.Context, req *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
+
+func (m *Candle) GetClose() float64 {
+	if m != nil {
+		return m.Close
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetCommunicationRelayers(ctx context.Context, req *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+
+func (m *Candle) GetVolume() float64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchanges(ctx context.Context, req *GetExchangesRequest) (*GetExchangesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+
+type CandleJob struct {
+	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange             string             `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair      `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string             `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string             `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start                string             `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End                  string             `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	Status               string             `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	RangesTotal          int64              `protobuf:"varint,9,opt,name=ranges_total,json=rangesTotal,proto3" json:"ranges_total,omitempty"`
+	RangesComplete       int64              `protobuf:"varint,10,opt,name=ranges_complete,json=rangesComplete,proto3" json:"ranges_complete,omitempty"`
+	CandlesSaved         uint64             `protobuf:"varint,11,opt,name=candles_saved,json=candlesSaved,proto3" json:"candles_saved,omitempty"`
+	Error                string             `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt            string             `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            string             `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Uncovered            []*CandleDateRange `protobuf:"bytes,15,rep,name=uncovered,proto3" json:"uncovered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) DisableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+
+func (m *CandleJob) Reset()         { *m = CandleJob{} }
+func (m *CandleJob) String() string { return proto.CompactTextString(m) }
+func (*CandleJob) ProtoMessage()    {}
+func (*CandleJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{120}
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeInfo(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+
+func (m *CandleJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandleJob.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+func (m *CandleJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandleJob.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCodes(ctx context.Context, req *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+func (m *CandleJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandleJob.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) EnableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+func (m *CandleJob) XXX_Size() int {
+	return xxx_messageInfo_CandleJob.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetTicker(ctx context.Context, req *GetTickerRequest) (*TickerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+func (m *CandleJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandleJob.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetTickers(ctx context.Context, req *GetTickersRequest) (*GetTickersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+
+var xxx_messageInfo_CandleJob proto.InternalMessageInfo
+
+func (m *CandleJob) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrderbook(ctx context.Context, req *GetOrderbookRequest) (*OrderbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+
+func (m *CandleJob) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrderbooks(ctx context.Context, req *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+
+func (m *CandleJob) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) GetAccountInfo(ctx context.Context, req *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+
+func (m *CandleJob) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetAccountInfoStream(req *GetAccountInfoRequest, srv GoCryptoTrader_GetAccountInfoStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
+
+func (m *CandleJob) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetConfig(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+
+func (m *CandleJob) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetPortfolio(ctx context.Context, req *GetPortfolioRequest) (*GetPortfolioResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+
+func (m *CandleJob) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetPortfolioSummary(ctx context.Context, req *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+
+func (m *CandleJob) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) AddPortfolioAddress(ctx context.Context, req *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+
+func (m *CandleJob) GetRangesTotal() int64 {
+	if m != nil {
+		return m.RangesTotal
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(ctx context.Context, req *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
+
+func (m *CandleJob) GetRangesComplete() int64 {
+	if m != nil {
+		return m.RangesComplete
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetForexProviders(ctx context.Context, req *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
+
+func (m *CandleJob) GetCandlesSaved() uint64 {
+	if m != nil {
+		return m.CandlesSaved
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetForexRates(ctx context.Context, req *GetForexRatesRequest) (*GetForexRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
+
+func (m *CandleJob) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrders(ctx context.Context, req *GetOrdersRequest) (*GetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+
+func (m *CandleJob) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrder(ctx context.Context, req *GetOrderRequest) (*OrderDetails, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+
+func (m *CandleJob) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) SubmitOrder(ctx context.Context, req *SubmitOrderRequest) (*SubmitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
+
+func (m *CandleJob) GetUncovered() []*CandleDateRange {
+	if m != nil {
+		return m.Uncovered
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) SimulateOrder(ctx context.Context, req *SimulateOrderRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+
+type StartCandleJobRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string        `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start                string        `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End                  string        `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) WhaleBomb(ctx context.Context, req *WhaleBombRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
+
+func (m *StartCandleJobRequest) Reset()         { *m = StartCandleJobRequest{} }
+func (m *StartCandleJobRequest) String() string { return proto.CompactTextString(m) }
+func (*StartCandleJobRequest) ProtoMessage()    {}
+func (*StartCandleJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{121}
 }
-func (*UnimplementedGoCryptoTraderServer) CancelOrder(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+
+func (m *StartCandleJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartCandleJobRequest.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) CancelAllOrders(ctx context.Context, req *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+func (m *StartCandleJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartCandleJobRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) GetEvents(ctx context.Context, req *GetEventsRequest) (*GetEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+func (m *StartCandleJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartCandleJobRequest.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) AddEvent(ctx context.Context, req *AddEventRequest) (*AddEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+func (m *StartCandleJobRequest) XXX_Size() int {
+	return xxx_messageInfo_StartCandleJobRequest.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) RemoveEvent(ctx context.Context, req *RemoveEventRequest) (*RemoveEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+func (m *StartCandleJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartCandleJobRequest.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddresses(ctx context.Context, req *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+
+var xxx_messageInfo_StartCandleJobRequest proto.InternalMessageInfo
+
+func (m *StartCandleJobRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddress(ctx context.Context, req *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+
+func (m *StartCandleJobRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+
+func (m *StartCandleJobRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+
+func (m *StartCandleJobRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetLoggerDetails(ctx context.Context, req *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+
+func (m *StartCandleJobRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) SetLoggerDetails(ctx context.Context, req *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+
+func (m *StartCandleJobRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangePairs(ctx context.Context, req *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+
+type GetCandleJobsRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) EnableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableExchangePair not implemented")
+
+func (m *GetCandleJobsRequest) Reset()         { *m = GetCandleJobsRequest{} }
+func (m *GetCandleJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCandleJobsRequest) ProtoMessage()    {}
+func (*GetCandleJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{122}
 }
-func (*UnimplementedGoCryptoTraderServer) DisableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableExchangePair not implemented")
+
+func (m *GetCandleJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCandleJobsRequest.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrderbookStream(req *GetOrderbookStreamRequest, srv GoCryptoTrader_GetOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+func (m *GetCandleJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCandleJobsRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(req *GetExchangeOrderbookStreamRequest, srv GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+func (m *GetCandleJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCandleJobsRequest.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) GetTickerStream(req *GetTickerStreamRequest, srv GoCryptoTrader_GetTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+func (m *GetCandleJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCandleJobsRequest.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(req *GetExchangeTickerStreamRequest, srv GoCryptoTrader_GetExchangeTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+func (m *GetCandleJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCandleJobsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetAuditEvent(ctx context.Context, req *GetAuditEventRequest) (*GetAuditEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+
+var xxx_messageInfo_GetCandleJobsRequest proto.InternalMessageInfo
+
+func (m *GetCandleJobsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptExecute(ctx context.Context, req *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
+
+type GetCandleJobsResponse struct {
+	Jobs                 []*CandleJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptUpload(ctx context.Context, req *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptUpload not implemented")
+
+func (m *GetCandleJobsResponse) Reset()         { *m = GetCandleJobsResponse{} }
+func (m *GetCandleJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCandleJobsResponse) ProtoMessage()    {}
+func (*GetCandleJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{123}
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptReadScript(ctx context.Context, req *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptReadScript not implemented")
+
+func (m *GetCandleJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCandleJobsResponse.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptStatus(ctx context.Context, req *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStatus not implemented")
+func (m *GetCandleJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCandleJobsResponse.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptQuery(ctx context.Context, req *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptQuery not implemented")
+func (m *GetCandleJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCandleJobsResponse.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptStop(ctx context.Context, req *GCTScriptStopRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStop not implemented")
+func (m *GetCandleJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCandleJobsResponse.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptStopAll(ctx context.Context, req *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStopAll not implemented")
+func (m *GetCandleJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCandleJobsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptListAll(ctx context.Context, req *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListAll not implemented")
+
+var xxx_messageInfo_GetCandleJobsResponse proto.InternalMessageInfo
+
+func (m *GetCandleJobsResponse) GetJobs() []*CandleJob {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptAutoLoadToggle(ctx context.Context, req *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
+
+type GetCandleCoverageRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string        `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start                string        `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End                  string        `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetCandleCoverageRequest) Reset()         { *m = GetCandleCoverageRequest{} }
+func (m *GetCandleCoverageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCandleCoverageRequest) ProtoMessage()    {}
+func (*GetCandleCoverageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{124}
+}
+
+func (m *GetCandleCoverageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCandleCoverageRequest.Unmarshal(m, b)
+}
+func (m *GetCandleCoverageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCandleCoverageRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCandleCoverageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCandleCoverageRequest.Merge(m, src)
+}
+func (m *GetCandleCoverageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCandleCoverageRequest.Size(m)
+}
+func (m *GetCandleCoverageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCandleCoverageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCandleCoverageRequest proto.InternalMessageInfo
+
+func (m *GetCandleCoverageRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetCandleCoverageRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetCandleCoverageRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetCandleCoverageRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *GetCandleCoverageRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *GetCandleCoverageRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+type CandleDateRange struct {
+	Start                string   `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  string   `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandleDateRange) Reset()         { *m = CandleDateRange{} }
+func (m *CandleDateRange) String() string { return proto.CompactTextString(m) }
+func (*CandleDateRange) ProtoMessage()    {}
+func (*CandleDateRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{125}
+}
+
+func (m *CandleDateRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandleDateRange.Unmarshal(m, b)
+}
+func (m *CandleDateRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandleDateRange.Marshal(b, m, deterministic)
+}
+func (m *CandleDateRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandleDateRange.Merge(m, src)
+}
+func (m *CandleDateRange) XXX_Size() int {
+	return xxx_messageInfo_CandleDateRange.Size(m)
+}
+func (m *CandleDateRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandleDateRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandleDateRange proto.InternalMessageInfo
+
+func (m *CandleDateRange) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *CandleDateRange) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+type GetCandleCoverageResponse struct {
+	Exchange             string             `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair      `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string             `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string             `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	StoredCandles        uint64             `protobuf:"varint,5,opt,name=stored_candles,json=storedCandles,proto3" json:"stored_candles,omitempty"`
+	Stored               []*CandleDateRange `protobuf:"bytes,6,rep,name=stored,proto3" json:"stored,omitempty"`
+	Missing              []*CandleDateRange `protobuf:"bytes,7,rep,name=missing,proto3" json:"missing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetCandleCoverageResponse) Reset()         { *m = GetCandleCoverageResponse{} }
+func (m *GetCandleCoverageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCandleCoverageResponse) ProtoMessage()    {}
+func (*GetCandleCoverageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{126}
+}
+
+func (m *GetCandleCoverageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCandleCoverageResponse.Unmarshal(m, b)
+}
+func (m *GetCandleCoverageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCandleCoverageResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCandleCoverageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCandleCoverageResponse.Merge(m, src)
+}
+func (m *GetCandleCoverageResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCandleCoverageResponse.Size(m)
+}
+func (m *GetCandleCoverageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCandleCoverageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCandleCoverageResponse proto.InternalMessageInfo
+
+func (m *GetCandleCoverageResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetCandleCoverageResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetCandleCoverageResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetCandleCoverageResponse) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *GetCandleCoverageResponse) GetStoredCandles() uint64 {
+	if m != nil {
+		return m.StoredCandles
+	}
+	return 0
+}
+
+func (m *GetCandleCoverageResponse) GetStored() []*CandleDateRange {
+	if m != nil {
+		return m.Stored
+	}
+	return nil
+}
+
+func (m *GetCandleCoverageResponse) GetMissing() []*CandleDateRange {
+	if m != nil {
+		return m.Missing
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Identifier           string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp            string   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{127}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+type GCTScript struct {
+	UUID                 string   `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	NextRun              string   `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScript) Reset()         { *m = GCTScript{} }
+func (m *GCTScript) String() string { return proto.CompactTextString(m) }
+func (*GCTScript) ProtoMessage()    {}
+func (*GCTScript) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{128}
+}
+
+func (m *GCTScript) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScript.Unmarshal(m, b)
+}
+func (m *GCTScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScript.Marshal(b, m, deterministic)
+}
+func (m *GCTScript) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScript.Merge(m, src)
+}
+func (m *GCTScript) XXX_Size() int {
+	return xxx_messageInfo_GCTScript.Size(m)
+}
+func (m *GCTScript) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScript.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScript proto.InternalMessageInfo
+
+func (m *GCTScript) GetUUID() string {
+	if m != nil {
+		return m.UUID
+	}
+	return ""
+}
+
+func (m *GCTScript) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GCTScript) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GCTScript) GetNextRun() string {
+	if m != nil {
+		return m.NextRun
+	}
+	return ""
+}
+
+type GCTScriptExecuteRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptExecuteRequest) Reset()         { *m = GCTScriptExecuteRequest{} }
+func (m *GCTScriptExecuteRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptExecuteRequest) ProtoMessage()    {}
+func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{129}
+}
+
+func (m *GCTScriptExecuteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptExecuteRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptExecuteRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptExecuteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptExecuteRequest.Merge(m, src)
+}
+func (m *GCTScriptExecuteRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptExecuteRequest.Size(m)
+}
+func (m *GCTScriptExecuteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptExecuteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptExecuteRequest proto.InternalMessageInfo
+
+func (m *GCTScriptExecuteRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptStopRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptStopRequest) Reset()         { *m = GCTScriptStopRequest{} }
+func (m *GCTScriptStopRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStopRequest) ProtoMessage()    {}
+func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{130}
+}
+
+func (m *GCTScriptStopRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStopRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptStopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStopRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStopRequest.Merge(m, src)
+}
+func (m *GCTScriptStopRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStopRequest.Size(m)
+}
+func (m *GCTScriptStopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStopRequest proto.InternalMessageInfo
+
+func (m *GCTScriptStopRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptStopAllRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptStopAllRequest) Reset()         { *m = GCTScriptStopAllRequest{} }
+func (m *GCTScriptStopAllRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStopAllRequest) ProtoMessage()    {}
+func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{131}
+}
+
+func (m *GCTScriptStopAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStopAllRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptStopAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStopAllRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStopAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStopAllRequest.Merge(m, src)
+}
+func (m *GCTScriptStopAllRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStopAllRequest.Size(m)
+}
+func (m *GCTScriptStopAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStopAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStopAllRequest proto.InternalMessageInfo
+
+type GCTScriptStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptStatusRequest) Reset()         { *m = GCTScriptStatusRequest{} }
+func (m *GCTScriptStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStatusRequest) ProtoMessage()    {}
+func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{132}
+}
+
+func (m *GCTScriptStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStatusRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStatusRequest.Merge(m, src)
+}
+func (m *GCTScriptStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStatusRequest.Size(m)
+}
+func (m *GCTScriptStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStatusRequest proto.InternalMessageInfo
+
+type GCTScriptListAllRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptListAllRequest) Reset()         { *m = GCTScriptListAllRequest{} }
+func (m *GCTScriptListAllRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptListAllRequest) ProtoMessage()    {}
+func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{133}
+}
+
+func (m *GCTScriptListAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptListAllRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptListAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptListAllRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptListAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptListAllRequest.Merge(m, src)
+}
+func (m *GCTScriptListAllRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptListAllRequest.Size(m)
+}
+func (m *GCTScriptListAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptListAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptListAllRequest proto.InternalMessageInfo
+
+type GCTScriptUploadRequest struct {
+	ScriptName           string   `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	ScriptData           string   `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Archived             bool     `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	Overwrite            bool     `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptUploadRequest) Reset()         { *m = GCTScriptUploadRequest{} }
+func (m *GCTScriptUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptUploadRequest) ProtoMessage()    {}
+func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{134}
+}
+
+func (m *GCTScriptUploadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptUploadRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptUploadRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptUploadRequest.Merge(m, src)
+}
+func (m *GCTScriptUploadRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptUploadRequest.Size(m)
+}
+func (m *GCTScriptUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptUploadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptUploadRequest proto.InternalMessageInfo
+
+func (m *GCTScriptUploadRequest) GetScriptName() string {
+	if m != nil {
+		return m.ScriptName
+	}
+	return ""
+}
+
+func (m *GCTScriptUploadRequest) GetScriptData() string {
+	if m != nil {
+		return m.ScriptData
+	}
+	return ""
+}
+
+func (m *GCTScriptUploadRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GCTScriptUploadRequest) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
+func (m *GCTScriptUploadRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+type GCTScriptReadScriptRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptReadScriptRequest) Reset()         { *m = GCTScriptReadScriptRequest{} }
+func (m *GCTScriptReadScriptRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptReadScriptRequest) ProtoMessage()    {}
+func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{135}
+}
+
+func (m *GCTScriptReadScriptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptReadScriptRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptReadScriptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptReadScriptRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptReadScriptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptReadScriptRequest.Merge(m, src)
+}
+func (m *GCTScriptReadScriptRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptReadScriptRequest.Size(m)
+}
+func (m *GCTScriptReadScriptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptReadScriptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptReadScriptRequest proto.InternalMessageInfo
+
+func (m *GCTScriptReadScriptRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptQueryRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptQueryRequest) Reset()         { *m = GCTScriptQueryRequest{} }
+func (m *GCTScriptQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptQueryRequest) ProtoMessage()    {}
+func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{136}
+}
+
+func (m *GCTScriptQueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptQueryRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptQueryRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptQueryRequest.Merge(m, src)
+}
+func (m *GCTScriptQueryRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptQueryRequest.Size(m)
+}
+func (m *GCTScriptQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptQueryRequest proto.InternalMessageInfo
+
+func (m *GCTScriptQueryRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptAutoLoadRequest struct {
+	Script               string   `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Status               bool     `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptAutoLoadRequest) Reset()         { *m = GCTScriptAutoLoadRequest{} }
+func (m *GCTScriptAutoLoadRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptAutoLoadRequest) ProtoMessage()    {}
+func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{137}
+}
+
+func (m *GCTScriptAutoLoadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptAutoLoadRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptAutoLoadRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptAutoLoadRequest.Merge(m, src)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptAutoLoadRequest.Size(m)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptAutoLoadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptAutoLoadRequest proto.InternalMessageInfo
+
+func (m *GCTScriptAutoLoadRequest) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *GCTScriptAutoLoadRequest) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type GCTScriptStatusResponse struct {
+	Status               string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Scripts              []*GCTScript `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GCTScriptStatusResponse) Reset()         { *m = GCTScriptStatusResponse{} }
+func (m *GCTScriptStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStatusResponse) ProtoMessage()    {}
+func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{138}
+}
+
+func (m *GCTScriptStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStatusResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStatusResponse.Merge(m, src)
+}
+func (m *GCTScriptStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStatusResponse.Size(m)
+}
+func (m *GCTScriptStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStatusResponse proto.InternalMessageInfo
+
+func (m *GCTScriptStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptStatusResponse) GetScripts() []*GCTScript {
+	if m != nil {
+		return m.Scripts
+	}
+	return nil
+}
+
+type GCTScriptQueryResponse struct {
+	Status               string     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Script               *GCTScript `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	Data                 string     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptQueryResponse) Reset()         { *m = GCTScriptQueryResponse{} }
+func (m *GCTScriptQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptQueryResponse) ProtoMessage()    {}
+func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{139}
+}
+
+func (m *GCTScriptQueryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptQueryResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptQueryResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptQueryResponse.Merge(m, src)
+}
+func (m *GCTScriptQueryResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptQueryResponse.Size(m)
+}
+func (m *GCTScriptQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptQueryResponse proto.InternalMessageInfo
+
+func (m *GCTScriptQueryResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptQueryResponse) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+func (m *GCTScriptQueryResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type GCTScriptGenericResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptGenericResponse) Reset()         { *m = GCTScriptGenericResponse{} }
+func (m *GCTScriptGenericResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptGenericResponse) ProtoMessage()    {}
+func (*GCTScriptGenericResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{140}
+}
+
+func (m *GCTScriptGenericResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptGenericResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptGenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptGenericResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptGenericResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptGenericResponse.Merge(m, src)
+}
+func (m *GCTScriptGenericResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptGenericResponse.Size(m)
+}
+func (m *GCTScriptGenericResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptGenericResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptGenericResponse proto.InternalMessageInfo
+
+func (m *GCTScriptGenericResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptGenericResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type GCTScriptStateRequest struct {
+	Script               string   `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptStateRequest) Reset()         { *m = GCTScriptStateRequest{} }
+func (m *GCTScriptStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStateRequest) ProtoMessage()    {}
+func (*GCTScriptStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{141}
+}
+
+func (m *GCTScriptStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStateRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStateRequest.Merge(m, src)
+}
+func (m *GCTScriptStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStateRequest.Size(m)
+}
+func (m *GCTScriptStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStateRequest proto.InternalMessageInfo
+
+func (m *GCTScriptStateRequest) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+type GCTScriptStateValue struct {
+	Script               string   `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	UpdatedAt            string   `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptStateValue) Reset()         { *m = GCTScriptStateValue{} }
+func (m *GCTScriptStateValue) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStateValue) ProtoMessage()    {}
+func (*GCTScriptStateValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{142}
+}
+
+func (m *GCTScriptStateValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStateValue.Unmarshal(m, b)
+}
+func (m *GCTScriptStateValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStateValue.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStateValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStateValue.Merge(m, src)
+}
+func (m *GCTScriptStateValue) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStateValue.Size(m)
+}
+func (m *GCTScriptStateValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStateValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStateValue proto.InternalMessageInfo
+
+func (m *GCTScriptStateValue) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *GCTScriptStateValue) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GCTScriptStateValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *GCTScriptStateValue) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type GCTScriptStateResponse struct {
+	Status               string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Values               []*GCTScriptStateValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GCTScriptStateResponse) Reset()         { *m = GCTScriptStateResponse{} }
+func (m *GCTScriptStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStateResponse) ProtoMessage()    {}
+func (*GCTScriptStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{143}
+}
+
+func (m *GCTScriptStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStateResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStateResponse.Merge(m, src)
+}
+func (m *GCTScriptStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStateResponse.Size(m)
+}
+func (m *GCTScriptStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStateResponse proto.InternalMessageInfo
+
+func (m *GCTScriptStateResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptStateResponse) GetValues() []*GCTScriptStateValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type GCTScriptTestRequest struct {
+	Scripts              []string `protobuf:"bytes,1,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Fixture              []byte   `protobuf:"bytes,3,opt,name=fixture,proto3" json:"fixture,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptTestRequest) Reset()         { *m = GCTScriptTestRequest{} }
+func (m *GCTScriptTestRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptTestRequest) ProtoMessage()    {}
+func (*GCTScriptTestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{144}
+}
+
+func (m *GCTScriptTestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptTestRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptTestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptTestRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptTestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptTestRequest.Merge(m, src)
+}
+func (m *GCTScriptTestRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptTestRequest.Size(m)
+}
+func (m *GCTScriptTestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptTestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptTestRequest proto.InternalMessageInfo
+
+func (m *GCTScriptTestRequest) GetScripts() []string {
+	if m != nil {
+		return m.Scripts
+	}
+	return nil
+}
+
+func (m *GCTScriptTestRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GCTScriptTestRequest) GetFixture() []byte {
+	if m != nil {
+		return m.Fixture
+	}
+	return nil
+}
+
+type GCTScriptTestResult struct {
+	Script               string          `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Passed               bool            `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Assertions           int64           `protobuf:"varint,3,opt,name=assertions,proto3" json:"assertions,omitempty"`
+	Failures             []string        `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"`
+	Error                string          `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Orders               []*OrderDetails `protobuf:"bytes,6,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GCTScriptTestResult) Reset()         { *m = GCTScriptTestResult{} }
+func (m *GCTScriptTestResult) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptTestResult) ProtoMessage()    {}
+func (*GCTScriptTestResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{145}
+}
+
+func (m *GCTScriptTestResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptTestResult.Unmarshal(m, b)
+}
+func (m *GCTScriptTestResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptTestResult.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptTestResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptTestResult.Merge(m, src)
+}
+func (m *GCTScriptTestResult) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptTestResult.Size(m)
+}
+func (m *GCTScriptTestResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptTestResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptTestResult proto.InternalMessageInfo
+
+func (m *GCTScriptTestResult) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *GCTScriptTestResult) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *GCTScriptTestResult) GetAssertions() int64 {
+	if m != nil {
+		return m.Assertions
+	}
+	return 0
+}
+
+func (m *GCTScriptTestResult) GetFailures() []string {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func (m *GCTScriptTestResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *GCTScriptTestResult) GetOrders() []*OrderDetails {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type GCTScriptTestResponse struct {
+	Status               string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              []*GCTScriptTestResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GCTScriptTestResponse) Reset()         { *m = GCTScriptTestResponse{} }
+func (m *GCTScriptTestResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptTestResponse) ProtoMessage()    {}
+func (*GCTScriptTestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{146}
+}
+
+func (m *GCTScriptTestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptTestResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptTestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptTestResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptTestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptTestResponse.Merge(m, src)
+}
+func (m *GCTScriptTestResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptTestResponse.Size(m)
+}
+func (m *GCTScriptTestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptTestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptTestResponse proto.InternalMessageInfo
+
+func (m *GCTScriptTestResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptTestResponse) GetResults() []*GCTScriptTestResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ArbitrageOpportunity struct {
+	Pair                 *CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	BuyExchange          string        `protobuf:"bytes,2,opt,name=buy_exchange,json=buyExchange,proto3" json:"buy_exchange,omitempty"`
+	SellExchange         string        `protobuf:"bytes,3,opt,name=sell_exchange,json=sellExchange,proto3" json:"sell_exchange,omitempty"`
+	Amount               float64       `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BuyPrice             float64       `protobuf:"fixed64,5,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
+	SellPrice            float64       `protobuf:"fixed64,6,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	BuyLimit             float64       `protobuf:"fixed64,7,opt,name=buy_limit,json=buyLimit,proto3" json:"buy_limit,omitempty"`
+	SellLimit            float64       `protobuf:"fixed64,8,opt,name=sell_limit,json=sellLimit,proto3" json:"sell_limit,omitempty"`
+	Cost                 float64       `protobuf:"fixed64,9,opt,name=cost,proto3" json:"cost,omitempty"`
+	Proceeds             float64       `protobuf:"fixed64,10,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	TradingFees          float64       `protobuf:"fixed64,11,opt,name=trading_fees,json=tradingFees,proto3" json:"trading_fees,omitempty"`
+	WithdrawalFees       float64       `protobuf:"fixed64,12,opt,name=withdrawal_fees,json=withdrawalFees,proto3" json:"withdrawal_fees,omitempty"`
+	NetProfit            float64       `protobuf:"fixed64,13,opt,name=net_profit,json=netProfit,proto3" json:"net_profit,omitempty"`
+	NetProfitPercent     float64       `protobuf:"fixed64,14,opt,name=net_profit_percent,json=netProfitPercent,proto3" json:"net_profit_percent,omitempty"`
+	Timestamp            int64         `protobuf:"varint,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ArbitrageOpportunity) Reset()         { *m = ArbitrageOpportunity{} }
+func (m *ArbitrageOpportunity) String() string { return proto.CompactTextString(m) }
+func (*ArbitrageOpportunity) ProtoMessage()    {}
+func (*ArbitrageOpportunity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{147}
+}
+
+func (m *ArbitrageOpportunity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArbitrageOpportunity.Unmarshal(m, b)
+}
+func (m *ArbitrageOpportunity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArbitrageOpportunity.Marshal(b, m, deterministic)
+}
+func (m *ArbitrageOpportunity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbitrageOpportunity.Merge(m, src)
+}
+func (m *ArbitrageOpportunity) XXX_Size() int {
+	return xxx_messageInfo_ArbitrageOpportunity.Size(m)
+}
+func (m *ArbitrageOpportunity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbitrageOpportunity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArbitrageOpportunity proto.InternalMessageInfo
+
+func (m *ArbitrageOpportunity) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *ArbitrageOpportunity) GetBuyExchange() string {
+	if m != nil {
+		return m.BuyExchange
+	}
+	return ""
+}
+
+func (m *ArbitrageOpportunity) GetSellExchange() string {
+	if m != nil {
+		return m.SellExchange
+	}
+	return ""
+}
+
+func (m *ArbitrageOpportunity) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetBuyPrice() float64 {
+	if m != nil {
+		return m.BuyPrice
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetSellPrice() float64 {
+	if m != nil {
+		return m.SellPrice
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetBuyLimit() float64 {
+	if m != nil {
+		return m.BuyLimit
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetSellLimit() float64 {
+	if m != nil {
+		return m.SellLimit
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetCost() float64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetProceeds() float64 {
+	if m != nil {
+		return m.Proceeds
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetTradingFees() float64 {
+	if m != nil {
+		return m.TradingFees
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetWithdrawalFees() float64 {
+	if m != nil {
+		return m.WithdrawalFees
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetNetProfit() float64 {
+	if m != nil {
+		return m.NetProfit
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetNetProfitPercent() float64 {
+	if m != nil {
+		return m.NetProfitPercent
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetArbitrageOpportunitiesRequest struct {
+	Pair                 *CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetArbitrageOpportunitiesRequest) Reset()         { *m = GetArbitrageOpportunitiesRequest{} }
+func (m *GetArbitrageOpportunitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetArbitrageOpportunitiesRequest) ProtoMessage()    {}
+func (*GetArbitrageOpportunitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{148}
+}
+
+func (m *GetArbitrageOpportunitiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetArbitrageOpportunitiesRequest.Unmarshal(m, b)
+}
+func (m *GetArbitrageOpportunitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetArbitrageOpportunitiesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetArbitrageOpportunitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArbitrageOpportunitiesRequest.Merge(m, src)
+}
+func (m *GetArbitrageOpportunitiesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetArbitrageOpportunitiesRequest.Size(m)
+}
+func (m *GetArbitrageOpportunitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArbitrageOpportunitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetArbitrageOpportunitiesRequest proto.InternalMessageInfo
+
+func (m *GetArbitrageOpportunitiesRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+type GetArbitrageOpportunitiesResponse struct {
+	Opportunities        []*ArbitrageOpportunity `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetArbitrageOpportunitiesResponse) Reset()         { *m = GetArbitrageOpportunitiesResponse{} }
+func (m *GetArbitrageOpportunitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetArbitrageOpportunitiesResponse) ProtoMessage()    {}
+func (*GetArbitrageOpportunitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{149}
+}
+
+func (m *GetArbitrageOpportunitiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetArbitrageOpportunitiesResponse.Unmarshal(m, b)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetArbitrageOpportunitiesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArbitrageOpportunitiesResponse.Merge(m, src)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetArbitrageOpportunitiesResponse.Size(m)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArbitrageOpportunitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetArbitrageOpportunitiesResponse proto.InternalMessageInfo
+
+func (m *GetArbitrageOpportunitiesResponse) GetOpportunities() []*ArbitrageOpportunity {
+	if m != nil {
+		return m.Opportunities
+	}
+	return nil
+}
+
+type GetArbitrageStreamRequest struct {
+	Pair                 *CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetArbitrageStreamRequest) Reset()         { *m = GetArbitrageStreamRequest{} }
+func (m *GetArbitrageStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetArbitrageStreamRequest) ProtoMessage()    {}
+func (*GetArbitrageStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{150}
+}
+
+func (m *GetArbitrageStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetArbitrageStreamRequest.Unmarshal(m, b)
+}
+func (m *GetArbitrageStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetArbitrageStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetArbitrageStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArbitrageStreamRequest.Merge(m, src)
+}
+func (m *GetArbitrageStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetArbitrageStreamRequest.Size(m)
+}
+func (m *GetArbitrageStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArbitrageStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetArbitrageStreamRequest proto.InternalMessageInfo
+
+func (m *GetArbitrageStreamRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+type TriangularArbitrageLeg struct {
+	Pair                 *CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side                 string        `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	From                 string        `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string        `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Input                float64       `protobuf:"fixed64,5,opt,name=input,proto3" json:"input,omitempty"`
+	Output               float64       `protobuf:"fixed64,6,opt,name=output,proto3" json:"output,omitempty"`
+	Amount               float64       `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                float64       `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	LimitPrice           float64       `protobuf:"fixed64,9,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	FeeRate              float64       `protobuf:"fixed64,10,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TriangularArbitrageLeg) Reset()         { *m = TriangularArbitrageLeg{} }
+func (m *TriangularArbitrageLeg) String() string { return proto.CompactTextString(m) }
+func (*TriangularArbitrageLeg) ProtoMessage()    {}
+func (*TriangularArbitrageLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{151}
+}
+
+func (m *TriangularArbitrageLeg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriangularArbitrageLeg.Unmarshal(m, b)
+}
+func (m *TriangularArbitrageLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriangularArbitrageLeg.Marshal(b, m, deterministic)
+}
+func (m *TriangularArbitrageLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriangularArbitrageLeg.Merge(m, src)
+}
+func (m *TriangularArbitrageLeg) XXX_Size() int {
+	return xxx_messageInfo_TriangularArbitrageLeg.Size(m)
+}
+func (m *TriangularArbitrageLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriangularArbitrageLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriangularArbitrageLeg proto.InternalMessageInfo
+
+func (m *TriangularArbitrageLeg) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *TriangularArbitrageLeg) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *TriangularArbitrageLeg) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TriangularArbitrageLeg) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TriangularArbitrageLeg) GetInput() float64 {
+	if m != nil {
+		return m.Input
+	}
+	return 0
+}
+
+func (m *TriangularArbitrageLeg) GetOutput() float64 {
+	if m != nil {
+		return m.Output
+	}
+	return 0
+}
+
+func (m *TriangularArbitrageLeg) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TriangularArbitrageLeg) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *TriangularArbitrageLeg) GetLimitPrice() float64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
+}
+
+func (m *TriangularArbitrageLeg) GetFeeRate() float64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+type TriangularArbitrageCycle struct {
+	Exchange             string                    `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currencies           []string                  `protobuf:"bytes,2,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	Legs                 []*TriangularArbitrageLeg `protobuf:"bytes,3,rep,name=legs,proto3" json:"legs,omitempty"`
+	Input                float64                   `protobuf:"fixed64,4,opt,name=input,proto3" json:"input,omitempty"`
+	Output               float64                   `protobuf:"fixed64,5,opt,name=output,proto3" json:"output,omitempty"`
+	NetReturnPercent     float64                   `protobuf:"fixed64,6,opt,name=net_return_percent,json=netReturnPercent,proto3" json:"net_return_percent,omitempty"`
+	Timestamp            int64                     `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *TriangularArbitrageCycle) Reset()         { *m = TriangularArbitrageCycle{} }
+func (m *TriangularArbitrageCycle) String() string { return proto.CompactTextString(m) }
+func (*TriangularArbitrageCycle) ProtoMessage()    {}
+func (*TriangularArbitrageCycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{152}
+}
+
+func (m *TriangularArbitrageCycle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriangularArbitrageCycle.Unmarshal(m, b)
+}
+func (m *TriangularArbitrageCycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriangularArbitrageCycle.Marshal(b, m, deterministic)
+}
+func (m *TriangularArbitrageCycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriangularArbitrageCycle.Merge(m, src)
+}
+func (m *TriangularArbitrageCycle) XXX_Size() int {
+	return xxx_messageInfo_TriangularArbitrageCycle.Size(m)
+}
+func (m *TriangularArbitrageCycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriangularArbitrageCycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriangularArbitrageCycle proto.InternalMessageInfo
+
+func (m *TriangularArbitrageCycle) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *TriangularArbitrageCycle) GetCurrencies() []string {
+	if m != nil {
+		return m.Currencies
+	}
+	return nil
+}
+
+func (m *TriangularArbitrageCycle) GetLegs() []*TriangularArbitrageLeg {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
+}
+
+func (m *TriangularArbitrageCycle) GetInput() float64 {
+	if m != nil {
+		return m.Input
+	}
+	return 0
+}
+
+func (m *TriangularArbitrageCycle) GetOutput() float64 {
+	if m != nil {
+		return m.Output
+	}
+	return 0
+}
+
+func (m *TriangularArbitrageCycle) GetNetReturnPercent() float64 {
+	if m != nil {
+		return m.NetReturnPercent
+	}
+	return 0
+}
+
+func (m *TriangularArbitrageCycle) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetTriangularArbitrageRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	MinReturnPercent     float64  `protobuf:"fixed64,2,opt,name=min_return_percent,json=minReturnPercent,proto3" json:"min_return_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTriangularArbitrageRequest) Reset()         { *m = GetTriangularArbitrageRequest{} }
+func (m *GetTriangularArbitrageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTriangularArbitrageRequest) ProtoMessage()    {}
+func (*GetTriangularArbitrageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{153}
+}
+
+func (m *GetTriangularArbitrageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTriangularArbitrageRequest.Unmarshal(m, b)
+}
+func (m *GetTriangularArbitrageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTriangularArbitrageRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTriangularArbitrageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTriangularArbitrageRequest.Merge(m, src)
+}
+func (m *GetTriangularArbitrageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTriangularArbitrageRequest.Size(m)
+}
+func (m *GetTriangularArbitrageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTriangularArbitrageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTriangularArbitrageRequest proto.InternalMessageInfo
+
+func (m *GetTriangularArbitrageRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetTriangularArbitrageRequest) GetMinReturnPercent() float64 {
+	if m != nil {
+		return m.MinReturnPercent
+	}
+	return 0
+}
+
+type GetTriangularArbitrageResponse struct {
+	Cycles               []*TriangularArbitrageCycle `protobuf:"bytes,1,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *GetTriangularArbitrageResponse) Reset()         { *m = GetTriangularArbitrageResponse{} }
+func (m *GetTriangularArbitrageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTriangularArbitrageResponse) ProtoMessage()    {}
+func (*GetTriangularArbitrageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{154}
+}
+
+func (m *GetTriangularArbitrageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTriangularArbitrageResponse.Unmarshal(m, b)
+}
+func (m *GetTriangularArbitrageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTriangularArbitrageResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTriangularArbitrageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTriangularArbitrageResponse.Merge(m, src)
+}
+func (m *GetTriangularArbitrageResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTriangularArbitrageResponse.Size(m)
+}
+func (m *GetTriangularArbitrageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTriangularArbitrageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTriangularArbitrageResponse proto.InternalMessageInfo
+
+func (m *GetTriangularArbitrageResponse) GetCycles() []*TriangularArbitrageCycle {
+	if m != nil {
+		return m.Cycles
+	}
+	return nil
+}
+
+type RouteOrderRequest struct {
+	Pair                 *CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side                 string        `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string        `protobuf:"bytes,3,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount               float64       `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                float64       `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Exchanges            []string      `protobuf:"bytes,6,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	DryRun               bool          `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RouteOrderRequest) Reset()         { *m = RouteOrderRequest{} }
+func (m *RouteOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*RouteOrderRequest) ProtoMessage()    {}
+func (*RouteOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{155}
+}
+
+func (m *RouteOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteOrderRequest.Unmarshal(m, b)
+}
+func (m *RouteOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *RouteOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteOrderRequest.Merge(m, src)
+}
+func (m *RouteOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_RouteOrderRequest.Size(m)
+}
+func (m *RouteOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteOrderRequest proto.InternalMessageInfo
+
+func (m *RouteOrderRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *RouteOrderRequest) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *RouteOrderRequest) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *RouteOrderRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *RouteOrderRequest) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *RouteOrderRequest) GetExchanges() []string {
+	if m != nil {
+		return m.Exchanges
+	}
+	return nil
+}
+
+func (m *RouteOrderRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type RouteAllocation struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                float64  `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	LimitPrice           float64  `protobuf:"fixed64,4,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	Cost                 float64  `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	Fee                  float64  `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeRate              float64  `protobuf:"fixed64,7,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	OrderId              string   `protobuf:"bytes,8,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status               string   `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	ExecutedAmount       float64  `protobuf:"fixed64,10,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	FillPrice            float64  `protobuf:"fixed64,11,opt,name=fill_price,json=fillPrice,proto3" json:"fill_price,omitempty"`
+	Error                string   `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RouteAllocation) Reset()         { *m = RouteAllocation{} }
+func (m *RouteAllocation) String() string { return proto.CompactTextString(m) }
+func (*RouteAllocation) ProtoMessage()    {}
+func (*RouteAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{156}
+}
+
+func (m *RouteAllocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteAllocation.Unmarshal(m, b)
+}
+func (m *RouteAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteAllocation.Marshal(b, m, deterministic)
+}
+func (m *RouteAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteAllocation.Merge(m, src)
+}
+func (m *RouteAllocation) XXX_Size() int {
+	return xxx_messageInfo_RouteAllocation.Size(m)
+}
+func (m *RouteAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteAllocation proto.InternalMessageInfo
+
+func (m *RouteAllocation) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *RouteAllocation) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *RouteAllocation) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *RouteAllocation) GetLimitPrice() float64 {
+	if m != nil {
+		return m.LimitPrice
+	}
+	return 0
+}
+
+func (m *RouteAllocation) GetCost() float64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+func (m *RouteAllocation) GetFee() float64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *RouteAllocation) GetFeeRate() float64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *RouteAllocation) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *RouteAllocation) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *RouteAllocation) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
+	}
+	return 0
+}
+
+func (m *RouteAllocation) GetFillPrice() float64 {
+	if m != nil {
+		return m.FillPrice
+	}
+	return 0
+}
+
+func (m *RouteAllocation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type RouteExclusion struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RouteExclusion) Reset()         { *m = RouteExclusion{} }
+func (m *RouteExclusion) String() string { return proto.CompactTextString(m) }
+func (*RouteExclusion) ProtoMessage()    {}
+func (*RouteExclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{157}
+}
+
+func (m *RouteExclusion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteExclusion.Unmarshal(m, b)
+}
+func (m *RouteExclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteExclusion.Marshal(b, m, deterministic)
+}
+func (m *RouteExclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteExclusion.Merge(m, src)
+}
+func (m *RouteExclusion) XXX_Size() int {
+	return xxx_messageInfo_RouteExclusion.Size(m)
+}
+func (m *RouteExclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteExclusion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteExclusion proto.InternalMessageInfo
+
+func (m *RouteExclusion) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *RouteExclusion) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type RouteResponse struct {
+	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pair                 *CurrencyPair      `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side                 string             `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string             `protobuf:"bytes,4,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount               float64            `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Allocated            float64            `protobuf:"fixed64,6,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Cost                 float64            `protobuf:"fixed64,7,opt,name=cost,proto3" json:"cost,omitempty"`
+	Fees                 float64            `protobuf:"fixed64,8,opt,name=fees,proto3" json:"fees,omitempty"`
+	AveragePrice         float64            `protobuf:"fixed64,9,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	EffectivePrice       float64            `protobuf:"fixed64,10,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	ExecutedAmount       float64            `protobuf:"fixed64,11,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	FillPrice            float64            `protobuf:"fixed64,12,opt,name=fill_price,json=fillPrice,proto3" json:"fill_price,omitempty"`
+	Status               string             `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	DryRun               bool               `protobuf:"varint,14,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Allocations          []*RouteAllocation `protobuf:"bytes,15,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	Excluded             []*RouteExclusion  `protobuf:"bytes,16,rep,name=excluded,proto3" json:"excluded,omitempty"`
+	CreatedAt            int64              `protobuf:"varint,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RouteResponse) Reset()         { *m = RouteResponse{} }
+func (m *RouteResponse) String() string { return proto.CompactTextString(m) }
+func (*RouteResponse) ProtoMessage()    {}
+func (*RouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{158}
+}
+
+func (m *RouteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteResponse.Unmarshal(m, b)
+}
+func (m *RouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteResponse.Marshal(b, m, deterministic)
+}
+func (m *RouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteResponse.Merge(m, src)
+}
+func (m *RouteResponse) XXX_Size() int {
+	return xxx_messageInfo_RouteResponse.Size(m)
+}
+func (m *RouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteResponse proto.InternalMessageInfo
+
+func (m *RouteResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RouteResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *RouteResponse) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *RouteResponse) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *RouteResponse) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *RouteResponse) GetAllocated() float64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
+}
+
+func (m *RouteResponse) GetCost() float64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+func (m *RouteResponse) GetFees() float64 {
+	if m != nil {
+		return m.Fees
+	}
+	return 0
+}
+
+func (m *RouteResponse) GetAveragePrice() float64 {
+	if m != nil {
+		return m.AveragePrice
+	}
+	return 0
+}
+
+func (m *RouteResponse) GetEffectivePrice() float64 {
+	if m != nil {
+		return m.EffectivePrice
+	}
+	return 0
+}
+
+func (m *RouteResponse) GetExecutedAmount() float64 {
+	if m != nil {
+		return m.ExecutedAmount
+	}
+	return 0
+}
+
+func (m *RouteResponse) GetFillPrice() float64 {
+	if m != nil {
+		return m.FillPrice
+	}
+	return 0
+}
+
+func (m *RouteResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *RouteResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *RouteResponse) GetAllocations() []*RouteAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func (m *RouteResponse) GetExcluded() []*RouteExclusion {
+	if m != nil {
+		return m.Excluded
+	}
+	return nil
+}
+
+func (m *RouteResponse) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type GetRoutesRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRoutesRequest) Reset()         { *m = GetRoutesRequest{} }
+func (m *GetRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRoutesRequest) ProtoMessage()    {}
+func (*GetRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{159}
+}
+
+func (m *GetRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRoutesRequest.Unmarshal(m, b)
+}
+func (m *GetRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRoutesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoutesRequest.Merge(m, src)
+}
+func (m *GetRoutesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRoutesRequest.Size(m)
+}
+func (m *GetRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRoutesRequest proto.InternalMessageInfo
+
+func (m *GetRoutesRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetRoutesResponse struct {
+	Routes               []*RouteResponse `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetRoutesResponse) Reset()         { *m = GetRoutesResponse{} }
+func (m *GetRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRoutesResponse) ProtoMessage()    {}
+func (*GetRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{160}
+}
+
+func (m *GetRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRoutesResponse.Unmarshal(m, b)
+}
+func (m *GetRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRoutesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoutesResponse.Merge(m, src)
+}
+func (m *GetRoutesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRoutesResponse.Size(m)
+}
+func (m *GetRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRoutesResponse proto.InternalMessageInfo
+
+func (m *GetRoutesResponse) GetRoutes() []*RouteResponse {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type AggregatedOrderbookItem struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Amount               float64       `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                float64       `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	OriginalPrice        float64       `protobuf:"fixed64,5,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AggregatedOrderbookItem) Reset()         { *m = AggregatedOrderbookItem{} }
+func (m *AggregatedOrderbookItem) String() string { return proto.CompactTextString(m) }
+func (*AggregatedOrderbookItem) ProtoMessage()    {}
+func (*AggregatedOrderbookItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{161}
+}
+
+func (m *AggregatedOrderbookItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregatedOrderbookItem.Unmarshal(m, b)
+}
+func (m *AggregatedOrderbookItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregatedOrderbookItem.Marshal(b, m, deterministic)
+}
+func (m *AggregatedOrderbookItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedOrderbookItem.Merge(m, src)
+}
+func (m *AggregatedOrderbookItem) XXX_Size() int {
+	return xxx_messageInfo_AggregatedOrderbookItem.Size(m)
+}
+func (m *AggregatedOrderbookItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedOrderbookItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedOrderbookItem proto.InternalMessageInfo
+
+func (m *AggregatedOrderbookItem) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *AggregatedOrderbookItem) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *AggregatedOrderbookItem) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *AggregatedOrderbookItem) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *AggregatedOrderbookItem) GetOriginalPrice() float64 {
+	if m != nil {
+		return m.OriginalPrice
+	}
+	return 0
+}
+
+type GetAggregatedOrderbookRequest struct {
+	Pair                 *CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Convert              bool          `protobuf:"varint,2,opt,name=convert,proto3" json:"convert,omitempty"`
+	Depth                int64         `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetAggregatedOrderbookRequest) Reset()         { *m = GetAggregatedOrderbookRequest{} }
+func (m *GetAggregatedOrderbookRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAggregatedOrderbookRequest) ProtoMessage()    {}
+func (*GetAggregatedOrderbookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{162}
+}
+
+func (m *GetAggregatedOrderbookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAggregatedOrderbookRequest.Unmarshal(m, b)
+}
+func (m *GetAggregatedOrderbookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAggregatedOrderbookRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAggregatedOrderbookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAggregatedOrderbookRequest.Merge(m, src)
+}
+func (m *GetAggregatedOrderbookRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAggregatedOrderbookRequest.Size(m)
+}
+func (m *GetAggregatedOrderbookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAggregatedOrderbookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAggregatedOrderbookRequest proto.InternalMessageInfo
+
+func (m *GetAggregatedOrderbookRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetAggregatedOrderbookRequest) GetConvert() bool {
+	if m != nil {
+		return m.Convert
+	}
+	return false
+}
+
+func (m *GetAggregatedOrderbookRequest) GetDepth() int64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type AggregatedOrderbookResponse struct {
+	Pair                 *CurrencyPair              `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Converted            bool                       `protobuf:"varint,2,opt,name=converted,proto3" json:"converted,omitempty"`
+	Exchanges            []string                   `protobuf:"bytes,3,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	Bids                 []*AggregatedOrderbookItem `protobuf:"bytes,4,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks                 []*AggregatedOrderbookItem `protobuf:"bytes,5,rep,name=asks,proto3" json:"asks,omitempty"`
+	LastUpdated          int64                      `protobuf:"varint,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *AggregatedOrderbookResponse) Reset()         { *m = AggregatedOrderbookResponse{} }
+func (m *AggregatedOrderbookResponse) String() string { return proto.CompactTextString(m) }
+func (*AggregatedOrderbookResponse) ProtoMessage()    {}
+func (*AggregatedOrderbookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{163}
+}
+
+func (m *AggregatedOrderbookResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregatedOrderbookResponse.Unmarshal(m, b)
+}
+func (m *AggregatedOrderbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregatedOrderbookResponse.Marshal(b, m, deterministic)
+}
+func (m *AggregatedOrderbookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedOrderbookResponse.Merge(m, src)
+}
+func (m *AggregatedOrderbookResponse) XXX_Size() int {
+	return xxx_messageInfo_AggregatedOrderbookResponse.Size(m)
+}
+func (m *AggregatedOrderbookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedOrderbookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedOrderbookResponse proto.InternalMessageInfo
+
+func (m *AggregatedOrderbookResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *AggregatedOrderbookResponse) GetConverted() bool {
+	if m != nil {
+		return m.Converted
+	}
+	return false
+}
+
+func (m *AggregatedOrderbookResponse) GetExchanges() []string {
+	if m != nil {
+		return m.Exchanges
+	}
+	return nil
+}
+
+func (m *AggregatedOrderbookResponse) GetBids() []*AggregatedOrderbookItem {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *AggregatedOrderbookResponse) GetAsks() []*AggregatedOrderbookItem {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
+}
+
+func (m *AggregatedOrderbookResponse) GetLastUpdated() int64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+type PositionDetails struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType            string        `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side                 string        `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	Amount               float64       `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	AvailableAmount      float64       `protobuf:"fixed64,6,opt,name=available_amount,json=availableAmount,proto3" json:"available_amount,omitempty"`
+	EntryPrice           float64       `protobuf:"fixed64,7,opt,name=entry_price,json=entryPrice,proto3" json:"entry_price,omitempty"`
+	MarkPrice            float64       `protobuf:"fixed64,8,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	LiquidationPrice     float64       `protobuf:"fixed64,9,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	Leverage             float64       `protobuf:"fixed64,10,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	MarginMode           string        `protobuf:"bytes,11,opt,name=margin_mode,json=marginMode,proto3" json:"margin_mode,omitempty"`
+	Margin               float64       `protobuf:"fixed64,12,opt,name=margin,proto3" json:"margin,omitempty"`
+	UnrealisedPnl        float64       `protobuf:"fixed64,13,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	RealisedPnl          float64       `protobuf:"fixed64,14,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	UpdatedAt            int64         `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PositionDetails) Reset()         { *m = PositionDetails{} }
+func (m *PositionDetails) String() string { return proto.CompactTextString(m) }
+func (*PositionDetails) ProtoMessage()    {}
+func (*PositionDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{164}
+}
+
+func (m *PositionDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PositionDetails.Unmarshal(m, b)
+}
+func (m *PositionDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PositionDetails.Marshal(b, m, deterministic)
+}
+func (m *PositionDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionDetails.Merge(m, src)
+}
+func (m *PositionDetails) XXX_Size() int {
+	return xxx_messageInfo_PositionDetails.Size(m)
+}
+func (m *PositionDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionDetails proto.InternalMessageInfo
+
+func (m *PositionDetails) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *PositionDetails) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *PositionDetails) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *PositionDetails) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *PositionDetails) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PositionDetails) GetAvailableAmount() float64 {
+	if m != nil {
+		return m.AvailableAmount
+	}
+	return 0
+}
+
+func (m *PositionDetails) GetEntryPrice() float64 {
+	if m != nil {
+		return m.EntryPrice
+	}
+	return 0
+}
+
+func (m *PositionDetails) GetMarkPrice() float64 {
+	if m != nil {
+		return m.MarkPrice
+	}
+	return 0
+}
+
+func (m *PositionDetails) GetLiquidationPrice() float64 {
+	if m != nil {
+		return m.LiquidationPrice
+	}
+	return 0
+}
+
+func (m *PositionDetails) GetLeverage() float64 {
+	if m != nil {
+		return m.Leverage
+	}
+	return 0
+}
+
+func (m *PositionDetails) GetMarginMode() string {
+	if m != nil {
+		return m.MarginMode
+	}
+	return ""
+}
+
+func (m *PositionDetails) GetMargin() float64 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *PositionDetails) GetUnrealisedPnl() float64 {
+	if m != nil {
+		return m.UnrealisedPnl
+	}
+	return 0
+}
+
+func (m *PositionDetails) GetRealisedPnl() float64 {
+	if m != nil {
+		return m.RealisedPnl
+	}
+	return 0
+}
+
+func (m *PositionDetails) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type GetPositionsRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType            string        `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetPositionsRequest) Reset()         { *m = GetPositionsRequest{} }
+func (m *GetPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPositionsRequest) ProtoMessage()    {}
+func (*GetPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{165}
+}
+
+func (m *GetPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPositionsRequest.Unmarshal(m, b)
+}
+func (m *GetPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPositionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPositionsRequest.Merge(m, src)
+}
+func (m *GetPositionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPositionsRequest.Size(m)
+}
+func (m *GetPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPositionsRequest proto.InternalMessageInfo
+
+func (m *GetPositionsRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetPositionsRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetPositionsRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+type GetPositionsResponse struct {
+	Positions            []*PositionDetails `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetPositionsResponse) Reset()         { *m = GetPositionsResponse{} }
+func (m *GetPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPositionsResponse) ProtoMessage()    {}
+func (*GetPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{166}
+}
+
+func (m *GetPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPositionsResponse.Unmarshal(m, b)
+}
+func (m *GetPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPositionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPositionsResponse.Merge(m, src)
+}
+func (m *GetPositionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPositionsResponse.Size(m)
+}
+func (m *GetPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPositionsResponse proto.InternalMessageInfo
+
+func (m *GetPositionsResponse) GetPositions() []*PositionDetails {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+type SetLeverageRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Leverage             float64       `protobuf:"fixed64,4,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SetLeverageRequest) Reset()         { *m = SetLeverageRequest{} }
+func (m *SetLeverageRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLeverageRequest) ProtoMessage()    {}
+func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{167}
+}
+
+func (m *SetLeverageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLeverageRequest.Unmarshal(m, b)
+}
+func (m *SetLeverageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLeverageRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLeverageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLeverageRequest.Merge(m, src)
+}
+func (m *SetLeverageRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLeverageRequest.Size(m)
+}
+func (m *SetLeverageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLeverageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLeverageRequest proto.InternalMessageInfo
+
+func (m *SetLeverageRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *SetLeverageRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *SetLeverageRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *SetLeverageRequest) GetLeverage() float64 {
+	if m != nil {
+		return m.Leverage
+	}
+	return 0
+}
+
+type SetLeverageResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLeverageResponse) Reset()         { *m = SetLeverageResponse{} }
+func (m *SetLeverageResponse) String() string { return proto.CompactTextString(m) }
+func (*SetLeverageResponse) ProtoMessage()    {}
+func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{168}
+}
+
+func (m *SetLeverageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLeverageResponse.Unmarshal(m, b)
+}
+func (m *SetLeverageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLeverageResponse.Marshal(b, m, deterministic)
+}
+func (m *SetLeverageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLeverageResponse.Merge(m, src)
+}
+func (m *SetLeverageResponse) XXX_Size() int {
+	return xxx_messageInfo_SetLeverageResponse.Size(m)
+}
+func (m *SetLeverageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLeverageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLeverageResponse proto.InternalMessageInfo
+
+type SetMarginModeRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	MarginMode           string        `protobuf:"bytes,4,opt,name=margin_mode,json=marginMode,proto3" json:"margin_mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SetMarginModeRequest) Reset()         { *m = SetMarginModeRequest{} }
+func (m *SetMarginModeRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMarginModeRequest) ProtoMessage()    {}
+func (*SetMarginModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{169}
+}
+
+func (m *SetMarginModeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMarginModeRequest.Unmarshal(m, b)
+}
+func (m *SetMarginModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMarginModeRequest.Marshal(b, m, deterministic)
+}
+func (m *SetMarginModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMarginModeRequest.Merge(m, src)
+}
+func (m *SetMarginModeRequest) XXX_Size() int {
+	return xxx_messageInfo_SetMarginModeRequest.Size(m)
+}
+func (m *SetMarginModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMarginModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMarginModeRequest proto.InternalMessageInfo
+
+func (m *SetMarginModeRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *SetMarginModeRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *SetMarginModeRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *SetMarginModeRequest) GetMarginMode() string {
+	if m != nil {
+		return m.MarginMode
+	}
+	return ""
+}
+
+type SetMarginModeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetMarginModeResponse) Reset()         { *m = SetMarginModeResponse{} }
+func (m *SetMarginModeResponse) String() string { return proto.CompactTextString(m) }
+func (*SetMarginModeResponse) ProtoMessage()    {}
+func (*SetMarginModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{170}
+}
+
+func (m *SetMarginModeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMarginModeResponse.Unmarshal(m, b)
+}
+func (m *SetMarginModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMarginModeResponse.Marshal(b, m, deterministic)
+}
+func (m *SetMarginModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMarginModeResponse.Merge(m, src)
+}
+func (m *SetMarginModeResponse) XXX_Size() int {
+	return xxx_messageInfo_SetMarginModeResponse.Size(m)
+}
+func (m *SetMarginModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMarginModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMarginModeResponse proto.InternalMessageInfo
+
+type GetFundingRatesRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetFundingRatesRequest) Reset()         { *m = GetFundingRatesRequest{} }
+func (m *GetFundingRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFundingRatesRequest) ProtoMessage()    {}
+func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{171}
+}
+
+func (m *GetFundingRatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFundingRatesRequest.Unmarshal(m, b)
+}
+func (m *GetFundingRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFundingRatesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFundingRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFundingRatesRequest.Merge(m, src)
+}
+func (m *GetFundingRatesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFundingRatesRequest.Size(m)
+}
+func (m *GetFundingRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFundingRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFundingRatesRequest proto.InternalMessageInfo
+
+func (m *GetFundingRatesRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetFundingRatesRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetFundingRatesRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type FundingRate struct {
+	Rate                 float64  `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundingRate) Reset()         { *m = FundingRate{} }
+func (m *FundingRate) String() string { return proto.CompactTextString(m) }
+func (*FundingRate) ProtoMessage()    {}
+func (*FundingRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{172}
+}
+
+func (m *FundingRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingRate.Unmarshal(m, b)
+}
+func (m *FundingRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingRate.Marshal(b, m, deterministic)
+}
+func (m *FundingRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingRate.Merge(m, src)
+}
+func (m *FundingRate) XXX_Size() int {
+	return xxx_messageInfo_FundingRate.Size(m)
+}
+func (m *FundingRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingRate proto.InternalMessageInfo
+
+func (m *FundingRate) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *FundingRate) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type GetFundingRatesResponse struct {
+	Exchange             string         `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair  `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string         `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Rates                []*FundingRate `protobuf:"bytes,4,rep,name=rates,proto3" json:"rates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetFundingRatesResponse) Reset()         { *m = GetFundingRatesResponse{} }
+func (m *GetFundingRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFundingRatesResponse) ProtoMessage()    {}
+func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{173}
+}
+
+func (m *GetFundingRatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFundingRatesResponse.Unmarshal(m, b)
+}
+func (m *GetFundingRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFundingRatesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFundingRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFundingRatesResponse.Merge(m, src)
+}
+func (m *GetFundingRatesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFundingRatesResponse.Size(m)
+}
+func (m *GetFundingRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFundingRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFundingRatesResponse proto.InternalMessageInfo
+
+func (m *GetFundingRatesResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetFundingRatesResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetFundingRatesResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetFundingRatesResponse) GetRates() []*FundingRate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+type ClosePositionRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ClosePositionRequest) Reset()         { *m = ClosePositionRequest{} }
+func (m *ClosePositionRequest) String() string { return proto.CompactTextString(m) }
+func (*ClosePositionRequest) ProtoMessage()    {}
+func (*ClosePositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{174}
+}
+
+func (m *ClosePositionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClosePositionRequest.Unmarshal(m, b)
+}
+func (m *ClosePositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClosePositionRequest.Marshal(b, m, deterministic)
+}
+func (m *ClosePositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosePositionRequest.Merge(m, src)
+}
+func (m *ClosePositionRequest) XXX_Size() int {
+	return xxx_messageInfo_ClosePositionRequest.Size(m)
+}
+func (m *ClosePositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosePositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClosePositionRequest proto.InternalMessageInfo
+
+func (m *ClosePositionRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ClosePositionRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *ClosePositionRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *ClosePositionRequest) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+type GetTradingRulesRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetTradingRulesRequest) Reset()         { *m = GetTradingRulesRequest{} }
+func (m *GetTradingRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTradingRulesRequest) ProtoMessage()    {}
+func (*GetTradingRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{175}
+}
+
+func (m *GetTradingRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTradingRulesRequest.Unmarshal(m, b)
+}
+func (m *GetTradingRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTradingRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTradingRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTradingRulesRequest.Merge(m, src)
+}
+func (m *GetTradingRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTradingRulesRequest.Size(m)
+}
+func (m *GetTradingRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTradingRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTradingRulesRequest proto.InternalMessageInfo
+
+func (m *GetTradingRulesRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetTradingRulesRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetTradingRulesRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type TradingRules struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	PriceTick            float64       `protobuf:"fixed64,4,opt,name=price_tick,json=priceTick,proto3" json:"price_tick,omitempty"`
+	AmountStep           float64       `protobuf:"fixed64,5,opt,name=amount_step,json=amountStep,proto3" json:"amount_step,omitempty"`
+	MinAmount            float64       `protobuf:"fixed64,6,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount            float64       `protobuf:"fixed64,7,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	MinNotional          float64       `protobuf:"fixed64,8,opt,name=min_notional,json=minNotional,proto3" json:"min_notional,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TradingRules) Reset()         { *m = TradingRules{} }
+func (m *TradingRules) String() string { return proto.CompactTextString(m) }
+func (*TradingRules) ProtoMessage()    {}
+func (*TradingRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{176}
+}
+
+func (m *TradingRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradingRules.Unmarshal(m, b)
+}
+func (m *TradingRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradingRules.Marshal(b, m, deterministic)
+}
+func (m *TradingRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingRules.Merge(m, src)
+}
+func (m *TradingRules) XXX_Size() int {
+	return xxx_messageInfo_TradingRules.Size(m)
+}
+func (m *TradingRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingRules proto.InternalMessageInfo
+
+func (m *TradingRules) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *TradingRules) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *TradingRules) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *TradingRules) GetPriceTick() float64 {
+	if m != nil {
+		return m.PriceTick
+	}
+	return 0
+}
+
+func (m *TradingRules) GetAmountStep() float64 {
+	if m != nil {
+		return m.AmountStep
+	}
+	return 0
+}
+
+func (m *TradingRules) GetMinAmount() float64 {
+	if m != nil {
+		return m.MinAmount
+	}
+	return 0
+}
+
+func (m *TradingRules) GetMaxAmount() float64 {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return 0
+}
+
+func (m *TradingRules) GetMinNotional() float64 {
+	if m != nil {
+		return m.MinNotional
+	}
+	return 0
+}
+
+type SubmitOrdersRequest struct {
+	Exchange             string                `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Orders               []*SubmitOrderRequest `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SubmitOrdersRequest) Reset()         { *m = SubmitOrdersRequest{} }
+func (m *SubmitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitOrdersRequest) ProtoMessage()    {}
+func (*SubmitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{177}
+}
+
+func (m *SubmitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitOrdersRequest.Unmarshal(m, b)
+}
+func (m *SubmitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *SubmitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitOrdersRequest.Merge(m, src)
+}
+func (m *SubmitOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitOrdersRequest.Size(m)
+}
+func (m *SubmitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitOrdersRequest proto.InternalMessageInfo
+
+func (m *SubmitOrdersRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *SubmitOrdersRequest) GetOrders() []*SubmitOrderRequest {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type SubmitOrdersResponse struct {
+	Orders               []*SubmitOrderResponse `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SubmitOrdersResponse) Reset()         { *m = SubmitOrdersResponse{} }
+func (m *SubmitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitOrdersResponse) ProtoMessage()    {}
+func (*SubmitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{178}
+}
+
+func (m *SubmitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitOrdersResponse.Unmarshal(m, b)
+}
+func (m *SubmitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitOrdersResponse.Marshal(b, m, deterministic)
+}
+func (m *SubmitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitOrdersResponse.Merge(m, src)
+}
+func (m *SubmitOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_SubmitOrdersResponse.Size(m)
+}
+func (m *SubmitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitOrdersResponse proto.InternalMessageInfo
+
+func (m *SubmitOrdersResponse) GetOrders() []*SubmitOrderResponse {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type CancelOrdersRequest struct {
+	Exchange             string                `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Orders               []*CancelOrderRequest `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CancelOrdersRequest) Reset()         { *m = CancelOrdersRequest{} }
+func (m *CancelOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelOrdersRequest) ProtoMessage()    {}
+func (*CancelOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{179}
+}
+
+func (m *CancelOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOrdersRequest.Unmarshal(m, b)
+}
+func (m *CancelOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOrdersRequest.Merge(m, src)
+}
+func (m *CancelOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelOrdersRequest.Size(m)
+}
+func (m *CancelOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOrdersRequest proto.InternalMessageInfo
+
+func (m *CancelOrdersRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *CancelOrdersRequest) GetOrders() []*CancelOrderRequest {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type CancelOrdersResponse struct {
+	OrderStatus          map[string]string `protobuf:"bytes,1,rep,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelOrdersResponse) Reset()         { *m = CancelOrdersResponse{} }
+func (m *CancelOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelOrdersResponse) ProtoMessage()    {}
+func (*CancelOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{180}
+}
+
+func (m *CancelOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOrdersResponse.Unmarshal(m, b)
+}
+func (m *CancelOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOrdersResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOrdersResponse.Merge(m, src)
+}
+func (m *CancelOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelOrdersResponse.Size(m)
+}
+func (m *CancelOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOrdersResponse proto.InternalMessageInfo
+
+func (m *CancelOrdersResponse) GetOrderStatus() map[string]string {
+	if m != nil {
+		return m.OrderStatus
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetInfoRequest)(nil), "gctrpc.GetInfoRequest")
+	proto.RegisterType((*GetInfoResponse)(nil), "gctrpc.GetInfoResponse")
+	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetInfoResponse.RpcEndpointsEntry")
+	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetInfoResponse.SubsystemStatusEntry")
+	proto.RegisterType((*GetCommunicationRelayersRequest)(nil), "gctrpc.GetCommunicationRelayersRequest")
+	proto.RegisterType((*CommunicationRelayer)(nil), "gctrpc.CommunicationRelayer")
+	proto.RegisterType((*GetCommunicationRelayersResponse)(nil), "gctrpc.GetCommunicationRelayersResponse")
+	proto.RegisterMapType((map[string]*CommunicationRelayer)(nil), "gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry")
+	proto.RegisterType((*GenericSubsystemRequest)(nil), "gctrpc.GenericSubsystemRequest")
+	proto.RegisterType((*GenericSubsystemResponse)(nil), "gctrpc.GenericSubsystemResponse")
+	proto.RegisterType((*GetSubsystemsRequest)(nil), "gctrpc.GetSubsystemsRequest")
+	proto.RegisterType((*GetSusbsytemsResponse)(nil), "gctrpc.GetSusbsytemsResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry")
+	proto.RegisterType((*GetRPCEndpointsRequest)(nil), "gctrpc.GetRPCEndpointsRequest")
+	proto.RegisterType((*RPCEndpoint)(nil), "gctrpc.RPCEndpoint")
+	proto.RegisterType((*GetRPCEndpointsResponse)(nil), "gctrpc.GetRPCEndpointsResponse")
+	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetRPCEndpointsResponse.EndpointsEntry")
+	proto.RegisterType((*GenericExchangeNameRequest)(nil), "gctrpc.GenericExchangeNameRequest")
+	proto.RegisterType((*GenericExchangeNameResponse)(nil), "gctrpc.GenericExchangeNameResponse")
+	proto.RegisterType((*GetExchangesRequest)(nil), "gctrpc.GetExchangesRequest")
+	proto.RegisterType((*GetExchangesResponse)(nil), "gctrpc.GetExchangesResponse")
+	proto.RegisterType((*GetExchangeOTPReponse)(nil), "gctrpc.GetExchangeOTPReponse")
+	proto.RegisterType((*GetExchangeOTPsRequest)(nil), "gctrpc.GetExchangeOTPsRequest")
+	proto.RegisterType((*GetExchangeOTPsResponse)(nil), "gctrpc.GetExchangeOTPsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetExchangeOTPsResponse.OtpCodesEntry")
+	proto.RegisterType((*DisableExchangeRequest)(nil), "gctrpc.DisableExchangeRequest")
+	proto.RegisterType((*PairsSupported)(nil), "gctrpc.PairsSupported")
+	proto.RegisterType((*GetExchangeInfoResponse)(nil), "gctrpc.GetExchangeInfoResponse")
+	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry")
+	proto.RegisterType((*GetTickerRequest)(nil), "gctrpc.GetTickerRequest")
+	proto.RegisterType((*CurrencyPair)(nil), "gctrpc.CurrencyPair")
+	proto.RegisterType((*TickerResponse)(nil), "gctrpc.TickerResponse")
+	proto.RegisterType((*GetTickersRequest)(nil), "gctrpc.GetTickersRequest")
+	proto.RegisterType((*Tickers)(nil), "gctrpc.Tickers")
+	proto.RegisterType((*GetTickersResponse)(nil), "gctrpc.GetTickersResponse")
+	proto.RegisterType((*GetOrderbookRequest)(nil), "gctrpc.GetOrderbookRequest")
+	proto.RegisterType((*OrderbookItem)(nil), "gctrpc.OrderbookItem")
+	proto.RegisterType((*OrderbookResponse)(nil), "gctrpc.OrderbookResponse")
+	proto.RegisterType((*GetOrderbooksRequest)(nil), "gctrpc.GetOrderbooksRequest")
+	proto.RegisterType((*Orderbooks)(nil), "gctrpc.Orderbooks")
+	proto.RegisterType((*GetOrderbooksResponse)(nil), "gctrpc.GetOrderbooksResponse")
+	proto.RegisterType((*GetAccountInfoRequest)(nil), "gctrpc.GetAccountInfoRequest")
+	proto.RegisterType((*Account)(nil), "gctrpc.Account")
+	proto.RegisterType((*AccountCurrencyInfo)(nil), "gctrpc.AccountCurrencyInfo")
+	proto.RegisterType((*GetAccountInfoResponse)(nil), "gctrpc.GetAccountInfoResponse")
+	proto.RegisterType((*GetConfigRequest)(nil), "gctrpc.GetConfigRequest")
+	proto.RegisterType((*GetConfigResponse)(nil), "gctrpc.GetConfigResponse")
+	proto.RegisterType((*PortfolioAddress)(nil), "gctrpc.PortfolioAddress")
+	proto.RegisterType((*GetPortfolioRequest)(nil), "gctrpc.GetPortfolioRequest")
+	proto.RegisterType((*GetPortfolioResponse)(nil), "gctrpc.GetPortfolioResponse")
+	proto.RegisterType((*GetPortfolioSummaryRequest)(nil), "gctrpc.GetPortfolioSummaryRequest")
+	proto.RegisterType((*Coin)(nil), "gctrpc.Coin")
+	proto.RegisterType((*OfflineCoinSummary)(nil), "gctrpc.OfflineCoinSummary")
+	proto.RegisterType((*OnlineCoinSummary)(nil), "gctrpc.OnlineCoinSummary")
+	proto.RegisterType((*OfflineCoins)(nil), "gctrpc.OfflineCoins")
+	proto.RegisterType((*OnlineCoins)(nil), "gctrpc.OnlineCoins")
+	proto.RegisterMapType((map[string]*OnlineCoinSummary)(nil), "gctrpc.OnlineCoins.CoinsEntry")
+	proto.RegisterType((*GetPortfolioSummaryResponse)(nil), "gctrpc.GetPortfolioSummaryResponse")
+	proto.RegisterMapType((map[string]*OfflineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry")
+	proto.RegisterMapType((map[string]*OnlineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry")
+	proto.RegisterType((*AddPortfolioAddressRequest)(nil), "gctrpc.AddPortfolioAddressRequest")
+	proto.RegisterType((*AddPortfolioAddressResponse)(nil), "gctrpc.AddPortfolioAddressResponse")
+	proto.RegisterType((*RemovePortfolioAddressRequest)(nil), "gctrpc.RemovePortfolioAddressRequest")
+	proto.RegisterType((*RemovePortfolioAddressResponse)(nil), "gctrpc.RemovePortfolioAddressResponse")
+	proto.RegisterType((*GetForexProvidersRequest)(nil), "gctrpc.GetForexProvidersRequest")
+	proto.RegisterType((*ForexProvider)(nil), "gctrpc.ForexProvider")
+	proto.RegisterType((*GetForexProvidersResponse)(nil), "gctrpc.GetForexProvidersResponse")
+	proto.RegisterType((*GetForexRatesRequest)(nil), "gctrpc.GetForexRatesRequest")
+	proto.RegisterType((*ForexRatesConversion)(nil), "gctrpc.ForexRatesConversion")
+	proto.RegisterType((*GetForexRatesResponse)(nil), "gctrpc.GetForexRatesResponse")
+	proto.RegisterType((*OrderDetails)(nil), "gctrpc.OrderDetails")
+	proto.RegisterType((*GetOrdersRequest)(nil), "gctrpc.GetOrdersRequest")
+	proto.RegisterType((*GetOrdersResponse)(nil), "gctrpc.GetOrdersResponse")
+	proto.RegisterType((*GetOrderRequest)(nil), "gctrpc.GetOrderRequest")
+	proto.RegisterType((*SubmitOrderRequest)(nil), "gctrpc.SubmitOrderRequest")
+	proto.RegisterType((*SubmitOrderResponse)(nil), "gctrpc.SubmitOrderResponse")
+	proto.RegisterType((*SimulateOrderRequest)(nil), "gctrpc.SimulateOrderRequest")
+	proto.RegisterType((*SimulateOrderResponse)(nil), "gctrpc.SimulateOrderResponse")
+	proto.RegisterType((*WhaleBombRequest)(nil), "gctrpc.WhaleBombRequest")
+	proto.RegisterType((*CancelOrderRequest)(nil), "gctrpc.CancelOrderRequest")
+	proto.RegisterType((*CancelOrderResponse)(nil), "gctrpc.CancelOrderResponse")
+	proto.RegisterType((*ModifyOrderRequest)(nil), "gctrpc.ModifyOrderRequest")
+	proto.RegisterType((*ModifyOrderResponse)(nil), "gctrpc.ModifyOrderResponse")
+	proto.RegisterType((*CancelAllOrdersRequest)(nil), "gctrpc.CancelAllOrdersRequest")
+	proto.RegisterType((*CancelAllOrdersResponse)(nil), "gctrpc.CancelAllOrdersResponse")
+	proto.RegisterType((*CancelAllOrdersResponse_Orders)(nil), "gctrpc.CancelAllOrdersResponse.Orders")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.CancelAllOrdersResponse.Orders.OrderStatusEntry")
+	proto.RegisterType((*SetRiskKillSwitchRequest)(nil), "gctrpc.SetRiskKillSwitchRequest")
+	proto.RegisterType((*SetRiskKillSwitchResponse)(nil), "gctrpc.SetRiskKillSwitchResponse")
+	proto.RegisterType((*ConditionalOrder)(nil), "gctrpc.ConditionalOrder")
+	proto.RegisterType((*ConditionalOrdersResponse)(nil), "gctrpc.ConditionalOrdersResponse")
+	proto.RegisterType((*AddConditionalOrderRequest)(nil), "gctrpc.AddConditionalOrderRequest")
+	proto.RegisterType((*AddOCOOrderRequest)(nil), "gctrpc.AddOCOOrderRequest")
+	proto.RegisterType((*AddBracketOrderRequest)(nil), "gctrpc.AddBracketOrderRequest")
+	proto.RegisterType((*GetConditionalOrdersRequest)(nil), "gctrpc.GetConditionalOrdersRequest")
+	proto.RegisterType((*CancelConditionalOrderRequest)(nil), "gctrpc.CancelConditionalOrderRequest")
+	proto.RegisterType((*StartExecutionRequest)(nil), "gctrpc.StartExecutionRequest")
+	proto.RegisterType((*ExecutionIDRequest)(nil), "gctrpc.ExecutionIDRequest")
+	proto.RegisterType((*GetExecutionsRequest)(nil), "gctrpc.GetExecutionsRequest")
+	proto.RegisterType((*ExecutionChildOrder)(nil), "gctrpc.ExecutionChildOrder")
+	proto.RegisterType((*ExecutionResponse)(nil), "gctrpc.ExecutionResponse")
+	proto.RegisterType((*GetExecutionsResponse)(nil), "gctrpc.GetExecutionsResponse")
+	proto.RegisterType((*GetEventsRequest)(nil), "gctrpc.GetEventsRequest")
+	proto.RegisterType((*ConditionParams)(nil), "gctrpc.ConditionParams")
+	proto.RegisterType((*GetEventsResponse)(nil), "gctrpc.GetEventsResponse")
+	proto.RegisterType((*AddEventRequest)(nil), "gctrpc.AddEventRequest")
+	proto.RegisterType((*AddEventResponse)(nil), "gctrpc.AddEventResponse")
+	proto.RegisterType((*RemoveEventRequest)(nil), "gctrpc.RemoveEventRequest")
+	proto.RegisterType((*RemoveEventResponse)(nil), "gctrpc.RemoveEventResponse")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressesRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressesRequest")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressesResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressRequest")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressResponse")
+	proto.RegisterType((*WithdrawCurrencyRequest)(nil), "gctrpc.WithdrawCurrencyRequest")
+	proto.RegisterType((*WithdrawResponse)(nil), "gctrpc.WithdrawResponse")
+	proto.RegisterType((*GetLoggerDetailsRequest)(nil), "gctrpc.GetLoggerDetailsRequest")
+	proto.RegisterType((*GetLoggerDetailsResponse)(nil), "gctrpc.GetLoggerDetailsResponse")
+	proto.RegisterType((*SetLoggerDetailsRequest)(nil), "gctrpc.SetLoggerDetailsRequest")
+	proto.RegisterType((*GetExchangePairsRequest)(nil), "gctrpc.GetExchangePairsRequest")
+	proto.RegisterType((*GetExchangePairsResponse)(nil), "gctrpc.GetExchangePairsResponse")
+	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangePairsResponse.SupportedAssetsEntry")
+	proto.RegisterType((*ExchangePairRequest)(nil), "gctrpc.ExchangePairRequest")
+	proto.RegisterType((*GetOrderbookStreamRequest)(nil), "gctrpc.GetOrderbookStreamRequest")
+	proto.RegisterType((*GetExchangeOrderbookStreamRequest)(nil), "gctrpc.GetExchangeOrderbookStreamRequest")
+	proto.RegisterType((*GetTickerStreamRequest)(nil), "gctrpc.GetTickerStreamRequest")
+	proto.RegisterType((*GetExchangeTickerStreamRequest)(nil), "gctrpc.GetExchangeTickerStreamRequest")
+	proto.RegisterType((*GetOrderUpdateStreamRequest)(nil), "gctrpc.GetOrderUpdateStreamRequest")
+	proto.RegisterType((*OrderUpdateResponse)(nil), "gctrpc.OrderUpdateResponse")
+	proto.RegisterType((*GetAuditEventRequest)(nil), "gctrpc.GetAuditEventRequest")
+	proto.RegisterType((*GetAuditEventResponse)(nil), "gctrpc.GetAuditEventResponse")
+	proto.RegisterType((*GetHistoricCandlesRequest)(nil), "gctrpc.GetHistoricCandlesRequest")
+	proto.RegisterType((*GetHistoricCandlesResponse)(nil), "gctrpc.GetHistoricCandlesResponse")
+	proto.RegisterType((*Candle)(nil), "gctrpc.Candle")
+	proto.RegisterType((*CandleJob)(nil), "gctrpc.CandleJob")
+	proto.RegisterType((*StartCandleJobRequest)(nil), "gctrpc.StartCandleJobRequest")
+	proto.RegisterType((*GetCandleJobsRequest)(nil), "gctrpc.GetCandleJobsRequest")
+	proto.RegisterType((*GetCandleJobsResponse)(nil), "gctrpc.GetCandleJobsResponse")
+	proto.RegisterType((*GetCandleCoverageRequest)(nil), "gctrpc.GetCandleCoverageRequest")
+	proto.RegisterType((*CandleDateRange)(nil), "gctrpc.CandleDateRange")
+	proto.RegisterType((*GetCandleCoverageResponse)(nil), "gctrpc.GetCandleCoverageResponse")
+	proto.RegisterType((*AuditEvent)(nil), "gctrpc.AuditEvent")
+	proto.RegisterType((*GCTScript)(nil), "gctrpc.GCTScript")
+	proto.RegisterType((*GCTScriptExecuteRequest)(nil), "gctrpc.GCTScriptExecuteRequest")
+	proto.RegisterType((*GCTScriptStopRequest)(nil), "gctrpc.GCTScriptStopRequest")
+	proto.RegisterType((*GCTScriptStopAllRequest)(nil), "gctrpc.GCTScriptStopAllRequest")
+	proto.RegisterType((*GCTScriptStatusRequest)(nil), "gctrpc.GCTScriptStatusRequest")
+	proto.RegisterType((*GCTScriptListAllRequest)(nil), "gctrpc.GCTScriptListAllRequest")
+	proto.RegisterType((*GCTScriptUploadRequest)(nil), "gctrpc.GCTScriptUploadRequest")
+	proto.RegisterType((*GCTScriptReadScriptRequest)(nil), "gctrpc.GCTScriptReadScriptRequest")
+	proto.RegisterType((*GCTScriptQueryRequest)(nil), "gctrpc.GCTScriptQueryRequest")
+	proto.RegisterType((*GCTScriptAutoLoadRequest)(nil), "gctrpc.GCTScriptAutoLoadRequest")
+	proto.RegisterType((*GCTScriptStatusResponse)(nil), "gctrpc.GCTScriptStatusResponse")
+	proto.RegisterType((*GCTScriptQueryResponse)(nil), "gctrpc.GCTScriptQueryResponse")
+	proto.RegisterType((*GCTScriptGenericResponse)(nil), "gctrpc.GCTScriptGenericResponse")
+	proto.RegisterType((*GCTScriptStateRequest)(nil), "gctrpc.GCTScriptStateRequest")
+	proto.RegisterType((*GCTScriptStateValue)(nil), "gctrpc.GCTScriptStateValue")
+	proto.RegisterType((*GCTScriptStateResponse)(nil), "gctrpc.GCTScriptStateResponse")
+	proto.RegisterType((*GCTScriptTestRequest)(nil), "gctrpc.GCTScriptTestRequest")
+	proto.RegisterType((*GCTScriptTestResult)(nil), "gctrpc.GCTScriptTestResult")
+	proto.RegisterType((*GCTScriptTestResponse)(nil), "gctrpc.GCTScriptTestResponse")
+	proto.RegisterType((*ArbitrageOpportunity)(nil), "gctrpc.ArbitrageOpportunity")
+	proto.RegisterType((*GetArbitrageOpportunitiesRequest)(nil), "gctrpc.GetArbitrageOpportunitiesRequest")
+	proto.RegisterType((*GetArbitrageOpportunitiesResponse)(nil), "gctrpc.GetArbitrageOpportunitiesResponse")
+	proto.RegisterType((*GetArbitrageStreamRequest)(nil), "gctrpc.GetArbitrageStreamRequest")
+	proto.RegisterType((*TriangularArbitrageLeg)(nil), "gctrpc.TriangularArbitrageLeg")
+	proto.RegisterType((*TriangularArbitrageCycle)(nil), "gctrpc.TriangularArbitrageCycle")
+	proto.RegisterType((*GetTriangularArbitrageRequest)(nil), "gctrpc.GetTriangularArbitrageRequest")
+	proto.RegisterType((*GetTriangularArbitrageResponse)(nil), "gctrpc.GetTriangularArbitrageResponse")
+	proto.RegisterType((*RouteOrderRequest)(nil), "gctrpc.RouteOrderRequest")
+	proto.RegisterType((*RouteAllocation)(nil), "gctrpc.RouteAllocation")
+	proto.RegisterType((*RouteExclusion)(nil), "gctrpc.RouteExclusion")
+	proto.RegisterType((*RouteResponse)(nil), "gctrpc.RouteResponse")
+	proto.RegisterType((*GetRoutesRequest)(nil), "gctrpc.GetRoutesRequest")
+	proto.RegisterType((*GetRoutesResponse)(nil), "gctrpc.GetRoutesResponse")
+	proto.RegisterType((*AggregatedOrderbookItem)(nil), "gctrpc.AggregatedOrderbookItem")
+	proto.RegisterType((*GetAggregatedOrderbookRequest)(nil), "gctrpc.GetAggregatedOrderbookRequest")
+	proto.RegisterType((*AggregatedOrderbookResponse)(nil), "gctrpc.AggregatedOrderbookResponse")
+	proto.RegisterType((*PositionDetails)(nil), "gctrpc.PositionDetails")
+	proto.RegisterType((*GetPositionsRequest)(nil), "gctrpc.GetPositionsRequest")
+	proto.RegisterType((*GetPositionsResponse)(nil), "gctrpc.GetPositionsResponse")
+	proto.RegisterType((*SetLeverageRequest)(nil), "gctrpc.SetLeverageRequest")
+	proto.RegisterType((*SetLeverageResponse)(nil), "gctrpc.SetLeverageResponse")
+	proto.RegisterType((*SetMarginModeRequest)(nil), "gctrpc.SetMarginModeRequest")
+	proto.RegisterType((*SetMarginModeResponse)(nil), "gctrpc.SetMarginModeResponse")
+	proto.RegisterType((*GetFundingRatesRequest)(nil), "gctrpc.GetFundingRatesRequest")
+	proto.RegisterType((*FundingRate)(nil), "gctrpc.FundingRate")
+	proto.RegisterType((*GetFundingRatesResponse)(nil), "gctrpc.GetFundingRatesResponse")
+	proto.RegisterType((*ClosePositionRequest)(nil), "gctrpc.ClosePositionRequest")
+	proto.RegisterType((*GetTradingRulesRequest)(nil), "gctrpc.GetTradingRulesRequest")
+	proto.RegisterType((*TradingRules)(nil), "gctrpc.TradingRules")
+	proto.RegisterType((*SubmitOrdersRequest)(nil), "gctrpc.SubmitOrdersRequest")
+	proto.RegisterType((*SubmitOrdersResponse)(nil), "gctrpc.SubmitOrdersResponse")
+	proto.RegisterType((*CancelOrdersRequest)(nil), "gctrpc.CancelOrdersRequest")
+	proto.RegisterType((*CancelOrdersResponse)(nil), "gctrpc.CancelOrdersResponse")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.CancelOrdersResponse.OrderStatusEntry")
+}
+
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
+
+var fileDescriptor_77a6da22d6a3feb1 = []byte{
+	// 8930 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x5d, 0x8f, 0x24, 0x49,
+	0x92, 0x90, 0x32, 0x2b, 0x2b, 0xb3, 0xd2, 0xea, 0x23, 0xab, 0xa2, 0xbe, 0xb2, 0xa3, 0xba, 0xfa,
+	0x23, 0xe6, 0xba, 0x67, 0x7a, 0x76, 0xb6, 0x7b, 0xa6, 0x67, 0x86, 0x9d, 0xd9, 0x5d, 0xf6, 0xa8,
+	0xae, 0x9e, 0xe9, 0xed, 0x9d, 0x9e, 0xed, 0xba, 0xa8, 0x9e, 0x19, 0x31, 0x87, 0x26, 0x89, 0xca,
+	0xf0, 0xcc, 0x8a, 0xad, 0xc8, 0x88, 0x9c, 0x88, 0xc8, 0xea, 0xae, 0xe1, 0x10, 0xab, 0xd5, 0x81,
+	0x8e, 0x6f, 0xc4, 0x71, 0x7c, 0x48, 0xf7, 0x04, 0x0f, 0x1c, 0x48, 0x08, 0x09, 0xdd, 0x13, 0x12,
+	0xc7, 0x49, 0xc0, 0x03, 0x42, 0xf0, 0x82, 0x90, 0xf6, 0x07, 0x20, 0x90, 0x90, 0x00, 0x09, 0xe9,
+	0x04, 0xe2, 0x05, 0xe4, 0xe6, 0x1f, 0xe1, 0x1e, 0xe1, 0x91, 0x95, 0x35, 0xdd, 0xd3, 0x7b, 0xf7,
+	0xd2, 0x9d, 0x61, 0x6e, 0xee, 0x66, 0x6e, 0x6e, 0xee, 0x6e, 0x6e, 0x6e, 0x6e, 0x05, 0xed, 0x64,
+	0xdc, 0xbf, 0x3d, 0x4e, 0xe2, 0x2c, 0xb6, 0x9a, 0xc3, 0x7e, 0x96, 0x8c, 0xfb, 0xf6, 0xe5, 0x61,
+	0x1c, 0x0f, 0x43, 0x72, 0xc7, 0x1b, 0x07, 0x77, 0xbc, 0x28, 0x8a, 0x33, 0x2f, 0x0b, 0xe2, 0x28,
+	0x65, 0x58, 0xce, 0x2a, 0xac, 0x3c, 0x20, 0xd9, 0xc3, 0x68, 0x10, 0xbb, 0xe4, 0xcb, 0x09, 0x49,
+	0x33, 0xe7, 0x77, 0x1b, 0xd0, 0x91, 0xa0, 0x74, 0x1c, 0x47, 0x29, 0xb1, 0xb6, 0xa0, 0x39, 0x19,
+	0x67, 0xc1, 0x88, 0x74, 0x6b, 0xd7, 0x6a, 0xaf, 0xb5, 0x5d, 0xfe, 0x65, 0xdd, 0x81, 0x75, 0xef,
+	0xd4, 0x0b, 0x42, 0xef, 0x28, 0x24, 0x3d, 0xf2, 0xac, 0x7f, 0xec, 0x45, 0x43, 0x92, 0x76, 0xeb,
+	0xd7, 0x6a, 0xaf, 0xcd, 0xb9, 0x96, 0x2c, 0xfa, 0x40, 0x94, 0x58, 0xdf, 0x82, 0x35, 0x12, 0x51,
+	0x90, 0xaf, 0xa0, 0xcf, 0x21, 0xfa, 0x2a, 0x2f, 0xc8, 0x91, 0xdf, 0x81, 0x2d, 0x9f, 0x0c, 0xbc,
+	0x49, 0x98, 0xf5, 0x06, 0x71, 0x42, 0x9e, 0xf5, 0xc6, 0x49, 0x7c, 0x1a, 0xf8, 0x24, 0xe9, 0x36,
+	0x90, 0x8b, 0x0d, 0x5e, 0xfa, 0x21, 0x2d, 0x3c, 0xe0, 0x65, 0xd6, 0x5d, 0xd8, 0x94, 0xb5, 0x02,
+	0x2f, 0xeb, 0xf5, 0x27, 0x49, 0x42, 0xa2, 0xfe, 0x59, 0x77, 0x1e, 0x2b, 0xad, 0x8b, 0x4a, 0x81,
+	0x97, 0xed, 0xf3, 0x22, 0xeb, 0x33, 0x58, 0x4d, 0x27, 0x47, 0xe9, 0x59, 0x9a, 0x91, 0x51, 0x2f,
+	0xcd, 0xbc, 0x6c, 0x92, 0x76, 0x9b, 0xd7, 0xe6, 0x5e, 0x5b, 0xbc, 0xfb, 0xc6, 0x6d, 0x26, 0xc6,
+	0xdb, 0x05, 0x91, 0xdc, 0x3e, 0x14, 0xf8, 0x87, 0x88, 0xfe, 0x41, 0x94, 0x25, 0x67, 0x6e, 0x27,
+	0xd5, 0xa1, 0xd6, 0x8f, 0x61, 0x39, 0x19, 0xf7, 0x7b, 0x24, 0xf2, 0xc7, 0x71, 0x10, 0x65, 0x69,
+	0xb7, 0x85, 0xad, 0xde, 0xaa, 0x6a, 0xd5, 0x1d, 0xf7, 0x3f, 0x10, 0xb8, 0xac, 0xc9, 0xa5, 0x44,
+	0x01, 0xd9, 0xf7, 0x60, 0xc3, 0x44, 0xd8, 0x5a, 0x85, 0xb9, 0x13, 0x72, 0xc6, 0x47, 0x87, 0xfe,
+	0xb4, 0x36, 0x60, 0xfe, 0xd4, 0x0b, 0x27, 0x04, 0x07, 0x63, 0xc1, 0x65, 0x1f, 0xdf, 0xad, 0xbf,
+	0x57, 0xb3, 0x9f, 0xc0, 0x5a, 0x89, 0x8c, 0xa1, 0x81, 0x5b, 0x6a, 0x03, 0x8b, 0x77, 0xd7, 0x05,
+	0xcb, 0xee, 0xc1, 0xbe, 0xa8, 0xab, 0xb4, 0xea, 0x5c, 0x87, 0xab, 0x0f, 0x48, 0xb6, 0x1f, 0x8f,
+	0x46, 0x93, 0x28, 0xe8, 0xa3, 0x8e, 0xb9, 0x24, 0xf4, 0xce, 0x48, 0x92, 0x0a, 0xcd, 0xfa, 0x31,
+	0x6c, 0x98, 0xca, 0xad, 0x2e, 0xb4, 0xf8, 0xd8, 0x23, 0xfd, 0x05, 0x57, 0x7c, 0x5a, 0x97, 0xa1,
+	0xdd, 0x8f, 0xa3, 0x88, 0xf4, 0x33, 0xe2, 0xf3, 0x8e, 0xe4, 0x00, 0xe7, 0x2f, 0xd4, 0xe1, 0x5a,
+	0x35, 0x4d, 0xae, 0xba, 0x5f, 0xc1, 0x56, 0x5f, 0x45, 0xe8, 0x25, 0x1c, 0xa3, 0x5b, 0xc3, 0xa1,
+	0xd8, 0x57, 0x86, 0x62, 0x6a, 0x4b, 0xb7, 0x8d, 0xa5, 0x6c, 0x90, 0x36, 0xfb, 0xa6, 0x32, 0x7b,
+	0x00, 0x76, 0x75, 0x25, 0x83, 0xc8, 0xef, 0xea, 0x22, 0xbf, 0x2c, 0x58, 0x33, 0x35, 0xa2, 0xca,
+	0xfe, 0x3b, 0xb0, 0xfd, 0x80, 0x44, 0x24, 0x09, 0xfa, 0x52, 0x39, 0xb8, 0xcc, 0xa9, 0x04, 0xa5,
+	0x4e, 0x72, 0x52, 0x39, 0xc0, 0xb1, 0xa1, 0x5b, 0xae, 0xc8, 0xba, 0xeb, 0x6c, 0xc1, 0xc6, 0x03,
+	0x92, 0x49, 0xb8, 0x1c, 0xc5, 0xdf, 0xab, 0xc1, 0x26, 0x16, 0xa4, 0x47, 0xe9, 0x19, 0x2b, 0xe0,
+	0xa2, 0xfe, 0xd3, 0xb0, 0x26, 0x9b, 0x4e, 0xc5, 0x34, 0x62, 0x52, 0x7e, 0x5b, 0x91, 0x72, 0xb9,
+	0x66, 0x3e, 0x99, 0x52, 0x75, 0x36, 0xad, 0xa6, 0x05, 0xb0, 0xbd, 0x0f, 0x9b, 0x46, 0xd4, 0x8b,
+	0xe8, 0xbf, 0xd3, 0x85, 0xad, 0x07, 0x24, 0x53, 0xd4, 0x58, 0x51, 0xd0, 0x45, 0x05, 0x4c, 0xf5,
+	0x32, 0xcd, 0xbc, 0x24, 0xcb, 0xf5, 0x92, 0x7f, 0x5a, 0x37, 0x60, 0x25, 0x0c, 0xd2, 0x8c, 0x44,
+	0x3d, 0xcf, 0xf7, 0x13, 0x92, 0xb2, 0x25, 0xaf, 0xed, 0x2e, 0x33, 0xe8, 0x1e, 0x03, 0x3a, 0xff,
+	0xbc, 0x06, 0xdb, 0x25, 0x52, 0x5c, 0x58, 0x8f, 0xa0, 0x9d, 0xaf, 0x0a, 0x4c, 0x48, 0xb7, 0x15,
+	0x21, 0x99, 0xea, 0xdc, 0x2e, 0x2c, 0x0d, 0x79, 0x03, 0xf6, 0xaf, 0xc0, 0xca, 0x8b, 0x9e, 0xd0,
+	0xef, 0x81, 0xcd, 0x75, 0x43, 0xac, 0xc8, 0x3f, 0xf6, 0x46, 0x44, 0xe8, 0x95, 0x0d, 0x0b, 0x62,
+	0x01, 0xe7, 0x34, 0xe4, 0xb7, 0xb3, 0x0b, 0x3b, 0xc6, 0x9a, 0x5c, 0xb1, 0xee, 0xc0, 0xfa, 0x03,
+	0x92, 0x89, 0x22, 0x21, 0xfc, 0xea, 0x55, 0xc0, 0x79, 0x07, 0x36, 0xf4, 0x0a, 0x5c, 0x84, 0x97,
+	0xa1, 0x9d, 0x6f, 0x22, 0x5c, 0xb7, 0x25, 0xc0, 0xb9, 0x0b, 0x9b, 0x4a, 0xad, 0xc7, 0x4f, 0x0e,
+	0x5c, 0xc2, 0xaa, 0x5d, 0x82, 0x85, 0x38, 0x1b, 0xf7, 0xfa, 0xb1, 0x2f, 0x58, 0x6f, 0xc5, 0xd9,
+	0x78, 0x3f, 0xf6, 0x09, 0x57, 0x0d, 0xa5, 0x8e, 0x54, 0x8d, 0xbf, 0xcf, 0x86, 0x52, 0x2f, 0xe2,
+	0x7c, 0xfc, 0x08, 0xda, 0xa2, 0x41, 0x31, 0x94, 0xdf, 0x56, 0x86, 0xd2, 0x54, 0xe7, 0xf6, 0x63,
+	0x46, 0x91, 0x8f, 0xe4, 0x02, 0x67, 0x20, 0xb5, 0xbf, 0x07, 0xcb, 0x5a, 0xd1, 0x79, 0x9a, 0xdd,
+	0x56, 0x87, 0xec, 0x1d, 0xd8, 0xba, 0x1f, 0xa4, 0xea, 0x8e, 0x3b, 0xcb, 0x70, 0x7d, 0x01, 0x2b,
+	0x07, 0x5e, 0x90, 0xa4, 0x87, 0x93, 0xf1, 0x38, 0x46, 0xf5, 0x7e, 0x15, 0x3a, 0xf9, 0xb6, 0x3e,
+	0xa6, 0x65, 0xbc, 0xd2, 0x8a, 0x04, 0x63, 0x0d, 0xeb, 0x15, 0x58, 0x16, 0xdb, 0x39, 0x43, 0x63,
+	0x2c, 0x2d, 0x71, 0x20, 0x22, 0x39, 0x3f, 0x6b, 0x68, 0xa2, 0xd3, 0x0c, 0x0b, 0x0b, 0x1a, 0x91,
+	0x27, 0xcd, 0x0a, 0xfc, 0xad, 0x2a, 0x42, 0x5d, 0xdf, 0x0e, 0xba, 0xd0, 0x3a, 0x25, 0xc9, 0x51,
+	0x9c, 0x12, 0xb4, 0x19, 0x16, 0x5c, 0xf1, 0x49, 0x19, 0x99, 0xa4, 0x41, 0x34, 0xec, 0xa5, 0x5e,
+	0xe4, 0x1f, 0xc5, 0xcf, 0xd0, 0x42, 0x58, 0x70, 0x97, 0x10, 0x78, 0xc8, 0x60, 0xd6, 0x75, 0x58,
+	0x3a, 0xce, 0xb2, 0x71, 0x8f, 0x9a, 0x2e, 0xf1, 0x24, 0xe3, 0x06, 0xc1, 0x22, 0x85, 0x3d, 0x61,
+	0x20, 0x3a, 0xb1, 0x11, 0x65, 0x92, 0x92, 0xc4, 0x1b, 0x92, 0x28, 0xeb, 0x36, 0xd9, 0xc4, 0xa6,
+	0xd0, 0x4f, 0x04, 0xd0, 0xda, 0x05, 0x40, 0xb4, 0x71, 0x12, 0x3f, 0x3b, 0xeb, 0xb6, 0x98, 0xea,
+	0x51, 0xc8, 0x01, 0x05, 0x50, 0xf9, 0x1d, 0x79, 0x29, 0x11, 0xa6, 0x47, 0x40, 0xd2, 0xee, 0x02,
+	0x93, 0x1f, 0x05, 0xef, 0x4b, 0xa8, 0xd5, 0xa3, 0x76, 0x07, 0x97, 0x7a, 0xcf, 0x4b, 0x53, 0x92,
+	0xa5, 0xdd, 0x36, 0x2a, 0xd0, 0x3b, 0x06, 0x05, 0x2a, 0xd8, 0x1f, 0xbc, 0xde, 0x1e, 0x56, 0x93,
+	0xf6, 0x87, 0x06, 0xa5, 0xf6, 0x96, 0x37, 0xc9, 0x8e, 0x49, 0x94, 0xd1, 0xdd, 0x83, 0x12, 0x19,
+	0x07, 0x5d, 0x40, 0xd9, 0xac, 0x6a, 0x05, 0x7b, 0xe3, 0xc0, 0xfe, 0x9c, 0x1a, 0x17, 0xe5, 0x56,
+	0x0d, 0x2a, 0xf8, 0x86, 0xbe, 0x94, 0x6c, 0x09, 0x66, 0x75, 0x3d, 0x52, 0x55, 0xf3, 0x29, 0xac,
+	0x3e, 0x20, 0xd9, 0x93, 0xa0, 0x7f, 0x42, 0x92, 0x19, 0x94, 0xd2, 0x7a, 0x0d, 0x1a, 0x54, 0xa3,
+	0x38, 0x81, 0x0d, 0xb9, 0x13, 0x72, 0x8b, 0x8d, 0x12, 0x72, 0x11, 0x83, 0x8e, 0x05, 0x4a, 0xae,
+	0x97, 0x9d, 0x8d, 0x99, 0x5e, 0xb4, 0xdd, 0x36, 0x42, 0x9e, 0x9c, 0x8d, 0x89, 0xf3, 0x29, 0x2c,
+	0xa9, 0x95, 0xe8, 0xa2, 0xe1, 0x93, 0x30, 0x18, 0x05, 0x19, 0x49, 0xc4, 0xa2, 0x21, 0x01, 0x54,
+	0x1f, 0xe9, 0x10, 0x71, 0x3d, 0xc6, 0xdf, 0x74, 0xbe, 0x7d, 0x39, 0x89, 0x33, 0xd1, 0x36, 0xfb,
+	0x70, 0x7e, 0xab, 0x0e, 0x2b, 0xa2, 0x3b, 0x5c, 0x99, 0x05, 0xcf, 0xb5, 0x73, 0x79, 0xbe, 0x0e,
+	0x4b, 0xa1, 0x97, 0x66, 0xbd, 0xc9, 0xd8, 0xf7, 0x84, 0x69, 0x33, 0xe7, 0x2e, 0x52, 0xd8, 0x27,
+	0x0c, 0x44, 0x35, 0x5a, 0x58, 0xae, 0x38, 0xb7, 0x38, 0xf5, 0xa5, 0xbe, 0xda, 0x19, 0x0b, 0x1a,
+	0xb4, 0x0e, 0x6a, 0x7b, 0xcd, 0xc5, 0xdf, 0x14, 0x76, 0x1c, 0x0c, 0x8f, 0x51, 0xbb, 0x6b, 0x2e,
+	0xfe, 0xa6, 0x23, 0x18, 0xc6, 0x4f, 0x51, 0x97, 0x6b, 0x2e, 0xfd, 0x49, 0x21, 0x47, 0x81, 0x8f,
+	0xaa, 0x5b, 0x73, 0xe9, 0x4f, 0x0a, 0xf1, 0xd2, 0x13, 0x54, 0xd4, 0x9a, 0x4b, 0x7f, 0x52, 0xab,
+	0xff, 0x34, 0x0e, 0x27, 0x23, 0xd2, 0x6d, 0x23, 0x90, 0x7f, 0x59, 0x3b, 0xd0, 0x1e, 0x27, 0x41,
+	0x9f, 0xf4, 0xbc, 0xec, 0x18, 0x95, 0xa9, 0xe6, 0x2e, 0x20, 0x60, 0x2f, 0x3b, 0x76, 0xd6, 0x61,
+	0x4d, 0x0e, 0xb4, 0x5c, 0x3d, 0x3f, 0x83, 0x16, 0x87, 0x4c, 0x1d, 0xf4, 0x37, 0xa1, 0x95, 0x31,
+	0xb4, 0x6e, 0xfd, 0xda, 0x9c, 0xaa, 0x58, 0xba, 0xa4, 0x5d, 0x81, 0xe6, 0xfc, 0x32, 0x58, 0x2a,
+	0x35, 0x3e, 0x10, 0xb7, 0xf2, 0x76, 0xd8, 0x72, 0xdc, 0xd1, 0xdb, 0x49, 0xf3, 0x06, 0xbe, 0xc2,
+	0xcd, 0xe8, 0x71, 0xe2, 0xd3, 0x85, 0x24, 0x3e, 0x79, 0xa9, 0xaa, 0xf9, 0x31, 0x2c, 0x4b, 0xc2,
+	0x0f, 0x33, 0x32, 0xa2, 0x02, 0xf7, 0x46, 0xf1, 0x24, 0xca, 0x90, 0x66, 0xcd, 0xe5, 0x5f, 0x54,
+	0x03, 0x51, 0xbe, 0x48, 0xb2, 0xe6, 0xb2, 0x0f, 0x6b, 0x05, 0xea, 0x81, 0xcf, 0x0f, 0x4f, 0xf5,
+	0xc0, 0x77, 0xfe, 0x6f, 0x0d, 0xd6, 0x94, 0x8e, 0x5c, 0x58, 0x29, 0x4b, 0x1a, 0x57, 0x37, 0x68,
+	0xdc, 0x2d, 0x68, 0x1c, 0x05, 0x3e, 0x3d, 0xb3, 0x51, 0xb9, 0x6e, 0x8a, 0xe6, 0xb4, 0x7e, 0xb8,
+	0x88, 0x42, 0x51, 0xbd, 0xf4, 0x24, 0xed, 0x36, 0xa6, 0xa2, 0x52, 0x94, 0xd2, 0x7c, 0x98, 0x2f,
+	0xcf, 0x07, 0x5d, 0x96, 0xcd, 0xa2, 0x2c, 0x99, 0xb5, 0x2a, 0xdb, 0x96, 0x9a, 0xd7, 0x07, 0xc8,
+	0x81, 0x53, 0x87, 0xf5, 0x7d, 0x80, 0x58, 0x62, 0x72, 0xfd, 0xbb, 0x54, 0x62, 0x5a, 0xaa, 0xa0,
+	0x82, 0xec, 0x7c, 0x84, 0xa6, 0x86, 0x4a, 0x9c, 0x0b, 0xff, 0xae, 0xd6, 0x26, 0xd3, 0x45, 0xab,
+	0xd4, 0x66, 0xaa, 0x35, 0xf6, 0x36, 0x36, 0xb6, 0xd7, 0xef, 0xd3, 0xa1, 0x57, 0x0e, 0xe6, 0x53,
+	0xf7, 0xf0, 0x4f, 0xa1, 0xc5, 0x6b, 0x70, 0xb5, 0x60, 0x08, 0xf5, 0xc0, 0xb7, 0xbe, 0x07, 0xa0,
+	0xec, 0x43, 0xac, 0x5f, 0x3b, 0x82, 0x07, 0x5e, 0x49, 0x68, 0x03, 0x92, 0x53, 0xd0, 0x9d, 0x01,
+	0xac, 0x1b, 0x50, 0x28, 0x2b, 0xf2, 0x58, 0xcd, 0x59, 0x11, 0xdf, 0xd6, 0x55, 0x58, 0xcc, 0xe2,
+	0xcc, 0x0b, 0x7b, 0xf9, 0x0e, 0x51, 0x73, 0x01, 0x41, 0x9f, 0x52, 0x08, 0x2e, 0x50, 0x71, 0xc8,
+	0x34, 0x97, 0x2e, 0x50, 0x71, 0xe8, 0x3b, 0x1e, 0x1a, 0x5e, 0x5a, 0xa7, 0xb9, 0x08, 0xa7, 0x0d,
+	0xd9, 0xb7, 0x60, 0xc1, 0x63, 0x55, 0x44, 0xc7, 0x3a, 0x85, 0x8e, 0xb9, 0x12, 0xc1, 0xb1, 0x70,
+	0x07, 0xda, 0x8f, 0xa3, 0x41, 0x30, 0x14, 0xda, 0xf1, 0x2a, 0xac, 0x29, 0xb0, 0xdc, 0x26, 0xf1,
+	0xbd, 0xcc, 0x43, 0x6a, 0x4b, 0x2e, 0xfe, 0x76, 0xfe, 0x7c, 0x0d, 0x56, 0x0f, 0xe2, 0x24, 0x1b,
+	0xc4, 0x61, 0x10, 0x73, 0xf3, 0x9e, 0x9a, 0x23, 0xc2, 0xfc, 0xe7, 0x76, 0x24, 0xff, 0xa4, 0x2b,
+	0x64, 0x3f, 0x0e, 0x22, 0xa6, 0xab, 0x75, 0x2e, 0xa0, 0x38, 0x88, 0xa8, 0xaa, 0x5a, 0xd7, 0x60,
+	0xd1, 0x27, 0x69, 0x3f, 0x09, 0xc6, 0xf4, 0x38, 0xc7, 0x97, 0x05, 0x15, 0x44, 0x1b, 0x3e, 0xf2,
+	0x42, 0x2f, 0xea, 0x13, 0xbe, 0xb2, 0x8b, 0x4f, 0x67, 0x13, 0x97, 0x2b, 0xc9, 0x89, 0x72, 0xb2,
+	0xd6, 0xc1, 0xbc, 0x2b, 0x7f, 0x0c, 0xda, 0x63, 0x01, 0xe4, 0xea, 0xd7, 0x95, 0x7b, 0x75, 0xa1,
+	0x3b, 0x6e, 0x8e, 0xea, 0x5c, 0x06, 0x5b, 0x6d, 0xef, 0x70, 0x32, 0x1a, 0x79, 0xc9, 0x99, 0xa0,
+	0x16, 0x41, 0x63, 0x3f, 0x0e, 0x22, 0x2a, 0x28, 0xda, 0x29, 0x61, 0xbc, 0xd1, 0xdf, 0x2a, 0xeb,
+	0x75, 0x8d, 0x75, 0x55, 0x5a, 0x73, 0xba, 0xb4, 0xae, 0x00, 0x8c, 0x49, 0xd2, 0x27, 0x51, 0xe6,
+	0x0d, 0x45, 0x8f, 0x15, 0x88, 0x73, 0x0c, 0xd6, 0xe3, 0xc1, 0x20, 0x0c, 0x22, 0x42, 0xc9, 0x72,
+	0x66, 0xa6, 0x48, 0xbf, 0x9a, 0x07, 0x9d, 0xd2, 0x5c, 0x89, 0xd2, 0xc7, 0xb0, 0xf6, 0x38, 0x32,
+	0x10, 0x12, 0xcd, 0xd5, 0xa6, 0x35, 0x57, 0x2f, 0x35, 0xf7, 0x43, 0x58, 0x52, 0x18, 0x4f, 0xad,
+	0xf7, 0xa0, 0xcd, 0x79, 0x94, 0x07, 0x05, 0x5b, 0xae, 0x06, 0xa5, 0x1e, 0xba, 0x39, 0xb2, 0xf3,
+	0x77, 0x6b, 0xb0, 0x98, 0x73, 0x46, 0x5d, 0x63, 0xf3, 0x54, 0xdc, 0xa2, 0x95, 0x2b, 0xb2, 0x95,
+	0x1c, 0xe7, 0x36, 0xfe, 0xcb, 0xec, 0x42, 0x86, 0x6c, 0x1f, 0x02, 0xe4, 0x40, 0x83, 0x59, 0x77,
+	0x47, 0x37, 0xeb, 0x2e, 0x95, 0x5b, 0x15, 0xac, 0x29, 0x96, 0xdd, 0xbf, 0x6b, 0xc0, 0x8e, 0x51,
+	0x59, 0xb8, 0x0e, 0x7e, 0x1b, 0x16, 0xd9, 0x5c, 0xa0, 0x2b, 0x80, 0x60, 0x78, 0x29, 0x77, 0x6d,
+	0x04, 0x91, 0x0b, 0x38, 0x37, 0xb0, 0xdc, 0x7a, 0x0b, 0x96, 0x91, 0xd9, 0x5e, 0xcc, 0x04, 0xd2,
+	0xad, 0x1b, 0x2a, 0x2c, 0x21, 0x0a, 0x17, 0x99, 0x35, 0x86, 0x4d, 0xad, 0x4a, 0x2f, 0x65, 0x2c,
+	0xf0, 0x4d, 0xea, 0xfb, 0x8a, 0x29, 0x5d, 0xc5, 0xe5, 0xed, 0x7d, 0xa5, 0x41, 0x5e, 0xc6, 0x44,
+	0xb7, 0xde, 0x2f, 0x97, 0x58, 0x77, 0x60, 0x89, 0x53, 0x44, 0xc9, 0x74, 0x1b, 0x06, 0x1e, 0x17,
+	0x59, 0x45, 0x44, 0xb0, 0x46, 0xb0, 0xa1, 0x56, 0x90, 0x1c, 0xce, 0x63, 0xc5, 0xef, 0xcd, 0xce,
+	0x61, 0x54, 0x62, 0xd0, 0xea, 0x97, 0x0a, 0xec, 0x3f, 0x05, 0xdd, 0xaa, 0x0e, 0x19, 0x86, 0xfd,
+	0x75, 0x7d, 0xd8, 0x37, 0x0c, 0x2a, 0x99, 0xaa, 0x0e, 0xc4, 0xcf, 0x61, 0xbb, 0x82, 0x99, 0x0b,
+	0x78, 0x1d, 0x1e, 0x47, 0xa6, 0xb6, 0x9d, 0xbf, 0x5e, 0x03, 0x7b, 0xcf, 0xf7, 0x4b, 0x8b, 0x53,
+	0xee, 0x24, 0x78, 0xd9, 0x4b, 0xee, 0x2e, 0xec, 0x18, 0x19, 0xe2, 0xde, 0x8c, 0x67, 0xb0, 0xeb,
+	0x92, 0x51, 0x7c, 0x4a, 0x5e, 0x36, 0xcb, 0xce, 0x35, 0xb8, 0x52, 0x45, 0x99, 0xf3, 0x86, 0xee,
+	0x3d, 0xdd, 0x3d, 0x2e, 0x0d, 0xa3, 0xff, 0x5e, 0x83, 0x65, 0xad, 0xe4, 0x85, 0x9d, 0xc5, 0xdf,
+	0x00, 0x2b, 0x21, 0x69, 0xd6, 0x1b, 0xc7, 0x61, 0x48, 0x8f, 0xe4, 0x3e, 0x75, 0x58, 0x72, 0x97,
+	0xfd, 0x2a, 0x2d, 0x39, 0x60, 0x05, 0xf7, 0x29, 0xdc, 0xda, 0x86, 0x96, 0x37, 0x0e, 0x7a, 0x54,
+	0x6b, 0xd8, 0x79, 0xbc, 0xe9, 0x8d, 0x83, 0x8f, 0xc8, 0x99, 0xe5, 0xc0, 0x32, 0x2f, 0xe8, 0x85,
+	0xe4, 0x94, 0x84, 0x68, 0xf3, 0xcd, 0xb9, 0x8b, 0xac, 0xf8, 0x11, 0x05, 0x59, 0xb7, 0x60, 0x75,
+	0x9c, 0x04, 0x54, 0xfd, 0xf2, 0xbb, 0x81, 0x16, 0x72, 0xd3, 0xe1, 0x70, 0xd1, 0x3b, 0xe7, 0x57,
+	0xe1, 0x92, 0x41, 0x16, 0x7c, 0x8d, 0xfa, 0x01, 0x74, 0xf4, 0x1b, 0x06, 0xb1, 0x4e, 0x49, 0xab,
+	0x55, 0xab, 0xe8, 0xae, 0x0c, 0xb4, 0x76, 0xb8, 0xf5, 0x89, 0x38, 0xae, 0x97, 0x49, 0x9f, 0x96,
+	0xf3, 0x25, 0x6c, 0xe4, 0xc0, 0xfd, 0x38, 0x3a, 0x25, 0x49, 0x4a, 0xb5, 0xcd, 0x82, 0xc6, 0x20,
+	0x89, 0x85, 0x43, 0x16, 0x7f, 0x53, 0xbb, 0x2d, 0x8b, 0xb9, 0x1a, 0xd4, 0xb3, 0x98, 0xe2, 0x24,
+	0x5e, 0x26, 0x76, 0x29, 0xfc, 0x4d, 0xed, 0xe4, 0x00, 0x1b, 0x21, 0x3d, 0x2c, 0x63, 0xaa, 0xba,
+	0xc8, 0x61, 0x94, 0x8a, 0xf3, 0x29, 0x9a, 0x8f, 0x2a, 0x2b, 0xbc, 0x8f, 0x7f, 0x1c, 0x16, 0x59,
+	0x1f, 0x69, 0x4d, 0xd1, 0xbf, 0xcb, 0x5a, 0xff, 0x0a, 0x6c, 0xba, 0x30, 0x90, 0x50, 0xe7, 0x7f,
+	0xd6, 0x61, 0x09, 0x2d, 0xd6, 0xfb, 0x24, 0xf3, 0x82, 0x70, 0xba, 0x2d, 0xcd, 0x6c, 0xd0, 0xba,
+	0xb4, 0x41, 0x5f, 0x81, 0x65, 0xd5, 0x21, 0x72, 0x26, 0x0e, 0xb3, 0x8a, 0x3b, 0xe4, 0x8c, 0xfa,
+	0x5e, 0xf0, 0x68, 0x9d, 0x63, 0x31, 0x9d, 0x59, 0x46, 0xa8, 0x44, 0xd3, 0x0f, 0x02, 0xf3, 0x85,
+	0x83, 0x00, 0x2d, 0x46, 0x63, 0xba, 0x97, 0x06, 0xbe, 0x3c, 0x27, 0x20, 0xe4, 0x30, 0xf0, 0x95,
+	0x62, 0xac, 0xdd, 0x52, 0x8a, 0xb1, 0x36, 0x3d, 0x03, 0x25, 0x84, 0x5d, 0x14, 0xe0, 0x7d, 0xd7,
+	0x02, 0x2a, 0xdd, 0x92, 0x00, 0x52, 0x3f, 0x11, 0x3d, 0xa6, 0x71, 0xe7, 0x76, 0x9b, 0x69, 0x2c,
+	0xfb, 0xca, 0x8f, 0x69, 0xa0, 0x1e, 0xd3, 0xf2, 0x43, 0xdd, 0xa2, 0x76, 0xa8, 0xbb, 0x0a, 0x8b,
+	0xf1, 0x98, 0x44, 0x3d, 0x7e, 0xc4, 0x5e, 0xc2, 0x42, 0xa0, 0xa0, 0x4f, 0x11, 0xc2, 0x5d, 0x26,
+	0x28, 0xf3, 0x74, 0x96, 0x73, 0xa9, 0x2e, 0x98, 0x7a, 0x51, 0x30, 0xe2, 0x20, 0x38, 0x77, 0xde,
+	0x41, 0xd0, 0xd9, 0x83, 0x35, 0x85, 0x30, 0x57, 0x9f, 0x37, 0xa0, 0x89, 0x62, 0x12, 0x9a, 0xb3,
+	0xa1, 0x1d, 0x63, 0xb8, 0x52, 0xb8, 0x1c, 0xc7, 0xf9, 0x21, 0xde, 0x21, 0x62, 0xd1, 0x2c, 0xac,
+	0x53, 0x97, 0x2c, 0x8e, 0x8a, 0xd4, 0x9a, 0x16, 0x7e, 0x3f, 0xf4, 0x9d, 0xff, 0x5d, 0x03, 0xeb,
+	0x70, 0x72, 0x34, 0x0a, 0x66, 0x6f, 0x6d, 0xf6, 0x03, 0xba, 0x05, 0x0d, 0x54, 0x13, 0xa6, 0x8e,
+	0xf8, 0xbb, 0xa0, 0x21, 0x8d, 0xa2, 0x86, 0xe4, 0xc3, 0x39, 0x6f, 0x3e, 0xa3, 0x37, 0xd5, 0xc1,
+	0xa7, 0x4b, 0x7c, 0x18, 0x90, 0x28, 0xeb, 0x71, 0x67, 0x0b, 0x5d, 0xe2, 0x11, 0xf0, 0xb0, 0x78,
+	0xa4, 0x5d, 0x28, 0x1e, 0x69, 0x0f, 0x61, 0x5d, 0xeb, 0x38, 0x1f, 0x88, 0xeb, 0xb0, 0xc4, 0xf8,
+	0x1b, 0x87, 0x5e, 0x5f, 0x3a, 0xcb, 0x17, 0x11, 0x76, 0x80, 0xa0, 0x69, 0xe2, 0xfc, 0x8d, 0x1a,
+	0x6c, 0x1c, 0x06, 0xa3, 0x49, 0xe8, 0x65, 0xe4, 0x1b, 0x10, 0x68, 0x2e, 0x9d, 0x39, 0x4d, 0x3a,
+	0x42, 0xd0, 0x8d, 0x5c, 0xd0, 0xce, 0xff, 0xaa, 0xc1, 0x66, 0x81, 0x15, 0x69, 0x32, 0xea, 0xba,
+	0x56, 0xe1, 0x3b, 0xe0, 0x48, 0x0a, 0xd1, 0xba, 0x46, 0xf4, 0x15, 0x58, 0x1e, 0x05, 0x51, 0x30,
+	0x9a, 0x8c, 0x7a, 0x6c, 0x68, 0x18, 0x4f, 0x4b, 0x1c, 0x78, 0x80, 0x23, 0x44, 0x91, 0xbc, 0x67,
+	0x0a, 0x52, 0x83, 0x23, 0x79, 0xcf, 0x72, 0xa4, 0x37, 0x61, 0x23, 0x37, 0xeb, 0x7b, 0x43, 0x2f,
+	0x88, 0x7a, 0x61, 0x9c, 0xa6, 0x5c, 0x05, 0xac, 0xbc, 0xec, 0x81, 0x17, 0x44, 0x8f, 0xe2, 0x34,
+	0x55, 0xd6, 0x88, 0xa6, 0xba, 0x46, 0x50, 0xfb, 0x66, 0xf5, 0xb3, 0x63, 0x2f, 0x24, 0xf7, 0xe2,
+	0xd1, 0xd1, 0x8b, 0x95, 0xfd, 0x75, 0x58, 0x62, 0x6e, 0xb9, 0xcc, 0x4b, 0x86, 0x44, 0x8c, 0xc0,
+	0x22, 0xc2, 0x9e, 0x20, 0xc8, 0x38, 0x0c, 0xff, 0xa3, 0x06, 0xd6, 0x3e, 0xb5, 0x74, 0xc2, 0x99,
+	0xf5, 0x81, 0x2a, 0x2e, 0x3b, 0x56, 0xe7, 0x1a, 0xd6, 0xe6, 0x90, 0x87, 0xba, 0xfa, 0xcd, 0x69,
+	0xea, 0x27, 0x7b, 0xd3, 0xb8, 0xa0, 0xef, 0xac, 0xb4, 0xcc, 0xdf, 0x80, 0x95, 0xa7, 0x5e, 0x18,
+	0x92, 0x4c, 0xde, 0xc0, 0x71, 0x47, 0x3d, 0x83, 0x8a, 0x23, 0xba, 0xe8, 0x70, 0x4b, 0xe9, 0xf0,
+	0x26, 0xac, 0x6b, 0xfd, 0xe5, 0xc6, 0xd2, 0x1f, 0xd4, 0xc0, 0xfa, 0x38, 0xf6, 0x83, 0xc1, 0xd9,
+	0x0b, 0x58, 0xb6, 0x66, 0x5f, 0x6d, 0x0b, 0x1d, 0x6d, 0x14, 0x3b, 0x2a, 0x7a, 0x30, 0x5f, 0xb9,
+	0x44, 0x35, 0x8b, 0x4b, 0x94, 0x5c, 0x8a, 0x5a, 0xe6, 0x7d, 0x68, 0x41, 0x9d, 0x25, 0xce, 0x9b,
+	0xb0, 0xae, 0x75, 0x3b, 0xcd, 0x6f, 0xc9, 0x44, 0xdf, 0x6a, 0xfa, 0x1a, 0xf2, 0x0e, 0x6c, 0x31,
+	0x01, 0xee, 0x85, 0xe1, 0xcc, 0xdb, 0x93, 0xf3, 0xdb, 0x75, 0xd8, 0x2e, 0x55, 0x93, 0xf6, 0x97,
+	0x3e, 0xe1, 0x6f, 0x4a, 0x79, 0x99, 0x2b, 0xdc, 0xe6, 0x9f, 0xbc, 0x96, 0xfd, 0xfb, 0x35, 0x68,
+	0x32, 0xd0, 0xd4, 0xf1, 0xfa, 0x5c, 0x2c, 0x9d, 0x7c, 0x6a, 0xb2, 0xa3, 0xe5, 0x77, 0x66, 0x23,
+	0xc6, 0xfe, 0x53, 0xef, 0xa7, 0x17, 0xe3, 0x1c, 0x62, 0xff, 0x00, 0x56, 0x8b, 0x08, 0x17, 0xba,
+	0xbb, 0xbb, 0x0b, 0xdd, 0x43, 0x92, 0xb9, 0x41, 0x7a, 0xf2, 0x51, 0x10, 0x86, 0x87, 0x4f, 0x83,
+	0xac, 0x7f, 0x2c, 0xc4, 0xba, 0x05, 0x4d, 0x12, 0x0d, 0x3d, 0xde, 0xa3, 0x05, 0x97, 0x7f, 0x39,
+	0x13, 0xb8, 0x64, 0xa8, 0xc3, 0x65, 0x8a, 0xa6, 0x3b, 0x45, 0x53, 0xee, 0x53, 0xf1, 0x53, 0x91,
+	0x76, 0xfd, 0xeb, 0x48, 0xdb, 0xf9, 0x79, 0x03, 0x56, 0xf7, 0xe3, 0xc8, 0x0f, 0xa8, 0x45, 0xe4,
+	0x31, 0xe4, 0x92, 0xdb, 0xf1, 0x12, 0x2c, 0x0c, 0x93, 0x78, 0x32, 0x56, 0xe6, 0x06, 0x7e, 0x3f,
+	0xf4, 0xf1, 0xfe, 0xc0, 0x4b, 0xf8, 0xa6, 0xc8, 0x16, 0x88, 0x05, 0x06, 0x78, 0xe8, 0x6b, 0xe3,
+	0xd7, 0xa8, 0x58, 0x0b, 0xe7, 0x2f, 0x38, 0xa9, 0x9a, 0x55, 0x93, 0xaa, 0x55, 0x39, 0xa9, 0x16,
+	0x0c, 0x96, 0x61, 0x96, 0x04, 0xc3, 0x21, 0xdd, 0x78, 0x71, 0x72, 0xb1, 0x3b, 0x91, 0x25, 0x0e,
+	0x64, 0xfb, 0xc4, 0x55, 0x58, 0xc4, 0x9b, 0xa4, 0x9e, 0x6a, 0x07, 0x02, 0x82, 0x0e, 0xa6, 0x1a,
+	0x83, 0xdf, 0x82, 0xb5, 0x2c, 0xf1, 0x02, 0x76, 0x5e, 0x0a, 0xd2, 0x0c, 0x0f, 0xa2, 0xcc, 0x24,
+	0x5c, 0x15, 0x05, 0xf7, 0x39, 0x9c, 0x9e, 0x7a, 0x24, 0x32, 0xdf, 0x7a, 0xba, 0xcb, 0x88, 0xdb,
+	0x11, 0xf0, 0x03, 0x06, 0xa6, 0x37, 0x91, 0x4f, 0xbd, 0x8c, 0x24, 0x23, 0x2f, 0x39, 0xe1, 0x4c,
+	0xad, 0x20, 0xe6, 0x8a, 0x04, 0x4b, 0xc6, 0xf8, 0xa4, 0xe8, 0x68, 0x36, 0xad, 0xba, 0x0c, 0xac,
+	0xea, 0x4b, 0xdc, 0x06, 0xcc, 0x93, 0x24, 0x89, 0x93, 0xee, 0x1a, 0xd3, 0x65, 0xfc, 0xa0, 0x62,
+	0x44, 0x63, 0x99, 0xde, 0x35, 0x66, 0x5d, 0x0b, 0xcd, 0xe7, 0x36, 0x87, 0xec, 0xe1, 0xcd, 0x29,
+	0x77, 0xf2, 0xd3, 0xe2, 0x75, 0x56, 0xcc, 0x21, 0x7b, 0x99, 0xf3, 0x31, 0x5c, 0x2a, 0x6a, 0x56,
+	0xbe, 0x4a, 0xbc, 0x59, 0x58, 0x25, 0xba, 0xb9, 0xbf, 0x45, 0xaf, 0x22, 0x35, 0xf5, 0xbf, 0xd5,
+	0xd1, 0x9b, 0x50, 0x2a, 0x7f, 0x89, 0xb7, 0x3c, 0xa6, 0x3d, 0xb7, 0xa0, 0x6b, 0xf3, 0xe7, 0xea,
+	0x5a, 0xf3, 0x7c, 0x5d, 0x6b, 0x4d, 0xd1, 0xb5, 0x85, 0xf3, 0x75, 0xad, 0x7d, 0x01, 0x5d, 0x03,
+	0xa3, 0xae, 0x39, 0x7f, 0xa9, 0x06, 0xd6, 0x9e, 0xef, 0x3f, 0xde, 0x7f, 0xac, 0x09, 0xf9, 0x3d,
+	0x98, 0x1f, 0x04, 0x49, 0x9a, 0xf1, 0x1b, 0x28, 0x47, 0x7a, 0xe8, 0x2b, 0xc7, 0xc5, 0x65, 0x15,
+	0xac, 0xef, 0x42, 0x33, 0x25, 0xfd, 0x38, 0xf2, 0xbb, 0xf5, 0x99, 0xab, 0xf2, 0x1a, 0xce, 0xbf,
+	0xa8, 0xc3, 0xd6, 0x9e, 0xef, 0xdf, 0x4b, 0xbc, 0xfe, 0x09, 0xc9, 0xfe, 0xd0, 0x8c, 0x3a, 0xa1,
+	0xfb, 0x82, 0x36, 0xea, 0x08, 0xc1, 0x2a, 0x57, 0x61, 0x91, 0x15, 0xab, 0x63, 0xce, 0x6a, 0x14,
+	0x07, 0xb4, 0xa5, 0x0d, 0xe8, 0xeb, 0xb0, 0x96, 0x79, 0x27, 0x84, 0x3a, 0x2f, 0x06, 0x52, 0x1f,
+	0x16, 0xf8, 0x20, 0x79, 0x27, 0xe4, 0x00, 0xe1, 0xac, 0x8d, 0x9b, 0xd0, 0x49, 0xb3, 0x78, 0x8c,
+	0xe6, 0xab, 0xb6, 0x90, 0x2d, 0x53, 0x30, 0x35, 0x5d, 0x11, 0xcf, 0x79, 0x1f, 0x9d, 0xba, 0x86,
+	0xb9, 0x78, 0xfe, 0x46, 0x7f, 0x07, 0x76, 0xd9, 0x46, 0x52, 0x35, 0xed, 0x0a, 0x5b, 0x85, 0xf3,
+	0x57, 0xe7, 0x60, 0xf3, 0x30, 0xf3, 0x92, 0xec, 0x83, 0x67, 0xa4, 0x3f, 0xc9, 0x30, 0xc6, 0x4d,
+	0x46, 0xaf, 0x79, 0xe1, 0x30, 0x4e, 0x82, 0xec, 0x58, 0x46, 0xaf, 0x49, 0x80, 0xc6, 0x44, 0xbd,
+	0x62, 0x20, 0xbf, 0x11, 0xfb, 0x2b, 0x1f, 0x88, 0x66, 0xf1, 0x48, 0x3f, 0x7d, 0x4a, 0x6e, 0xc0,
+	0x3c, 0x86, 0x90, 0xf1, 0xed, 0x85, 0x7d, 0x50, 0x33, 0x81, 0x44, 0x3e, 0x77, 0x26, 0xd0, 0x9f,
+	0xb8, 0x1a, 0x87, 0x41, 0x9f, 0xa4, 0x38, 0xd7, 0xe6, 0x5c, 0xfe, 0x45, 0x7b, 0x1c, 0x44, 0x19,
+	0x49, 0x4e, 0xbd, 0x10, 0x37, 0x90, 0xb6, 0x2b, 0xbf, 0x99, 0xf9, 0x1f, 0x0f, 0x82, 0x90, 0xf4,
+	0x7c, 0xef, 0x2c, 0xc5, 0xdd, 0x63, 0xce, 0x5d, 0xe4, 0xb0, 0xfb, 0xde, 0x59, 0x4a, 0x8d, 0xe6,
+	0xd3, 0x20, 0x0d, 0x68, 0x54, 0x0f, 0xe7, 0x9f, 0x6d, 0x1b, 0xcb, 0x1c, 0xba, 0x87, 0x40, 0xe7,
+	0x97, 0xc0, 0x92, 0x23, 0xf1, 0xf0, 0x7e, 0xd5, 0xa8, 0xdd, 0xe3, 0x51, 0x59, 0x1c, 0x71, 0x26,
+	0x17, 0x45, 0xc1, 0x2f, 0xe4, 0xfc, 0xd7, 0x1a, 0xac, 0xcb, 0x16, 0xf6, 0x8f, 0x83, 0xd0, 0x67,
+	0xc6, 0x44, 0xb5, 0xf1, 0x59, 0x79, 0xd8, 0x7b, 0x15, 0x3a, 0x04, 0x5b, 0xa2, 0x3b, 0x8b, 0x7a,
+	0x04, 0x5d, 0x11, 0xe0, 0x3d, 0x79, 0x2a, 0xf4, 0x4e, 0x31, 0x90, 0x47, 0x3f, 0xf0, 0x71, 0x60,
+	0x71, 0x3b, 0x9c, 0xd7, 0xb6, 0xc3, 0xeb, 0xb0, 0x94, 0xe2, 0x99, 0x9c, 0x6f, 0x60, 0xdc, 0x27,
+	0x29, 0x61, 0x7b, 0x59, 0xbe, 0x2d, 0xb6, 0x94, 0x6d, 0xd1, 0xf9, 0x97, 0x0d, 0x58, 0x53, 0xd4,
+	0x9b, 0xef, 0x68, 0x45, 0xa3, 0x49, 0xd3, 0xf7, 0xfa, 0x34, 0x7d, 0x9f, 0xab, 0xd0, 0xf7, 0xe7,
+	0x3e, 0x58, 0x09, 0x7d, 0x6f, 0xea, 0xfa, 0xce, 0xa5, 0xd1, 0xd2, 0xa4, 0x51, 0xb5, 0xc3, 0x14,
+	0xe6, 0x41, 0xbb, 0x34, 0x0f, 0x0c, 0x83, 0x05, 0xc6, 0xc1, 0xba, 0x05, 0xab, 0x09, 0x19, 0x79,
+	0x41, 0x44, 0xf7, 0x1f, 0xcd, 0x72, 0xea, 0x48, 0x78, 0xd5, 0xb8, 0x2e, 0x19, 0xc6, 0x95, 0x8e,
+	0x1f, 0x4e, 0x25, 0x76, 0x1d, 0xd5, 0x5d, 0xe6, 0xe3, 0x87, 0x30, 0xbc, 0x81, 0xa2, 0xcc, 0x73,
+	0x94, 0x94, 0x6e, 0x76, 0x2b, 0x88, 0x01, 0x0c, 0x74, 0x48, 0x98, 0xa7, 0x87, 0x4d, 0xe2, 0x8e,
+	0x61, 0x12, 0xaf, 0xe6, 0x93, 0xd8, 0x6c, 0x1f, 0x7d, 0x07, 0x16, 0xfa, 0x54, 0xd1, 0x13, 0x12,
+	0x75, 0x2d, 0xfd, 0x32, 0xde, 0x30, 0x13, 0x5c, 0x89, 0xec, 0xb8, 0x3c, 0x9e, 0x31, 0x9f, 0x6f,
+	0x5c, 0x89, 0xde, 0x07, 0x20, 0x12, 0xca, 0x4d, 0xa3, 0x4b, 0xa5, 0x36, 0xf3, 0xc0, 0x85, 0x1c,
+	0x99, 0xdf, 0x89, 0x7f, 0x70, 0x4a, 0x94, 0x20, 0xd8, 0xdf, 0xab, 0x41, 0x47, 0xae, 0xdc, 0x07,
+	0x5e, 0xe2, 0x8d, 0x52, 0x1e, 0x87, 0xcd, 0x40, 0x62, 0x1d, 0x96, 0x80, 0x8a, 0xf0, 0x14, 0x6a,
+	0x08, 0x1e, 0x93, 0xfe, 0x49, 0x8f, 0xc7, 0x8b, 0xb0, 0xe0, 0x6d, 0x0a, 0xb9, 0x47, 0xa3, 0x43,
+	0xbe, 0x0d, 0xeb, 0x79, 0x71, 0xcf, 0x8b, 0xfc, 0x1e, 0x0f, 0x16, 0xc1, 0xd8, 0x34, 0x89, 0xb7,
+	0x17, 0xf9, 0x7b, 0x34, 0x42, 0xe4, 0x16, 0xac, 0xca, 0x18, 0x89, 0x9e, 0xe6, 0x80, 0xeb, 0x48,
+	0x38, 0x5f, 0xbe, 0xfe, 0xa0, 0x06, 0x6b, 0x4a, 0xaf, 0x4a, 0x53, 0x0d, 0xa3, 0x65, 0xa6, 0x6e,
+	0x1e, 0x16, 0x34, 0x02, 0x1a, 0x2f, 0xcd, 0xdd, 0x82, 0xf4, 0xb7, 0x75, 0x0f, 0x56, 0x65, 0x8f,
+	0x7b, 0x63, 0x14, 0x0b, 0x9f, 0x6c, 0xdb, 0x25, 0x33, 0x94, 0x49, 0xcd, 0xed, 0xf4, 0x0b, 0x62,
+	0x9c, 0xfd, 0xfc, 0x42, 0x67, 0x56, 0x1f, 0xa5, 0xcd, 0xdd, 0x47, 0xec, 0x8b, 0x71, 0xcd, 0x66,
+	0x08, 0xbf, 0xe8, 0x90, 0xdf, 0xce, 0x7f, 0xa9, 0x41, 0x67, 0xcf, 0xf7, 0xb1, 0xdf, 0xb3, 0x2c,
+	0xc6, 0xa2, 0x97, 0xf5, 0x73, 0x7a, 0x39, 0xf7, 0x35, 0x7b, 0xf9, 0xdc, 0x4b, 0x51, 0x85, 0x10,
+	0x1c, 0x07, 0x56, 0xf3, 0x7e, 0x9a, 0x87, 0x97, 0xee, 0x61, 0xec, 0x72, 0x4c, 0x13, 0x47, 0x11,
+	0x6b, 0x13, 0xd6, 0x35, 0x2c, 0xee, 0x0a, 0xfa, 0x10, 0x5e, 0xa3, 0xc6, 0x4f, 0x72, 0x36, 0xce,
+	0x62, 0x71, 0x19, 0x71, 0x9f, 0x8c, 0xe3, 0x34, 0x10, 0x8e, 0x25, 0x32, 0x93, 0x25, 0xf4, 0x6f,
+	0x6b, 0x70, 0x6b, 0x86, 0x86, 0x78, 0x17, 0xbe, 0x28, 0x47, 0x07, 0xfc, 0x09, 0xf5, 0x71, 0xc2,
+	0x4c, 0xad, 0xdc, 0x96, 0x10, 0x1e, 0x23, 0x2e, 0x9b, 0xb4, 0xbf, 0x0f, 0x2b, 0x7a, 0xe1, 0x85,
+	0xfc, 0x13, 0x21, 0xdc, 0x3c, 0x87, 0x89, 0x59, 0x74, 0xee, 0x26, 0xac, 0xf4, 0xb5, 0x26, 0x38,
+	0xa1, 0x02, 0xd4, 0xd9, 0x87, 0x57, 0xcf, 0xa5, 0x96, 0xfb, 0x39, 0xcc, 0xf7, 0xab, 0xce, 0x3f,
+	0x69, 0xc0, 0xf6, 0x67, 0x41, 0x76, 0xec, 0x27, 0xde, 0x53, 0xa1, 0x7d, 0xb3, 0x30, 0x59, 0xb8,
+	0x7a, 0xad, 0x97, 0x6f, 0x8b, 0x5f, 0x87, 0xb5, 0x38, 0x22, 0x78, 0x43, 0xd4, 0x1b, 0x7b, 0x69,
+	0xfa, 0x34, 0x4e, 0x84, 0x27, 0xa3, 0x13, 0x47, 0x84, 0xde, 0x12, 0x1d, 0x70, 0x70, 0xc1, 0x59,
+	0xda, 0x28, 0x3a, 0x4b, 0x57, 0x61, 0x6e, 0x1c, 0x44, 0x3c, 0xe2, 0x8d, 0xfe, 0xa4, 0x56, 0x5a,
+	0x96, 0x78, 0xbe, 0xd2, 0x32, 0x77, 0x6d, 0x22, 0x54, 0xb6, 0xab, 0xc6, 0x60, 0xb5, 0x0a, 0x31,
+	0x58, 0x8a, 0x4c, 0x16, 0xf4, 0x3b, 0xe7, 0xab, 0xb0, 0xc8, 0x7f, 0xf6, 0x32, 0x6f, 0xc8, 0x6d,
+	0x4e, 0xe0, 0xa0, 0x27, 0xde, 0x50, 0xd9, 0xd3, 0x41, 0xdb, 0xd3, 0x77, 0x01, 0x06, 0x84, 0xe8,
+	0x7b, 0x70, 0x7b, 0x40, 0xb8, 0xcd, 0x48, 0x7d, 0x3a, 0x47, 0x5e, 0x74, 0xd2, 0xc3, 0x1b, 0xe4,
+	0x25, 0xc6, 0x0e, 0x05, 0xd0, 0xc8, 0x7f, 0xba, 0xeb, 0x62, 0xa1, 0xe0, 0x69, 0x99, 0x49, 0x94,
+	0xc2, 0xf6, 0xf2, 0xbb, 0x70, 0x44, 0xe9, 0x07, 0xd9, 0x59, 0x77, 0x25, 0xaf, 0xbf, 0x1f, 0x64,
+	0x67, 0xb2, 0x3e, 0xca, 0x2c, 0x39, 0xeb, 0x76, 0xf2, 0xfa, 0xfb, 0x0c, 0x44, 0xd9, 0x4b, 0x9f,
+	0x06, 0x03, 0xc2, 0xc2, 0xfa, 0xd9, 0x2e, 0xdc, 0x46, 0x08, 0x8d, 0xa5, 0xa7, 0xc6, 0xc1, 0xd3,
+	0x20, 0x51, 0xae, 0x16, 0xd9, 0x9e, 0xbc, 0x44, 0x81, 0x42, 0x35, 0x9c, 0xd7, 0x61, 0x55, 0xa8,
+	0x8b, 0xfa, 0xf2, 0x2d, 0x21, 0xe9, 0x24, 0xcc, 0xc4, 0xcb, 0x37, 0xf6, 0xe5, 0xbc, 0x85, 0x31,
+	0xed, 0x8f, 0xe2, 0xe1, 0x30, 0xbf, 0xfc, 0xca, 0xbd, 0x75, 0x21, 0xc2, 0x45, 0x15, 0xf6, 0xe5,
+	0x44, 0xd0, 0x2d, 0x57, 0xc9, 0x63, 0xce, 0x82, 0x68, 0x10, 0x73, 0x4f, 0x1d, 0xfe, 0xa6, 0x73,
+	0xd1, 0x27, 0x47, 0x93, 0xa1, 0x78, 0xc1, 0x82, 0x1f, 0x14, 0xf3, 0xa9, 0x97, 0x44, 0x7c, 0x43,
+	0xc5, 0xdf, 0xb9, 0xa5, 0xc1, 0x76, 0x4f, 0xf6, 0xe1, 0x3c, 0x80, 0xed, 0xc3, 0x8b, 0xb1, 0x48,
+	0x1b, 0x62, 0x77, 0xed, 0x7c, 0xfa, 0xe3, 0x87, 0xf3, 0x91, 0x16, 0xbf, 0x8f, 0x31, 0xde, 0xb3,
+	0x4c, 0xa3, 0x0d, 0x98, 0xc7, 0xb5, 0x5c, 0x34, 0x86, 0x1f, 0xce, 0xcf, 0x6b, 0xd0, 0x2d, 0xb7,
+	0x26, 0x5f, 0x10, 0x95, 0xe3, 0xe1, 0xd9, 0x4a, 0xf8, 0xae, 0x21, 0x1e, 0x5e, 0xab, 0x3b, 0x5b,
+	0x40, 0xfc, 0x37, 0x1a, 0xe3, 0xfe, 0x15, 0xac, 0xab, 0xac, 0xbd, 0xd4, 0x3b, 0xdb, 0x9f, 0xd6,
+	0x30, 0xbe, 0x41, 0x5e, 0x90, 0x1d, 0x66, 0x09, 0xf1, 0x46, 0x2f, 0x35, 0x9c, 0xf9, 0x97, 0xe1,
+	0xba, 0xfa, 0xda, 0xe5, 0xc2, 0x9c, 0x38, 0x7f, 0x16, 0x83, 0x40, 0x59, 0x88, 0xf6, 0x2f, 0x80,
+	0xff, 0xef, 0xc3, 0x15, 0x85, 0xff, 0x0b, 0xb2, 0xc1, 0x1d, 0x26, 0xd8, 0x6b, 0x16, 0xb2, 0x3c,
+	0x7b, 0xd5, 0x7f, 0x50, 0x87, 0x75, 0xa5, 0xa2, 0x9c, 0x0d, 0xaf, 0xc3, 0x3c, 0xda, 0xb6, 0xc5,
+	0xd8, 0x6d, 0xed, 0xc6, 0x9d, 0xa1, 0xd0, 0x1d, 0x09, 0x3d, 0x01, 0x91, 0x17, 0xf6, 0x0a, 0x77,
+	0x52, 0x1d, 0x51, 0xf0, 0x98, 0x1f, 0xa1, 0x5f, 0x85, 0xce, 0x38, 0x21, 0xa7, 0x41, 0x3c, 0x91,
+	0xaf, 0xf4, 0x98, 0x30, 0x56, 0x04, 0x98, 0xbf, 0x5e, 0x35, 0x1c, 0xd3, 0x1a, 0x33, 0x1f, 0xd3,
+	0xe6, 0xcd, 0xc7, 0xb4, 0x1b, 0x20, 0x2b, 0xd3, 0xc8, 0xa0, 0xcc, 0xe3, 0x3e, 0x94, 0x65, 0x01,
+	0xbd, 0x4f, 0x81, 0x74, 0x3e, 0x0e, 0x88, 0x70, 0xa1, 0xd0, 0x9f, 0xce, 0xdf, 0xab, 0xa1, 0xc3,
+	0x61, 0x6f, 0xe2, 0x07, 0x99, 0x66, 0xd4, 0xd1, 0xa5, 0x3f, 0xf3, 0x92, 0xac, 0x47, 0x85, 0x27,
+	0xdf, 0x38, 0x52, 0xc8, 0x7d, 0x2f, 0xc3, 0x8b, 0x2c, 0x12, 0xf9, 0xac, 0x90, 0x5f, 0x44, 0x90,
+	0xc8, 0x17, 0x45, 0x4c, 0x56, 0x47, 0x67, 0xda, 0x45, 0xe5, 0x3d, 0x34, 0x84, 0xf0, 0xbc, 0x8a,
+	0x1d, 0x9e, 0x77, 0xd9, 0x07, 0x5d, 0x37, 0xe3, 0xc1, 0x20, 0x25, 0xac, 0x77, 0xf3, 0x2e, 0xff,
+	0x72, 0xf6, 0x61, 0xb3, 0xc0, 0x9a, 0x1c, 0xc2, 0x26, 0xa1, 0x80, 0x52, 0xf0, 0xb7, 0x82, 0xcb,
+	0x31, 0x9c, 0x7f, 0xcd, 0xa6, 0xf0, 0x0f, 0x83, 0x34, 0x8b, 0x93, 0xa0, 0xbf, 0xef, 0x45, 0x7e,
+	0x48, 0xd2, 0x97, 0x39, 0x05, 0xf2, 0xa3, 0x6d, 0xc3, 0x70, 0xb4, 0x9d, 0xcf, 0x8f, 0xb6, 0xaa,
+	0x1f, 0xaa, 0xa9, 0xfb, 0xa1, 0x68, 0x60, 0x99, 0x6d, 0xea, 0xc6, 0x0c, 0xf1, 0xdc, 0x7f, 0x98,
+	0xfa, 0x61, 0xdd, 0x84, 0x66, 0x1f, 0x79, 0xe7, 0x6f, 0xb6, 0x57, 0x94, 0x5b, 0x32, 0x3f, 0x24,
+	0x2e, 0x2f, 0x75, 0x7e, 0xbd, 0x06, 0x4d, 0x06, 0xa2, 0x7b, 0xb3, 0xf2, 0x48, 0x1e, 0x7f, 0x8b,
+	0xa7, 0x37, 0xf5, 0xfc, 0xe9, 0x8d, 0x78, 0xa0, 0x33, 0xa7, 0x3c, 0xd0, 0xb1, 0xa0, 0x11, 0x8f,
+	0x49, 0x24, 0x1e, 0xf2, 0xd0, 0xdf, 0xb4, 0x13, 0xfd, 0x30, 0x4e, 0x09, 0x9f, 0x49, 0xec, 0x43,
+	0x79, 0x94, 0xd3, 0x54, 0x1f, 0xe5, 0x38, 0x3f, 0x9f, 0x83, 0x36, 0x63, 0xe3, 0x47, 0xf1, 0x51,
+	0xc9, 0xb1, 0xf4, 0x52, 0x5c, 0xa5, 0xaa, 0x34, 0xe7, 0x0b, 0xd2, 0x94, 0x23, 0xd2, 0x34, 0x8c,
+	0x48, 0x4b, 0xf7, 0x7c, 0xb2, 0x25, 0x69, 0xa1, 0xe8, 0x78, 0x4b, 0x28, 0xb7, 0xc2, 0x71, 0xd3,
+	0x66, 0x8e, 0x1b, 0x06, 0x63, 0x8e, 0x9b, 0x57, 0xa1, 0xc3, 0x51, 0xfa, 0xf1, 0x68, 0x1c, 0x92,
+	0x8c, 0x70, 0xef, 0xe9, 0x0a, 0x03, 0xef, 0x73, 0x28, 0x06, 0x79, 0x31, 0xad, 0xec, 0xa5, 0xde,
+	0x29, 0xf1, 0xd1, 0x9a, 0x6d, 0xb8, 0x4b, 0x1c, 0x78, 0x48, 0x61, 0xb9, 0x4d, 0xb5, 0x54, 0x7d,
+	0xbb, 0xb5, 0xcc, 0x3d, 0x21, 0x15, 0xb7, 0x5b, 0xcc, 0x8c, 0xcd, 0x6f, 0xb7, 0xac, 0x77, 0xa1,
+	0x3d, 0x89, 0xfa, 0xf1, 0x29, 0x49, 0x88, 0xdf, 0xed, 0xa0, 0x56, 0x6d, 0xeb, 0x5a, 0x45, 0x97,
+	0x25, 0x97, 0x72, 0xeb, 0xe6, 0x98, 0xce, 0xef, 0xd7, 0xb8, 0x7f, 0x5c, 0x8e, 0xef, 0x4b, 0x5d,
+	0x14, 0xd4, 0x61, 0x6d, 0x54, 0x0d, 0xeb, 0xbc, 0x61, 0x58, 0x9b, 0x72, 0x58, 0x9d, 0x9b, 0xb8,
+	0x76, 0x4b, 0xfe, 0xd3, 0x2a, 0xa7, 0xf2, 0x0f, 0x60, 0xb3, 0x80, 0xc7, 0x97, 0x8d, 0x1b, 0xd0,
+	0xf8, 0x49, 0x7c, 0x24, 0x96, 0xd1, 0x35, 0x5d, 0x6a, 0x54, 0x22, 0x58, 0xec, 0xfc, 0x2b, 0x66,
+	0x5d, 0x32, 0xf0, 0x7e, 0xcc, 0x5c, 0x7f, 0x7f, 0xe4, 0xa4, 0xf5, 0x3e, 0x74, 0x0a, 0xea, 0x90,
+	0x57, 0xad, 0x19, 0xaa, 0xd6, 0xf3, 0xaa, 0xff, 0xb0, 0x8e, 0x9b, 0x48, 0x51, 0x00, 0x2f, 0x73,
+	0xf1, 0x9d, 0x26, 0x81, 0x1b, 0xb0, 0x42, 0xb7, 0x05, 0xe2, 0xf7, 0xf8, 0x64, 0x43, 0x51, 0x34,
+	0xf0, 0xf6, 0x29, 0x21, 0x3e, 0xdf, 0x2c, 0xac, 0x3b, 0xd0, 0x64, 0x80, 0x6e, 0x73, 0xfa, 0x2c,
+	0xe1, 0x68, 0xd6, 0x5b, 0xd0, 0x1a, 0x05, 0x29, 0x7d, 0xec, 0xdb, 0x6d, 0x4d, 0xaf, 0x21, 0xf0,
+	0x9c, 0x67, 0x00, 0xf9, 0x26, 0x8c, 0x4b, 0xf7, 0xd9, 0x58, 0x48, 0x05, 0x7f, 0xd3, 0xe7, 0x1d,
+	0x81, 0x4f, 0xa2, 0x2c, 0x18, 0x04, 0x44, 0xbc, 0x86, 0x53, 0x20, 0xf4, 0x94, 0x3d, 0x22, 0x69,
+	0xea, 0x49, 0x77, 0xbc, 0xf8, 0xa4, 0xfe, 0x52, 0xba, 0xf8, 0xa7, 0x99, 0x37, 0x1a, 0x8b, 0x75,
+	0x52, 0x02, 0x9c, 0x23, 0x68, 0x3f, 0xd8, 0x7f, 0x72, 0x88, 0xde, 0x04, 0x4a, 0xf8, 0x93, 0x4f,
+	0x1e, 0xde, 0x17, 0x84, 0xe9, 0x6f, 0x19, 0x89, 0x5d, 0x57, 0x22, 0xb1, 0x2d, 0x3a, 0x3c, 0xd9,
+	0xb1, 0xf0, 0x49, 0xd2, 0xdf, 0xd4, 0x7e, 0x89, 0xc8, 0xb3, 0xac, 0x97, 0x4c, 0x22, 0x4e, 0xa5,
+	0x45, 0xbf, 0xdd, 0x49, 0xe4, 0xdc, 0x87, 0x6d, 0x49, 0x83, 0xf9, 0x80, 0xe5, 0x34, 0xb8, 0x05,
+	0x4d, 0xe6, 0xc9, 0xe0, 0x76, 0xa5, 0x9c, 0x4c, 0xb2, 0x82, 0xcb, 0x11, 0x9c, 0x3d, 0xd8, 0x90,
+	0xc0, 0xc3, 0x2c, 0x1e, 0x7f, 0x8d, 0x26, 0x2e, 0xc1, 0xb6, 0xd6, 0xc4, 0x5e, 0x18, 0x0a, 0x4f,
+	0x33, 0x7d, 0x6d, 0x9f, 0x17, 0xd1, 0x65, 0x5e, 0x94, 0xa8, 0x95, 0x1e, 0x05, 0x69, 0xa6, 0x54,
+	0xfa, 0x9d, 0x9a, 0x52, 0xeb, 0x93, 0x71, 0x18, 0x7b, 0xbe, 0xe0, 0x8a, 0x7a, 0xee, 0x11, 0xdc,
+	0x53, 0xe2, 0xd8, 0x81, 0x81, 0xd0, 0x0f, 0x91, 0x23, 0xe0, 0x03, 0xaf, 0xba, 0x8a, 0x70, 0xdf,
+	0xcb, 0x3c, 0xf9, 0xf4, 0x6b, 0x2e, 0x7f, 0xfa, 0x45, 0xd5, 0xd9, 0x4b, 0xfa, 0xc7, 0x01, 0xdd,
+	0x28, 0xd8, 0xf9, 0x5a, 0x7e, 0xd3, 0x71, 0xa6, 0x53, 0xec, 0x69, 0x12, 0x64, 0x6c, 0x9b, 0x5e,
+	0x70, 0x73, 0x80, 0xf3, 0x00, 0xec, 0x5c, 0x1e, 0xc4, 0xf3, 0xc5, 0xaf, 0x0b, 0xcb, 0xf0, 0x1e,
+	0x6c, 0x4a, 0xe0, 0xaf, 0x4c, 0x48, 0x72, 0xf6, 0x35, 0xda, 0xf8, 0x11, 0x74, 0x25, 0x70, 0x6f,
+	0x92, 0xc5, 0x8f, 0x14, 0xc1, 0x6d, 0x69, 0xcd, 0xb4, 0x45, 0x1d, 0x65, 0x33, 0x66, 0x2e, 0x08,
+	0xfe, 0xe5, 0x7c, 0xa1, 0x8d, 0x29, 0x1b, 0xb8, 0xdc, 0x5f, 0x22, 0x13, 0x7f, 0xa8, 0xfb, 0xf7,
+	0xb7, 0xa0, 0xc5, 0x1a, 0x15, 0x41, 0x47, 0x06, 0x56, 0x05, 0x86, 0x13, 0xc3, 0x56, 0xb1, 0xbf,
+	0xe7, 0x34, 0x9f, 0x0b, 0xa2, 0x7e, 0x8e, 0x20, 0xb4, 0x31, 0x6e, 0xf3, 0xe7, 0x7d, 0x1f, 0x2a,
+	0xc2, 0xe1, 0xa9, 0x2b, 0xce, 0x25, 0x29, 0xda, 0xa9, 0x2b, 0xed, 0xdc, 0x81, 0x4d, 0x4d, 0x30,
+	0xe4, 0x1c, 0x09, 0x3b, 0x19, 0xac, 0xeb, 0x15, 0xd8, 0x13, 0xc9, 0xaa, 0x01, 0xe1, 0x5e, 0x8a,
+	0xba, 0xc1, 0x61, 0x3b, 0xa7, 0x38, 0x6c, 0x0b, 0x76, 0x48, 0xa3, 0x60, 0x87, 0x38, 0xa4, 0x30,
+	0xf1, 0xc8, 0xb9, 0x9d, 0x7d, 0x1b, 0x9a, 0xd8, 0x72, 0xe9, 0x01, 0xa9, 0x81, 0x7b, 0x97, 0xa3,
+	0x3a, 0x5f, 0x28, 0xab, 0xc7, 0x13, 0x92, 0x66, 0xca, 0x8b, 0x18, 0xa1, 0x0b, 0x74, 0x3b, 0x6f,
+	0xcb, 0x81, 0x97, 0x8b, 0x5c, 0x5d, 0x59, 0xe4, 0xba, 0xd0, 0x1a, 0x04, 0xcf, 0xb2, 0x49, 0x42,
+	0xf8, 0xb4, 0x14, 0x9f, 0xce, 0xbf, 0xa9, 0xc1, 0x7a, 0x81, 0x00, 0xf5, 0xcd, 0x4d, 0x53, 0x67,
+	0xea, 0x52, 0x95, 0x6f, 0x59, 0xf8, 0x17, 0x5d, 0xe7, 0xe9, 0x8f, 0x84, 0x5d, 0xa0, 0xb1, 0x07,
+	0xd5, 0x0a, 0x84, 0xae, 0x00, 0x03, 0x2f, 0x08, 0x27, 0x09, 0x61, 0x8f, 0x99, 0xdb, 0xae, 0xfc,
+	0xce, 0xcd, 0xc4, 0x79, 0xd5, 0x4c, 0xcc, 0x83, 0xe5, 0x9b, 0x33, 0x04, 0xcb, 0x0f, 0x60, 0xb3,
+	0xd8, 0x8d, 0xe9, 0xa3, 0xf1, 0x2e, 0xb4, 0x98, 0x1b, 0xb2, 0x7a, 0x38, 0x72, 0x71, 0xb8, 0x02,
+	0xd7, 0xf9, 0x7f, 0x73, 0xb0, 0xb1, 0x97, 0x1c, 0x05, 0x19, 0xb5, 0x09, 0x1e, 0xa3, 0x03, 0x6b,
+	0x12, 0x51, 0xff, 0xea, 0x85, 0x12, 0x17, 0x1c, 0x4d, 0xce, 0x7a, 0x85, 0xb3, 0xc4, 0xe2, 0xd1,
+	0xe4, 0x4c, 0xf8, 0x4d, 0xa8, 0x75, 0x9d, 0x92, 0x30, 0xec, 0x15, 0xae, 0xaa, 0x97, 0x28, 0x50,
+	0x22, 0xe5, 0x5e, 0xe6, 0x86, 0xe6, 0x65, 0xa6, 0x6e, 0xe0, 0x89, 0x88, 0x80, 0x61, 0xe7, 0x9e,
+	0x85, 0xa3, 0x09, 0x8f, 0x7f, 0xa1, 0x07, 0x7d, 0xda, 0xb2, 0x1a, 0x1f, 0xd3, 0xa6, 0x90, 0x03,
+	0x11, 0x6b, 0x4f, 0xeb, 0xb2, 0x63, 0x7b, 0x4b, 0xd6, 0x7d, 0x44, 0xbf, 0x65, 0x5d, 0x56, 0xba,
+	0x90, 0xd7, 0x65, 0xc5, 0xf8, 0x94, 0x35, 0xcd, 0xf8, 0x55, 0x35, 0xfe, 0xa6, 0xc3, 0x3e, 0x4e,
+	0xe2, 0x3e, 0x21, 0x7e, 0x9a, 0x67, 0x39, 0x60, 0xdf, 0x54, 0x0e, 0x59, 0xe2, 0xf9, 0xd4, 0xdd,
+	0x31, 0x20, 0x24, 0xe5, 0xfe, 0xf0, 0x45, 0x0e, 0xfb, 0x90, 0x10, 0x74, 0x9e, 0x3c, 0xe5, 0xde,
+	0x64, 0x2f, 0x64, 0x58, 0x4b, 0x3c, 0xf4, 0x4e, 0x82, 0x11, 0x71, 0x17, 0x20, 0x22, 0x19, 0x8f,
+	0xde, 0xe1, 0x11, 0x19, 0xed, 0x88, 0x64, 0x2c, 0x6c, 0x87, 0x3e, 0xa7, 0xca, 0x8b, 0x65, 0x0c,
+	0x16, 0x8b, 0xe2, 0x5b, 0x95, 0x68, 0x22, 0xe0, 0x4f, 0xb3, 0x3c, 0x3a, 0x2c, 0xbc, 0x4e, 0x02,
+	0x9c, 0x47, 0x98, 0x30, 0xcb, 0xa0, 0x03, 0x41, 0xee, 0x68, 0x98, 0x59, 0x19, 0x9c, 0x21, 0x5c,
+	0x9f, 0xd2, 0x1a, 0xd7, 0xe1, 0x7b, 0xb0, 0x1c, 0xab, 0x05, 0xc5, 0x87, 0x47, 0x26, 0x85, 0x74,
+	0xf5, 0x2a, 0xce, 0x07, 0x68, 0xd3, 0x4a, 0x4c, 0xdd, 0xb3, 0x36, 0x3b, 0xbf, 0x7f, 0xb3, 0x0e,
+	0x5b, 0x4f, 0x92, 0xc0, 0x8b, 0x86, 0x93, 0xd0, 0x4b, 0x64, 0x73, 0x8f, 0xc8, 0xf0, 0x02, 0x33,
+	0x40, 0xc4, 0x47, 0xd4, 0x95, 0xf8, 0x08, 0xf1, 0x9c, 0x6b, 0xae, 0xf4, 0x9c, 0xab, 0x21, 0x9f,
+	0x73, 0x6d, 0xc0, 0x7c, 0x10, 0x8d, 0x27, 0xc2, 0x2f, 0xc6, 0x3e, 0xd0, 0xa1, 0x34, 0xc9, 0x28,
+	0x98, 0x9f, 0xe6, 0xd9, 0x57, 0x65, 0xa8, 0x97, 0xbc, 0x6a, 0x5f, 0x50, 0xaf, 0xda, 0xcf, 0x8d,
+	0xb7, 0xb8, 0x04, 0x0b, 0xf4, 0xf2, 0x06, 0xdf, 0x8c, 0x31, 0x55, 0x6e, 0x0d, 0x08, 0x7b, 0x2f,
+	0xf6, 0xeb, 0x75, 0xe8, 0x1a, 0x84, 0xb2, 0x7f, 0xd6, 0x0f, 0xa7, 0x9f, 0x17, 0xae, 0x94, 0xf2,
+	0x0a, 0xb4, 0xd5, 0xd4, 0x01, 0xd6, 0x5d, 0x68, 0x84, 0x64, 0x28, 0x32, 0x45, 0xc8, 0x17, 0xca,
+	0xe6, 0x01, 0x70, 0x11, 0x37, 0x17, 0x52, 0xc3, 0x2c, 0xa4, 0x79, 0x4d, 0x48, 0x7c, 0x66, 0x24,
+	0x24, 0x9b, 0x24, 0x91, 0x9c, 0x19, 0x4d, 0x39, 0x33, 0x5c, 0x2c, 0x30, 0xce, 0x8c, 0x56, 0x71,
+	0x66, 0x04, 0xb0, 0x4b, 0x7d, 0xcf, 0x65, 0xe6, 0x66, 0x39, 0x3c, 0xbe, 0x01, 0xd6, 0x28, 0x88,
+	0x8a, 0x8c, 0x30, 0x97, 0xcf, 0xea, 0x28, 0x88, 0x34, 0x46, 0x9c, 0xcf, 0xe1, 0x4a, 0x15, 0x29,
+	0x3e, 0x67, 0xde, 0x83, 0x66, 0x9f, 0xca, 0x5f, 0x4c, 0x96, 0x6b, 0x53, 0x84, 0x87, 0x03, 0xe5,
+	0x72, 0x7c, 0xe7, 0x3f, 0xd5, 0x60, 0xcd, 0x8d, 0x27, 0x85, 0xb7, 0x3d, 0xcf, 0xa7, 0xdd, 0x7a,
+	0xb0, 0xea, 0x5c, 0xf5, 0x83, 0xa8, 0x86, 0x59, 0x55, 0xe7, 0x55, 0x55, 0xd5, 0x72, 0x76, 0x35,
+	0x51, 0x69, 0x72, 0x00, 0x7d, 0x0c, 0xea, 0x27, 0x67, 0x78, 0x9e, 0x61, 0xd1, 0x0d, 0x4d, 0x3f,
+	0x39, 0xa3, 0xc7, 0x99, 0xff, 0x50, 0x87, 0x0e, 0xf6, 0x6b, 0x2f, 0x0c, 0x63, 0x96, 0x07, 0x6f,
+	0xea, 0x88, 0x54, 0x45, 0x89, 0x49, 0xa6, 0xe6, 0xa6, 0xcc, 0x9f, 0x46, 0x69, 0xfe, 0x88, 0xed,
+	0x61, 0x5e, 0xd9, 0x1e, 0xb8, 0x87, 0xba, 0x29, 0x3d, 0xd4, 0xda, 0x2c, 0x6b, 0x69, 0xb3, 0x4c,
+	0x0b, 0x68, 0x5b, 0x28, 0x05, 0xb4, 0x19, 0x5f, 0x13, 0xce, 0x1c, 0x23, 0x45, 0x6f, 0x66, 0x03,
+	0xb9, 0x2d, 0x8a, 0x9b, 0xd9, 0x40, 0x6c, 0x8b, 0x46, 0x47, 0x96, 0x73, 0x1f, 0x56, 0x50, 0x9e,
+	0x1f, 0x3c, 0xeb, 0x87, 0x93, 0x74, 0x06, 0x71, 0x26, 0xc4, 0x4b, 0xe5, 0x65, 0x38, 0xff, 0x72,
+	0xfe, 0x69, 0x03, 0x96, 0xb1, 0x99, 0xca, 0x88, 0xb6, 0x5f, 0xc8, 0x5b, 0x3c, 0x0c, 0xa3, 0x43,
+	0x3d, 0x21, 0xbe, 0xb0, 0x13, 0x24, 0x40, 0x0e, 0x66, 0x4b, 0x19, 0x4c, 0xba, 0x82, 0x13, 0x9e,
+	0xa6, 0xab, 0xe6, 0xe2, 0xef, 0x72, 0x40, 0x59, 0xdb, 0x10, 0x50, 0x46, 0x47, 0x69, 0x30, 0x20,
+	0xfd, 0x2c, 0x38, 0x25, 0x5a, 0xd4, 0xff, 0x8a, 0x04, 0x57, 0x86, 0xbc, 0x2d, 0xce, 0x30, 0x9c,
+	0x4b, 0xc5, 0xe1, 0xcc, 0xd5, 0x65, 0x59, 0x53, 0x17, 0x65, 0xea, 0xac, 0xa8, 0x53, 0xc7, 0x7a,
+	0x1f, 0x16, 0x3d, 0x39, 0x69, 0xd2, 0xa2, 0xdb, 0xb1, 0x30, 0xa9, 0x5c, 0x15, 0xd7, 0xba, 0x8b,
+	0x2a, 0x11, 0x4e, 0x7c, 0x42, 0x03, 0xdb, 0xb4, 0x84, 0x4c, 0xba, 0xf2, 0xb8, 0x12, 0xaf, 0xe0,
+	0x21, 0x5d, 0x2b, 0xc4, 0xff, 0xd3, 0xd8, 0x9d, 0x07, 0x24, 0xc3, 0xda, 0x69, 0x75, 0x64, 0xe9,
+	0x9a, 0x82, 0x93, 0xbf, 0x09, 0x4c, 0x10, 0x52, 0x7c, 0x13, 0xa8, 0xe9, 0x9f, 0xcb, 0x91, 0x9c,
+	0xdf, 0xad, 0xc1, 0xf6, 0xde, 0x70, 0x98, 0x90, 0x21, 0x25, 0xac, 0xa7, 0x59, 0xfa, 0x66, 0x9f,
+	0x3a, 0xca, 0x25, 0xa6, 0xa1, 0x2e, 0x31, 0x37, 0x60, 0x25, 0x4e, 0x82, 0x61, 0x40, 0xef, 0xe7,
+	0xd4, 0x65, 0x71, 0x59, 0x40, 0x59, 0xd8, 0xf5, 0x19, 0x6e, 0x43, 0x06, 0xc6, 0x2f, 0xbe, 0x94,
+	0x77, 0xa1, 0xd5, 0xc7, 0xa7, 0xdc, 0x99, 0x78, 0xba, 0xcf, 0x3f, 0x59, 0x60, 0xc1, 0x98, 0xfb,
+	0x92, 0xe6, 0x5c, 0xf6, 0xe1, 0xfc, 0x8d, 0x3a, 0xec, 0x18, 0x09, 0x5f, 0x38, 0x91, 0x14, 0x8b,
+	0x16, 0xa4, 0xa4, 0xb4, 0xac, 0xad, 0x0c, 0xa0, 0xef, 0x00, 0x73, 0xc5, 0x1d, 0xe0, 0x6d, 0x9e,
+	0x5f, 0x8a, 0x65, 0xd4, 0xb8, 0x2a, 0xad, 0x44, 0xf3, 0x50, 0xf2, 0x4c, 0x53, 0x6f, 0xf3, 0x4c,
+	0x53, 0xf3, 0x33, 0x56, 0x32, 0xe6, 0x9c, 0x6a, 0x96, 0x72, 0x4e, 0x39, 0xff, 0x67, 0x0e, 0x3a,
+	0x07, 0x71, 0x8a, 0xc1, 0x6d, 0xb3, 0x3c, 0x7b, 0x7f, 0x51, 0xb7, 0xf9, 0xc6, 0xd7, 0x03, 0x55,
+	0x8b, 0xdd, 0x2d, 0x58, 0xcd, 0x93, 0x35, 0x6a, 0x61, 0xe9, 0x79, 0x12, 0xc7, 0x3d, 0x19, 0x97,
+	0xab, 0xbe, 0x30, 0x68, 0x95, 0x5e, 0x18, 0xec, 0x02, 0x28, 0x2f, 0x85, 0xf8, 0x29, 0x29, 0x7f,
+	0x24, 0xf4, 0x2d, 0x58, 0x0b, 0x83, 0x2f, 0x27, 0x81, 0xcf, 0x1e, 0xc8, 0xab, 0xab, 0xe2, 0xaa,
+	0x52, 0xc0, 0x90, 0x6d, 0x58, 0x08, 0x09, 0x5b, 0x2a, 0xc5, 0xf1, 0x49, 0x7c, 0x53, 0x46, 0x46,
+	0x5e, 0x32, 0x0c, 0xa2, 0xde, 0x28, 0xf6, 0x09, 0x0f, 0x65, 0x07, 0x06, 0xfa, 0x38, 0x66, 0x9d,
+	0x65, 0x5f, 0x7c, 0x01, 0xe4, 0x5f, 0x74, 0x1a, 0x4d, 0xa2, 0x84, 0x78, 0x61, 0x90, 0x12, 0xbf,
+	0x37, 0x8e, 0x42, 0x11, 0xc1, 0x9e, 0x43, 0x0f, 0x22, 0x8c, 0x85, 0xd7, 0x90, 0xd8, 0x69, 0x69,
+	0x51, 0x45, 0xd1, 0x5d, 0x24, 0x9d, 0xe2, 0x43, 0xa4, 0xaf, 0x78, 0xa2, 0x25, 0x36, 0xf8, 0x2f,
+	0xf7, 0xfd, 0xfd, 0xc7, 0xb0, 0xa1, 0xd3, 0xe6, 0x33, 0xf0, 0x5d, 0x9a, 0xcd, 0x89, 0x03, 0xbb,
+	0x35, 0x7d, 0x1d, 0x2f, 0xa8, 0xa9, 0x9b, 0x63, 0x3a, 0xbf, 0x45, 0x5f, 0xd0, 0x93, 0xec, 0x11,
+	0xf9, 0xc5, 0xdc, 0x86, 0x48, 0x25, 0x68, 0xe8, 0x4a, 0x40, 0x83, 0x2f, 0x35, 0xb6, 0x78, 0xf0,
+	0xe5, 0x6f, 0xd3, 0x17, 0xea, 0x24, 0xfb, 0x58, 0x2a, 0xc3, 0x4b, 0x65, 0xb8, 0xa0, 0x99, 0x8d,
+	0xa2, 0x66, 0x3a, 0xdb, 0xb0, 0x59, 0xe0, 0x8e, 0xf3, 0xcd, 0xa2, 0x57, 0x3e, 0x9c, 0x44, 0xd4,
+	0x03, 0xa0, 0x66, 0x01, 0x79, 0x39, 0xd1, 0x2b, 0xef, 0xc2, 0xa2, 0x42, 0x5b, 0x66, 0x0f, 0xa9,
+	0x29, 0xd9, 0x43, 0xc4, 0xf5, 0x34, 0xcb, 0x36, 0x89, 0xbf, 0x9d, 0x7f, 0xc4, 0xf2, 0xda, 0xea,
+	0x6c, 0xbf, 0xcc, 0xdb, 0xa2, 0x5b, 0x30, 0xcf, 0x12, 0x92, 0xb0, 0x15, 0x5f, 0xe6, 0x07, 0x52,
+	0x38, 0x72, 0x19, 0x06, 0x7d, 0x3b, 0xbf, 0xb1, 0x4f, 0x2f, 0xc1, 0x85, 0xb2, 0xff, 0xa2, 0x5f,
+	0x74, 0x89, 0x88, 0x25, 0xe6, 0xf5, 0x71, 0x27, 0x2f, 0x39, 0x5c, 0xc3, 0xf9, 0x5b, 0x75, 0x58,
+	0x52, 0x89, 0xbf, 0x1c, 0x41, 0xec, 0x02, 0xf0, 0x3c, 0x03, 0x41, 0xff, 0x84, 0xcf, 0x6a, 0x96,
+	0x10, 0x94, 0xc6, 0x4c, 0x61, 0x84, 0x29, 0x6e, 0x37, 0xbd, 0x34, 0x23, 0x63, 0xbe, 0x59, 0x01,
+	0x03, 0x1d, 0x66, 0x64, 0x8c, 0x9b, 0x4c, 0x10, 0xe9, 0x5b, 0x55, 0x7b, 0x14, 0x44, 0xb9, 0xfd,
+	0x3b, 0xf2, 0x9e, 0xf5, 0x34, 0xf7, 0x47, 0x7b, 0xe4, 0x3d, 0xe3, 0xc5, 0xd7, 0x81, 0xe6, 0x6f,
+	0xe8, 0x45, 0x31, 0x7b, 0x58, 0xc6, 0x37, 0xa9, 0xc5, 0x51, 0x10, 0xfd, 0x98, 0x83, 0x1c, 0xa2,
+	0x25, 0xce, 0x98, 0x69, 0x48, 0xee, 0x16, 0x9e, 0x44, 0xcb, 0xb4, 0x6c, 0xe5, 0xd4, 0x23, 0xd2,
+	0x6d, 0xfb, 0x11, 0x6c, 0x28, 0xa5, 0xf9, 0xb4, 0x79, 0xbb, 0xf0, 0x4c, 0x75, 0xc7, 0xd8, 0x96,
+	0xb0, 0x57, 0x79, 0x63, 0x44, 0x4b, 0x4a, 0xf0, 0x7c, 0x3c, 0x97, 0xb3, 0x39, 0x48, 0x32, 0xbf,
+	0x43, 0xa7, 0x90, 0x46, 0x87, 0x33, 0x7d, 0x50, 0x78, 0x1a, 0x5f, 0x48, 0x63, 0x6d, 0xaa, 0xf3,
+	0xcd, 0x3e, 0x88, 0xbf, 0xfb, 0xef, 0x5d, 0x58, 0x79, 0x10, 0xb3, 0x10, 0x70, 0xaa, 0xe4, 0x24,
+	0xb1, 0x1e, 0x43, 0x8b, 0xff, 0xc1, 0x04, 0x6b, 0xab, 0xf4, 0x17, 0x14, 0xb0, 0xa3, 0xf6, 0x76,
+	0xc5, 0x5f, 0x56, 0x70, 0xd6, 0x7f, 0xf6, 0x1f, 0xff, 0xf3, 0x6f, 0xd6, 0x97, 0xad, 0xc5, 0x3b,
+	0xa7, 0x6f, 0xdd, 0x19, 0x92, 0x0c, 0x43, 0x6c, 0x87, 0xb0, 0xac, 0xe5, 0xb8, 0xb7, 0x2e, 0x6b,
+	0x79, 0xea, 0x0b, 0xa9, 0xef, 0xed, 0xdd, 0xa9, 0x59, 0xec, 0x9d, 0x4b, 0x48, 0x62, 0xdd, 0x5a,
+	0xe3, 0x24, 0xf2, 0xf4, 0xf5, 0xd6, 0x97, 0xd0, 0xf9, 0x00, 0x13, 0x67, 0xc9, 0x46, 0xad, 0xab,
+	0x79, 0x63, 0xc6, 0xd4, 0xfd, 0xf6, 0xb5, 0x6a, 0x04, 0x4e, 0x70, 0x07, 0x09, 0x6e, 0x5a, 0xeb,
+	0x94, 0x20, 0x4b, 0xcc, 0x25, 0x69, 0x5a, 0x29, 0xac, 0xf2, 0x64, 0xe0, 0x2f, 0x94, 0xe6, 0x65,
+	0xa4, 0xb9, 0x65, 0x6d, 0x50, 0x9a, 0x7e, 0x90, 0xea, 0x44, 0x63, 0xcc, 0xfb, 0xa3, 0x26, 0xaf,
+	0xb7, 0xae, 0x54, 0x66, 0xb5, 0x67, 0x24, 0xaf, 0x9e, 0x93, 0xf5, 0x5e, 0xef, 0xe5, 0x90, 0x50,
+	0x5c, 0x99, 0xf8, 0xde, 0xfa, 0x4d, 0x1e, 0xf0, 0x61, 0xfa, 0x33, 0x0b, 0xd6, 0xab, 0xe7, 0xff,
+	0x6d, 0x07, 0xc6, 0xc3, 0x6b, 0xb3, 0xfe, 0x11, 0x08, 0xe7, 0x97, 0x90, 0x99, 0x2b, 0xd6, 0x65,
+	0xce, 0x8c, 0xf6, 0x87, 0x1f, 0xc4, 0x9f, 0x96, 0xb0, 0xfa, 0xb0, 0xa4, 0x66, 0xac, 0xb7, 0x76,
+	0x0c, 0xd1, 0xcb, 0x92, 0xf8, 0x65, 0x73, 0x21, 0x27, 0xd8, 0x45, 0x82, 0x96, 0xb5, 0xca, 0x09,
+	0xe6, 0x47, 0xa5, 0xaf, 0xa0, 0x53, 0xc8, 0xf6, 0x6e, 0x39, 0x85, 0xe1, 0x33, 0x64, 0xee, 0xb7,
+	0x5f, 0x99, 0x8a, 0xc3, 0xa9, 0x5e, 0x41, 0xaa, 0x5d, 0x67, 0x5d, 0x19, 0x65, 0x41, 0xf9, 0xbb,
+	0xb5, 0xd7, 0xad, 0x14, 0xc7, 0x59, 0x4d, 0x4c, 0x3e, 0x13, 0xed, 0xab, 0xe7, 0x64, 0x35, 0x2f,
+	0x8d, 0xb5, 0xa0, 0x89, 0xb3, 0x35, 0x05, 0x4b, 0xa9, 0xf7, 0xf8, 0xc9, 0x01, 0x86, 0xf6, 0xcf,
+	0x42, 0x77, 0xd7, 0x9c, 0x8e, 0x9f, 0xff, 0x45, 0x00, 0xc7, 0x46, 0xaa, 0x1b, 0x96, 0x55, 0xa0,
+	0x1a, 0x67, 0x63, 0x2b, 0x85, 0xf5, 0x32, 0x51, 0x5d, 0xab, 0x0d, 0x7f, 0x2f, 0xc0, 0xbe, 0x5a,
+	0x59, 0x7e, 0x4e, 0x4f, 0xe3, 0x6c, 0x9c, 0x5a, 0xcf, 0xe8, 0x9f, 0x73, 0xf8, 0x66, 0x46, 0x76,
+	0x17, 0xe9, 0x6e, 0x3b, 0x56, 0xbe, 0x66, 0xa8, 0x03, 0xfb, 0x19, 0xb4, 0x65, 0x0c, 0xb6, 0xd5,
+	0x55, 0x3a, 0xa1, 0xa5, 0x6e, 0xb7, 0x2b, 0x12, 0x73, 0x0b, 0x6d, 0x75, 0x96, 0x79, 0xaf, 0x58,
+	0x9a, 0x6d, 0xda, 0xf0, 0xaf, 0x02, 0xc8, 0x56, 0x52, 0xeb, 0x52, 0xa9, 0x65, 0x29, 0x39, 0xdb,
+	0x54, 0xc4, 0x9b, 0xdf, 0xc2, 0xe6, 0x57, 0xad, 0x15, 0xad, 0x79, 0x31, 0xdf, 0xe4, 0x29, 0x5f,
+	0x9b, 0x6f, 0x45, 0x17, 0x8a, 0x5d, 0x9d, 0xd4, 0x59, 0x0c, 0x8a, 0x23, 0x26, 0x9b, 0x7c, 0x5a,
+	0x48, 0x7b, 0xc0, 0x36, 0x0b, 0x59, 0x49, 0xdf, 0x2c, 0x4a, 0x99, 0xa7, 0xed, 0xdd, 0x8a, 0xd2,
+	0x8a, 0xcd, 0x22, 0xce, 0xdb, 0x3d, 0xc1, 0xbf, 0xc9, 0xa4, 0x24, 0x43, 0xb6, 0xd4, 0xb6, 0xca,
+	0x99, 0xa1, 0xed, 0x2b, 0x55, 0xc5, 0xa9, 0x59, 0xbf, 0xf9, 0xeb, 0x23, 0x9c, 0x54, 0x67, 0x2c,
+	0xaa, 0x3a, 0xaf, 0xc5, 0x6e, 0xd7, 0x9e, 0x97, 0xe4, 0x35, 0x24, 0x69, 0x5b, 0xdd, 0x32, 0xc9,
+	0x14, 0x09, 0xbc, 0x59, 0xe3, 0xba, 0xc6, 0xb2, 0x2f, 0x6b, 0xba, 0xa6, 0x25, 0x69, 0xb6, 0x2f,
+	0x19, 0x4a, 0x38, 0x95, 0x4d, 0xa4, 0xd2, 0xb1, 0x96, 0xe5, 0x6a, 0x8c, 0x6d, 0x31, 0x75, 0x90,
+	0x69, 0x31, 0x35, 0x75, 0x28, 0xe6, 0x4e, 0xb6, 0x2f, 0x9b, 0x0b, 0x2b, 0x96, 0x5f, 0x99, 0x23,
+	0xd9, 0xfa, 0x73, 0x7a, 0x2a, 0x66, 0x91, 0x1a, 0xd6, 0x99, 0x9a, 0xcb, 0xb5, 0x34, 0x51, 0x2b,
+	0xf3, 0xbd, 0x3a, 0x57, 0x91, 0xf2, 0x25, 0x6b, 0xbb, 0x48, 0x99, 0xe7, 0x8e, 0xb5, 0x7e, 0x56,
+	0x83, 0x75, 0x43, 0x66, 0x52, 0x4b, 0x4d, 0x93, 0x51, 0x91, 0x94, 0xd4, 0x7e, 0x65, 0x2a, 0x0e,
+	0xe7, 0xc0, 0x41, 0x0e, 0x2e, 0x3b, 0xc8, 0x81, 0xe7, 0xfb, 0x92, 0x03, 0xfe, 0x8e, 0x8b, 0x4e,
+	0x8a, 0xbf, 0x56, 0x83, 0x2d, 0x73, 0x16, 0x52, 0xeb, 0x86, 0xa0, 0x31, 0x35, 0x3f, 0xaa, 0x7d,
+	0xf3, 0x3c, 0x34, 0xce, 0xcd, 0x0d, 0xe4, 0xe6, 0xaa, 0x63, 0x53, 0x6e, 0x12, 0xc4, 0x35, 0x31,
+	0xf4, 0x14, 0x9d, 0xc7, 0x7a, 0x9e, 0x4f, 0x4b, 0x31, 0x6b, 0xcc, 0xe9, 0x50, 0xed, 0xeb, 0x53,
+	0x30, 0xf4, 0x95, 0xd3, 0xda, 0xe4, 0x03, 0x82, 0xc9, 0x31, 0x65, 0xc2, 0x50, 0xbe, 0x3c, 0xe4,
+	0x79, 0x34, 0xb5, 0xe5, 0xa1, 0x94, 0x1a, 0xd4, 0xde, 0xad, 0x28, 0xad, 0x58, 0x1e, 0x90, 0x18,
+	0x1e, 0x83, 0xad, 0xcf, 0xa1, 0x2d, 0x96, 0x94, 0x54, 0x9b, 0x36, 0xda, 0xd1, 0xc1, 0xbe, 0x64,
+	0x28, 0xa9, 0x58, 0xa5, 0xd9, 0xe1, 0x80, 0x4a, 0xcf, 0x85, 0x05, 0x81, 0x6e, 0x6d, 0x17, 0x1b,
+	0x10, 0x2d, 0x1b, 0xa3, 0x59, 0x9c, 0x6d, 0x6c, 0x74, 0xcd, 0x59, 0x52, 0x1b, 0xa5, 0x6d, 0x1e,
+	0xc1, 0xa2, 0x72, 0xf2, 0xb1, 0xa6, 0x1c, 0xad, 0xec, 0x69, 0x47, 0x25, 0xb1, 0x8a, 0x39, 0x1d,
+	0x4a, 0x80, 0x65, 0x5c, 0x90, 0x34, 0x7e, 0x02, 0xcb, 0x5a, 0x2a, 0xc1, 0x5c, 0xf8, 0xa6, 0x64,
+	0x87, 0xf6, 0x6e, 0x45, 0xa9, 0x6e, 0xe3, 0x3a, 0x28, 0xfc, 0x94, 0xa3, 0x48, 0x5a, 0x5f, 0x40,
+	0x5b, 0x66, 0xf0, 0xcb, 0xe5, 0x5f, 0x4c, 0xea, 0x77, 0x1e, 0x0d, 0x6d, 0x0c, 0x9e, 0xd2, 0xca,
+	0x47, 0xf1, 0xe8, 0x88, 0xcb, 0x4b, 0x39, 0x6e, 0x59, 0x53, 0x8e, 0x75, 0xf6, 0x8e, 0xb1, 0xcc,
+	0x24, 0xaf, 0x3e, 0x22, 0xa8, 0x63, 0xa2, 0x24, 0x7d, 0xcb, 0x69, 0x94, 0x13, 0xe0, 0xd9, 0x3b,
+	0xc6, 0x32, 0x13, 0x8d, 0x11, 0x22, 0x48, 0x1a, 0x09, 0x74, 0x0a, 0x09, 0xc5, 0x72, 0xab, 0xc9,
+	0x9c, 0x3f, 0xce, 0xbe, 0x5a, 0x59, 0x6e, 0xb2, 0x4b, 0x59, 0x9f, 0xe8, 0x85, 0x95, 0xd4, 0xdf,
+	0xaf, 0x60, 0xad, 0x94, 0x11, 0x2d, 0x9f, 0xfd, 0x55, 0x09, 0xd6, 0xec, 0xeb, 0x53, 0x30, 0xf4,
+	0x0d, 0xcd, 0xc1, 0xd9, 0x9f, 0x92, 0x2c, 0x09, 0xd2, 0x93, 0x93, 0x20, 0x0c, 0x53, 0x44, 0xa3,
+	0xb4, 0x7f, 0xca, 0xd6, 0xe3, 0x52, 0x66, 0xb4, 0x19, 0xd2, 0x16, 0xe5, 0x0c, 0x54, 0x66, 0xbf,
+	0x2a, 0xad, 0xc6, 0xfd, 0x1c, 0x53, 0x8a, 0xfc, 0x18, 0x16, 0x95, 0x0c, 0x4c, 0xf9, 0xb0, 0x96,
+	0xd3, 0x32, 0xcd, 0x42, 0x51, 0x1b, 0x5c, 0xcf, 0xf7, 0xe3, 0x7e, 0x2c, 0x29, 0x65, 0xd0, 0x29,
+	0xa4, 0x57, 0xca, 0x07, 0xd7, 0x9c, 0x77, 0x69, 0x16, 0x8a, 0xda, 0xf0, 0x7a, 0xbe, 0x7f, 0xc4,
+	0x9a, 0x91, 0x54, 0x7f, 0xca, 0xde, 0x80, 0x95, 0x1a, 0xb0, 0x5e, 0xd1, 0x6d, 0x04, 0x63, 0xd2,
+	0xa2, 0x59, 0x18, 0x28, 0x9a, 0x2d, 0x45, 0x21, 0xa7, 0xd6, 0x5f, 0xac, 0x89, 0xec, 0x87, 0xa5,
+	0x81, 0xbe, 0xa1, 0x6b, 0xef, 0x73, 0x8c, 0xb5, 0xb6, 0xd7, 0x31, 0x35, 0x37, 0x0d, 0x77, 0x00,
+	0x2b, 0x7a, 0xde, 0xa4, 0xdc, 0x6a, 0x33, 0xe6, 0x53, 0xb2, 0xab, 0xd3, 0x82, 0xe8, 0xe7, 0x02,
+	0xf6, 0x77, 0xee, 0x04, 0x0e, 0x25, 0x35, 0xa0, 0x7f, 0x24, 0x6c, 0x92, 0x92, 0x9c, 0x94, 0x5d,
+	0x6a, 0xeb, 0xe1, 0xfd, 0x8b, 0xd2, 0x19, 0xd3, 0x26, 0x35, 0x3a, 0xc7, 0xd0, 0x71, 0x49, 0x3a,
+	0x19, 0x3d, 0x3f, 0x21, 0x4d, 0x97, 0x12, 0x6c, 0xb3, 0x48, 0x89, 0x8d, 0xd3, 0x8b, 0xa5, 0xc4,
+	0x46, 0x4b, 0xa3, 0xc4, 0x2c, 0x03, 0x59, 0x4f, 0xb7, 0x0c, 0x4a, 0x09, 0x94, 0xec, 0xdd, 0x8a,
+	0xd2, 0x0a, 0xcb, 0x80, 0xe4, 0xed, 0x32, 0x83, 0x9a, 0x25, 0x3e, 0xd1, 0x2c, 0x03, 0x2d, 0xc3,
+	0x8b, 0x7d, 0xc9, 0x50, 0x52, 0x61, 0x50, 0xb3, 0xa7, 0x89, 0xd6, 0xa7, 0xb0, 0x20, 0x32, 0x6e,
+	0xe4, 0x66, 0x41, 0x21, 0xd7, 0x88, 0xdd, 0x2d, 0x17, 0xf0, 0x56, 0x35, 0xd3, 0xc0, 0xf3, 0x7d,
+	0x6c, 0x95, 0x6f, 0x43, 0x4a, 0xfe, 0x8d, 0x5c, 0xfe, 0xe5, 0xd4, 0x1d, 0xf6, 0x8e, 0xb1, 0xcc,
+	0xb4, 0x52, 0x31, 0xdb, 0x50, 0xd2, 0xf8, 0x67, 0x35, 0x0c, 0x53, 0x9c, 0x9e, 0x3e, 0xc3, 0x7a,
+	0xf3, 0x02, 0x99, 0x36, 0x18, 0x43, 0x6f, 0x5d, 0x38, 0x37, 0x87, 0xf3, 0x1a, 0xb2, 0xe9, 0x38,
+	0xbb, 0x62, 0x75, 0xc1, 0x6a, 0x3e, 0x43, 0x97, 0x89, 0x3a, 0x28, 0xd3, 0xff, 0xb8, 0xc6, 0xfe,
+	0x9c, 0xea, 0x94, 0x76, 0xad, 0xdb, 0x33, 0x32, 0x20, 0x18, 0xbe, 0x33, 0x33, 0x3e, 0x67, 0xf7,
+	0x26, 0xb2, 0x7b, 0xcd, 0xd9, 0x99, 0xc2, 0x2e, 0x65, 0xf6, 0xd7, 0x60, 0x47, 0xa6, 0xd9, 0xd0,
+	0xda, 0xa5, 0x77, 0x38, 0x69, 0xee, 0x74, 0xac, 0xc8, 0xc5, 0x61, 0x77, 0x8b, 0x08, 0xe6, 0x3d,
+	0x4f, 0x84, 0xcd, 0x32, 0x36, 0x06, 0xb4, 0x6d, 0x4a, 0x7d, 0x0c, 0x6b, 0xa2, 0x1e, 0xfd, 0x9b,
+	0xbe, 0xcf, 0x4d, 0x53, 0xdb, 0xe8, 0x05, 0x4d, 0xfa, 0x97, 0x84, 0x25, 0xc5, 0x14, 0x63, 0x58,
+	0xb4, 0xc4, 0x0a, 0xaa, 0x67, 0xd5, 0x98, 0x72, 0xc1, 0xbe, 0x56, 0x8d, 0x60, 0xf2, 0xac, 0x0e,
+	0x49, 0xc6, 0x72, 0x32, 0xf8, 0x9c, 0xc0, 0x29, 0xac, 0x1e, 0x56, 0x12, 0x3d, 0xfc, 0xda, 0x44,
+	0xf9, 0x29, 0xd3, 0xd9, 0xe0, 0x66, 0x8d, 0x46, 0x94, 0x76, 0xf6, 0x94, 0xa5, 0x88, 0x52, 0x53,
+	0x2e, 0x58, 0x57, 0xab, 0x93, 0x31, 0x94, 0xe9, 0x1a, 0xb3, 0x35, 0xe8, 0x74, 0x15, 0xf7, 0x17,
+	0xfe, 0x19, 0x49, 0x4a, 0xf7, 0x0c, 0x2c, 0xdd, 0x05, 0x46, 0xeb, 0x5b, 0x4a, 0xae, 0xac, 0x52,
+	0xa2, 0x85, 0xd9, 0xfc, 0x5f, 0xd7, 0x91, 0xf0, 0x8e, 0xb3, 0x55, 0xf6, 0x7f, 0x51, 0xda, 0x94,
+	0xf4, 0x9f, 0x81, 0xf5, 0x82, 0x63, 0xf5, 0x05, 0xd1, 0xd6, 0xd4, 0xb9, 0xe0, 0x55, 0x15, 0xc4,
+	0x33, 0x74, 0x72, 0x16, 0xb2, 0x27, 0x58, 0xd7, 0x4d, 0xce, 0x24, 0x2d, 0x0e, 0x7a, 0x9a, 0x5b,
+	0x8b, 0x6f, 0x50, 0xd6, 0x56, 0xc9, 0xd7, 0x24, 0x5c, 0x31, 0x7f, 0x85, 0x3d, 0xda, 0xae, 0x48,
+	0xde, 0x60, 0xdd, 0x32, 0x79, 0x33, 0x2f, 0xcc, 0x06, 0x5f, 0x4f, 0xac, 0x2b, 0x45, 0x97, 0x67,
+	0x89, 0x9d, 0x63, 0xe8, 0x48, 0xef, 0x1f, 0x67, 0xe1, 0x4a, 0xc9, 0x2d, 0xa8, 0xd3, 0xad, 0xf2,
+	0x48, 0x16, 0xfd, 0xac, 0xdc, 0x65, 0x28, 0x28, 0xfd, 0x54, 0xff, 0xbb, 0xae, 0x1a, 0xc9, 0x9b,
+	0x86, 0x5e, 0x5f, 0x84, 0xf4, 0x2b, 0x48, 0x7a, 0xd7, 0xda, 0x29, 0xf4, 0xb7, 0xc0, 0xc2, 0xaf,
+	0xe5, 0x7f, 0xba, 0x4e, 0xcd, 0x1c, 0xa1, 0xd9, 0xb4, 0x55, 0x79, 0x25, 0xf2, 0x6d, 0xd1, 0x90,
+	0x40, 0xa2, 0x64, 0xcd, 0xa2, 0xa0, 0x59, 0x8c, 0x8b, 0xa4, 0xce, 0x8c, 0x13, 0xe5, 0x25, 0xa4,
+	0x6a, 0x9c, 0x94, 0x92, 0x2d, 0xd8, 0xbb, 0x15, 0xa5, 0x15, 0xc6, 0x89, 0x47, 0x51, 0x70, 0x2b,
+	0xb6, 0x32, 0x58, 0x2d, 0xbe, 0x48, 0x54, 0x16, 0x12, 0xf3, 0x5b, 0x45, 0xfb, 0x5a, 0x09, 0xa1,
+	0xf0, 0x3c, 0xab, 0xe0, 0x95, 0xe9, 0x67, 0xec, 0xad, 0xcf, 0x1d, 0x1e, 0x54, 0x49, 0xcf, 0x29,
+	0x85, 0xd7, 0x82, 0x8a, 0x26, 0x19, 0x9f, 0x11, 0xce, 0x40, 0x53, 0x5f, 0xbc, 0x24, 0xcd, 0x09,
+	0x36, 0x43, 0x27, 0xf1, 0x33, 0x58, 0x37, 0xbc, 0xfc, 0x53, 0x7c, 0x83, 0x95, 0xcf, 0x02, 0xed,
+	0x32, 0x77, 0xda, 0x0b, 0x38, 0xdd, 0x7e, 0xce, 0x69, 0x27, 0x84, 0x51, 0x1e, 0x2b, 0xfd, 0xe5,
+	0x59, 0x3c, 0xae, 0x18, 0xdf, 0x6a, 0x4d, 0x0c, 0x57, 0x15, 0xe6, 0x37, 0x7d, 0x85, 0x8d, 0x49,
+	0x92, 0xe4, 0x81, 0xa7, 0x21, 0xac, 0xe8, 0xac, 0x2a, 0xae, 0x63, 0xd3, 0xa3, 0xc5, 0x73, 0x7b,
+	0xa8, 0xcf, 0x58, 0x49, 0xee, 0x4b, 0x6c, 0x3b, 0x82, 0x65, 0xed, 0x39, 0xa9, 0xa2, 0xae, 0x86,
+	0x87, 0xaa, 0xb3, 0xeb, 0x4f, 0x51, 0x9e, 0x69, 0x16, 0x8f, 0xd9, 0x72, 0xbc, 0x5a, 0x7c, 0xbe,
+	0x6a, 0x5d, 0x35, 0x92, 0xcc, 0xdf, 0xa8, 0x3e, 0x3f, 0xd5, 0x14, 0x56, 0x8b, 0xef, 0x5f, 0x0d,
+	0x54, 0xf5, 0x97, 0xb1, 0xe7, 0x8f, 0xe3, 0x39, 0x44, 0x71, 0x29, 0x2c, 0x3e, 0x11, 0x7d, 0x12,
+	0x0f, 0x87, 0x21, 0xb1, 0xca, 0x3d, 0x2a, 0xbc, 0x21, 0x9d, 0xa1, 0xcf, 0xda, 0xce, 0x9b, 0x93,
+	0xf7, 0x26, 0x59, 0x2c, 0xe6, 0x8d, 0xaa, 0x4b, 0x94, 0x79, 0x62, 0xd0, 0x25, 0xf5, 0x5d, 0xa5,
+	0x7d, 0xa5, 0xaa, 0x78, 0xba, 0x2e, 0xa5, 0xd8, 0xf6, 0x09, 0x2c, 0x6b, 0xef, 0xe5, 0x0c, 0xba,
+	0xa4, 0x3c, 0x5b, 0xb4, 0x77, 0x2b, 0x4a, 0xa7, 0x4b, 0x37, 0x23, 0x69, 0xc6, 0x8c, 0x0a, 0xab,
+	0x9c, 0x15, 0x45, 0xdb, 0xd7, 0xcd, 0x89, 0x5f, 0x6c, 0x67, 0x1a, 0x4a, 0xc5, 0x06, 0x7f, 0xcc,
+	0xf1, 0xf8, 0x23, 0x7b, 0xcb, 0xe3, 0x8e, 0x82, 0x3c, 0x41, 0x88, 0xee, 0x28, 0x28, 0x26, 0x96,
+	0xb0, 0xcb, 0x09, 0x16, 0x0c, 0x0e, 0x02, 0xd6, 0xfa, 0x4f, 0xe2, 0xa3, 0xfc, 0x90, 0x2b, 0xd1,
+	0xf5, 0x43, 0x6e, 0x29, 0xf1, 0x83, 0xbd, 0x5b, 0x51, 0x5a, 0xb1, 0x8f, 0x48, 0x52, 0x29, 0x77,
+	0xf0, 0xeb, 0x09, 0x0e, 0x34, 0x07, 0xbf, 0x31, 0xf9, 0x83, 0x7d, 0x7d, 0x0a, 0x46, 0x85, 0x83,
+	0x9f, 0x11, 0xed, 0x0b, 0x1a, 0x7f, 0xa7, 0xa6, 0x3f, 0x43, 0xd3, 0xde, 0xbb, 0x59, 0x6a, 0x08,
+	0xc1, 0xd4, 0x07, 0x76, 0xf6, 0xad, 0x19, 0x30, 0x75, 0x3f, 0x90, 0x25, 0x0e, 0x8c, 0x9e, 0x40,
+	0xd7, 0xde, 0xc7, 0x59, 0x4f, 0xc1, 0x52, 0xdb, 0x32, 0xd8, 0x8c, 0xe6, 0xb7, 0x73, 0xf6, 0xd4,
+	0x57, 0x78, 0x25, 0xad, 0x92, 0xd4, 0xa5, 0xf1, 0xf0, 0x97, 0x6b, 0x3c, 0x00, 0xae, 0xf4, 0x2c,
+	0x29, 0x77, 0x86, 0x4d, 0x7d, 0x56, 0x65, 0xdf, 0x3c, 0x0f, 0x4d, 0x37, 0x9d, 0x2d, 0x9b, 0xf3,
+	0x92, 0x49, 0x5c, 0xc9, 0x95, 0xf5, 0x27, 0x01, 0xf2, 0xb7, 0x4f, 0xf9, 0x15, 0x73, 0xe9, 0x3d,
+	0x94, 0x6d, 0x7e, 0x3b, 0x20, 0x94, 0xce, 0xc1, 0xdb, 0x65, 0x7c, 0x47, 0x20, 0x3d, 0x6d, 0xcc,
+	0xb3, 0x82, 0xe8, 0xba, 0x67, 0x45, 0x7b, 0xc9, 0x60, 0x5f, 0x32, 0x94, 0x54, 0x78, 0x56, 0x12,
+	0xd6, 0xd6, 0x6f, 0x30, 0x09, 0x1a, 0x42, 0xd5, 0x35, 0x09, 0x56, 0xbf, 0x08, 0x50, 0xae, 0xf2,
+	0xaa, 0x83, 0xf7, 0x4b, 0xe2, 0xf3, 0x24, 0xae, 0x34, 0xbe, 0xad, 0xbf, 0x5d, 0x83, 0xcb, 0x66,
+	0x52, 0x5c, 0xa1, 0x5e, 0x24, 0x43, 0xdc, 0x15, 0x62, 0x5d, 0xab, 0x66, 0x48, 0x6a, 0x99, 0xb8,
+	0xce, 0xe5, 0x01, 0xcd, 0x85, 0xeb, 0x5c, 0x3d, 0x42, 0xdb, 0xbe, 0x6c, 0x2e, 0xac, 0xbc, 0xce,
+	0x15, 0x8d, 0xd2, 0x5b, 0xaa, 0x3c, 0x1a, 0x59, 0xb9, 0xa5, 0x2a, 0x45, 0x4e, 0xdb, 0x3b, 0xc6,
+	0x32, 0xe3, 0x2d, 0x15, 0xc9, 0x44, 0xb8, 0xb3, 0xb8, 0xa5, 0x52, 0x63, 0x87, 0x95, 0x5b, 0x2a,
+	0x43, 0xc0, 0xb3, 0xbd, 0x5b, 0x51, 0x6a, 0xbc, 0xa5, 0x22, 0x19, 0x0b, 0x52, 0xa6, 0x61, 0xcb,
+	0x94, 0x16, 0x8b, 0xc4, 0x52, 0xe3, 0x7a, 0xb5, 0x23, 0x94, 0x21, 0x4e, 0xd9, 0xbe, 0x5a, 0x59,
+	0x5e, 0x71, 0x96, 0x1a, 0x30, 0x24, 0x76, 0x2d, 0x79, 0x0c, 0xcb, 0x5a, 0x70, 0x6e, 0xde, 0x39,
+	0x53, 0xcc, 0xee, 0xf4, 0xab, 0x3e, 0xad, 0x6b, 0x98, 0xf7, 0x4a, 0x0c, 0x14, 0xed, 0x9a, 0xcf,
+	0x4e, 0x87, 0x6a, 0xe0, 0xab, 0x76, 0x3a, 0x2c, 0x87, 0xe3, 0xe6, 0x57, 0x96, 0x6a, 0x61, 0xa9,
+	0x3f, 0xfc, 0xf9, 0x76, 0x82, 0x4d, 0x0e, 0x60, 0x49, 0x61, 0x4d, 0xd1, 0x3a, 0x43, 0x6c, 0xa9,
+	0x7d, 0xd9, 0x5c, 0x68, 0x0a, 0x2b, 0x51, 0x6e, 0x2e, 0x53, 0xe6, 0x59, 0x5f, 0x52, 0xa3, 0x2b,
+	0xad, 0x1d, 0x73, 0xcc, 0x65, 0x81, 0x8e, 0x29, 0x20, 0x53, 0xa7, 0xa3, 0xdc, 0xf8, 0x51, 0x3a,
+	0x47, 0xcd, 0x71, 0x12, 0x67, 0xf1, 0xdb, 0xff, 0x7f, 0x00, 0x86, 0x80, 0x13, 0xcf, 0x23, 0x8c,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GoCryptoTraderClient is the client API for GoCryptoTrader service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GoCryptoTraderClient interface {
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error)
+	EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
+	DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
+	GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error)
+	GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error)
+	GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error)
+	DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error)
+	GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error)
+	GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error)
+	EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error)
+	GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error)
+	GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error)
+	GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error)
+	GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error)
+	GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error)
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error)
+	RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error)
+	GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error)
+	GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error)
+	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error)
+	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
+	SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
+	WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	ModifyOrder(ctx context.Context, in *ModifyOrderRequest, opts ...grpc.CallOption) (*ModifyOrderResponse, error)
+	CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error)
+	SetRiskKillSwitch(ctx context.Context, in *SetRiskKillSwitchRequest, opts ...grpc.CallOption) (*SetRiskKillSwitchResponse, error)
+	AddConditionalOrder(ctx context.Context, in *AddConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error)
+	AddOCOOrder(ctx context.Context, in *AddOCOOrderRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error)
+	AddBracketOrder(ctx context.Context, in *AddBracketOrderRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error)
+	GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error)
+	CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error)
+	StartExecution(ctx context.Context, in *StartExecutionRequest, opts ...grpc.CallOption) (*ExecutionResponse, error)
+	PauseExecution(ctx context.Context, in *ExecutionIDRequest, opts ...grpc.CallOption) (*ExecutionResponse, error)
+	ResumeExecution(ctx context.Context, in *ExecutionIDRequest, opts ...grpc.CallOption) (*ExecutionResponse, error)
+	CancelExecution(ctx context.Context, in *ExecutionIDRequest, opts ...grpc.CallOption) (*ExecutionResponse, error)
+	GetExecutions(ctx context.Context, in *GetExecutionsRequest, opts ...grpc.CallOption) (*GetExecutionsResponse, error)
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error)
+	RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error)
+	GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error)
+	GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error)
+	WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
+	SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
+	GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error)
+	EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error)
+	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error)
+	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error)
+	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error)
+	GetOrderUpdateStream(ctx context.Context, in *GetOrderUpdateStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderUpdateStreamClient, error)
+	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
+	GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
+	GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
+	GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
+	GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
+	GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptState(ctx context.Context, in *GCTScriptStateRequest, opts ...grpc.CallOption) (*GCTScriptStateResponse, error)
+	GCTScriptTest(ctx context.Context, in *GCTScriptTestRequest, opts ...grpc.CallOption) (*GCTScriptTestResponse, error)
+	GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error)
+	StartCandleJob(ctx context.Context, in *StartCandleJobRequest, opts ...grpc.CallOption) (*CandleJob, error)
+	GetCandleJobs(ctx context.Context, in *GetCandleJobsRequest, opts ...grpc.CallOption) (*GetCandleJobsResponse, error)
+	GetCandleCoverage(ctx context.Context, in *GetCandleCoverageRequest, opts ...grpc.CallOption) (*GetCandleCoverageResponse, error)
+	GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error)
+	GetArbitrageStream(ctx context.Context, in *GetArbitrageStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetArbitrageStreamClient, error)
+	GetTriangularArbitrage(ctx context.Context, in *GetTriangularArbitrageRequest, opts ...grpc.CallOption) (*GetTriangularArbitrageResponse, error)
+	RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteResponse, error)
+	GetRoutes(ctx context.Context, in *GetRoutesRequest, opts ...grpc.CallOption) (*GetRoutesResponse, error)
+	GetAggregatedOrderbook(ctx context.Context, in *GetAggregatedOrderbookRequest, opts ...grpc.CallOption) (*AggregatedOrderbookResponse, error)
+	GetAggregatedOrderbookStream(ctx context.Context, in *GetAggregatedOrderbookRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAggregatedOrderbookStreamClient, error)
+	GetPositions(ctx context.Context, in *GetPositionsRequest, opts ...grpc.CallOption) (*GetPositionsResponse, error)
+	SetLeverage(ctx context.Context, in *SetLeverageRequest, opts ...grpc.CallOption) (*SetLeverageResponse, error)
+	SetMarginMode(ctx context.Context, in *SetMarginModeRequest, opts ...grpc.CallOption) (*SetMarginModeResponse, error)
+	GetFundingRates(ctx context.Context, in *GetFundingRatesRequest, opts ...grpc.CallOption) (*GetFundingRatesResponse, error)
+	ClosePosition(ctx context.Context, in *ClosePositionRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
+	GetTradingRules(ctx context.Context, in *GetTradingRulesRequest, opts ...grpc.CallOption) (*TradingRules, error)
+	SubmitOrders(ctx context.Context, in *SubmitOrdersRequest, opts ...grpc.CallOption) (*SubmitOrdersResponse, error)
+	CancelOrders(ctx context.Context, in *CancelOrdersRequest, opts ...grpc.CallOption) (*CancelOrdersResponse, error)
+}
+
+type goCryptoTraderClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGoCryptoTraderClient(cc *grpc.ClientConn) GoCryptoTraderClient {
+	return &goCryptoTraderClient{cc}
+}
+
+func (c *goCryptoTraderClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error) {
+	out := new(GetSusbsytemsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSubsystems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
+	out := new(GenericSubsystemResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableSubsystem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
+	out := new(GenericSubsystemResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableSubsystem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error) {
+	out := new(GetRPCEndpointsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRPCEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error) {
+	out := new(GetCommunicationRelayersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCommunicationRelayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error) {
+	out := new(GetExchangesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error) {
+	out := new(GetExchangeInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error) {
+	out := new(GetExchangeOTPReponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error) {
+	out := new(GetExchangeOTPsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error) {
+	out := new(TickerResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTicker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error) {
+	out := new(GetTickersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error) {
+	out := new(OrderbookResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error) {
+	out := new(GetOrderbooksResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error) {
+	out := new(GetAccountInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAccountInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[0], "/gctrpc.GoCryptoTrader/GetAccountInfoStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetAccountInfoStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetAccountInfoStreamClient interface {
+	Recv() (*GetAccountInfoResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetAccountInfoStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetAccountInfoStreamClient) Recv() (*GetAccountInfoResponse, error) {
+	m := new(GetAccountInfoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
+	out := new(GetPortfolioResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error) {
+	out := new(GetPortfolioSummaryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error) {
+	out := new(AddPortfolioAddressResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddPortfolioAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error) {
+	out := new(RemovePortfolioAddressResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemovePortfolioAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error) {
+	out := new(GetForexProvidersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error) {
+	out := new(GetForexRatesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error) {
+	out := new(GetOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error) {
+	out := new(OrderDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
+	out := new(SubmitOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
+	out := new(SimulateOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SimulateOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
+	out := new(SimulateOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WhaleBomb", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ModifyOrder(ctx context.Context, in *ModifyOrderRequest, opts ...grpc.CallOption) (*ModifyOrderResponse, error) {
+	out := new(ModifyOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ModifyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error) {
+	out := new(CancelAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetRiskKillSwitch(ctx context.Context, in *SetRiskKillSwitchRequest, opts ...grpc.CallOption) (*SetRiskKillSwitchResponse, error) {
+	out := new(SetRiskKillSwitchResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetRiskKillSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddConditionalOrder(ctx context.Context, in *AddConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error) {
+	out := new(ConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddConditionalOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddOCOOrder(ctx context.Context, in *AddOCOOrderRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error) {
+	out := new(ConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddOCOOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddBracketOrder(ctx context.Context, in *AddBracketOrderRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error) {
+	out := new(ConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddBracketOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error) {
+	out := new(ConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConditionalOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error) {
+	out := new(ConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelConditionalOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) StartExecution(ctx context.Context, in *StartExecutionRequest, opts ...grpc.CallOption) (*ExecutionResponse, error) {
+	out := new(ExecutionResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/StartExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) PauseExecution(ctx context.Context, in *ExecutionIDRequest, opts ...grpc.CallOption) (*ExecutionResponse, error) {
+	out := new(ExecutionResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/PauseExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ResumeExecution(ctx context.Context, in *ExecutionIDRequest, opts ...grpc.CallOption) (*ExecutionResponse, error) {
+	out := new(ExecutionResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ResumeExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelExecution(ctx context.Context, in *ExecutionIDRequest, opts ...grpc.CallOption) (*ExecutionResponse, error) {
+	out := new(ExecutionResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExecutions(ctx context.Context, in *GetExecutionsRequest, opts ...grpc.CallOption) (*GetExecutionsResponse, error) {
+	out := new(GetExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error) {
+	out := new(AddEventResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error) {
+	out := new(RemoveEventResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemoveEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error) {
+	out := new(GetCryptocurrencyDepositAddressesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error) {
+	out := new(GetCryptocurrencyDepositAddressResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawFiatFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
+	out := new(GetLoggerDetailsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLoggerDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
+	out := new(GetLoggerDetailsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLoggerDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error) {
+	out := new(GetExchangePairsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangePairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchangePair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchangePair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[1], "/gctrpc.GoCryptoTrader/GetOrderbookStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetOrderbookStreamClient interface {
+	Recv() (*OrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
+	m := new(OrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[2], "/gctrpc.GoCryptoTrader/GetExchangeOrderbookStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetExchangeOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetExchangeOrderbookStreamClient interface {
+	Recv() (*OrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetExchangeOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetExchangeOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
+	m := new(OrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[3], "/gctrpc.GoCryptoTrader/GetTickerStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetTickerStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetTickerStreamClient interface {
+	Recv() (*TickerResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetTickerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetTickerStreamClient) Recv() (*TickerResponse, error) {
+	m := new(TickerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[4], "/gctrpc.GoCryptoTrader/GetExchangeTickerStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetExchangeTickerStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetExchangeTickerStreamClient interface {
+	Recv() (*TickerResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetExchangeTickerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetExchangeTickerStreamClient) Recv() (*TickerResponse, error) {
+	m := new(TickerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderUpdateStream(ctx context.Context, in *GetOrderUpdateStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderUpdateStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[5], "/gctrpc.GoCryptoTrader/GetOrderUpdateStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetOrderUpdateStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetOrderUpdateStreamClient interface {
+	Recv() (*OrderUpdateResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetOrderUpdateStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetOrderUpdateStreamClient) Recv() (*OrderUpdateResponse, error) {
+	m := new(OrderUpdateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error) {
+	out := new(GetAuditEventResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAuditEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptExecute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
+	out := new(GCTScriptQueryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptReadScript", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
+	out := new(GCTScriptStatusResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
+	out := new(GCTScriptQueryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStopAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
+	out := new(GCTScriptStatusResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptListAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptState(ctx context.Context, in *GCTScriptStateRequest, opts ...grpc.CallOption) (*GCTScriptStateResponse, error) {
+	out := new(GCTScriptStateResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptTest(ctx context.Context, in *GCTScriptTestRequest, opts ...grpc.CallOption) (*GCTScriptTestResponse, error) {
+	out := new(GCTScriptTestResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptTest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error) {
+	out := new(GetHistoricCandlesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetHistoricCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) StartCandleJob(ctx context.Context, in *StartCandleJobRequest, opts ...grpc.CallOption) (*CandleJob, error) {
+	out := new(CandleJob)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/StartCandleJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCandleJobs(ctx context.Context, in *GetCandleJobsRequest, opts ...grpc.CallOption) (*GetCandleJobsResponse, error) {
+	out := new(GetCandleJobsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCandleJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCandleCoverage(ctx context.Context, in *GetCandleCoverageRequest, opts ...grpc.CallOption) (*GetCandleCoverageResponse, error) {
+	out := new(GetCandleCoverageResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCandleCoverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error) {
+	out := new(GetArbitrageOpportunitiesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetArbitrageOpportunities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetArbitrageStream(ctx context.Context, in *GetArbitrageStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetArbitrageStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[6], "/gctrpc.GoCryptoTrader/GetArbitrageStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetArbitrageStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetArbitrageStreamClient interface {
+	Recv() (*ArbitrageOpportunity, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetArbitrageStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetArbitrageStreamClient) Recv() (*ArbitrageOpportunity, error) {
+	m := new(ArbitrageOpportunity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetTriangularArbitrage(ctx context.Context, in *GetTriangularArbitrageRequest, opts ...grpc.CallOption) (*GetTriangularArbitrageResponse, error) {
+	out := new(GetTriangularArbitrageResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTriangularArbitrage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteResponse, error) {
+	out := new(RouteResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RouteOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetRoutes(ctx context.Context, in *GetRoutesRequest, opts ...grpc.CallOption) (*GetRoutesResponse, error) {
+	out := new(GetRoutesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAggregatedOrderbook(ctx context.Context, in *GetAggregatedOrderbookRequest, opts ...grpc.CallOption) (*AggregatedOrderbookResponse, error) {
+	out := new(AggregatedOrderbookResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAggregatedOrderbook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAggregatedOrderbookStream(ctx context.Context, in *GetAggregatedOrderbookRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAggregatedOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[7], "/gctrpc.GoCryptoTrader/GetAggregatedOrderbookStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetAggregatedOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetAggregatedOrderbookStreamClient interface {
+	Recv() (*AggregatedOrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetAggregatedOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetAggregatedOrderbookStreamClient) Recv() (*AggregatedOrderbookResponse, error) {
+	m := new(AggregatedOrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetPositions(ctx context.Context, in *GetPositionsRequest, opts ...grpc.CallOption) (*GetPositionsResponse, error) {
+	out := new(GetPositionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetLeverage(ctx context.Context, in *SetLeverageRequest, opts ...grpc.CallOption) (*SetLeverageResponse, error) {
+	out := new(SetLeverageResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLeverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetMarginMode(ctx context.Context, in *SetMarginModeRequest, opts ...grpc.CallOption) (*SetMarginModeResponse, error) {
+	out := new(SetMarginModeResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetMarginMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetFundingRates(ctx context.Context, in *GetFundingRatesRequest, opts ...grpc.CallOption) (*GetFundingRatesResponse, error) {
+	out := new(GetFundingRatesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFundingRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ClosePosition(ctx context.Context, in *ClosePositionRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
+	out := new(SubmitOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ClosePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTradingRules(ctx context.Context, in *GetTradingRulesRequest, opts ...grpc.CallOption) (*TradingRules, error) {
+	out := new(TradingRules)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTradingRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitOrders(ctx context.Context, in *SubmitOrdersRequest, opts ...grpc.CallOption) (*SubmitOrdersResponse, error) {
+	out := new(SubmitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelOrders(ctx context.Context, in *CancelOrdersRequest, opts ...grpc.CallOption) (*CancelOrdersResponse, error) {
+	out := new(CancelOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoCryptoTraderServer is the server API for GoCryptoTrader service.
+type GoCryptoTraderServer interface {
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
+	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
+	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
+	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
+	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
+	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
+	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
+	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
+	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error)
+	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
+	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
+	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
+	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
+	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
+	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
+	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error)
+	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error)
+	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
+	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
+	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
+	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
+	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error)
+	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
+	SetRiskKillSwitch(context.Context, *SetRiskKillSwitchRequest) (*SetRiskKillSwitchResponse, error)
+	AddConditionalOrder(context.Context, *AddConditionalOrderRequest) (*ConditionalOrdersResponse, error)
+	AddOCOOrder(context.Context, *AddOCOOrderRequest) (*ConditionalOrdersResponse, error)
+	AddBracketOrder(context.Context, *AddBracketOrderRequest) (*ConditionalOrdersResponse, error)
+	GetConditionalOrders(context.Context, *GetConditionalOrdersRequest) (*ConditionalOrdersResponse, error)
+	CancelConditionalOrder(context.Context, *CancelConditionalOrderRequest) (*ConditionalOrdersResponse, error)
+	StartExecution(context.Context, *StartExecutionRequest) (*ExecutionResponse, error)
+	PauseExecution(context.Context, *ExecutionIDRequest) (*ExecutionResponse, error)
+	ResumeExecution(context.Context, *ExecutionIDRequest) (*ExecutionResponse, error)
+	CancelExecution(context.Context, *ExecutionIDRequest) (*ExecutionResponse, error)
+	GetExecutions(context.Context, *GetExecutionsRequest) (*GetExecutionsResponse, error)
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
+	RemoveEvent(context.Context, *RemoveEventRequest) (*RemoveEventResponse, error)
+	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
+	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
+	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
+	WithdrawFiatFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
+	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
+	EnableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
+	DisableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
+	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
+	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
+	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
+	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
+	GetOrderUpdateStream(*GetOrderUpdateStreamRequest, GoCryptoTrader_GetOrderUpdateStreamServer) error
+	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
+	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptState(context.Context, *GCTScriptStateRequest) (*GCTScriptStateResponse, error)
+	GCTScriptTest(context.Context, *GCTScriptTestRequest) (*GCTScriptTestResponse, error)
+	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
+	StartCandleJob(context.Context, *StartCandleJobRequest) (*CandleJob, error)
+	GetCandleJobs(context.Context, *GetCandleJobsRequest) (*GetCandleJobsResponse, error)
+	GetCandleCoverage(context.Context, *GetCandleCoverageRequest) (*GetCandleCoverageResponse, error)
+	GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error)
+	GetArbitrageStream(*GetArbitrageStreamRequest, GoCryptoTrader_GetArbitrageStreamServer) error
+	GetTriangularArbitrage(context.Context, *GetTriangularArbitrageRequest) (*GetTriangularArbitrageResponse, error)
+	RouteOrder(context.Context, *RouteOrderRequest) (*RouteResponse, error)
+	GetRoutes(context.Context, *GetRoutesRequest) (*GetRoutesResponse, error)
+	GetAggregatedOrderbook(context.Context, *GetAggregatedOrderbookRequest) (*AggregatedOrderbookResponse, error)
+	GetAggregatedOrderbookStream(*GetAggregatedOrderbookRequest, GoCryptoTrader_GetAggregatedOrderbookStreamServer) error
+	GetPositions(context.Context, *GetPositionsRequest) (*GetPositionsResponse, error)
+	SetLeverage(context.Context, *SetLeverageRequest) (*SetLeverageResponse, error)
+	SetMarginMode(context.Context, *SetMarginModeRequest) (*SetMarginModeResponse, error)
+	GetFundingRates(context.Context, *GetFundingRatesRequest) (*GetFundingRatesResponse, error)
+	ClosePosition(context.Context, *ClosePositionRequest) (*SubmitOrderResponse, error)
+	GetTradingRules(context.Context, *GetTradingRulesRequest) (*TradingRules, error)
+	SubmitOrders(context.Context, *SubmitOrdersRequest) (*SubmitOrdersResponse, error)
+	CancelOrders(context.Context, *CancelOrdersRequest) (*CancelOrdersResponse, error)
+}
+
+// UnimplementedGoCryptoTraderServer can be embedded to have forward compatible implementations.
+type UnimplementedGoCryptoTraderServer struct {
+}
+
+func (*UnimplementedGoCryptoTraderServer) GetInfo(ctx context.Context, req *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetSubsystems(ctx context.Context, req *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) EnableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) DisableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetRPCEndpoints(ctx context.Context, req *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCommunicationRelayers(ctx context.Context, req *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchanges(ctx context.Context, req *GetExchangesRequest) (*GetExchangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) DisableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeInfo(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCodes(ctx context.Context, req *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) EnableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTicker(ctx context.Context, req *GetTickerRequest) (*TickerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTickers(ctx context.Context, req *GetTickersRequest) (*GetTickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbook(ctx context.Context, req *GetOrderbookRequest) (*OrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbooks(ctx context.Context, req *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAccountInfo(ctx context.Context, req *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAccountInfoStream(req *GetAccountInfoRequest, srv GoCryptoTrader_GetAccountInfoStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetConfig(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetPortfolio(ctx context.Context, req *GetPortfolioRequest) (*GetPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetPortfolioSummary(ctx context.Context, req *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddPortfolioAddress(ctx context.Context, req *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(ctx context.Context, req *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetForexProviders(ctx context.Context, req *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetForexRates(ctx context.Context, req *GetForexRatesRequest) (*GetForexRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrders(ctx context.Context, req *GetOrdersRequest) (*GetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrder(ctx context.Context, req *GetOrderRequest) (*OrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SubmitOrder(ctx context.Context, req *SubmitOrderRequest) (*SubmitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SimulateOrder(ctx context.Context, req *SimulateOrderRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) WhaleBomb(ctx context.Context, req *WhaleBombRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelOrder(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ModifyOrder(ctx context.Context, req *ModifyOrderRequest) (*ModifyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelAllOrders(ctx context.Context, req *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SetRiskKillSwitch(ctx context.Context, req *SetRiskKillSwitchRequest) (*SetRiskKillSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRiskKillSwitch not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddConditionalOrder(ctx context.Context, req *AddConditionalOrderRequest) (*ConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConditionalOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddOCOOrder(ctx context.Context, req *AddOCOOrderRequest) (*ConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOCOOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddBracketOrder(ctx context.Context, req *AddBracketOrderRequest) (*ConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBracketOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetConditionalOrders(ctx context.Context, req *GetConditionalOrdersRequest) (*ConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConditionalOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelConditionalOrder(ctx context.Context, req *CancelConditionalOrderRequest) (*ConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConditionalOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) StartExecution(ctx context.Context, req *StartExecutionRequest) (*ExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartExecution not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) PauseExecution(ctx context.Context, req *ExecutionIDRequest) (*ExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseExecution not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ResumeExecution(ctx context.Context, req *ExecutionIDRequest) (*ExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeExecution not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelExecution(ctx context.Context, req *ExecutionIDRequest) (*ExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExecution not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExecutions(ctx context.Context, req *GetExecutionsRequest) (*GetExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutions not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetEvents(ctx context.Context, req *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddEvent(ctx context.Context, req *AddEventRequest) (*AddEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RemoveEvent(ctx context.Context, req *RemoveEventRequest) (*RemoveEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddresses(ctx context.Context, req *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddress(ctx context.Context, req *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetLoggerDetails(ctx context.Context, req *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SetLoggerDetails(ctx context.Context, req *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangePairs(ctx context.Context, req *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) EnableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableExchangePair not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) DisableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExchangePair not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbookStream(req *GetOrderbookStreamRequest, srv GoCryptoTrader_GetOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(req *GetExchangeOrderbookStreamRequest, srv GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTickerStream(req *GetTickerStreamRequest, srv GoCryptoTrader_GetTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(req *GetExchangeTickerStreamRequest, srv GoCryptoTrader_GetExchangeTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderUpdateStream(req *GetOrderUpdateStreamRequest, srv GoCryptoTrader_GetOrderUpdateStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderUpdateStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAuditEvent(ctx context.Context, req *GetAuditEventRequest) (*GetAuditEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptExecute(ctx context.Context, req *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptUpload(ctx context.Context, req *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptUpload not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptReadScript(ctx context.Context, req *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptReadScript not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptStatus(ctx context.Context, req *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStatus not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptQuery(ctx context.Context, req *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptQuery not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptStop(ctx context.Context, req *GCTScriptStopRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStop not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptStopAll(ctx context.Context, req *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStopAll not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptListAll(ctx context.Context, req *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListAll not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptAutoLoadToggle(ctx context.Context, req *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptState(ctx context.Context, req *GCTScriptStateRequest) (*GCTScriptStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptState not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptTest(ctx context.Context, req *GCTScriptTestRequest) (*GCTScriptTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptTest not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetHistoricCandles(ctx context.Context, req *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) StartCandleJob(ctx context.Context, req *StartCandleJobRequest) (*CandleJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCandleJob not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCandleJobs(ctx context.Context, req *GetCandleJobsRequest) (*GetCandleJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandleJobs not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCandleCoverage(ctx context.Context, req *GetCandleCoverageRequest) (*GetCandleCoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandleCoverage not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetArbitrageOpportunities(ctx context.Context, req *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunities not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetArbitrageStream(req *GetArbitrageStreamRequest, srv GoCryptoTrader_GetArbitrageStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetArbitrageStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTriangularArbitrage(ctx context.Context, req *GetTriangularArbitrageRequest) (*GetTriangularArbitrageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTriangularArbitrage not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RouteOrder(ctx context.Context, req *RouteOrderRequest) (*RouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetRoutes(ctx context.Context, req *GetRoutesRequest) (*GetRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoutes not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAggregatedOrderbook(ctx context.Context, req *GetAggregatedOrderbookRequest) (*AggregatedOrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAggregatedOrderbook not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAggregatedOrderbookStream(req *GetAggregatedOrderbookRequest, srv GoCryptoTrader_GetAggregatedOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAggregatedOrderbookStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetPositions(ctx context.Context, req *GetPositionsRequest) (*GetPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositions not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SetLeverage(ctx context.Context, req *SetLeverageRequest) (*SetLeverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLeverage not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SetMarginMode(ctx context.Context, req *SetMarginModeRequest) (*SetMarginModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarginMode not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetFundingRates(ctx context.Context, req *GetFundingRatesRequest) (*GetFundingRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingRates not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ClosePosition(ctx context.Context, req *ClosePositionRequest) (*SubmitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePosition not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTradingRules(ctx context.Context, req *GetTradingRulesRequest) (*TradingRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradingRules not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SubmitOrders(ctx context.Context, req *SubmitOrdersRequest) (*SubmitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelOrders(ctx context.Context, req *CancelOrdersRequest) (*CancelOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrders not implemented")
+}
+
+func RegisterGoCryptoTraderServer(s *grpc.Server, srv GoCryptoTraderServer) {
+	s.RegisterService(&_GoCryptoTrader_serviceDesc, srv)
+}
+
+func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubsystemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRPCEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunicationRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCommunicationRelayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeOTPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAccountInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetAccountInfoStream(m, &goCryptoTraderGetAccountInfoStreamServer{stream})
+}
+
+type GoCryptoTrader_GetAccountInfoStreamServer interface {
+	Send(*GetAccountInfoResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetAccountInfoStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGoCryptoTraderServer) GetHistoricCandles(ctx context.Context, req *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
+
+func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGoCryptoTraderServer(s *grpc.Server, srv GoCryptoTraderServer) {
-	s.RegisterService(&_GoCryptoTrader_serviceDesc, srv)
+func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubsystemsRequest)
+func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableSubsystem",
+		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhaleBombRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableSubsystem",
+		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRPCEndpointsRequest)
+func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCEndpoints",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommunicationRelayersRequest)
+func _GoCryptoTrader_ModifyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, in)
+		return srv.(GoCryptoTraderServer).ModifyOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCommunicationRelayers",
+		FullMethod: "/gctrpc.GoCryptoTrader/ModifyOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+		return srv.(GoCryptoTraderServer).ModifyOrder(ctx, req.(*ModifyOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangesRequest)
+func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_SetRiskKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRiskKillSwitchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).SetRiskKillSwitch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetRiskKillSwitch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).SetRiskKillSwitch(ctx, req.(*SetRiskKillSwitchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_AddConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConditionalOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).AddConditionalOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddConditionalOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).AddConditionalOrder(ctx, req.(*AddConditionalOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_AddOCOOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOCOOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, in)
+		return srv.(GoCryptoTraderServer).AddOCOOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCode",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddOCOOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).AddOCOOrder(ctx, req.(*AddOCOOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangeOTPsRequest)
+func _GoCryptoTrader_AddBracketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBracketOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, in)
+		return srv.(GoCryptoTraderServer).AddBracketOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddBracketOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+		return srv.(GoCryptoTraderServer).AddBracketOrder(ctx, req.(*AddBracketOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_GetConditionalOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConditionalOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).GetConditionalOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConditionalOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).GetConditionalOrders(ctx, req.(*GetConditionalOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickerRequest)
+func _GoCryptoTrader_CancelConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelConditionalOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelConditionalOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelConditionalOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+		return srv.(GoCryptoTraderServer).CancelConditionalOrder(ctx, req.(*CancelConditionalOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickersRequest)
+func _GoCryptoTrader_StartExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartExecutionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+		return srv.(GoCryptoTraderServer).StartExecution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+		FullMethod: "/gctrpc.GoCryptoTrader/StartExecution",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+		return srv.(GoCryptoTraderServer).StartExecution(ctx, req.(*StartExecutionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbookRequest)
+func _GoCryptoTrader_PauseExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+		return srv.(GoCryptoTraderServer).PauseExecution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+		FullMethod: "/gctrpc.GoCryptoTrader/PauseExecution",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+		return srv.(GoCryptoTraderServer).PauseExecution(ctx, req.(*ExecutionIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbooksRequest)
+func _GoCryptoTrader_ResumeExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+		return srv.(GoCryptoTraderServer).ResumeExecution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+		FullMethod: "/gctrpc.GoCryptoTrader/ResumeExecution",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+		return srv.(GoCryptoTraderServer).ResumeExecution(ctx, req.(*ExecutionIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountInfoRequest)
+func _GoCryptoTrader_CancelExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelExecution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelExecution",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+		return srv.(GoCryptoTraderServer).CancelExecution(ctx, req.(*ExecutionIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetAccountInfoRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoCryptoTrader_GetExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoCryptoTraderServer).GetAccountInfoStream(m, &goCryptoTraderGetAccountInfoStreamServer{stream})
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExecutions(ctx, req.(*GetExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type GoCryptoTrader_GetAccountInfoStreamServer interface {
-	Send(*GetAccountInfoResponse) error
-	grpc.ServerStream
+func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type goCryptoTraderGetAccountInfoStreamServer struct {
-	grpc.ServerStream
+func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioSummaryRequest)
+func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCurrencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCurrencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPortfolioAddressRequest)
+func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCurrencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawCurrencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemovePortfolioAddressRequest)
+func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexProvidersRequest)
+func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexRatesRequest)
+func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangePairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrdersRequest)
+func _GoCryptoTrader_EnableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangePairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchangePair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, req.(*ExchangePairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderRequest)
+func _GoCryptoTrader_DisableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangePairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchangePair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, req.(*ExchangePairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitOrderRequest)
+func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
+}
+
+type GoCryptoTrader_GetTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetOrderUpdateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderUpdateStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetOrderUpdateStream(m, &goCryptoTraderGetOrderUpdateStreamServer{stream})
+}
+
+type GoCryptoTrader_GetOrderUpdateStreamServer interface {
+	Send(*OrderUpdateResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetOrderUpdateStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetOrderUpdateStreamServer) Send(m *OrderUpdateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulateOrderRequest)
+func _GoCryptoTrader_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptExecuteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptExecute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WhaleBombRequest)
+func _GoCryptoTrader_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptUpload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelOrderRequest)
+func _GoCryptoTrader_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptReadScriptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptReadScript",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelAllOrdersRequest)
+func _GoCryptoTrader_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventsRequest)
+func _GoCryptoTrader_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddEventRequest)
+func _GoCryptoTrader_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveEventRequest)
+func _GoCryptoTrader_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStopAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressesRequest)
+func _GoCryptoTrader_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptListAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressRequest)
+func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptAutoLoadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCurrencyRequest)
+func _GoCryptoTrader_GCTScriptState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCurrencyRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptState(ctx, req.(*GCTScriptStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCurrencyRequest)
+func _GoCryptoTrader_GCTScriptTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptTestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptTest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptTest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawCurrencyRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptTest(ctx, req.(*GCTScriptTestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoggerDetailsRequest)
+func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoricCandlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricCandles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLoggerDetailsRequest)
+func _GoCryptoTrader_StartCandleJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCandleJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).StartCandleJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/StartCandleJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).StartCandleJob(ctx, req.(*StartCandleJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangePairsRequest)
+func _GoCryptoTrader_GetCandleJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandleJobsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCandleJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCandleJobs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
+		return srv.(GoCryptoTraderServer).GetCandleJobs(ctx, req.(*GetCandleJobsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExchangePairRequest)
+func _GoCryptoTrader_GetCandleCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandleCoverageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCandleCoverage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchangePair",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCandleCoverage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, req.(*ExchangePairRequest))
+		return srv.(GoCryptoTraderServer).GetCandleCoverage(ctx, req.(*GetCandleCoverageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExchangePairRequest)
+func _GoCryptoTrader_GetArbitrageOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArbitrageOpportunitiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServer).GetArbitrageOpportunities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchangePair",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetArbitrageOpportunities",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, req.(*ExchangePairRequest))
+		return srv.(GoCryptoTraderServer).GetArbitrageOpportunities(ctx, req.(*GetArbitrageOpportunitiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetOrderbookStreamRequest)
+func _GoCryptoTrader_GetArbitrageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetArbitrageStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
+	return srv.(GoCryptoTraderServer).GetArbitrageStream(m, &goCryptoTraderGetArbitrageStreamServer{stream})
 }
 
-type GoCryptoTrader_GetOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
+type GoCryptoTrader_GetArbitrageStreamServer interface {
+	Send(*ArbitrageOpportunity) error
 	grpc.ServerStream
 }
 
-type goCryptoTraderGetOrderbookStreamServer struct {
+type goCryptoTraderGetArbitrageStreamServer struct {
 	grpc.ServerStream
 }
 
-func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
+func (x *goCryptoTraderGetArbitrageStreamServer) Send(m *ArbitrageOpportunity) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoCryptoTrader_GetTriangularArbitrage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTriangularArbitrageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTriangularArbitrage(ctx, in)
 	}
-	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTriangularArbitrage",
 	}
-	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTriangularArbitrage(ctx, req.(*GetTriangularArbitrageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAuditEventRequest)
+func _GoCryptoTrader_RouteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).RouteOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/RouteOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
+		return srv.(GoCryptoTraderServer).RouteOrder(ctx, req.(*RouteOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptExecuteRequest)
+func _GoCryptoTrader_GetRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoutesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, in)
+		return srv.(GoCryptoTraderServer).GetRoutes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptExecute",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRoutes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
+		return srv.(GoCryptoTraderServer).GetRoutes(ctx, req.(*GetRoutesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptUploadRequest)
+func _GoCryptoTrader_GetAggregatedOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAggregatedOrderbookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, in)
+		return srv.(GoCryptoTraderServer).GetAggregatedOrderbook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptUpload",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAggregatedOrderbook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
+		return srv.(GoCryptoTraderServer).GetAggregatedOrderbook(ctx, req.(*GetAggregatedOrderbookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptReadScriptRequest)
+func _GoCryptoTrader_GetAggregatedOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAggregatedOrderbookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetAggregatedOrderbookStream(m, &goCryptoTraderGetAggregatedOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetAggregatedOrderbookStreamServer interface {
+	Send(*AggregatedOrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetAggregatedOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetAggregatedOrderbookStreamServer) Send(m *AggregatedOrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPositionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, in)
+		return srv.(GoCryptoTraderServer).GetPositions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptReadScript",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPositions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
+		return srv.(GoCryptoTraderServer).GetPositions(ctx, req.(*GetPositionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStatusRequest)
+func _GoCryptoTrader_SetLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLeverageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, in)
+		return srv.(GoCryptoTraderServer).SetLeverage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStatus",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLeverage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
+		return srv.(GoCryptoTraderServer).SetLeverage(ctx, req.(*SetLeverageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptQueryRequest)
+func _GoCryptoTrader_SetMarginMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMarginModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, in)
+		return srv.(GoCryptoTraderServer).SetMarginMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptQuery",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetMarginMode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
+		return srv.(GoCryptoTraderServer).SetMarginMode(ctx, req.(*SetMarginModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopRequest)
+func _GoCryptoTrader_GetFundingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, in)
+		return srv.(GoCryptoTraderServer).GetFundingRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStop",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFundingRates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
+		return srv.(GoCryptoTraderServer).GetFundingRates(ctx, req.(*GetFundingRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopAllRequest)
+func _GoCryptoTrader_ClosePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePositionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, in)
+		return srv.(GoCryptoTraderServer).ClosePosition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStopAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/ClosePosition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
+		return srv.(GoCryptoTraderServer).ClosePosition(ctx, req.(*ClosePositionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptListAllRequest)
+func _GoCryptoTrader_GetTradingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradingRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, in)
+		return srv.(GoCryptoTraderServer).GetTradingRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTradingRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
+		return srv.(GoCryptoTraderServer).GetTradingRules(ctx, req.(*GetTradingRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptAutoLoadRequest)
+func _GoCryptoTrader_SubmitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, in)
+		return srv.(GoCryptoTraderServer).SubmitOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle",
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
+		return srv.(GoCryptoTraderServer).SubmitOrders(ctx, req.(*SubmitOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHistoricCandlesRequest)
+func _GoCryptoTrader_CancelOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricCandles",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
+		return srv.(GoCryptoTraderServer).CancelOrders(ctx, req.(*CancelOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -8496,10 +14510,58 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _GoCryptoTrader_CancelOrder_Handler,
 		},
+		{
+			MethodName: "ModifyOrder",
+			Handler:    _GoCryptoTrader_ModifyOrder_Handler,
+		},
 		{
 			MethodName: "CancelAllOrders",
 			Handler:    _GoCryptoTrader_CancelAllOrders_Handler,
 		},
+		{
+			MethodName: "SetRiskKillSwitch",
+			Handler:    _GoCryptoTrader_SetRiskKillSwitch_Handler,
+		},
+		{
+			MethodName: "AddConditionalOrder",
+			Handler:    _GoCryptoTrader_AddConditionalOrder_Handler,
+		},
+		{
+			MethodName: "AddOCOOrder",
+			Handler:    _GoCryptoTrader_AddOCOOrder_Handler,
+		},
+		{
+			MethodName: "AddBracketOrder",
+			Handler:    _GoCryptoTrader_AddBracketOrder_Handler,
+		},
+		{
+			MethodName: "GetConditionalOrders",
+			Handler:    _GoCryptoTrader_GetConditionalOrders_Handler,
+		},
+		{
+			MethodName: "CancelConditionalOrder",
+			Handler:    _GoCryptoTrader_CancelConditionalOrder_Handler,
+		},
+		{
+			MethodName: "StartExecution",
+			Handler:    _GoCryptoTrader_StartExecution_Handler,
+		},
+		{
+			MethodName: "PauseExecution",
+			Handler:    _GoCryptoTrader_PauseExecution_Handler,
+		},
+		{
+			MethodName: "ResumeExecution",
+			Handler:    _GoCryptoTrader_ResumeExecution_Handler,
+		},
+		{
+			MethodName: "CancelExecution",
+			Handler:    _GoCryptoTrader_CancelExecution_Handler,
+		},
+		{
+			MethodName: "GetExecutions",
+			Handler:    _GoCryptoTrader_GetExecutions_Handler,
+		},
 		{
 			MethodName: "GetEvents",
 			Handler:    _GoCryptoTrader_GetEvents_Handler,
@@ -8588,10 +14650,82 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GCTScriptAutoLoadToggle",
 			Handler:    _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler,
 		},
+		{
+			MethodName: "GCTScriptState",
+			Handler:    _GoCryptoTrader_GCTScriptState_Handler,
+		},
+		{
+			MethodName: "GCTScriptTest",
+			Handler:    _GoCryptoTrader_GCTScriptTest_Handler,
+		},
 		{
 			MethodName: "GetHistoricCandles",
 			Handler:    _GoCryptoTrader_GetHistoricCandles_Handler,
 		},
+		{
+			MethodName: "StartCandleJob",
+			Handler:    _GoCryptoTrader_StartCandleJob_Handler,
+		},
+		{
+			MethodName: "GetCandleJobs",
+			Handler:    _GoCryptoTrader_GetCandleJobs_Handler,
+		},
+		{
+			MethodName: "GetCandleCoverage",
+			Handler:    _GoCryptoTrader_GetCandleCoverage_Handler,
+		},
+		{
+			MethodName: "GetArbitrageOpportunities",
+			Handler:    _GoCryptoTrader_GetArbitrageOpportunities_Handler,
+		},
+		{
+			MethodName: "GetTriangularArbitrage",
+			Handler:    _GoCryptoTrader_GetTriangularArbitrage_Handler,
+		},
+		{
+			MethodName: "RouteOrder",
+			Handler:    _GoCryptoTrader_RouteOrder_Handler,
+		},
+		{
+			MethodName: "GetRoutes",
+			Handler:    _GoCryptoTrader_GetRoutes_Handler,
+		},
+		{
+			MethodName: "GetAggregatedOrderbook",
+			Handler:    _GoCryptoTrader_GetAggregatedOrderbook_Handler,
+		},
+		{
+			MethodName: "GetPositions",
+			Handler:    _GoCryptoTrader_GetPositions_Handler,
+		},
+		{
+			MethodName: "SetLeverage",
+			Handler:    _GoCryptoTrader_SetLeverage_Handler,
+		},
+		{
+			MethodName: "SetMarginMode",
+			Handler:    _GoCryptoTrader_SetMarginMode_Handler,
+		},
+		{
+			MethodName: "GetFundingRates",
+			Handler:    _GoCryptoTrader_GetFundingRates_Handler,
+		},
+		{
+			MethodName: "ClosePosition",
+			Handler:    _GoCryptoTrader_ClosePosition_Handler,
+		},
+		{
+			MethodName: "GetTradingRules",
+			Handler:    _GoCryptoTrader_GetTradingRules_Handler,
+		},
+		{
+			MethodName: "SubmitOrders",
+			Handler:    _GoCryptoTrader_SubmitOrders_Handler,
+		},
+		{
+			MethodName: "CancelOrders",
+			Handler:    _GoCryptoTrader_CancelOrders_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -8619,6 +14753,21 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetExchangeTickerStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetOrderUpdateStream",
+			Handler:       _GoCryptoTrader_GetOrderUpdateStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetArbitrageStream",
+			Handler:       _GoCryptoTrader_GetArbitrageStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAggregatedOrderbookStream",
+			Handler:       _GoCryptoTrader_GetAggregatedOrderbookStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -3,31 +3,69 @@ package gct
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	objects "github.com/d5/tengo/v2"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 )
 
-var exchangeModule = map[string]objects.Object{
-	"orderbook":      &objects.UserFunction{Name: "orderbook", Value: ExchangeOrderbook},
-	"ticker":         &objects.UserFunction{Name: "ticker", Value: ExchangeTicker},
-	"exchanges":      &objects.UserFunction{Name: "exchanges", Value: ExchangeExchanges},
-	"pairs":          &objects.UserFunction{Name: "pairs", Value: ExchangePairs},
-	"accountinfo":    &objects.UserFunction{Name: "accountinfo", Value: ExchangeAccountInfo},
-	"depositaddress": &objects.UserFunction{Name: "depositaddress", Value: ExchangeDepositAddress},
-	"orderquery":     &objects.UserFunction{Name: "orderquery", Value: ExchangeOrderQuery},
-	"ordercancel":    &objects.UserFunction{Name: "ordercancel", Value: ExchangeOrderCancel},
-	"ordersubmit":    &objects.UserFunction{Name: "ordersubmit", Value: ExchangeOrderSubmit},
-	"withdrawcrypto": &objects.UserFunction{Name: "withdrawcrypto", Value: ExchangeWithdrawCrypto},
-	"withdrawfiat":   &objects.UserFunction{Name: "withdrawfiat", Value: ExchangeWithdrawFiat},
+// exchangeFuncs maps the exchange module function names to implementations
+// taking the wrapper to call
+var exchangeFuncs = map[string]func(modules.GCT, ...objects.Object) (objects.Object, error){
+	"orderbook":        exchangeOrderbook,
+	"ticker":           exchangeTicker,
+	"exchanges":        exchangeExchanges,
+	"pairs":            exchangePairs,
+	"accountinfo":      exchangeAccountInfo,
+	"depositaddress":   exchangeDepositAddress,
+	"orderquery":       exchangeOrderQuery,
+	"ordercancel":      exchangeOrderCancel,
+	"ordersubmit":      exchangeOrderSubmit,
+	"withdrawcrypto":   exchangeWithdrawCrypto,
+	"withdrawfiat":     exchangeWithdrawFiat,
+	"candles":          exchangeCandles,
+	"trades":           exchangeTrades,
+	"orderhistory":     exchangeOrderHistory,
+	"activeorders":     exchangeActiveOrders,
+	"fundinghistory":   exchangeFundingHistory,
+	"ordersubmitbatch": exchangeOrderSubmitBatch,
+	"ordercancelbatch": exchangeOrderCancelBatch,
+}
+
+var exchangeModule = ExchangeModule(wrappers.GetWrapper)
+
+// ExchangeModule returns the exchange module with each function calling the
+// wrapper returned by getWrapper, allowing a virtual machine to restrict the
+// wrapper used by its script
+func ExchangeModule(getWrapper func() modules.GCT) map[string]objects.Object {
+	module := make(map[string]objects.Object, len(exchangeFuncs))
+	for name, fn := range exchangeFuncs {
+		fn := fn
+		module[name] = &objects.UserFunction{
+			Name: name,
+			Value: func(args ...objects.Object) (objects.Object, error) {
+				return fn(getWrapper(), args...)
+			},
+		}
+	}
+	return module
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
 func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderbook(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderbook(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 4 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -52,41 +90,20 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 	pairs := currency.NewPairDelimiter(currencyPair, delimiter)
 	assetType := asset.Item(assetTypeParam)
 
-	ob, err := wrappers.GetWrapper().Orderbook(exchangeName, pairs, assetType)
+	ob, err := w.Orderbook(exchangeName, pairs, assetType)
 	if err != nil {
 		return nil, err
 	}
 
-	var asks, bids objects.Array
-
-	for x := range ob.Asks {
-		temp := make(map[string]objects.Object, 2)
-		temp["amount"] = &objects.Float{Value: ob.Asks[x].Amount}
-		temp["price"] = &objects.Float{Value: ob.Asks[x].Price}
-		asks.Value = append(asks.Value, &objects.Map{Value: temp})
-	}
-
-	for x := range ob.Bids {
-		temp := make(map[string]objects.Object, 2)
-		temp["amount"] = &objects.Float{Value: ob.Bids[x].Amount}
-		temp["price"] = &objects.Float{Value: ob.Bids[x].Price}
-		bids.Value = append(bids.Value, &objects.Map{Value: temp})
-	}
-
-	data := make(map[string]objects.Object, 5)
-	data["exchange"] = &objects.String{Value: ob.ExchangeName}
-	data["pair"] = &objects.String{Value: ob.Pair.String()}
-	data["asks"] = &asks
-	data["bids"] = &bids
-	data["asset"] = &objects.String{Value: ob.AssetType.String()}
-
-	return &objects.Map{
-		Value: data,
-	}, nil
+	return OrderbookToObject(ob), nil
 }
 
 // ExchangeTicker returns ticker data for requested exchange and currency pair
 func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
+	return exchangeTicker(wrappers.GetWrapper(), args...)
+}
+
+func exchangeTicker(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 4 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -111,34 +128,20 @@ func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 	pairs := currency.NewPairDelimiter(currencyPair, delimiter)
 	assetType := asset.Item(assetTypeParam)
 
-	tx, err := wrappers.GetWrapper().Ticker(exchangeName, pairs, assetType)
+	tx, err := w.Ticker(exchangeName, pairs, assetType)
 	if err != nil {
 		return nil, err
 	}
 
-	data := make(map[string]objects.Object, 14)
-	data["exchange"] = &objects.String{Value: tx.ExchangeName}
-	data["last"] = &objects.Float{Value: tx.Last}
-	data["High"] = &objects.Float{Value: tx.High}
-	data["Low"] = &objects.Float{Value: tx.Low}
-	data["bid"] = &objects.Float{Value: tx.Bid}
-	data["ask"] = &objects.Float{Value: tx.Ask}
-	data["volume"] = &objects.Float{Value: tx.Volume}
-	data["quotevolume"] = &objects.Float{Value: tx.QuoteVolume}
-	data["priceath"] = &objects.Float{Value: tx.PriceATH}
-	data["open"] = &objects.Float{Value: tx.Open}
-	data["close"] = &objects.Float{Value: tx.Close}
-	data["pair"] = &objects.String{Value: tx.Pair.String()}
-	data["asset"] = &objects.String{Value: tx.AssetType.String()}
-	data["updated"] = &objects.Time{Value: tx.LastUpdated}
-
-	return &objects.Map{
-		Value: data,
-	}, nil
+	return TickerToObject(tx), nil
 }
 
 // ExchangeExchanges returns list of exchanges either enabled or all
 func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
+	return exchangeExchanges(wrappers.GetWrapper(), args...)
+}
+
+func exchangeExchanges(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 1 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -147,7 +150,7 @@ func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 	if !ok {
 		return nil, fmt.Errorf(ErrParameterConvertFailed, enabledOnly)
 	}
-	rtnValue := wrappers.GetWrapper().Exchanges(enabledOnly)
+	rtnValue := w.Exchanges(enabledOnly)
 
 	r := objects.Array{}
 	for x := range rtnValue {
@@ -159,6 +162,10 @@ func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 
 // ExchangePairs returns currency pairs for requested exchange
 func ExchangePairs(args ...objects.Object) (objects.Object, error) {
+	return exchangePairs(wrappers.GetWrapper(), args...)
+}
+
+func exchangePairs(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 3 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -177,7 +184,7 @@ func ExchangePairs(args ...objects.Object) (objects.Object, error) {
 	}
 	assetType := asset.Item(strings.ToLower(assetTypeParam))
 
-	rtnValue, err := wrappers.GetWrapper().Pairs(exchangeName, enabledOnly, assetType)
+	rtnValue, err := w.Pairs(exchangeName, enabledOnly, assetType)
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +198,10 @@ func ExchangePairs(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeAccountInfo returns account information for requested exchange
 func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
+	return exchangeAccountInfo(wrappers.GetWrapper(), args...)
+}
+
+func exchangeAccountInfo(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 1 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -199,33 +210,20 @@ func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
 	if !ok {
 		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
 	}
-	rtnValue, err := wrappers.GetWrapper().AccountInformation(exchangeName)
+	rtnValue, err := w.AccountInformation(exchangeName)
 	if err != nil {
 		return nil, err
 	}
 
-	var funds objects.Array
-	for x := range rtnValue.Accounts {
-		for y := range rtnValue.Accounts[x].Currencies {
-			temp := make(map[string]objects.Object, 3)
-			temp["name"] = &objects.String{Value: rtnValue.Accounts[x].Currencies[y].CurrencyName.String()}
-			temp["total"] = &objects.Float{Value: rtnValue.Accounts[x].Currencies[y].TotalValue}
-			temp["hold"] = &objects.Float{Value: rtnValue.Accounts[x].Currencies[y].Hold}
-			funds.Value = append(funds.Value, &objects.Map{Value: temp})
-		}
-	}
-
-	data := make(map[string]objects.Object, 2)
-	data["exchange"] = &objects.String{Value: rtnValue.Exchange}
-	data["currencies"] = &funds
-
-	return &objects.Map{
-		Value: data,
-	}, nil
+	return AccountToObject(&rtnValue), nil
 }
 
 // ExchangeOrderQuery query order on exchange
 func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderQuery(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderQuery(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 2 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -238,47 +236,20 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 	if !ok {
 		return nil, fmt.Errorf(ErrParameterConvertFailed, orderID)
 	}
-	orderDetails, err := wrappers.GetWrapper().QueryOrder(exchangeName, orderID)
+	orderDetails, err := w.QueryOrder(exchangeName, orderID)
 	if err != nil {
 		return nil, err
 	}
 
-	var tradeHistory objects.Array
-	for x := range orderDetails.Trades {
-		temp := make(map[string]objects.Object, 7)
-		temp["timestamp"] = &objects.Time{Value: orderDetails.Trades[x].Timestamp}
-		temp["price"] = &objects.Float{Value: orderDetails.Trades[x].Price}
-		temp["fee"] = &objects.Float{Value: orderDetails.Trades[x].Fee}
-		temp["amount"] = &objects.Float{Value: orderDetails.Trades[x].Amount}
-		temp["type"] = &objects.String{Value: orderDetails.Trades[x].Type.String()}
-		temp["side"] = &objects.String{Value: orderDetails.Trades[x].Side.String()}
-		temp["description"] = &objects.String{Value: orderDetails.Trades[x].Description}
-		tradeHistory.Value = append(tradeHistory.Value, &objects.Map{Value: temp})
-	}
-
-	data := make(map[string]objects.Object, 14)
-	data["exchange"] = &objects.String{Value: orderDetails.Exchange}
-	data["id"] = &objects.String{Value: orderDetails.ID}
-	data["accountid"] = &objects.String{Value: orderDetails.AccountID}
-	data["currencypair"] = &objects.String{Value: orderDetails.CurrencyPair.String()}
-	data["price"] = &objects.Float{Value: orderDetails.Price}
-	data["amount"] = &objects.Float{Value: orderDetails.Amount}
-	data["amountexecuted"] = &objects.Float{Value: orderDetails.ExecutedAmount}
-	data["amountremaining"] = &objects.Float{Value: orderDetails.RemainingAmount}
-	data["fee"] = &objects.Float{Value: orderDetails.Fee}
-	data["side"] = &objects.String{Value: orderDetails.OrderSide.String()}
-	data["type"] = &objects.String{Value: orderDetails.OrderType.String()}
-	data["date"] = &objects.String{Value: orderDetails.OrderDate.String()}
-	data["status"] = &objects.String{Value: orderDetails.Status.String()}
-	data["trades"] = &tradeHistory
-
-	return &objects.Map{
-		Value: data,
-	}, nil
+	return OrderToObject(orderDetails), nil
 }
 
 // ExchangeOrderCancel cancels order on requested exchange
 func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderCancel(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderCancel(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 2 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -292,7 +263,7 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 		return nil, fmt.Errorf(ErrParameterConvertFailed, orderID)
 	}
 
-	rtn, err := wrappers.GetWrapper().CancelOrder(exchangeName, orderID)
+	rtn, err := w.CancelOrder(exchangeName, orderID)
 	if err != nil {
 		return nil, err
 	}
@@ -305,6 +276,10 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeOrderSubmit submit order on exchange
 func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderSubmit(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderSubmit(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 8 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -357,7 +332,7 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 		return nil, err
 	}
 
-	rtn, err := wrappers.GetWrapper().SubmitOrder(exchangeName, tempSubmit)
+	rtn, err := w.SubmitOrder(exchangeName, tempSubmit)
 	if err != nil {
 		return nil, err
 	}
@@ -375,8 +350,188 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	}, nil
 }
 
+// ExchangeOrderSubmitBatch submits a batch of orders to an exchange together
+func ExchangeOrderSubmitBatch(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderSubmitBatch(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderSubmitBatch(w modules.GCT, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[0])
+	}
+	values, ok := arrayValues(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[1])
+	}
+
+	submit := make([]order.Submit, len(values))
+	for x := range values {
+		s, err := orderFromObject(values[x])
+		if err != nil {
+			return nil, fmt.Errorf("order %d: %v", x+1, err)
+		}
+		submit[x] = s
+	}
+
+	rtn, err := w.SubmitOrders(exchangeName, submit)
+	if err != nil {
+		return nil, err
+	}
+
+	r := objects.Array{}
+	for x := range rtn {
+		data := make(map[string]objects.Object, 2)
+		data["orderid"] = &objects.String{Value: rtn[x].OrderID}
+		if rtn[x].IsOrderPlaced {
+			data["isorderplaced"] = objects.TrueValue
+		} else {
+			data["isorderplaced"] = objects.FalseValue
+		}
+		r.Value = append(r.Value, &objects.Map{Value: data})
+	}
+	return &r, nil
+}
+
+// ExchangeOrderCancelBatch cancels a batch of orders on an exchange together
+// and returns the orders which failed to cancel with their error
+func ExchangeOrderCancelBatch(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderCancelBatch(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderCancelBatch(w modules.GCT, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[0])
+	}
+	values, ok := arrayValues(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[1])
+	}
+
+	orderIDs := make([]string, len(values))
+	for x := range values {
+		orderIDs[x], ok = objects.ToString(values[x])
+		if !ok {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, values[x])
+		}
+	}
+
+	rtn, err := w.CancelOrders(exchangeName, orderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, len(rtn.Status))
+	for id, status := range rtn.Status {
+		data[id] = &objects.String{Value: status}
+	}
+	return &objects.Map{Value: data}, nil
+}
+
+// orderFromObject converts a script order map with pair, delimiter, type,
+// side, price, amount and an optional clientid to a validated order
+func orderFromObject(obj objects.Object) (order.Submit, error) {
+	var m map[string]objects.Object
+	switch v := obj.(type) {
+	case *objects.Map:
+		m = v.Value
+	case *objects.ImmutableMap:
+		m = v.Value
+	default:
+		return order.Submit{}, fmt.Errorf(ErrParameterConvertFailed, obj)
+	}
+
+	pair, err := mapString(m, "pair")
+	if err != nil {
+		return order.Submit{}, err
+	}
+	delim, err := mapString(m, "delimiter")
+	if err != nil {
+		return order.Submit{}, err
+	}
+	orderType, err := mapString(m, "type")
+	if err != nil {
+		return order.Submit{}, err
+	}
+	orderSide, err := mapString(m, "side")
+	if err != nil {
+		return order.Submit{}, err
+	}
+	clientID, err := mapString(m, "clientid")
+	if err != nil {
+		return order.Submit{}, err
+	}
+	price, err := mapFloat(m, "price")
+	if err != nil {
+		return order.Submit{}, err
+	}
+	amount, err := mapFloat(m, "amount")
+	if err != nil {
+		return order.Submit{}, err
+	}
+
+	s := order.Submit{
+		Pair:      currency.NewPairDelimiter(pair, delim),
+		OrderType: order.Type(orderType),
+		OrderSide: order.Side(orderSide),
+		Price:     price,
+		Amount:    amount,
+		ClientID:  clientID,
+	}
+	return s, s.Validate()
+}
+
+// mapString returns the string value of the key, a missing key is empty
+func mapString(m map[string]objects.Object, key string) (string, error) {
+	v, ok := m[key]
+	if !ok {
+		return "", nil
+	}
+	str, ok := objects.ToString(v)
+	if !ok {
+		return "", fmt.Errorf(ErrParameterConvertFailed, v)
+	}
+	return str, nil
+}
+
+// mapFloat returns the float value of the key, a missing key is zero
+func mapFloat(m map[string]objects.Object, key string) (float64, error) {
+	v, ok := m[key]
+	if !ok {
+		return 0, nil
+	}
+	f, ok := objects.ToFloat64(v)
+	if !ok {
+		return 0, fmt.Errorf(ErrParameterConvertFailed, v)
+	}
+	return f, nil
+}
+
+func arrayValues(obj objects.Object) ([]objects.Object, bool) {
+	switch a := obj.(type) {
+	case *objects.Array:
+		return a.Value, true
+	case *objects.ImmutableArray:
+		return a.Value, true
+	}
+	return nil, false
+}
+
 // ExchangeDepositAddress returns deposit address (if supported by exchange)
 func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
+	return exchangeDepositAddress(wrappers.GetWrapper(), args...)
+}
+
+func exchangeDepositAddress(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 2 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -392,7 +547,7 @@ func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 
 	currCode := currency.NewCode(currencyCode)
 
-	rtn, err := wrappers.GetWrapper().DepositAddress(exchangeName, currCode)
+	rtn, err := w.DepositAddress(exchangeName, currCode)
 	if err != nil {
 		return nil, err
 	}
@@ -402,6 +557,10 @@ func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeWithdrawCrypto submit request to withdraw crypto assets
 func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
+	return exchangeWithdrawCrypto(wrappers.GetWrapper(), args...)
+}
+
+func exchangeWithdrawCrypto(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 7 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -446,7 +605,7 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 		FeeAmount:  feeAmount,
 	}
 
-	rtn, err := wrappers.GetWrapper().WithdrawalCryptoFunds(exchangeName, withdrawRequest)
+	rtn, err := w.WithdrawalCryptoFunds(exchangeName, withdrawRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -456,6 +615,10 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeWithdrawFiat submit request to withdraw fiat assets
 func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
+	return exchangeWithdrawFiat(wrappers.GetWrapper(), args...)
+}
+
+func exchangeWithdrawFiat(w modules.GCT, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 5 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -489,10 +652,374 @@ func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
 		},
 	}
 
-	rtn, err := wrappers.GetWrapper().WithdrawalFiatFunds(exchangeName, bankAccountID, withdrawRequest)
+	rtn, err := w.WithdrawalFiatFunds(exchangeName, bankAccountID, withdrawRequest)
 	if err != nil {
 		return nil, err
 	}
 
 	return &objects.String{Value: rtn}, nil
 }
+
+// ExchangeCandles returns historic candles for requested exchange, currency
+// pair and interval between the start and end times
+func ExchangeCandles(args ...objects.Object) (objects.Object, error) {
+	return exchangeCandles(wrappers.GetWrapper(), args...)
+}
+
+func exchangeCandles(w modules.GCT, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[0])
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[1])
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[3])
+	}
+	intervalParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[4])
+	}
+	start, end, err := timeRange(args[5], args[6])
+	if err != nil {
+		return nil, err
+	}
+	interval, err := kline.ParseInterval(intervalParam)
+	if err != nil {
+		return nil, err
+	}
+
+	pair := currency.NewPairDelimiter(currencyPair, delimiter)
+	assetType := asset.Item(strings.ToLower(assetTypeParam))
+
+	candles, err := w.HistoricCandles(exchangeName, pair, assetType, start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	r := objects.Array{}
+	for x := range candles.Candles {
+		temp := make(map[string]objects.Object, 6)
+		temp["time"] = &objects.Time{Value: candles.Candles[x].Time}
+		temp["open"] = &objects.Float{Value: candles.Candles[x].Open}
+		temp["high"] = &objects.Float{Value: candles.Candles[x].High}
+		temp["low"] = &objects.Float{Value: candles.Candles[x].Low}
+		temp["close"] = &objects.Float{Value: candles.Candles[x].Close}
+		temp["volume"] = &objects.Float{Value: candles.Candles[x].Volume}
+		r.Value = append(r.Value, &objects.Map{Value: temp})
+	}
+	return &r, nil
+}
+
+// ExchangeTrades returns public trade history for requested exchange and
+// currency pair between the start and end times
+func ExchangeTrades(args ...objects.Object) (objects.Object, error) {
+	return exchangeTrades(wrappers.GetWrapper(), args...)
+}
+
+func exchangeTrades(w modules.GCT, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[0])
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[1])
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[2])
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[3])
+	}
+	start, end, err := timeRange(args[4], args[5])
+	if err != nil {
+		return nil, err
+	}
+
+	pair := currency.NewPairDelimiter(currencyPair, delimiter)
+	assetType := asset.Item(strings.ToLower(assetTypeParam))
+
+	trades, err := w.TradeHistory(exchangeName, pair, assetType, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	r := objects.Array{}
+	for x := range trades {
+		temp := make(map[string]objects.Object, 5)
+		temp["timestamp"] = &objects.Time{Value: trades[x].Timestamp}
+		temp["id"] = &objects.String{Value: trades[x].TID}
+		temp["price"] = &objects.Float{Value: trades[x].Price}
+		temp["amount"] = &objects.Float{Value: trades[x].Amount}
+		temp["side"] = &objects.String{Value: trades[x].Side.String()}
+		r.Value = append(r.Value, &objects.Map{Value: temp})
+	}
+	return &r, nil
+}
+
+// ExchangeOrderHistory returns closed orders on requested exchange between
+// the start and end times, an empty currency pair returns all pairs
+func ExchangeOrderHistory(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderHistory(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderHistory(w modules.GCT, args ...objects.Object) (objects.Object, error) {
+	exchangeName, request, err := ordersRequest(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := w.OrderHistory(exchangeName, request)
+	if err != nil {
+		return nil, err
+	}
+	return ordersToObject(orders), nil
+}
+
+// ExchangeActiveOrders returns open orders on requested exchange between the
+// start and end times, an empty currency pair returns all pairs
+func ExchangeActiveOrders(args ...objects.Object) (objects.Object, error) {
+	return exchangeActiveOrders(wrappers.GetWrapper(), args...)
+}
+
+func exchangeActiveOrders(w modules.GCT, args ...objects.Object) (objects.Object, error) {
+	exchangeName, request, err := ordersRequest(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := w.ActiveOrders(exchangeName, request)
+	if err != nil {
+		return nil, err
+	}
+	return ordersToObject(orders), nil
+}
+
+// ExchangeFundingHistory returns deposits and withdrawals on requested
+// exchange between the start and end times
+func ExchangeFundingHistory(args ...objects.Object) (objects.Object, error) {
+	return exchangeFundingHistory(wrappers.GetWrapper(), args...)
+}
+
+func exchangeFundingHistory(w modules.GCT, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[0])
+	}
+	start, end, err := timeRange(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	funding, err := w.FundingHistory(exchangeName, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	r := objects.Array{}
+	for x := range funding {
+		temp := make(map[string]objects.Object, 9)
+		temp["id"] = &objects.String{Value: funding[x].TransferID}
+		temp["status"] = &objects.String{Value: funding[x].Status}
+		temp["timestamp"] = &objects.Time{Value: funding[x].Timestamp}
+		temp["currency"] = &objects.String{Value: funding[x].Currency}
+		temp["amount"] = &objects.Float{Value: funding[x].Amount}
+		temp["fee"] = &objects.Float{Value: funding[x].Fee}
+		temp["type"] = &objects.String{Value: funding[x].TransferType}
+		temp["description"] = &objects.String{Value: funding[x].Description}
+		temp["txid"] = &objects.String{Value: funding[x].CryptoTxID}
+		r.Value = append(r.Value, &objects.Map{Value: temp})
+	}
+	return &r, nil
+}
+
+// ordersRequest parses the exchange, currency pair, delimiter, start and end
+// arguments shared by the order history functions
+func ordersRequest(args ...objects.Object) (string, *order.GetOrdersRequest, error) {
+	if len(args) != 5 {
+		return "", nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, args[0])
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, args[1])
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return "", nil, fmt.Errorf(ErrParameterConvertFailed, args[2])
+	}
+	start, end, err := timeRange(args[3], args[4])
+	if err != nil {
+		return "", nil, err
+	}
+
+	request := &order.GetOrdersRequest{
+		StartTicks: start,
+		EndTicks:   end,
+	}
+	if currencyPair != "" {
+		request.Currencies = []currency.Pair{
+			currency.NewPairDelimiter(currencyPair, delimiter),
+		}
+	}
+	return exchangeName, request, nil
+}
+
+// timeRange converts start and end arguments to times, both accept a time or
+// unix timestamp where 0 leaves that side of the range open
+func timeRange(startArg, endArg objects.Object) (start, end time.Time, err error) {
+	start, ok := objects.ToTime(startArg)
+	if !ok {
+		return start, end, fmt.Errorf(ErrParameterConvertFailed, startArg)
+	}
+	end, ok = objects.ToTime(endArg)
+	if !ok {
+		return start, end, fmt.Errorf(ErrParameterConvertFailed, endArg)
+	}
+	return start, end, nil
+}
+
+func ordersToObject(orders []order.Detail) objects.Object {
+	r := objects.Array{}
+	for x := range orders {
+		r.Value = append(r.Value, OrderToObject(&orders[x]))
+	}
+	return &r
+}
+
+// OrderbookToObject converts an orderbook to a script map
+func OrderbookToObject(ob *orderbook.Base) objects.Object {
+	var asks, bids objects.Array
+
+	for x := range ob.Asks {
+		temp := make(map[string]objects.Object, 2)
+		temp["amount"] = &objects.Float{Value: ob.Asks[x].Amount}
+		temp["price"] = &objects.Float{Value: ob.Asks[x].Price}
+		asks.Value = append(asks.Value, &objects.Map{Value: temp})
+	}
+
+	for x := range ob.Bids {
+		temp := make(map[string]objects.Object, 2)
+		temp["amount"] = &objects.Float{Value: ob.Bids[x].Amount}
+		temp["price"] = &objects.Float{Value: ob.Bids[x].Price}
+		bids.Value = append(bids.Value, &objects.Map{Value: temp})
+	}
+
+	data := make(map[string]objects.Object, 5)
+	data["exchange"] = &objects.String{Value: ob.ExchangeName}
+	data["pair"] = &objects.String{Value: ob.Pair.String()}
+	data["asks"] = &asks
+	data["bids"] = &bids
+	data["asset"] = &objects.String{Value: ob.AssetType.String()}
+
+	return &objects.Map{
+		Value: data,
+	}
+}
+
+// TickerToObject converts a ticker to a script map
+func TickerToObject(tx *ticker.Price) objects.Object {
+	data := make(map[string]objects.Object, 14)
+	data["exchange"] = &objects.String{Value: tx.ExchangeName}
+	data["last"] = &objects.Float{Value: tx.Last}
+	data["High"] = &objects.Float{Value: tx.High}
+	data["Low"] = &objects.Float{Value: tx.Low}
+	data["bid"] = &objects.Float{Value: tx.Bid}
+	data["ask"] = &objects.Float{Value: tx.Ask}
+	data["volume"] = &objects.Float{Value: tx.Volume}
+	data["quotevolume"] = &objects.Float{Value: tx.QuoteVolume}
+	data["priceath"] = &objects.Float{Value: tx.PriceATH}
+	data["open"] = &objects.Float{Value: tx.Open}
+	data["close"] = &objects.Float{Value: tx.Close}
+	data["pair"] = &objects.String{Value: tx.Pair.String()}
+	data["asset"] = &objects.String{Value: tx.AssetType.String()}
+	data["updated"] = &objects.Time{Value: tx.LastUpdated}
+
+	return &objects.Map{
+		Value: data,
+	}
+}
+
+// AccountToObject converts account holdings to a script map
+func AccountToObject(rtnValue *account.Holdings) objects.Object {
+	var funds objects.Array
+	for x := range rtnValue.Accounts {
+		for y := range rtnValue.Accounts[x].Currencies {
+			temp := make(map[string]objects.Object, 3)
+			temp["name"] = &objects.String{Value: rtnValue.Accounts[x].Currencies[y].CurrencyName.String()}
+			temp["total"] = &objects.Float{Value: rtnValue.Accounts[x].Currencies[y].TotalValue}
+			temp["hold"] = &objects.Float{Value: rtnValue.Accounts[x].Currencies[y].Hold}
+			funds.Value = append(funds.Value, &objects.Map{Value: temp})
+		}
+	}
+
+	data := make(map[string]objects.Object, 2)
+	data["exchange"] = &objects.String{Value: rtnValue.Exchange}
+	data["currencies"] = &funds
+
+	return &objects.Map{
+		Value: data,
+	}
+}
+
+// OrderToObject converts order details to a script map
+func OrderToObject(orderDetails *order.Detail) objects.Object {
+	var tradeHistory objects.Array
+	for x := range orderDetails.Trades {
+		temp := make(map[string]objects.Object, 7)
+		temp["timestamp"] = &objects.Time{Value: orderDetails.Trades[x].Timestamp}
+		temp["price"] = &objects.Float{Value: orderDetails.Trades[x].Price}
+		temp["fee"] = &objects.Float{Value: orderDetails.Trades[x].Fee}
+		temp["amount"] = &objects.Float{Value: orderDetails.Trades[x].Amount}
+		temp["type"] = &objects.String{Value: orderDetails.Trades[x].Type.String()}
+		temp["side"] = &objects.String{Value: orderDetails.Trades[x].Side.String()}
+		temp["description"] = &objects.String{Value: orderDetails.Trades[x].Description}
+		tradeHistory.Value = append(tradeHistory.Value, &objects.Map{Value: temp})
+	}
+
+	data := make(map[string]objects.Object, 14)
+	data["exchange"] = &objects.String{Value: orderDetails.Exchange}
+	data["id"] = &objects.String{Value: orderDetails.ID}
+	data["accountid"] = &objects.String{Value: orderDetails.AccountID}
+	data["currencypair"] = &objects.String{Value: orderDetails.CurrencyPair.String()}
+	data["price"] = &objects.Float{Value: orderDetails.Price}
+	data["amount"] = &objects.Float{Value: orderDetails.Amount}
+	data["amountexecuted"] = &objects.Float{Value: orderDetails.ExecutedAmount}
+	data["amountremaining"] = &objects.Float{Value: orderDetails.RemainingAmount}
+	data["fee"] = &objects.Float{Value: orderDetails.Fee}
+	data["side"] = &objects.String{Value: orderDetails.OrderSide.String()}
+	data["type"] = &objects.String{Value: orderDetails.OrderType.String()}
+	data["date"] = &objects.String{Value: orderDetails.OrderDate.String()}
+	data["status"] = &objects.String{Value: orderDetails.Status.String()}
+	data["trades"] = &tradeHistory
+
+	return &objects.Map{
+		Value: data,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	objects "github.com/d5/tengo/v2"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
@@ -210,6 +211,73 @@ func TestExchangeOrderSubmit(t *testing.T) {
 	}
 }
 
+func TestExchangeOrderSubmitBatch(t *testing.T) {
+	_, err := ExchangeOrderSubmitBatch()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	o := &objects.Map{Value: map[string]objects.Object{
+		"pair":      currencyPair,
+		"delimiter": delimiter,
+		"type":      &objects.String{Value: "LIMIT"},
+		"side":      &objects.String{Value: "BUY"},
+		"price":     &objects.Float{Value: 1},
+		"amount":    &objects.Float{Value: 1},
+	}}
+	r, err := ExchangeOrderSubmitBatch(&objects.String{Value: "true"},
+		&objects.Array{Value: []objects.Object{o, o}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, ok := r.(*objects.Array); !ok || len(a.Value) != 2 {
+		t.Errorf("expected an array of two orders, received %v", r)
+	}
+
+	_, err = ExchangeOrderSubmitBatch(exch, o)
+	if err == nil {
+		t.Error("expected an error when the orders are not an array")
+	}
+
+	invalid := &objects.Map{Value: map[string]objects.Object{"pair": currencyPair}}
+	_, err = ExchangeOrderSubmitBatch(exch, &objects.Array{Value: []objects.Object{invalid}})
+	if err == nil {
+		t.Error("expected an error for an invalid order")
+	}
+
+	_, err = ExchangeOrderSubmitBatch(exchError, &objects.Array{Value: []objects.Object{o}})
+	if err != nil && !errors.Is(err, errTestFailed) {
+		t.Fatal(err)
+	}
+}
+
+func TestExchangeOrderCancelBatch(t *testing.T) {
+	_, err := ExchangeOrderCancelBatch()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	r, err := ExchangeOrderCancelBatch(exch, &objects.Array{
+		Value: []objects.Object{orderID, &objects.String{Value: "false"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m, ok := r.(*objects.Map); !ok || len(m.Value) != 1 {
+		t.Errorf("expected one failed order, received %v", r)
+	}
+
+	_, err = ExchangeOrderCancelBatch(exch, orderID)
+	if err == nil {
+		t.Error("expected an error when the order ids are not an array")
+	}
+
+	_, err = ExchangeOrderCancelBatch(exchError, &objects.Array{})
+	if err != nil && !errors.Is(err, errTestFailed) {
+		t.Fatal(err)
+	}
+}
+
 func TestAllModuleNames(t *testing.T) {
 	x := AllModuleNames()
 	xType := reflect.TypeOf(x).Kind()
@@ -268,3 +336,134 @@ func TestExchangeWithdrawFiat(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestExchangeCandles(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeCandles()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	interval := &objects.String{Value: "1h"}
+	start := &objects.Time{Value: time.Now().Add(-time.Hour * 24)}
+	end := &objects.Time{Value: time.Now()}
+	candles, err := ExchangeCandles(exch, currencyPair, delimiter, assetType, interval, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles.(*objects.Array).Value) != 1 {
+		t.Fatalf("expected 1 candle received %v", candles)
+	}
+
+	_, err = ExchangeCandles(exch, currencyPair, delimiter, assetType, &objects.String{Value: "3d"}, start, end)
+	if err == nil {
+		t.Fatal("expected unsupported interval error")
+	}
+
+	_, err = ExchangeCandles(exch, currencyPair, delimiter, assetType, interval, orderID, end)
+	if err == nil {
+		t.Fatal("expected invalid start time to return error")
+	}
+
+	_, err = ExchangeCandles(exchError, currencyPair, delimiter, assetType, interval, start, end)
+	if err == nil {
+		t.Fatal("expected error exchange to return error")
+	}
+}
+
+func TestExchangeTrades(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeTrades()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	start := &objects.Int{Value: time.Now().Add(-time.Hour).Unix()}
+	end := &objects.Int{Value: 0}
+	trades, err := ExchangeTrades(exch, currencyPair, delimiter, assetType, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades.(*objects.Array).Value) != 1 {
+		t.Fatalf("expected 1 trade received %v", trades)
+	}
+
+	_, err = ExchangeTrades(exchError, currencyPair, delimiter, assetType, start, end)
+	if err == nil {
+		t.Fatal("expected error exchange to return error")
+	}
+}
+
+func TestExchangeOrderHistory(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderHistory()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	start := &objects.Int{Value: 0}
+	end := &objects.Time{Value: time.Now()}
+	orders, err := ExchangeOrderHistory(exch, currencyPair, delimiter, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders.(*objects.Array).Value) != 1 {
+		t.Fatalf("expected 1 order received %v", orders)
+	}
+
+	_, err = ExchangeOrderHistory(exch, &objects.String{}, delimiter, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ExchangeOrderHistory(exchError, currencyPair, delimiter, start, end)
+	if err == nil {
+		t.Fatal("expected error exchange to return error")
+	}
+}
+
+func TestExchangeActiveOrders(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeActiveOrders()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	start := &objects.Int{Value: 0}
+	end := &objects.Int{Value: 0}
+	orders, err := ExchangeActiveOrders(exch, currencyPair, delimiter, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders.(*objects.Array).Value) != 1 {
+		t.Fatalf("expected 1 order received %v", orders)
+	}
+
+	_, err = ExchangeActiveOrders(exchError, currencyPair, delimiter, start, end)
+	if err == nil {
+		t.Fatal("expected error exchange to return error")
+	}
+}
+
+func TestExchangeFundingHistory(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFundingHistory()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	start := &objects.Int{Value: 0}
+	end := &objects.Time{Value: time.Now()}
+	funding, err := ExchangeFundingHistory(exch, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(funding.(*objects.Array).Value) != 1 {
+		t.Fatalf("expected 1 funding record received %v", funding)
+	}
+
+	_, err = ExchangeFundingHistory(exchError, start, end)
+	if err == nil {
+		t.Fatal("expected error exchange to return error")
+	}
+}
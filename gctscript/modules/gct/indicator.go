@@ -0,0 +1,216 @@
+package gct
+
+import (
+	"errors"
+	"fmt"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/indicators"
+)
+
+var indicatorModule = map[string]objects.Object{
+	"sma":    &objects.UserFunction{Name: "sma", Value: IndicatorSMA},
+	"ema":    &objects.UserFunction{Name: "ema", Value: IndicatorEMA},
+	"rsi":    &objects.UserFunction{Name: "rsi", Value: IndicatorRSI},
+	"macd":   &objects.UserFunction{Name: "macd", Value: IndicatorMACD},
+	"bbands": &objects.UserFunction{Name: "bbands", Value: IndicatorBollingerBands},
+	"atr":    &objects.UserFunction{Name: "atr", Value: IndicatorATR},
+}
+
+// IndicatorSMA returns the simple moving average of the candle closes
+func IndicatorSMA(args ...objects.Object) (objects.Object, error) {
+	return closeIndicator(indicators.SMA, args...)
+}
+
+// IndicatorEMA returns the exponential moving average of the candle closes
+func IndicatorEMA(args ...objects.Object) (objects.Object, error) {
+	return closeIndicator(indicators.EMA, args...)
+}
+
+// IndicatorRSI returns the relative strength index of the candle closes
+func IndicatorRSI(args ...objects.Object) (objects.Object, error) {
+	return closeIndicator(indicators.RSI, args...)
+}
+
+// IndicatorMACD returns the MACD line, signal line and histogram of the candle
+// closes
+func IndicatorMACD(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	candles, err := toCandles(args[0])
+	if err != nil {
+		return nil, err
+	}
+	fast, ok := objects.ToInt(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[1])
+	}
+	slow, ok := objects.ToInt(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[2])
+	}
+	signal, ok := objects.ToInt(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[3])
+	}
+
+	r, err := indicators.MACD(indicators.Close(candles), fast, slow, signal)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 3)
+	data["macd"] = toFloatArray(r.MACD)
+	data["signal"] = toFloatArray(r.Signal)
+	data["histogram"] = toFloatArray(r.Histogram)
+	return &objects.Map{Value: data}, nil
+}
+
+// IndicatorBollingerBands returns the upper, middle and lower Bollinger Bands
+// of the candle closes
+func IndicatorBollingerBands(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	candles, err := toCandles(args[0])
+	if err != nil {
+		return nil, err
+	}
+	period, ok := objects.ToInt(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[1])
+	}
+	deviations, ok := objects.ToFloat64(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[2])
+	}
+
+	r, err := indicators.BollingerBands(indicators.Close(candles), period, deviations)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 3)
+	data["upper"] = toFloatArray(r.Upper)
+	data["middle"] = toFloatArray(r.Middle)
+	data["lower"] = toFloatArray(r.Lower)
+	return &objects.Map{Value: data}, nil
+}
+
+// IndicatorATR returns the average true range of the candles
+func IndicatorATR(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	candles, err := toCandles(args[0])
+	if err != nil {
+		return nil, err
+	}
+	period, ok := objects.ToInt(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[1])
+	}
+
+	out, err := indicators.ATR(candles, period)
+	if err != nil {
+		return nil, err
+	}
+	return toFloatArray(out), nil
+}
+
+// closeIndicator parses the candles and period arguments and applies an
+// indicator to the candle closes
+func closeIndicator(fn func([]float64, int) ([]float64, error), args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	candles, err := toCandles(args[0])
+	if err != nil {
+		return nil, err
+	}
+	period, ok := objects.ToInt(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, args[1])
+	}
+
+	out, err := fn(indicators.Close(candles), period)
+	if err != nil {
+		return nil, err
+	}
+	return toFloatArray(out), nil
+}
+
+// toCandles converts a script array to candles, each element is either a
+// candle map with open, high, low, close and volume keys or a number which is
+// used as every price of the candle
+func toCandles(obj objects.Object) ([]kline.Candle, error) {
+	var values []objects.Object
+	switch a := obj.(type) {
+	case *objects.Array:
+		values = a.Value
+	case *objects.ImmutableArray:
+		values = a.Value
+	default:
+		return nil, fmt.Errorf(ErrParameterConvertFailed, obj)
+	}
+
+	candles := make([]kline.Candle, len(values))
+	for x := range values {
+		var m map[string]objects.Object
+		switch v := values[x].(type) {
+		case *objects.Map:
+			m = v.Value
+		case *objects.ImmutableMap:
+			m = v.Value
+		default:
+			price, ok := objects.ToFloat64(v)
+			if !ok {
+				return nil, fmt.Errorf(ErrParameterConvertFailed, v)
+			}
+			candles[x] = kline.Candle{Open: price, High: price, Low: price, Close: price}
+			continue
+		}
+
+		closePrice, ok := candleValue(m, "close")
+		if !ok {
+			return nil, errors.New("candle close price is not set")
+		}
+		candles[x].Close = closePrice
+		candles[x].Open, ok = candleValue(m, "open")
+		if !ok {
+			candles[x].Open = closePrice
+		}
+		candles[x].High, ok = candleValue(m, "high")
+		if !ok {
+			candles[x].High = closePrice
+		}
+		candles[x].Low, ok = candleValue(m, "low")
+		if !ok {
+			candles[x].Low = closePrice
+		}
+		candles[x].Volume, _ = candleValue(m, "volume")
+	}
+	return candles, nil
+}
+
+func candleValue(m map[string]objects.Object, key string) (float64, bool) {
+	v, ok := m[key]
+	if !ok {
+		return 0, false
+	}
+	return objects.ToFloat64(v)
+}
+
+func toFloatArray(values []float64) *objects.Array {
+	out := &objects.Array{Value: make([]objects.Object, len(values))}
+	for x := range values {
+		out.Value[x] = &objects.Float{Value: values[x]}
+	}
+	return out
+}
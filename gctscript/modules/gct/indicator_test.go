@@ -0,0 +1,183 @@
+package gct
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/indicators"
+)
+
+var testCloses = []float64{44.34, 44.09, 44.15, 43.61, 44.33, 44.83, 45.10, 45.42,
+	45.84, 46.08, 45.89, 46.03, 45.61, 46.28, 46.28, 46.00, 46.03, 46.41, 46.22,
+	45.64}
+
+func closeArray() *objects.Array {
+	a := &objects.Array{}
+	for x := range testCloses {
+		a.Value = append(a.Value, &objects.Float{Value: testCloses[x]})
+	}
+	return a
+}
+
+func candleArray() *objects.Array {
+	a := &objects.Array{}
+	for x := range testCloses {
+		a.Value = append(a.Value, &objects.Map{Value: map[string]objects.Object{
+			"open":   &objects.Float{Value: testCloses[x]},
+			"high":   &objects.Float{Value: testCloses[x] + 0.5},
+			"low":    &objects.Float{Value: testCloses[x] - 0.5},
+			"close":  &objects.Float{Value: testCloses[x]},
+			"volume": &objects.Int{Value: 10},
+		}})
+	}
+	return a
+}
+
+func checkFloatArray(t *testing.T, obj objects.Object, expected []float64) {
+	t.Helper()
+	a, ok := obj.(*objects.Array)
+	if !ok {
+		t.Fatalf("expected array, received %T", obj)
+	}
+	if len(a.Value) != len(expected) {
+		t.Fatalf("expected %d values, received %d", len(expected), len(a.Value))
+	}
+	for x := range expected {
+		v, ok := a.Value[x].(*objects.Float)
+		if !ok || math.Abs(v.Value-expected[x]) > 1e-9 {
+			t.Errorf("value %d expected %v, received %v", x, expected[x], a.Value[x])
+		}
+	}
+}
+
+func TestIndicatorSMA(t *testing.T) {
+	t.Parallel()
+	expected, err := indicators.SMA(testCloses, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := IndicatorSMA(closeArray(), &objects.Int{Value: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkFloatArray(t, out, expected)
+
+	out, err = IndicatorSMA(candleArray(), &objects.Int{Value: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkFloatArray(t, out, expected)
+
+	_, err = IndicatorSMA(closeArray())
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+	_, err = IndicatorSMA(exch, &objects.Int{Value: 5})
+	if err == nil {
+		t.Error("expected error converting a string to candles")
+	}
+	_, err = IndicatorSMA(closeArray(), &objects.Int{Value: 50})
+	if !errors.Is(err, indicators.ErrInsufficientData) {
+		t.Error(err)
+	}
+}
+
+func TestIndicatorEMA(t *testing.T) {
+	t.Parallel()
+	expected, err := indicators.EMA(testCloses, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := IndicatorEMA(closeArray(), &objects.Int{Value: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkFloatArray(t, out, expected)
+}
+
+func TestIndicatorRSI(t *testing.T) {
+	t.Parallel()
+	expected, err := indicators.RSI(testCloses, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := IndicatorRSI(candleArray(), &objects.Int{Value: 14})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkFloatArray(t, out, expected)
+}
+
+func TestIndicatorMACD(t *testing.T) {
+	t.Parallel()
+	expected, err := indicators.MACD(testCloses, 3, 6, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := IndicatorMACD(closeArray(), &objects.Int{Value: 3},
+		&objects.Int{Value: 6}, &objects.Int{Value: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := out.(*objects.Map)
+	if !ok {
+		t.Fatalf("expected map, received %T", out)
+	}
+	checkFloatArray(t, m.Value["macd"], expected.MACD)
+	checkFloatArray(t, m.Value["signal"], expected.Signal)
+	checkFloatArray(t, m.Value["histogram"], expected.Histogram)
+
+	_, err = IndicatorMACD(closeArray(), &objects.Int{Value: 3})
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+}
+
+func TestIndicatorBollingerBands(t *testing.T) {
+	t.Parallel()
+	expected, err := indicators.BollingerBands(testCloses, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := IndicatorBollingerBands(closeArray(), &objects.Int{Value: 10},
+		&objects.Float{Value: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := out.(*objects.Map)
+	if !ok {
+		t.Fatalf("expected map, received %T", out)
+	}
+	checkFloatArray(t, m.Value["upper"], expected.Upper)
+	checkFloatArray(t, m.Value["middle"], expected.Middle)
+	checkFloatArray(t, m.Value["lower"], expected.Lower)
+}
+
+func TestIndicatorATR(t *testing.T) {
+	t.Parallel()
+	candles, err := toCandles(candleArray())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if candles[0].High != testCloses[0]+0.5 || candles[0].Volume != 10 {
+		t.Errorf("unexpected candle %+v", candles[0])
+	}
+	expected, err := indicators.ATR(candles, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := IndicatorATR(candleArray(), &objects.Int{Value: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkFloatArray(t, out, expected)
+
+	_, err = IndicatorATR(&objects.Array{Value: []objects.Object{
+		&objects.Map{Value: map[string]objects.Object{"open": &objects.Float{Value: 1}}},
+	}}, &objects.Int{Value: 5})
+	if err == nil {
+		t.Error("expected error for a candle without a close price")
+	}
+}
@@ -0,0 +1,136 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var (
+	// ErrExchangeNotAllowed is returned when a script uses an exchange that is
+	// not in its allow-list
+	ErrExchangeNotAllowed = errors.New("exchange is not allowed by script policy")
+	// ErrPairNotAllowed is returned when a script uses a currency pair that is
+	// not in its allow-list
+	ErrPairNotAllowed = errors.New("currency pair is not allowed by script policy")
+	// ErrWithdrawalsDisabled is returned when a script attempts a withdrawal
+	// and withdrawals are disabled
+	ErrWithdrawalsDisabled = errors.New("withdrawals are disabled by script policy")
+	// ErrOrderRateExceeded is returned when a script submits more orders in a
+	// minute than its policy allows
+	ErrOrderRateExceeded = errors.New("order rate exceeded script policy")
+)
+
+// PolicyEnforcer is implemented by wrappers that can restrict a script to
+// a Policy
+type PolicyEnforcer interface {
+	WithPolicy(p *Policy) GCT
+}
+
+// Policy restricts the exchanges, currency pairs and actions available to a
+// script, empty allow-lists and a zero order rate are unrestricted
+type Policy struct {
+	Script             string
+	Hash               string
+	Path               string
+	AllowedExchanges   []string
+	AllowedPairs       []currency.Pair
+	MaxOrdersPerMinute int
+	DisableWithdrawals bool
+
+	m      sync.Mutex
+	orders []time.Time
+}
+
+// CheckExchange returns an error if the exchange is not allowed
+func (p *Policy) CheckExchange(exch string) error {
+	if p == nil || len(p.AllowedExchanges) == 0 {
+		return nil
+	}
+	for x := range p.AllowedExchanges {
+		if strings.EqualFold(p.AllowedExchanges[x], exch) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%v: %s", ErrExchangeNotAllowed, exch)
+}
+
+// CheckPair returns an error if the exchange or currency pair is not allowed
+func (p *Policy) CheckPair(exch string, pair currency.Pair) error {
+	err := p.CheckExchange(exch)
+	if err != nil || p == nil || len(p.AllowedPairs) == 0 {
+		return err
+	}
+	for x := range p.AllowedPairs {
+		if p.AllowedPairs[x].Equal(pair) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%v: %s", ErrPairNotAllowed, pair)
+}
+
+// CheckWithdrawal returns an error if withdrawals are disabled or the
+// exchange is not allowed
+func (p *Policy) CheckWithdrawal(exch string) error {
+	if p != nil && p.DisableWithdrawals {
+		return ErrWithdrawalsDisabled
+	}
+	return p.CheckExchange(exch)
+}
+
+// CheckOrder returns an error if the exchange or currency pair is not allowed
+// or the order would exceed the order rate, an allowed order counts towards
+// the rate
+func (p *Policy) CheckOrder(exch string, pair currency.Pair) error {
+	return p.CheckOrders(exch, []currency.Pair{pair})
+}
+
+// CheckOrders returns an error if the exchange or any currency pair of a batch
+// of orders is not allowed or the batch would exceed the order rate, the
+// whole batch is checked before any order counts towards the rate
+func (p *Policy) CheckOrders(exch string, pairs []currency.Pair) error {
+	for x := range pairs {
+		if err := p.CheckPair(exch, pairs[x]); err != nil {
+			return err
+		}
+	}
+	if p == nil || p.MaxOrdersPerMinute <= 0 {
+		return nil
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+	now := time.Now()
+	cutoff := now.Add(-time.Minute)
+	var x int
+	for x < len(p.orders) && !p.orders[x].After(cutoff) {
+		x++
+	}
+	p.orders = p.orders[x:]
+	if len(p.orders)+len(pairs) > p.MaxOrdersPerMinute {
+		return fmt.Errorf("%v: %d orders per minute",
+			ErrOrderRateExceeded, p.MaxOrdersPerMinute)
+	}
+	for range pairs {
+		p.orders = append(p.orders, now)
+	}
+	return nil
+}
+
+// FilterExchanges returns the exchanges that are allowed
+func (p *Policy) FilterExchanges(exchanges []string) []string {
+	if p == nil || len(p.AllowedExchanges) == 0 {
+		return exchanges
+	}
+	var allowed []string
+	for x := range exchanges {
+		if p.CheckExchange(exchanges[x]) == nil {
+			allowed = append(allowed, exchanges[x])
+		}
+	}
+	return allowed
+}
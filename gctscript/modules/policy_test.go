@@ -0,0 +1,117 @@
+package modules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var (
+	btcusd = currency.NewPair(currency.BTC, currency.USD)
+	ltcusd = currency.NewPair(currency.LTC, currency.USD)
+)
+
+func TestPolicyUnrestricted(t *testing.T) {
+	t.Parallel()
+	for _, p := range []*Policy{nil, {}} {
+		if err := p.CheckExchange("bitstamp"); err != nil {
+			t.Error(err)
+		}
+		if err := p.CheckPair("bitstamp", btcusd); err != nil {
+			t.Error(err)
+		}
+		if err := p.CheckWithdrawal("bitstamp"); err != nil {
+			t.Error(err)
+		}
+		for x := 0; x < 100; x++ {
+			if err := p.CheckOrder("bitstamp", btcusd); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if e := p.FilterExchanges([]string{"bitstamp", "kraken"}); len(e) != 2 {
+			t.Errorf("expected all exchanges to be allowed, received %v", e)
+		}
+	}
+}
+
+func TestPolicyAllowLists(t *testing.T) {
+	t.Parallel()
+	p := &Policy{
+		AllowedExchanges: []string{"Bitstamp"},
+		AllowedPairs:     []currency.Pair{btcusd},
+	}
+	if err := p.CheckExchange("bitstamp"); err != nil {
+		t.Error(err)
+	}
+	if err := p.CheckExchange("kraken"); err == nil {
+		t.Error("expected exchange to be rejected")
+	}
+	if err := p.CheckPair("bitstamp", btcusd); err != nil {
+		t.Error(err)
+	}
+	if err := p.CheckPair("bitstamp", ltcusd); err == nil {
+		t.Error("expected pair to be rejected")
+	}
+	if err := p.CheckPair("kraken", btcusd); err == nil {
+		t.Error("expected exchange to be rejected")
+	}
+	if e := p.FilterExchanges([]string{"Bitstamp", "Kraken"}); len(e) != 1 || e[0] != "Bitstamp" {
+		t.Errorf("unexpected allowed exchanges %v", e)
+	}
+}
+
+func TestPolicyWithdrawals(t *testing.T) {
+	t.Parallel()
+	p := &Policy{DisableWithdrawals: true}
+	if err := p.CheckWithdrawal("bitstamp"); err != ErrWithdrawalsDisabled {
+		t.Errorf("expected %v, received %v", ErrWithdrawalsDisabled, err)
+	}
+}
+
+func TestPolicyOrderRate(t *testing.T) {
+	t.Parallel()
+	p := &Policy{MaxOrdersPerMinute: 2}
+	for x := 0; x < 2; x++ {
+		if err := p.CheckOrder("bitstamp", btcusd); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := p.CheckOrder("bitstamp", btcusd); err == nil {
+		t.Fatal("expected order rate to be exceeded")
+	}
+
+	// orders older than a minute no longer count towards the rate
+	p.orders[0] = p.orders[0].Add(-time.Minute)
+	if err := p.CheckOrder("bitstamp", btcusd); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPolicyOrderBatch(t *testing.T) {
+	t.Parallel()
+	p := &Policy{
+		AllowedPairs:       []currency.Pair{btcusd},
+		MaxOrdersPerMinute: 3,
+	}
+	err := p.CheckOrders("bitstamp", []currency.Pair{btcusd, ltcusd})
+	if err == nil {
+		t.Fatal("expected a pair which is not allowed to fail the batch")
+	}
+	if len(p.orders) != 0 {
+		t.Errorf("expected a refused batch to use no quota, used %d", len(p.orders))
+	}
+
+	if err = p.CheckOrders("bitstamp", []currency.Pair{btcusd, btcusd}); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.CheckOrders("bitstamp", []currency.Pair{btcusd, btcusd}); err == nil {
+		t.Fatal("expected the batch to exceed the order rate")
+	}
+	if len(p.orders) != 2 {
+		t.Errorf("expected only the allowed batch to use quota, used %d", len(p.orders))
+	}
+	if err = p.CheckOrder("bitstamp", btcusd); err != nil {
+		t.Error(err)
+	}
+}
@@ -1,9 +1,13 @@
 package modules
 
 import (
+	"time"
+
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -16,6 +20,7 @@ var Wrapper GCT
 // GCT interface requirements
 type GCT interface {
 	Exchange
+	Stream
 }
 
 // Exchange interface requirements
@@ -28,10 +33,46 @@ type Exchange interface {
 	QueryOrder(exch, orderid string) (*order.Detail, error)
 	SubmitOrder(exch string, submit *order.Submit) (*order.SubmitResponse, error)
 	CancelOrder(exch, orderid string) (bool, error)
+	SubmitOrders(exch string, submit []order.Submit) ([]order.SubmitResponse, error)
+	CancelOrders(exch string, orderIDs []string) (order.CancelAllResponse, error)
 	AccountInformation(exch string) (account.Holdings, error)
 	DepositAddress(exch string, currencyCode currency.Code) (string, error)
 	WithdrawalFiatFunds(exch, bankaccountid string, request *withdraw.FiatRequest) (out string, err error)
 	WithdrawalCryptoFunds(exch string, request *withdraw.CryptoRequest) (out string, err error)
+	HistoricCandles(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error)
+	TradeHistory(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]order.TradeHistory, error)
+	OrderHistory(exch string, request *order.GetOrdersRequest) ([]order.Detail, error)
+	ActiveOrders(exch string, request *order.GetOrdersRequest) ([]order.Detail, error)
+	FundingHistory(exch string, start, end time.Time) ([]FundHistory, error)
+}
+
+// Stream interface requirements for subscribing to data updates
+type Stream interface {
+	SubscribeTicker(exch string, pair currency.Pair, item asset.Item) (dispatch.Pipe, error)
+	SubscribeOrderbook(exch string, pair currency.Pair, item asset.Item) (dispatch.Pipe, error)
+	SubscribeAccount(exch string) (dispatch.Pipe, error)
+	SubscribeOrders(exch string) (dispatch.Pipe, error)
+	OrderUpdate(data interface{}) (*order.Detail, error)
+}
+
+// FundHistory holds a deposit or withdrawal made on an exchange, it matches
+// exchange.FundHistory which cannot be imported by the modules without an
+// import cycle
+type FundHistory struct {
+	ExchangeName      string
+	Status            string
+	TransferID        string
+	Description       string
+	Timestamp         time.Time
+	Currency          string
+	Amount            float64
+	Fee               float64
+	TransferType      string
+	CryptoToAddress   string
+	CryptoFromAddress string
+	CryptoTxID        string
+	BankTo            string
+	BankFrom          string
 }
 
 // SetModuleWrapper link the wrapper and interface to use for modules
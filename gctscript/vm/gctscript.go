@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
 )
@@ -44,6 +45,34 @@ func Validate(file string) (err error) {
 	return tempVM.Run()
 }
 
+// Test runs a script once against the wrapper in place of the live exchanges
+// and reports if it passed. The run is isolated from running scripts, it does
+// not subscribe to updates, record script events or share stored state
+func Test(file string, w modules.GCT) (*TestResult, error) {
+	tempVM := NewVM()
+	if tempVM == nil {
+		return nil, ErrNoVMLoaded
+	}
+	tempVM.testWrapper = w
+	err := tempVM.Load(file)
+	if err != nil {
+		return nil, err
+	}
+	defer tempVM.events.terminate()
+
+	result := &TestResult{Script: tempVM.ShortName()}
+	err = tempVM.Compile()
+	if err == nil {
+		err = tempVM.RunCtx()
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	result.Assertions, result.Failures = tempVM.assert.results()
+	result.Passed = err == nil && len(result.Failures) == 0
+	return result, nil
+}
+
 // ShutdownAll shutdown all
 func ShutdownAll() (err error) {
 	if GCTScriptConfig.Verbose {
@@ -15,6 +15,15 @@ type Config struct {
 	AllowImports       bool          `json:"allow_imports"`
 	AutoLoad           []string      `json:"auto_load"`
 	Verbose            bool          `json:"verbose"`
+
+	// Resource policies applied to each virtual machine, zero values are
+	// unlimited and empty allow-lists allow everything
+	MaxAllocations     int64    `json:"max_allocations"`
+	MaxInstructions    int64    `json:"max_instructions"`
+	MaxOrdersPerMinute int      `json:"max_orders_per_minute"`
+	AllowedExchanges   []string `json:"allowed_exchanges"`
+	AllowedPairs       []string `json:"allowed_pairs"`
+	DisableWithdrawals bool     `json:"disable_withdrawals"`
 }
 
 // Error interface to meet error requirements
@@ -15,6 +15,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	scriptevent "github.com/thrasher-corp/gocryptotrader/database/repository/script"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/loader"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
 	log "github.com/thrasher-corp/gocryptotrader/logger"
@@ -85,9 +86,41 @@ func (vm *VM) Load(file string) error {
 
 	vm.File = file
 	vm.Path = filepath.Dir(file)
-	vm.Script = tengo.NewScript(code)
-	vm.Script.SetImports(loader.GetModuleMap())
 	vm.Hash = vm.getHash()
+	vm.policy, err = vm.newPolicy()
+	if err != nil {
+		return &Error{
+			Action: "Load: Policy",
+			Script: file,
+			Cause:  err,
+		}
+	}
+	vm.events = newEventHandler(vm.ShortName(), vm.wrapper)
+	vm.events.testing = vm.testWrapper != nil
+	vm.assert = newAssertions(vm.ShortName(), vm.testWrapper == nil)
+	state := &stateStore{script: vm.ShortName()}
+	if vm.testWrapper != nil {
+		state.isolated = &scriptState{values: make(map[string]map[string]StateValue)}
+	}
+	modules := loader.GetModuleMap()
+	modules.AddBuiltinModule("exchange", gct.ExchangeModule(vm.wrapper))
+	modules.AddBuiltinModule(eventsModule, vm.events.module())
+	modules.AddBuiltinModule(stateModule, newStateModule(state))
+	modules.AddBuiltinModule(assertModule, vm.assert.module())
+	source := vm.instrumentSource(code)
+	if importsEvents(code) {
+		source = append(source, eventLoop...)
+	}
+	vm.Script = tengo.NewScript(source)
+	vm.Script.SetImports(modules)
+	err = vm.setLimits()
+	if err != nil {
+		return &Error{
+			Action: "Load: Limits",
+			Script: file,
+			Cause:  err,
+		}
+	}
 
 	if GCTScriptConfig.AllowImports {
 		if GCTScriptConfig.Verbose {
@@ -130,16 +163,34 @@ func (vm *VM) RunCtx() (err error) {
 		vm.ctx = context.Background()
 	}
 
-	ct, cancel := context.WithTimeout(vm.ctx, GCTScriptConfig.ScriptTimeout)
+	ct, cancel := context.WithCancel(vm.ctx)
 	defer cancel()
 
+	// The timeout and instruction budget apply to the top level code and to
+	// each event callback separately, time spent waiting for events is not
+	// counted
+	w := newWatchdog(GCTScriptConfig.ScriptTimeout, cancel)
+	vm.budget.reset()
+	if vm.events != nil {
+		vm.events.begin(ct.Done(), w, vm.budget)
+		defer vm.events.stop()
+	}
+
 	if GCTScriptConfig.Verbose {
 		log.Debugf(log.GCTScriptMgr, "Running script: %s ID: %v", vm.ShortName(), vm.ID)
 	}
 
 	err = vm.Compiled.RunContext(ct)
+	if w.stop() {
+		err = context.DeadlineExceeded
+	}
 	if err != nil {
-		vm.event(StatusFailure, TypeExecute)
+		if vm.violated(err) {
+			log.Warnf(log.GCTScriptMgr, "Script %s resource limit violation: %v\n", vm.ShortName(), err)
+			vm.event(StatusViolation, TypeExecute)
+		} else {
+			vm.event(StatusFailure, TypeExecute)
+		}
 		return Error{
 			Action: "RunCtx",
 			Cause:  err,
@@ -173,6 +224,10 @@ func (vm *VM) CompileAndRun() {
 		}
 		return
 	}
+	if vm.events != nil && vm.events.isShutdown() {
+		// stopped while waiting on events
+		return
+	}
 	if vm.Compiled.Get("timer").String() != "" {
 		vm.T, err = time.ParseDuration(vm.Compiled.Get("timer").String())
 		if err != nil {
@@ -209,6 +264,9 @@ func (vm *VM) Shutdown() error {
 	if vm.S != nil {
 		close(vm.S)
 	}
+	if vm.events != nil {
+		vm.events.terminate()
+	}
 	if GCTScriptConfig.Verbose {
 		log.Debugf(log.GCTScriptMgr, "Shutting down script: %s ID: %v", vm.ShortName(), vm.ID)
 	}
@@ -238,7 +296,7 @@ func (vm *VM) ShortName() string {
 }
 
 func (vm *VM) event(status, executionType string) {
-	if validator.IsTestExecution.Load() == true {
+	if validator.IsTestExecution.Load() == true || vm.testWrapper != nil {
 		return
 	}
 
@@ -0,0 +1,164 @@
+package vm
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// assertModule is the name scripts import to make assertions
+const assertModule = "assert"
+
+func newAssertions(script string, logFailures bool) *assertions {
+	return &assertions{script: script, logFailures: logFailures}
+}
+
+// module returns the assert module for the script, every function returns if
+// the assertion held and takes an optional message describing it
+func (a *assertions) module() map[string]tengo.Object {
+	return map[string]tengo.Object{
+		"equal":     &tengo.UserFunction{Name: "equal", Value: a.equal},
+		"not_equal": &tengo.UserFunction{Name: "not_equal", Value: a.notEqual},
+		"is_true":   &tengo.UserFunction{Name: "is_true", Value: a.isTrue},
+		"is_false":  &tengo.UserFunction{Name: "is_false", Value: a.isFalse},
+		"near":      &tengo.UserFunction{Name: "near", Value: a.near},
+		"fail":      &tengo.UserFunction{Name: "fail", Value: a.fail},
+	}
+}
+
+// equal asserts the values are equal, ints and floats of the same value are
+// equal
+// args: expected, actual, message (optional)
+func (a *assertions) equal(args ...tengo.Object) (tengo.Object, error) {
+	msg, err := message(2, args...)
+	if err != nil {
+		return nil, err
+	}
+	return a.check(objectsEqual(args[0], args[1]), msg,
+		"expected %s received %s", args[0], args[1]), nil
+}
+
+// notEqual asserts the values are not equal
+// args: expected, actual, message (optional)
+func (a *assertions) notEqual(args ...tengo.Object) (tengo.Object, error) {
+	msg, err := message(2, args...)
+	if err != nil {
+		return nil, err
+	}
+	return a.check(!objectsEqual(args[0], args[1]), msg,
+		"expected a value other than %s", args[0]), nil
+}
+
+// isTrue asserts the value is truthy
+// args: value, message (optional)
+func (a *assertions) isTrue(args ...tengo.Object) (tengo.Object, error) {
+	msg, err := message(1, args...)
+	if err != nil {
+		return nil, err
+	}
+	return a.check(!args[0].IsFalsy(), msg, "expected true received %s", args[0]), nil
+}
+
+// isFalse asserts the value is falsy
+// args: value, message (optional)
+func (a *assertions) isFalse(args ...tengo.Object) (tengo.Object, error) {
+	msg, err := message(1, args...)
+	if err != nil {
+		return nil, err
+	}
+	return a.check(args[0].IsFalsy(), msg, "expected false received %s", args[0]), nil
+}
+
+// near asserts the numbers differ by no more than the tolerance
+// args: expected, actual, tolerance, message (optional)
+func (a *assertions) near(args ...tengo.Object) (tengo.Object, error) {
+	msg, err := message(3, args...)
+	if err != nil {
+		return nil, err
+	}
+	var values [3]float64
+	for x := range values {
+		var ok bool
+		values[x], ok = tengo.ToFloat64(args[x])
+		if !ok {
+			return nil, fmt.Errorf(gct.ErrParameterConvertFailed, args[x])
+		}
+	}
+	return a.check(math.Abs(values[0]-values[1]) <= values[2], msg,
+		"expected %s within %s received %s", args[0], args[2], args[1]), nil
+}
+
+// fail records a failed assertion
+// args: message (optional)
+func (a *assertions) fail(args ...tengo.Object) (tengo.Object, error) {
+	msg, err := message(0, args...)
+	if err != nil {
+		return nil, err
+	}
+	return a.check(false, msg, "failed"), nil
+}
+
+// check records the assertion, the failure is described by the message when
+// the script supplies one
+func (a *assertions) check(passed bool, msg, format string, args ...interface{}) tengo.Object {
+	a.m.Lock()
+	a.count++
+	if passed {
+		a.m.Unlock()
+		return tengo.TrueValue
+	}
+	failure := fmt.Sprintf(format, args...)
+	if msg != "" {
+		failure = msg + ": " + failure
+	}
+	a.failures = append(a.failures, failure)
+	a.m.Unlock()
+
+	if a.logFailures {
+		log.Warnf(log.GCTScriptMgr, "Script %s assertion failed: %s\n", a.script, failure)
+	}
+	return tengo.FalseValue
+}
+
+// results returns the number of assertions made and the failures
+func (a *assertions) results() (count int, failures []string) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.count, append([]string(nil), a.failures...)
+}
+
+// message returns the optional message following the required arguments
+func message(required int, args ...tengo.Object) (string, error) {
+	if len(args) != required && len(args) != required+1 {
+		return "", tengo.ErrWrongNumArguments
+	}
+	if len(args) == required {
+		return "", nil
+	}
+	msg, ok := tengo.ToString(args[required])
+	if !ok {
+		return "", fmt.Errorf(gct.ErrParameterConvertFailed, args[required])
+	}
+	return msg, nil
+}
+
+func objectsEqual(a, b tengo.Object) bool {
+	if isNumber(a) && isNumber(b) {
+		x, _ := tengo.ToFloat64(a)
+		y, _ := tengo.ToFloat64(b)
+		return x == y
+	}
+	return a.Equals(b)
+}
+
+func isNumber(o tengo.Object) bool {
+	switch o.(type) {
+	case *tengo.Int, *tengo.Float:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,105 @@
+package vm
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/fixture"
+)
+
+var (
+	testFixture           = filepath.Join("..", "..", "testdata", "gctscript", "fixture.json")
+	testHarnessScript     = filepath.Join("..", "..", "testdata", "gctscript", "harness.gct")
+	testHarnessFailScript = filepath.Join("..", "..", "testdata", "gctscript", "harness_fail.gct")
+)
+
+func TestAssertModule(t *testing.T) {
+	t.Parallel()
+	a := newAssertions(scriptName, false)
+	msg := &tengo.String{Value: "message"}
+
+	for _, tc := range []struct {
+		fn     func(...tengo.Object) (tengo.Object, error)
+		args   []tengo.Object
+		passed bool
+	}{
+		{a.equal, []tengo.Object{&tengo.Int{Value: 1}, &tengo.Float{Value: 1}}, true},
+		{a.equal, []tengo.Object{&tengo.String{Value: "a"}, &tengo.String{Value: "b"}, msg}, false},
+		{a.notEqual, []tengo.Object{&tengo.Int{Value: 1}, &tengo.Int{Value: 2}}, true},
+		{a.isTrue, []tengo.Object{tengo.TrueValue}, true},
+		{a.isTrue, []tengo.Object{tengo.UndefinedValue}, false},
+		{a.isFalse, []tengo.Object{tengo.FalseValue, msg}, true},
+		{a.near, []tengo.Object{&tengo.Float{Value: 1}, &tengo.Float{Value: 1.05}, &tengo.Float{Value: 0.1}}, true},
+		{a.near, []tengo.Object{&tengo.Float{Value: 1}, &tengo.Float{Value: 1.5}, &tengo.Float{Value: 0.1}}, false},
+		{a.fail, []tengo.Object{msg}, false},
+	} {
+		r, err := tc.fn(tc.args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if passed := !r.IsFalsy(); passed != tc.passed {
+			t.Errorf("%v expected passed %v received %v", tc.args, tc.passed, passed)
+		}
+	}
+
+	count, failures := a.results()
+	if count != 9 || len(failures) != 4 {
+		t.Errorf("expected 9 assertions and 4 failures received %v %v", count, failures)
+	}
+	if failures[0] != `message: expected "a" received "b"` {
+		t.Errorf("unexpected failure message %q", failures[0])
+	}
+
+	if _, err := a.equal(tengo.TrueValue); err != tengo.ErrWrongNumArguments {
+		t.Errorf("expected %v received %v", tengo.ErrWrongNumArguments, err)
+	}
+	if _, err := a.near(&tengo.String{Value: "a"}, &tengo.Int{}, &tengo.Int{}); err == nil {
+		t.Error("expected non numeric value to return an error")
+	}
+}
+
+func TestTestScript(t *testing.T) {
+	w, err := fixture.LoadFile(testFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// state from a previous test must not leak in to the tested script
+	_, err = (&stateStore{script: "harness.gct"}).set(&tengo.String{Value: "runs"}, &tengo.Int{Value: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := Test(testHarnessScript, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Passed || r.Error != "" || r.Assertions != 4 || len(r.Failures) != 0 {
+		t.Errorf("expected script to pass received %+v", r)
+	}
+	orders := w.Orders()
+	if len(orders) != 1 || orders[0].OrderSide != order.Buy || orders[0].Price != 10001 {
+		t.Errorf("unexpected submitted orders %+v", orders)
+	}
+
+	r, err = Test(testHarnessFailScript, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Passed || r.Assertions != 2 || len(r.Failures) != 2 {
+		t.Errorf("expected assertions to fail received %+v", r)
+	}
+
+	r, err = Test(testBrokenScript, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Passed || r.Error == "" {
+		t.Errorf("expected broken script to fail received %+v", r)
+	}
+
+	if _, err = Test("missing.gct", w); err == nil {
+		t.Error("expected missing script to return an error")
+	}
+}
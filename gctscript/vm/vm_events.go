@@ -0,0 +1,463 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+)
+
+// eventsModule is the name scripts import to subscribe to data updates
+const eventsModule = "events"
+
+// eventLoop is appended to scripts which import the events module and
+// delivers subscribed updates to their callbacks once the top level code has
+// run, it exits straight away if the script has not subscribed to anything
+const eventLoop = `
+__gct_events := import("` + eventsModule + `")
+for __gct_event := __gct_events.wait(); !is_undefined(__gct_event); __gct_event = __gct_events.wait() {
+	__gct_event.fn(__gct_event.data)
+}
+`
+
+var errEventsShutdown = errors.New("virtual machine is shutting down")
+
+func newEventHandler(script string, wrapper func() modules.GCT) *eventHandler {
+	return &eventHandler{
+		script:   script,
+		wrapper:  wrapper,
+		subs:     make(map[string]*subscription),
+		pending:  make(map[string]*pendingEvent),
+		notify:   make(chan struct{}, 1),
+		shutdown: make(chan struct{}),
+	}
+}
+
+// module returns the events module for the script
+func (e *eventHandler) module() map[string]tengo.Object {
+	return map[string]tengo.Object{
+		"on_ticker":         &tengo.UserFunction{Name: "on_ticker", Value: e.onTicker},
+		"on_orderbook":      &tengo.UserFunction{Name: "on_orderbook", Value: e.onOrderbook},
+		"on_order_update":   &tengo.UserFunction{Name: "on_order_update", Value: e.onOrderUpdate},
+		"on_account_change": &tengo.UserFunction{Name: "on_account_change", Value: e.onAccountChange},
+		"stop":              &tengo.UserFunction{Name: "stop", Value: e.stopEvents},
+		"wait":              &tengo.UserFunction{Name: "wait", Value: e.wait},
+	}
+}
+
+// onTicker registers a callback for ticker updates
+// args: exchange, pair, asset, fn
+func (e *eventHandler) onTicker(args ...tengo.Object) (tengo.Object, error) {
+	exch, pair, item, fn, err := pairArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	pipe, err := e.wrapper().SubscribeTicker(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.register(pairKey("ticker", exch, pair, item), fn, pipe,
+		func(data interface{}) (tengo.Object, string, error) {
+			p, ok := data.(ticker.Price)
+			if !ok {
+				return nil, "", fmt.Errorf("unexpected ticker type %T", data)
+			}
+			return gct.TickerToObject(&p), "", nil
+		})
+}
+
+// onOrderbook registers a callback for orderbook updates
+// args: exchange, pair, asset, fn
+func (e *eventHandler) onOrderbook(args ...tengo.Object) (tengo.Object, error) {
+	exch, pair, item, fn, err := pairArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	pipe, err := e.wrapper().SubscribeOrderbook(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.register(pairKey("orderbook", exch, pair, item), fn, pipe,
+		func(data interface{}) (tengo.Object, string, error) {
+			ob, ok := data.(orderbook.Base)
+			if !ok {
+				return nil, "", fmt.Errorf("unexpected orderbook type %T", data)
+			}
+			return gct.OrderbookToObject(&ob), "", nil
+		})
+}
+
+// onOrderUpdate registers a callback for order updates, pending updates are
+// coalesced per order so each changed order is delivered
+// args: exchange, fn
+func (e *eventHandler) onOrderUpdate(args ...tengo.Object) (tengo.Object, error) {
+	exch, fn, err := exchangeArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	w := e.wrapper()
+	pipe, err := w.SubscribeOrders(exch)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.register("order|"+strings.ToLower(exch), fn, pipe,
+		func(data interface{}) (tengo.Object, string, error) {
+			d, errUpdate := w.OrderUpdate(data)
+			if errUpdate != nil {
+				return nil, "", errUpdate
+			}
+			return gct.OrderToObject(d), d.ID, nil
+		})
+}
+
+// onAccountChange registers a callback for account holdings updates
+// args: exchange, fn
+func (e *eventHandler) onAccountChange(args ...tengo.Object) (tengo.Object, error) {
+	exch, fn, err := exchangeArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	pipe, err := e.wrapper().SubscribeAccount(exch)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.register("account|"+strings.ToLower(exch), fn, pipe,
+		func(data interface{}) (tengo.Object, string, error) {
+			h, ok := data.(account.Holdings)
+			if !ok {
+				return nil, "", fmt.Errorf("unexpected account type %T", data)
+			}
+			return gct.AccountToObject(&h), "", nil
+		})
+}
+
+// stopEvents removes all subscriptions allowing the script to finish
+func (e *eventHandler) stopEvents(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 0 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	e.stop()
+	return tengo.UndefinedValue, nil
+}
+
+// wait blocks until an update is pending and returns it with its callback, it
+// returns undefined when there are no subscriptions, the virtual machine is
+// shutting down or the run has been cancelled. The watchdog is paused while
+// waiting so the script timeout only applies to running script code, and the
+// timeout and instruction budget restart for each callback
+func (e *eventHandler) wait(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 0 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	e.m.Lock()
+	done, w, b := e.done, e.watchdog, e.budget
+	e.m.Unlock()
+
+	w.pause()
+	for {
+		fn, data, ok := e.next()
+		if !ok {
+			return tengo.UndefinedValue, nil
+		}
+		if fn != nil {
+			w.resume()
+			b.reset()
+			return &tengo.Map{Value: map[string]tengo.Object{
+				"fn":   fn,
+				"data": data,
+			}}, nil
+		}
+
+		select {
+		case <-e.notify:
+		case <-e.shutdown:
+			return tengo.UndefinedValue, nil
+		case <-done:
+			return tengo.UndefinedValue, nil
+		}
+	}
+}
+
+// next pops the oldest pending update, ok is false when there are no
+// subscriptions left to wait on
+func (e *eventHandler) next() (fn, data tengo.Object, ok bool) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	if len(e.subs) == 0 {
+		return nil, nil, false
+	}
+	for len(e.queue) > 0 {
+		ev := e.pending[e.queue[0]]
+		delete(e.pending, e.queue[0])
+		e.queue = e.queue[1:]
+		if s, found := e.subs[ev.key]; found {
+			return s.fn, ev.data, true
+		}
+	}
+	return nil, nil, true
+}
+
+// register stores the callback for the subscription key and starts relaying
+// updates from the pipe, registering an existing key replaces its callback
+func (e *eventHandler) register(key string, fn tengo.Object, pipe dispatch.Pipe, convert func(interface{}) (tengo.Object, string, error)) (tengo.Object, error) {
+	if validator.IsTestExecution.Load() == true || e.testing {
+		// tested scripts run once without waiting on updates
+		return tengo.UndefinedValue, nil
+	}
+
+	e.m.Lock()
+	select {
+	case <-e.shutdown:
+		e.m.Unlock()
+		releasePipe(&pipe)
+		return nil, errEventsShutdown
+	default:
+	}
+
+	if s, ok := e.subs[key]; ok {
+		s.fn = fn
+		e.m.Unlock()
+		releasePipe(&pipe)
+		return tengo.UndefinedValue, nil
+	}
+
+	s := &subscription{
+		fn:      fn,
+		pipe:    pipe,
+		convert: convert,
+		done:    make(chan struct{}),
+	}
+	e.subs[key] = s
+	e.wg.Add(1)
+	e.m.Unlock()
+
+	go e.relay(key, s)
+	return tengo.UndefinedValue, nil
+}
+
+// relay reads updates from the subscription pipe until it is removed
+func (e *eventHandler) relay(key string, s *subscription) {
+	defer e.wg.Done()
+	for {
+		select {
+		case data, ok := <-s.pipe.C:
+			if !ok {
+				return
+			}
+			e.push(key, s, *data.(*interface{}))
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// push queues an update for delivery, replacing any undelivered update for the
+// same stream
+func (e *eventHandler) push(key string, s *subscription, data interface{}) {
+	obj, id, err := s.convert(data)
+	if err != nil {
+		log.Errorf(log.GCTScriptMgr, "Script %s %s event error: %v\n", e.script, key, err)
+		return
+	}
+
+	pendingKey := key
+	if id != "" {
+		pendingKey += "|" + id
+	}
+
+	e.m.Lock()
+	if e.subs[key] != s {
+		e.m.Unlock()
+		return
+	}
+	if _, ok := e.pending[pendingKey]; !ok {
+		if len(e.queue) >= DefaultMaxPendingEvents {
+			e.m.Unlock()
+			log.Warnf(log.GCTScriptMgr, "Script %s has %d pending events, dropping %s update\n",
+				e.script, DefaultMaxPendingEvents, key)
+			return
+		}
+		e.queue = append(e.queue, pendingKey)
+	}
+	e.pending[pendingKey] = &pendingEvent{key: key, data: obj}
+	e.m.Unlock()
+
+	select {
+	case e.notify <- struct{}{}:
+	default:
+	}
+}
+
+// begin sets the context, watchdog and instruction budget of the current
+// script run
+func (e *eventHandler) begin(done <-chan struct{}, w *watchdog, b *instructionBudget) {
+	e.m.Lock()
+	e.done = done
+	e.watchdog = w
+	e.budget = b
+	e.m.Unlock()
+}
+
+// stop removes all subscriptions and pending updates
+func (e *eventHandler) stop() {
+	e.m.Lock()
+	subs := e.subs
+	e.subs = make(map[string]*subscription)
+	e.pending = make(map[string]*pendingEvent)
+	e.queue = nil
+	e.m.Unlock()
+
+	for _, s := range subs {
+		close(s.done)
+		releasePipe(&s.pipe)
+	}
+	select {
+	case e.notify <- struct{}{}:
+	default:
+	}
+}
+
+// terminate stops the handler permanently, waking any script waiting on an
+// update
+func (e *eventHandler) terminate() {
+	e.once.Do(func() {
+		e.m.Lock()
+		close(e.shutdown)
+		e.m.Unlock()
+	})
+	e.stop()
+	e.wg.Wait()
+}
+
+// isShutdown returns if the handler has been closed
+func (e *eventHandler) isShutdown() bool {
+	select {
+	case <-e.shutdown:
+		return true
+	default:
+		return false
+	}
+}
+
+func releasePipe(p *dispatch.Pipe) {
+	if p.C == nil {
+		return
+	}
+	err := p.Release()
+	if err != nil {
+		log.Errorf(log.GCTScriptMgr, "Failed to release event pipe: %v\n", err)
+	}
+}
+
+func pairKey(stream, exch string, pair currency.Pair, item asset.Item) string {
+	return stream + "|" + strings.ToLower(exch) + "|" +
+		pair.Base.Upper().String() + pair.Quote.Upper().String() + "|" +
+		strings.ToLower(item.String())
+}
+
+func pairArgs(args ...tengo.Object) (exch string, pair currency.Pair, item asset.Item, fn tengo.Object, err error) {
+	if len(args) != 4 {
+		err = tengo.ErrWrongNumArguments
+		return
+	}
+
+	exch, ok := tengo.ToString(args[0])
+	if !ok {
+		err = fmt.Errorf(gct.ErrParameterConvertFailed, args[0])
+		return
+	}
+	p, ok := tengo.ToString(args[1])
+	if !ok {
+		err = fmt.Errorf(gct.ErrParameterConvertFailed, args[1])
+		return
+	}
+	a, ok := tengo.ToString(args[2])
+	if !ok {
+		err = fmt.Errorf(gct.ErrParameterConvertFailed, args[2])
+		return
+	}
+	if !args[3].CanCall() {
+		err = fmt.Errorf(gct.ErrParameterConvertFailed, args[3])
+		return
+	}
+	return exch, currency.NewPairFromString(p), asset.Item(a), args[3], nil
+}
+
+func exchangeArgs(args ...tengo.Object) (exch string, fn tengo.Object, err error) {
+	if len(args) != 2 {
+		err = tengo.ErrWrongNumArguments
+		return
+	}
+
+	exch, ok := tengo.ToString(args[0])
+	if !ok {
+		err = fmt.Errorf(gct.ErrParameterConvertFailed, args[0])
+		return
+	}
+	if !args[1].CanCall() {
+		err = fmt.Errorf(gct.ErrParameterConvertFailed, args[1])
+		return
+	}
+	return exch, args[1], nil
+}
+
+func newWatchdog(timeout time.Duration, cancel context.CancelFunc) *watchdog {
+	w := &watchdog{timeout: timeout}
+	w.timer = time.AfterFunc(timeout, func() {
+		w.m.Lock()
+		w.expired = true
+		w.m.Unlock()
+		cancel()
+	})
+	return w
+}
+
+// pause stops the watchdog from counting
+func (w *watchdog) pause() {
+	if w == nil {
+		return
+	}
+	w.timer.Stop()
+}
+
+// resume restarts the watchdog with the full timeout
+func (w *watchdog) resume() {
+	if w == nil {
+		return
+	}
+	w.m.Lock()
+	if !w.expired {
+		w.timer.Reset(w.timeout)
+	}
+	w.m.Unlock()
+}
+
+// stop stops the watchdog and returns if it cancelled the run
+func (w *watchdog) stop() bool {
+	w.timer.Stop()
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.expired
+}
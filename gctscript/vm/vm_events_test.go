@@ -0,0 +1,247 @@
+package vm
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+var testEventScript = filepath.Join("..", "..", "testdata", "gctscript", "events.gct")
+
+// eventWrapper serves ticker subscriptions from a test mux
+type eventWrapper struct {
+	validator.Wrapper
+	mux *dispatch.Mux
+	id  uuid.UUID
+}
+
+func (w eventWrapper) SubscribeTicker(_ string, _ currency.Pair, _ asset.Item) (dispatch.Pipe, error) {
+	return w.mux.Subscribe(w.id)
+}
+
+func testSubscription(e *eventHandler, key string) *subscription {
+	s := &subscription{
+		fn: &tengo.UserFunction{Name: key},
+		convert: func(data interface{}) (tengo.Object, string, error) {
+			d := data.([2]int)
+			id := ""
+			if d[0] != 0 {
+				id = strconv.Itoa(d[0])
+			}
+			return &tengo.Int{Value: int64(d[1])}, id, nil
+		},
+		done: make(chan struct{}),
+	}
+	e.subs[key] = s
+	return s
+}
+
+func waitEvent(t *testing.T, e *eventHandler) int64 {
+	t.Helper()
+	r, err := e.wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := r.(*tengo.Map)
+	if !ok {
+		t.Fatalf("expected event received %v", r)
+	}
+	return m.Value["data"].(*tengo.Int).Value
+}
+
+func TestEventHandler(t *testing.T) {
+	e := newEventHandler(scriptName, wrappers.GetWrapper)
+	r, err := e.wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != tengo.UndefinedValue {
+		t.Fatal("expected wait to return undefined without subscriptions")
+	}
+
+	ticks := testSubscription(e, "ticker")
+	orders := testSubscription(e, "order")
+	e.push("ticker", ticks, [2]int{0, 1})
+	e.push("order", orders, [2]int{1, 10})
+	e.push("ticker", ticks, [2]int{0, 2})
+	e.push("order", orders, [2]int{2, 20})
+	e.push("order", orders, [2]int{1, 11})
+
+	for _, expected := range []int64{2, 11, 20} {
+		if v := waitEvent(t, e); v != expected {
+			t.Errorf("expected event %v received %v", expected, v)
+		}
+	}
+
+	for x := 0; x <= DefaultMaxPendingEvents; x++ {
+		e.push("order", orders, [2]int{x + 1, x})
+	}
+	if len(e.queue) != DefaultMaxPendingEvents {
+		t.Errorf("expected %v pending events received %v", DefaultMaxPendingEvents, len(e.queue))
+	}
+
+	e.stop()
+	r, err = e.wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != tengo.UndefinedValue {
+		t.Fatal("expected wait to return undefined once stopped")
+	}
+}
+
+func TestEventHandlerTerminate(t *testing.T) {
+	e := newEventHandler(scriptName, wrappers.GetWrapper)
+	testSubscription(e, "ticker")
+
+	result := make(chan tengo.Object, 1)
+	go func() {
+		r, _ := e.wait()
+		result <- r
+	}()
+	e.terminate()
+
+	select {
+	case r := <-result:
+		if r != tengo.UndefinedValue {
+			t.Fatalf("expected undefined received %v", r)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("wait was not released on terminate")
+	}
+
+	if !e.isShutdown() {
+		t.Fatal("expected handler to be shutdown")
+	}
+	_, err := e.register("ticker", &tengo.UserFunction{}, dispatch.Pipe{}, nil)
+	if err != errEventsShutdown {
+		t.Fatalf("expected %v received %v", errEventsShutdown, err)
+	}
+}
+
+func TestWatchdog(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := newWatchdog(time.Millisecond*50, cancel)
+	w.pause()
+	time.Sleep(time.Millisecond * 100)
+	if ctx.Err() != nil {
+		t.Fatal("watchdog should not expire while paused")
+	}
+
+	w.resume()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second * 5):
+		t.Fatal("watchdog did not expire")
+	}
+	if !w.stop() {
+		t.Fatal("expected watchdog to report expiry")
+	}
+}
+
+func TestVMEvents(t *testing.T) {
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	mux := dispatch.GetNewMux()
+	id, err := mux.GetID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	modules.SetModuleWrapper(eventWrapper{mux: mux, id: id})
+	defer modules.SetModuleWrapper(nil)
+
+	testVM := New()
+	err = testVM.Load(testEventScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- testVM.RunCtx()
+	}()
+
+	timeout := time.After(time.Second * 10)
+loop:
+	for {
+		select {
+		case err = <-errs:
+			if err != nil {
+				t.Fatal(err)
+			}
+			break loop
+		case <-time.After(time.Millisecond * 10):
+			err = mux.Publish([]uuid.UUID{id}, &ticker.Price{Last: 1337})
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-timeout:
+			t.Fatal("script did not receive ticker event")
+		}
+	}
+
+	if last := testVM.Compiled.Get("last").Float(); last != 1337 {
+		t.Errorf("expected last price 1337 received %v", last)
+	}
+	err = testVM.Shutdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateEvents(t *testing.T) {
+	err := Validate(testEventScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestImportsEvents(t *testing.T) {
+	t.Parallel()
+	if importsEvents([]byte("fmt := import(\"fmt\")\nfmt.println(1)\n")) {
+		t.Error("expected a script without the events module to have no event loop")
+	}
+	if !importsEvents([]byte("f := func() {\n\tevents := import(\"events\")\n\tevents.stop()\n}\n")) {
+		t.Error("expected a script importing the events module to have an event loop")
+	}
+	if importsEvents([]byte("for {")) {
+		t.Error("expected invalid source to have no event loop")
+	}
+
+	testVM := New()
+	err := testVM.Load(testScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if testVM.Compiled.IsDefined("__gct_events") {
+		t.Error("expected the event loop not to be added to a script without events")
+	}
+	err = RemoveVM(testVM.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,272 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/parser"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+)
+
+// instructionFunc is the global function instrumented scripts call to count
+// instructions
+const instructionFunc = "__gct_instruction"
+
+var (
+	errInstructionLimit = errors.New("instruction limit exceeded")
+	errInvalidPair      = errors.New("invalid allowed currency pair")
+
+	instructionCall = []byte(instructionFunc + "();")
+)
+
+// newPolicy returns the resource policy of the script from the config, an
+// invalid allowed currency pair is an error so it never silently matches
+// nothing
+func (vm *VM) newPolicy() (*modules.Policy, error) {
+	p := &modules.Policy{
+		Script:             vm.ShortName(),
+		Hash:               vm.Hash,
+		Path:               vm.Path,
+		AllowedExchanges:   GCTScriptConfig.AllowedExchanges,
+		MaxOrdersPerMinute: GCTScriptConfig.MaxOrdersPerMinute,
+		DisableWithdrawals: GCTScriptConfig.DisableWithdrawals,
+	}
+	for _, pair := range GCTScriptConfig.AllowedPairs {
+		if len(pair) < 3 {
+			return nil, fmt.Errorf("%v: %q", errInvalidPair, pair)
+		}
+		allowed := currency.NewPairFromString(pair)
+		if allowed.Base.IsEmpty() || allowed.Quote.IsEmpty() {
+			return nil, fmt.Errorf("%v: %q", errInvalidPair, pair)
+		}
+		p.AllowedPairs = append(p.AllowedPairs, allowed)
+	}
+	return p, nil
+}
+
+// wrapper returns the wrapper restricted to the policy of the script, or the
+// test wrapper when the script is tested
+func (vm *VM) wrapper() modules.GCT {
+	if vm.testWrapper != nil {
+		return vm.testWrapper
+	}
+	return wrappers.GetPolicyWrapper(vm.policy)
+}
+
+// instrumentSource returns the script source instrumented to count
+// instructions when an instruction limit is set
+func (vm *VM) instrumentSource(code []byte) []byte {
+	if GCTScriptConfig.MaxInstructions <= 0 {
+		return code
+	}
+	instrumented, err := instrument(code)
+	if err != nil {
+		// the compiler reports the syntax error against the original source
+		return code
+	}
+	vm.budget = &instructionBudget{max: GCTScriptConfig.MaxInstructions}
+	return instrumented
+}
+
+// setLimits applies the allocation and instruction limits to the script
+func (vm *VM) setLimits() error {
+	if GCTScriptConfig.MaxAllocations > 0 {
+		vm.Script.SetMaxAllocs(GCTScriptConfig.MaxAllocations)
+	}
+	if vm.budget == nil {
+		return nil
+	}
+	return vm.Script.Add(instructionFunc, &tengo.UserFunction{
+		Name:  instructionFunc,
+		Value: vm.budget.step,
+	})
+}
+
+// violated returns if the run error was caused by the script exceeding its
+// allocation or instruction limit
+func (vm *VM) violated(err error) bool {
+	return vm.budget.violated() ||
+		strings.Contains(err.Error(), tengo.ErrObjectAllocLimit.Error())
+}
+
+// instrument inserts a call to the instruction counter at the start of every
+// loop and function body, it does not add lines so error positions still
+// match the original source
+func instrument(code []byte) ([]byte, error) {
+	fileSet := parser.NewFileSet()
+	file := fileSet.AddFile("script", -1, len(code))
+	f, err := parser.NewParser(file, code, nil).ParseFile()
+	if err != nil {
+		return nil, err
+	}
+
+	var i instrumenter
+	i.stmts(f.Stmts)
+
+	offsets := make([]int, len(i.bodies))
+	for x := range i.bodies {
+		offsets[x] = file.Offset(i.bodies[x].LBrace) + 1
+	}
+	sort.Ints(offsets)
+
+	out := make([]byte, 0, len(code)+len(offsets)*len(instructionCall))
+	var last int
+	for x := range offsets {
+		out = append(out, code[last:offsets[x]]...)
+		out = append(out, instructionCall...)
+		last = offsets[x]
+	}
+	return append(out, code[last:]...), nil
+}
+
+// importsEvents returns if the script imports the events module and so needs
+// the event loop to deliver updates to its callbacks, a script which cannot be
+// parsed is left for the compiler to report
+func importsEvents(code []byte) bool {
+	fileSet := parser.NewFileSet()
+	file := fileSet.AddFile("script", -1, len(code))
+	f, err := parser.NewParser(file, code, nil).ParseFile()
+	if err != nil {
+		return false
+	}
+
+	var i instrumenter
+	i.stmts(f.Stmts)
+	for x := range i.imports {
+		if i.imports[x] == eventsModule {
+			return true
+		}
+	}
+	return false
+}
+
+// instrumenter collects the loop and function bodies and the imported modules
+// of a script
+type instrumenter struct {
+	bodies  []*parser.BlockStmt
+	imports []string
+}
+
+func (i *instrumenter) body(b *parser.BlockStmt) {
+	i.bodies = append(i.bodies, b)
+	i.stmts(b.Stmts)
+}
+
+func (i *instrumenter) stmts(stmts []parser.Stmt) {
+	for x := range stmts {
+		i.stmt(stmts[x])
+	}
+}
+
+func (i *instrumenter) stmt(stmt parser.Stmt) {
+	switch s := stmt.(type) {
+	case *parser.AssignStmt:
+		i.exprs(s.LHS)
+		i.exprs(s.RHS)
+	case *parser.BlockStmt:
+		if s != nil {
+			i.stmts(s.Stmts)
+		}
+	case *parser.ExportStmt:
+		i.expr(s.Result)
+	case *parser.ExprStmt:
+		i.expr(s.Expr)
+	case *parser.ForInStmt:
+		i.expr(s.Iterable)
+		i.body(s.Body)
+	case *parser.ForStmt:
+		i.stmt(s.Init)
+		i.expr(s.Cond)
+		i.stmt(s.Post)
+		i.body(s.Body)
+	case *parser.IfStmt:
+		i.stmt(s.Init)
+		i.expr(s.Cond)
+		i.stmt(s.Body)
+		i.stmt(s.Else)
+	case *parser.IncDecStmt:
+		i.expr(s.Expr)
+	case *parser.ReturnStmt:
+		i.expr(s.Result)
+	}
+}
+
+func (i *instrumenter) exprs(exprs []parser.Expr) {
+	for x := range exprs {
+		i.expr(exprs[x])
+	}
+}
+
+func (i *instrumenter) expr(expr parser.Expr) {
+	switch e := expr.(type) {
+	case *parser.ArrayLit:
+		i.exprs(e.Elements)
+	case *parser.BinaryExpr:
+		i.expr(e.LHS)
+		i.expr(e.RHS)
+	case *parser.CallExpr:
+		i.expr(e.Func)
+		i.exprs(e.Args)
+	case *parser.CondExpr:
+		i.expr(e.Cond)
+		i.expr(e.True)
+		i.expr(e.False)
+	case *parser.ErrorExpr:
+		i.expr(e.Expr)
+	case *parser.FuncLit:
+		i.body(e.Body)
+	case *parser.ImportExpr:
+		i.imports = append(i.imports, e.ModuleName)
+	case *parser.ImmutableExpr:
+		i.expr(e.Expr)
+	case *parser.IndexExpr:
+		i.expr(e.Expr)
+		i.expr(e.Index)
+	case *parser.MapLit:
+		for x := range e.Elements {
+			i.expr(e.Elements[x].Value)
+		}
+	case *parser.ParenExpr:
+		i.expr(e.Expr)
+	case *parser.SelectorExpr:
+		i.expr(e.Expr)
+		i.expr(e.Sel)
+	case *parser.SliceExpr:
+		i.expr(e.Expr)
+		i.expr(e.Low)
+		i.expr(e.High)
+	case *parser.UnaryExpr:
+		i.expr(e.Expr)
+	}
+}
+
+// step counts an instruction, returning an error once the budget is spent
+func (b *instructionBudget) step(args ...tengo.Object) (tengo.Object, error) {
+	if atomic.AddInt64(&b.count, 1) > b.max {
+		atomic.StoreInt32(&b.exceeded, 1)
+		return nil, fmt.Errorf("%v: %d", errInstructionLimit, b.max)
+	}
+	return tengo.UndefinedValue, nil
+}
+
+// reset restores the full budget
+func (b *instructionBudget) reset() {
+	if b == nil {
+		return
+	}
+	atomic.StoreInt64(&b.count, 0)
+}
+
+// violated returns if the budget has been exceeded since the last call
+func (b *instructionBudget) violated() bool {
+	if b == nil {
+		return false
+	}
+	return atomic.SwapInt32(&b.exceeded, 0) == 1
+}
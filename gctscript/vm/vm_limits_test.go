@@ -0,0 +1,126 @@
+package vm
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var testLoopScript = filepath.Join("..", "..", "testdata", "gctscript", "loop.gct")
+
+func TestInstrument(t *testing.T) {
+	t.Parallel()
+	src := []byte("f := func(x) { return x }\nfor i := 0; i < 2; i++ {\n\tf({a: func() {}}.a)\n}\n")
+	out, err := instrument(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := bytes.Count(out, instructionCall); n != 3 {
+		t.Errorf("expected 3 instruction calls received %v: %s", n, out)
+	}
+	if bytes.Count(out, []byte("\n")) != bytes.Count(src, []byte("\n")) {
+		t.Errorf("expected line count to be unchanged: %s", out)
+	}
+
+	_, err = instrument([]byte("for {"))
+	if err == nil {
+		t.Fatal("expected invalid source to return error")
+	}
+}
+
+func TestInstructionLimit(t *testing.T) {
+	GCTScriptConfig = configHelper(true, true, maxTestVirtualMachines)
+	GCTScriptConfig.MaxInstructions = 100
+	defer func() {
+		GCTScriptConfig = configHelper(true, true, maxTestVirtualMachines)
+	}()
+
+	testVM := New()
+	err := testVM.Load(testLoopScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.RunCtx()
+	if err == nil || !strings.Contains(err.Error(), errInstructionLimit.Error()) {
+		t.Fatalf("expected %v received %v", errInstructionLimit, err)
+	}
+	err = RemoveVM(testVM.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAllocationLimit(t *testing.T) {
+	GCTScriptConfig = configHelper(true, true, maxTestVirtualMachines)
+	GCTScriptConfig.MaxAllocations = 100
+	defer func() {
+		GCTScriptConfig = configHelper(true, true, maxTestVirtualMachines)
+	}()
+
+	testVM := New()
+	err := testVM.Load(testLoopScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.RunCtx()
+	if err == nil || !testVM.violated(err) {
+		t.Fatalf("expected allocation limit violation received %v", err)
+	}
+	err = RemoveVM(testVM.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewPolicy(t *testing.T) {
+	GCTScriptConfig = configHelper(true, true, maxTestVirtualMachines)
+	GCTScriptConfig.AllowedExchanges = []string{"Bitstamp"}
+	GCTScriptConfig.AllowedPairs = []string{"BTC-USD", "X"}
+	GCTScriptConfig.MaxOrdersPerMinute = 5
+	GCTScriptConfig.DisableWithdrawals = true
+	defer func() {
+		GCTScriptConfig = configHelper(true, true, maxTestVirtualMachines)
+	}()
+
+	testVM := NewVM()
+	testVM.File = testScript
+	if _, err := testVM.newPolicy(); err == nil {
+		t.Fatal("expected an invalid allowed pair to fail the policy")
+	}
+	GCTScriptConfig.AllowedPairs = []string{"BTC-"}
+	if _, err := testVM.newPolicy(); err == nil {
+		t.Fatal("expected an allowed pair without a quote to fail the policy")
+	}
+	if err := testVM.Load(testScript); err == nil {
+		t.Error("expected an invalid allowed pair to fail loading the script")
+	}
+
+	GCTScriptConfig.AllowedPairs = []string{"BTC-USD"}
+	p, err := testVM.newPolicy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Script != testVM.ShortName() || p.MaxOrdersPerMinute != 5 || !p.DisableWithdrawals {
+		t.Errorf("unexpected policy %+v", p)
+	}
+	if len(p.AllowedPairs) != 1 {
+		t.Fatalf("unexpected allowed pairs %v", p.AllowedPairs)
+	}
+	if p.CheckPair("bitstamp", currency.NewPair(currency.BTC, currency.USD)) != nil {
+		t.Error("expected BTC-USD to be allowed")
+	}
+	if p.CheckPair("bitstamp", currency.NewPair(currency.LTC, currency.USD)) == nil {
+		t.Error("expected LTC-USD to be rejected")
+	}
+}
@@ -0,0 +1,321 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptstate"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+)
+
+// stateModule is the name scripts import to store values between runs
+const stateModule = "state"
+
+var (
+	errInvalidStateKey   = errors.New("state key must be set")
+	errInvalidStateValue = errors.New("only strings, numbers, bools, times, arrays and maps can be stored")
+
+	memoryState = &scriptState{values: make(map[string]map[string]StateValue)}
+)
+
+// newStateModule returns the state module for the store, values are scoped
+// to the script name so they are shared by every run of the script
+func newStateModule(s *stateStore) map[string]tengo.Object {
+	return map[string]tengo.Object{
+		"get":    &tengo.UserFunction{Name: "get", Value: s.get},
+		"set":    &tengo.UserFunction{Name: "set", Value: s.set},
+		"delete": &tengo.UserFunction{Name: "delete", Value: s.delete},
+		"list":   &tengo.UserFunction{Name: "list", Value: s.list},
+	}
+}
+
+// get returns the value stored under the key, or the default value if
+// one is supplied and nothing is stored
+// args: key, default (optional)
+func (s *stateStore) get(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	key, err := stateKey(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.getState(key)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		if len(args) == 2 {
+			return args[1], nil
+		}
+		return tengo.UndefinedValue, nil
+	}
+	return decodeStateValue(v.Value)
+}
+
+// set stores the value under the key and returns it
+// args: key, value
+func (s *stateStore) set(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 2 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	key, err := stateKey(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := encodeStateValue(args[1])
+	if err != nil {
+		return nil, err
+	}
+	err = s.setState(key, value)
+	if err != nil {
+		return nil, err
+	}
+	return args[1], nil
+}
+
+// delete removes the value stored under the key
+// args: key
+func (s *stateStore) delete(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	key, err := stateKey(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.deleteState(key)
+	if err != nil {
+		return nil, err
+	}
+	return tengo.UndefinedValue, nil
+}
+
+// list returns a map of every value stored by the script
+func (s *stateStore) list(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 0 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+
+	values, err := s.values()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]tengo.Object, len(values))
+	for x := range values {
+		out[values[x].Key], err = decodeStateValue(values[x].Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &tengo.Map{Value: out}, nil
+}
+
+// GetState returns the values stored by a script ordered by key, values for
+// every script are returned if the script name is empty
+func GetState(script string) ([]StateValue, error) {
+	if database.DB.Connected {
+		stored, err := scriptstate.GetByScript(script)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]StateValue, len(stored))
+		for x := range stored {
+			out[x] = StateValue(stored[x])
+		}
+		return out, nil
+	}
+	return memoryState.list(script), nil
+}
+
+func (s *stateStore) values() ([]StateValue, error) {
+	if s.isolated != nil {
+		return s.isolated.list(s.script), nil
+	}
+	return GetState(s.script)
+}
+
+func (s *stateStore) getState(key string) (*StateValue, error) {
+	if s.isolated != nil {
+		return s.isolated.get(s.script, key), nil
+	}
+	if database.DB.Connected {
+		stored, err := scriptstate.Get(s.script, key)
+		if err != nil || stored == nil {
+			return nil, err
+		}
+		v := StateValue(*stored)
+		return &v, nil
+	}
+	return memoryState.get(s.script, key), nil
+}
+
+func (s *stateStore) setState(key, value string) error {
+	if s.isolated != nil {
+		s.isolated.set(s.script, key, value)
+		return nil
+	}
+	if database.DB.Connected {
+		return scriptstate.Upsert(&scriptstate.Details{
+			ScriptName: s.script,
+			Key:        key,
+			Value:      value,
+		})
+	}
+	memoryState.set(s.script, key, value)
+	return nil
+}
+
+func (s *stateStore) deleteState(key string) error {
+	if s.isolated != nil {
+		s.isolated.delete(s.script, key)
+		return nil
+	}
+	if database.DB.Connected {
+		return scriptstate.Delete(s.script, key)
+	}
+	memoryState.delete(s.script, key)
+	return nil
+}
+
+func stateKey(obj tengo.Object) (string, error) {
+	key, ok := tengo.ToString(obj)
+	if !ok {
+		return "", fmt.Errorf(gct.ErrParameterConvertFailed, obj)
+	}
+	if key == "" {
+		return "", errInvalidStateKey
+	}
+	return key, nil
+}
+
+// encodeStateValue converts a script value to JSON for storage
+func encodeStateValue(obj tengo.Object) (string, error) {
+	v := tengo.ToInterface(obj)
+	if !isStorable(v) {
+		return "", errInvalidStateValue
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// decodeStateValue converts a stored JSON value back to a script value,
+// numbers without a fractional part are returned as ints
+func decodeStateValue(value string) (tengo.Object, error) {
+	d := json.NewDecoder(bytes.NewBufferString(value))
+	d.UseNumber()
+	var v interface{}
+	err := d.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	return tengo.FromInterface(fromJSONNumbers(v))
+}
+
+func isStorable(v interface{}) bool {
+	switch t := v.(type) {
+	case nil, string, int64, float64, bool, time.Time:
+		return true
+	case []interface{}:
+		for x := range t {
+			if !isStorable(t[x]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		for k := range t {
+			if !isStorable(t[k]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func fromJSONNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case []interface{}:
+		for x := range t {
+			t[x] = fromJSONNumbers(t[x])
+		}
+	case map[string]interface{}:
+		for k := range t {
+			t[k] = fromJSONNumbers(t[k])
+		}
+	}
+	return v
+}
+
+func (s *scriptState) get(script, key string) *StateValue {
+	s.m.Lock()
+	defer s.m.Unlock()
+	v, ok := s.values[script][key]
+	if !ok {
+		return nil
+	}
+	return &v
+}
+
+func (s *scriptState) set(script, key, value string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.values[script] == nil {
+		s.values[script] = make(map[string]StateValue)
+	}
+	s.values[script][key] = StateValue{
+		ScriptName: script,
+		Key:        key,
+		Value:      value,
+		UpdatedAt:  time.Now().UTC(),
+	}
+}
+
+func (s *scriptState) delete(script, key string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	delete(s.values[script], key)
+}
+
+func (s *scriptState) list(script string) []StateValue {
+	s.m.Lock()
+	var out []StateValue
+	for name, values := range s.values {
+		if script != "" && name != script {
+			continue
+		}
+		for _, v := range values {
+			out = append(out, v)
+		}
+	}
+	s.m.Unlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ScriptName != out[j].ScriptName {
+			return out[i].ScriptName < out[j].ScriptName
+		}
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
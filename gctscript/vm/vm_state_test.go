@@ -0,0 +1,137 @@
+package vm
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/d5/tengo/v2"
+)
+
+var testStateScript = filepath.Join("..", "..", "testdata", "gctscript", "state.gct")
+
+func TestStateModule(t *testing.T) {
+	memoryState = &scriptState{values: make(map[string]map[string]StateValue)}
+	s := &stateStore{script: "state_module.gct"}
+
+	r, err := s.get(&tengo.String{Value: "missing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != tengo.UndefinedValue {
+		t.Errorf("expected undefined received %v", r)
+	}
+	r, err = s.get(&tengo.String{Value: "missing"}, &tengo.Int{Value: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := tengo.ToInt64(r); !ok || v != 5 {
+		t.Errorf("expected default value received %v", r)
+	}
+
+	_, err = s.set(&tengo.String{Value: "position"}, &tengo.Float{Value: 1.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.set(&tengo.String{Value: "count"}, &tengo.Int{Value: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = (&stateStore{script: "other.gct"}).set(&tengo.String{Value: "count"}, &tengo.Int{Value: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.set(&tengo.String{Value: "fn"}, &tengo.UserFunction{})
+	if err != errInvalidStateValue {
+		t.Errorf("expected %v received %v", errInvalidStateValue, err)
+	}
+	_, err = s.set(&tengo.String{}, &tengo.Int{Value: 2})
+	if err != errInvalidStateKey {
+		t.Errorf("expected %v received %v", errInvalidStateKey, err)
+	}
+
+	r, err = s.get(&tengo.String{Value: "count"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := r.(*tengo.Int); !ok || v.Value != 2 {
+		t.Errorf("expected int 2 received %v", r)
+	}
+
+	r, err = s.list()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := r.(*tengo.Map)
+	if !ok || len(m.Value) != 2 {
+		t.Fatalf("expected map of 2 values received %v", r)
+	}
+	if v, ok := m.Value["position"].(*tengo.Float); !ok || v.Value != 1.5 {
+		t.Errorf("expected float 1.5 received %v", m.Value["position"])
+	}
+
+	_, err = s.delete(&tengo.String{Value: "count"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := GetState("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || values[0].ScriptName != "other.gct" || values[1].Key != "position" {
+		t.Errorf("unexpected state values %+v", values)
+	}
+}
+
+func TestStateValueEncoding(t *testing.T) {
+	t.Parallel()
+	in := &tengo.Map{Value: map[string]tengo.Object{
+		"signal":  &tengo.String{Value: "buy"},
+		"amounts": &tengo.Array{Value: []tengo.Object{&tengo.Int{Value: 1}, &tengo.Float{Value: 0.5}}},
+		"active":  tengo.TrueValue,
+	}}
+	encoded, err := encodeStateValue(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := decodeStateValue(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !out.Equals(in) {
+		t.Errorf("expected %v received %v", in, out)
+	}
+}
+
+func TestStateScript(t *testing.T) {
+	memoryState = &scriptState{values: make(map[string]map[string]StateValue)}
+	for x := int64(1); x <= 2; x++ {
+		testVM := New()
+		err := testVM.Load(testStateScript)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = testVM.Compile()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = testVM.RunCtx()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count := testVM.Compiled.Get("count").Int64(); count != x {
+			t.Errorf("expected count %v received %v", x, count)
+		}
+		err = testVM.Shutdown()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	values, err := GetState("state.gct")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || values[0].Key != "count" || values[0].Value != "2" {
+		t.Errorf("unexpected state values %+v", values)
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/d5/tengo/v2"
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 const (
@@ -14,6 +16,9 @@ const (
 	DefaultTimeoutValue = 30 * time.Second
 	// DefaultMaxVirtualMachines max number of virtual machines that can be loaded at one time
 	DefaultMaxVirtualMachines uint8 = 10
+	// DefaultMaxPendingEvents max number of undelivered events a virtual machine
+	// will queue before dropping new updates
+	DefaultMaxPendingEvents = 1000
 
 	// TypeLoad text to display in script_event table when a VM is loaded
 	TypeLoad = "load"
@@ -30,6 +35,9 @@ const (
 	StatusSuccess = "success"
 	// StatusFailure text to display in script_event table when script execution fails
 	StatusFailure = "failure"
+	// StatusViolation text to display in script_event table when a script breaks
+	// its resource policy
+	StatusViolation = "violation"
 )
 
 type vmscount int32
@@ -58,4 +66,104 @@ type VM struct {
 	T        time.Duration
 	NextRun  time.Time
 	S        chan struct{}
+	events   *eventHandler
+	policy   *modules.Policy
+	budget   *instructionBudget
+	assert   *assertions
+	// testWrapper replaces the exchange wrapper when the script is tested
+	testWrapper modules.GCT
+}
+
+// TestResult is the outcome of testing a script against a fixture, the test
+// passes when the script runs without error and every assertion holds
+type TestResult struct {
+	Script     string
+	Passed     bool
+	Assertions int
+	Failures   []string
+	Error      string
+}
+
+// eventHandler relays dispatch updates for the streams a script has
+// subscribed to. Updates are read as soon as they are published so a slow
+// script never blocks the dispatcher, and undelivered updates for the same
+// stream are coalesced so the script always receives the latest state
+type eventHandler struct {
+	script   string
+	wrapper  func() modules.GCT
+	m        sync.Mutex
+	subs     map[string]*subscription
+	pending  map[string]*pendingEvent
+	queue    []string
+	notify   chan struct{}
+	shutdown chan struct{}
+	once     sync.Once
+	wg       sync.WaitGroup
+	done     <-chan struct{}
+	watchdog *watchdog
+	budget   *instructionBudget
+	testing  bool
+}
+
+// subscription is a script callback registered against a dispatch pipe
+type subscription struct {
+	fn      tengo.Object
+	pipe    dispatch.Pipe
+	convert func(interface{}) (data tengo.Object, id string, err error)
+	done    chan struct{}
+}
+
+// pendingEvent is an update waiting to be delivered to a subscription
+type pendingEvent struct {
+	key  string
+	data tengo.Object
+}
+
+// watchdog cancels a script run once it has been executing for longer than
+// the timeout
+type watchdog struct {
+	m       sync.Mutex
+	timer   *time.Timer
+	timeout time.Duration
+	expired bool
+}
+
+// instructionBudget limits the instructions a script can execute in a run,
+// instructions are counted at each loop iteration and function call as they
+// are the only way a script can execute indefinitely
+type instructionBudget struct {
+	max      int64
+	count    int64
+	exceeded int32
+}
+
+// assertions records the assertions made by a script
+type assertions struct {
+	script      string
+	logFailures bool
+	m           sync.Mutex
+	count       int
+	failures    []string
+}
+
+// StateValue is a JSON encoded value stored by a script under a key
+type StateValue struct {
+	ScriptName string
+	Key        string
+	Value      string
+	UpdatedAt  time.Time
+}
+
+// stateStore is the state of a single script, isolated state is kept in
+// memory apart from every other run and is used when testing scripts
+type stateStore struct {
+	script   string
+	isolated *scriptState
+}
+
+// scriptState holds script values in memory when the database is not
+// connected
+type scriptState struct {
+	m      sync.Mutex
+	values map[string]map[string]StateValue
 }
@@ -0,0 +1,456 @@
+package fixture
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+// Load parses a JSON fixture and returns a wrapper serving it
+func Load(data []byte) (*Wrapper, error) {
+	var f Fixture
+	err := json.Unmarshal(data, &f)
+	if err != nil {
+		return nil, err
+	}
+	for x := range f.Exchanges {
+		if f.Exchanges[x].Name == "" {
+			return nil, fmt.Errorf("fixture exchange %d has no name", x)
+		}
+		for y := range f.Exchanges[x].Candles {
+			_, err = kline.ParseInterval(f.Exchanges[x].Candles[y].Interval)
+			if err != nil {
+				return nil, fmt.Errorf("%s candles: %v", f.Exchanges[x].Name, err)
+			}
+		}
+	}
+	return New(&f), nil
+}
+
+// LoadFile reads a JSON fixture from disk and returns a wrapper serving it
+func LoadFile(path string) (*Wrapper, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Load(data)
+}
+
+// New returns a wrapper serving the fixture
+func New(f *Fixture) *Wrapper {
+	return &Wrapper{fixture: f}
+}
+
+// Orders returns every order submitted to the wrapper in submission order
+func (w *Wrapper) Orders() []order.Detail {
+	w.m.Lock()
+	defer w.m.Unlock()
+	orders := make([]order.Detail, len(w.orders))
+	copy(orders, w.orders)
+	return orders
+}
+
+// Exchanges returns the exchanges in the fixture
+func (w *Wrapper) Exchanges(_ bool) []string {
+	exchanges := make([]string, len(w.fixture.Exchanges))
+	for x := range w.fixture.Exchanges {
+		exchanges[x] = w.fixture.Exchanges[x].Name
+	}
+	return exchanges
+}
+
+// IsEnabled returns if the exchange is in the fixture
+func (w *Wrapper) IsEnabled(exch string) bool {
+	return w.exchange(exch) != nil
+}
+
+// Orderbook returns the fixture orderbook
+func (w *Wrapper) Orderbook(exch string, pair currency.Pair, item asset.Item) (*orderbook.Base, error) {
+	e, err := w.getExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	for x := range e.Orderbooks {
+		ob := &e.Orderbooks[x]
+		if !matches(ob.Pair, ob.Asset, pair, item) {
+			continue
+		}
+		return &orderbook.Base{
+			ExchangeName: e.Name,
+			Pair:         pair,
+			AssetType:    item,
+			Bids:         levels(ob.Bids),
+			Asks:         levels(ob.Asks),
+			LastUpdated:  time.Now(),
+		}, nil
+	}
+	return nil, notFound("orderbook", exch, pair, item)
+}
+
+// Ticker returns the fixture ticker
+func (w *Wrapper) Ticker(exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
+	e, err := w.getExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	for x := range e.Tickers {
+		t := &e.Tickers[x]
+		if !matches(t.Pair, t.Asset, pair, item) {
+			continue
+		}
+		return &ticker.Price{
+			Last:         t.Last,
+			High:         t.High,
+			Low:          t.Low,
+			Bid:          t.Bid,
+			Ask:          t.Ask,
+			Volume:       t.Volume,
+			Pair:         pair,
+			ExchangeName: e.Name,
+			AssetType:    item,
+			LastUpdated:  time.Now(),
+		}, nil
+	}
+	return nil, notFound("ticker", exch, pair, item)
+}
+
+// Pairs returns every pair of the asset with a ticker, orderbook or candles in
+// the fixture
+func (w *Wrapper) Pairs(exch string, _ bool, item asset.Item) (*currency.Pairs, error) {
+	e, err := w.getExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	var pairs currency.Pairs
+	add := func(p currency.Pair, a asset.Item) {
+		if assetOf(a) == assetOf(item) && !pairs.Contains(p, false) {
+			pairs = append(pairs, p)
+		}
+	}
+	for x := range e.Tickers {
+		add(e.Tickers[x].Pair, e.Tickers[x].Asset)
+	}
+	for x := range e.Orderbooks {
+		add(e.Orderbooks[x].Pair, e.Orderbooks[x].Asset)
+	}
+	for x := range e.Candles {
+		add(e.Candles[x].Pair, e.Candles[x].Asset)
+	}
+	return &pairs, nil
+}
+
+// QueryOrder returns a submitted order
+func (w *Wrapper) QueryOrder(exch, orderID string) (*order.Detail, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	d := w.order(exch, orderID)
+	if d == nil {
+		return nil, fmt.Errorf("%v: %s", errOrderNotFound, orderID)
+	}
+	c := *d
+	return &c, nil
+}
+
+// SubmitOrder records the order, it is placed but never filled
+func (w *Wrapper) SubmitOrder(exch string, submit *order.Submit) (*order.SubmitResponse, error) {
+	e, err := w.getExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	if submit == nil || submit.Amount <= 0 {
+		return nil, errInvalidOrder
+	}
+
+	w.m.Lock()
+	defer w.m.Unlock()
+	id := strconv.Itoa(len(w.orders) + 1)
+	w.orders = append(w.orders, order.Detail{
+		Exchange:        e.Name,
+		ID:              id,
+		InternalOrderID: submit.ClientID,
+		CurrencyPair:    submit.Pair,
+		AssetType:       asset.Spot,
+		OrderSide:       submit.OrderSide,
+		OrderType:       submit.OrderType,
+		OrderDate:       time.Now(),
+		Status:          order.New,
+		Price:           submit.Price,
+		Amount:          submit.Amount,
+		RemainingAmount: submit.Amount,
+	})
+	return &order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       id,
+	}, nil
+}
+
+// CancelOrder cancels an open submitted order
+func (w *Wrapper) CancelOrder(exch, orderID string) (bool, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	d := w.order(exch, orderID)
+	if d == nil {
+		return false, fmt.Errorf("%v: %s", errOrderNotFound, orderID)
+	}
+	if !isOpen(d.Status) {
+		return false, nil
+	}
+	d.Status = order.Cancelled
+	return true, nil
+}
+
+// SubmitOrders records each order in turn, it stops at the first order which
+// cannot be recorded
+func (w *Wrapper) SubmitOrders(exch string, submit []order.Submit) ([]order.SubmitResponse, error) {
+	resp := make([]order.SubmitResponse, 0, len(submit))
+	for x := range submit {
+		r, err := w.SubmitOrder(exch, &submit[x])
+		if err != nil {
+			return resp, err
+		}
+		resp = append(resp, *r)
+	}
+	return resp, nil
+}
+
+// CancelOrders cancels each open submitted order, orders which are missing or
+// no longer open are returned with their error
+func (w *Wrapper) CancelOrders(exch string, orderIDs []string) (order.CancelAllResponse, error) {
+	resp := order.CancelAllResponse{Status: make(map[string]string)}
+	for x := range orderIDs {
+		cancelled, err := w.CancelOrder(exch, orderIDs[x])
+		if err != nil {
+			resp.Status[orderIDs[x]] = err.Error()
+			continue
+		}
+		if !cancelled {
+			resp.Status[orderIDs[x]] = errOrderNotOpen.Error()
+		}
+	}
+	return resp, nil
+}
+
+// AccountInformation returns the fixture balances
+func (w *Wrapper) AccountInformation(exch string) (account.Holdings, error) {
+	e, err := w.getExchange(exch)
+	if err != nil {
+		return account.Holdings{}, err
+	}
+	balances := make([]account.Balance, len(e.Balances))
+	for x := range e.Balances {
+		balances[x] = account.Balance{
+			CurrencyName: e.Balances[x].Currency,
+			TotalValue:   e.Balances[x].Total,
+			Hold:         e.Balances[x].Hold,
+		}
+	}
+	return account.Holdings{
+		Exchange: e.Name,
+		Accounts: []account.SubAccount{
+			{
+				ID:         e.Name,
+				Currencies: balances,
+			},
+		},
+	}, nil
+}
+
+// DepositAddress is not supported by fixtures
+func (w *Wrapper) DepositAddress(exch string, _ currency.Code) (string, error) {
+	return "", fmt.Errorf("%s deposit address %v", exch, errNotSupported)
+}
+
+// WithdrawalFiatFunds is not supported by fixtures
+func (w *Wrapper) WithdrawalFiatFunds(exch, _ string, _ *withdraw.FiatRequest) (string, error) {
+	return "", fmt.Errorf("%s withdrawal %v", exch, errNotSupported)
+}
+
+// WithdrawalCryptoFunds is not supported by fixtures
+func (w *Wrapper) WithdrawalCryptoFunds(exch string, _ *withdraw.CryptoRequest) (string, error) {
+	return "", fmt.Errorf("%s withdrawal %v", exch, errNotSupported)
+}
+
+// HistoricCandles returns the fixture candles within the date range
+func (w *Wrapper) HistoricCandles(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error) {
+	e, err := w.getExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	for x := range e.Candles {
+		c := &e.Candles[x]
+		if !matches(c.Pair, c.Asset, pair, item) {
+			continue
+		}
+		// intervals are validated when the fixture is loaded
+		if i, _ := kline.ParseInterval(c.Interval); i != interval {
+			continue
+		}
+		out := &kline.Item{
+			Exchange: e.Name,
+			Pair:     pair,
+			Asset:    item,
+			Interval: interval,
+		}
+		for y := range c.Candles {
+			if c.Candles[y].Time.Before(start) || !c.Candles[y].Time.Before(end) {
+				continue
+			}
+			out.Candles = append(out.Candles, kline.Candle{
+				Time:   c.Candles[y].Time,
+				Open:   c.Candles[y].Open,
+				High:   c.Candles[y].High,
+				Low:    c.Candles[y].Low,
+				Close:  c.Candles[y].Close,
+				Volume: c.Candles[y].Volume,
+			})
+		}
+		return out, nil
+	}
+	return nil, notFound(interval.Short()+" candles", exch, pair, item)
+}
+
+// TradeHistory returns no trades as submitted orders are never filled
+func (w *Wrapper) TradeHistory(exch string, _ currency.Pair, _ asset.Item, _, _ time.Time) ([]order.TradeHistory, error) {
+	_, err := w.getExchange(exch)
+	return nil, err
+}
+
+// OrderHistory returns the submitted orders that are no longer open
+func (w *Wrapper) OrderHistory(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	return w.filterOrders(exch, request, false)
+}
+
+// ActiveOrders returns the submitted orders that are open
+func (w *Wrapper) ActiveOrders(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	return w.filterOrders(exch, request, true)
+}
+
+// FundingHistory returns no transfers as withdrawals are not supported
+func (w *Wrapper) FundingHistory(exch string, _, _ time.Time) ([]modules.FundHistory, error) {
+	_, err := w.getExchange(exch)
+	return nil, err
+}
+
+// SubscribeTicker returns an empty pipe as fixtures are not streamed
+func (w *Wrapper) SubscribeTicker(exch string, _ currency.Pair, _ asset.Item) (dispatch.Pipe, error) {
+	_, err := w.getExchange(exch)
+	return dispatch.Pipe{}, err
+}
+
+// SubscribeOrderbook returns an empty pipe as fixtures are not streamed
+func (w *Wrapper) SubscribeOrderbook(exch string, _ currency.Pair, _ asset.Item) (dispatch.Pipe, error) {
+	_, err := w.getExchange(exch)
+	return dispatch.Pipe{}, err
+}
+
+// SubscribeAccount returns an empty pipe as fixtures are not streamed
+func (w *Wrapper) SubscribeAccount(exch string) (dispatch.Pipe, error) {
+	_, err := w.getExchange(exch)
+	return dispatch.Pipe{}, err
+}
+
+// SubscribeOrders returns an empty pipe as fixtures are not streamed
+func (w *Wrapper) SubscribeOrders(exch string) (dispatch.Pipe, error) {
+	_, err := w.getExchange(exch)
+	return dispatch.Pipe{}, err
+}
+
+// OrderUpdate converts an order update to an order detail
+func (w *Wrapper) OrderUpdate(data interface{}) (*order.Detail, error) {
+	d, ok := data.(order.Detail)
+	if !ok {
+		return nil, fmt.Errorf("unexpected order update type %T", data)
+	}
+	return &d, nil
+}
+
+func (w *Wrapper) exchange(exch string) *Exchange {
+	for x := range w.fixture.Exchanges {
+		if strings.EqualFold(w.fixture.Exchanges[x].Name, exch) {
+			return &w.fixture.Exchanges[x]
+		}
+	}
+	return nil
+}
+
+func (w *Wrapper) getExchange(exch string) (*Exchange, error) {
+	e := w.exchange(exch)
+	if e == nil {
+		return nil, fmt.Errorf("%v: %s", errExchangeNotFound, exch)
+	}
+	return e, nil
+}
+
+// order returns the submitted order, the lock must be held
+func (w *Wrapper) order(exch, orderID string) *order.Detail {
+	for x := range w.orders {
+		if w.orders[x].ID == orderID && strings.EqualFold(w.orders[x].Exchange, exch) {
+			return &w.orders[x]
+		}
+	}
+	return nil
+}
+
+func (w *Wrapper) filterOrders(exch string, request *order.GetOrdersRequest, open bool) ([]order.Detail, error) {
+	_, err := w.getExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+
+	w.m.Lock()
+	defer w.m.Unlock()
+	var orders []order.Detail
+	for x := range w.orders {
+		d := &w.orders[x]
+		if !strings.EqualFold(d.Exchange, exch) || isOpen(d.Status) != open {
+			continue
+		}
+		if request != nil && len(request.Currencies) > 0 &&
+			!currency.Pairs(request.Currencies).Contains(d.CurrencyPair, false) {
+			continue
+		}
+		orders = append(orders, *d)
+	}
+	return orders, nil
+}
+
+func isOpen(s order.Status) bool {
+	return s == order.New || s == order.Active || s == order.PartiallyFilled
+}
+
+func assetOf(item asset.Item) asset.Item {
+	if item == "" {
+		return asset.Spot
+	}
+	return asset.Item(strings.ToLower(item.String()))
+}
+
+func matches(p currency.Pair, a asset.Item, pair currency.Pair, item asset.Item) bool {
+	return p.Equal(pair) && assetOf(a) == assetOf(item)
+}
+
+func levels(l []Level) []orderbook.Item {
+	items := make([]orderbook.Item, len(l))
+	for x := range l {
+		items[x] = orderbook.Item{Price: l[x].Price, Amount: l[x].Amount}
+	}
+	return items
+}
+
+func notFound(data, exch string, pair currency.Pair, item asset.Item) error {
+	return fmt.Errorf("%v: %s %s %s %s", errDataNotFound, exch, pair, assetOf(item), data)
+}
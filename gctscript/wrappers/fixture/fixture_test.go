@@ -0,0 +1,252 @@
+package fixture
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const testFixture = `{
+	"exchanges": [
+		{
+			"name": "Bitstamp",
+			"tickers": [
+				{"pair": "BTC-USD", "last": 10000, "bid": 9999, "ask": 10001}
+			],
+			"orderbooks": [
+				{"pair": "BTC-USD", "bids": [{"price": 9999, "amount": 1}], "asks": [{"price": 10001, "amount": 2}]},
+				{"pair": "LTC-USD", "asset": "margin", "bids": [{"price": 50, "amount": 1}]}
+			],
+			"balances": [
+				{"currency": "USD", "total": 1000, "hold": 100}
+			],
+			"candles": [
+				{"pair": "BTC-USD", "interval": "1h", "candles": [
+					{"time": "2020-01-01T00:00:00Z", "open": 1, "high": 2, "low": 0.5, "close": 1.5, "volume": 10},
+					{"time": "2020-01-01T01:00:00Z", "open": 1.5, "high": 2, "low": 1, "close": 1, "volume": 5}
+				]}
+			]
+		}
+	]
+}`
+
+var btcusd = currency.NewPair(currency.BTC, currency.USD)
+
+func testWrapper(t *testing.T) *Wrapper {
+	t.Helper()
+	w, err := Load([]byte(testFixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	if _, err := Load([]byte(`{"exchanges": [{"tickers": []}]}`)); err == nil {
+		t.Error("expected unnamed exchange to be rejected")
+	}
+	if _, err := Load([]byte(`{"exchanges": [{"name": "a", "candles": [{"interval": "7m"}]}]}`)); err == nil {
+		t.Error("expected unsupported interval to be rejected")
+	}
+	if _, err := Load([]byte(`{`)); err == nil {
+		t.Error("expected invalid JSON to be rejected")
+	}
+	if _, err := LoadFile("missing.json"); err == nil {
+		t.Error("expected missing file to return an error")
+	}
+}
+
+func TestMarketData(t *testing.T) {
+	t.Parallel()
+	w := testWrapper(t)
+
+	if e := w.Exchanges(true); len(e) != 1 || e[0] != "Bitstamp" {
+		t.Errorf("unexpected exchanges %v", e)
+	}
+	if !w.IsEnabled("bitstamp") || w.IsEnabled("kraken") {
+		t.Error("expected only fixture exchanges to be enabled")
+	}
+
+	tick, err := w.Ticker("bitstamp", btcusd, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick.Last != 10000 || tick.Ask != 10001 {
+		t.Errorf("unexpected ticker %+v", tick)
+	}
+	if _, err = w.Ticker("bitstamp", btcusd, asset.Margin); err == nil {
+		t.Error("expected missing ticker to return an error")
+	}
+	if _, err = w.Ticker("kraken", btcusd, asset.Spot); err == nil {
+		t.Error("expected missing exchange to return an error")
+	}
+
+	ob, err := w.Orderbook("bitstamp", btcusd, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ob.Bids) != 1 || len(ob.Asks) != 1 || ob.Asks[0].Amount != 2 {
+		t.Errorf("unexpected orderbook %+v", ob)
+	}
+
+	pairs, err := w.Pairs("bitstamp", true, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*pairs) != 1 || !(*pairs)[0].Equal(btcusd) {
+		t.Errorf("unexpected spot pairs %v", *pairs)
+	}
+	pairs, err = w.Pairs("bitstamp", true, asset.Margin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*pairs) != 1 {
+		t.Errorf("unexpected margin pairs %v", *pairs)
+	}
+
+	h, err := w.AccountInformation("bitstamp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := h.Accounts[0].Currencies
+	if len(b) != 1 || b[0].CurrencyName != currency.USD || b[0].TotalValue != 1000 || b[0].Hold != 100 {
+		t.Errorf("unexpected balances %+v", b)
+	}
+}
+
+func TestHistoricCandles(t *testing.T) {
+	t.Parallel()
+	w := testWrapper(t)
+	start := time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC)
+	c, err := w.HistoricCandles("bitstamp", btcusd, asset.Spot, start, start.Add(time.Hour), kline.OneHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Candles) != 1 || c.Candles[0].Close != 1 {
+		t.Errorf("unexpected candles %+v", c.Candles)
+	}
+	if _, err = w.HistoricCandles("bitstamp", btcusd, asset.Spot, start, start.Add(time.Hour), kline.OneDay); err == nil {
+		t.Error("expected missing interval to return an error")
+	}
+}
+
+func TestOrders(t *testing.T) {
+	t.Parallel()
+	w := testWrapper(t)
+
+	if _, err := w.SubmitOrder("kraken", &order.Submit{Amount: 1}); err == nil {
+		t.Error("expected missing exchange to return an error")
+	}
+	if _, err := w.SubmitOrder("bitstamp", &order.Submit{}); err != errInvalidOrder {
+		t.Errorf("expected %v, received %v", errInvalidOrder, err)
+	}
+
+	for x := 0; x < 2; x++ {
+		r, err := w.SubmitOrder("bitstamp", &order.Submit{
+			Pair:      btcusd,
+			OrderSide: order.Buy,
+			OrderType: order.Limit,
+			Price:     9000,
+			Amount:    1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !r.IsOrderPlaced || r.OrderID == "" {
+			t.Errorf("unexpected submit response %+v", r)
+		}
+	}
+
+	cancelled, err := w.CancelOrder("bitstamp", "1")
+	if err != nil || !cancelled {
+		t.Fatalf("expected order to be cancelled, received %v %v", cancelled, err)
+	}
+	cancelled, err = w.CancelOrder("bitstamp", "1")
+	if err != nil || cancelled {
+		t.Errorf("expected cancelled order to not be cancelled again, received %v %v", cancelled, err)
+	}
+	if _, err = w.CancelOrder("bitstamp", "3"); err == nil {
+		t.Error("expected missing order to return an error")
+	}
+
+	d, err := w.QueryOrder("bitstamp", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Status != order.Cancelled {
+		t.Errorf("expected cancelled status, received %v", d.Status)
+	}
+
+	active, err := w.ActiveOrders("bitstamp", &order.GetOrdersRequest{Currencies: []currency.Pair{btcusd}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 1 || active[0].ID != "2" {
+		t.Errorf("unexpected active orders %+v", active)
+	}
+	history, err := w.OrderHistory("bitstamp", &order.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].ID != "1" {
+		t.Errorf("unexpected order history %+v", history)
+	}
+
+	if orders := w.Orders(); len(orders) != 2 || orders[0].Price != 9000 {
+		t.Errorf("unexpected recorded orders %+v", orders)
+	}
+}
+
+func TestBatchOrders(t *testing.T) {
+	t.Parallel()
+	w := testWrapper(t)
+
+	submit := []order.Submit{
+		{Pair: btcusd, OrderSide: order.Buy, OrderType: order.Limit, Price: 9000, Amount: 1},
+		{Pair: btcusd, OrderSide: order.Sell, OrderType: order.Limit, Price: 11000, Amount: 1},
+		{Pair: btcusd, OrderSide: order.Sell, OrderType: order.Limit, Price: 12000},
+	}
+	r, err := w.SubmitOrders("bitstamp", submit)
+	if err != errInvalidOrder {
+		t.Errorf("expected %v, received %v", errInvalidOrder, err)
+	}
+	if len(r) != 2 || r[0].OrderID != "1" || r[1].OrderID != "2" {
+		t.Errorf("expected the orders before the invalid order to be placed, received %+v", r)
+	}
+
+	c, err := w.CancelOrders("bitstamp", []string{"1", "2", "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Status) != 1 || c.Status["3"] == "" {
+		t.Errorf("expected only the missing order to fail, received %+v", c.Status)
+	}
+	if c, _ = w.CancelOrders("bitstamp", []string{"1"}); c.Status["1"] != errOrderNotOpen.Error() {
+		t.Errorf("expected the cancelled order to not be open, received %+v", c.Status)
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	t.Parallel()
+	w := testWrapper(t)
+	if _, err := w.DepositAddress("bitstamp", currency.BTC); err == nil {
+		t.Error("expected deposit address to be unsupported")
+	}
+	if _, err := w.WithdrawalCryptoFunds("bitstamp", nil); err == nil {
+		t.Error("expected withdrawals to be unsupported")
+	}
+	if _, err := w.WithdrawalFiatFunds("bitstamp", "", nil); err == nil {
+		t.Error("expected withdrawals to be unsupported")
+	}
+	if _, err := w.SubscribeTicker("bitstamp", btcusd, asset.Spot); err != nil {
+		t.Error(err)
+	}
+	if _, err := w.SubscribeOrders("kraken"); err == nil {
+		t.Error("expected missing exchange to return an error")
+	}
+}
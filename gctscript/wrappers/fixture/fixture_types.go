@@ -0,0 +1,94 @@
+package fixture
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	errExchangeNotFound = errors.New("exchange not found in fixture")
+	errDataNotFound     = errors.New("data not found in fixture")
+	errOrderNotFound    = errors.New("order not found")
+	errNotSupported     = errors.New("not supported by fixture")
+	errInvalidOrder     = errors.New("order amount must be greater than zero")
+	errOrderNotOpen     = errors.New("order is not open")
+)
+
+// Fixture holds the canned exchange data scripts are tested against
+type Fixture struct {
+	Exchanges []Exchange `json:"exchanges"`
+}
+
+// Exchange holds the canned data of a single exchange, the asset of a ticker,
+// orderbook or candle series defaults to spot
+type Exchange struct {
+	Name       string      `json:"name"`
+	Tickers    []Ticker    `json:"tickers"`
+	Orderbooks []Orderbook `json:"orderbooks"`
+	Balances   []Balance   `json:"balances"`
+	Candles    []Candles   `json:"candles"`
+}
+
+// Ticker holds a canned ticker
+type Ticker struct {
+	Pair   currency.Pair `json:"pair"`
+	Asset  asset.Item    `json:"asset"`
+	Last   float64       `json:"last"`
+	High   float64       `json:"high"`
+	Low    float64       `json:"low"`
+	Bid    float64       `json:"bid"`
+	Ask    float64       `json:"ask"`
+	Volume float64       `json:"volume"`
+}
+
+// Orderbook holds a canned orderbook
+type Orderbook struct {
+	Pair  currency.Pair `json:"pair"`
+	Asset asset.Item    `json:"asset"`
+	Bids  []Level       `json:"bids"`
+	Asks  []Level       `json:"asks"`
+}
+
+// Level is a single orderbook price level
+type Level struct {
+	Price  float64 `json:"price"`
+	Amount float64 `json:"amount"`
+}
+
+// Balance holds a canned currency balance
+type Balance struct {
+	Currency currency.Code `json:"currency"`
+	Total    float64       `json:"total"`
+	Hold     float64       `json:"hold"`
+}
+
+// Candles holds a canned candle series
+type Candles struct {
+	Pair     currency.Pair `json:"pair"`
+	Asset    asset.Item    `json:"asset"`
+	Interval string        `json:"interval"`
+	Candles  []Candle      `json:"candles"`
+}
+
+// Candle is a single canned candle
+type Candle struct {
+	Time   time.Time `json:"time"`
+	Open   float64   `json:"open"`
+	High   float64   `json:"high"`
+	Low    float64   `json:"low"`
+	Close  float64   `json:"close"`
+	Volume float64   `json:"volume"`
+}
+
+// Wrapper serves a fixture to scripts and records every order they submit,
+// submitted orders are never filled and stay open until cancelled
+type Wrapper struct {
+	fixture *Fixture
+	m       sync.Mutex
+	orders  []order.Detail
+}
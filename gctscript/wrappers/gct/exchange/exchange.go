@@ -4,24 +4,36 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	scriptevent "github.com/thrasher-corp/gocryptotrader/database/repository/script"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	log "github.com/thrasher-corp/gocryptotrader/logger"
+	"github.com/volatiletech/null"
 )
 
-// Exchange implements all required methods for Wrapper
-type Exchange struct{}
+// Exchange implements all required methods for Wrapper, a Policy restricts
+// the exchanges, currency pairs and actions available to a script
+type Exchange struct {
+	Policy *modules.Policy
+}
 
 // Exchanges returns slice of all current exchanges
 func (e Exchange) Exchanges(enabledOnly bool) []string {
-	return engine.GetExchanges(enabledOnly)
+	return e.Policy.FilterExchanges(engine.GetExchanges(enabledOnly))
 }
 
 // GetExchange returns IBotExchange for exchange or error if exchange is not found
@@ -46,11 +58,19 @@ func (e Exchange) IsEnabled(exch string) bool {
 
 // Orderbook returns current orderbook requested exchange, pair and asset
 func (e Exchange) Orderbook(exch string, pair currency.Pair, item asset.Item) (*orderbook.Base, error) {
+	if err := e.enforce(e.Policy.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+
 	return engine.GetSpecificOrderbook(pair, exch, item)
 }
 
 // Ticker returns ticker for provided currency pair & asset type
 func (e Exchange) Ticker(exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
+	if err := e.enforce(e.Policy.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+
 	ex, err := e.GetExchange(exch)
 	if err != nil {
 		return nil, err
@@ -61,6 +81,10 @@ func (e Exchange) Ticker(exch string, pair currency.Pair, item asset.Item) (*tic
 
 // Pairs returns either all or enabled currency pairs
 func (e Exchange) Pairs(exch string, enabledOnly bool, item asset.Item) (*currency.Pairs, error) {
+	if err := e.enforce(e.Policy.CheckExchange(exch)); err != nil {
+		return nil, err
+	}
+
 	x, err := engine.Bot.Config.GetExchangeConfig(exch)
 	if err != nil {
 		return nil, err
@@ -74,6 +98,10 @@ func (e Exchange) Pairs(exch string, enabledOnly bool, item asset.Item) (*curren
 
 // QueryOrder returns details of a valid exchange order
 func (e Exchange) QueryOrder(exch, orderID string) (*order.Detail, error) {
+	if err := e.enforce(e.Policy.CheckExchange(exch)); err != nil {
+		return nil, err
+	}
+
 	ex, err := e.GetExchange(exch)
 	if err != nil {
 		return nil, err
@@ -89,6 +117,10 @@ func (e Exchange) QueryOrder(exch, orderID string) (*order.Detail, error) {
 
 // SubmitOrder submit new order on exchange
 func (e Exchange) SubmitOrder(exch string, submit *order.Submit) (*order.SubmitResponse, error) {
+	if err := e.enforce(e.Policy.CheckOrder(exch, submit.Pair)); err != nil {
+		return nil, err
+	}
+
 	r, err := engine.Bot.OrderManager.Submit(exch, submit)
 	if err != nil {
 		return nil, err
@@ -118,8 +150,51 @@ func (e Exchange) CancelOrder(exch, orderID string) (bool, error) {
 	return true, nil
 }
 
+// SubmitOrders submits a batch of orders to an exchange together, every order
+// must be allowed by the policy before any are submitted
+func (e Exchange) SubmitOrders(exch string, submit []order.Submit) ([]order.SubmitResponse, error) {
+	pairs := make([]currency.Pair, len(submit))
+	for x := range submit {
+		pairs[x] = submit[x].Pair
+	}
+	if err := e.enforce(e.Policy.CheckOrders(exch, pairs)); err != nil {
+		return nil, err
+	}
+
+	r, err := engine.Bot.OrderManager.SubmitOrders(exch, submit)
+	resp := make([]order.SubmitResponse, len(r))
+	for x := range r {
+		resp[x] = r[x].SubmitResponse
+	}
+	return resp, err
+}
+
+// CancelOrders cancels a batch of orders on an exchange together, the orders
+// which failed to cancel are returned with their error
+func (e Exchange) CancelOrders(exch string, orderIDs []string) (order.CancelAllResponse, error) {
+	cancels := make([]order.Cancel, len(orderIDs))
+	for x := range orderIDs {
+		orderDetails, err := e.QueryOrder(exch, orderIDs[x])
+		if err != nil {
+			return order.CancelAllResponse{}, err
+		}
+		cancels[x] = order.Cancel{
+			AccountID:    orderDetails.AccountID,
+			OrderID:      orderDetails.ID,
+			CurrencyPair: orderDetails.CurrencyPair,
+			Side:         orderDetails.OrderSide,
+		}
+	}
+
+	return engine.Bot.OrderManager.CancelOrders(exch, cancels)
+}
+
 // AccountInformation returns account information (balance etc) for requested exchange
 func (e Exchange) AccountInformation(exch string) (account.Holdings, error) {
+	if err := e.enforce(e.Policy.CheckExchange(exch)); err != nil {
+		return account.Holdings{}, err
+	}
+
 	ex, err := e.GetExchange(exch)
 	if err != nil {
 		return account.Holdings{}, err
@@ -135,6 +210,10 @@ func (e Exchange) AccountInformation(exch string) (account.Holdings, error) {
 
 // DepositAddress gets the address required to deposit funds for currency type
 func (e Exchange) DepositAddress(exch string, currencyCode currency.Code) (out string, err error) {
+	if err = e.enforce(e.Policy.CheckExchange(exch)); err != nil {
+		return "", err
+	}
+
 	if currencyCode.IsEmpty() {
 		err = errors.New("currency code is empty")
 		return
@@ -144,6 +223,10 @@ func (e Exchange) DepositAddress(exch string, currencyCode currency.Code) (out s
 
 // WithdrawalFiatFunds withdraw funds from exchange to requested fiat source
 func (e Exchange) WithdrawalFiatFunds(exch, bankaccountid string, request *withdraw.FiatRequest) (string, error) {
+	if err := e.enforce(e.Policy.CheckWithdrawal(exch)); err != nil {
+		return "", err
+	}
+
 	ex, err := e.GetExchange(exch)
 	if err != nil {
 		return "", err
@@ -182,6 +265,10 @@ func (e Exchange) WithdrawalFiatFunds(exch, bankaccountid string, request *withd
 
 // WithdrawalCryptoFunds withdraw funds from exchange to requested Crypto source
 func (e Exchange) WithdrawalCryptoFunds(exch string, request *withdraw.CryptoRequest) (out string, err error) {
+	if err = e.enforce(e.Policy.CheckWithdrawal(exch)); err != nil {
+		return "", err
+	}
+
 	ex, err := e.GetExchange(exch)
 	if err != nil {
 		return "", err
@@ -202,3 +289,199 @@ func (e Exchange) WithdrawalCryptoFunds(exch string, request *withdraw.CryptoReq
 	}
 	return ex.WithdrawCryptocurrencyFunds(request)
 }
+
+// SubscribeTicker returns a pipe which receives each ticker update for the
+// exchange, currency pair and asset
+func (e Exchange) SubscribeTicker(exch string, pair currency.Pair, item asset.Item) (dispatch.Pipe, error) {
+	if err := e.enforce(e.Policy.CheckPair(exch, pair)); err != nil {
+		return dispatch.Pipe{}, err
+	}
+
+	return ticker.SubscribeTicker(exch, pair, item)
+}
+
+// SubscribeOrderbook returns a pipe which receives each orderbook update for
+// the exchange, currency pair and asset
+func (e Exchange) SubscribeOrderbook(exch string, pair currency.Pair, item asset.Item) (dispatch.Pipe, error) {
+	if err := e.enforce(e.Policy.CheckPair(exch, pair)); err != nil {
+		return dispatch.Pipe{}, err
+	}
+
+	return orderbook.SubscribeOrderbook(exch, pair, item)
+}
+
+// SubscribeAccount returns a pipe which receives each account holdings update
+// for the exchange
+func (e Exchange) SubscribeAccount(exch string) (dispatch.Pipe, error) {
+	if err := e.enforce(e.Policy.CheckExchange(exch)); err != nil {
+		return dispatch.Pipe{}, err
+	}
+
+	return account.SubscribeToExchangeAccount(exch)
+}
+
+// SubscribeOrders returns a pipe which receives each order update tracked by
+// the order manager for the exchange
+func (e Exchange) SubscribeOrders(exch string) (dispatch.Pipe, error) {
+	if err := e.enforce(e.Policy.CheckExchange(exch)); err != nil {
+		return dispatch.Pipe{}, err
+	}
+
+	if !engine.Bot.OrderManager.Started() {
+		return dispatch.Pipe{}, errors.New("order manager is not running")
+	}
+	return engine.Bot.OrderManager.SubscribeOrders(exch)
+}
+
+// OrderUpdate returns the order details of an update received from a
+// SubscribeOrders pipe
+func (e Exchange) OrderUpdate(data interface{}) (*order.Detail, error) {
+	u, ok := data.(engine.OrderUpdate)
+	if !ok {
+		return nil, fmt.Errorf("unexpected order update type %T", data)
+	}
+	return &u.Detail, nil
+}
+
+// HistoricCandles returns candles for the exchange, currency pair and asset
+// between the start and end times
+func (e Exchange) HistoricCandles(exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error) {
+	if err := e.enforce(e.Policy.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+
+	candles, err := engine.GetHistoricCandles(ex, pair, item, start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+	return &candles, nil
+}
+
+// TradeHistory returns the recent trades made on the exchange for the
+// currency pair and asset between the start and end times
+func (e Exchange) TradeHistory(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]order.TradeHistory, error) {
+	if err := e.enforce(e.Policy.CheckPair(exch, pair)); err != nil {
+		return nil, err
+	}
+
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := ex.GetExchangeHistory(pair, item)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []order.TradeHistory
+	for x := range trades {
+		if !inTimeRange(trades[x].Timestamp, start, end) {
+			continue
+		}
+		history = append(history, order.TradeHistory{
+			Timestamp:   trades[x].Timestamp,
+			TID:         strconv.FormatInt(trades[x].TID, 10),
+			Price:       trades[x].Price,
+			Amount:      trades[x].Amount,
+			Exchange:    ex.GetName(),
+			Side:        order.Side(strings.ToUpper(trades[x].Type)),
+			Fee:         trades[x].Fee,
+			Description: trades[x].Description,
+		})
+	}
+	return history, nil
+}
+
+// OrderHistory returns the closed orders on the exchange matching the request
+func (e Exchange) OrderHistory(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	if err := e.enforce(e.Policy.CheckExchange(exch)); err != nil {
+		return nil, err
+	}
+
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := ex.GetOrderHistory(request)
+	if err != nil {
+		return nil, err
+	}
+	order.FilterOrdersByTickRange(&orders, request.StartTicks, request.EndTicks)
+	return orders, nil
+}
+
+// ActiveOrders returns the open orders on the exchange matching the request
+func (e Exchange) ActiveOrders(exch string, request *order.GetOrdersRequest) ([]order.Detail, error) {
+	if err := e.enforce(e.Policy.CheckExchange(exch)); err != nil {
+		return nil, err
+	}
+
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := ex.GetActiveOrders(request)
+	if err != nil {
+		return nil, err
+	}
+	order.FilterOrdersByTickRange(&orders, request.StartTicks, request.EndTicks)
+	return orders, nil
+}
+
+// FundingHistory returns the deposits and withdrawals made on the exchange
+// between the start and end times
+func (e Exchange) FundingHistory(exch string, start, end time.Time) ([]modules.FundHistory, error) {
+	if err := e.enforce(e.Policy.CheckExchange(exch)); err != nil {
+		return nil, err
+	}
+
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+
+	funding, err := ex.GetFundingHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	var history []modules.FundHistory
+	for x := range funding {
+		if !inTimeRange(funding[x].Timestamp, start, end) {
+			continue
+		}
+		history = append(history, modules.FundHistory(funding[x]))
+	}
+	return history, nil
+}
+
+// enforce records a policy violation for the script before returning the
+// error
+func (e Exchange) enforce(err error) error {
+	if err != nil {
+		log.Warnf(log.GCTScriptMgr, "Script %s policy violation: %v\n", e.Policy.Script, err)
+		scriptevent.Event(e.Policy.Hash, e.Policy.Script, e.Policy.Path, null.Bytes{},
+			vm.TypeExecute, vm.StatusViolation, time.Now())
+	}
+	return err
+}
+
+// inTimeRange returns if t is within the start and end times, a zero start or
+// end time leaves that side of the range open
+func inTimeRange(t, start, end time.Time) bool {
+	if !start.IsZero() && start.Unix() != 0 && t.Before(start) {
+		return false
+	}
+	if !end.IsZero() && end.Unix() != 0 && t.After(end) {
+		return false
+	}
+	return true
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 // change these if you wish to test another exchange and/or currency pair
@@ -188,3 +190,31 @@ func configureExchangeKeys() bool {
 	ex.SkipAuthCheck = true
 	return ex.ValidateAPICredentials()
 }
+
+func TestExchange_Policy(t *testing.T) {
+	t.Parallel()
+	restricted := Exchange{Policy: &modules.Policy{
+		Script:             "policy.gct",
+		AllowedExchanges:   []string{exchName},
+		AllowedPairs:       []currency.Pair{currency.NewPairDelimiter(pairs, delimiter)},
+		DisableWithdrawals: true,
+	}}
+
+	x := restricted.Exchanges(false)
+	if len(x) != 1 || x[0] != exchName {
+		t.Fatalf("expected only %v to be allowed received %v", exchName, x)
+	}
+
+	_, err := restricted.Ticker("Bitstamp", currency.NewPairDelimiter(pairs, delimiter), assetType)
+	if err == nil {
+		t.Fatal("expected exchange to be rejected by policy")
+	}
+	_, err = restricted.Orderbook(exchName, currency.NewPairFromString("LTCAUD"), assetType)
+	if err == nil {
+		t.Fatal("expected currency pair to be rejected by policy")
+	}
+	_, err = restricted.WithdrawalCryptoFunds(exchName, &withdraw.CryptoRequest{})
+	if err != modules.ErrWithdrawalsDisabled {
+		t.Fatalf("expected %v received %v", modules.ErrWithdrawalsDisabled, err)
+	}
+}
@@ -1,6 +1,9 @@
 package gct
 
-import "github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/exchange"
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/exchange"
+)
 
 // Setup returns a Wrapper
 func Setup() *Wrapper {
@@ -8,3 +11,10 @@ func Setup() *Wrapper {
 		&exchange.Exchange{},
 	}
 }
+
+// WithPolicy returns a Wrapper restricted to the policy of a script
+func (w *Wrapper) WithPolicy(p *modules.Policy) modules.GCT {
+	return &Wrapper{
+		&exchange.Exchange{Policy: p},
+	}
+}
@@ -3,6 +3,8 @@ package gct
 import (
 	"reflect"
 	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 func TestSetup(t *testing.T) {
@@ -12,3 +14,14 @@ func TestSetup(t *testing.T) {
 		t.Fatalf("Setup() should return pointer to Wrapper instead received: %v", x)
 	}
 }
+
+func TestWithPolicy(t *testing.T) {
+	p := &modules.Policy{DisableWithdrawals: true}
+	x, ok := Setup().WithPolicy(p).(*Wrapper)
+	if !ok {
+		t.Fatal("WithPolicy() should return pointer to Wrapper")
+	}
+	if x.Policy != p {
+		t.Fatal("WithPolicy() should set the policy of the wrapper")
+	}
+}
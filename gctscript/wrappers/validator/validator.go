@@ -4,12 +4,15 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 // Exchanges validator for test execution/scripts
@@ -151,6 +154,37 @@ func (w Wrapper) CancelOrder(exch, orderid string) (bool, error) {
 	return orderid != "false", nil
 }
 
+// SubmitOrders validator for test execution/scripts
+func (w Wrapper) SubmitOrders(exch string, submit []order.Submit) ([]order.SubmitResponse, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+
+	resp := make([]order.SubmitResponse, len(submit))
+	for x := range submit {
+		resp[x] = order.SubmitResponse{
+			IsOrderPlaced: exch == "true",
+			OrderID:       exch,
+		}
+	}
+	return resp, nil
+}
+
+// CancelOrders validator for test execution/scripts
+func (w Wrapper) CancelOrders(exch string, orderIDs []string) (order.CancelAllResponse, error) {
+	if exch == exchError.String() {
+		return order.CancelAllResponse{}, errTestFailed
+	}
+
+	resp := order.CancelAllResponse{Status: make(map[string]string)}
+	for x := range orderIDs {
+		if orderIDs[x] == "false" {
+			resp.Status[orderIDs[x]] = errTestFailed.Error()
+		}
+	}
+	return resp, nil
+}
+
 // AccountInformation validator for test execution/scripts
 func (w Wrapper) AccountInformation(exch string) (account.Holdings, error) {
 	if exch == exchError.String() {
@@ -209,3 +243,147 @@ func (w Wrapper) WithdrawalFiatFunds(exch, _ string, _ *withdraw.FiatRequest) (o
 
 	return "123", nil
 }
+
+// SubscribeTicker validator for test execution/scripts
+func (w Wrapper) SubscribeTicker(exch string, _ currency.Pair, _ asset.Item) (dispatch.Pipe, error) {
+	if exch == exchError.String() {
+		return dispatch.Pipe{}, errTestFailed
+	}
+
+	return dispatch.Pipe{}, nil
+}
+
+// SubscribeOrderbook validator for test execution/scripts
+func (w Wrapper) SubscribeOrderbook(exch string, _ currency.Pair, _ asset.Item) (dispatch.Pipe, error) {
+	if exch == exchError.String() {
+		return dispatch.Pipe{}, errTestFailed
+	}
+
+	return dispatch.Pipe{}, nil
+}
+
+// SubscribeAccount validator for test execution/scripts
+func (w Wrapper) SubscribeAccount(exch string) (dispatch.Pipe, error) {
+	if exch == exchError.String() {
+		return dispatch.Pipe{}, errTestFailed
+	}
+
+	return dispatch.Pipe{}, nil
+}
+
+// SubscribeOrders validator for test execution/scripts
+func (w Wrapper) SubscribeOrders(exch string) (dispatch.Pipe, error) {
+	if exch == exchError.String() {
+		return dispatch.Pipe{}, errTestFailed
+	}
+
+	return dispatch.Pipe{}, nil
+}
+
+// OrderUpdate validator for test execution/scripts
+func (w Wrapper) OrderUpdate(data interface{}) (*order.Detail, error) {
+	d, ok := data.(order.Detail)
+	if !ok {
+		return nil, errTestFailed
+	}
+
+	return &d, nil
+}
+
+// HistoricCandles validator for test execution/scripts
+func (w Wrapper) HistoricCandles(exch string, pair currency.Pair, item asset.Item, start, _ time.Time, interval kline.Interval) (*kline.Item, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+
+	return &kline.Item{
+		Exchange: exch,
+		Pair:     pair,
+		Asset:    item,
+		Interval: interval,
+		Candles: []kline.Candle{
+			{
+				Time:   start,
+				Open:   1000,
+				High:   1100,
+				Low:    900,
+				Close:  1050,
+				Volume: 10,
+			},
+		},
+	}, nil
+}
+
+// TradeHistory validator for test execution/scripts
+func (w Wrapper) TradeHistory(exch string, _ currency.Pair, _ asset.Item, start, _ time.Time) ([]order.TradeHistory, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+
+	return []order.TradeHistory{
+		{
+			Timestamp: start,
+			TID:       "1337",
+			Price:     1000,
+			Amount:    1,
+			Exchange:  exch,
+			Side:      order.Buy,
+		},
+	}, nil
+}
+
+// OrderHistory validator for test execution/scripts
+func (w Wrapper) OrderHistory(exch string, _ *order.GetOrdersRequest) ([]order.Detail, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+
+	return []order.Detail{
+		{
+			Exchange:  exch,
+			ID:        "1",
+			OrderSide: order.Buy,
+			OrderType: order.Limit,
+			Status:    order.Filled,
+			Price:     1000,
+			Amount:    1,
+		},
+	}, nil
+}
+
+// ActiveOrders validator for test execution/scripts
+func (w Wrapper) ActiveOrders(exch string, _ *order.GetOrdersRequest) ([]order.Detail, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+
+	return []order.Detail{
+		{
+			Exchange:  exch,
+			ID:        "2",
+			OrderSide: order.Sell,
+			OrderType: order.Limit,
+			Status:    order.Active,
+			Price:     1100,
+			Amount:    1,
+		},
+	}, nil
+}
+
+// FundingHistory validator for test execution/scripts
+func (w Wrapper) FundingHistory(exch string, start, _ time.Time) ([]modules.FundHistory, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+
+	return []modules.FundHistory{
+		{
+			ExchangeName: exch,
+			Status:       "complete",
+			Timestamp:    start,
+			Currency:     "BTC",
+			Amount:       1,
+			TransferType: "deposit",
+		},
+	}, nil
+}
@@ -2,9 +2,11 @@ package validator
 
 import (
 	"testing"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -85,6 +87,23 @@ func TestWrapper_CancelOrder(t *testing.T) {
 	}
 }
 
+func TestWrapper_CancelOrders(t *testing.T) {
+	t.Parallel()
+
+	r, err := testWrapper.CancelOrders(exchName, []string{orderID, "false"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Status) != 1 {
+		t.Errorf("expected one failed order, received %+v", r.Status)
+	}
+
+	_, err = testWrapper.CancelOrders(exchError.String(), nil)
+	if err == nil {
+		t.Fatal("expected CancelOrders to return error on invalid name")
+	}
+}
+
 func TestWrapper_DepositAddress(t *testing.T) {
 	_, err := testWrapper.DepositAddress(exchError.String(), currency.NewCode("BTC"))
 	if err == nil {
@@ -166,6 +185,31 @@ func TestWrapper_SubmitOrder(t *testing.T) {
 	}
 }
 
+func TestWrapper_SubmitOrders(t *testing.T) {
+	t.Parallel()
+
+	tempOrder := order.Submit{
+		Pair:      currency.NewPairDelimiter(pairs, delimiter),
+		OrderType: orderType,
+		OrderSide: orderSide,
+		Price:     orderPrice,
+		Amount:    orderAmount,
+		ClientID:  orderClientID,
+	}
+	r, err := testWrapper.SubmitOrders("true", []order.Submit{tempOrder, tempOrder})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r) != 2 || !r[1].IsOrderPlaced {
+		t.Errorf("expected two placed orders, received %+v", r)
+	}
+
+	_, err = testWrapper.SubmitOrders(exchError.String(), nil)
+	if err == nil {
+		t.Fatal("expected SubmitOrders to return error with invalid name")
+	}
+}
+
 func TestWrapper_Ticker(t *testing.T) {
 	t.Parallel()
 	c := currency.NewPairDelimiter(pairs, delimiter)
@@ -203,3 +247,139 @@ func TestWrapper_WithdrawalFiatFunds(t *testing.T) {
 		t.Fatal("expected WithdrawalCryptoFunds to return error with invalid name")
 	}
 }
+
+func TestWrapper_Subscribe(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.SubscribeTicker(exchName, currencyPair, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = testWrapper.SubscribeTicker(exchError.String(), currencyPair, assetType)
+	if err == nil {
+		t.Fatal("expected SubscribeTicker to return error with invalid name")
+	}
+
+	_, err = testWrapper.SubscribeOrderbook(exchName, currencyPair, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = testWrapper.SubscribeOrderbook(exchError.String(), currencyPair, assetType)
+	if err == nil {
+		t.Fatal("expected SubscribeOrderbook to return error with invalid name")
+	}
+
+	_, err = testWrapper.SubscribeAccount(exchName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = testWrapper.SubscribeAccount(exchError.String())
+	if err == nil {
+		t.Fatal("expected SubscribeAccount to return error with invalid name")
+	}
+
+	_, err = testWrapper.SubscribeOrders(exchName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = testWrapper.SubscribeOrders(exchError.String())
+	if err == nil {
+		t.Fatal("expected SubscribeOrders to return error with invalid name")
+	}
+}
+
+func TestWrapper_OrderUpdate(t *testing.T) {
+	t.Parallel()
+	d, err := testWrapper.OrderUpdate(order.Detail{ID: orderID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.ID != orderID {
+		t.Fatalf("expected order ID %v received %v", orderID, d.ID)
+	}
+
+	_, err = testWrapper.OrderUpdate(nil)
+	if err == nil {
+		t.Fatal("expected OrderUpdate to return error with invalid data")
+	}
+}
+
+func TestWrapper_HistoricCandles(t *testing.T) {
+	t.Parallel()
+	start := time.Now().Add(-time.Hour)
+	candles, err := testWrapper.HistoricCandles(exchName, currencyPair, assetType, start, time.Now(), kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles.Candles) != 1 || !candles.Candles[0].Time.Equal(start) {
+		t.Fatalf("unexpected candles %+v", candles.Candles)
+	}
+
+	_, err = testWrapper.HistoricCandles(exchError.String(), currencyPair, assetType, start, time.Now(), kline.OneMin)
+	if err == nil {
+		t.Fatal("expected HistoricCandles to return error on invalid name")
+	}
+}
+
+func TestWrapper_TradeHistory(t *testing.T) {
+	t.Parallel()
+	trades, err := testWrapper.TradeHistory(exchName, currencyPair, assetType, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 1 {
+		t.Fatalf("expected 1 trade received %v", len(trades))
+	}
+
+	_, err = testWrapper.TradeHistory(exchError.String(), currencyPair, assetType, time.Time{}, time.Time{})
+	if err == nil {
+		t.Fatal("expected TradeHistory to return error on invalid name")
+	}
+}
+
+func TestWrapper_OrderHistory(t *testing.T) {
+	t.Parallel()
+	orders, err := testWrapper.OrderHistory(exchName, &order.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 || orders[0].Status != order.Filled {
+		t.Fatalf("unexpected orders %+v", orders)
+	}
+
+	_, err = testWrapper.OrderHistory(exchError.String(), &order.GetOrdersRequest{})
+	if err == nil {
+		t.Fatal("expected OrderHistory to return error on invalid name")
+	}
+}
+
+func TestWrapper_ActiveOrders(t *testing.T) {
+	t.Parallel()
+	orders, err := testWrapper.ActiveOrders(exchName, &order.GetOrdersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 || orders[0].Status != order.Active {
+		t.Fatalf("unexpected orders %+v", orders)
+	}
+
+	_, err = testWrapper.ActiveOrders(exchError.String(), &order.GetOrdersRequest{})
+	if err == nil {
+		t.Fatal("expected ActiveOrders to return error on invalid name")
+	}
+}
+
+func TestWrapper_FundingHistory(t *testing.T) {
+	t.Parallel()
+	funding, err := testWrapper.FundingHistory(exchName, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(funding) != 1 {
+		t.Fatalf("expected 1 funding record received %v", len(funding))
+	}
+
+	_, err = testWrapper.FundingHistory(exchError.String(), time.Time{}, time.Time{})
+	if err == nil {
+		t.Fatal("expected FundingHistory to return error on invalid name")
+	}
+}
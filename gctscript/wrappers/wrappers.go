@@ -12,3 +12,13 @@ func GetWrapper() modules.GCT {
 	}
 	return modules.Wrapper
 }
+
+// GetPolicyWrapper returns the instance of each wrapper to use restricted to
+// the policy of a script when the wrapper supports it
+func GetPolicyWrapper(p *modules.Policy) modules.GCT {
+	w := GetWrapper()
+	if e, ok := w.(modules.PolicyEnforcer); ok {
+		return e.WithPolicy(p)
+	}
+	return w
+}
@@ -0,0 +1,253 @@
+// Package indicators calculates technical analysis indicators from candle
+// data. Each indicator returns values only for the points where it is fully
+// defined, so the output is aligned to the end of the input and the first
+// value corresponds to input[len(input)-len(output)]
+package indicators
+
+import (
+	"math"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Open returns the open prices of the candles
+func Open(candles []kline.Candle) []float64 {
+	out := make([]float64, len(candles))
+	for x := range candles {
+		out[x] = candles[x].Open
+	}
+	return out
+}
+
+// High returns the high prices of the candles
+func High(candles []kline.Candle) []float64 {
+	out := make([]float64, len(candles))
+	for x := range candles {
+		out[x] = candles[x].High
+	}
+	return out
+}
+
+// Low returns the low prices of the candles
+func Low(candles []kline.Candle) []float64 {
+	out := make([]float64, len(candles))
+	for x := range candles {
+		out[x] = candles[x].Low
+	}
+	return out
+}
+
+// Close returns the close prices of the candles
+func Close(candles []kline.Candle) []float64 {
+	out := make([]float64, len(candles))
+	for x := range candles {
+		out[x] = candles[x].Close
+	}
+	return out
+}
+
+// Volume returns the volumes of the candles
+func Volume(candles []kline.Candle) []float64 {
+	out := make([]float64, len(candles))
+	for x := range candles {
+		out[x] = candles[x].Volume
+	}
+	return out
+}
+
+// SMA returns the simple moving average of the values over the period
+func SMA(values []float64, period int) ([]float64, error) {
+	err := checkPeriod(len(values), period)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]float64, 0, len(values)-period+1)
+	var sum float64
+	for x := range values {
+		sum += values[x]
+		if x >= period {
+			sum -= values[x-period]
+		}
+		if x >= period-1 {
+			out = append(out, sum/float64(period))
+		}
+	}
+	return out, nil
+}
+
+// EMA returns the exponential moving average of the values over the period,
+// seeded with the simple moving average of the first period values
+func EMA(values []float64, period int) ([]float64, error) {
+	err := checkPeriod(len(values), period)
+	if err != nil {
+		return nil, err
+	}
+
+	k := 2 / float64(period+1)
+	out := make([]float64, 0, len(values)-period+1)
+	var seed float64
+	for x := 0; x < period; x++ {
+		seed += values[x]
+	}
+	out = append(out, seed/float64(period))
+	for x := period; x < len(values); x++ {
+		out = append(out, values[x]*k+out[len(out)-1]*(1-k))
+	}
+	return out, nil
+}
+
+// RSI returns the relative strength index of the values over the period using
+// Wilder's smoothing
+func RSI(values []float64, period int) ([]float64, error) {
+	err := checkPeriod(len(values)-1, period)
+	if err != nil {
+		return nil, err
+	}
+
+	var avgGain, avgLoss float64
+	for x := 1; x <= period; x++ {
+		gain, loss := change(values[x-1], values[x])
+		avgGain += gain
+		avgLoss += loss
+	}
+	avgGain /= float64(period)
+	avgLoss /= float64(period)
+
+	out := make([]float64, 0, len(values)-period)
+	out = append(out, relativeStrength(avgGain, avgLoss))
+	for x := period + 1; x < len(values); x++ {
+		gain, loss := change(values[x-1], values[x])
+		avgGain = (avgGain*float64(period-1) + gain) / float64(period)
+		avgLoss = (avgLoss*float64(period-1) + loss) / float64(period)
+		out = append(out, relativeStrength(avgGain, avgLoss))
+	}
+	return out, nil
+}
+
+// MACD returns the moving average convergence divergence of the values, the
+// difference between the fast and slow EMAs, along with its signal line EMA
+// and histogram
+func MACD(values []float64, fast, slow, signal int) (*MACDResult, error) {
+	if fast <= 0 || slow <= 0 || signal <= 0 {
+		return nil, ErrInvalidPeriod
+	}
+	if fast >= slow {
+		return nil, ErrInvalidMACD
+	}
+
+	slowEMA, err := EMA(values, slow)
+	if err != nil {
+		return nil, err
+	}
+	fastEMA, err := EMA(values, fast)
+	if err != nil {
+		return nil, err
+	}
+	fastEMA = fastEMA[len(fastEMA)-len(slowEMA):]
+
+	line := make([]float64, len(slowEMA))
+	for x := range slowEMA {
+		line[x] = fastEMA[x] - slowEMA[x]
+	}
+	signalLine, err := EMA(line, signal)
+	if err != nil {
+		return nil, err
+	}
+	line = line[len(line)-len(signalLine):]
+
+	histogram := make([]float64, len(signalLine))
+	for x := range signalLine {
+		histogram[x] = line[x] - signalLine[x]
+	}
+	return &MACDResult{
+		MACD:      line,
+		Signal:    signalLine,
+		Histogram: histogram,
+	}, nil
+}
+
+// BollingerBands returns the simple moving average of the values over the
+// period with bands the supplied number of population standard deviations
+// above and below it
+func BollingerBands(values []float64, period int, deviations float64) (*BollingerBandsResult, error) {
+	middle, err := SMA(values, period)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &BollingerBandsResult{
+		Upper:  make([]float64, len(middle)),
+		Middle: middle,
+		Lower:  make([]float64, len(middle)),
+	}
+	for x := range middle {
+		var variance float64
+		for _, v := range values[x : x+period] {
+			variance += (v - middle[x]) * (v - middle[x])
+		}
+		offset := deviations * math.Sqrt(variance/float64(period))
+		r.Upper[x] = middle[x] + offset
+		r.Lower[x] = middle[x] - offset
+	}
+	return r, nil
+}
+
+// ATR returns the average true range of the candles over the period using
+// Wilder's smoothing
+func ATR(candles []kline.Candle, period int) ([]float64, error) {
+	err := checkPeriod(len(candles)-1, period)
+	if err != nil {
+		return nil, err
+	}
+
+	var atr float64
+	for x := 1; x <= period; x++ {
+		atr += trueRange(&candles[x], candles[x-1].Close)
+	}
+	atr /= float64(period)
+
+	out := make([]float64, 0, len(candles)-period)
+	out = append(out, atr)
+	for x := period + 1; x < len(candles); x++ {
+		atr = (atr*float64(period-1) + trueRange(&candles[x], candles[x-1].Close)) / float64(period)
+		out = append(out, atr)
+	}
+	return out, nil
+}
+
+// checkPeriod ensures the period is valid and there are enough values to
+// calculate at least one point
+func checkPeriod(length, period int) error {
+	if period <= 0 {
+		return ErrInvalidPeriod
+	}
+	if length < period {
+		return ErrInsufficientData
+	}
+	return nil
+}
+
+func change(previous, current float64) (gain, loss float64) {
+	if current > previous {
+		return current - previous, 0
+	}
+	return 0, previous - current
+}
+
+// relativeStrength converts average gains and losses to an RSI value, a flat
+// market with no gains or losses is neutral
+func relativeStrength(avgGain, avgLoss float64) float64 {
+	if avgLoss == 0 {
+		if avgGain == 0 {
+			return 50
+		}
+		return 100
+	}
+	return 100 - 100/(1+avgGain/avgLoss)
+}
+
+func trueRange(c *kline.Candle, previousClose float64) float64 {
+	return math.Max(c.High-c.Low,
+		math.Max(math.Abs(c.High-previousClose), math.Abs(c.Low-previousClose)))
+}
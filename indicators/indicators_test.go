@@ -0,0 +1,152 @@
+package indicators
+
+import (
+	"math"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// closes are the reference closing prices used by Wilder's RSI example
+var closes = []float64{44.34, 44.09, 44.15, 43.61, 44.33, 44.83, 45.10, 45.42,
+	45.84, 46.08, 45.89, 46.03, 45.61, 46.28, 46.28, 46.00, 46.03, 46.41, 46.22,
+	45.64}
+
+func testCandles() []kline.Candle {
+	candles := make([]kline.Candle, len(closes))
+	for x := range closes {
+		candles[x] = kline.Candle{
+			Open:  closes[x],
+			High:  closes[x] + 0.5 + 0.1*float64(x%3),
+			Low:   closes[x] - 0.4 - 0.1*float64(x%4),
+			Close: closes[x],
+		}
+	}
+	return candles
+}
+
+func checkValues(t *testing.T, name string, received, expected []float64) {
+	t.Helper()
+	if len(received) != len(expected) {
+		t.Fatalf("%s expected %d values, received %d", name, len(expected), len(received))
+	}
+	for x := range expected {
+		if math.Abs(received[x]-expected[x]) > 0.0001 {
+			t.Errorf("%s value %d expected %.4f, received %.4f", name, x, expected[x], received[x])
+		}
+	}
+}
+
+func TestCandleValues(t *testing.T) {
+	t.Parallel()
+	candles := testCandles()
+	checkValues(t, "close", Close(candles), closes)
+	checkValues(t, "open", Open(candles), closes)
+	high, low, volume := High(candles), Low(candles), Volume(candles)
+	for x := range candles {
+		if high[x] != candles[x].High || low[x] != candles[x].Low || volume[x] != candles[x].Volume {
+			t.Errorf("unexpected candle %d values high %v low %v volume %v",
+				x, high[x], low[x], volume[x])
+		}
+	}
+}
+
+func TestSMA(t *testing.T) {
+	t.Parallel()
+	out, err := SMA(closes, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkValues(t, "SMA", out, []float64{44.1040, 44.2020, 44.4040, 44.6580,
+		45.1040, 45.4540, 45.6660, 45.8520, 45.8900, 45.9780, 46.0180, 46.0400,
+		46.0400, 46.2000, 46.1880, 46.0600})
+
+	if _, err = SMA(closes, 0); err != ErrInvalidPeriod {
+		t.Errorf("expected %v, received %v", ErrInvalidPeriod, err)
+	}
+	if _, err = SMA(closes, 21); err != ErrInsufficientData {
+		t.Errorf("expected %v, received %v", ErrInsufficientData, err)
+	}
+}
+
+func TestEMA(t *testing.T) {
+	t.Parallel()
+	out, err := EMA(closes, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkValues(t, "EMA", out, []float64{44.1040, 44.3460, 44.5973, 44.8716,
+		45.1944, 45.4896, 45.6231, 45.7587, 45.7091, 45.8994, 46.0263, 46.0175,
+		46.0217, 46.1511, 46.1741, 45.9961})
+}
+
+func TestRSI(t *testing.T) {
+	t.Parallel()
+	out, err := RSI(closes, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkValues(t, "RSI", out, []float64{70.4641, 66.2496, 66.4809, 69.3469,
+		66.2947, 57.9150})
+
+	if _, err = RSI(closes, 20); err != ErrInsufficientData {
+		t.Errorf("expected %v, received %v", ErrInsufficientData, err)
+	}
+
+	out, err = RSI([]float64{1, 1, 1}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkValues(t, "flat RSI", out, []float64{50})
+}
+
+func TestMACD(t *testing.T) {
+	t.Parallel()
+	out, err := MACD(closes, 3, 6, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkValues(t, "MACD", out.MACD, []float64{0.4138, 0.4259, 0.3287, 0.2770,
+		0.1290, 0.2013, 0.1983, 0.1089, 0.0679, 0.1250, 0.0868, -0.0635})
+	checkValues(t, "MACD signal", out.Signal, []float64{0.3328, 0.3701, 0.3535,
+		0.3229, 0.2453, 0.2277, 0.2160, 0.1732, 0.1310, 0.1286, 0.1119, 0.0417})
+	checkValues(t, "MACD histogram", out.Histogram, []float64{0.0809, 0.0558,
+		-0.0248, -0.0459, -0.1164, -0.0264, -0.0176, -0.0642, -0.0632, -0.0037,
+		-0.0251, -0.1053})
+
+	if _, err = MACD(closes, 6, 3, 4); err != ErrInvalidMACD {
+		t.Errorf("expected %v, received %v", ErrInvalidMACD, err)
+	}
+	if _, err = MACD(closes, 3, 6, 0); err != ErrInvalidPeriod {
+		t.Errorf("expected %v, received %v", ErrInvalidPeriod, err)
+	}
+}
+
+func TestBollingerBands(t *testing.T) {
+	t.Parallel()
+	out, err := BollingerBands(closes, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkValues(t, "upper band", out.Upper, []float64{46.3238, 46.5793, 46.7869,
+		46.8158, 46.7196, 46.6681, 46.5697, 46.4606, 46.4934, 46.5197, 46.5503})
+	checkValues(t, "middle band", out.Middle, []float64{44.7790, 44.9340, 45.1280,
+		45.2740, 45.5410, 45.7360, 45.8530, 45.9460, 46.0450, 46.0830, 46.0390})
+	checkValues(t, "lower band", out.Lower, []float64{43.2342, 43.2887, 43.4691,
+		43.7322, 44.3624, 44.8039, 45.1363, 45.4314, 45.5966, 45.6463, 45.5277})
+}
+
+func TestATR(t *testing.T) {
+	t.Parallel()
+	out, err := ATR(testCandles(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkValues(t, "ATR", out, []float64{1.2320, 1.2056, 1.2245, 1.2036, 1.1629,
+		1.1703, 1.2162, 1.1530, 1.1764, 1.2011, 1.2009, 1.1607, 1.1686, 1.1549,
+		1.1839})
+
+	if _, err = ATR(testCandles()[:5], 5); err != ErrInsufficientData {
+		t.Errorf("expected %v, received %v", ErrInsufficientData, err)
+	}
+}
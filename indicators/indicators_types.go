@@ -0,0 +1,25 @@
+package indicators
+
+import "errors"
+
+// vars for the indicators package
+var (
+	ErrInvalidPeriod    = errors.New("indicator period must be greater than zero")
+	ErrInsufficientData = errors.New("not enough data to calculate indicator")
+	ErrInvalidMACD      = errors.New("MACD fast period must be less than the slow period")
+)
+
+// MACDResult holds the MACD line, its signal line and the histogram of their
+// difference, all aligned to the same candles
+type MACDResult struct {
+	MACD      []float64
+	Signal    []float64
+	Histogram []float64
+}
+
+// BollingerBandsResult holds the upper, middle and lower Bollinger Bands
+type BollingerBandsResult struct {
+	Upper  []float64
+	Middle []float64
+	Lower  []float64
+}